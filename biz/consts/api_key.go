@@ -0,0 +1,31 @@
+package consts
+
+// APIKeyScope 团队API Key授权范围
+type APIKeyScope string
+
+const (
+	APIKeyScopeCampaignRead  APIKeyScope = "campaign:read"  // 查看Campaign
+	APIKeyScopeCampaignWrite APIKeyScope = "campaign:write" // 创建、修改Campaign
+	APIKeyScopeKolRead       APIKeyScope = "kol:read"       // 查看KOL信息、报价和视频
+	APIKeyScopeOrdersWrite   APIKeyScope = "orders:write"   // 创建、取消KOL订单和广告订单
+)
+
+// String 返回授权范围的字符串表示
+func (s APIKeyScope) String() string {
+	return string(s)
+}
+
+// IsValid 检查授权范围是否有效
+func (s APIKeyScope) IsValid() bool {
+	for _, scope := range AllAPIKeyScopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AllAPIKeyScopes 返回所有API Key授权范围
+func AllAPIKeyScopes() []APIKeyScope {
+	return []APIKeyScope{APIKeyScopeCampaignRead, APIKeyScopeCampaignWrite, APIKeyScopeKolRead, APIKeyScopeOrdersWrite}
+}
@@ -0,0 +1,104 @@
+package consts
+
+// Permission 后台权限
+type Permission string
+
+const (
+	// 用户管理
+	PermUserRead   Permission = "user:read"   // 查看用户、团队
+	PermUserManage Permission = "user:manage" // 修改用户状态、合并账号
+	PermRoleManage Permission = "role:manage" // 分配/撤销后台角色
+
+	// KOL管理
+	PermKolRead   Permission = "kol:read"   // 查看KOL列表
+	PermKolReview Permission = "kol:review" // 审核KOL申请、管理KOL订单评价
+
+	// 订单和Campaign
+	PermOrderRead      Permission = "order:read"      // 查看KOL订单、广告订单
+	PermCampaignRead   Permission = "campaign:read"   // 查看Campaign
+	PermCampaignManage Permission = "campaign:manage" // 修改Campaign状态
+
+	// 资金
+	PermFinanceRead   Permission = "finance:read"   // 查看充值、提现、链上入账、钱包和对账
+	PermFinanceWrite  Permission = "finance:write"  // 确认/拒绝充值和提现、处理入账、Campaign扣费、订单退款
+	PermPaymentManage Permission = "payment:manage" // 管理收款设置
+
+	// 内容
+	PermContentManage Permission = "content:manage" // 管理字典、优秀案例、内容趋势和平台数据
+
+	// 审计
+	PermAuditRead Permission = "audit:read" // 查询和导出审计日志
+)
+
+// String 返回权限的字符串表示
+func (p Permission) String() string {
+	return string(p)
+}
+
+// StaffRole 后台角色，一个用户可以拥有多个后台角色，权限取并集
+type StaffRole string
+
+const (
+	// StaffRoleSuperAdmin 超级管理员，拥有全部权限
+	StaffRoleSuperAdmin StaffRole = "super_admin"
+	// StaffRoleFinance 财务
+	StaffRoleFinance StaffRole = "finance"
+	// StaffRoleModerator 审核
+	StaffRoleModerator StaffRole = "moderator"
+	// StaffRoleContentEditor 内容编辑
+	StaffRoleContentEditor StaffRole = "content_editor"
+	// StaffRoleSupport 客服（只读）
+	StaffRoleSupport StaffRole = "support"
+)
+
+// staffRolePermissions 后台角色拥有的权限
+var staffRolePermissions = map[StaffRole][]Permission{
+	StaffRoleSuperAdmin: AllPermissions(),
+	StaffRoleFinance: {
+		PermUserRead, PermOrderRead, PermCampaignRead,
+		PermFinanceRead, PermFinanceWrite, PermPaymentManage,
+	},
+	StaffRoleModerator: {
+		PermUserRead, PermUserManage, PermKolRead, PermKolReview,
+		PermOrderRead, PermCampaignRead, PermCampaignManage,
+	},
+	StaffRoleContentEditor: {
+		PermContentManage,
+	},
+	StaffRoleSupport: {
+		PermUserRead, PermKolRead, PermOrderRead, PermCampaignRead, PermFinanceRead,
+	},
+}
+
+// String 返回角色的字符串表示
+func (r StaffRole) String() string {
+	return string(r)
+}
+
+// IsValid 检查后台角色是否有效
+func (r StaffRole) IsValid() bool {
+	_, ok := staffRolePermissions[r]
+	return ok
+}
+
+// Permissions 返回后台角色拥有的权限
+func (r StaffRole) Permissions() []Permission {
+	return staffRolePermissions[r]
+}
+
+// AllStaffRoles 返回所有后台角色列表
+func AllStaffRoles() []StaffRole {
+	return []StaffRole{StaffRoleSuperAdmin, StaffRoleFinance, StaffRoleModerator, StaffRoleContentEditor, StaffRoleSupport}
+}
+
+// AllPermissions 返回所有权限列表
+func AllPermissions() []Permission {
+	return []Permission{
+		PermUserRead, PermUserManage, PermRoleManage,
+		PermKolRead, PermKolReview,
+		PermOrderRead, PermCampaignRead, PermCampaignManage,
+		PermFinanceRead, PermFinanceWrite, PermPaymentManage,
+		PermContentManage,
+		PermAuditRead,
+	}
+}
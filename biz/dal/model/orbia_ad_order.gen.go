@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"orbia_api/biz/utils/money"
 )
 
 const TableNameOrbiaAdOrder = "orbia_ad_order"
@@ -20,7 +22,7 @@ type OrbiaAdOrder struct {
 	TeamID         *int64         `gorm:"column:team_id;type:bigint;comment:下单团队ID（如果是团队下单）" json:"team_id"`                                                                                                                                            // 下单团队ID（如果是团队下单）
 	Title          string         `gorm:"column:title;type:varchar(200);not null;comment:广告订单标题" json:"title"`                                                                                                                                          // 广告订单标题
 	Description    string         `gorm:"column:description;type:text;not null;comment:广告订单描述" json:"description"`                                                                                                                                      // 广告订单描述
-	Budget         float64        `gorm:"column:budget;type:decimal(12,2);not null;comment:广告预算（美元）" json:"budget"`                                                                                                                                     // 广告预算（美元）
+	Budget         money.Amount   `gorm:"column:budget;type:decimal(12,2);not null;comment:广告预算（美元）" json:"budget"`                                                                                                                                     // 广告预算（美元）
 	AdType         string         `gorm:"column:ad_type;type:varchar(50);not null;comment:广告类型：banner, video, social_media, influencer" json:"ad_type"`                                                                                                 // 广告类型：banner, video, social_media, influencer
 	TargetAudience string         `gorm:"column:target_audience;type:varchar(500);not null;comment:目标受众" json:"target_audience"`                                                                                                                        // 目标受众
 	StartDate      time.Time      `gorm:"column:start_date;type:date;not null;comment:开始日期" json:"start_date"`                                                                                                                                          // 开始日期
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"orbia_api/biz/utils/money"
 )
 
 const TableNameOrbiaCampaign = "orbia_campaign"
@@ -42,7 +44,7 @@ type OrbiaCampaign struct {
 	FrequencyCapTimes  *int32         `gorm:"column:frequency_cap_times;type:int;comment:自定义频次（次数）" json:"frequency_cap_times"`                                                                                                  // 自定义频次（次数）
 	FrequencyCapDays   *int32         `gorm:"column:frequency_cap_days;type:int;comment:自定义频次（天数）" json:"frequency_cap_days"`                                                                                                    // 自定义频次（天数）
 	BudgetType         int32          `gorm:"column:budget_type;type:tinyint;not null;comment:预算类型：0-每日预算，1-总预算" json:"budget_type"`                                                                                             // 预算类型：0-每日预算，1-总预算
-	BudgetAmount       float64        `gorm:"column:budget_amount;type:decimal(15,2);not null;comment:预算金额" json:"budget_amount"`                                                                                                // 预算金额
+	BudgetAmount       money.Amount   `gorm:"column:budget_amount;type:decimal(15,2);not null;comment:预算金额" json:"budget_amount"`                                                                                                // 预算金额
 	Website            *string        `gorm:"column:website;type:varchar(1000);comment:网站链接" json:"website"`                                                                                                                     // 网站链接
 	IosDownloadURL     *string        `gorm:"column:ios_download_url;type:varchar(1000);comment:iOS下载链接" json:"ios_download_url"`                                                                                                // iOS下载链接
 	AndroidDownloadURL *string        `gorm:"column:android_download_url;type:varchar(1000);comment:Android下载链接" json:"android_download_url"`                                                                                    // Android下载链接
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"orbia_api/biz/utils/money"
 )
 
 const TableNameOrbiaKolOrder = "orbia_kol_order"
@@ -22,7 +24,7 @@ type OrbiaKolOrder struct {
 	PlanID                 int64          `gorm:"column:plan_id;type:bigint;not null;comment:KOL报价Plan ID" json:"plan_id"`                                                                                                                                                                                                              // KOL报价Plan ID
 	PlanTitle              string         `gorm:"column:plan_title;type:varchar(200);not null;comment:Plan标题（快照）" json:"plan_title"`                                                                                                                                                                                                    // Plan标题（快照）
 	PlanDescription        *string        `gorm:"column:plan_description;type:text;comment:Plan描述（快照）" json:"plan_description"`                                                                                                                                                                                                         // Plan描述（快照）
-	PlanPrice              float64        `gorm:"column:plan_price;type:decimal(10,2);not null;comment:Plan价格（快照，美元）" json:"plan_price"`                                                                                                                                                                                                // Plan价格（快照，美元）
+	PlanPrice              money.Amount   `gorm:"column:plan_price;type:decimal(10,2);not null;comment:Plan价格（快照，美元）" json:"plan_price"`                                                                                                                                                                                                // Plan价格（快照，美元）
 	PlanType               string         `gorm:"column:plan_type;type:varchar(20);not null;comment:Plan类型（快照）：basic, standard, premium" json:"plan_type"`                                                                                                                                                                              // Plan类型（快照）：basic, standard, premium
 	Title                  string         `gorm:"column:title;type:varchar(200);not null;comment:订单标题" json:"title"`                                                                                                                                                                                                                    // 订单标题
 	RequirementDescription string         `gorm:"column:requirement_description;type:text;not null;comment:合作需求描述" json:"requirement_description"`                                                                                                                                                                                      // 合作需求描述
@@ -37,6 +39,8 @@ type OrbiaKolOrder struct {
 	ConfirmedAt            *time.Time     `gorm:"column:confirmed_at;type:timestamp;comment:确认时间" json:"confirmed_at"`                                                                                                                                                                                                                  // 确认时间
 	CompletedAt            *time.Time     `gorm:"column:completed_at;type:timestamp;comment:完成时间" json:"completed_at"`                                                                                                                                                                                                                  // 完成时间
 	CancelledAt            *time.Time     `gorm:"column:cancelled_at;type:timestamp;comment:取消时间" json:"cancelled_at"`                                                                                                                                                                                                                  // 取消时间
+	RefundAmount           *money.Amount  `gorm:"column:refund_amount;type:decimal(10,2);comment:退款金额（美元，部分退款时小于plan_price）" json:"refund_amount"`                                                                                                                                                                                      // 退款金额（美元，部分退款时小于plan_price）
+	RefundedAt             *time.Time     `gorm:"column:refunded_at;type:timestamp;comment:退款时间" json:"refunded_at"`                                                                                                                                                                                                                    // 退款时间
 	CreatedAt              *time.Time     `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                                                                                                                            // 创建时间
 	UpdatedAt              *time.Time     `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                                                                                                                                                            // 更新时间
 	DeletedAt              gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:软删除时间" json:"deleted_at"`                                                                                                                                                                                                                     // 软删除时间
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"orbia_api/biz/utils/money"
 )
 
 const TableNameOrbiaRechargeOrder = "orbia_recharge_order"
@@ -17,7 +19,7 @@ type OrbiaRechargeOrder struct {
 	ID                    int64          `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true;comment:自增ID（内部使用）" json:"id"`                                                                                          // 自增ID（内部使用）
 	OrderID               string         `gorm:"column:order_id;type:varchar(64);not null;comment:订单ID（业务唯一ID，格式：RCHORD_{timestamp}_{random}）" json:"order_id"`                                                             // 订单ID（业务唯一ID，格式：RCHORD_{timestamp}_{random}）
 	UserID                int64          `gorm:"column:user_id;type:bigint;not null;comment:用户ID" json:"user_id"`                                                                                                           // 用户ID
-	Amount                float64        `gorm:"column:amount;type:decimal(12,2);not null;comment:充值金额（美元）" json:"amount"`                                                                                                  // 充值金额（美元）
+	Amount                money.Amount   `gorm:"column:amount;type:decimal(12,2);not null;comment:充值金额（美元）" json:"amount"`                                                                                                  // 充值金额（美元）
 	PaymentType           string         `gorm:"column:payment_type;type:enum('crypto','online');not null;comment:支付类型：crypto-加密货币，online-在线支付" json:"payment_type"`                                                        // 支付类型：crypto-加密货币，online-在线支付
 	PaymentSettingID      *int64         `gorm:"column:payment_setting_id;type:bigint;comment:关联的payment_setting ID（用于加密货币支付）" json:"payment_setting_id"`                                                                   // 关联的payment_setting ID（用于加密货币支付）
 	PaymentNetwork        *string        `gorm:"column:payment_network;type:varchar(100);comment:快照-区块链网络" json:"payment_network"`                                                                                          // 快照-区块链网络
@@ -32,10 +34,13 @@ type OrbiaRechargeOrder struct {
 	ConfirmedBy           *int64         `gorm:"column:confirmed_by;type:bigint;comment:确认人ID（管理员）" json:"confirmed_by"`                                                                                                    // 确认人ID（管理员）
 	ConfirmedAt           *time.Time     `gorm:"column:confirmed_at;type:timestamp;comment:确认时间" json:"confirmed_at"`                                                                                                       // 确认时间
 	FailedReason          *string        `gorm:"column:failed_reason;type:text;comment:失败原因" json:"failed_reason"`                                                                                                          // 失败原因
-	Remark                *string        `gorm:"column:remark;type:text;comment:备注" json:"remark"`                                                                                                                          // 备注
-	CreatedAt             *time.Time     `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                 // 创建时间
-	UpdatedAt             *time.Time     `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                                                 // 更新时间
-	DeletedAt             gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:软删除时间" json:"deleted_at"`                                                                                                          // 软删除时间
+	Remark                *string        `gorm:"column:remark;type:text;comment:备注" json:"remark"`
+	VerifyStatus          string         `gorm:"column:verify_status;type:enum('unverified','verified','flagged');not null;default:unverified;comment:链上校验状态：unverified-未校验，verified-校验通过，flagged-校验不通过（待人工处理）" json:"verify_status"` // 链上校验状态：unverified-未校验，verified-校验通过，flagged-校验不通过（待人工处理）
+	VerifyMessage         *string        `gorm:"column:verify_message;type:varchar(500);comment:链上校验结果说明" json:"verify_message"`                                                                                                      // 链上校验结果说明
+	VerifiedAt            *time.Time     `gorm:"column:verified_at;type:timestamp;comment:最近一次链上校验时间" json:"verified_at"`                                                                                                             // 备注
+	CreatedAt             *time.Time     `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                           // 创建时间
+	UpdatedAt             *time.Time     `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                                                           // 更新时间
+	DeletedAt             gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:软删除时间" json:"deleted_at"`                                                                                                                    // 软删除时间
 }
 
 // TableName OrbiaRechargeOrder's table name
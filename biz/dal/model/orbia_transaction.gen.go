@@ -6,26 +6,28 @@ package model
 
 import (
 	"time"
+
+	"orbia_api/biz/utils/money"
 )
 
 const TableNameOrbiaTransaction = "orbia_transaction"
 
-// OrbiaTransaction 交易记录表（仅记录支出账单）
+// OrbiaTransaction 交易记录表（用户账单）
 type OrbiaTransaction struct {
-	ID               int64      `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true;comment:自增ID（内部使用）" json:"id"`                                                                                                                      // 自增ID（内部使用）
-	TransactionID    string     `gorm:"column:transaction_id;type:varchar(64);not null;comment:交易ID（业务唯一ID，格式：TXN{snowflake_id}）" json:"transaction_id"`                                                                                       // 交易ID（业务唯一ID，格式：TXN{snowflake_id}）
-	UserID           int64      `gorm:"column:user_id;type:bigint;not null;comment:用户ID" json:"user_id"`                                                                                                                                       // 用户ID
-	Type             string     `gorm:"column:type;type:enum('consume','refund','freeze','unfreeze');not null;comment:交易类型：consume-消费，refund-退款，freeze-冻结，unfreeze-解冻" json:"type"`                                                            // 交易类型：consume-消费，refund-退款，freeze-冻结，unfreeze-解冻
-	Amount           float64    `gorm:"column:amount;type:decimal(12,2);not null;comment:交易金额（美元）" json:"amount"`                                                                                                                              // 交易金额（美元）
-	BalanceBefore    float64    `gorm:"column:balance_before;type:decimal(12,2);not null;comment:交易前余额（美元）" json:"balance_before"`                                                                                                             // 交易前余额（美元）
-	BalanceAfter     float64    `gorm:"column:balance_after;type:decimal(12,2);not null;comment:交易后余额（美元）" json:"balance_after"`                                                                                                               // 交易后余额（美元）
-	Status           string     `gorm:"column:status;type:enum('pending','processing','completed','failed','cancelled');not null;default:pending;comment:交易状态：pending-待处理，processing-处理中，completed-已完成，failed-失败，cancelled-已取消" json:"status"` // 交易状态：pending-待处理，processing-处理中，completed-已完成，failed-失败，cancelled-已取消
-	RelatedOrderType *string    `gorm:"column:related_order_type;type:varchar(50);comment:关联订单类型：kol_order-KOL订单，ad_order-广告订单" json:"related_order_type"`                                                                                     // 关联订单类型：kol_order-KOL订单，ad_order-广告订单
-	RelatedOrderID   *string    `gorm:"column:related_order_id;type:varchar(64);comment:关联订单ID（如果是消费/退款类型）" json:"related_order_id"`                                                                                                           // 关联订单ID（如果是消费/退款类型）
-	Remark           *string    `gorm:"column:remark;type:text;comment:备注说明" json:"remark"`                                                                                                                                                    // 备注说明
-	CompletedAt      *time.Time `gorm:"column:completed_at;type:timestamp;comment:完成时间" json:"completed_at"`                                                                                                                                   // 完成时间
-	CreatedAt        *time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                                             // 创建时间
-	UpdatedAt        *time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                                                                             // 更新时间
+	ID               int64        `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true;comment:自增ID（内部使用）" json:"id"`                                                                                                                      // 自增ID（内部使用）
+	TransactionID    string       `gorm:"column:transaction_id;type:varchar(64);not null;comment:交易ID（业务唯一ID，格式：TXN{snowflake_id}）" json:"transaction_id"`                                                                                       // 交易ID（业务唯一ID，格式：TXN{snowflake_id}）
+	UserID           int64        `gorm:"column:user_id;type:bigint;not null;comment:用户ID" json:"user_id"`                                                                                                                                       // 用户ID
+	Type             string       `gorm:"column:type;type:enum('recharge','consume','refund','freeze','unfreeze');not null;comment:交易类型：recharge-充值，consume-消费，refund-退款，freeze-冻结，unfreeze-解冻" json:"type"`                                     // 交易类型：recharge-充值，consume-消费，refund-退款，freeze-冻结，unfreeze-解冻
+	Amount           money.Amount `gorm:"column:amount;type:decimal(12,2);not null;comment:交易金额（美元）" json:"amount"`                                                                                                                              // 交易金额（美元）
+	BalanceBefore    money.Amount `gorm:"column:balance_before;type:decimal(12,2);not null;comment:交易前余额（美元）" json:"balance_before"`                                                                                                             // 交易前余额（美元）
+	BalanceAfter     money.Amount `gorm:"column:balance_after;type:decimal(12,2);not null;comment:交易后余额（美元）" json:"balance_after"`                                                                                                               // 交易后余额（美元）
+	Status           string       `gorm:"column:status;type:enum('pending','processing','completed','failed','cancelled');not null;default:pending;comment:交易状态：pending-待处理，processing-处理中，completed-已完成，failed-失败，cancelled-已取消" json:"status"` // 交易状态：pending-待处理，processing-处理中，completed-已完成，failed-失败，cancelled-已取消
+	RelatedOrderType *string      `gorm:"column:related_order_type;type:varchar(50);comment:关联订单类型：kol_order-KOL订单，ad_order-广告订单，recharge_order-充值订单" json:"related_order_type"`                                                                 // 关联订单类型：kol_order-KOL订单，ad_order-广告订单，recharge_order-充值订单
+	RelatedOrderID   *string      `gorm:"column:related_order_id;type:varchar(64);comment:关联订单ID" json:"related_order_id"`                                                                                                                       // 关联订单ID
+	Remark           *string      `gorm:"column:remark;type:text;comment:备注说明" json:"remark"`                                                                                                                                                    // 备注说明
+	CompletedAt      *time.Time   `gorm:"column:completed_at;type:timestamp;comment:完成时间" json:"completed_at"`                                                                                                                                   // 完成时间
+	CreatedAt        *time.Time   `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                                             // 创建时间
+	UpdatedAt        *time.Time   `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                                                                             // 更新时间
 }
 
 // TableName OrbiaTransaction's table name
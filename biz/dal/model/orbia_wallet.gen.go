@@ -6,20 +6,22 @@ package model
 
 import (
 	"time"
+
+	"orbia_api/biz/utils/money"
 )
 
 const TableNameOrbiaWallet = "orbia_wallet"
 
 // OrbiaWallet 用户钱包表
 type OrbiaWallet struct {
-	ID            int64      `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true;comment:钱包ID" json:"id"`                              // 钱包ID
-	UserID        int64      `gorm:"column:user_id;type:bigint;not null;comment:用户ID" json:"user_id"`                                         // 用户ID
-	Balance       float64    `gorm:"column:balance;type:decimal(12,2);not null;default:0.00;comment:余额（美元）" json:"balance"`                   // 余额（美元）
-	FrozenBalance float64    `gorm:"column:frozen_balance;type:decimal(12,2);not null;default:0.00;comment:冻结余额（美元）" json:"frozen_balance"`   // 冻结余额（美元）
-	TotalRecharge float64    `gorm:"column:total_recharge;type:decimal(12,2);not null;default:0.00;comment:累计充值金额（美元）" json:"total_recharge"` // 累计充值金额（美元）
-	TotalConsume  float64    `gorm:"column:total_consume;type:decimal(12,2);not null;default:0.00;comment:累计消费金额（美元）" json:"total_consume"`   // 累计消费金额（美元）
-	CreatedAt     *time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`               // 创建时间
-	UpdatedAt     *time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`               // 更新时间
+	ID            int64        `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true;comment:钱包ID" json:"id"`                              // 钱包ID
+	UserID        int64        `gorm:"column:user_id;type:bigint;not null;comment:用户ID" json:"user_id"`                                         // 用户ID
+	Balance       money.Amount `gorm:"column:balance;type:decimal(12,2);not null;default:0.00;comment:余额（美元）" json:"balance"`                   // 余额（美元）
+	FrozenBalance money.Amount `gorm:"column:frozen_balance;type:decimal(12,2);not null;default:0.00;comment:冻结余额（美元）" json:"frozen_balance"`   // 冻结余额（美元）
+	TotalRecharge money.Amount `gorm:"column:total_recharge;type:decimal(12,2);not null;default:0.00;comment:累计充值金额（美元）" json:"total_recharge"` // 累计充值金额（美元）
+	TotalConsume  money.Amount `gorm:"column:total_consume;type:decimal(12,2);not null;default:0.00;comment:累计消费金额（美元）" json:"total_consume"`   // 累计消费金额（美元）
+	CreatedAt     *time.Time   `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`               // 创建时间
+	UpdatedAt     *time.Time   `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`               // 更新时间
 }
 
 // TableName OrbiaWallet's table name
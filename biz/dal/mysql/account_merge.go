@@ -0,0 +1,149 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+
+	"orbia_api/biz/dal/model"
+
+	"gorm.io/gorm"
+)
+
+// userOwnedTables 按 user_id 归属用户的业务表，合并账号时整体转移到目标用户
+// orbia_kol_review 以 order_id 唯一，评价随订单一起转移，不会与目标用户的评价冲突
+var userOwnedTables = []string{
+	"orbia_kol_order",
+	"orbia_kol_review",
+	"orbia_ad_order",
+	"orbia_recharge_order",
+	"orbia_withdrawal_order",
+	"orbia_campaign",
+	"orbia_transaction",
+	"orbia_kol_earning",
+	"orbia_kol_earning_record",
+}
+
+// teamRoleRank 团队角色等级，合并同一团队的成员关系时保留较高的角色
+var teamRoleRank = map[string]int{
+	"member":  1,
+	"owner":   2,
+	"creator": 3,
+}
+
+// AccountMergeRepository 账号合并仓储接口（所有方法都必须在事务中调用）
+type AccountMergeRepository interface {
+	ReassignOwnedRecords(tx *gorm.DB, fromUserID, toUserID int64) error
+	MergeTeamMemberships(tx *gorm.DB, fromUserID, toUserID int64) error
+	MergeConversationMemberships(tx *gorm.DB, fromUserID, toUserID int64) error
+	ReassignKol(tx *gorm.DB, kolID, toUserID int64) error
+}
+
+// accountMergeRepository 账号合并仓储实现
+type accountMergeRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountMergeRepository 创建账号合并仓储实例
+func NewAccountMergeRepository(db *gorm.DB) AccountMergeRepository {
+	return &accountMergeRepository{db: db}
+}
+
+// ReassignOwnedRecords 将订单、评价、Campaign、交易记录、团队创建者、邀请、团队 API Key 和消息等转移到目标用户
+func (r *accountMergeRepository) ReassignOwnedRecords(tx *gorm.DB, fromUserID, toUserID int64) error {
+	if tx == nil {
+		return errors.New("ReassignOwnedRecords must be called within a transaction")
+	}
+
+	for _, table := range userOwnedTables {
+		if err := tx.Table(table).Where("user_id = ?", fromUserID).Update("user_id", toUserID).Error; err != nil {
+			return fmt.Errorf("failed to reassign %s: %v", table, err)
+		}
+	}
+
+	if err := tx.Unscoped().Model(&Team{}).Where("creator_id = ?", fromUserID).Update("creator_id", toUserID).Error; err != nil {
+		return fmt.Errorf("failed to reassign team creator: %v", err)
+	}
+	if err := tx.Unscoped().Model(&TeamInvitation{}).Where("inviter_id = ?", fromUserID).Update("inviter_id", toUserID).Error; err != nil {
+		return fmt.Errorf("failed to reassign team invitation: %v", err)
+	}
+	// 团队 API Key 以创建者身份在团队内操作，成员关系已转移到目标用户，创建者需同步转移，否则 Key 会失效
+	if err := tx.Model(&TeamAPIKey{}).Where("creator_id = ?", fromUserID).Update("creator_id", toUserID).Error; err != nil {
+		return fmt.Errorf("failed to reassign team api key creator: %v", err)
+	}
+	if err := tx.Model(&model.OrbiaMessage{}).Where("sender_id = ?", fromUserID).Update("sender_id", toUserID).Error; err != nil {
+		return fmt.Errorf("failed to reassign message sender: %v", err)
+	}
+
+	return nil
+}
+
+// MergeTeamMemberships 合并团队成员关系
+// 目标用户已在同一团队时删除源用户的成员关系，并保留两者中较高的角色；否则直接转移
+func (r *accountMergeRepository) MergeTeamMemberships(tx *gorm.DB, fromUserID, toUserID int64) error {
+	if tx == nil {
+		return errors.New("MergeTeamMemberships must be called within a transaction")
+	}
+
+	var fromMembers []TeamMember
+	if err := tx.Where("user_id = ?", fromUserID).Find(&fromMembers).Error; err != nil {
+		return err
+	}
+
+	for _, member := range fromMembers {
+		var existing TeamMember
+		err := tx.Where("team_id = ? AND user_id = ?", member.TeamID, toUserID).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Model(&TeamMember{}).Where("id = ?", member.ID).Update("user_id", toUserID).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if teamRoleRank[member.Role] > teamRoleRank[existing.Role] {
+			if err := tx.Model(&TeamMember{}).Where("id = ?", existing.ID).Update("role", member.Role).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(&TeamMember{}, member.ID).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MergeConversationMemberships 合并会话成员关系，目标用户已在同一会话时删除源用户的成员关系
+func (r *accountMergeRepository) MergeConversationMemberships(tx *gorm.DB, fromUserID, toUserID int64) error {
+	if tx == nil {
+		return errors.New("MergeConversationMemberships must be called within a transaction")
+	}
+
+	var shared []string
+	if err := tx.Model(&model.OrbiaConversationMember{}).
+		Where("user_id = ?", toUserID).
+		Pluck("conversation_id", &shared).Error; err != nil {
+		return err
+	}
+	if len(shared) > 0 {
+		if err := tx.Where("user_id = ? AND conversation_id IN ?", fromUserID, shared).
+			Delete(&model.OrbiaConversationMember{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(&model.OrbiaConversationMember{}).
+		Where("user_id = ?", fromUserID).
+		Update("user_id", toUserID).Error
+}
+
+// ReassignKol 将 KOL 资料转移到目标用户
+func (r *accountMergeRepository) ReassignKol(tx *gorm.DB, kolID, toUserID int64) error {
+	if tx == nil {
+		return errors.New("ReassignKol must be called within a transaction")
+	}
+
+	return tx.Unscoped().Model(&Kol{}).Where("id = ?", kolID).Update("user_id", toUserID).Error
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"orbia_api/biz/utils/money"
 )
 
 // AdOrder 广告订单模型
@@ -14,7 +16,7 @@ type AdOrder struct {
 	TeamID         *int64         `gorm:"column:team_id" json:"team_id"`
 	Title          string         `gorm:"column:title;size:200;not null" json:"title"`
 	Description    string         `gorm:"column:description;type:text;not null" json:"description"`
-	Budget         float64        `gorm:"column:budget;type:decimal(12,2);not null" json:"budget"`
+	Budget         money.Amount   `gorm:"column:budget;type:decimal(12,2);not null" json:"budget"`
 	AdType         string         `gorm:"column:ad_type;size:50;not null" json:"ad_type"`
 	TargetAudience string         `gorm:"column:target_audience;size:500;not null" json:"target_audience"`
 	StartDate      string         `gorm:"column:start_date;type:date;not null" json:"start_date"`
@@ -0,0 +1,144 @@
+package mysql
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TeamAPIKey 团队API Key模型（只保存密钥哈希）
+type TeamAPIKey struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	TeamID     int64      `gorm:"column:team_id;not null;index" json:"team_id"`
+	CreatorID  int64      `gorm:"column:creator_id;not null;index" json:"creator_id"`
+	Name       string     `gorm:"column:name;size:50;not null" json:"name"`
+	KeyPrefix  string     `gorm:"column:key_prefix;size:20;not null" json:"key_prefix"`
+	KeyHash    string     `gorm:"column:key_hash;size:64;not null;uniqueIndex" json:"-"`
+	Scopes     string     `gorm:"column:scopes;size:255;not null" json:"scopes"` // 逗号分隔
+	Status     string     `gorm:"column:status;type:enum('active','revoked');not null;default:'active'" json:"status"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null" json:"expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at" json:"last_used_at"`
+	LastUsedIP *string    `gorm:"column:last_used_ip;size:45" json:"last_used_ip"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at" json:"revoked_at"`
+	RevokedBy  *int64     `gorm:"column:revoked_by" json:"revoked_by"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (TeamAPIKey) TableName() string {
+	return "orbia_team_api_key"
+}
+
+// ScopeList 返回授权范围列表
+func (k *TeamAPIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+// HasScope 检查API Key是否拥有指定授权范围
+func (k *TeamAPIKey) HasScope(scope string) bool {
+	for _, s := range k.ScopeList() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUsable 检查API Key是否可用（未吊销且未过期）
+func (k *TeamAPIKey) IsUsable(now time.Time) bool {
+	return k.Status == "active" && now.Before(k.ExpiresAt)
+}
+
+// TeamAPIKeyRepository 团队API Key仓储接口
+type TeamAPIKeyRepository interface {
+	CreateAPIKey(key *TeamAPIKey) error
+	GetAPIKeyByID(id int64) (*TeamAPIKey, error)
+	GetAPIKeyByHash(keyHash string) (*TeamAPIKey, error)
+	ListTeamAPIKeys(teamID int64) ([]*TeamAPIKey, error)
+	CountActiveAPIKeys(teamID int64) (int64, error)
+	RevokeAPIKey(id, revokedBy int64) (bool, error)
+	TouchAPIKey(id int64, ip string, now time.Time, interval time.Duration) error
+}
+
+// teamAPIKeyRepository 团队API Key仓储实现
+type teamAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewTeamAPIKeyRepository 创建团队API Key仓储实例
+func NewTeamAPIKeyRepository(db *gorm.DB) TeamAPIKeyRepository {
+	return &teamAPIKeyRepository{db: db}
+}
+
+// CreateAPIKey 创建API Key
+func (r *teamAPIKeyRepository) CreateAPIKey(key *TeamAPIKey) error {
+	return r.db.Create(key).Error
+}
+
+// GetAPIKeyByID 根据ID获取API Key
+func (r *teamAPIKeyRepository) GetAPIKeyByID(id int64) (*TeamAPIKey, error) {
+	var key TeamAPIKey
+	err := r.db.Where("id = ?", id).First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// GetAPIKeyByHash 根据密钥哈希获取API Key
+func (r *teamAPIKeyRepository) GetAPIKeyByHash(keyHash string) (*TeamAPIKey, error) {
+	var key TeamAPIKey
+	err := r.db.Where("key_hash = ?", keyHash).First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// ListTeamAPIKeys 获取团队的API Key列表（按创建时间倒序）
+func (r *teamAPIKeyRepository) ListTeamAPIKeys(teamID int64) ([]*TeamAPIKey, error) {
+	var keys []*TeamAPIKey
+	err := r.db.Where("team_id = ?", teamID).Order("id DESC").Find(&keys).Error
+	return keys, err
+}
+
+// CountActiveAPIKeys 统计团队未吊销且未过期的API Key数量
+func (r *teamAPIKeyRepository) CountActiveAPIKeys(teamID int64) (int64, error) {
+	var count int64
+	err := r.db.Model(&TeamAPIKey{}).
+		Where("team_id = ? AND status = ? AND expires_at > ?", teamID, "active", time.Now()).
+		Count(&count).Error
+	return count, err
+}
+
+// RevokeAPIKey 吊销API Key，返回 false 表示API Key已被吊销
+func (r *teamAPIKeyRepository) RevokeAPIKey(id, revokedBy int64) (bool, error) {
+	now := time.Now()
+	result := r.db.Model(&TeamAPIKey{}).
+		Where("id = ? AND status = ?", id, "active").
+		Updates(map[string]interface{}{
+			"status":     "revoked",
+			"revoked_at": &now,
+			"revoked_by": revokedBy,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TouchAPIKey 记录API Key最后使用时间和IP
+// 距上次记录不足 interval 时不更新，避免每个请求都写库
+func (r *teamAPIKeyRepository) TouchAPIKey(id int64, ip string, now time.Time, interval time.Duration) error {
+	return r.db.Model(&TeamAPIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, now.Add(-interval)).
+		Updates(map[string]interface{}{
+			"last_used_at": now,
+			"last_used_ip": ip,
+		}).Error
+}
@@ -0,0 +1,107 @@
+package mysql
+
+import (
+	"fmt"
+	"time"
+
+	"orbia_api/biz/dal/model"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogFilter 审计日志查询条件，零值字段不参与过滤
+type AuditLogFilter struct {
+	ActorID    int64
+	Action     string
+	TargetType string
+	TargetID   string
+	RequestID  string
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
+
+// AuditLogRepository 审计日志仓库接口
+// 审计日志只允许追加，不提供修改和删除方法
+type AuditLogRepository interface {
+	CreateAuditLog(tx *gorm.DB, log *model.OrbiaAdminAuditLog) error
+	GetAuditLogs(filter *AuditLogFilter, page, pageSize int) ([]*model.OrbiaAdminAuditLog, int64, error)
+	GetAuditLogsForExport(filter *AuditLogFilter, limit int) ([]*model.OrbiaAdminAuditLog, error)
+}
+
+// auditLogRepository 审计日志仓库实现
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository 创建审计日志仓库实例
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+// CreateAuditLog 写入审计日志，传入事务时与业务修改一起提交
+func (r *auditLogRepository) CreateAuditLog(tx *gorm.DB, log *model.OrbiaAdminAuditLog) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(log).Error
+}
+
+// GetAuditLogs 分页查询审计日志，按时间倒序
+func (r *auditLogRepository) GetAuditLogs(filter *AuditLogFilter, page, pageSize int) ([]*model.OrbiaAdminAuditLog, int64, error) {
+	var logs []*model.OrbiaAdminAuditLog
+	var total int64
+
+	query := r.filterQuery(filter)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %v", err)
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("id DESC").Limit(pageSize).Offset(offset).Find(&logs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to query audit logs: %v", err)
+	}
+
+	return logs, total, nil
+}
+
+// GetAuditLogsForExport 查询导出用的审计日志，最多返回 limit 条
+func (r *auditLogRepository) GetAuditLogsForExport(filter *AuditLogFilter, limit int) ([]*model.OrbiaAdminAuditLog, error) {
+	var logs []*model.OrbiaAdminAuditLog
+	if err := r.filterQuery(filter).Order("id DESC").Limit(limit).Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("failed to query audit logs: %v", err)
+	}
+	return logs, nil
+}
+
+// filterQuery 根据查询条件构建查询
+func (r *auditLogRepository) filterQuery(filter *AuditLogFilter) *gorm.DB {
+	query := r.db.Model(&model.OrbiaAdminAuditLog{})
+	if filter == nil {
+		return query
+	}
+
+	if filter.ActorID > 0 {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.StartTime != nil {
+		query = query.Where("created_at >= ?", *filter.StartTime)
+	}
+	if filter.EndTime != nil {
+		query = query.Where("created_at <= ?", *filter.EndTime)
+	}
+
+	return query
+}
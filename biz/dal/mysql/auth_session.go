@@ -0,0 +1,247 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AuthSession 登录会话模型（一个刷新令牌家族）
+type AuthSession struct {
+	ID              int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	SessionID       string     `gorm:"column:session_id;size:64;not null;uniqueIndex" json:"session_id"`
+	UserID          int64      `gorm:"column:user_id;not null" json:"user_id"`
+	Status          string     `gorm:"column:status;type:enum('active','revoked');not null;default:'active'" json:"status"`
+	RevokeReason    *string    `gorm:"column:revoke_reason;size:50" json:"revoke_reason"`
+	RevokedAt       *time.Time `gorm:"column:revoked_at" json:"revoked_at"`
+	LastRefreshedAt *time.Time `gorm:"column:last_refreshed_at" json:"last_refreshed_at"`
+	MFAVerifiedAt   *time.Time `gorm:"column:mfa_verified_at" json:"mfa_verified_at"` // 两步验证通过时间，为空表示未通过两步验证
+	DeviceHash      *string    `gorm:"column:device_hash;size:64" json:"-"`
+	DeviceName      *string    `gorm:"column:device_name;size:100" json:"device_name"`
+	UserAgent       *string    `gorm:"column:user_agent;size:500" json:"user_agent"`
+	IP              *string    `gorm:"column:ip;size:45" json:"ip"`
+	LastSeenAt      *time.Time `gorm:"column:last_seen_at" json:"last_seen_at"`
+	LastSeenIP      *string    `gorm:"column:last_seen_ip;size:45" json:"last_seen_ip"`
+	CreatedAt       time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (AuthSession) TableName() string {
+	return "orbia_auth_session"
+}
+
+// RefreshToken 刷新令牌模型（只保存令牌哈希）
+type RefreshToken struct {
+	ID        int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	TokenHash string     `gorm:"column:token_hash;size:64;not null;uniqueIndex" json:"token_hash"`
+	SessionID string     `gorm:"column:session_id;size:64;not null" json:"session_id"`
+	UserID    int64      `gorm:"column:user_id;not null" json:"user_id"`
+	Status    string     `gorm:"column:status;type:enum('active','rotated','revoked');not null;default:'active'" json:"status"`
+	ExpiresAt time.Time  `gorm:"column:expires_at;not null" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"column:used_at" json:"used_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (RefreshToken) TableName() string {
+	return "orbia_refresh_token"
+}
+
+// AuthSessionRepository 登录会话仓储接口
+type AuthSessionRepository interface {
+	CreateSession(tx *gorm.DB, session *AuthSession) error
+	GetSessionBySessionID(sessionID string) (*AuthSession, error)
+	TouchSession(tx *gorm.DB, sessionID string) error
+	MarkSessionMFAVerified(tx *gorm.DB, sessionID string) error
+	TouchSessionLastSeen(sessionID, ip string, now time.Time, interval time.Duration) error
+	ListActiveUserSessions(userID int64) ([]*AuthSession, error)
+	HasUserSessions(userID int64) (bool, error)
+	HasUserDeviceSession(userID int64, deviceHash string) (bool, error)
+	RevokeSession(tx *gorm.DB, sessionID, reason string) error
+	RevokeUserSessions(tx *gorm.DB, userID int64, reason string) error
+	CreateRefreshToken(tx *gorm.DB, token *RefreshToken) error
+	GetRefreshTokenByHashForUpdate(tx *gorm.DB, tokenHash string) (*RefreshToken, error)
+	MarkRefreshTokenRotated(tx *gorm.DB, id int64) error
+}
+
+// authSessionRepository 登录会话仓储实现
+type authSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewAuthSessionRepository 创建登录会话仓储实例
+func NewAuthSessionRepository(db *gorm.DB) AuthSessionRepository {
+	return &authSessionRepository{db: db}
+}
+
+// CreateSession 创建会话
+func (r *authSessionRepository) CreateSession(tx *gorm.DB, session *AuthSession) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Create(session).Error
+}
+
+// GetSessionBySessionID 根据会话ID获取会话
+func (r *authSessionRepository) GetSessionBySessionID(sessionID string) (*AuthSession, error) {
+	var session AuthSession
+	err := r.db.Where("session_id = ?", sessionID).First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// TouchSession 更新会话的最后刷新时间
+func (r *authSessionRepository) TouchSession(tx *gorm.DB, sessionID string) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Model(&AuthSession{}).
+		Where("session_id = ?", sessionID).
+		Update("last_refreshed_at", time.Now()).Error
+}
+
+// MarkSessionMFAVerified 将会话标记为已通过两步验证
+func (r *authSessionRepository) MarkSessionMFAVerified(tx *gorm.DB, sessionID string) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Model(&AuthSession{}).
+		Where("session_id = ?", sessionID).
+		Update("mfa_verified_at", time.Now()).Error
+}
+
+// TouchSessionLastSeen 记录会话最后活跃时间和IP
+// 距上次记录不足 interval 时不更新，避免每个请求都写库
+func (r *authSessionRepository) TouchSessionLastSeen(sessionID, ip string, now time.Time, interval time.Duration) error {
+	return r.db.Model(&AuthSession{}).
+		Where("session_id = ? AND (last_seen_at IS NULL OR last_seen_at < ?)", sessionID, now.Add(-interval)).
+		Updates(map[string]interface{}{
+			"last_seen_at": now,
+			"last_seen_ip": ip,
+		}).Error
+}
+
+// ListActiveUserSessions 获取用户所有有效的会话（按创建时间倒序）
+func (r *authSessionRepository) ListActiveUserSessions(userID int64) ([]*AuthSession, error) {
+	var sessions []*AuthSession
+	err := r.db.Where("user_id = ? AND status = ?", userID, "active").
+		Order("id DESC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// HasUserSessions 用户是否登录过（包括已吊销的会话）
+func (r *authSessionRepository) HasUserSessions(userID int64) (bool, error) {
+	var count int64
+	err := r.db.Model(&AuthSession{}).
+		Where("user_id = ?", userID).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// HasUserDeviceSession 用户是否曾在该设备上登录过（包括已吊销的会话）
+func (r *authSessionRepository) HasUserDeviceSession(userID int64, deviceHash string) (bool, error) {
+	var count int64
+	err := r.db.Model(&AuthSession{}).
+		Where("user_id = ? AND device_hash = ?", userID, deviceHash).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// RevokeSession 吊销会话及其所有未使用的刷新令牌
+func (r *authSessionRepository) RevokeSession(tx *gorm.DB, sessionID, reason string) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	now := time.Now()
+	if err := db.Model(&AuthSession{}).
+		Where("session_id = ? AND status = ?", sessionID, "active").
+		Updates(map[string]interface{}{
+			"status":        "revoked",
+			"revoke_reason": reason,
+			"revoked_at":    &now,
+		}).Error; err != nil {
+		return err
+	}
+
+	return db.Model(&RefreshToken{}).
+		Where("session_id = ? AND status = ?", sessionID, "active").
+		Update("status", "revoked").Error
+}
+
+// RevokeUserSessions 吊销用户的所有会话及刷新令牌
+func (r *authSessionRepository) RevokeUserSessions(tx *gorm.DB, userID int64, reason string) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	now := time.Now()
+	if err := db.Model(&AuthSession{}).
+		Where("user_id = ? AND status = ?", userID, "active").
+		Updates(map[string]interface{}{
+			"status":        "revoked",
+			"revoke_reason": reason,
+			"revoked_at":    &now,
+		}).Error; err != nil {
+		return err
+	}
+
+	return db.Model(&RefreshToken{}).
+		Where("user_id = ? AND status = ?", userID, "active").
+		Update("status", "revoked").Error
+}
+
+// CreateRefreshToken 创建刷新令牌
+func (r *authSessionRepository) CreateRefreshToken(tx *gorm.DB, token *RefreshToken) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Create(token).Error
+}
+
+// GetRefreshTokenByHashForUpdate 根据令牌哈希获取刷新令牌并加行锁（必须在事务中调用）
+func (r *authSessionRepository) GetRefreshTokenByHashForUpdate(tx *gorm.DB, tokenHash string) (*RefreshToken, error) {
+	if tx == nil {
+		return nil, errors.New("GetRefreshTokenByHashForUpdate must be called within a transaction")
+	}
+
+	var token RefreshToken
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkRefreshTokenRotated 将刷新令牌标记为已轮换
+func (r *authSessionRepository) MarkRefreshTokenRotated(tx *gorm.DB, id int64) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	now := time.Now()
+	return db.Model(&RefreshToken{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":  "rotated",
+			"used_at": &now,
+		}).Error
+}
@@ -1,9 +1,13 @@
 package mysql
 
 import (
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"orbia_api/biz/utils/money"
 )
 
 // Campaign 广告活动模型
@@ -36,7 +40,7 @@ type Campaign struct {
 	FrequencyCapTimes  *int32         `gorm:"column:frequency_cap_times" json:"frequency_cap_times"`
 	FrequencyCapDays   *int32         `gorm:"column:frequency_cap_days" json:"frequency_cap_days"`
 	BudgetType         int8           `gorm:"column:budget_type;not null" json:"budget_type"`
-	BudgetAmount       float64        `gorm:"column:budget_amount;type:decimal(15,2);not null" json:"budget_amount"`
+	BudgetAmount       money.Amount   `gorm:"column:budget_amount;type:decimal(15,2);not null" json:"budget_amount"`
 	Website            *string        `gorm:"column:website;size:1000" json:"website"`
 	IOSDownloadURL     *string        `gorm:"column:ios_download_url;size:1000" json:"ios_download_url"`
 	AndroidDownloadURL *string        `gorm:"column:android_download_url;size:1000" json:"android_download_url"`
@@ -76,6 +80,8 @@ type CampaignRepository interface {
 	GetCampaignByID(id int64) (*Campaign, error)
 	GetCampaignByCampaignID(campaignID string) (*Campaign, error)
 	UpdateCampaign(campaign *Campaign) error
+	GetCampaignByCampaignIDForUpdate(tx *gorm.DB, campaignID string) (*Campaign, error)
+	UpdateCampaignWithTx(tx *gorm.DB, campaign *Campaign) error
 	DeleteCampaign(id int64) error
 
 	// Campaign查询
@@ -126,7 +132,31 @@ func (r *campaignRepository) GetCampaignByCampaignID(campaignID string) (*Campai
 
 // UpdateCampaign 更新Campaign
 func (r *campaignRepository) UpdateCampaign(campaign *Campaign) error {
-	return r.db.Save(campaign).Error
+	return r.UpdateCampaignWithTx(nil, campaign)
+}
+
+// GetCampaignByCampaignIDForUpdate 根据业务ID获取Campaign并加行锁（必须在事务中调用）
+func (r *campaignRepository) GetCampaignByCampaignIDForUpdate(tx *gorm.DB, campaignID string) (*Campaign, error) {
+	if tx == nil {
+		return nil, errors.New("GetCampaignByCampaignIDForUpdate must be called within a transaction")
+	}
+
+	var campaign Campaign
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("campaign_id = ?", campaignID).
+		First(&campaign).Error
+	if err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// UpdateCampaignWithTx 更新Campaign（在事务中执行）
+func (r *campaignRepository) UpdateCampaignWithTx(tx *gorm.DB, campaign *Campaign) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(campaign).Error
 }
 
 // DeleteCampaign 删除Campaign（软删除）
@@ -0,0 +1,126 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+
+	"orbia_api/biz/dal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ChainDepositRepository 链上入账记录仓库接口
+type ChainDepositRepository interface {
+	GetCursor(network string) (uint64, error)
+	SaveCursor(network string, position uint64) error
+	CreateDepositIfAbsent(deposit *model.OrbiaChainDeposit) (bool, error)
+	GetDepositByIDForUpdate(tx *gorm.DB, id int64) (*model.OrbiaChainDeposit, error)
+	UpdateDepositWithTx(tx *gorm.DB, deposit *model.OrbiaChainDeposit) error
+	GetDeposits(status, network *string, page, pageSize int) ([]*model.OrbiaChainDeposit, int64, error)
+	ClaimTxHashWithTx(tx *gorm.DB, claim *model.OrbiaChainTxClaim) (bool, error)
+}
+
+// chainDepositRepository 链上入账记录仓库实现
+type chainDepositRepository struct {
+	db *gorm.DB
+}
+
+// NewChainDepositRepository 创建链上入账记录仓库实例
+func NewChainDepositRepository(db *gorm.DB) ChainDepositRepository {
+	return &chainDepositRepository{db: db}
+}
+
+// GetCursor 获取网络的扫描游标，尚未扫描过时返回 0
+func (r *chainDepositRepository) GetCursor(network string) (uint64, error) {
+	var cursor model.OrbiaChainCursor
+	err := r.db.Where("network = ?", network).First(&cursor).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return cursor.Position, nil
+}
+
+// SaveCursor 保存网络的扫描游标
+func (r *chainDepositRepository) SaveCursor(network string, position uint64) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "network"}},
+		DoUpdates: clause.AssignmentColumns([]string{"position"}),
+	}).Create(&model.OrbiaChainCursor{Network: network, Position: position}).Error
+}
+
+// CreateDepositIfAbsent 保存扫描到的入账记录，同一笔转账 (network, tx_hash, log_index) 已存在时不插入并返回 false
+func (r *chainDepositRepository) CreateDepositIfAbsent(deposit *model.OrbiaChainDeposit) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(deposit)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ClaimTxHashWithTx 登记入账使用的链上交易（在入账事务中执行）
+// 同一网络的交易哈希已被登记时不插入并返回 false；并发登记时后到的事务等待先到的事务提交后返回 false
+func (r *chainDepositRepository) ClaimTxHashWithTx(tx *gorm.DB, claim *model.OrbiaChainTxClaim) (bool, error) {
+	if tx == nil {
+		tx = r.db
+	}
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(claim)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetDepositByIDForUpdate 根据ID获取入账记录并加行锁（在事务中执行）
+func (r *chainDepositRepository) GetDepositByIDForUpdate(tx *gorm.DB, id int64) (*model.OrbiaChainDeposit, error) {
+	if tx == nil {
+		return nil, errors.New("GetDepositByIDForUpdate must be called within a transaction")
+	}
+
+	var deposit model.OrbiaChainDeposit
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&deposit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+// UpdateDepositWithTx 更新入账记录（在事务中执行）
+func (r *chainDepositRepository) UpdateDepositWithTx(tx *gorm.DB, deposit *model.OrbiaChainDeposit) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(deposit).Error
+}
+
+// GetDeposits 获取入账记录列表（管理员审核队列）
+func (r *chainDepositRepository) GetDeposits(status, network *string, page, pageSize int) ([]*model.OrbiaChainDeposit, int64, error) {
+	var deposits []*model.OrbiaChainDeposit
+	var total int64
+
+	query := r.db.Model(&model.OrbiaChainDeposit{})
+
+	if status != nil && *status != "" {
+		query = query.Where("status = ?", *status)
+	}
+
+	if network != nil && *network != "" {
+		query = query.Where("network = ?", *network)
+	}
+
+	// 获取总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count chain deposits: %v", err)
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC, id DESC").Limit(pageSize).Offset(offset).Find(&deposits).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to query chain deposits: %v", err)
+	}
+
+	return deposits, total, nil
+}
@@ -11,11 +11,11 @@ import (
 // ExcellentCaseRepository 优秀广告案例仓储接口
 type ExcellentCaseRepository interface {
 	// 创建优秀案例
-	CreateExcellentCase(excellentCase *model.OrbiaExcellentCase) error
+	CreateExcellentCaseWithTx(tx *gorm.DB, excellentCase *model.OrbiaExcellentCase) error
 	// 更新优秀案例
-	UpdateExcellentCase(excellentCase *model.OrbiaExcellentCase) error
+	UpdateExcellentCaseWithTx(tx *gorm.DB, excellentCase *model.OrbiaExcellentCase) error
 	// 删除优秀案例（软删除）
-	DeleteExcellentCase(id int64) error
+	DeleteExcellentCaseWithTx(tx *gorm.DB, id int64) error
 	// 根据ID获取优秀案例
 	GetExcellentCaseByID(id int64) (*model.OrbiaExcellentCase, error)
 	// 获取优秀案例列表
@@ -34,19 +34,28 @@ func NewExcellentCaseRepository(db *gorm.DB) ExcellentCaseRepository {
 	return &excellentCaseRepository{db: db}
 }
 
-// CreateExcellentCase 创建优秀案例
-func (r *excellentCaseRepository) CreateExcellentCase(excellentCase *model.OrbiaExcellentCase) error {
-	return r.db.Create(excellentCase).Error
+// CreateExcellentCaseWithTx 创建优秀案例（在事务中执行）
+func (r *excellentCaseRepository) CreateExcellentCaseWithTx(tx *gorm.DB, excellentCase *model.OrbiaExcellentCase) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(excellentCase).Error
 }
 
-// UpdateExcellentCase 更新优秀案例
-func (r *excellentCaseRepository) UpdateExcellentCase(excellentCase *model.OrbiaExcellentCase) error {
-	return r.db.Save(excellentCase).Error
+// UpdateExcellentCaseWithTx 更新优秀案例（在事务中执行）
+func (r *excellentCaseRepository) UpdateExcellentCaseWithTx(tx *gorm.DB, excellentCase *model.OrbiaExcellentCase) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(excellentCase).Error
 }
 
-// DeleteExcellentCase 删除优秀案例（软删除）
-func (r *excellentCaseRepository) DeleteExcellentCase(id int64) error {
-	return r.db.Delete(&model.OrbiaExcellentCase{}, id).Error
+// DeleteExcellentCaseWithTx 删除优秀案例（软删除，在事务中执行）
+func (r *excellentCaseRepository) DeleteExcellentCaseWithTx(tx *gorm.DB, id int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Delete(&model.OrbiaExcellentCase{}, id).Error
 }
 
 // GetExcellentCaseByID 根据ID获取优秀案例
@@ -99,11 +108,11 @@ func (r *excellentCaseRepository) GetEnabledExcellentCases() ([]*model.OrbiaExce
 // ContentTrendRepository 内容趋势仓储接口
 type ContentTrendRepository interface {
 	// 创建内容趋势
-	CreateContentTrend(trend *model.OrbiaContentTrend) error
+	CreateContentTrendWithTx(tx *gorm.DB, trend *model.OrbiaContentTrend) error
 	// 更新内容趋势
-	UpdateContentTrend(trend *model.OrbiaContentTrend) error
+	UpdateContentTrendWithTx(tx *gorm.DB, trend *model.OrbiaContentTrend) error
 	// 删除内容趋势（软删除）
-	DeleteContentTrend(id int64) error
+	DeleteContentTrendWithTx(tx *gorm.DB, id int64) error
 	// 根据ID获取内容趋势
 	GetContentTrendByID(id int64) (*model.OrbiaContentTrend, error)
 	// 获取内容趋势列表
@@ -124,19 +133,28 @@ func NewContentTrendRepository(db *gorm.DB) ContentTrendRepository {
 	return &contentTrendRepository{db: db}
 }
 
-// CreateContentTrend 创建内容趋势
-func (r *contentTrendRepository) CreateContentTrend(trend *model.OrbiaContentTrend) error {
-	return r.db.Create(trend).Error
+// CreateContentTrendWithTx 创建内容趋势（在事务中执行）
+func (r *contentTrendRepository) CreateContentTrendWithTx(tx *gorm.DB, trend *model.OrbiaContentTrend) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(trend).Error
 }
 
-// UpdateContentTrend 更新内容趋势
-func (r *contentTrendRepository) UpdateContentTrend(trend *model.OrbiaContentTrend) error {
-	return r.db.Save(trend).Error
+// UpdateContentTrendWithTx 更新内容趋势（在事务中执行）
+func (r *contentTrendRepository) UpdateContentTrendWithTx(tx *gorm.DB, trend *model.OrbiaContentTrend) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(trend).Error
 }
 
-// DeleteContentTrend 删除内容趋势（软删除）
-func (r *contentTrendRepository) DeleteContentTrend(id int64) error {
-	return r.db.Delete(&model.OrbiaContentTrend{}, id).Error
+// DeleteContentTrendWithTx 删除内容趋势（软删除，在事务中执行）
+func (r *contentTrendRepository) DeleteContentTrendWithTx(tx *gorm.DB, id int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Delete(&model.OrbiaContentTrend{}, id).Error
 }
 
 // GetContentTrendByID 根据ID获取内容趋势
@@ -202,7 +220,7 @@ type PlatformStatsRepository interface {
 	// 获取平台数据统计（只有一行数据）
 	GetPlatformStats() (*model.OrbiaPlatformStat, error)
 	// 更新平台数据统计
-	UpdatePlatformStats(stats *model.OrbiaPlatformStat) error
+	UpdatePlatformStatsWithTx(tx *gorm.DB, stats *model.OrbiaPlatformStat) error
 	// 创建平台数据统计（首次创建）
 	CreatePlatformStats(stats *model.OrbiaPlatformStat) error
 	// 获取或创建平台数据统计
@@ -229,9 +247,12 @@ func (r *platformStatsRepository) GetPlatformStats() (*model.OrbiaPlatformStat,
 	return &stats, nil
 }
 
-// UpdatePlatformStats 更新平台数据统计
-func (r *platformStatsRepository) UpdatePlatformStats(stats *model.OrbiaPlatformStat) error {
-	return r.db.Save(stats).Error
+// UpdatePlatformStatsWithTx 更新平台数据统计（在事务中执行）
+func (r *platformStatsRepository) UpdatePlatformStatsWithTx(tx *gorm.DB, stats *model.OrbiaPlatformStat) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(stats).Error
 }
 
 // CreatePlatformStats 创建平台数据统计（首次创建）
@@ -11,9 +11,9 @@ import (
 // DictionaryRepository 字典仓储接口
 type DictionaryRepository interface {
 	// 字典管理
-	CreateDictionary(dictionary *model.OrbiaDictionary) error
-	UpdateDictionary(dictionary *model.OrbiaDictionary) error
-	DeleteDictionary(id int64) error
+	CreateDictionaryWithTx(tx *gorm.DB, dictionary *model.OrbiaDictionary) error
+	UpdateDictionaryWithTx(tx *gorm.DB, dictionary *model.OrbiaDictionary) error
+	DeleteDictionaryWithTx(tx *gorm.DB, id int64) error
 	GetDictionaryByID(id int64) (*model.OrbiaDictionary, error)
 	GetDictionaryByCode(code string) (*model.OrbiaDictionary, error)
 	GetDictionaries(keyword string, status *int32, offset int, limit int) ([]*model.OrbiaDictionary, int64, error)
@@ -24,10 +24,10 @@ type DictionaryRepository interface {
 // DictionaryItemRepository 字典项仓储接口
 type DictionaryItemRepository interface {
 	// 字典项管理
-	CreateDictionaryItem(item *model.OrbiaDictionaryItem) error
-	UpdateDictionaryItem(item *model.OrbiaDictionaryItem) error
-	DeleteDictionaryItem(id int64) error
-	DeleteDictionaryItemsByDictionaryID(dictionaryID int64) error
+	CreateDictionaryItemWithTx(tx *gorm.DB, item *model.OrbiaDictionaryItem) error
+	UpdateDictionaryItemWithTx(tx *gorm.DB, item *model.OrbiaDictionaryItem) error
+	DeleteDictionaryItemWithTx(tx *gorm.DB, id int64) error
+	DeleteDictionaryItemsByDictionaryIDWithTx(tx *gorm.DB, dictionaryID int64) error
 	GetDictionaryItemByID(id int64) (*model.OrbiaDictionaryItem, error)
 	GetDictionaryItems(dictionaryID int64, parentID *int64, status *int32, offset int, limit int) ([]*model.OrbiaDictionaryItem, int64, error)
 	GetDictionaryItemsByDictionaryCode(dictionaryCode string, onlyEnabled bool) ([]*model.OrbiaDictionaryItem, error)
@@ -57,19 +57,28 @@ func NewDictionaryItemRepository(db *gorm.DB) DictionaryItemRepository {
 
 // ==================== 字典管理实现 ====================
 
-// CreateDictionary 创建字典
-func (r *dictionaryRepository) CreateDictionary(dictionary *model.OrbiaDictionary) error {
-	return r.db.Create(dictionary).Error
+// CreateDictionaryWithTx 创建字典（在事务中执行）
+func (r *dictionaryRepository) CreateDictionaryWithTx(tx *gorm.DB, dictionary *model.OrbiaDictionary) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(dictionary).Error
 }
 
-// UpdateDictionary 更新字典
-func (r *dictionaryRepository) UpdateDictionary(dictionary *model.OrbiaDictionary) error {
-	return r.db.Save(dictionary).Error
+// UpdateDictionaryWithTx 更新字典（在事务中执行）
+func (r *dictionaryRepository) UpdateDictionaryWithTx(tx *gorm.DB, dictionary *model.OrbiaDictionary) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(dictionary).Error
 }
 
-// DeleteDictionary 删除字典（软删除）
-func (r *dictionaryRepository) DeleteDictionary(id int64) error {
-	return r.db.Delete(&model.OrbiaDictionary{}, id).Error
+// DeleteDictionaryWithTx 删除字典（软删除，在事务中执行）
+func (r *dictionaryRepository) DeleteDictionaryWithTx(tx *gorm.DB, id int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Delete(&model.OrbiaDictionary{}, id).Error
 }
 
 // GetDictionaryByID 根据ID获取字典
@@ -148,24 +157,36 @@ func (r *dictionaryRepository) CheckDictionaryCodeExists(code string, excludeID
 
 // ==================== 字典项管理实现 ====================
 
-// CreateDictionaryItem 创建字典项
-func (r *dictionaryItemRepository) CreateDictionaryItem(item *model.OrbiaDictionaryItem) error {
-	return r.db.Create(item).Error
+// CreateDictionaryItemWithTx 创建字典项（在事务中执行）
+func (r *dictionaryItemRepository) CreateDictionaryItemWithTx(tx *gorm.DB, item *model.OrbiaDictionaryItem) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(item).Error
 }
 
-// UpdateDictionaryItem 更新字典项
-func (r *dictionaryItemRepository) UpdateDictionaryItem(item *model.OrbiaDictionaryItem) error {
-	return r.db.Save(item).Error
+// UpdateDictionaryItemWithTx 更新字典项（在事务中执行）
+func (r *dictionaryItemRepository) UpdateDictionaryItemWithTx(tx *gorm.DB, item *model.OrbiaDictionaryItem) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(item).Error
 }
 
-// DeleteDictionaryItem 删除字典项（软删除）
-func (r *dictionaryItemRepository) DeleteDictionaryItem(id int64) error {
-	return r.db.Delete(&model.OrbiaDictionaryItem{}, id).Error
+// DeleteDictionaryItemWithTx 删除字典项（软删除，在事务中执行）
+func (r *dictionaryItemRepository) DeleteDictionaryItemWithTx(tx *gorm.DB, id int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Delete(&model.OrbiaDictionaryItem{}, id).Error
 }
 
-// DeleteDictionaryItemsByDictionaryID 删除字典下的所有字典项
-func (r *dictionaryItemRepository) DeleteDictionaryItemsByDictionaryID(dictionaryID int64) error {
-	return r.db.Where("dictionary_id = ?", dictionaryID).Delete(&model.OrbiaDictionaryItem{}).Error
+// DeleteDictionaryItemsByDictionaryIDWithTx 删除字典下的所有字典项（在事务中执行）
+func (r *dictionaryItemRepository) DeleteDictionaryItemsByDictionaryIDWithTx(tx *gorm.DB, dictionaryID int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Where("dictionary_id = ?", dictionaryID).Delete(&model.OrbiaDictionaryItem{}).Error
 }
 
 // GetDictionaryItemByID 根据ID获取字典项
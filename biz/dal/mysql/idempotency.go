@@ -0,0 +1,62 @@
+package mysql
+
+import (
+	"orbia_api/biz/dal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyRepository 幂等键仓库接口
+type IdempotencyRepository interface {
+	CreateIfAbsent(record *model.OrbiaIdempotencyKey) (bool, error)
+	GetByKey(userID int64, path, key string) (*model.OrbiaIdempotencyKey, error)
+	Complete(id int64, responseStatus int32, responseBody string) error
+	Delete(id int64) error
+}
+
+// idempotencyRepository 幂等键仓库实现
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyRepository 创建幂等键仓库实例
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+// CreateIfAbsent 插入幂等键记录，已存在相同的 (user_id, path, idem_key) 时不插入并返回 false
+// 依赖唯一索引保证并发请求中只有一个能占用该幂等键
+func (r *idempotencyRepository) CreateIfAbsent(record *model.OrbiaIdempotencyKey) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetByKey 根据用户、路径和幂等键获取记录
+func (r *idempotencyRepository) GetByKey(userID int64, path, key string) (*model.OrbiaIdempotencyKey, error) {
+	var record model.OrbiaIdempotencyKey
+	err := r.db.Where("user_id = ? AND path = ? AND idem_key = ?", userID, path, key).First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Complete 保存首次响应并将记录标记为已完成
+func (r *idempotencyRepository) Complete(id int64, responseStatus int32, responseBody string) error {
+	return r.db.Model(&model.OrbiaIdempotencyKey{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          "completed",
+			"response_status": responseStatus,
+			"response_body":   responseBody,
+		}).Error
+}
+
+// Delete 删除幂等键记录（请求未成功处理或记录已过期时释放该幂等键）
+func (r *idempotencyRepository) Delete(id int64) error {
+	return r.db.Delete(&model.OrbiaIdempotencyKey{}, id).Error
+}
@@ -1,9 +1,13 @@
 package mysql
 
 import (
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"orbia_api/biz/utils/money"
 )
 
 // Kol KOL信息模型
@@ -60,17 +64,34 @@ func (KolTag) TableName() string {
 
 // KolStats KOL数据统计模型
 type KolStats struct {
-	ID                 int64     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
-	KolID              int64     `gorm:"uniqueIndex;column:kol_id;not null" json:"kol_id"`
-	TotalFollowers     int64     `gorm:"column:total_followers;default:0" json:"total_followers"`
-	TiktokFollowers    int64     `gorm:"column:tiktok_followers;default:0" json:"tiktok_followers"`
-	YoutubeSubscribers int64     `gorm:"column:youtube_subscribers;default:0" json:"youtube_subscribers"`
-	XFollowers         int64     `gorm:"column:x_followers;default:0" json:"x_followers"`
-	DiscordMembers     int64     `gorm:"column:discord_members;default:0" json:"discord_members"`
-	TiktokAvgViews     int64     `gorm:"column:tiktok_avg_views;default:0" json:"tiktok_avg_views"`
-	EngagementRate     float64   `gorm:"column:engagement_rate;type:decimal(10,2);default:0" json:"engagement_rate"`
-	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
-	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+	ID                 int64   `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	KolID              int64   `gorm:"uniqueIndex;column:kol_id;not null" json:"kol_id"`
+	TotalFollowers     int64   `gorm:"column:total_followers;default:0" json:"total_followers"`
+	TiktokFollowers    int64   `gorm:"column:tiktok_followers;default:0" json:"tiktok_followers"`
+	YoutubeSubscribers int64   `gorm:"column:youtube_subscribers;default:0" json:"youtube_subscribers"`
+	XFollowers         int64   `gorm:"column:x_followers;default:0" json:"x_followers"`
+	DiscordMembers     int64   `gorm:"column:discord_members;default:0" json:"discord_members"`
+	TiktokAvgViews     int64   `gorm:"column:tiktok_avg_views;default:0" json:"tiktok_avg_views"`
+	EngagementRate     float64 `gorm:"column:engagement_rate;type:decimal(10,2);default:0" json:"engagement_rate"`
+
+	// KOL手动填写的数据，平台接口核验失败或未配置时使用
+	ManualTotalFollowers     int64   `gorm:"column:manual_total_followers;default:0" json:"manual_total_followers"`
+	ManualTiktokFollowers    int64   `gorm:"column:manual_tiktok_followers;default:0" json:"manual_tiktok_followers"`
+	ManualYoutubeSubscribers int64   `gorm:"column:manual_youtube_subscribers;default:0" json:"manual_youtube_subscribers"`
+	ManualXFollowers         int64   `gorm:"column:manual_x_followers;default:0" json:"manual_x_followers"`
+	ManualDiscordMembers     int64   `gorm:"column:manual_discord_members;default:0" json:"manual_discord_members"`
+	ManualTiktokAvgViews     int64   `gorm:"column:manual_tiktok_avg_views;default:0" json:"manual_tiktok_avg_views"`
+	ManualEngagementRate     float64 `gorm:"column:manual_engagement_rate;type:decimal(10,2);default:0" json:"manual_engagement_rate"`
+
+	// 平台接口核验状态
+	Verified          bool       `gorm:"column:verified;not null;default:false" json:"verified"`       // 所有已填写主页的平台粉丝数均已核验
+	VerifiedPlatforms string     `gorm:"column:verified_platforms;size:100" json:"verified_platforms"` // 最近一次同步核验成功的平台，逗号分隔
+	VerifiedAt        *time.Time `gorm:"column:verified_at" json:"verified_at"`                        // 最近一次核验成功的时间
+	LastRefreshedAt   *time.Time `gorm:"column:last_refreshed_at" json:"last_refreshed_at"`            // 最近一次同步时间
+	RefreshError      *string    `gorm:"column:refresh_error;size:1000" json:"refresh_error"`          // 最近一次同步失败的平台及原因
+
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
 }
 
 // TableName 指定表名
@@ -80,15 +101,16 @@ func (KolStats) TableName() string {
 
 // KolPlan KOL报价Plan模型
 type KolPlan struct {
-	ID          int64          `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
-	KolID       int64          `gorm:"column:kol_id;not null" json:"kol_id"`
-	Title       string         `gorm:"column:title;size:200;not null" json:"title"`
-	Description *string        `gorm:"column:description;type:text" json:"description"`
-	Price       float64        `gorm:"column:price;type:decimal(10,2);not null" json:"price"`
-	PlanType    string         `gorm:"column:plan_type;type:enum('basic','standard','premium');not null" json:"plan_type"`
-	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time      `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+	ID           int64          `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	KolID        int64          `gorm:"column:kol_id;not null" json:"kol_id"`
+	Title        string         `gorm:"column:title;size:200;not null" json:"title"`
+	Description  *string        `gorm:"column:description;type:text" json:"description"`
+	Price        money.Amount   `gorm:"column:price;type:decimal(10,2);not null" json:"price"`
+	PlanType     string         `gorm:"column:plan_type;type:enum('basic','standard','premium');not null" json:"plan_type"`
+	MaxRevisions int32          `gorm:"column:max_revisions;not null" json:"max_revisions"` // 买家验收时最多可申请修改的次数
+	CreatedAt    time.Time      `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time      `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
 }
 
 // TableName 指定表名
@@ -119,8 +141,11 @@ type KolRepository interface {
 	GetKolByID(id int64) (*Kol, error)
 	GetKolByUserID(userID int64) (*Kol, error)
 	UpdateKol(kol *Kol) error
+	GetKolByIDForUpdate(tx *gorm.DB, id int64) (*Kol, error)
+	UpdateKolWithTx(tx *gorm.DB, kol *Kol) error
 	DeleteKol(id int64) error
-	GetKolList(status *string, country *string, tag *string, offset, limit int) ([]*Kol, int64, error)
+	SearchKols(filter *KolSearchFilter, offset, limit int) ([]*Kol, int64, error)
+	GetKolSearchFacets(filter *KolSearchFilter) (*KolSearchFacets, error)
 	// 管理员功能
 	GetAllKols(keyword string, status string, country string, tag string, offset int, limit int) ([]*Kol, int64, error)
 
@@ -128,16 +153,24 @@ type KolRepository interface {
 	CreateKolLanguage(language *KolLanguage) error
 	GetKolLanguages(kolID int64) ([]*KolLanguage, error)
 	DeleteKolLanguages(kolID int64) error
+	GetKolLanguagesByKolIDs(kolIDs []int64) (map[int64][]*KolLanguage, error)
 
 	// KOL标签
 	CreateKolTag(tag *KolTag) error
 	GetKolTags(kolID int64) ([]*KolTag, error)
 	DeleteKolTags(kolID int64) error
+	GetKolTagsByKolIDs(kolIDs []int64) (map[int64][]*KolTag, error)
 
 	// KOL统计数据
 	CreateKolStats(stats *KolStats) error
 	GetKolStats(kolID int64) (*KolStats, error)
 	UpdateKolStats(stats *KolStats) error
+	GetKolStatsByKolIDs(kolIDs []int64) (map[int64]*KolStats, error)
+	ListKolsForStatsRefresh(afterID int64, limit int) ([]*Kol, error)
+	CreateKolStatsHistory(records []*KolStatsHistory) error
+	UpsertKolStatsDaily(stats *KolStats, date time.Time) error
+	SnapshotAllKolStats(date time.Time) (int64, error)
+	GetKolStatsDaily(kolID int64, from time.Time) ([]*KolStatsDaily, error)
 
 	// KOL报价Plan
 	CreateKolPlan(plan *KolPlan) error
@@ -145,6 +178,7 @@ type KolRepository interface {
 	GetKolPlans(kolID int64) ([]*KolPlan, error)
 	UpdateKolPlan(plan *KolPlan) error
 	DeleteKolPlan(id int64) error
+	GetKolMinPlanPrices(kolIDs []int64) (map[int64]money.Amount, error)
 
 	// KOL视频
 	CreateKolVideo(video *KolVideo) error
@@ -152,6 +186,9 @@ type KolRepository interface {
 	GetKolVideos(kolID int64, offset, limit int) ([]*KolVideo, int64, error)
 	UpdateKolVideo(video *KolVideo) error
 	DeleteKolVideo(id int64) error
+
+	// KOL评分
+	GetKolRatingsByKolIDs(kolIDs []int64) (map[int64]*KolRating, error)
 }
 
 // kolRepository KOL仓储实现
@@ -191,49 +228,36 @@ func (r *kolRepository) GetKolByUserID(userID int64) (*Kol, error) {
 
 // UpdateKol 更新KOL信息
 func (r *kolRepository) UpdateKol(kol *Kol) error {
-	return r.db.Save(kol).Error
+	return r.UpdateKolWithTx(nil, kol)
 }
 
-// DeleteKol 删除KOL（软删除）
-func (r *kolRepository) DeleteKol(id int64) error {
-	return r.db.Delete(&Kol{}, id).Error
-}
-
-// GetKolList 获取KOL列表
-func (r *kolRepository) GetKolList(status *string, country *string, tag *string, offset, limit int) ([]*Kol, int64, error) {
-	var kols []*Kol
-	var total int64
-
-	query := r.db.Model(&Kol{})
-
-	// 如果status为空，默认只查询已审核通过的KOL
-	if status != nil && *status != "" {
-		query = query.Where("status = ?", *status)
-	} else {
-		query = query.Where("status = ?", "approved")
+// GetKolByIDForUpdate 根据ID获取KOL并加行锁（必须在事务中调用）
+func (r *kolRepository) GetKolByIDForUpdate(tx *gorm.DB, id int64) (*Kol, error) {
+	if tx == nil {
+		return nil, errors.New("GetKolByIDForUpdate must be called within a transaction")
 	}
 
-	if country != nil && *country != "" {
-		query = query.Where("country = ?", *country)
-	}
-
-	// 如果有标签过滤，需要join KolTag表
-	if tag != nil && *tag != "" {
-		query = query.Joins("INNER JOIN orbia_kol_tag ON orbia_kol.id = orbia_kol_tag.kol_id").
-			Where("orbia_kol_tag.tag = ?", *tag)
-	}
-
-	// 获取总数
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	var kol Kol
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&kol).Error
+	if err != nil {
+		return nil, err
 	}
+	return &kol, nil
+}
 
-	// 获取列表
-	if err := query.Offset(offset).Limit(limit).Find(&kols).Error; err != nil {
-		return nil, 0, err
+// UpdateKolWithTx 更新KOL信息（在事务中执行）
+func (r *kolRepository) UpdateKolWithTx(tx *gorm.DB, kol *Kol) error {
+	if tx == nil {
+		tx = r.db
 	}
+	return tx.Save(kol).Error
+}
 
-	return kols, total, nil
+// DeleteKol 删除KOL（软删除）
+func (r *kolRepository) DeleteKol(id int64) error {
+	return r.db.Delete(&Kol{}, id).Error
 }
 
 // CreateKolLanguage 创建KOL语言
@@ -0,0 +1,123 @@
+package mysql
+
+import (
+	"fmt"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/utils/money"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// KolEarningRepository KOL收益仓库接口
+type KolEarningRepository interface {
+	GetEarningByKolID(kolID int64) (*model.OrbiaKolEarning, error)
+	GetOrCreateEarningWithTx(tx *gorm.DB, kolID, userID int64) (*model.OrbiaKolEarning, error)
+	UpdateEarningTotals(tx *gorm.DB, kolID int64, earnedDelta, commissionDelta, withdrawnDelta money.Amount) error
+	CreateEarningRecord(tx *gorm.DB, record *model.OrbiaKolEarningRecord) error
+	GetIncomeRecordByOrderIDWithTx(tx *gorm.DB, kolID int64, orderID string) (*model.OrbiaKolEarningRecord, error)
+	GetEarningRecordsByKolID(kolID int64, recordType *string, page, pageSize int) ([]*model.OrbiaKolEarningRecord, int64, error)
+}
+
+// kolEarningRepository KOL收益仓库实现
+type kolEarningRepository struct {
+	db *gorm.DB
+}
+
+// NewKolEarningRepository 创建KOL收益仓库实例
+func NewKolEarningRepository(db *gorm.DB) KolEarningRepository {
+	return &kolEarningRepository{db: db}
+}
+
+// GetEarningByKolID 根据KOL ID获取收益账户
+func (r *kolEarningRepository) GetEarningByKolID(kolID int64) (*model.OrbiaKolEarning, error) {
+	var earning model.OrbiaKolEarning
+	err := r.db.Where("kol_id = ?", kolID).First(&earning).Error
+	if err != nil {
+		return nil, err
+	}
+	return &earning, nil
+}
+
+// GetOrCreateEarningWithTx 获取收益账户并加行锁，不存在时自动创建（在事务中执行）
+// 锁在事务结束时释放，保证同一KOL的并发变动按顺序执行，收益流水的前后余额准确
+func (r *kolEarningRepository) GetOrCreateEarningWithTx(tx *gorm.DB, kolID, userID int64) (*model.OrbiaKolEarning, error) {
+	if tx == nil {
+		tx = r.db
+	}
+
+	var earning model.OrbiaKolEarning
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("kol_id = ?", kolID).
+		Attrs(model.OrbiaKolEarning{UserID: userID}).
+		FirstOrCreate(&earning).Error
+	if err != nil {
+		return nil, err
+	}
+	return &earning, nil
+}
+
+// UpdateEarningTotals 更新累计收益、累计佣金和累计提现金额（在事务中执行）
+// 余额变动必须通过账本（LedgerRepository）完成，这里只维护统计字段
+func (r *kolEarningRepository) UpdateEarningTotals(tx *gorm.DB, kolID int64, earnedDelta, commissionDelta, withdrawnDelta money.Amount) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	return tx.Model(&model.OrbiaKolEarning{}).
+		Where("kol_id = ?", kolID).
+		Updates(map[string]interface{}{
+			"total_earned":     addAmountExpr("total_earned", earnedDelta),
+			"total_commission": addAmountExpr("total_commission", commissionDelta),
+			"total_withdrawn":  addAmountExpr("total_withdrawn", withdrawnDelta),
+		}).Error
+}
+
+// CreateEarningRecord 创建收益流水（在事务中执行）
+func (r *kolEarningRepository) CreateEarningRecord(tx *gorm.DB, record *model.OrbiaKolEarningRecord) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(record).Error
+}
+
+// GetIncomeRecordByOrderIDWithTx 获取KOL订单对应的收入流水（在事务中执行）
+func (r *kolEarningRepository) GetIncomeRecordByOrderIDWithTx(tx *gorm.DB, kolID int64, orderID string) (*model.OrbiaKolEarningRecord, error) {
+	if tx == nil {
+		tx = r.db
+	}
+
+	var record model.OrbiaKolEarningRecord
+	err := tx.Where("kol_id = ? AND type = ? AND related_order_type = ? AND related_order_id = ?", kolID, "income", "kol_order", orderID).
+		First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// GetEarningRecordsByKolID 根据KOL ID获取收益流水列表
+func (r *kolEarningRepository) GetEarningRecordsByKolID(kolID int64, recordType *string, page, pageSize int) ([]*model.OrbiaKolEarningRecord, int64, error) {
+	var records []*model.OrbiaKolEarningRecord
+	var total int64
+
+	query := r.db.Model(&model.OrbiaKolEarningRecord{}).Where("kol_id = ?", kolID)
+
+	if recordType != nil && *recordType != "" {
+		query = query.Where("type = ?", *recordType)
+	}
+
+	// 获取总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count earning records: %v", err)
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC, id DESC").Limit(pageSize).Offset(offset).Find(&records).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to query earning records: %v", err)
+	}
+
+	return records, total, nil
+}
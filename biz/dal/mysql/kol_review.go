@@ -0,0 +1,233 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// KolReview KOL订单评价模型
+type KolReview struct {
+	ID           int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	OrderID      string     `gorm:"uniqueIndex;column:order_id;size:64;not null" json:"order_id"`
+	KolID        int64      `gorm:"column:kol_id;not null;index:idx_kol_hidden,priority:1" json:"kol_id"`
+	UserID       int64      `gorm:"column:user_id;not null;index" json:"user_id"`
+	Rating       int32      `gorm:"column:rating;type:tinyint;not null" json:"rating"`
+	Content      string     `gorm:"column:content;type:text;not null" json:"content"`
+	Reply        *string    `gorm:"column:reply;type:text" json:"reply"`
+	RepliedAt    *time.Time `gorm:"column:replied_at" json:"replied_at"`
+	Hidden       bool       `gorm:"column:hidden;not null;default:false;index:idx_kol_hidden,priority:2" json:"hidden"`
+	HiddenReason *string    `gorm:"column:hidden_reason;size:500" json:"hidden_reason"`
+	HiddenBy     *int64     `gorm:"column:hidden_by" json:"hidden_by"`
+	HiddenAt     *time.Time `gorm:"column:hidden_at" json:"hidden_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (KolReview) TableName() string {
+	return "orbia_kol_review"
+}
+
+// KolReviewWithUser 评价和评价用户、KOL信息的联合查询结果
+type KolReviewWithUser struct {
+	KolReview
+	UserNickname   *string `json:"user_nickname"`
+	UserAvatarURL  *string `json:"user_avatar_url"`
+	KolDisplayName *string `json:"kol_display_name"`
+}
+
+// KolRating KOL评分汇总（只统计未隐藏的评价）
+type KolRating struct {
+	KolID         int64   `gorm:"column:kol_id" json:"kol_id"`
+	AverageRating float64 `gorm:"column:average_rating" json:"average_rating"`
+	ReviewCount   int64   `gorm:"column:review_count" json:"review_count"`
+}
+
+// KolReviewFilter 管理员查询评价的筛选条件
+type KolReviewFilter struct {
+	KolID  *int64
+	Rating *int32
+	Hidden *bool
+}
+
+// KolReviewRepository KOL订单评价仓储接口
+type KolReviewRepository interface {
+	// 创建评价
+	CreateReview(review *KolReview) error
+
+	// 根据ID获取评价
+	GetReviewByID(id int64) (*KolReview, error)
+
+	// 根据订单ID获取评价
+	GetReviewByOrderID(orderID string) (*KolReview, error)
+
+	// 根据订单ID批量获取评价
+	GetReviewsByOrderIDs(orderIDs []string) (map[string]*KolReview, error)
+
+	// 更新KOL回复
+	UpdateReviewReply(id int64, reply string) error
+
+	// 设置评价是否隐藏
+	SetReviewHiddenWithTx(tx *gorm.DB, id int64, hidden bool, reason *string, adminUserID int64) error
+
+	// 获取KOL公开的评价列表（不含已隐藏的评价）
+	GetKolVisibleReviews(kolID int64, offset, limit int) ([]*KolReviewWithUser, int64, error)
+
+	// 管理员功能
+	GetAllReviews(filter *KolReviewFilter, offset, limit int) ([]*KolReviewWithUser, int64, error)
+}
+
+// kolReviewRepository KOL订单评价仓储实现
+type kolReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewKolReviewRepository 创建KOL订单评价仓储实例
+func NewKolReviewRepository(db *gorm.DB) KolReviewRepository {
+	return &kolReviewRepository{db: db}
+}
+
+// CreateReview 创建评价
+func (r *kolReviewRepository) CreateReview(review *KolReview) error {
+	return r.db.Create(review).Error
+}
+
+// GetReviewByID 根据ID获取评价
+func (r *kolReviewRepository) GetReviewByID(id int64) (*KolReview, error) {
+	var review KolReview
+	err := r.db.Where("id = ?", id).First(&review).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetReviewByOrderID 根据订单ID获取评价
+func (r *kolReviewRepository) GetReviewByOrderID(orderID string) (*KolReview, error) {
+	var review KolReview
+	err := r.db.Where("order_id = ?", orderID).First(&review).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetReviewsByOrderIDs 根据订单ID批量获取评价，没有评价的订单不在结果中
+func (r *kolReviewRepository) GetReviewsByOrderIDs(orderIDs []string) (map[string]*KolReview, error) {
+	result := make(map[string]*KolReview, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return result, nil
+	}
+
+	var reviews []*KolReview
+	if err := r.db.Where("order_id IN ?", orderIDs).Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		result[review.OrderID] = review
+	}
+	return result, nil
+}
+
+// UpdateReviewReply 更新KOL回复
+func (r *kolReviewRepository) UpdateReviewReply(id int64, reply string) error {
+	return r.db.Model(&KolReview{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"reply":      reply,
+			"replied_at": time.Now(),
+		}).Error
+}
+
+// SetReviewHiddenWithTx 设置评价是否隐藏，取消隐藏时清空隐藏原因（在事务中执行）
+func (r *kolReviewRepository) SetReviewHiddenWithTx(tx *gorm.DB, id int64, hidden bool, reason *string, adminUserID int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	updates := map[string]interface{}{
+		"hidden":        hidden,
+		"hidden_reason": nil,
+		"hidden_by":     nil,
+		"hidden_at":     nil,
+	}
+	if hidden {
+		updates["hidden_reason"] = reason
+		updates["hidden_by"] = adminUserID
+		updates["hidden_at"] = time.Now()
+	}
+	return tx.Model(&KolReview{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// GetKolVisibleReviews 获取KOL公开的评价列表（按评价时间倒序）
+func (r *kolReviewRepository) GetKolVisibleReviews(kolID int64, offset, limit int) ([]*KolReviewWithUser, int64, error) {
+	return r.listReviews(
+		r.reviewQuery().Where("orbia_kol_review.kol_id = ? AND orbia_kol_review.hidden = ?", kolID, false),
+		offset, limit)
+}
+
+// GetAllReviews 获取所有评价（管理员使用，包含已隐藏的评价）
+func (r *kolReviewRepository) GetAllReviews(filter *KolReviewFilter, offset, limit int) ([]*KolReviewWithUser, int64, error) {
+	query := r.reviewQuery()
+	if filter.KolID != nil {
+		query = query.Where("orbia_kol_review.kol_id = ?", *filter.KolID)
+	}
+	if filter.Rating != nil {
+		query = query.Where("orbia_kol_review.rating = ?", *filter.Rating)
+	}
+	if filter.Hidden != nil {
+		query = query.Where("orbia_kol_review.hidden = ?", *filter.Hidden)
+	}
+	return r.listReviews(query, offset, limit)
+}
+
+// reviewQuery 评价联合查询（评价用户昵称、头像和KOL显示名称）
+func (r *kolReviewRepository) reviewQuery() *gorm.DB {
+	return r.db.Table("orbia_kol_review").
+		Select("orbia_kol_review.*, orbia_user.nickname as user_nickname, orbia_user.avatar_url as user_avatar_url, orbia_kol.display_name as kol_display_name").
+		Joins("LEFT JOIN orbia_user ON orbia_kol_review.user_id = orbia_user.id").
+		Joins("LEFT JOIN orbia_kol ON orbia_kol_review.kol_id = orbia_kol.id")
+}
+
+// listReviews 分页查询评价
+func (r *kolReviewRepository) listReviews(query *gorm.DB, offset, limit int) ([]*KolReviewWithUser, int64, error) {
+	var reviews []*KolReviewWithUser
+	var total int64
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("orbia_kol_review.created_at DESC, orbia_kol_review.id DESC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&reviews).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+// GetKolRatingsByKolIDs 批量获取KOL评分汇总，没有公开评价的KOL不在结果中
+func (r *kolRepository) GetKolRatingsByKolIDs(kolIDs []int64) (map[int64]*KolRating, error) {
+	result := make(map[int64]*KolRating, len(kolIDs))
+	if len(kolIDs) == 0 {
+		return result, nil
+	}
+
+	var ratings []*KolRating
+	err := r.db.Model(&KolReview{}).
+		Select("kol_id, AVG(rating) AS average_rating, COUNT(*) AS review_count").
+		Where("kol_id IN ? AND hidden = ?", kolIDs, false).
+		Group("kol_id").
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rating := range ratings {
+		result[rating.KolID] = rating
+	}
+	return result, nil
+}
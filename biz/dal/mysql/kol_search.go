@@ -0,0 +1,420 @@
+package mysql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"orbia_api/biz/utils/money"
+)
+
+// KOL搜索排序方式
+const (
+	KolSortRelevance  = "relevance"  // 关键词相关度（有关键词时的默认排序）
+	KolSortFollowers  = "followers"  // 粉丝总数
+	KolSortEngagement = "engagement" // 互动率
+	KolSortPrice      = "price"      // 最低报价
+	KolSortRating     = "rating"     // 评分（未隐藏评价的平均分，平均分相同时评价数多的在前）
+	KolSortRecency    = "recency"    // 审核通过时间（无关键词时的默认排序）
+)
+
+// KOL搜索分面
+const (
+	KolFacetTags       = "tags"
+	KolFacetLanguages  = "languages"
+	KolFacetCountries  = "countries"
+	KolFacetFollowers  = "followers"
+	KolFacetEngagement = "engagement"
+	KolFacetPrice      = "price"
+)
+
+// kolFacetValueLimit 标签、语言、国家分面最多返回的取值数量
+const kolFacetValueLimit = 50
+
+// KolSearchFilter KOL搜索条件
+// 同一条件内的多个取值为“任一匹配”，不同条件之间为“同时满足”
+type KolSearchFilter struct {
+	Status        string        // 为空时只查询已审核通过的KOL
+	Keyword       string        // 匹配显示名称和描述（全文索引）
+	Countries     []string      // 国家
+	Tags          []string      // 标签
+	Languages     []string      // 语言代码
+	MinFollowers  *int64        // 粉丝总数下限（含）
+	MaxFollowers  *int64        // 粉丝总数上限（含）
+	MinEngagement *float64      // 互动率下限（含）
+	MaxEngagement *float64      // 互动率上限（含）
+	MinPrice      *money.Amount // 任一报价Plan不低于该价格
+	MaxPrice      *money.Amount // 任一报价Plan不高于该价格（与 MinPrice 同时指定时为同一Plan落在区间内）
+	SortBy        string        // 排序方式，见 KolSort* 常量
+	SortDesc      bool          // 是否倒序
+}
+
+// KolRangeBucket 区间分面的桶，区间为 [Min, Max)，Max 为 nil 表示无上限
+type KolRangeBucket struct {
+	Key string
+	Min float64
+	Max *float64
+}
+
+// KolFacetCount 分面取值及命中的KOL数量
+type KolFacetCount struct {
+	Value string `gorm:"column:value"`
+	Label string `gorm:"column:label"`
+	Count int64  `gorm:"column:count"`
+}
+
+// KolSearchFacets KOL搜索分面统计
+// 每个分面统计时忽略该分面自身的条件，便于客户端展示多选项的数量
+type KolSearchFacets struct {
+	Tags       []*KolFacetCount
+	Languages  []*KolFacetCount
+	Countries  []*KolFacetCount
+	Followers  []*KolFacetCount
+	Engagement []*KolFacetCount
+	Price      []*KolFacetCount
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+// KolFollowerBuckets 粉丝总数分面区间
+var KolFollowerBuckets = []KolRangeBucket{
+	{Key: "0-10000", Min: 0, Max: floatPtr(10000)},
+	{Key: "10000-100000", Min: 10000, Max: floatPtr(100000)},
+	{Key: "100000-1000000", Min: 100000, Max: floatPtr(1000000)},
+	{Key: "1000000+", Min: 1000000},
+}
+
+// KolEngagementBuckets 互动率分面区间
+var KolEngagementBuckets = []KolRangeBucket{
+	{Key: "0-1", Min: 0, Max: floatPtr(1)},
+	{Key: "1-3", Min: 1, Max: floatPtr(3)},
+	{Key: "3-5", Min: 3, Max: floatPtr(5)},
+	{Key: "5+", Min: 5},
+}
+
+// KolPriceBuckets 报价分面区间（美元），KOL有任一Plan落在区间内即计入
+var KolPriceBuckets = []KolRangeBucket{
+	{Key: "0-100", Min: 0, Max: floatPtr(100)},
+	{Key: "100-500", Min: 100, Max: floatPtr(500)},
+	{Key: "500-1000", Min: 500, Max: floatPtr(1000)},
+	{Key: "1000-5000", Min: 1000, Max: floatPtr(5000)},
+	{Key: "5000+", Min: 5000},
+}
+
+// SearchKols 按条件搜索KOL
+func (r *kolRepository) SearchKols(filter *KolSearchFilter, offset, limit int) ([]*Kol, int64, error) {
+	var kols []*Kol
+	var total int64
+
+	if err := r.kolSearchQuery(filter, "").Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := r.kolSearchQuery(filter, "").Select("k.*")
+	for _, order := range kolSearchOrder(filter) {
+		query = query.Order(order)
+	}
+	if err := query.Offset(offset).Limit(limit).Find(&kols).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return kols, total, nil
+}
+
+// GetKolSearchFacets 统计搜索条件下各分面的KOL数量
+func (r *kolRepository) GetKolSearchFacets(filter *KolSearchFilter) (*KolSearchFacets, error) {
+	facets := &KolSearchFacets{}
+
+	if err := r.kolSearchQuery(filter, KolFacetTags).
+		Select("t.tag AS value, t.tag AS label, COUNT(DISTINCT k.id) AS count").
+		Joins("INNER JOIN orbia_kol_tag t ON t.kol_id = k.id").
+		Group("t.tag").
+		Order("count DESC, value ASC").
+		Limit(kolFacetValueLimit).
+		Scan(&facets.Tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to count tag facet: %w", err)
+	}
+
+	if err := r.kolSearchQuery(filter, KolFacetLanguages).
+		Select("l.language_code AS value, MAX(l.language_name) AS label, COUNT(DISTINCT k.id) AS count").
+		Joins("INNER JOIN orbia_kol_language l ON l.kol_id = k.id").
+		Group("l.language_code").
+		Order("count DESC, value ASC").
+		Limit(kolFacetValueLimit).
+		Scan(&facets.Languages).Error; err != nil {
+		return nil, fmt.Errorf("failed to count language facet: %w", err)
+	}
+
+	if err := r.kolSearchQuery(filter, KolFacetCountries).
+		Select("k.country AS value, k.country AS label, COUNT(*) AS count").
+		Where("k.country IS NOT NULL AND k.country <> ''").
+		Group("k.country").
+		Order("count DESC, value ASC").
+		Limit(kolFacetValueLimit).
+		Scan(&facets.Countries).Error; err != nil {
+		return nil, fmt.Errorf("failed to count country facet: %w", err)
+	}
+
+	var err error
+	facets.Followers, err = r.countKolRangeFacet(
+		r.kolSearchQuery(filter, KolFacetFollowers), "COALESCE(s.total_followers, 0)", "COUNT(*)", KolFollowerBuckets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count follower facet: %w", err)
+	}
+
+	facets.Engagement, err = r.countKolRangeFacet(
+		r.kolSearchQuery(filter, KolFacetEngagement), "COALESCE(s.engagement_rate, 0)", "COUNT(*)", KolEngagementBuckets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count engagement facet: %w", err)
+	}
+
+	facets.Price, err = r.countKolRangeFacet(
+		r.kolSearchQuery(filter, KolFacetPrice).
+			Joins("INNER JOIN orbia_kol_plan fp ON fp.kol_id = k.id AND fp.deleted_at IS NULL"),
+		"fp.price", "COUNT(DISTINCT k.id)", KolPriceBuckets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count price facet: %w", err)
+	}
+
+	return facets, nil
+}
+
+// GetKolMinPlanPrices 批量获取KOL的最低报价，没有报价Plan的KOL不在结果中
+func (r *kolRepository) GetKolMinPlanPrices(kolIDs []int64) (map[int64]money.Amount, error) {
+	result := make(map[int64]money.Amount, len(kolIDs))
+	if len(kolIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		KolID    int64        `gorm:"column:kol_id"`
+		MinPrice money.Amount `gorm:"column:min_price"`
+	}
+	err := r.db.Model(&KolPlan{}).
+		Select("kol_id, MIN(price) AS min_price").
+		Where("kol_id IN ?", kolIDs).
+		Group("kol_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.KolID] = row.MinPrice
+	}
+	return result, nil
+}
+
+// GetKolStatsByKolIDs 批量获取KOL统计数据
+func (r *kolRepository) GetKolStatsByKolIDs(kolIDs []int64) (map[int64]*KolStats, error) {
+	result := make(map[int64]*KolStats, len(kolIDs))
+	if len(kolIDs) == 0 {
+		return result, nil
+	}
+
+	var stats []*KolStats
+	if err := r.db.Where("kol_id IN ?", kolIDs).Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	for _, s := range stats {
+		result[s.KolID] = s
+	}
+	return result, nil
+}
+
+// GetKolTagsByKolIDs 批量获取KOL标签
+func (r *kolRepository) GetKolTagsByKolIDs(kolIDs []int64) (map[int64][]*KolTag, error) {
+	result := make(map[int64][]*KolTag, len(kolIDs))
+	if len(kolIDs) == 0 {
+		return result, nil
+	}
+
+	var tags []*KolTag
+	if err := r.db.Where("kol_id IN ?", kolIDs).Order("id ASC").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		result[t.KolID] = append(result[t.KolID], t)
+	}
+	return result, nil
+}
+
+// GetKolLanguagesByKolIDs 批量获取KOL语言
+func (r *kolRepository) GetKolLanguagesByKolIDs(kolIDs []int64) (map[int64][]*KolLanguage, error) {
+	result := make(map[int64][]*KolLanguage, len(kolIDs))
+	if len(kolIDs) == 0 {
+		return result, nil
+	}
+
+	var languages []*KolLanguage
+	if err := r.db.Where("kol_id IN ?", kolIDs).Order("id ASC").Find(&languages).Error; err != nil {
+		return nil, err
+	}
+	for _, l := range languages {
+		result[l.KolID] = append(result[l.KolID], l)
+	}
+	return result, nil
+}
+
+// kolSearchQuery 构建KOL搜索查询，exclude 指定统计分面时需要忽略的条件
+func (r *kolRepository) kolSearchQuery(filter *KolSearchFilter, exclude string) *gorm.DB {
+	query := r.db.Unscoped().Table("orbia_kol AS k").
+		Joins("LEFT JOIN orbia_kol_stats s ON s.kol_id = k.id").
+		Where("k.deleted_at IS NULL")
+
+	if filter.Status != "" {
+		query = query.Where("k.status = ?", filter.Status)
+	} else {
+		query = query.Where("k.status = ?", "approved")
+	}
+
+	if keyword := kolFullTextQuery(filter.Keyword); keyword != "" {
+		query = query.Where("MATCH(k.display_name, k.description) AGAINST (? IN BOOLEAN MODE)", keyword)
+	}
+
+	if exclude != KolFacetCountries && len(filter.Countries) > 0 {
+		query = query.Where("k.country IN ?", filter.Countries)
+	}
+	if exclude != KolFacetTags && len(filter.Tags) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM orbia_kol_tag ft WHERE ft.kol_id = k.id AND ft.tag IN ?)", filter.Tags)
+	}
+	if exclude != KolFacetLanguages && len(filter.Languages) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM orbia_kol_language fl WHERE fl.kol_id = k.id AND fl.language_code IN ?)", filter.Languages)
+	}
+
+	if exclude != KolFacetFollowers {
+		if filter.MinFollowers != nil {
+			query = query.Where("COALESCE(s.total_followers, 0) >= ?", *filter.MinFollowers)
+		}
+		if filter.MaxFollowers != nil {
+			query = query.Where("COALESCE(s.total_followers, 0) <= ?", *filter.MaxFollowers)
+		}
+	}
+
+	if exclude != KolFacetEngagement {
+		if filter.MinEngagement != nil {
+			query = query.Where("COALESCE(s.engagement_rate, 0) >= ?", *filter.MinEngagement)
+		}
+		if filter.MaxEngagement != nil {
+			query = query.Where("COALESCE(s.engagement_rate, 0) <= ?", *filter.MaxEngagement)
+		}
+	}
+
+	if exclude != KolFacetPrice && (filter.MinPrice != nil || filter.MaxPrice != nil) {
+		planCond := "SELECT 1 FROM orbia_kol_plan pp WHERE pp.kol_id = k.id AND pp.deleted_at IS NULL"
+		var args []interface{}
+		if filter.MinPrice != nil {
+			planCond += " AND pp.price >= ?"
+			args = append(args, *filter.MinPrice)
+		}
+		if filter.MaxPrice != nil {
+			planCond += " AND pp.price <= ?"
+			args = append(args, *filter.MaxPrice)
+		}
+		query = query.Where("EXISTS ("+planCond+")", args...)
+	}
+
+	return query
+}
+
+// kolSearchOrder 搜索结果排序，最后按ID排序保证分页稳定
+func kolSearchOrder(filter *KolSearchFilter) []interface{} {
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	sortBy := filter.SortBy
+	keyword := kolFullTextQuery(filter.Keyword)
+	if sortBy == "" || (sortBy == KolSortRelevance && keyword == "") {
+		if keyword != "" {
+			sortBy = KolSortRelevance
+		} else {
+			sortBy = KolSortRecency
+			direction = "DESC"
+		}
+	}
+
+	var orders []interface{}
+	switch sortBy {
+	case KolSortRelevance:
+		orders = append(orders, clause.OrderBy{Expression: clause.Expr{
+			SQL:  "MATCH(k.display_name, k.description) AGAINST (? IN BOOLEAN MODE) DESC",
+			Vars: []interface{}{keyword},
+		}})
+	case KolSortFollowers:
+		orders = append(orders, "COALESCE(s.total_followers, 0) "+direction)
+	case KolSortEngagement:
+		orders = append(orders, "COALESCE(s.engagement_rate, 0) "+direction)
+	case KolSortPrice:
+		// 没有报价Plan的KOL排在最后
+		minPrice := "(SELECT MIN(op.price) FROM orbia_kol_plan op WHERE op.kol_id = k.id AND op.deleted_at IS NULL)"
+		orders = append(orders, minPrice+" IS NULL ASC", minPrice+" "+direction)
+	case KolSortRating:
+		// 没有评价的KOL排在最后
+		rating := "(SELECT AVG(rv.rating) FROM orbia_kol_review rv WHERE rv.kol_id = k.id AND rv.hidden = 0)"
+		reviewCount := "(SELECT COUNT(*) FROM orbia_kol_review rc WHERE rc.kol_id = k.id AND rc.hidden = 0)"
+		orders = append(orders, rating+" IS NULL ASC", rating+" "+direction, reviewCount+" DESC")
+	default:
+		orders = append(orders, "COALESCE(k.approved_at, k.created_at) "+direction)
+	}
+	return append(orders, "k.id DESC")
+}
+
+// countKolRangeFacet 按区间统计分面数量，column 为参与分桶的字段
+func (r *kolRepository) countKolRangeFacet(query *gorm.DB, column, countExpr string, buckets []KolRangeBucket) ([]*KolFacetCount, error) {
+	var caseExpr strings.Builder
+	caseExpr.WriteString("CASE")
+	for _, b := range buckets {
+		caseExpr.WriteString(" WHEN " + column + " >= " + strconv.FormatFloat(b.Min, 'f', -1, 64))
+		if b.Max != nil {
+			caseExpr.WriteString(" AND " + column + " < " + strconv.FormatFloat(*b.Max, 'f', -1, 64))
+		}
+		caseExpr.WriteString(" THEN '" + b.Key + "'")
+	}
+	caseExpr.WriteString(" END")
+
+	var rows []*KolFacetCount
+	err := query.
+		Select(caseExpr.String() + " AS value, " + countExpr + " AS count").
+		Group("value").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Value] = row.Count
+	}
+
+	// 按区间顺序返回所有桶，没有命中的桶数量为0
+	result := make([]*KolFacetCount, 0, len(buckets))
+	for _, b := range buckets {
+		result = append(result, &KolFacetCount{Value: b.Key, Label: b.Key, Count: counts[b.Key]})
+	}
+	return result, nil
+}
+
+// kolFullTextQuery 将用户输入的关键词转换为全文检索布尔模式查询，每个词都必须出现
+// 去掉布尔模式的运算符，避免用户输入改变查询语义
+func kolFullTextQuery(keyword string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		switch r {
+		case '+', '-', '<', '>', '(', ')', '~', '*', '"', '@':
+			return ' '
+		}
+		return r
+	}, keyword)
+
+	terms := strings.Fields(cleaned)
+	for i, term := range terms {
+		terms[i] = `+"` + term + `"`
+	}
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,187 @@
+package mysql
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// KOL统计指标，与 orbia_kol_stats 的字段名一致
+const (
+	KolMetricTotalFollowers     = "total_followers"
+	KolMetricTiktokFollowers    = "tiktok_followers"
+	KolMetricYoutubeSubscribers = "youtube_subscribers"
+	KolMetricXFollowers         = "x_followers"
+	KolMetricDiscordMembers     = "discord_members"
+	KolMetricTiktokAvgViews     = "tiktok_avg_views"
+	KolMetricEngagementRate     = "engagement_rate"
+)
+
+// KOL统计数据来源
+const (
+	KolStatsSourceProvider = "provider" // 平台接口核验
+	KolStatsSourceManual   = "manual"   // KOL手动填写
+)
+
+// KolStatsHistory KOL统计数据历史（每次同步或手动更新记录一次各项指标）
+type KolStatsHistory struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	KolID      int64     `gorm:"column:kol_id;not null;index:idx_kol_metric_time,priority:1" json:"kol_id"`
+	Metric     string    `gorm:"column:metric;size:50;not null;index:idx_kol_metric_time,priority:2" json:"metric"`
+	Value      float64   `gorm:"column:value;type:decimal(20,2);not null" json:"value"`
+	Source     string    `gorm:"column:source;type:enum('provider','manual');not null" json:"source"`
+	RecordedAt time.Time `gorm:"column:recorded_at;not null;index:idx_kol_metric_time,priority:3" json:"recorded_at"`
+}
+
+// TableName 指定表名
+func (KolStatsHistory) TableName() string {
+	return "orbia_kol_stats_history"
+}
+
+// KolStatsDaily KOL统计数据每日快照（每个KOL每天一条，保存当天最后一次的数据）
+type KolStatsDaily struct {
+	ID                 int64     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	KolID              int64     `gorm:"column:kol_id;not null;uniqueIndex:uk_kol_date,priority:1" json:"kol_id"`
+	SnapshotDate       time.Time `gorm:"column:snapshot_date;type:date;not null;uniqueIndex:uk_kol_date,priority:2" json:"snapshot_date"`
+	TotalFollowers     int64     `gorm:"column:total_followers;default:0" json:"total_followers"`
+	TiktokFollowers    int64     `gorm:"column:tiktok_followers;default:0" json:"tiktok_followers"`
+	YoutubeSubscribers int64     `gorm:"column:youtube_subscribers;default:0" json:"youtube_subscribers"`
+	XFollowers         int64     `gorm:"column:x_followers;default:0" json:"x_followers"`
+	DiscordMembers     int64     `gorm:"column:discord_members;default:0" json:"discord_members"`
+	TiktokAvgViews     int64     `gorm:"column:tiktok_avg_views;default:0" json:"tiktok_avg_views"`
+	EngagementRate     float64   `gorm:"column:engagement_rate;type:decimal(10,2);default:0" json:"engagement_rate"`
+	Verified           bool      `gorm:"column:verified;not null;default:false" json:"verified"`
+	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (KolStatsDaily) TableName() string {
+	return "orbia_kol_stats_daily"
+}
+
+// MetricValue 返回快照中指定指标的值
+func (d *KolStatsDaily) MetricValue(metric string) float64 {
+	switch metric {
+	case KolMetricTotalFollowers:
+		return float64(d.TotalFollowers)
+	case KolMetricTiktokFollowers:
+		return float64(d.TiktokFollowers)
+	case KolMetricYoutubeSubscribers:
+		return float64(d.YoutubeSubscribers)
+	case KolMetricXFollowers:
+		return float64(d.XFollowers)
+	case KolMetricDiscordMembers:
+		return float64(d.DiscordMembers)
+	case KolMetricTiktokAvgViews:
+		return float64(d.TiktokAvgViews)
+	case KolMetricEngagementRate:
+		return d.EngagementRate
+	default:
+		return 0
+	}
+}
+
+// AllKolMetrics 返回所有KOL统计指标
+func AllKolMetrics() []string {
+	return []string{
+		KolMetricTotalFollowers,
+		KolMetricTiktokFollowers,
+		KolMetricYoutubeSubscribers,
+		KolMetricXFollowers,
+		KolMetricDiscordMembers,
+		KolMetricTiktokAvgViews,
+		KolMetricEngagementRate,
+	}
+}
+
+// VerifiedPlatformList 返回最近一次同步核验成功的平台列表
+func (s *KolStats) VerifiedPlatformList() []string {
+	if s.VerifiedPlatforms == "" {
+		return []string{}
+	}
+	return strings.Split(s.VerifiedPlatforms, ",")
+}
+
+// IsPlatformVerified 检查平台的粉丝数是否已核验
+func (s *KolStats) IsPlatformVerified(platform string) bool {
+	for _, p := range s.VerifiedPlatformList() {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// ListKolsForStatsRefresh 按ID分批获取需要同步社交平台数据的KOL（已审核通过）
+func (r *kolRepository) ListKolsForStatsRefresh(afterID int64, limit int) ([]*Kol, error) {
+	var kols []*Kol
+	err := r.db.Where("id > ? AND status = ?", afterID, "approved").
+		Order("id ASC").
+		Limit(limit).
+		Find(&kols).Error
+	return kols, err
+}
+
+// CreateKolStatsHistory 批量记录KOL统计数据历史
+func (r *kolRepository) CreateKolStatsHistory(records []*KolStatsHistory) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return r.db.Create(&records).Error
+}
+
+// UpsertKolStatsDaily 保存KOL当天的统计快照，当天已有快照时覆盖
+func (r *kolRepository) UpsertKolStatsDaily(stats *KolStats, date time.Time) error {
+	snapshot := &KolStatsDaily{
+		KolID:              stats.KolID,
+		SnapshotDate:       date,
+		TotalFollowers:     stats.TotalFollowers,
+		TiktokFollowers:    stats.TiktokFollowers,
+		YoutubeSubscribers: stats.YoutubeSubscribers,
+		XFollowers:         stats.XFollowers,
+		DiscordMembers:     stats.DiscordMembers,
+		TiktokAvgViews:     stats.TiktokAvgViews,
+		EngagementRate:     stats.EngagementRate,
+		Verified:           stats.Verified,
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "kol_id"}, {Name: "snapshot_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"total_followers", "tiktok_followers", "youtube_subscribers", "x_followers",
+			"discord_members", "tiktok_avg_views", "engagement_rate", "verified", "updated_at",
+		}),
+	}).Create(snapshot).Error
+}
+
+// SnapshotAllKolStats 将所有KOL的当前统计数据保存为当天快照，返回影响的行数
+func (r *kolRepository) SnapshotAllKolStats(date time.Time) (int64, error) {
+	result := r.db.Exec(`INSERT INTO orbia_kol_stats_daily
+		(kol_id, snapshot_date, total_followers, tiktok_followers, youtube_subscribers, x_followers,
+		 discord_members, tiktok_avg_views, engagement_rate, verified, created_at, updated_at)
+		SELECT kol_id, ?, COALESCE(total_followers, 0), COALESCE(tiktok_followers, 0), COALESCE(youtube_subscribers, 0),
+		       COALESCE(x_followers, 0), COALESCE(discord_members, 0), COALESCE(tiktok_avg_views, 0),
+		       COALESCE(engagement_rate, 0), verified, NOW(), NOW()
+		FROM orbia_kol_stats
+		ON DUPLICATE KEY UPDATE
+			total_followers = VALUES(total_followers),
+			tiktok_followers = VALUES(tiktok_followers),
+			youtube_subscribers = VALUES(youtube_subscribers),
+			x_followers = VALUES(x_followers),
+			discord_members = VALUES(discord_members),
+			tiktok_avg_views = VALUES(tiktok_avg_views),
+			engagement_rate = VALUES(engagement_rate),
+			verified = VALUES(verified),
+			updated_at = VALUES(updated_at)`, date.Format("2006-01-02"))
+	return result.RowsAffected, result.Error
+}
+
+// GetKolStatsDaily 获取KOL从指定日期（含）开始的每日快照，按日期升序
+func (r *kolRepository) GetKolStatsDaily(kolID int64, from time.Time) ([]*KolStatsDaily, error) {
+	var snapshots []*KolStatsDaily
+	err := r.db.Where("kol_id = ? AND snapshot_date >= ?", kolID, from.Format("2006-01-02")).
+		Order("snapshot_date ASC").
+		Find(&snapshots).Error
+	return snapshots, err
+}
@@ -0,0 +1,156 @@
+package mysql
+
+import (
+	"errors"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/utils/money"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WalletReconciliationRow 钱包对账数据（钱包余额与账本余额）
+type WalletReconciliationRow struct {
+	UserID              int64        `gorm:"column:user_id"`
+	WalletBalance       money.Amount `gorm:"column:wallet_balance"`
+	WalletFrozenBalance money.Amount `gorm:"column:wallet_frozen_balance"`
+	LedgerBalance       money.Amount `gorm:"column:ledger_balance"`
+	LedgerFrozenBalance money.Amount `gorm:"column:ledger_frozen_balance"`
+}
+
+// LedgerRepository 账本仓库接口
+// 钱包和KOL收益账户的余额字段只允许通过本仓库更新
+type LedgerRepository interface {
+	CreateEntry(tx *gorm.DB, entry *model.OrbiaLedgerEntry, lines []*model.OrbiaLedgerLine) error
+	ApplyWalletDelta(tx *gorm.DB, userID int64, balanceDelta money.Amount, frozenDelta money.Amount) error
+	ApplyKolEarningDelta(tx *gorm.DB, kolID int64, balanceDelta money.Amount, frozenDelta money.Amount) error
+	GetWalletReconciliation(userID *int64) ([]*WalletReconciliationRow, error)
+	GetTrialBalance() (totalDebit money.Amount, totalCredit money.Amount, err error)
+}
+
+// ledgerRepository 账本仓库实现
+type ledgerRepository struct {
+	db *gorm.DB
+}
+
+// NewLedgerRepository 创建账本仓库实例
+func NewLedgerRepository(db *gorm.DB) LedgerRepository {
+	return &ledgerRepository{db: db}
+}
+
+// CreateEntry 创建账本分录及明细（在事务中执行）
+func (r *ledgerRepository) CreateEntry(tx *gorm.DB, entry *model.OrbiaLedgerEntry, lines []*model.OrbiaLedgerLine) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	if err := tx.Create(entry).Error; err != nil {
+		return err
+	}
+	return tx.Create(&lines).Error
+}
+
+// ApplyWalletDelta 更新钱包可用余额和冻结余额（在事务中执行）
+func (r *ledgerRepository) ApplyWalletDelta(tx *gorm.DB, userID int64, balanceDelta money.Amount, frozenDelta money.Amount) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	result := tx.Model(&model.OrbiaWallet{}).
+		Where("user_id = ?", userID).
+		Where("balance + CAST(? AS DECIMAL(15,2)) >= 0", balanceDelta).       // 确保余额不会为负
+		Where("frozen_balance + CAST(? AS DECIMAL(15,2)) >= 0", frozenDelta). // 确保冻结余额不会为负
+		Updates(map[string]interface{}{
+			"balance":        addAmountExpr("balance", balanceDelta),
+			"frozen_balance": addAmountExpr("frozen_balance", frozenDelta),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("insufficient balance or wallet not found")
+	}
+
+	return nil
+}
+
+// ApplyKolEarningDelta 更新KOL可提现余额和冻结金额（在事务中执行）
+func (r *ledgerRepository) ApplyKolEarningDelta(tx *gorm.DB, kolID int64, balanceDelta money.Amount, frozenDelta money.Amount) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	result := tx.Model(&model.OrbiaKolEarning{}).
+		Where("kol_id = ?", kolID).
+		Where("balance + CAST(? AS DECIMAL(15,2)) >= 0", balanceDelta).       // 确保余额不会为负
+		Where("frozen_balance + CAST(? AS DECIMAL(15,2)) >= 0", frozenDelta). // 确保冻结金额不会为负
+		Updates(map[string]interface{}{
+			"balance":        addAmountExpr("balance", balanceDelta),
+			"frozen_balance": addAmountExpr("frozen_balance", frozenDelta),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("insufficient earning balance or earning account not found")
+	}
+
+	return nil
+}
+
+// GetWalletReconciliation 按账本明细重新计算每个钱包的余额
+// 用户钱包账户为负债类账户，余额 = 贷方合计 - 借方合计
+func (r *ledgerRepository) GetWalletReconciliation(userID *int64) ([]*WalletReconciliationRow, error) {
+	ledgerSums := r.db.Model(&model.OrbiaLedgerLine{}).
+		Select(`owner_id,
+			SUM(CASE WHEN account_type = 'user_wallet' THEN IF(direction = 'credit', amount, -amount) ELSE 0 END) AS balance,
+			SUM(CASE WHEN account_type = 'user_wallet_frozen' THEN IF(direction = 'credit', amount, -amount) ELSE 0 END) AS frozen_balance`).
+		Where("account_type IN ?", []string{"user_wallet", "user_wallet_frozen"}).
+		Group("owner_id")
+
+	query := r.db.Table("orbia_wallet AS w").
+		Select(`w.user_id,
+			w.balance AS wallet_balance,
+			w.frozen_balance AS wallet_frozen_balance,
+			COALESCE(l.balance, 0) AS ledger_balance,
+			COALESCE(l.frozen_balance, 0) AS ledger_frozen_balance`).
+		Joins("LEFT JOIN (?) AS l ON l.owner_id = w.user_id", ledgerSums)
+
+	if userID != nil && *userID > 0 {
+		query = query.Where("w.user_id = ?", *userID)
+	}
+
+	var rows []*WalletReconciliationRow
+	if err := query.Order("w.user_id ASC").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// GetTrialBalance 获取账本借方合计与贷方合计（试算平衡）
+func (r *ledgerRepository) GetTrialBalance() (money.Amount, money.Amount, error) {
+	var result struct {
+		TotalDebit  money.Amount
+		TotalCredit money.Amount
+	}
+
+	err := r.db.Model(&model.OrbiaLedgerLine{}).
+		Select(`COALESCE(SUM(CASE WHEN direction = 'debit' THEN amount ELSE 0 END), 0) AS total_debit,
+			COALESCE(SUM(CASE WHEN direction = 'credit' THEN amount ELSE 0 END), 0) AS total_credit`).
+		Scan(&result).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	return result.TotalDebit, result.TotalCredit, nil
+}
+
+// addAmountExpr 生成金额字段增减表达式
+// 金额以十进制字符串传入，显式转换为 DECIMAL，避免 MySQL 按浮点数参与运算
+func addAmountExpr(column string, delta money.Amount) clause.Expr {
+	return gorm.Expr(column+" + CAST(? AS DECIMAL(15,2))", delta)
+}
@@ -1,9 +1,12 @@
 package mysql
 
 import (
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
+
+	"orbia_api/biz/utils/money"
 )
 
 // KolOrder KOL订单模型
@@ -16,7 +19,7 @@ type KolOrder struct {
 	PlanID                 int64          `gorm:"column:plan_id;not null" json:"plan_id"`
 	PlanTitle              string         `gorm:"column:plan_title;size:200;not null" json:"plan_title"`
 	PlanDescription        *string        `gorm:"column:plan_description;type:text" json:"plan_description"`
-	PlanPrice              float64        `gorm:"column:plan_price;type:decimal(10,2);not null" json:"plan_price"`
+	PlanPrice              money.Amount   `gorm:"column:plan_price;type:decimal(10,2);not null" json:"plan_price"`
 	PlanType               string         `gorm:"column:plan_type;size:20;not null" json:"plan_type"`
 	Title                  string         `gorm:"column:title;size:200;not null" json:"title"`
 	RequirementDescription string         `gorm:"column:requirement_description;type:text;not null" json:"requirement_description"`
@@ -26,11 +29,16 @@ type KolOrder struct {
 	ExpectedDeliveryDate   string         `gorm:"column:expected_delivery_date;type:date;not null" json:"expected_delivery_date"`
 	AdditionalRequirements *string        `gorm:"column:additional_requirements;type:text" json:"additional_requirements"`
 	ConversationID         *string        `gorm:"column:conversation_id;size:64" json:"conversation_id"`
-	Status                 string         `gorm:"column:status;type:enum('pending','confirmed','in_progress','completed','cancelled','refunded');default:pending;not null" json:"status"`
+	PlanMaxRevisions       int32          `gorm:"column:plan_max_revisions;not null;default:0" json:"plan_max_revisions"`
+	RevisionCount          int32          `gorm:"column:revision_count;not null;default:0" json:"revision_count"`
+	Status                 string         `gorm:"column:status;type:enum('pending_payment','pending','confirmed','in_progress','delivered','completed','cancelled','refunded');default:pending_payment;not null" json:"status"`
 	RejectReason           *string        `gorm:"column:reject_reason;type:text" json:"reject_reason"`
 	ConfirmedAt            *time.Time     `gorm:"column:confirmed_at" json:"confirmed_at"`
+	DeliveredAt            *time.Time     `gorm:"column:delivered_at" json:"delivered_at"`
 	CompletedAt            *time.Time     `gorm:"column:completed_at" json:"completed_at"`
 	CancelledAt            *time.Time     `gorm:"column:cancelled_at" json:"cancelled_at"`
+	RefundAmount           *money.Amount  `gorm:"column:refund_amount;type:decimal(10,2)" json:"refund_amount"`
+	RefundedAt             *time.Time     `gorm:"column:refunded_at" json:"refunded_at"`
 	CreatedAt              time.Time      `gorm:"column:created_at;autoCreateTime" json:"created_at"`
 	UpdatedAt              time.Time      `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
 	DeletedAt              gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
@@ -73,6 +81,21 @@ type OrderRepository interface {
 	// 更新订单状态
 	UpdateOrderStatus(orderID string, status string, reason *string) error
 
+	// 更新订单状态（在事务中执行）
+	UpdateOrderStatusWithTx(tx *gorm.DB, orderID string, status string, reason *string) error
+
+	// 更新订单状态（在事务中执行，仅当订单仍处于 fromStatus 状态时生效，防止并发重复处理）
+	UpdateOrderStatusFromWithTx(tx *gorm.DB, orderID string, fromStatus string, status string, reason *string) error
+
+	// 标记订单已退款（在事务中执行，仅当订单仍处于 fromStatus 状态时生效，防止重复退款）
+	MarkOrderRefundedWithTx(tx *gorm.DB, orderID string, fromStatus string, refundAmount money.Amount, reason *string) error
+
+	// 买家申请修改交付内容（在事务中执行，仅当订单为已交付且修改次数未用完时生效），订单回到进行中
+	RequestOrderRevisionWithTx(tx *gorm.DB, orderID string) error
+
+	// 获取交付时间早于 deliveredBefore 的已交付订单（用于超时自动验收）
+	GetDeliveredOrdersBefore(deliveredBefore time.Time, limit int) ([]*KolOrder, error)
+
 	// 更新订单
 	UpdateOrder(order *KolOrder) error
 
@@ -238,6 +261,40 @@ func (r *orderRepository) GetTeamOrders(teamID int64, status *string, offset, li
 
 // UpdateOrderStatus 更新订单状态
 func (r *orderRepository) UpdateOrderStatus(orderID string, status string, reason *string) error {
+	return r.UpdateOrderStatusWithTx(nil, orderID, status, reason)
+}
+
+// UpdateOrderStatusWithTx 更新订单状态（在事务中执行）
+func (r *orderRepository) UpdateOrderStatusWithTx(tx *gorm.DB, orderID string, status string, reason *string) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	return tx.Model(&KolOrder{}).
+		Where("order_id = ?", orderID).
+		Updates(buildOrderStatusUpdates(status, reason)).Error
+}
+
+// UpdateOrderStatusFromWithTx 更新订单状态（在事务中执行）
+func (r *orderRepository) UpdateOrderStatusFromWithTx(tx *gorm.DB, orderID string, fromStatus string, status string, reason *string) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	result := tx.Model(&KolOrder{}).
+		Where("order_id = ? AND status = ?", orderID, fromStatus).
+		Updates(buildOrderStatusUpdates(status, reason))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("order status has changed")
+	}
+	return nil
+}
+
+// buildOrderStatusUpdates 构建订单状态变更需要更新的字段
+func buildOrderStatusUpdates(status string, reason *string) map[string]interface{} {
 	updates := map[string]interface{}{
 		"status": status,
 	}
@@ -246,6 +303,8 @@ func (r *orderRepository) UpdateOrderStatus(orderID string, status string, reaso
 	switch status {
 	case "confirmed":
 		updates["confirmed_at"] = now
+	case "delivered":
+		updates["delivered_at"] = now
 	case "completed":
 		updates["completed_at"] = now
 	case "cancelled", "refunded":
@@ -254,10 +313,66 @@ func (r *orderRepository) UpdateOrderStatus(orderID string, status string, reaso
 			updates["reject_reason"] = *reason
 		}
 	}
+	return updates
+}
 
-	return r.db.Model(&KolOrder{}).
-		Where("order_id = ?", orderID).
-		Updates(updates).Error
+// MarkOrderRefundedWithTx 标记订单已退款（在事务中执行）
+func (r *orderRepository) MarkOrderRefundedWithTx(tx *gorm.DB, orderID string, fromStatus string, refundAmount money.Amount, reason *string) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	updates := map[string]interface{}{
+		"status":        "refunded",
+		"refund_amount": refundAmount,
+		"refunded_at":   time.Now(),
+	}
+	if reason != nil {
+		updates["reject_reason"] = *reason
+	}
+
+	result := tx.Model(&KolOrder{}).
+		Where("order_id = ? AND status = ?", orderID, fromStatus).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("order status has changed")
+	}
+	return nil
+}
+
+// RequestOrderRevisionWithTx 买家申请修改交付内容（在事务中执行）
+// 仅当订单仍为已交付且修改次数未达到Plan上限时生效，订单回到进行中并累加修改次数
+func (r *orderRepository) RequestOrderRevisionWithTx(tx *gorm.DB, orderID string) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	result := tx.Model(&KolOrder{}).
+		Where("order_id = ? AND status = ? AND revision_count < plan_max_revisions", orderID, "delivered").
+		Updates(map[string]interface{}{
+			"status":         "in_progress",
+			"revision_count": gorm.Expr("revision_count + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("order status has changed or revision limit reached")
+	}
+	return nil
+}
+
+// GetDeliveredOrdersBefore 获取交付时间早于 deliveredBefore 的已交付订单（按交付时间升序）
+func (r *orderRepository) GetDeliveredOrdersBefore(deliveredBefore time.Time, limit int) ([]*KolOrder, error) {
+	var orders []*KolOrder
+	err := r.db.Where("status = ? AND delivered_at < ?", "delivered", deliveredBefore).
+		Order("delivered_at ASC").
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
 }
 
 // UpdateOrder 更新订单
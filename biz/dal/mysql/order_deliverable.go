@@ -0,0 +1,139 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 交付内容状态
+const (
+	DeliverableStatusSubmitted         = "submitted"          // 已提交，等待买家验收
+	DeliverableStatusAccepted          = "accepted"           // 买家已验收（或超时自动验收）
+	DeliverableStatusRevisionRequested = "revision_requested" // 买家申请修改
+)
+
+// 交付内容类型
+const (
+	DeliverableItemVideo = "video" // 视频链接
+	DeliverableItemFile  = "file"  // 上传的文件
+)
+
+// KolOrderDeliverable KOL订单交付内容（每次提交一条，round 从1开始）
+type KolOrderDeliverable struct {
+	ID           int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	OrderID      string     `gorm:"column:order_id;size:64;not null;uniqueIndex:uk_order_round,priority:1" json:"order_id"`
+	KolID        int64      `gorm:"column:kol_id;not null;index" json:"kol_id"`
+	Round        int32      `gorm:"column:round;not null;uniqueIndex:uk_order_round,priority:2" json:"round"`
+	Note         *string    `gorm:"column:note;type:text" json:"note"`
+	Status       string     `gorm:"column:status;type:enum('submitted','accepted','revision_requested');default:submitted;not null" json:"status"`
+	Feedback     *string    `gorm:"column:feedback;type:text" json:"feedback"`
+	AutoAccepted bool       `gorm:"column:auto_accepted;not null;default:false" json:"auto_accepted"`
+	ReviewedAt   *time.Time `gorm:"column:reviewed_at" json:"reviewed_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+
+	Items []*KolOrderDeliverableItem `gorm:"foreignKey:DeliverableID" json:"items"`
+}
+
+// TableName 指定表名
+func (KolOrderDeliverable) TableName() string {
+	return "orbia_kol_order_deliverable"
+}
+
+// KolOrderDeliverableItem 交付内容中的视频链接或文件
+type KolOrderDeliverableItem struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	DeliverableID int64     `gorm:"column:deliverable_id;not null;index" json:"deliverable_id"`
+	ItemType      string    `gorm:"column:item_type;type:enum('video','file');not null" json:"item_type"`
+	URL           string    `gorm:"column:url;size:1000;not null" json:"url"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (KolOrderDeliverableItem) TableName() string {
+	return "orbia_kol_order_deliverable_item"
+}
+
+// KolOrderDeliverableRepository KOL订单交付内容仓储接口
+type KolOrderDeliverableRepository interface {
+	// 创建交付内容及其视频链接和文件（在事务中执行）
+	CreateDeliverableWithTx(tx *gorm.DB, deliverable *KolOrderDeliverable) error
+
+	// 获取订单最近一次提交的交付内容
+	GetLatestDeliverable(orderID string) (*KolOrderDeliverable, error)
+
+	// 获取订单的所有交付内容（按提交轮次升序，包含视频链接和文件）
+	GetDeliverablesByOrderID(orderID string) ([]*KolOrderDeliverable, error)
+
+	// 买家验收或申请修改（在事务中执行，仅当交付内容仍为已提交时生效）
+	ReviewDeliverableWithTx(tx *gorm.DB, id int64, status string, feedback *string, autoAccepted bool) error
+}
+
+// kolOrderDeliverableRepository KOL订单交付内容仓储实现
+type kolOrderDeliverableRepository struct {
+	db *gorm.DB
+}
+
+// NewKolOrderDeliverableRepository 创建KOL订单交付内容仓储实例
+func NewKolOrderDeliverableRepository(db *gorm.DB) KolOrderDeliverableRepository {
+	return &kolOrderDeliverableRepository{db: db}
+}
+
+// CreateDeliverableWithTx 创建交付内容（在事务中执行），Items 一并保存
+func (r *kolOrderDeliverableRepository) CreateDeliverableWithTx(tx *gorm.DB, deliverable *KolOrderDeliverable) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(deliverable).Error
+}
+
+// GetLatestDeliverable 获取订单最近一次提交的交付内容
+func (r *kolOrderDeliverableRepository) GetLatestDeliverable(orderID string) (*KolOrderDeliverable, error) {
+	var deliverable KolOrderDeliverable
+	err := r.db.Where("order_id = ?", orderID).Order("round DESC").First(&deliverable).Error
+	if err != nil {
+		return nil, err
+	}
+	return &deliverable, nil
+}
+
+// GetDeliverablesByOrderID 获取订单的所有交付内容
+func (r *kolOrderDeliverableRepository) GetDeliverablesByOrderID(orderID string) ([]*KolOrderDeliverable, error) {
+	var deliverables []*KolOrderDeliverable
+	err := r.db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).
+		Where("order_id = ?", orderID).
+		Order("round ASC").
+		Find(&deliverables).Error
+	return deliverables, err
+}
+
+// ReviewDeliverableWithTx 买家验收或申请修改（在事务中执行）
+func (r *kolOrderDeliverableRepository) ReviewDeliverableWithTx(tx *gorm.DB, id int64, status string, feedback *string, autoAccepted bool) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	updates := map[string]interface{}{
+		"status":        status,
+		"auto_accepted": autoAccepted,
+		"reviewed_at":   time.Now(),
+	}
+	if feedback != nil {
+		updates["feedback"] = *feedback
+	}
+
+	result := tx.Model(&KolOrderDeliverable{}).
+		Where("id = ? AND status = ?", id, DeliverableStatusSubmitted).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("deliverable has already been reviewed")
+	}
+	return nil
+}
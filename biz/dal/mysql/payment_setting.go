@@ -8,9 +8,9 @@ import (
 
 // PaymentSettingRepository 收款钱包设置仓储接口
 type PaymentSettingRepository interface {
-	CreatePaymentSetting(setting *model.OrbiaPaymentSetting) error
-	UpdatePaymentSetting(setting *model.OrbiaPaymentSetting) error
-	DeletePaymentSetting(id int64) error
+	CreatePaymentSettingWithTx(tx *gorm.DB, setting *model.OrbiaPaymentSetting) error
+	UpdatePaymentSettingWithTx(tx *gorm.DB, setting *model.OrbiaPaymentSetting) error
+	DeletePaymentSettingWithTx(tx *gorm.DB, id int64) error
 	GetPaymentSettingByID(id int64) (*model.OrbiaPaymentSetting, error)
 	GetPaymentSettings(network string, status *int32, offset int, limit int) ([]*model.OrbiaPaymentSetting, int64, error)
 	GetActivePaymentSettings(network string) ([]*model.OrbiaPaymentSetting, error)
@@ -26,19 +26,28 @@ func NewPaymentSettingRepository(db *gorm.DB) PaymentSettingRepository {
 	return &paymentSettingRepository{db: db}
 }
 
-// CreatePaymentSetting 创建收款钱包设置
-func (r *paymentSettingRepository) CreatePaymentSetting(setting *model.OrbiaPaymentSetting) error {
-	return r.db.Create(setting).Error
+// CreatePaymentSettingWithTx 创建收款钱包设置（在事务中执行）
+func (r *paymentSettingRepository) CreatePaymentSettingWithTx(tx *gorm.DB, setting *model.OrbiaPaymentSetting) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(setting).Error
 }
 
-// UpdatePaymentSetting 更新收款钱包设置
-func (r *paymentSettingRepository) UpdatePaymentSetting(setting *model.OrbiaPaymentSetting) error {
-	return r.db.Save(setting).Error
+// UpdatePaymentSettingWithTx 更新收款钱包设置（在事务中执行）
+func (r *paymentSettingRepository) UpdatePaymentSettingWithTx(tx *gorm.DB, setting *model.OrbiaPaymentSetting) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(setting).Error
 }
 
-// DeletePaymentSetting 删除收款钱包设置（软删除）
-func (r *paymentSettingRepository) DeletePaymentSetting(id int64) error {
-	return r.db.Delete(&model.OrbiaPaymentSetting{}, id).Error
+// DeletePaymentSettingWithTx 删除收款钱包设置（软删除，在事务中执行）
+func (r *paymentSettingRepository) DeletePaymentSettingWithTx(tx *gorm.DB, id int64) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Delete(&model.OrbiaPaymentSetting{}, id).Error
 }
 
 // GetPaymentSettingByID 根据ID获取收款钱包设置
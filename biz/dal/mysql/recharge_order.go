@@ -1,11 +1,14 @@
 package mysql
 
 import (
+	"errors"
 	"fmt"
 
 	"orbia_api/biz/dal/model"
+	"orbia_api/biz/utils/money"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RechargeOrderRepository 充值订单仓库接口
@@ -13,9 +16,15 @@ type RechargeOrderRepository interface {
 	CreateRechargeOrder(order *model.OrbiaRechargeOrder) error
 	GetRechargeOrderByID(orderID string) (*model.OrbiaRechargeOrder, error)
 	GetRechargeOrderByOrderID(orderID string) (*model.OrbiaRechargeOrder, error)
+	GetRechargeOrderByOrderIDForUpdate(tx *gorm.DB, orderID string) (*model.OrbiaRechargeOrder, error)
 	UpdateRechargeOrder(order *model.OrbiaRechargeOrder) error
+	UpdateRechargeOrderWithTx(tx *gorm.DB, order *model.OrbiaRechargeOrder) error
 	GetRechargeOrdersByUserID(userID int64, status *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error)
-	GetAllRechargeOrders(userID *int64, status, paymentType *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error)
+	GetAllRechargeOrders(userID *int64, status, paymentType, verifyStatus *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error)
+	GetCryptoOrdersToVerify(limit int) ([]*model.OrbiaRechargeOrder, error)
+	IsTxHashConfirmed(tx *gorm.DB, txHash string, excludeOrderID string) (bool, error)
+	GetConfirmedOrderByTxHash(txHash string) (*model.OrbiaRechargeOrder, error)
+	GetPendingCryptoOrdersByAmount(amount money.Amount) ([]*model.OrbiaRechargeOrder, error)
 }
 
 // rechargeOrderRepository 充值订单仓库实现
@@ -53,11 +62,36 @@ func (r *rechargeOrderRepository) GetRechargeOrderByOrderID(orderID string) (*mo
 	return &order, nil
 }
 
+// GetRechargeOrderByOrderIDForUpdate 根据订单ID获取充值订单并加行锁（SELECT ... FOR UPDATE）
+// 必须在事务中调用，锁在事务结束时释放，用于防止同一订单被并发确认或拒绝
+func (r *rechargeOrderRepository) GetRechargeOrderByOrderIDForUpdate(tx *gorm.DB, orderID string) (*model.OrbiaRechargeOrder, error) {
+	if tx == nil {
+		return nil, errors.New("GetRechargeOrderByOrderIDForUpdate must be called within a transaction")
+	}
+
+	var order model.OrbiaRechargeOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
 // UpdateRechargeOrder 更新充值订单
 func (r *rechargeOrderRepository) UpdateRechargeOrder(order *model.OrbiaRechargeOrder) error {
 	return r.db.Save(order).Error
 }
 
+// UpdateRechargeOrderWithTx 更新充值订单（在事务中执行）
+func (r *rechargeOrderRepository) UpdateRechargeOrderWithTx(tx *gorm.DB, order *model.OrbiaRechargeOrder) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(order).Error
+}
+
 // GetRechargeOrdersByUserID 根据用户ID获取充值订单列表
 func (r *rechargeOrderRepository) GetRechargeOrdersByUserID(userID int64, status *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error) {
 	var orders []*model.OrbiaRechargeOrder
@@ -84,7 +118,7 @@ func (r *rechargeOrderRepository) GetRechargeOrdersByUserID(userID int64, status
 }
 
 // GetAllRechargeOrders 获取所有充值订单列表（管理员）
-func (r *rechargeOrderRepository) GetAllRechargeOrders(userID *int64, status, paymentType *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error) {
+func (r *rechargeOrderRepository) GetAllRechargeOrders(userID *int64, status, paymentType, verifyStatus *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error) {
 	var orders []*model.OrbiaRechargeOrder
 	var total int64
 
@@ -102,6 +136,10 @@ func (r *rechargeOrderRepository) GetAllRechargeOrders(userID *int64, status, pa
 		query = query.Where("payment_type = ?", *paymentType)
 	}
 
+	if verifyStatus != nil && *verifyStatus != "" {
+		query = query.Where("verify_status = ?", *verifyStatus)
+	}
+
 	// 获取总数
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count all recharge orders: %v", err)
@@ -115,3 +153,57 @@ func (r *rechargeOrderRepository) GetAllRechargeOrders(userID *int64, status, pa
 
 	return orders, total, nil
 }
+
+// GetCryptoOrdersToVerify 获取待链上校验的加密货币充值订单（待确认、已填写交易哈希、尚未被标记）
+func (r *rechargeOrderRepository) GetCryptoOrdersToVerify(limit int) ([]*model.OrbiaRechargeOrder, error) {
+	var orders []*model.OrbiaRechargeOrder
+	err := r.db.Where("status = ? AND payment_type = ? AND verify_status = ?", "pending", "crypto", "unverified").
+		Where("crypto_tx_hash IS NOT NULL AND crypto_tx_hash <> ''").
+		Order("id ASC").
+		Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// IsTxHashConfirmed 交易哈希是否已被其他已确认的充值订单使用（在事务中执行）
+// 仅用于提前给出明确的错误，并发确认由入账事务中登记交易哈希（orbia_chain_tx_claim 唯一索引）保证
+func (r *rechargeOrderRepository) IsTxHashConfirmed(tx *gorm.DB, txHash string, excludeOrderID string) (bool, error) {
+	if tx == nil {
+		tx = r.db
+	}
+
+	var count int64
+	err := tx.Model(&model.OrbiaRechargeOrder{}).
+		Where("crypto_tx_hash = ? AND status = ? AND order_id <> ?", txHash, "confirmed", excludeOrderID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetConfirmedOrderByTxHash 根据交易哈希获取已确认的充值订单
+func (r *rechargeOrderRepository) GetConfirmedOrderByTxHash(txHash string) (*model.OrbiaRechargeOrder, error) {
+	var order model.OrbiaRechargeOrder
+	err := r.db.Where("crypto_tx_hash = ? AND status = ?", txHash, "confirmed").First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// GetPendingCryptoOrdersByAmount 根据金额获取待确认的加密货币充值订单（按创建顺序）
+// 地址格式因链而异，收款地址和转出地址由调用方规范化后比较
+func (r *rechargeOrderRepository) GetPendingCryptoOrdersByAmount(amount money.Amount) ([]*model.OrbiaRechargeOrder, error) {
+	var orders []*model.OrbiaRechargeOrder
+	err := r.db.Where("status = ? AND payment_type = ? AND amount = ?", "pending", "crypto", amount).
+		Order("id ASC").
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
@@ -0,0 +1,219 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserTOTP 两步验证（TOTP）模型
+type UserTOTP struct {
+	ID           int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	UserID       int64      `gorm:"column:user_id;not null;uniqueIndex" json:"user_id"`
+	Secret       string     `gorm:"column:secret;size:255;not null" json:"-"` // 加密后的密钥
+	Status       string     `gorm:"column:status;type:enum('pending','enabled');not null;default:'pending'" json:"status"`
+	LastUsedStep int64      `gorm:"column:last_used_step;not null;default:0" json:"last_used_step"`
+	EnabledAt    *time.Time `gorm:"column:enabled_at" json:"enabled_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (UserTOTP) TableName() string {
+	return "orbia_user_totp"
+}
+
+// RecoveryCode 两步验证恢复码模型（只保存哈希）
+type RecoveryCode struct {
+	ID        int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	UserID    int64      `gorm:"column:user_id;not null" json:"user_id"`
+	CodeHash  string     `gorm:"column:code_hash;size:64;not null" json:"-"`
+	UsedAt    *time.Time `gorm:"column:used_at" json:"used_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (RecoveryCode) TableName() string {
+	return "orbia_user_recovery_code"
+}
+
+// MFAChallenge 两步验证登录挑战模型（只保存挑战令牌哈希）
+type MFAChallenge struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	TokenHash string    `gorm:"column:token_hash;size:64;not null;uniqueIndex" json:"-"`
+	UserID    int64     `gorm:"column:user_id;not null" json:"user_id"`
+	Attempts  int       `gorm:"column:attempts;not null;default:0" json:"attempts"`
+	Status    string    `gorm:"column:status;type:enum('pending','completed','expired');not null;default:'pending'" json:"status"`
+	ExpiresAt time.Time `gorm:"column:expires_at;not null" json:"expires_at"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (MFAChallenge) TableName() string {
+	return "orbia_mfa_challenge"
+}
+
+// TwoFactorRepository 两步验证仓储接口
+type TwoFactorRepository interface {
+	GetTOTPByUserID(userID int64) (*UserTOTP, error)
+	SaveTOTP(tx *gorm.DB, totp *UserTOTP) error
+	DeleteTOTP(tx *gorm.DB, userID int64) error
+	UseTOTPStep(tx *gorm.DB, id, step int64) (bool, error)
+	ReplaceRecoveryCodes(tx *gorm.DB, userID int64, codeHashes []string) error
+	UseRecoveryCode(tx *gorm.DB, userID int64, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(userID int64) (int64, error)
+	DeleteRecoveryCodes(tx *gorm.DB, userID int64) error
+	CreateChallenge(challenge *MFAChallenge) error
+	GetChallengeByHashForUpdate(tx *gorm.DB, tokenHash string) (*MFAChallenge, error)
+	UpdateChallenge(tx *gorm.DB, challenge *MFAChallenge) error
+}
+
+// twoFactorRepository 两步验证仓储实现
+type twoFactorRepository struct {
+	db *gorm.DB
+}
+
+// NewTwoFactorRepository 创建两步验证仓储实例
+func NewTwoFactorRepository(db *gorm.DB) TwoFactorRepository {
+	return &twoFactorRepository{db: db}
+}
+
+// GetTOTPByUserID 获取用户的两步验证设置
+func (r *twoFactorRepository) GetTOTPByUserID(userID int64) (*UserTOTP, error) {
+	var totp UserTOTP
+	err := r.db.Where("user_id = ?", userID).First(&totp).Error
+	if err != nil {
+		return nil, err
+	}
+	return &totp, nil
+}
+
+// SaveTOTP 创建或更新两步验证设置
+func (r *twoFactorRepository) SaveTOTP(tx *gorm.DB, totp *UserTOTP) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Save(totp).Error
+}
+
+// DeleteTOTP 删除用户的两步验证设置
+func (r *twoFactorRepository) DeleteTOTP(tx *gorm.DB, userID int64) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Where("user_id = ?", userID).Delete(&UserTOTP{}).Error
+}
+
+// UseTOTPStep 记录验证通过的时间步
+// 以条件更新保证同一时间步（及更早的时间步）的验证码只能使用一次，返回 false 表示验证码已被使用
+func (r *twoFactorRepository) UseTOTPStep(tx *gorm.DB, id, step int64) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	result := db.Model(&UserTOTP{}).
+		Where("id = ? AND last_used_step < ?", id, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ReplaceRecoveryCodes 删除用户原有的恢复码并保存新的恢复码
+func (r *twoFactorRepository) ReplaceRecoveryCodes(tx *gorm.DB, userID int64, codeHashes []string) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	if err := db.Where("user_id = ?", userID).Delete(&RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	codes := make([]*RecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &RecoveryCode{UserID: userID, CodeHash: hash})
+	}
+	return db.Create(&codes).Error
+}
+
+// UseRecoveryCode 将未使用的恢复码标记为已使用
+// 以条件更新保证并发请求中只有一个能使用成功，返回 false 表示恢复码不存在或已被使用
+func (r *twoFactorRepository) UseRecoveryCode(tx *gorm.DB, userID int64, codeHash string) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+
+	now := time.Now()
+	result := db.Model(&RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", &now)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CountUnusedRecoveryCodes 统计用户未使用的恢复码数量
+func (r *twoFactorRepository) CountUnusedRecoveryCodes(userID int64) (int64, error) {
+	var count int64
+	err := r.db.Model(&RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// DeleteRecoveryCodes 删除用户的所有恢复码
+func (r *twoFactorRepository) DeleteRecoveryCodes(tx *gorm.DB, userID int64) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Where("user_id = ?", userID).Delete(&RecoveryCode{}).Error
+}
+
+// CreateChallenge 创建登录挑战
+func (r *twoFactorRepository) CreateChallenge(challenge *MFAChallenge) error {
+	return r.db.Create(challenge).Error
+}
+
+// GetChallengeByHashForUpdate 根据挑战令牌哈希获取登录挑战并加行锁（必须在事务中调用）
+func (r *twoFactorRepository) GetChallengeByHashForUpdate(tx *gorm.DB, tokenHash string) (*MFAChallenge, error) {
+	if tx == nil {
+		return nil, errors.New("GetChallengeByHashForUpdate must be called within a transaction")
+	}
+
+	var challenge MFAChallenge
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ?", tokenHash).
+		First(&challenge).Error
+	if err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// UpdateChallenge 更新登录挑战的状态和错误次数
+func (r *twoFactorRepository) UpdateChallenge(tx *gorm.DB, challenge *MFAChallenge) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Model(&MFAChallenge{}).
+		Where("id = ?", challenge.ID).
+		Updates(map[string]interface{}{
+			"attempts": challenge.Attempts,
+			"status":   challenge.Status,
+		}).Error
+}
@@ -1,9 +1,11 @@
 package mysql
 
 import (
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // User 用户模型
@@ -38,9 +40,16 @@ type UserRepository interface {
 	GetUserByID(id int64) (*User, error)
 	UpdateUser(user *User) error
 	DeleteUser(id int64) error
+	// 登录方式绑定
+	LinkEmail(userID int64, email string) (bool, error)
+	LinkWalletAddress(userID int64, walletAddress string) (bool, error)
+	UnlinkEmail(userID int64) (bool, error)
+	UnlinkWalletAddress(userID int64) (bool, error)
 	// 管理员功能
 	GetAllUsers(keyword string, role string, status string, offset int, limit int) ([]*User, int64, error)
-	UpdateUserStatus(userID int64, status string) error
+	UpdateUserStatusWithTx(tx *gorm.DB, userID int64, status string) error
+	GetUserByIDForUpdate(tx *gorm.DB, id int64) (*User, error)
+	UpdateUserWithTx(tx *gorm.DB, user *User) error
 }
 
 // userRepository 用户仓储实现
@@ -135,9 +144,68 @@ func (r *userRepository) GetAllUsers(keyword string, role string, status string,
 	return users, total, err
 }
 
-// UpdateUserStatus 更新用户状态（管理员功能）
-func (r *userRepository) UpdateUserStatus(userID int64, status string) error {
-	return r.db.Model(&User{}).
+// UpdateUserStatusWithTx 更新用户状态（管理员功能，在事务中执行）
+func (r *userRepository) UpdateUserStatusWithTx(tx *gorm.DB, userID int64, status string) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Model(&User{}).
 		Where("id = ?", userID).
 		Update("status", status).Error
 }
+
+// LinkEmail 为用户绑定邮箱，仅在用户尚未绑定邮箱时生效，返回是否绑定成功
+func (r *userRepository) LinkEmail(userID int64, email string) (bool, error) {
+	result := r.db.Model(&User{}).
+		Where("id = ? AND email IS NULL", userID).
+		Update("email", email)
+	return result.RowsAffected > 0, result.Error
+}
+
+// LinkWalletAddress 为用户绑定钱包地址，仅在用户尚未绑定钱包时生效，返回是否绑定成功
+func (r *userRepository) LinkWalletAddress(userID int64, walletAddress string) (bool, error) {
+	result := r.db.Model(&User{}).
+		Where("id = ? AND wallet_address IS NULL", userID).
+		Update("wallet_address", walletAddress)
+	return result.RowsAffected > 0, result.Error
+}
+
+// UnlinkEmail 解绑邮箱，仅在用户还绑定了钱包时生效（至少保留一种登录方式），返回是否解绑成功
+func (r *userRepository) UnlinkEmail(userID int64) (bool, error) {
+	result := r.db.Model(&User{}).
+		Where("id = ? AND email IS NOT NULL AND wallet_address IS NOT NULL", userID).
+		Update("email", nil)
+	return result.RowsAffected > 0, result.Error
+}
+
+// UnlinkWalletAddress 解绑钱包地址，仅在用户还绑定了邮箱时生效（至少保留一种登录方式），返回是否解绑成功
+func (r *userRepository) UnlinkWalletAddress(userID int64) (bool, error) {
+	result := r.db.Model(&User{}).
+		Where("id = ? AND wallet_address IS NOT NULL AND email IS NOT NULL", userID).
+		Update("wallet_address", nil)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetUserByIDForUpdate 根据ID获取用户并加行锁（必须在事务中调用）
+func (r *userRepository) GetUserByIDForUpdate(tx *gorm.DB, id int64) (*User, error) {
+	if tx == nil {
+		return nil, errors.New("GetUserByIDForUpdate must be called within a transaction")
+	}
+
+	var user User
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UpdateUserWithTx 更新用户信息（在事务中执行）
+func (r *userRepository) UpdateUserWithTx(tx *gorm.DB, user *User) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Save(user).Error
+}
@@ -0,0 +1,94 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserRole 后台角色分配模型
+type UserRole struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex:uk_user_role" json:"user_id"`
+	Role      string    `gorm:"column:role;size:50;not null;uniqueIndex:uk_user_role" json:"role"`
+	GrantedBy *int64    `gorm:"column:granted_by" json:"granted_by"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (UserRole) TableName() string {
+	return "orbia_user_role"
+}
+
+// UserRoleRepository 后台角色分配仓储接口
+type UserRoleRepository interface {
+	GetRolesByUserID(userID int64) ([]*UserRole, error)
+	AssignRole(tx *gorm.DB, userRole *UserRole) error
+	RevokeRole(tx *gorm.DB, userID int64, role string) (bool, error)
+	CountRoles(tx *gorm.DB, userID int64) (int64, error)
+	CountUsersWithRoleForUpdate(tx *gorm.DB, role string) (int64, error)
+}
+
+// userRoleRepository 后台角色分配仓储实现
+type userRoleRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRoleRepository 创建后台角色分配仓储实例
+func NewUserRoleRepository(db *gorm.DB) UserRoleRepository {
+	return &userRoleRepository{db: db}
+}
+
+// GetRolesByUserID 获取用户的后台角色
+func (r *userRoleRepository) GetRolesByUserID(userID int64) ([]*UserRole, error) {
+	var roles []*UserRole
+	err := r.db.Where("user_id = ?", userID).Order("id ASC").Find(&roles).Error
+	return roles, err
+}
+
+// AssignRole 分配后台角色，已拥有该角色时不重复写入
+func (r *userRoleRepository) AssignRole(tx *gorm.DB, userRole *UserRole) error {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	return db.Where("user_id = ? AND role = ?", userRole.UserID, userRole.Role).
+		FirstOrCreate(userRole).Error
+}
+
+// RevokeRole 撤销后台角色，返回是否撤销成功
+func (r *userRoleRepository) RevokeRole(tx *gorm.DB, userID int64, role string) (bool, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	result := db.Where("user_id = ? AND role = ?", userID, role).Delete(&UserRole{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// CountRoles 统计用户的后台角色数量
+func (r *userRoleRepository) CountRoles(tx *gorm.DB, userID int64) (int64, error) {
+	db := r.db
+	if tx != nil {
+		db = tx
+	}
+	var count int64
+	err := db.Model(&UserRole{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// CountUsersWithRoleForUpdate 统计拥有某个后台角色的用户数量，并锁定这些角色分配记录（SELECT ... FOR UPDATE）
+// 必须在调用方事务中使用：并发撤销同一角色时会在此排队，后执行的事务读取到的是前一个事务提交后的数量
+func (r *userRoleRepository) CountUsersWithRoleForUpdate(tx *gorm.DB, role string) (int64, error) {
+	if tx == nil {
+		return 0, errors.New("CountUsersWithRoleForUpdate must be called within a transaction")
+	}
+
+	var userRoles []*UserRole
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role = ?", role).
+		Find(&userRoles).Error
+	return int64(len(userRoles)), err
+}
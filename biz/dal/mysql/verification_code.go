@@ -8,15 +8,16 @@ import (
 
 // VerificationCode 验证码模型
 type VerificationCode struct {
-	ID        int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
-	Email     string     `gorm:"column:email;size:255;not null" json:"email"`
-	Code      string     `gorm:"column:code;size:10;not null" json:"code"`
-	CodeType  string     `gorm:"column:code_type;type:enum('login','register','reset_password');not null;default:'login'" json:"code_type"`
-	Status    string     `gorm:"column:status;type:enum('unused','used','expired');not null;default:'unused'" json:"status"`
-	UsedAt    *time.Time `gorm:"column:used_at" json:"used_at"`
-	ExpiresAt time.Time  `gorm:"column:expires_at;not null" json:"expires_at"`
-	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
-	UpdatedAt time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+	ID             int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Email          string     `gorm:"column:email;size:255;not null" json:"email"`
+	Code           string     `gorm:"column:code;size:10;not null" json:"code"`
+	CodeType       string     `gorm:"column:code_type;type:enum('login','register','reset_password','link');not null;default:'login'" json:"code_type"`
+	Status         string     `gorm:"column:status;type:enum('unused','used','expired');not null;default:'unused'" json:"status"`
+	FailedAttempts int        `gorm:"column:failed_attempts;not null;default:0" json:"failed_attempts"`
+	UsedAt         *time.Time `gorm:"column:used_at" json:"used_at"`
+	ExpiresAt      time.Time  `gorm:"column:expires_at;not null" json:"expires_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
 }
 
 // TableName 指定表名
@@ -28,7 +29,9 @@ func (VerificationCode) TableName() string {
 type VerificationCodeRepository interface {
 	CreateVerificationCode(code *VerificationCode) error
 	GetValidVerificationCode(email, code, codeType string) (*VerificationCode, error)
-	MarkAsUsed(id int64) error
+	ConsumeCode(id int64) (bool, error)
+	RecordFailedAttempt(email, codeType string, maxAttempts int) error
+	ExpireUnusedCodes(email, codeType string) error
 	CleanExpiredCodes() error
 }
 
@@ -60,15 +63,39 @@ func (r *verificationCodeRepository) GetValidVerificationCode(email, code, codeT
 	return &verificationCode, nil
 }
 
-// MarkAsUsed 标记验证码为已使用
-func (r *verificationCodeRepository) MarkAsUsed(id int64) error {
+// ConsumeCode 将未使用且未过期的验证码标记为已使用
+// 以条件更新保证并发请求中只有一个能使用成功，返回 false 表示已被使用、已失效或已过期
+func (r *verificationCodeRepository) ConsumeCode(id int64) (bool, error) {
 	now := time.Now()
-	return r.db.Model(&VerificationCode{}).
-		Where("id = ?", id).
+	result := r.db.Model(&VerificationCode{}).
+		Where("id = ? AND status = ? AND expires_at > ?", id, "unused", now).
 		Updates(map[string]interface{}{
 			"status":  "used",
 			"used_at": &now,
-		}).Error
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RecordFailedAttempt 记录一次验证码输入错误
+// 该邮箱所有有效验证码的错误次数加一，达到 maxAttempts 后验证码失效
+func (r *verificationCodeRepository) RecordFailedAttempt(email, codeType string, maxAttempts int) error {
+	// MySQL 单表 UPDATE 按顺序赋值，status 判断使用的是加一后的 failed_attempts
+	return r.db.Exec(
+		"UPDATE orbia_verification_code SET failed_attempts = failed_attempts + 1, "+
+			"status = IF(failed_attempts >= ?, 'expired', status) "+
+			"WHERE email = ? AND code_type = ? AND status = ? AND expires_at > ?",
+		maxAttempts, email, codeType, "unused", time.Now(),
+	).Error
+}
+
+// ExpireUnusedCodes 使该邮箱之前发送的未使用验证码失效，同一时间只有最新的验证码有效
+func (r *verificationCodeRepository) ExpireUnusedCodes(email, codeType string) error {
+	return r.db.Model(&VerificationCode{}).
+		Where("email = ? AND code_type = ? AND status = ?", email, codeType, "unused").
+		Update("status", "expired").Error
 }
 
 // CleanExpiredCodes 清理过期的验证码
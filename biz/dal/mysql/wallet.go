@@ -5,16 +5,19 @@ import (
 	"fmt"
 
 	"orbia_api/biz/dal/model"
+	"orbia_api/biz/utils/money"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // WalletRepository 钱包仓库接口
 type WalletRepository interface {
 	CreateWallet(wallet *model.OrbiaWallet) error
 	GetWalletByUserID(userID int64) (*model.OrbiaWallet, error)
-	UpdateWallet(wallet *model.OrbiaWallet) error
-	UpdateBalance(tx *gorm.DB, userID int64, balanceDelta float64, frozenDelta float64) error
+	GetWalletByUserIDWithTx(tx *gorm.DB, userID int64) (*model.OrbiaWallet, error)
+	GetWalletByUserIDForUpdate(tx *gorm.DB, userID int64) (*model.OrbiaWallet, error)
+	UpdateWalletTotals(tx *gorm.DB, userID int64, rechargeDelta money.Amount, consumeDelta money.Amount) error
 }
 
 // TransactionRepository 交易记录仓库接口
@@ -50,36 +53,51 @@ func (r *walletRepository) GetWalletByUserID(userID int64) (*model.OrbiaWallet,
 	return &wallet, nil
 }
 
-// UpdateWallet 更新钱包
-func (r *walletRepository) UpdateWallet(wallet *model.OrbiaWallet) error {
-	return r.db.Save(wallet).Error
+// GetWalletByUserIDWithTx 根据用户ID获取钱包（在事务中执行）
+func (r *walletRepository) GetWalletByUserIDWithTx(tx *gorm.DB, userID int64) (*model.OrbiaWallet, error) {
+	if tx == nil {
+		tx = r.db
+	}
+
+	var wallet model.OrbiaWallet
+	err := tx.Where("user_id = ?", userID).First(&wallet).Error
+	if err != nil {
+		return nil, err
+	}
+	return &wallet, nil
 }
 
-// UpdateBalance 更新余额（在事务中执行）
-func (r *walletRepository) UpdateBalance(tx *gorm.DB, userID int64, balanceDelta float64, frozenDelta float64) error {
+// GetWalletByUserIDForUpdate 根据用户ID获取钱包并加行锁（SELECT ... FOR UPDATE）
+// 必须在调用方事务中使用：余额检查、记账和交易记录的前后余额都基于加锁后的数据，
+// 同一钱包的并发扣款会在此排队，直到前一个事务提交或回滚
+func (r *walletRepository) GetWalletByUserIDForUpdate(tx *gorm.DB, userID int64) (*model.OrbiaWallet, error) {
 	if tx == nil {
-		tx = r.db
+		return nil, errors.New("GetWalletByUserIDForUpdate must be called within a transaction")
 	}
 
-	// 使用乐观锁更新余额
-	result := tx.Model(&model.OrbiaWallet{}).
+	var wallet model.OrbiaWallet
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
 		Where("user_id = ?", userID).
-		Where("balance + ? >= 0", balanceDelta).       // 确保余额不会为负
-		Where("frozen_balance + ? >= 0", frozenDelta). // 确保冻结余额不会为负
-		Updates(map[string]interface{}{
-			"balance":        gorm.Expr("balance + ?", balanceDelta),
-			"frozen_balance": gorm.Expr("frozen_balance + ?", frozenDelta),
-		})
-
-	if result.Error != nil {
-		return result.Error
+		First(&wallet).Error
+	if err != nil {
+		return nil, err
 	}
+	return &wallet, nil
+}
 
-	if result.RowsAffected == 0 {
-		return errors.New("insufficient balance or wallet not found")
+// UpdateWalletTotals 更新累计充值和累计消费金额（在事务中执行）
+// 余额变动必须通过账本（LedgerRepository）完成，这里只维护统计字段
+func (r *walletRepository) UpdateWalletTotals(tx *gorm.DB, userID int64, rechargeDelta money.Amount, consumeDelta money.Amount) error {
+	if tx == nil {
+		tx = r.db
 	}
 
-	return nil
+	return tx.Model(&model.OrbiaWallet{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"total_recharge": addAmountExpr("total_recharge", rechargeDelta),
+			"total_consume":  addAmountExpr("total_consume", consumeDelta),
+		}).Error
 }
 
 // transactionRepository 交易记录仓库实现
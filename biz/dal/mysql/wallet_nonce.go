@@ -0,0 +1,82 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WalletNonce 钱包登录随机数模型
+type WalletNonce struct {
+	ID            int64      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Nonce         string     `gorm:"column:nonce;size:64;not null;uniqueIndex" json:"nonce"`
+	WalletAddress string     `gorm:"column:wallet_address;size:42;not null" json:"wallet_address"`
+	ChainID       int64      `gorm:"column:chain_id;not null" json:"chain_id"`
+	Message       string     `gorm:"column:message;type:text;not null" json:"message"`
+	Status        string     `gorm:"column:status;type:enum('unused','used','expired');not null;default:'unused'" json:"status"`
+	UsedAt        *time.Time `gorm:"column:used_at" json:"used_at"`
+	ExpiresAt     time.Time  `gorm:"column:expires_at;not null" json:"expires_at"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (WalletNonce) TableName() string {
+	return "orbia_wallet_nonce"
+}
+
+// WalletNonceRepository 钱包登录随机数仓储接口
+type WalletNonceRepository interface {
+	CreateNonce(nonce *WalletNonce) error
+	GetNonce(nonce string) (*WalletNonce, error)
+	ConsumeNonce(id int64) (bool, error)
+	CleanExpiredNonces() error
+}
+
+// walletNonceRepository 钱包登录随机数仓储实现
+type walletNonceRepository struct {
+	db *gorm.DB
+}
+
+// NewWalletNonceRepository 创建钱包登录随机数仓储实例
+func NewWalletNonceRepository(db *gorm.DB) WalletNonceRepository {
+	return &walletNonceRepository{db: db}
+}
+
+// CreateNonce 创建随机数
+func (r *walletNonceRepository) CreateNonce(nonce *WalletNonce) error {
+	return r.db.Create(nonce).Error
+}
+
+// GetNonce 根据随机数获取记录
+func (r *walletNonceRepository) GetNonce(nonce string) (*WalletNonce, error) {
+	var walletNonce WalletNonce
+	err := r.db.Where("nonce = ?", nonce).First(&walletNonce).Error
+	if err != nil {
+		return nil, err
+	}
+	return &walletNonce, nil
+}
+
+// ConsumeNonce 将未使用且未过期的随机数标记为已使用
+// 以条件更新保证并发请求中只有一个能使用成功，返回 false 表示已被使用或已过期
+func (r *walletNonceRepository) ConsumeNonce(id int64) (bool, error) {
+	now := time.Now()
+	result := r.db.Model(&WalletNonce{}).
+		Where("id = ? AND status = ? AND expires_at > ?", id, "unused", now).
+		Updates(map[string]interface{}{
+			"status":  "used",
+			"used_at": &now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CleanExpiredNonces 清理过期的随机数
+func (r *walletNonceRepository) CleanExpiredNonces() error {
+	return r.db.Model(&WalletNonce{}).
+		Where("status = ? AND expires_at < ?", "unused", time.Now()).
+		Update("status", "expired").Error
+}
@@ -0,0 +1,138 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+
+	"orbia_api/biz/dal/model"
+
+	"gorm.io/gorm"
+)
+
+// WithdrawalOrderRepository 提现订单仓库接口
+type WithdrawalOrderRepository interface {
+	CreateWithdrawalOrder(tx *gorm.DB, order *model.OrbiaWithdrawalOrder) error
+	GetWithdrawalOrderByOrderID(orderID string) (*model.OrbiaWithdrawalOrder, error)
+	UpdatePendingWithdrawalOrder(tx *gorm.DB, order *model.OrbiaWithdrawalOrder) error
+	GetWithdrawalOrdersByKolID(kolID int64, status *string, page, pageSize int) ([]*model.OrbiaWithdrawalOrder, int64, error)
+	GetAllWithdrawalOrders(kolID, userID *int64, status, network *string, page, pageSize int) ([]*model.OrbiaWithdrawalOrder, int64, error)
+}
+
+// withdrawalOrderRepository 提现订单仓库实现
+type withdrawalOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewWithdrawalOrderRepository 创建提现订单仓库实例
+func NewWithdrawalOrderRepository(db *gorm.DB) WithdrawalOrderRepository {
+	return &withdrawalOrderRepository{db: db}
+}
+
+// CreateWithdrawalOrder 创建提现订单（在事务中执行）
+func (r *withdrawalOrderRepository) CreateWithdrawalOrder(tx *gorm.DB, order *model.OrbiaWithdrawalOrder) error {
+	if tx == nil {
+		tx = r.db
+	}
+	return tx.Create(order).Error
+}
+
+// GetWithdrawalOrderByOrderID 根据订单ID获取提现订单
+func (r *withdrawalOrderRepository) GetWithdrawalOrderByOrderID(orderID string) (*model.OrbiaWithdrawalOrder, error) {
+	var order model.OrbiaWithdrawalOrder
+	err := r.db.Where("order_id = ?", orderID).First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// UpdatePendingWithdrawalOrder 更新待处理的提现订单（在事务中执行）
+// 仅当订单仍处于 pending 状态时更新，防止重复处理
+func (r *withdrawalOrderRepository) UpdatePendingWithdrawalOrder(tx *gorm.DB, order *model.OrbiaWithdrawalOrder) error {
+	if tx == nil {
+		tx = r.db
+	}
+
+	result := tx.Model(&model.OrbiaWithdrawalOrder{}).
+		Where("order_id = ? AND status = ?", order.OrderID, "pending").
+		Updates(map[string]interface{}{
+			"status":          order.Status,
+			"crypto_tx_hash":  order.CryptoTxHash,
+			"confirmed_by":    order.ConfirmedBy,
+			"confirmed_at":    order.ConfirmedAt,
+			"rejected_reason": order.RejectedReason,
+			"remark":          order.Remark,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("withdrawal order has already been processed")
+	}
+
+	return nil
+}
+
+// GetWithdrawalOrdersByKolID 根据KOL ID获取提现订单列表
+func (r *withdrawalOrderRepository) GetWithdrawalOrdersByKolID(kolID int64, status *string, page, pageSize int) ([]*model.OrbiaWithdrawalOrder, int64, error) {
+	var orders []*model.OrbiaWithdrawalOrder
+	var total int64
+
+	query := r.db.Model(&model.OrbiaWithdrawalOrder{}).Where("kol_id = ?", kolID)
+
+	if status != nil && *status != "" {
+		query = query.Where("status = ?", *status)
+	}
+
+	// 获取总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count withdrawal orders: %v", err)
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC").Limit(pageSize).Offset(offset).Find(&orders).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to query withdrawal orders: %v", err)
+	}
+
+	return orders, total, nil
+}
+
+// GetAllWithdrawalOrders 获取所有提现订单列表（管理员）
+func (r *withdrawalOrderRepository) GetAllWithdrawalOrders(kolID, userID *int64, status, network *string, page, pageSize int) ([]*model.OrbiaWithdrawalOrder, int64, error) {
+	var orders []*model.OrbiaWithdrawalOrder
+	var total int64
+
+	query := r.db.Model(&model.OrbiaWithdrawalOrder{})
+
+	if kolID != nil && *kolID > 0 {
+		query = query.Where("kol_id = ?", *kolID)
+	}
+
+	if userID != nil && *userID > 0 {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	if status != nil && *status != "" {
+		query = query.Where("status = ?", *status)
+	}
+
+	if network != nil && *network != "" {
+		query = query.Where("network = ?", *network)
+	}
+
+	// 获取总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count all withdrawal orders: %v", err)
+	}
+
+	// 分页查询，按创建时间倒序
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC").Limit(pageSize).Offset(offset).Find(&orders).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to query all withdrawal orders: %v", err)
+	}
+
+	return orders, total, nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"orbia_api/biz/infra/config"
+)
+
+var Client *redis.Client
+
+// Init 初始化 Redis 连接
+func Init() error {
+	cfg := config.GlobalConfig.Redis
+
+	Client = redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Password: cfg.Password,
+		DB:       cfg.DB,
+		PoolSize: cfg.PoolSize,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := Client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to connect redis: %v", err)
+	}
+
+	log.Println("✅ Redis connected successfully")
+	return nil
+}
+
+// Close 关闭 Redis 连接
+func Close() error {
+	if Client != nil {
+		return Client.Close()
+	}
+	return nil
+}
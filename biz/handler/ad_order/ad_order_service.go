@@ -32,6 +32,15 @@ func CreateAdOrder(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 团队API Key只能为所属团队下单
+	if apiKey, isAPIKey := mw.GetAuthAPIKey(c); isAPIKey {
+		if req.TeamID != nil && *req.TeamID != apiKey.TeamID {
+			utils.Error(c, apiconsts.ForbiddenCode, "团队ID与API Key所属团队不一致")
+			return
+		}
+		req.TeamID = &apiKey.TeamID
+	}
+
 	// 调用 service 层
 	resp, err := adOrderService.CreateAdOrder(userID, &req)
 	if err != nil {
@@ -60,8 +69,8 @@ func GetAdOrder(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	role, _ := mw.GetAuthUserRole(c)
-	isAdmin := role == "admin"
+	// 拥有订单查看权限的后台用户可以查看任意订单
+	isAdmin := mw.HasPermission(c, apiconsts.PermOrderRead)
 
 	// 调用 service 层
 	resp, err := adOrderService.GetAdOrder(userID, isAdmin, &req)
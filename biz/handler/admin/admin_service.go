@@ -4,10 +4,15 @@ package admin
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"orbia_api/biz/dal/mysql"
 	admin "orbia_api/biz/model/admin"
+	"orbia_api/biz/mw"
 	adminService "orbia_api/biz/service/admin"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/ledger"
 	"orbia_api/biz/utils"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -25,7 +30,12 @@ func InitAdminService() {
 	walletRepo := mysql.NewWalletRepository(mysql.DB)
 	campaignRepo := mysql.NewCampaignRepository(mysql.DB)
 	txRepo := mysql.NewTransactionRepository(mysql.DB)
-	adminSvc = adminService.NewAdminService(userRepo, kolRepo, teamRepo, orderRepo, walletRepo, campaignRepo, txRepo, mysql.DB)
+	ledgerSvc := ledger.NewLedgerService(mysql.DB, mysql.NewLedgerRepository(mysql.DB))
+	sessionRepo := mysql.NewAuthSessionRepository(mysql.DB)
+	mergeRepo := mysql.NewAccountMergeRepository(mysql.DB)
+	userRoleRepo := mysql.NewUserRoleRepository(mysql.DB)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+	adminSvc = adminService.NewAdminService(userRepo, kolRepo, teamRepo, orderRepo, walletRepo, campaignRepo, txRepo, ledgerSvc, sessionRepo, mergeRepo, userRoleRepo, auditSvc, mysql.DB)
 }
 
 // GetAllUsers .
@@ -265,3 +275,181 @@ func AddCampaignConsume(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// ReconcileWallets .
+// @router /api/v1/admin/wallet/reconcile [POST]
+func ReconcileWallets(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.ReconcileWalletsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	serviceReq := &admin.ReconcileWalletsReq{
+		UserID: req.UserID,
+	}
+
+	resp, err := adminSvc.ReconcileWallets(ctx, serviceReq)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// MergeUsers 合并用户账号
+// @router /api/v1/admin/user/merge [POST]
+func MergeUsers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.MergeUsersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := adminSvc.MergeUsers(ctx, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListRoles .
+// @router /api/v1/admin/roles [POST]
+func ListRoles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.ListRolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := adminSvc.ListRoles(ctx, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetUserRoles .
+// @router /api/v1/admin/user/:user_id/roles [POST]
+func GetUserRoles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.GetUserRolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := adminSvc.GetUserRoles(ctx, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AssignUserRole .
+// @router /api/v1/admin/user/role/assign [POST]
+func AssignUserRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.UpdateUserRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	operatorID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(401, "User not authenticated"))
+		return
+	}
+
+	resp, err := adminSvc.AssignUserRole(ctx, operatorID, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeUserRole .
+// @router /api/v1/admin/user/role/revoke [POST]
+func RevokeUserRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.UpdateUserRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	operatorID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(401, "User not authenticated"))
+		return
+	}
+
+	resp, err := adminSvc.RevokeUserRole(ctx, operatorID, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetAuditLogs .
+// @router /api/v1/admin/audit/logs [POST]
+func GetAuditLogs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.GetAuditLogsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := adminSvc.GetAuditLogs(ctx, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ExportAuditLogs .
+// @router /api/v1/admin/audit/export [POST]
+func ExportAuditLogs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.GetAuditLogsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := adminSvc.ExportAuditLogs(ctx, &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, utils.BuildErrorResp(500, err.Error()))
+		return
+	}
+
+	filename := fmt.Sprintf("audit_logs_%s.csv", time.Now().Format("20060102150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(consts.StatusOK, "text/csv; charset=utf-8", data)
+}
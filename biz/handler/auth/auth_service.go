@@ -5,20 +5,27 @@ package auth
 import (
 	"context"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
 	auth "orbia_api/biz/model/auth"
 	"orbia_api/biz/model/common"
+	"orbia_api/biz/mw"
 	authService "orbia_api/biz/service/auth"
+	"orbia_api/biz/service/twofactor"
 	walletService "orbia_api/biz/service/wallet"
+	"orbia_api/biz/utils"
 )
 
 var (
-	authSvc authService.AuthService
+	authSvc      authService.AuthService
+	twoFactorSvc twofactor.TwoFactorService
 )
 
 // InitAuthService 初始化认证服务
@@ -29,8 +36,24 @@ func InitAuthService() {
 	walletRepo := mysql.NewWalletRepository(db)
 	txRepo := mysql.NewTransactionRepository(db)
 	verificationRepo := mysql.NewVerificationCodeRepository(db)
+	nonceRepo := mysql.NewWalletNonceRepository(db)
+	sessionRepo := mysql.NewAuthSessionRepository(db)
 	walletSvc := walletService.NewWalletService(db, walletRepo, txRepo)
-	authSvc = authService.NewAuthService(userRepo, teamRepo, walletSvc, verificationRepo)
+	twoFactorSvc = twofactor.NewTwoFactorService(db, mysql.NewTwoFactorRepository(db), userRepo, sessionRepo)
+	authSvc = authService.NewAuthService(db, userRepo, teamRepo, walletSvc, verificationRepo, nonceRepo, sessionRepo, twoFactorSvc)
+	authService.StartNonceCleanupJob(authSvc, time.Duration(config.GlobalConfig.SIWE.NonceCleanupIntervalMinutes)*time.Minute)
+}
+
+// DeviceIDHeader 客户端设备标识请求头，用于识别新设备登录；未提供时以 User-Agent 识别
+const DeviceIDHeader = "X-Device-ID"
+
+// clientInfo 从请求中提取登录客户端信息
+func clientInfo(c *app.RequestContext) *authService.ClientInfo {
+	return &authService.ClientInfo{
+		DeviceID:  strings.TrimSpace(string(c.GetHeader(DeviceIDHeader))),
+		UserAgent: string(c.UserAgent()),
+		IP:        c.ClientIP(),
+	}
 }
 
 // WalletLogin 钱包登录
@@ -51,11 +74,7 @@ func WalletLogin(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 调用服务层处理登录逻辑
-	var message string
-	if req.Message != nil {
-		message = *req.Message
-	}
-	token, expiresIn, err := authSvc.WalletLogin(req.WalletAddress, req.Signature, message)
+	result, err := authSvc.WalletLogin(req.WalletAddress, req.Signature, req.Message, clientInfo(c))
 	if err != nil {
 		hlog.Errorf("WalletLogin service error: %v", err)
 		c.JSON(http.StatusUnauthorized, &auth.WalletLoginResp{
@@ -67,9 +86,25 @@ func WalletLogin(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 已启用两步验证：返回挑战令牌，凭验证码调用 /auth/2fa/verify 完成登录
+	if result.MFARequired {
+		c.JSON(consts.StatusOK, &auth.WalletLoginResp{
+			MfaRequired:  true,
+			MfaToken:     result.MFAToken,
+			MfaExpiresIn: result.MFAExpiresIn,
+			BaseResp: &common.BaseResp{
+				Code:    200,
+				Message: "Two-factor verification required",
+			},
+		})
+		return
+	}
+
 	resp := &auth.WalletLoginResp{
-		Token:     token,
-		ExpiresIn: expiresIn,
+		Token:            result.Tokens.AccessToken,
+		ExpiresIn:        result.Tokens.ExpiresIn,
+		RefreshToken:     result.Tokens.RefreshToken,
+		RefreshExpiresIn: result.Tokens.RefreshExpiresIn,
 		BaseResp: &common.BaseResp{
 			Code:    200,
 			Message: "Login successful",
@@ -97,7 +132,7 @@ func EmailLogin(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 调用服务层处理登录逻辑
-	token, expiresIn, err := authSvc.EmailLogin(req.Email, req.Code)
+	result, err := authSvc.EmailLogin(req.Email, req.Code, clientInfo(c))
 	if err != nil {
 		hlog.Errorf("EmailLogin service error: %v", err)
 		c.JSON(http.StatusUnauthorized, &auth.EmailLoginResp{
@@ -109,9 +144,25 @@ func EmailLogin(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 已启用两步验证：返回挑战令牌，凭验证码调用 /auth/2fa/verify 完成登录
+	if result.MFARequired {
+		c.JSON(consts.StatusOK, &auth.EmailLoginResp{
+			MfaRequired:  true,
+			MfaToken:     result.MFAToken,
+			MfaExpiresIn: result.MFAExpiresIn,
+			BaseResp: &common.BaseResp{
+				Code:    200,
+				Message: "Two-factor verification required",
+			},
+		})
+		return
+	}
+
 	resp := &auth.EmailLoginResp{
-		Token:     token,
-		ExpiresIn: expiresIn,
+		Token:            result.Tokens.AccessToken,
+		ExpiresIn:        result.Tokens.ExpiresIn,
+		RefreshToken:     result.Tokens.RefreshToken,
+		RefreshExpiresIn: result.Tokens.RefreshExpiresIn,
 		BaseResp: &common.BaseResp{
 			Code:    200,
 			Message: "Login successful",
@@ -166,3 +217,571 @@ func SendVerificationCode(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// WalletChallenge 获取钱包登录挑战（EIP-4361 消息）
+// @router /api/v1/auth/wallet-challenge [POST]
+func WalletChallenge(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.WalletChallengeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("WalletChallenge bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.WalletChallengeResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	var chainID int64
+	if req.ChainID != nil {
+		chainID = *req.ChainID
+	}
+	challenge, err := authSvc.CreateWalletChallenge(req.WalletAddress, chainID)
+	if err != nil {
+		hlog.Errorf("WalletChallenge service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.WalletChallengeResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp := &auth.WalletChallengeResp{
+		Message:   challenge.Message,
+		Nonce:     challenge.Nonce,
+		ExpiresAt: utils.FormatTime(&challenge.ExpiresAt),
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Success",
+		},
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RefreshToken 刷新访问令牌
+// @router /api/v1/auth/refresh [POST]
+func RefreshToken(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.RefreshTokenReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("RefreshToken bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.RefreshTokenResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	tokens, err := authSvc.RefreshToken(req.RefreshToken)
+	if err != nil {
+		hlog.Warnf("RefreshToken service error: %v", err)
+		c.JSON(http.StatusUnauthorized, &auth.RefreshTokenResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp := &auth.RefreshTokenResp{
+		Token:            tokens.AccessToken,
+		ExpiresIn:        tokens.ExpiresIn,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: tokens.RefreshExpiresIn,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Token refreshed",
+		},
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Logout 退出登录（吊销当前会话）
+// @router /api/v1/auth/logout [POST]
+func Logout(ctx context.Context, c *app.RequestContext) {
+	sessionID, exists := mw.GetAuthSessionID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.LogoutResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	if err := authSvc.Logout(sessionID); err != nil {
+		hlog.Errorf("Logout service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &auth.LogoutResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &auth.LogoutResp{
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Logout successful",
+		},
+	})
+}
+
+// LogoutAll 退出所有会话（吊销用户的全部会话）
+// @router /api/v1/auth/logout-all [POST]
+func LogoutAll(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.LogoutResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	if err := authSvc.LogoutAll(userID); err != nil {
+		hlog.Errorf("LogoutAll service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &auth.LogoutResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &auth.LogoutResp{
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "All sessions logged out",
+		},
+	})
+}
+
+// LinkEmail 为当前账号绑定邮箱
+// @router /api/v1/auth/link-email [POST]
+func LinkEmail(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	var err error
+	var req auth.LinkEmailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("LinkEmail bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	user, err := authSvc.LinkEmail(userID, req.Email, req.Code)
+	if err != nil {
+		hlog.Errorf("LinkEmail service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, buildLinkAccountResp(user, "Email linked successfully"))
+}
+
+// LinkWallet 为当前账号绑定钱包
+// @router /api/v1/auth/link-wallet [POST]
+func LinkWallet(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	var err error
+	var req auth.LinkWalletReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("LinkWallet bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	user, err := authSvc.LinkWallet(userID, req.WalletAddress, req.Signature, req.Message)
+	if err != nil {
+		hlog.Errorf("LinkWallet service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, buildLinkAccountResp(user, "Wallet linked successfully"))
+}
+
+// UnlinkLoginMethod 解绑登录方式
+// @router /api/v1/auth/unlink [POST]
+func UnlinkLoginMethod(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	var err error
+	var req auth.UnlinkLoginMethodReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("UnlinkLoginMethod bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	user, err := authSvc.UnlinkLoginMethod(userID, req.Method)
+	if err != nil {
+		hlog.Errorf("UnlinkLoginMethod service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.LinkAccountResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, buildLinkAccountResp(user, "Login method unlinked successfully"))
+}
+
+// buildLinkAccountResp 构建绑定/解绑响应，返回账号当前的登录方式
+func buildLinkAccountResp(user *mysql.User, message string) *auth.LinkAccountResp {
+	return &auth.LinkAccountResp{
+		Email:         user.Email,
+		WalletAddress: user.WalletAddress,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: message,
+		},
+	}
+}
+
+// VerifyTwoFactorLogin 两步验证登录（登录第二步）
+// @router /api/v1/auth/2fa/verify [POST]
+func VerifyTwoFactorLogin(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.TwoFactorLoginReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("VerifyTwoFactorLogin bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorLoginResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	tokens, err := authSvc.VerifyTwoFactorLogin(req.MfaToken, req.Code, clientInfo(c))
+	if err != nil {
+		hlog.Warnf("VerifyTwoFactorLogin service error: %v", err)
+		c.JSON(http.StatusUnauthorized, &auth.TwoFactorLoginResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &auth.TwoFactorLoginResp{
+		Token:            tokens.AccessToken,
+		ExpiresIn:        tokens.ExpiresIn,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: tokens.RefreshExpiresIn,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Login successful",
+		},
+	})
+}
+
+// GetTwoFactorStatus 获取两步验证状态
+// @router /api/v1/auth/2fa/status [POST]
+func GetTwoFactorStatus(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.TwoFactorStatusResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	status, err := twoFactorSvc.GetStatus(userID)
+	if err != nil {
+		hlog.Errorf("GetTwoFactorStatus service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &auth.TwoFactorStatusResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, buildTwoFactorStatusResp(status, "Success"))
+}
+
+// SetupTwoFactor 开始启用两步验证，下发 TOTP 密钥
+// @router /api/v1/auth/2fa/setup [POST]
+func SetupTwoFactor(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.SetupTwoFactorResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	info, err := twoFactorSvc.Setup(userID)
+	if err != nil {
+		hlog.Errorf("SetupTwoFactor service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.SetupTwoFactorResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &auth.SetupTwoFactorResp{
+		Secret:     info.Secret,
+		OtpauthURL: info.OTPAuthURL,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Scan the QR code with your authenticator app, then confirm with a code",
+		},
+	})
+}
+
+// EnableTwoFactor 校验验证码后启用两步验证，返回恢复码
+// @router /api/v1/auth/2fa/enable [POST]
+func EnableTwoFactor(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.TwoFactorRecoveryCodesResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	var err error
+	var req auth.TwoFactorCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("EnableTwoFactor bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorRecoveryCodesResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	sessionID, _ := mw.GetAuthSessionID(c)
+	codes, err := twoFactorSvc.Enable(userID, sessionID, req.Code)
+	if err != nil {
+		hlog.Errorf("EnableTwoFactor service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorRecoveryCodesResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &auth.TwoFactorRecoveryCodesResp{
+		RecoveryCodes: codes,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Two-factor authentication enabled",
+		},
+	})
+}
+
+// DisableTwoFactor 校验验证码后关闭两步验证（管理员不能关闭）
+// @router /api/v1/auth/2fa/disable [POST]
+func DisableTwoFactor(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.TwoFactorStatusResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	var err error
+	var req auth.TwoFactorCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("DisableTwoFactor bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorStatusResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := twoFactorSvc.Disable(userID, req.Code); err != nil {
+		hlog.Errorf("DisableTwoFactor service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorStatusResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	status, err := twoFactorSvc.GetStatus(userID)
+	if err != nil {
+		hlog.Errorf("GetTwoFactorStatus service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &auth.TwoFactorStatusResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, buildTwoFactorStatusResp(status, "Two-factor authentication disabled"))
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，原有恢复码全部失效
+// @router /api/v1/auth/2fa/recovery-codes [POST]
+func RegenerateRecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &auth.TwoFactorRecoveryCodesResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	var err error
+	var req auth.TwoFactorCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("RegenerateRecoveryCodes bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorRecoveryCodesResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	codes, err := twoFactorSvc.RegenerateRecoveryCodes(userID, req.Code)
+	if err != nil {
+		hlog.Errorf("RegenerateRecoveryCodes service error: %v", err)
+		c.JSON(http.StatusBadRequest, &auth.TwoFactorRecoveryCodesResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &auth.TwoFactorRecoveryCodesResp{
+		RecoveryCodes: codes,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Recovery codes regenerated",
+		},
+	})
+}
+
+// buildTwoFactorStatusResp 构建两步验证状态响应
+func buildTwoFactorStatusResp(status *twofactor.Status, message string) *auth.TwoFactorStatusResp {
+	return &auth.TwoFactorStatusResp{
+		Enabled:                status.Enabled,
+		Required:               status.Required,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: message,
+		},
+	}
+}
@@ -12,8 +12,10 @@ import (
 	campaignModel "orbia_api/biz/model/campaign"
 	commonModel "orbia_api/biz/model/common"
 	"orbia_api/biz/mw"
+	"orbia_api/biz/service/audit"
 	campaignService "orbia_api/biz/service/campaign"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 )
 
 var (
@@ -25,7 +27,8 @@ func InitCampaignService() {
 	campaignRepo := mysql.NewCampaignRepository(mysql.DB)
 	userRepo := mysql.NewUserRepository(mysql.DB)
 	teamRepo := mysql.NewTeamRepository(mysql.DB)
-	svc = campaignService.NewCampaignService(campaignRepo, userRepo, teamRepo)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+	svc = campaignService.NewCampaignService(mysql.DB, campaignRepo, userRepo, teamRepo, auditSvc)
 }
 
 // CreateCampaign 创建Campaign
@@ -52,6 +55,12 @@ func CreateCampaign(ctx context.Context, c *app.RequestContext) {
 
 	teamID := *user.CurrentTeamID
 
+	budgetAmount, err := money.Parse(req.BudgetAmount)
+	if err != nil {
+		utils.ParamError(c, "Invalid budget amount")
+		return
+	}
+
 	// 构建service请求
 	serviceReq := &campaignService.CreateCampaignRequest{
 		CampaignName:       req.CampaignName,
@@ -78,7 +87,7 @@ func CreateCampaign(ctx context.Context, c *app.RequestContext) {
 		FrequencyCapTimes:  req.FrequencyCapTimes,
 		FrequencyCapDays:   req.FrequencyCapDays,
 		BudgetType:         int8(req.BudgetType),
-		BudgetAmount:       req.BudgetAmount,
+		BudgetAmount:       budgetAmount,
 		Website:            req.Website,
 		IOSDownloadURL:     req.IosDownloadURL,
 		AndroidDownloadURL: req.AndroidDownloadURL,
@@ -120,6 +129,10 @@ func UpdateCampaign(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	if !checkAPIKeyCampaignTeam(c, userID, req.CampaignID) {
+		return
+	}
+
 	// 构建service请求
 	serviceReq := &campaignService.UpdateCampaignRequest{
 		CampaignName:       req.CampaignName,
@@ -181,7 +194,12 @@ func UpdateCampaign(ctx context.Context, c *app.RequestContext) {
 		serviceReq.BudgetType = &budgetType
 	}
 	if req.BudgetAmount != nil {
-		serviceReq.BudgetAmount = req.BudgetAmount
+		budgetAmount, err := money.Parse(*req.BudgetAmount)
+		if err != nil {
+			utils.ParamError(c, "Invalid budget amount")
+			return
+		}
+		serviceReq.BudgetAmount = &budgetAmount
 	}
 
 	// 调用service更新Campaign
@@ -219,6 +237,10 @@ func UpdateCampaignStatus(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	if !checkAPIKeyCampaignTeam(c, userID, req.CampaignID) {
+		return
+	}
+
 	// 调用service更新状态
 	if err := svc.UpdateCampaignStatus(userID, req.CampaignID, req.Status); err != nil {
 		utils.Error(c, 500, err.Error())
@@ -331,6 +353,12 @@ func GetCampaign(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 团队API Key只能访问所属团队的Campaign
+	if apiKey, isAPIKey := mw.GetAuthAPIKey(c); isAPIKey && campaign.TeamID != apiKey.TeamID {
+		utils.Error(c, 403, "Campaign does not belong to the API key's team")
+		return
+	}
+
 	resp := &campaignModel.GetCampaignResp{
 		Campaign: convertToCampaignInfo(campaign, attachments),
 		BaseResp: &commonModel.BaseResp{
@@ -410,7 +438,7 @@ func AdminUpdateCampaignStatus(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 调用service更新状态
-	if err := svc.AdminUpdateCampaignStatus(req.CampaignID, req.Status); err != nil {
+	if err := svc.AdminUpdateCampaignStatus(ctx, req.CampaignID, req.Status); err != nil {
 		utils.Error(c, 500, err.Error())
 		return
 	}
@@ -427,6 +455,25 @@ func AdminUpdateCampaignStatus(ctx context.Context, c *app.RequestContext) {
 
 // Helper functions
 
+// checkAPIKeyCampaignTeam 团队API Key只能操作所属团队的Campaign，JWT请求直接通过
+func checkAPIKeyCampaignTeam(c *app.RequestContext, userID int64, campaignID string) bool {
+	apiKey, isAPIKey := mw.GetAuthAPIKey(c)
+	if !isAPIKey {
+		return true
+	}
+
+	campaign, _, err := svc.GetCampaign(userID, campaignID)
+	if err != nil {
+		utils.Error(c, 500, err.Error())
+		return false
+	}
+	if campaign.TeamID != apiKey.TeamID {
+		utils.Error(c, 403, "Campaign does not belong to the API key's team")
+		return false
+	}
+	return true
+}
+
 // convertToCampaignInfo 转换为CampaignInfo
 func convertToCampaignInfo(campaign *mysql.Campaign, attachments []*mysql.CampaignAttachment) *campaignModel.CampaignInfo {
 	info := &campaignModel.CampaignInfo{
@@ -443,7 +490,7 @@ func convertToCampaignInfo(campaign *mysql.Campaign, attachments []*mysql.Campai
 		DaypartingType:     int32(campaign.DaypartingType),
 		FrequencyCapType:   int32(campaign.FrequencyCapType),
 		BudgetType:         int32(campaign.BudgetType),
-		BudgetAmount:       campaign.BudgetAmount,
+		BudgetAmount:       campaign.BudgetAmount.String(),
 		Status:             campaign.Status,
 		CreatedAt:          campaign.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:          campaign.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
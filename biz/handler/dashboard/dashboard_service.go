@@ -7,6 +7,7 @@ import (
 
 	"orbia_api/biz/dal/mysql"
 	dashboard "orbia_api/biz/model/dashboard"
+	"orbia_api/biz/service/audit"
 	dashboardService "orbia_api/biz/service/dashboard"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -20,7 +21,8 @@ func InitDashboardService() {
 	excellentCaseRepo := mysql.NewExcellentCaseRepository(mysql.DB)
 	contentTrendRepo := mysql.NewContentTrendRepository(mysql.DB)
 	platformStatsRepo := mysql.NewPlatformStatsRepository(mysql.DB)
-	dashSvc = dashboardService.NewDashboardService(excellentCaseRepo, contentTrendRepo, platformStatsRepo)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+	dashSvc = dashboardService.NewDashboardService(mysql.DB, excellentCaseRepo, contentTrendRepo, platformStatsRepo, auditSvc)
 }
 
 // ==================== 优秀广告案例管理 ====================
@@ -7,6 +7,7 @@ import (
 
 	"orbia_api/biz/dal/mysql"
 	dictionary "orbia_api/biz/model/dictionary"
+	"orbia_api/biz/service/audit"
 	dictionaryService "orbia_api/biz/service/dictionary"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -19,7 +20,8 @@ var dictSvc *dictionaryService.DictionaryService
 func InitDictionaryService() {
 	dictRepo := mysql.NewDictionaryRepository(mysql.DB)
 	dictItemRepo := mysql.NewDictionaryItemRepository(mysql.DB)
-	dictSvc = dictionaryService.NewDictionaryService(dictRepo, dictItemRepo)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+	dictSvc = dictionaryService.NewDictionaryService(mysql.DB, dictRepo, dictItemRepo, auditSvc)
 }
 
 // CreateDictionary .
@@ -10,6 +10,7 @@ import (
 	"orbia_api/biz/handler/dashboard"
 	"orbia_api/biz/handler/dictionary"
 	"orbia_api/biz/handler/kol"
+	"orbia_api/biz/handler/kol_earning"
 	"orbia_api/biz/handler/payment_setting"
 	"orbia_api/biz/handler/recharge_order"
 	"orbia_api/biz/handler/team"
@@ -42,6 +43,9 @@ func InitAllServices() {
 	kolOrderService.InitKolOrderService()
 	log.Println("  ✅ KOL Order service initialized")
 
+	kol_earning.InitKolEarningHandler()
+	log.Println("  ✅ KOL Earning service initialized")
+
 	adOrderService.InitAdOrderService()
 	log.Println("  ✅ Ad Order service initialized")
 
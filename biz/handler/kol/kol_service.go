@@ -4,17 +4,25 @@ package kol
 
 import (
 	"context"
+	"errors"
+	"math"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
 	"orbia_api/biz/model/common"
 	kolModel "orbia_api/biz/model/kol"
 	"orbia_api/biz/mw"
+	"orbia_api/biz/service/audit"
 	kolService "orbia_api/biz/service/kol"
+	"orbia_api/biz/service/social"
+	"orbia_api/biz/utils/money"
 )
 
 var (
@@ -25,7 +33,18 @@ var (
 func InitKolService() {
 	kolRepo := mysql.NewKolRepository(mysql.DB)
 	userRepo := mysql.NewUserRepository(mysql.DB)
-	kolSvc = kolService.NewKolService(kolRepo, userRepo)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+
+	// 社交平台接口配置错误时不同步粉丝数据，KOL手动填写的数据仍可使用
+	statsRegistry, err := social.NewRegistry(config.GlobalConfig.SocialStats)
+	if err != nil {
+		hlog.Errorf("Failed to init social stats providers, stats refresh disabled: %v", err)
+		statsRegistry = nil
+	}
+
+	kolSvc = kolService.NewKolService(mysql.DB, kolRepo, userRepo, auditSvc, statsRegistry)
+	kolService.StartStatsRefreshJob(kolSvc, time.Duration(config.GlobalConfig.SocialStats.RefreshIntervalMinutes)*time.Minute)
+	kolService.StartStatsSnapshotJob(kolSvc, time.Duration(config.GlobalConfig.SocialStats.SnapshotIntervalMinutes)*time.Minute)
 }
 
 // ApplyKol 申请成为KOL
@@ -188,28 +207,21 @@ func GetKolInfo(ctx context.Context, c *app.RequestContext) {
 
 	// 添加统计数据
 	// 确保即使没有统计数据也返回默认值而不是 null
-	if stats != nil {
-		kolInfo.Stats = &kolModel.KolStats{
-			TotalFollowers:     stats.TotalFollowers,
-			TiktokFollowers:    stats.TiktokFollowers,
-			YoutubeSubscribers: stats.YoutubeSubscribers,
-			XFollowers:         stats.XFollowers,
-			DiscordMembers:     stats.DiscordMembers,
-			TiktokAvgViews:     stats.TiktokAvgViews,
-			EngagementRate:     stats.EngagementRate,
-		}
-	} else {
-		// 返回默认的统计数据
-		kolInfo.Stats = &kolModel.KolStats{
-			TotalFollowers:     0,
-			TiktokFollowers:    0,
-			YoutubeSubscribers: 0,
-			XFollowers:         0,
-			DiscordMembers:     0,
-			TiktokAvgViews:     0,
-			EngagementRate:     0,
-		}
+	kolInfo.Stats = convertKolStats(stats)
+
+	// 添加评分汇总
+	rating, err := kolSvc.GetKolRating(kol.ID)
+	if err != nil {
+		hlog.Errorf("GetKolInfo rating error: %v", err)
+		c.JSON(http.StatusInternalServerError, &kolModel.GetKolInfoResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: err.Error(),
+			},
+		})
+		return
 	}
+	kolInfo.Rating = convertKolRating(rating)
 
 	resp := &kolModel.GetKolInfoResp{
 		KolInfo: kolInfo,
@@ -314,7 +326,7 @@ func ReviewKol(ctx context.Context, c *app.RequestContext) {
 	if req.RejectReason != nil {
 		rejectReason = *req.RejectReason
 	}
-	err = kolSvc.ReviewKol(req.KolID, req.Status, rejectReason)
+	err = kolSvc.ReviewKol(ctx, req.KolID, req.Status, rejectReason)
 	if err != nil {
 		hlog.Errorf("ReviewKol service error: %v", err)
 		c.JSON(http.StatusBadRequest, &kolModel.ReviewKolResp{
@@ -367,8 +379,21 @@ func GetKolList(ctx context.Context, c *app.RequestContext) {
 		pageSize = int(*req.PageSize)
 	}
 
-	// 调用服务层获取KOL列表
-	kols, total, err := kolSvc.GetKolList(req.Status, req.Country, req.Tag, page, pageSize)
+	filter, err := buildKolSearchFilter(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &kolModel.GetKolListResp{
+			KolList: make([]*kolModel.KolInfo, 0),
+			Total:   0,
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	// 调用服务层搜索KOL列表
+	result, err := kolSvc.SearchKols(filter, page, pageSize, req.WithFacets != nil && *req.WithFacets)
 	if err != nil {
 		hlog.Errorf("GetKolList service error: %v", err)
 		c.JSON(http.StatusBadRequest, &kolModel.GetKolListResp{
@@ -392,8 +417,8 @@ func GetKolList(ctx context.Context, c *app.RequestContext) {
 
 	// 转换为响应格式
 	// 确保即使没有数据也返回空数组而不是 null
-	kolList := make([]*kolModel.KolInfo, 0, len(kols))
-	for _, kol := range kols {
+	kolList := make([]*kolModel.KolInfo, 0, len(result.Kols))
+	for _, kol := range result.Kols {
 		kolInfo := &kolModel.KolInfo{
 			ID:          kol.ID,
 			UserID:      kol.UserID,
@@ -408,26 +433,163 @@ func GetKolList(ctx context.Context, c *app.RequestContext) {
 			Status:      kol.Status,
 			CreatedAt:   kol.CreatedAt.Format("2006-01-02 15:04:05"),
 			UpdatedAt:   kol.UpdatedAt.Format("2006-01-02 15:04:05"),
+			Languages:   make([]*kolModel.KolLanguage, 0, len(result.Languages[kol.ID])),
+			Tags:        make([]*kolModel.KolTag, 0, len(result.Tags[kol.ID])),
+			Stats:       convertKolStats(result.Stats[kol.ID]),
 		}
 		if kol.ApprovedAt != nil {
 			approvedAt := kol.ApprovedAt.Format("2006-01-02 15:04:05")
 			kolInfo.ApprovedAt = approvedAt
 		}
+		for _, lang := range result.Languages[kol.ID] {
+			kolInfo.Languages = append(kolInfo.Languages, &kolModel.KolLanguage{
+				LanguageCode: lang.LanguageCode,
+				LanguageName: lang.LanguageName,
+			})
+		}
+		for _, tag := range result.Tags[kol.ID] {
+			kolInfo.Tags = append(kolInfo.Tags, &kolModel.KolTag{
+				Tag: tag.Tag,
+			})
+		}
+		if minPrice, ok := result.MinPrices[kol.ID]; ok {
+			price := minPrice.String()
+			kolInfo.MinPrice = &price
+		}
+		kolInfo.Rating = convertKolRating(result.Ratings[kol.ID])
 		kolList = append(kolList, kolInfo)
 	}
 
 	resp := &kolModel.GetKolListResp{
 		KolList: kolList,
-		Total:   total,
+		Total:   result.Total,
 		BaseResp: &common.BaseResp{
 			Code:    200,
 			Message: "success",
 		},
 	}
+	if result.Facets != nil {
+		resp.Facets = &kolModel.KolSearchFacets{
+			Tags:             convertKolFacetBuckets(result.Facets.Tags),
+			Languages:        convertKolFacetBuckets(result.Facets.Languages),
+			Countries:        convertKolFacetBuckets(result.Facets.Countries),
+			FollowerRanges:   convertKolFacetBuckets(result.Facets.Followers),
+			EngagementRanges: convertKolFacetBuckets(result.Facets.Engagement),
+			PriceRanges:      convertKolFacetBuckets(result.Facets.Price),
+		}
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
 
+// convertKolRating 转换KOL评分汇总，没有评价时平均分和评价数为0
+func convertKolRating(rating *mysql.KolRating) *kolModel.KolRating {
+	if rating == nil {
+		return &kolModel.KolRating{}
+	}
+	return &kolModel.KolRating{
+		AverageRating: math.Round(rating.AverageRating*100) / 100,
+		ReviewCount:   rating.ReviewCount,
+	}
+}
+
+// buildKolSearchFilter 将列表请求转换为搜索条件，旧参数 country、tag 与多选参数合并
+func buildKolSearchFilter(req *kolModel.GetKolListReq) (*mysql.KolSearchFilter, error) {
+	filter := &mysql.KolSearchFilter{
+		Countries:     req.Countries,
+		Tags:          req.Tags,
+		Languages:     req.Languages,
+		MinFollowers:  req.MinFollowers,
+		MaxFollowers:  req.MaxFollowers,
+		MinEngagement: req.MinEngagementRate,
+		MaxEngagement: req.MaxEngagementRate,
+		SortDesc:      true,
+	}
+	if req.Status != nil {
+		filter.Status = *req.Status
+	}
+	if req.Keyword != nil {
+		filter.Keyword = *req.Keyword
+	}
+	if req.Country != nil && *req.Country != "" {
+		filter.Countries = append(filter.Countries, *req.Country)
+	}
+	if req.Tag != nil && *req.Tag != "" {
+		filter.Tags = append(filter.Tags, *req.Tag)
+	}
+
+	if req.MinPrice != nil && *req.MinPrice != "" {
+		price, err := money.Parse(*req.MinPrice)
+		if err != nil {
+			return nil, errors.New("invalid min_price format")
+		}
+		filter.MinPrice = &price
+	}
+	if req.MaxPrice != nil && *req.MaxPrice != "" {
+		price, err := money.Parse(*req.MaxPrice)
+		if err != nil {
+			return nil, errors.New("invalid max_price format")
+		}
+		filter.MaxPrice = &price
+	}
+
+	if req.SortBy != nil {
+		filter.SortBy = strings.ToLower(strings.TrimSpace(*req.SortBy))
+	}
+	if req.SortOrder != nil {
+		switch strings.ToLower(strings.TrimSpace(*req.SortOrder)) {
+		case "", "desc":
+		case "asc":
+			filter.SortDesc = false
+		default:
+			return nil, errors.New("invalid sort_order: must be asc or desc")
+		}
+	}
+
+	return filter, nil
+}
+
+// convertKolStats 转换KOL统计数据，没有统计数据时返回默认值而不是 null
+func convertKolStats(stats *mysql.KolStats) *kolModel.KolStats {
+	if stats == nil {
+		return &kolModel.KolStats{VerifiedPlatforms: make([]string, 0)}
+	}
+
+	info := &kolModel.KolStats{
+		TotalFollowers:     stats.TotalFollowers,
+		TiktokFollowers:    stats.TiktokFollowers,
+		YoutubeSubscribers: stats.YoutubeSubscribers,
+		XFollowers:         stats.XFollowers,
+		DiscordMembers:     stats.DiscordMembers,
+		TiktokAvgViews:     stats.TiktokAvgViews,
+		EngagementRate:     stats.EngagementRate,
+		Verified:           stats.Verified,
+		VerifiedPlatforms:  stats.VerifiedPlatformList(),
+	}
+	if stats.VerifiedAt != nil {
+		verifiedAt := stats.VerifiedAt.Format("2006-01-02 15:04:05")
+		info.VerifiedAt = &verifiedAt
+	}
+	if stats.LastRefreshedAt != nil {
+		lastRefreshedAt := stats.LastRefreshedAt.Format("2006-01-02 15:04:05")
+		info.LastRefreshedAt = &lastRefreshedAt
+	}
+	return info
+}
+
+// convertKolFacetBuckets 转换分面统计，确保返回空数组而不是 null
+func convertKolFacetBuckets(counts []*mysql.KolFacetCount) []*kolModel.KolFacetBucket {
+	buckets := make([]*kolModel.KolFacetBucket, 0, len(counts))
+	for _, c := range counts {
+		buckets = append(buckets, &kolModel.KolFacetBucket{
+			Value: c.Value,
+			Label: c.Label,
+			Count: c.Count,
+		})
+	}
+	return buckets
+}
+
 // UpdateKolStats 更新KOL统计数据
 // @router /api/v1/kol/stats/update [POST]
 func UpdateKolStats(ctx context.Context, c *app.RequestContext) {
@@ -482,6 +644,102 @@ func UpdateKolStats(ctx context.Context, c *app.RequestContext) {
 	c.JSON(consts.StatusOK, resp)
 }
 
+// GetKolStatsHistory 获取KOL每日统计快照和7、30、90天增长
+// @router /api/v1/kol/stats/history [POST]
+func GetKolStatsHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kolModel.GetKolStatsHistoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("GetKolStatsHistory bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &kolModel.GetKolStatsHistoryResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	// 从JWT中间件获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		hlog.Error("GetKolStatsHistory: user ID not found in context")
+		c.JSON(http.StatusUnauthorized, &kolModel.GetKolStatsHistoryResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	// 如果没有指定kol_id，则查询当前用户的KOL统计趋势
+	var kolID *int64
+	var userIDPtr *int64
+	if req.KolID != nil && *req.KolID > 0 {
+		kolID = req.KolID
+	} else {
+		userIDPtr = &userID
+	}
+
+	days := 0
+	if req.Days != nil {
+		days = int(*req.Days)
+	}
+
+	result, err := kolSvc.GetKolStatsHistory(kolID, userIDPtr, days)
+	if err != nil {
+		hlog.Errorf("GetKolStatsHistory service error: %v", err)
+		c.JSON(http.StatusNotFound, &kolModel.GetKolStatsHistoryResp{
+			BaseResp: &common.BaseResp{
+				Code:    404,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	snapshots := make([]*kolModel.KolStatsSnapshot, 0, len(result.Snapshots))
+	for _, snapshot := range result.Snapshots {
+		snapshots = append(snapshots, &kolModel.KolStatsSnapshot{
+			Date:               snapshot.SnapshotDate.Format("2006-01-02"),
+			TotalFollowers:     snapshot.TotalFollowers,
+			TiktokFollowers:    snapshot.TiktokFollowers,
+			YoutubeSubscribers: snapshot.YoutubeSubscribers,
+			XFollowers:         snapshot.XFollowers,
+			DiscordMembers:     snapshot.DiscordMembers,
+			TiktokAvgViews:     snapshot.TiktokAvgViews,
+			EngagementRate:     snapshot.EngagementRate,
+			Verified:           snapshot.Verified,
+		})
+	}
+
+	growth := make([]*kolModel.KolStatsGrowth, 0, len(result.Growth))
+	for _, g := range result.Growth {
+		growth = append(growth, &kolModel.KolStatsGrowth{
+			PeriodDays: int32(g.PeriodDays),
+			Metric:     g.Metric,
+			StartValue: g.StartValue,
+			EndValue:   g.EndValue,
+			Change:     g.Change,
+			ChangeRate: g.ChangeRate,
+		})
+	}
+
+	resp := &kolModel.GetKolStatsHistoryResp{
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "success",
+		},
+		KolID:     result.Kol.ID,
+		Snapshots: snapshots,
+		Growth:    growth,
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
 // SaveKolPlan 创建或更新KOL报价Plan
 // @router /api/v1/kol/plan/save [POST]
 func SaveKolPlan(ctx context.Context, c *app.RequestContext) {
@@ -512,8 +770,19 @@ func SaveKolPlan(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	price, err := money.Parse(req.Price)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &kolModel.SaveKolPlanResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid price: " + err.Error(),
+			},
+		})
+		return
+	}
+
 	// 调用服务层保存Plan
-	planID, err := kolSvc.SaveKolPlan(userID, req.ID, req.Title, req.Description, req.Price, req.PlanType)
+	planID, err := kolSvc.SaveKolPlan(userID, req.ID, req.Title, req.Description, price, req.PlanType, req.MaxRevisions)
 	if err != nil {
 		hlog.Errorf("SaveKolPlan service error: %v", err)
 		c.JSON(http.StatusBadRequest, &kolModel.SaveKolPlanResp{
@@ -657,13 +926,14 @@ func GetKolPlans(ctx context.Context, c *app.RequestContext) {
 	planList := make([]*kolModel.KolPlan, 0, len(plans))
 	for _, plan := range plans {
 		planList = append(planList, &kolModel.KolPlan{
-			ID:          plan.ID,
-			Title:       plan.Title,
-			Description: ptrToStr(plan.Description),
-			Price:       plan.Price,
-			PlanType:    plan.PlanType,
-			CreatedAt:   plan.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:   plan.UpdatedAt.Format("2006-01-02 15:04:05"),
+			ID:           plan.ID,
+			Title:        plan.Title,
+			Description:  ptrToStr(plan.Description),
+			Price:        plan.Price.String(),
+			PlanType:     plan.PlanType,
+			CreatedAt:    plan.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:    plan.UpdatedAt.Format("2006-01-02 15:04:05"),
+			MaxRevisions: plan.MaxRevisions,
 		})
 	}
 
@@ -0,0 +1,402 @@
+// Code generated by hertz generator.
+
+package kol_earning
+
+import (
+	"context"
+	"strconv"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	kol_earning "orbia_api/biz/model/kol_earning"
+	"orbia_api/biz/mw"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/chain"
+	kolEarningService "orbia_api/biz/service/kol_earning"
+	"orbia_api/biz/service/ledger"
+	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var (
+	kolEarningSvc kolEarningService.KolEarningService
+)
+
+// InitKolEarningHandler 初始化KOL收益 handler
+func InitKolEarningHandler() {
+	db := mysql.DB
+	kolRepo := mysql.NewKolRepository(db)
+	kolEarningRepo := mysql.NewKolEarningRepository(db)
+	withdrawalOrderRepo := mysql.NewWithdrawalOrderRepository(db)
+	ledgerSvc := ledger.NewLedgerService(db, mysql.NewLedgerRepository(db))
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(db))
+
+	// 链配置错误时不支持任何提现网络，提现申请会被拒绝
+	chainRegistry, err := chain.NewRegistry(config.GlobalConfig.Chain)
+	if err != nil {
+		hlog.Errorf("Failed to init chain networks, withdrawals disabled: %v", err)
+		chainRegistry = nil
+	}
+
+	kolEarningSvc = kolEarningService.NewKolEarningService(db, kolRepo, kolEarningRepo, withdrawalOrderRepo, ledgerSvc, auditSvc, chainRegistry)
+}
+
+// GetMyKolEarning 获取我的收益账户
+// @router /api/v1/kol-earning/info [POST]
+func GetMyKolEarning(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.GetMyKolEarningReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 获取收益账户
+	earning, err := kolEarningSvc.GetMyKolEarning(userID)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	earningCfg := config.GlobalConfig.KolEarning
+	minWithdrawalAmount, err := money.FromFloat(earningCfg.MinWithdrawalAmount)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+	utils.SuccessResponse(c, map[string]interface{}{
+		"earning": &kol_earning.KolEarningInfo{
+			KolID:               earning.KolID,
+			UserID:              earning.UserID,
+			Balance:             earning.Balance.String(),
+			FrozenBalance:       earning.FrozenBalance.String(),
+			TotalEarned:         earning.TotalEarned.String(),
+			TotalCommission:     earning.TotalCommission.String(),
+			TotalWithdrawn:      earning.TotalWithdrawn.String(),
+			CommissionRate:      strconv.FormatFloat(earningCfg.CommissionRate, 'f', -1, 64),
+			MinWithdrawalAmount: minWithdrawalAmount.String(),
+		},
+	})
+}
+
+// GetMyKolEarningRecords 获取我的收益流水
+// @router /api/v1/kol-earning/records [POST]
+func GetMyKolEarningRecords(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.GetMyKolEarningRecordsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 设置默认分页参数
+	page := 1
+	pageSize := 10
+	if req.Page != nil {
+		page = int(*req.Page)
+	}
+	if req.PageSize != nil {
+		pageSize = int(*req.PageSize)
+	}
+
+	// 查询收益流水
+	records, total, err := kolEarningSvc.GetMyKolEarningRecords(userID, req.Type, page, pageSize)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	recordList := make([]*kol_earning.KolEarningRecord, 0, len(records))
+	for _, record := range records {
+		recordList = append(recordList, buildKolEarningRecord(record))
+	}
+
+	utils.SuccessResponse(c, map[string]interface{}{
+		"records":   recordList,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// CreateWithdrawalOrder 创建提现订单
+// @router /api/v1/kol-earning/withdrawal/create [POST]
+func CreateWithdrawalOrder(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.CreateWithdrawalOrderReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 解析金额
+	amount, err := money.Parse(req.Amount)
+	if err != nil {
+		utils.ErrorResponse(c, 400, "invalid amount format")
+		return
+	}
+
+	// 创建提现订单
+	order, err := kolEarningSvc.CreateWithdrawalOrder(userID, amount, req.Network, req.Address, req.Remark)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	utils.SuccessResponse(c, map[string]interface{}{
+		"order": buildWithdrawalOrderInfo(order),
+	})
+}
+
+// GetMyWithdrawalOrders 获取我的提现订单列表
+// @router /api/v1/kol-earning/withdrawal/my/list [POST]
+func GetMyWithdrawalOrders(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.GetMyWithdrawalOrdersReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 设置默认分页参数
+	page := 1
+	pageSize := 10
+	if req.Page != nil {
+		page = int(*req.Page)
+	}
+	if req.PageSize != nil {
+		pageSize = int(*req.PageSize)
+	}
+
+	// 查询提现订单列表
+	orders, total, err := kolEarningSvc.GetMyWithdrawalOrders(userID, req.Status, page, pageSize)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, buildWithdrawalOrderList(orders, total, page, pageSize))
+}
+
+// GetWithdrawalOrderDetail 获取提现订单详情
+// @router /api/v1/kol-earning/withdrawal/detail/:order_id [POST]
+func GetWithdrawalOrderDetail(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.GetWithdrawalOrderDetailReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取用户ID和角色
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 拥有资金查看权限的后台用户可以查看任意订单
+	isAdmin := mw.HasPermission(c, consts.PermFinanceRead)
+
+	// 获取提现订单详情
+	order, err := kolEarningSvc.GetWithdrawalOrderDetail(userID, req.OrderID, isAdmin)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	utils.SuccessResponse(c, map[string]interface{}{
+		"order": buildWithdrawalOrderInfo(order),
+	})
+}
+
+// GetAllWithdrawalOrders 获取所有提现订单列表（管理员）
+// @router /api/v1/admin/withdrawal/list [POST]
+func GetAllWithdrawalOrders(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.GetAllWithdrawalOrdersReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 设置默认分页参数
+	page := 1
+	pageSize := 10
+	if req.Page != nil {
+		page = int(*req.Page)
+	}
+	if req.PageSize != nil {
+		pageSize = int(*req.PageSize)
+	}
+
+	// 查询所有提现订单
+	orders, total, err := kolEarningSvc.GetAllWithdrawalOrders(req.KolID, req.UserID, req.Status, req.Network, page, pageSize)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, buildWithdrawalOrderList(orders, total, page, pageSize))
+}
+
+// ConfirmWithdrawalOrder 确认提现订单已打款（管理员）
+// @router /api/v1/admin/withdrawal/confirm [POST]
+func ConfirmWithdrawalOrder(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.ConfirmWithdrawalOrderReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取管理员用户ID
+	adminUserID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 确认提现订单
+	order, err := kolEarningSvc.ConfirmWithdrawalOrder(ctx, adminUserID, req.OrderID, req.CryptoTxHash, req.Remark)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	utils.SuccessResponse(c, map[string]interface{}{
+		"order": buildWithdrawalOrderInfo(order),
+	})
+}
+
+// RejectWithdrawalOrder 拒绝提现订单（管理员）
+// @router /api/v1/admin/withdrawal/reject [POST]
+func RejectWithdrawalOrder(ctx context.Context, c *app.RequestContext) {
+	var req kol_earning.RejectWithdrawalOrderReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取管理员用户ID
+	adminUserID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	// 拒绝提现订单
+	order, err := kolEarningSvc.RejectWithdrawalOrder(ctx, adminUserID, req.OrderID, req.RejectedReason)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	utils.SuccessResponse(c, map[string]interface{}{
+		"order": buildWithdrawalOrderInfo(order),
+	})
+}
+
+// buildWithdrawalOrderList 构建提现订单列表响应
+func buildWithdrawalOrderList(orders []*model.OrbiaWithdrawalOrder, total int64, page, pageSize int) map[string]interface{} {
+	orderList := make([]map[string]interface{}, 0, len(orders))
+	for _, order := range orders {
+		orderList = append(orderList, buildWithdrawalOrderInfo(order))
+	}
+
+	return map[string]interface{}{
+		"orders":    orderList,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	}
+}
+
+// buildWithdrawalOrderInfo 构建提现订单信息
+func buildWithdrawalOrderInfo(order *model.OrbiaWithdrawalOrder) map[string]interface{} {
+	info := map[string]interface{}{
+		"id":         order.ID,
+		"order_id":   order.OrderID,
+		"kol_id":     order.KolID,
+		"user_id":    order.UserID,
+		"amount":     order.Amount.String(),
+		"network":    order.Network,
+		"address":    order.Address,
+		"status":     order.Status,
+		"created_at": utils.FormatTime(order.CreatedAt),
+		"updated_at": utils.FormatTime(order.UpdatedAt),
+	}
+
+	if order.CryptoTxHash != nil {
+		info["crypto_tx_hash"] = *order.CryptoTxHash
+	}
+	if order.ConfirmedBy != nil {
+		info["confirmed_by"] = *order.ConfirmedBy
+	}
+	if order.ConfirmedAt != nil {
+		info["confirmed_at"] = utils.FormatTime(order.ConfirmedAt)
+	}
+	if order.RejectedReason != nil {
+		info["rejected_reason"] = *order.RejectedReason
+	}
+	if order.Remark != nil {
+		info["remark"] = *order.Remark
+	}
+
+	return info
+}
+
+// buildKolEarningRecord 构建收益流水信息
+func buildKolEarningRecord(record *model.OrbiaKolEarningRecord) *kol_earning.KolEarningRecord {
+	return &kol_earning.KolEarningRecord{
+		ID:               record.ID,
+		RecordID:         record.RecordID,
+		KolID:            record.KolID,
+		Type:             record.Type,
+		Amount:           record.Amount.String(),
+		CommissionAmount: record.CommissionAmount.String(),
+		BalanceBefore:    record.BalanceBefore.String(),
+		BalanceAfter:     record.BalanceAfter.String(),
+		RelatedOrderType: record.RelatedOrderType,
+		RelatedOrderID:   record.RelatedOrderID,
+		Remark:           record.Remark,
+		CreatedAt:        utils.FormatTime(record.CreatedAt),
+	}
+}
+
+// formatAmount 格式化金额
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -32,6 +32,15 @@ func CreateKolOrder(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 团队API Key只能为所属团队下单
+	if apiKey, isAPIKey := mw.GetAuthAPIKey(c); isAPIKey {
+		if req.TeamID != nil && *req.TeamID != apiKey.TeamID {
+			utils.Error(c, apiconsts.ForbiddenCode, "团队ID与API Key所属团队不一致")
+			return
+		}
+		req.TeamID = &apiKey.TeamID
+	}
+
 	// 调用 service 层
 	resp, err := kolOrderService.CreateKolOrder(userID, &req)
 	if err != nil {
@@ -209,3 +218,269 @@ func ConfirmKolOrderPayment(ctx context.Context, c *app.RequestContext) {
 
 	utils.Success(c, resp)
 }
+
+// AdminRefundKolOrder .
+// @router /api/v1/admin/kol-order/refund [POST]
+func AdminRefundKolOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.AdminRefundKolOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取管理员用户ID
+	adminUserID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.AdminRefundKolOrder(ctx, adminUserID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// CreateKolOrderReview .
+// @router /api/v1/kol-order/review/create [POST]
+func CreateKolOrderReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.CreateKolOrderReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.CreateKolOrderReview(userID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// ReplyKolReview .
+// @router /api/v1/kol-order/review/reply [POST]
+func ReplyKolReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.ReplyKolReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.ReplyKolReview(userID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// GetKolReviewList .
+// @router /api/v1/kol-order/review/list [POST]
+func GetKolReviewList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.GetKolReviewListReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.GetKolReviewList(&req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// AdminGetKolReviewList .
+// @router /api/v1/admin/kol-order/review/list [POST]
+func AdminGetKolReviewList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.AdminGetKolReviewListReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.AdminGetKolReviewList(&req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// AdminModerateKolReview .
+// @router /api/v1/admin/kol-order/review/moderate [POST]
+func AdminModerateKolReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.AdminModerateKolReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取管理员用户ID
+	adminUserID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.AdminModerateKolReview(ctx, adminUserID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// SubmitKolOrderDeliverable .
+// @router /api/v1/kol-order/deliverable/submit [POST]
+func SubmitKolOrderDeliverable(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.SubmitKolOrderDeliverableReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.SubmitKolOrderDeliverable(userID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// GetKolOrderDeliverables .
+// @router /api/v1/kol-order/deliverable/list [POST]
+func GetKolOrderDeliverables(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.GetKolOrderDeliverablesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.GetKolOrderDeliverables(userID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// AcceptKolOrderDeliverable .
+// @router /api/v1/kol-order/deliverable/accept [POST]
+func AcceptKolOrderDeliverable(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.AcceptKolOrderDeliverableReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.AcceptKolOrderDeliverable(userID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
+
+// RequestKolOrderRevision .
+// @router /api/v1/kol-order/deliverable/revision [POST]
+func RequestKolOrderRevision(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req kol_order.RequestKolOrderRevisionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.ParamError(c, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.Error(c, apiconsts.UnauthorizedCode, "未登录")
+		return
+	}
+
+	// 调用 service 层
+	resp, err := kolOrderService.RequestKolOrderRevision(userID, &req)
+	if err != nil {
+		utils.Error(c, apiconsts.SystemErrorCode, err.Error())
+		return
+	}
+
+	utils.Success(c, resp)
+}
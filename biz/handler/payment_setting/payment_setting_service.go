@@ -7,6 +7,7 @@ import (
 
 	"orbia_api/biz/dal/mysql"
 	payment_setting "orbia_api/biz/model/payment_setting"
+	"orbia_api/biz/service/audit"
 	paymentSettingService "orbia_api/biz/service/payment_setting"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -18,7 +19,8 @@ var psSvc *paymentSettingService.PaymentSettingService
 // InitPaymentSettingService 初始化收款钱包设置服务
 func InitPaymentSettingService() {
 	repo := mysql.NewPaymentSettingRepository(mysql.DB)
-	psSvc = paymentSettingService.NewPaymentSettingService(repo)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+	psSvc = paymentSettingService.NewPaymentSettingService(mysql.DB, repo, auditSvc)
 }
 
 // GetPaymentSettingList .
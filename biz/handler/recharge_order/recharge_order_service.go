@@ -4,17 +4,28 @@ package recharge_order
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"strconv"
+	"time"
 
 	"orbia_api/biz/consts"
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
 	recharge_order "orbia_api/biz/model/recharge_order"
 	"orbia_api/biz/mw"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/chain"
+	"orbia_api/biz/service/ledger"
+	"orbia_api/biz/service/payment"
 	rechargeOrderService "orbia_api/biz/service/recharge_order"
+	"orbia_api/biz/service/twofactor"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 var (
@@ -27,7 +38,23 @@ func InitRechargeOrderHandler() {
 	rechargeOrderRepo := mysql.NewRechargeOrderRepository(db)
 	paymentSettingRepo := mysql.NewPaymentSettingRepository(db)
 	walletRepo := mysql.NewWalletRepository(db)
-	rechargeOrderSvc = rechargeOrderService.NewRechargeOrderService(db, rechargeOrderRepo, paymentSettingRepo, walletRepo)
+	txRepo := mysql.NewTransactionRepository(db)
+	ledgerSvc := ledger.NewLedgerService(db, mysql.NewLedgerRepository(db))
+
+	// 链上校验未配置或配置错误时仅关闭自动校验，管理员仍可人工确认
+	chainRegistry, err := chain.NewRegistry(config.GlobalConfig.Chain)
+	if err != nil {
+		hlog.Errorf("Failed to init chain verifiers, on-chain verification disabled: %v", err)
+		chainRegistry = nil
+	}
+
+	chainDepositRepo := mysql.NewChainDepositRepository(db)
+	paymentRegistry := payment.NewRegistry(config.GlobalConfig.Payment)
+	auditSvc := audit.NewAuditService(mysql.NewAuditLogRepository(db))
+
+	rechargeOrderSvc = rechargeOrderService.NewRechargeOrderService(db, rechargeOrderRepo, paymentSettingRepo, walletRepo, txRepo, ledgerSvc, chainRegistry, chainDepositRepo, paymentRegistry, auditSvc)
+	rechargeOrderService.StartChainVerifyJob(rechargeOrderSvc, time.Duration(config.GlobalConfig.Chain.VerifyIntervalMinutes)*time.Minute)
+	rechargeOrderService.StartDepositWatcher(rechargeOrderSvc, time.Duration(config.GlobalConfig.Chain.DepositScanIntervalSeconds)*time.Second)
 }
 
 // CreateCryptoRechargeOrder 创建加密货币充值订单
@@ -47,7 +74,7 @@ func CreateCryptoRechargeOrder(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 解析金额
-	amount, err := strconv.ParseFloat(req.Amount, 64)
+	amount, err := money.Parse(req.Amount)
 	if err != nil {
 		utils.ErrorResponse(c, 400, "invalid amount format")
 		return
@@ -90,7 +117,7 @@ func CreateOnlineRechargeOrder(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 解析金额
-	amount, err := strconv.ParseFloat(req.Amount, 64)
+	amount, err := money.Parse(req.Amount)
 	if err != nil {
 		utils.ErrorResponse(c, 400, "invalid amount format")
 		return
@@ -98,11 +125,16 @@ func CreateOnlineRechargeOrder(ctx context.Context, c *app.RequestContext) {
 
 	// 创建充值订单
 	order, paymentURL, err := rechargeOrderSvc.CreateOnlineRechargeOrder(
+		ctx,
 		userID,
 		amount,
 		req.Platform,
 	)
 	if err != nil {
+		if errors.Is(err, payment.ErrUnsupportedPlatform) {
+			utils.ErrorResponse(c, 400, err.Error())
+			return
+		}
 		utils.ErrorResponse(c, 500, err.Error())
 		return
 	}
@@ -180,8 +212,8 @@ func GetRechargeOrderDetail(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	userRole, _ := mw.GetAuthUserRole(c)
-	isAdmin := userRole == consts.RoleAdmin
+	// 拥有资金查看权限的后台用户可以查看任意订单
+	isAdmin := mw.HasPermission(c, consts.PermFinanceRead)
 
 	// 获取充值订单详情
 	order, err := rechargeOrderSvc.GetRechargeOrderDetail(userID, req.OrderID, isAdmin)
@@ -216,7 +248,7 @@ func GetAllRechargeOrders(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 查询所有充值订单
-	orders, total, err := rechargeOrderSvc.GetAllRechargeOrders(req.UserID, req.Status, req.PaymentType, page, pageSize)
+	orders, total, err := rechargeOrderSvc.GetAllRechargeOrders(req.UserID, req.Status, req.PaymentType, req.VerifyStatus, page, pageSize)
 	if err != nil {
 		utils.ErrorResponse(c, 500, err.Error())
 		return
@@ -253,8 +285,16 @@ func ConfirmRechargeOrder(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 确认充值订单
-	order, err := rechargeOrderSvc.ConfirmRechargeOrder(adminUserID, req.OrderID, req.CryptoTxHash, req.Remark)
+	order, err := rechargeOrderSvc.ConfirmRechargeOrder(ctx, adminUserID, req.OrderID, req.CryptoTxHash, req.Remark)
 	if err != nil {
+		// 大额订单需要在 X-TOTP-Code 请求头中携带两步验证码；返回 HTTP 403 使幂等键被释放，可带上验证码重试
+		if errors.Is(err, twofactor.ErrStepUpRequired) {
+			c.JSON(http.StatusForbidden, utils.Response{
+				Code:    403,
+				Message: err.Error(),
+			})
+			return
+		}
 		utils.ErrorResponse(c, 500, err.Error())
 		return
 	}
@@ -282,7 +322,7 @@ func RejectRechargeOrder(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 拒绝充值订单
-	order, err := rechargeOrderSvc.RejectRechargeOrder(adminUserID, req.OrderID, req.FailedReason)
+	order, err := rechargeOrderSvc.RejectRechargeOrder(ctx, adminUserID, req.OrderID, req.FailedReason)
 	if err != nil {
 		utils.ErrorResponse(c, 500, err.Error())
 		return
@@ -297,14 +337,15 @@ func RejectRechargeOrder(ctx context.Context, c *app.RequestContext) {
 // buildRechargeOrderInfo 构建充值订单信息
 func buildRechargeOrderInfo(order *model.OrbiaRechargeOrder) map[string]interface{} {
 	info := map[string]interface{}{
-		"id":           order.ID,
-		"order_id":     order.OrderID,
-		"user_id":      order.UserID,
-		"amount":       formatAmount(order.Amount),
-		"payment_type": order.PaymentType,
-		"status":       order.Status,
-		"created_at":   utils.FormatTime(order.CreatedAt),
-		"updated_at":   utils.FormatTime(order.UpdatedAt),
+		"id":            order.ID,
+		"order_id":      order.OrderID,
+		"user_id":       order.UserID,
+		"amount":        order.Amount.String(),
+		"payment_type":  order.PaymentType,
+		"status":        order.Status,
+		"verify_status": order.VerifyStatus,
+		"created_at":    utils.FormatTime(order.CreatedAt),
+		"updated_at":    utils.FormatTime(order.UpdatedAt),
 	}
 
 	if order.PaymentSettingID != nil {
@@ -346,6 +387,12 @@ func buildRechargeOrderInfo(order *model.OrbiaRechargeOrder) map[string]interfac
 	if order.Remark != nil {
 		info["remark"] = *order.Remark
 	}
+	if order.VerifyMessage != nil {
+		info["verify_message"] = *order.VerifyMessage
+	}
+	if order.VerifiedAt != nil {
+		info["verified_at"] = utils.FormatTime(order.VerifiedAt)
+	}
 
 	return info
 }
@@ -354,3 +401,158 @@ func buildRechargeOrderInfo(order *model.OrbiaRechargeOrder) map[string]interfac
 func formatAmount(amount float64) string {
 	return strconv.FormatFloat(amount, 'f', 2, 64)
 }
+
+// VerifyRechargeOrder 触发加密货币充值订单的链上校验（管理员）
+// @router /api/v1/admin/recharge/verify [POST]
+func VerifyRechargeOrder(ctx context.Context, c *app.RequestContext) {
+	var req recharge_order.VerifyRechargeOrderReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 链上校验，通过时自动确认入账，不通过时标记待人工处理
+	order, err := rechargeOrderSvc.VerifyCryptoRechargeOrder(ctx, req.OrderID)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	utils.SuccessResponse(c, map[string]interface{}{
+		"order": buildRechargeOrderInfo(order),
+	})
+}
+
+// GetChainDeposits 获取链上入账记录列表（管理员审核队列）
+// @router /api/v1/admin/recharge/deposit/list [POST]
+func GetChainDeposits(ctx context.Context, c *app.RequestContext) {
+	var req recharge_order.GetChainDepositsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 设置默认分页参数
+	page := 1
+	pageSize := 10
+	if req.Page != nil {
+		page = int(*req.Page)
+	}
+	if req.PageSize != nil {
+		pageSize = int(*req.PageSize)
+	}
+
+	deposits, total, err := rechargeOrderSvc.GetChainDeposits(req.Status, req.Network, page, pageSize)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	depositList := make([]map[string]interface{}, 0, len(deposits))
+	for _, deposit := range deposits {
+		depositList = append(depositList, buildChainDepositInfo(deposit))
+	}
+
+	utils.SuccessResponse(c, map[string]interface{}{
+		"deposits":  depositList,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// ResolveChainDeposit 处理未匹配的链上入账记录（管理员）
+// @router /api/v1/admin/recharge/deposit/resolve [POST]
+func ResolveChainDeposit(ctx context.Context, c *app.RequestContext) {
+	var req recharge_order.ResolveChainDepositReq
+	if err := c.BindAndValidate(&req); err != nil {
+		utils.ErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	// 从上下文获取管理员用户ID
+	adminUserID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		utils.ErrorResponse(c, 401, "unauthorized")
+		return
+	}
+
+	deposit, err := rechargeOrderSvc.ResolveChainDeposit(ctx, adminUserID, req.DepositID, req.Action, req.OrderID, req.Remark)
+	if err != nil {
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	// 构建响应
+	utils.SuccessResponse(c, map[string]interface{}{
+		"deposit": buildChainDepositInfo(deposit),
+	})
+}
+
+// buildChainDepositInfo 构建链上入账记录信息
+func buildChainDepositInfo(deposit *model.OrbiaChainDeposit) map[string]interface{} {
+	info := map[string]interface{}{
+		"id":             deposit.ID,
+		"network":        deposit.Network,
+		"tx_hash":        deposit.TxHash,
+		"log_index":      deposit.LogIndex,
+		"block_number":   deposit.BlockNumber,
+		"token_symbol":   deposit.TokenSymbol,
+		"token_contract": deposit.TokenContract,
+		"from_address":   deposit.FromAddress,
+		"to_address":     deposit.ToAddress,
+		"amount":         deposit.Amount.String(),
+		"status":         deposit.Status,
+		"created_at":     utils.FormatTime(deposit.CreatedAt),
+	}
+
+	if deposit.RechargeOrderID != nil {
+		info["recharge_order_id"] = *deposit.RechargeOrderID
+	}
+	if deposit.ResolvedBy != nil {
+		info["resolved_by"] = *deposit.ResolvedBy
+	}
+	if deposit.ResolvedAt != nil {
+		info["resolved_at"] = utils.FormatTime(deposit.ResolvedAt)
+	}
+	if deposit.Remark != nil {
+		info["remark"] = *deposit.Remark
+	}
+
+	return info
+}
+
+// PaymentWebhook 在线支付平台 webhook 通知
+// 签名校验失败返回 400；处理失败返回 500，由支付平台稍后重试
+// @router /api/v1/recharge/webhook/:platform [POST]
+func PaymentWebhook(ctx context.Context, c *app.RequestContext) {
+	platform := c.Param("platform")
+
+	// 验签需要原始请求头和请求体
+	header := make(http.Header)
+	c.Request.Header.VisitAll(func(key, value []byte) {
+		header.Add(string(key), string(value))
+	})
+	body := c.Request.Body()
+
+	order, err := rechargeOrderSvc.HandlePaymentWebhook(ctx, platform, header, body)
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) || errors.Is(err, payment.ErrUnsupportedPlatform) {
+			hlog.Warnf("Rejected %s payment webhook: %v", platform, err)
+			utils.ErrorResponse(c, 400, err.Error())
+			return
+		}
+		hlog.Errorf("Failed to handle %s payment webhook: %v", platform, err)
+		utils.ErrorResponse(c, 500, err.Error())
+		return
+	}
+
+	result := map[string]interface{}{}
+	if order != nil {
+		result["order_id"] = order.OrderID
+		result["status"] = order.Status
+	}
+	utils.SuccessResponse(c, result)
+}
@@ -34,7 +34,7 @@ func InitTeamService() {
 
 	teamRepo := mysql.NewTeamRepository(mysql.DB)
 	userRepo := mysql.NewUserRepository(mysql.DB)
-	teamSvc = teamService.NewTeamService(teamRepo, userRepo)
+	teamSvc = teamService.NewTeamService(teamRepo, userRepo, mysql.NewTeamAPIKeyRepository(mysql.DB))
 
 	// 检查 teamSvc 是否成功初始化
 	if teamSvc == nil {
@@ -454,3 +454,138 @@ func GetUserInvitations(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// CreateTeamAPIKey 创建团队API Key
+// @router /api/v1/team/api-key/create [POST]
+func CreateTeamAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req team.CreateTeamAPIKeyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("CreateTeamAPIKey bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &team.CreateTeamAPIKeyResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	// 从JWT中间件获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		hlog.Error("CreateTeamAPIKey: user not authenticated")
+		c.JSON(http.StatusUnauthorized, &team.CreateTeamAPIKeyResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	resp, err := teamSvc.CreateAPIKey(userID, &req)
+	if err != nil {
+		hlog.Errorf("CreateTeamAPIKey service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &team.CreateTeamAPIKeyResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: "Failed to create api key: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListTeamAPIKeys 获取团队API Key列表
+// @router /api/v1/team/api-key/list [POST]
+func ListTeamAPIKeys(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req team.ListTeamAPIKeysReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("ListTeamAPIKeys bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &team.ListTeamAPIKeysResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	// 从JWT中间件获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		hlog.Error("ListTeamAPIKeys: user not authenticated")
+		c.JSON(http.StatusUnauthorized, &team.ListTeamAPIKeysResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	resp, err := teamSvc.ListAPIKeys(userID, &req)
+	if err != nil {
+		hlog.Errorf("ListTeamAPIKeys service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &team.ListTeamAPIKeysResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: "Failed to list api keys: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeTeamAPIKey 吊销团队API Key
+// @router /api/v1/team/api-key/revoke [POST]
+func RevokeTeamAPIKey(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req team.RevokeTeamAPIKeyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("RevokeTeamAPIKey bind error: %v", err)
+		c.JSON(http.StatusBadRequest, &team.RevokeTeamAPIKeyResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: "Invalid request parameters: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	// 从JWT中间件获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		hlog.Error("RevokeTeamAPIKey: user not authenticated")
+		c.JSON(http.StatusUnauthorized, &team.RevokeTeamAPIKeyResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	resp, err := teamSvc.RevokeAPIKey(userID, &req)
+	if err != nil {
+		hlog.Errorf("RevokeTeamAPIKey service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &team.RevokeTeamAPIKeyResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: "Failed to revoke api key: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -29,7 +29,7 @@ func InitUserService() {
 	userRepo := mysql.NewUserRepository(mysql.DB)
 	teamRepo := mysql.NewTeamRepository(mysql.DB)
 	kolRepo := mysql.NewKolRepository(mysql.DB)
-	userSvc = userService.NewUserService(userRepo, teamRepo, kolRepo)
+	userSvc = userService.NewUserService(userRepo, teamRepo, kolRepo, mysql.NewAuthSessionRepository(mysql.DB))
 }
 
 // GetProfile 获取用户资料
@@ -354,6 +354,16 @@ func convertKolInfo(kolInfo *userService.KolInfo) *kol.KolInfo {
 			DiscordMembers:     kolInfo.Stats.DiscordMembers,
 			TiktokAvgViews:     kolInfo.Stats.TiktokAvgViews,
 			EngagementRate:     kolInfo.Stats.EngagementRate,
+			Verified:           kolInfo.Stats.Verified,
+			VerifiedPlatforms:  kolInfo.Stats.VerifiedPlatformList(),
+		}
+		if kolInfo.Stats.VerifiedAt != nil {
+			verifiedAt := kolInfo.Stats.VerifiedAt.Format("2006-01-02 15:04:05")
+			kolResp.Stats.VerifiedAt = &verifiedAt
+		}
+		if kolInfo.Stats.LastRefreshedAt != nil {
+			lastRefreshedAt := kolInfo.Stats.LastRefreshedAt.Format("2006-01-02 15:04:05")
+			kolResp.Stats.LastRefreshedAt = &lastRefreshedAt
 		}
 	} else {
 		// 返回默认的统计数据
@@ -365,8 +375,124 @@ func convertKolInfo(kolInfo *userService.KolInfo) *kol.KolInfo {
 			DiscordMembers:     0,
 			TiktokAvgViews:     0,
 			EngagementRate:     0,
+			VerifiedPlatforms:  make([]string, 0),
 		}
 	}
 
 	return kolResp
 }
+
+// ListSessions 获取当前用户的登录会话（设备）列表
+// @router /api/v1/user/sessions [POST]
+func ListSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListSessionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &user.ListSessionsResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+	currentSessionID, _ := mw.GetAuthSessionID(c)
+
+	sessions, err := userSvc.ListSessions(userID)
+	if err != nil {
+		hlog.Errorf("ListSessions service error: %v", err)
+		c.JSON(http.StatusInternalServerError, &user.ListSessionsResp{
+			BaseResp: &common.BaseResp{
+				Code:    500,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	sessionList := make([]*user.UserSession, 0, len(sessions))
+	for _, session := range sessions {
+		sessionList = append(sessionList, convertUserSession(session, currentSessionID))
+	}
+
+	resp := &user.ListSessionsResp{
+		Sessions: sessionList,
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Success",
+		},
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeSession 吊销当前用户的指定登录会话
+// @router /api/v1/user/sessions/revoke [POST]
+func RevokeSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevokeSessionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, exists := mw.GetAuthUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, &user.RevokeSessionResp{
+			BaseResp: &common.BaseResp{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		})
+		return
+	}
+
+	if err := userSvc.RevokeSession(userID, req.SessionID); err != nil {
+		hlog.Errorf("RevokeSession service error: %v", err)
+		c.JSON(http.StatusBadRequest, &user.RevokeSessionResp{
+			BaseResp: &common.BaseResp{
+				Code:    400,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp := &user.RevokeSessionResp{
+		BaseResp: &common.BaseResp{
+			Code:    200,
+			Message: "Session revoked successfully",
+		},
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// convertUserSession 将登录会话转换为响应格式
+func convertUserSession(session *mysql.AuthSession, currentSessionID string) *user.UserSession {
+	info := &user.UserSession{
+		SessionID:   session.SessionID,
+		DeviceName:  session.DeviceName,
+		UserAgent:   session.UserAgent,
+		IP:          session.IP,
+		CreatedAt:   session.CreatedAt.Format("2006-01-02 15:04:05"),
+		LastSeenIP:  session.LastSeenIP,
+		IsCurrent:   session.SessionID == currentSessionID,
+		MfaVerified: session.MFAVerifiedAt != nil,
+	}
+	if session.LastSeenAt != nil {
+		lastSeenAt := session.LastSeenAt.Format("2006-01-02 15:04:05")
+		info.LastSeenAt = &lastSeenAt
+	}
+	return info
+}
@@ -2,7 +2,6 @@ package wallet
 
 import (
 	"context"
-	"strconv"
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
@@ -10,6 +9,7 @@ import (
 	"orbia_api/biz/mw"
 	walletService "orbia_api/biz/service/wallet"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 
 	"github.com/cloudwego/hertz/pkg/app"
 )
@@ -53,10 +53,10 @@ func GetWalletInfo(ctx context.Context, c *app.RequestContext) {
 	walletInfo := &walletModel.WalletInfo{
 		ID:            walletData.ID,
 		UserID:        walletData.UserID,
-		Balance:       formatAmount(walletData.Balance),
-		FrozenBalance: formatAmount(walletData.FrozenBalance),
-		TotalRecharge: formatAmount(walletData.TotalRecharge),
-		TotalConsume:  formatAmount(walletData.TotalConsume),
+		Balance:       walletData.Balance.String(),
+		FrozenBalance: walletData.FrozenBalance.String(),
+		TotalRecharge: walletData.TotalRecharge.String(),
+		TotalConsume:  walletData.TotalConsume.String(),
 		CreatedAt:     utils.FormatTime(walletData.CreatedAt),
 		UpdatedAt:     utils.FormatTime(walletData.UpdatedAt),
 	}
@@ -83,7 +83,7 @@ func CryptoRecharge(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 解析金额
-	amount, err := strconv.ParseFloat(req.Amount, 64)
+	amount, err := money.Parse(req.Amount)
 	if err != nil {
 		utils.ErrorResponse(c, 400, "invalid amount format")
 		return
@@ -126,7 +126,7 @@ func OnlineRecharge(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 解析金额
-	amount, err := strconv.ParseFloat(req.Amount, 64)
+	amount, err := money.Parse(req.Amount)
 	if err != nil {
 		utils.ErrorResponse(c, 400, "invalid amount format")
 		return
@@ -276,9 +276,9 @@ func buildTransactionResponse(tx *model.OrbiaTransaction) *walletModel.Transacti
 		TransactionID: tx.TransactionID,
 		UserID:        tx.UserID,
 		Type:          tx.Type,
-		Amount:        formatAmount(tx.Amount),
-		BalanceBefore: formatAmount(tx.BalanceBefore),
-		BalanceAfter:  formatAmount(tx.BalanceAfter),
+		Amount:        tx.Amount.String(),
+		BalanceBefore: tx.BalanceBefore.String(),
+		BalanceAfter:  tx.BalanceAfter.String(),
 		Status:        tx.Status,
 		CreatedAt:     utils.FormatTime(tx.CreatedAt),
 		UpdatedAt:     utils.FormatTime(tx.UpdatedAt),
@@ -302,8 +302,3 @@ func buildTransactionResponse(tx *model.OrbiaTransaction) *walletModel.Transacti
 
 	return resp
 }
-
-// 辅助函数：格式化金额
-func formatAmount(amount float64) string {
-	return strconv.FormatFloat(amount, 'f', 2, 64)
-}
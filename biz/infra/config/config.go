@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
@@ -11,7 +12,14 @@ import (
 
 var GlobalConfig *Config
 
+// 运行环境（ORBIA_ENV）
+const (
+	EnvDev  = "dev"
+	EnvProd = "prod"
+)
+
 type Config struct {
+	Env              string                 `yaml:"-"` // 运行环境，由 ORBIA_ENV 决定，不从配置文件读取
 	Server           ServerConfig           `yaml:"server"`
 	Database         DatabaseConfig         `yaml:"database"`
 	Redis            RedisConfig            `yaml:"redis"`
@@ -20,13 +28,23 @@ type Config struct {
 	R2               R2Config               `yaml:"r2"`
 	SMTP             SMTPConfig             `yaml:"smtp"`
 	VerificationCode VerificationCodeConfig `yaml:"verification_code"`
+	SIWE             SIWEConfig             `yaml:"siwe"`
+	KolEarning       KolEarningConfig       `yaml:"kol_earning"`
+	KolOrder         KolOrderConfig         `yaml:"kol_order"`
+	Ledger           LedgerConfig           `yaml:"ledger"`
+	Chain            ChainConfig            `yaml:"chain"`
+	Payment          PaymentConfig          `yaml:"payment"`
+	RateLimit        RateLimitConfig        `yaml:"rate_limit"`
+	TwoFactor        TwoFactorConfig        `yaml:"two_factor"`
+	SocialStats      SocialStatsConfig      `yaml:"social_stats"`
 }
 
 type ServerConfig struct {
-	Host         string `yaml:"host"`
-	Port         int    `yaml:"port"`
-	ReadTimeout  string `yaml:"read_timeout"`
-	WriteTimeout string `yaml:"write_timeout"`
+	Host           string   `yaml:"host"`
+	Port           int      `yaml:"port"`
+	ReadTimeout    string   `yaml:"read_timeout"`
+	WriteTimeout   string   `yaml:"write_timeout"`
+	TrustedProxies []string `yaml:"trusted_proxies"` // 可信反向代理（IP 或 CIDR），只信任其传递的 X-Forwarded-For/X-Real-IP；为空时使用直连地址
 }
 
 type DatabaseConfig struct {
@@ -56,8 +74,9 @@ type RedisConfig struct {
 }
 
 type JWTConfig struct {
-	Secret      string `yaml:"secret"`
-	ExpireHours int    `yaml:"expire_hours"`
+	Secret              string `yaml:"secret"`
+	AccessExpireMinutes int    `yaml:"access_expire_minutes"` // 访问令牌有效期（分钟）
+	RefreshExpireDays   int    `yaml:"refresh_expire_days"`   // 刷新令牌有效期（天），每次刷新后重新计算
 }
 
 type LogConfig struct {
@@ -97,8 +116,160 @@ type SMTPConfig struct {
 
 // VerificationCodeConfig 验证码配置
 type VerificationCodeConfig struct {
-	ExpireMinutes int `yaml:"expire_minutes"`
-	Length        int `yaml:"length"`
+	ExpireMinutes int            `yaml:"expire_minutes"`
+	Length        int            `yaml:"length"`
+	MaxAttempts   int            `yaml:"max_attempts"` // 验证码允许的最大错误次数，达到后验证码失效
+	TestMode      TestModeConfig `yaml:"test_mode"`
+}
+
+// TestModeConfig 验证码测试模式配置
+// 仅在显式设置 ORBIA_ENV=dev 时生效，未设置或其他环境即使开启也会被忽略
+type TestModeConfig struct {
+	Enabled bool     `yaml:"enabled"` // 是否开启测试模式
+	Code    string   `yaml:"code"`    // 固定测试验证码
+	Emails  []string `yaml:"emails"`  // 允许使用测试验证码的邮箱白名单
+}
+
+// SIWEConfig 钱包登录（EIP-4361 Sign-In with Ethereum）配置
+type SIWEConfig struct {
+	Domain                      string  `yaml:"domain"`                         // 请求签名的前端域名（可带端口），登录时校验消息中的 domain
+	URI                         string  `yaml:"uri"`                            // 登录的资源地址
+	Statement                   string  `yaml:"statement"`                      // 钱包中展示给用户的说明
+	ChainIDs                    []int64 `yaml:"chain_ids"`                      // 允许的链ID，第一个为默认值
+	NonceExpireMinutes          int     `yaml:"nonce_expire_minutes"`           // 登录挑战有效期（分钟）
+	NonceCleanupIntervalMinutes int     `yaml:"nonce_cleanup_interval_minutes"` // 过期登录挑战清理任务执行间隔（分钟），0 表示不启动
+}
+
+// KolEarningConfig KOL收益配置
+type KolEarningConfig struct {
+	CommissionRate      float64 `yaml:"commission_rate"`       // 平台佣金比例（0-1，如 0.1 表示 10%）
+	MinWithdrawalAmount float64 `yaml:"min_withdrawal_amount"` // 单笔最低提现金额（美元）
+}
+
+// KolOrderConfig KOL订单交付验收配置
+type KolOrderConfig struct {
+	AutoAcceptDays            int `yaml:"auto_accept_days"`             // 交付后买家超过该天数未验收或申请修改则自动验收，0 表示不自动验收
+	AutoAcceptIntervalMinutes int `yaml:"auto_accept_interval_minutes"` // 自动验收任务执行间隔（分钟），0 表示不启动
+}
+
+// LedgerConfig 账本配置
+type LedgerConfig struct {
+	ReconcileIntervalMinutes int `yaml:"reconcile_interval_minutes"` // 定时对账间隔（分钟），0 表示不启动
+}
+
+// ChainConfig 链上充值校验配置
+type ChainConfig struct {
+	VerifyIntervalMinutes      int                  `yaml:"verify_interval_minutes"`       // 待确认加密货币充值订单的定时校验间隔（分钟），0 表示不启动
+	DepositScanIntervalSeconds int                  `yaml:"deposit_scan_interval_seconds"` // 收款地址入账扫描间隔（秒），0 表示不启动
+	Networks                   []ChainNetworkConfig `yaml:"networks"`
+}
+
+// ChainNetworkConfig 区块链网络配置
+type ChainNetworkConfig struct {
+	Name             string             `yaml:"name"`              // 网络标识，与收款钱包设置的 network 前缀匹配（如 TRC-20、ERC-20）
+	Type             string             `yaml:"type"`              // 链类型：evm, tron
+	RPCURL           string             `yaml:"rpc_url"`           // 节点地址（EVM 为 JSON-RPC 地址，TRON 为 HTTP API 地址）
+	APIKey           string             `yaml:"api_key"`           // 节点 API Key（TronGrid 等服务需要）
+	MinConfirmations uint64             `yaml:"min_confirmations"` // 自动确认所需的最少区块确认数
+	Tokens           []ChainTokenConfig `yaml:"tokens"`            // 接受的稳定币合约
+}
+
+// ChainTokenConfig 稳定币合约配置
+type ChainTokenConfig struct {
+	Symbol   string `yaml:"symbol"`   // 代币符号：USDT, USDC
+	Contract string `yaml:"contract"` // 合约地址
+	Decimals int    `yaml:"decimals"` // 代币精度
+}
+
+// PaymentConfig 在线支付配置
+type PaymentConfig struct {
+	SuccessURL string            `yaml:"success_url"` // 支付成功后的跳转地址，会追加 order_id 参数
+	CancelURL  string            `yaml:"cancel_url"`  // 取消支付后的跳转地址，会追加 order_id 参数
+	Stripe     StripeConfig      `yaml:"stripe"`
+	PayPal     PayPalConfig      `yaml:"paypal"`
+	Fake       FakePaymentConfig `yaml:"fake"`
+}
+
+// StripeConfig Stripe 配置，secret_key 为空时不启用
+type StripeConfig struct {
+	APIBase       string `yaml:"api_base"`       // API 地址，为空时使用官方地址
+	SecretKey     string `yaml:"secret_key"`     // API 密钥
+	WebhookSecret string `yaml:"webhook_secret"` // webhook 签名密钥（whsec_ 开头）
+}
+
+// PayPalConfig PayPal 配置，client_id 为空时不启用
+type PayPalConfig struct {
+	APIBase      string `yaml:"api_base"`      // API 地址，沙箱环境为 https://api-m.sandbox.paypal.com
+	ClientID     string `yaml:"client_id"`     // REST 应用 Client ID
+	ClientSecret string `yaml:"client_secret"` // REST 应用 Secret
+	WebhookID    string `yaml:"webhook_id"`    // 在 PayPal 后台创建的 webhook ID，用于验签
+}
+
+// FakePaymentConfig 模拟支付配置，仅用于本地联调
+type FakePaymentConfig struct {
+	Enabled       bool   `yaml:"enabled"`        // 是否启用模拟支付
+	CheckoutURL   string `yaml:"checkout_url"`   // 模拟支付页面地址
+	WebhookSecret string `yaml:"webhook_secret"` // webhook 签名密钥
+}
+
+// RateLimitConfig 限流配置
+type RateLimitConfig struct {
+	Store    string                     `yaml:"store"`    // 计数存储：memory（默认，单实例）、redis（多实例共享）
+	Policies map[string][]RateLimitRule `yaml:"policies"` // 按策略名配置的限流规则，路由中间件引用策略名
+}
+
+// RateLimitRule 限流规则（固定窗口）
+type RateLimitRule struct {
+	Key           string `yaml:"key"`            // 计数维度：ip，或请求体字段名（如 email、wallet_address）
+	Limit         int64  `yaml:"limit"`          // 窗口内允许的最大请求数
+	WindowSeconds int    `yaml:"window_seconds"` // 窗口长度（秒）
+}
+
+// TwoFactorConfig 两步验证（TOTP）配置
+type TwoFactorConfig struct {
+	Issuer                 string  `yaml:"issuer"`                   // 验证器 App 中显示的发行方名称
+	EncryptionKey          string  `yaml:"encryption_key"`           // TOTP 密钥的加密密钥，不能复用 jwt.secret；除显式设置 ORBIA_ENV=dev 外必须配置，否则启动失败
+	ChallengeExpireMinutes int     `yaml:"challenge_expire_minutes"` // 登录时两步验证挑战的有效期（分钟）
+	MaxAttempts            int     `yaml:"max_attempts"`             // 登录挑战允许的最大错误次数，达到后挑战失效
+	StepUpMaxAttempts      int     `yaml:"step_up_max_attempts"`     // 高风险操作在锁定窗口内允许的验证码校验次数，超过后返回 429，验证成功后清零
+	StepUpLockoutMinutes   int     `yaml:"step_up_lockout_minutes"`  // 高风险操作验证码校验次数的锁定窗口（分钟）
+	StepUpRechargeAmount   float64 `yaml:"step_up_recharge_amount"`  // 确认充值订单时需要重新输入验证码的金额阈值（美元），0 表示所有订单都需要
+}
+
+// SocialStatsConfig KOL社交平台数据同步和每日统计快照配置
+type SocialStatsConfig struct {
+	RefreshIntervalMinutes  int                `yaml:"refresh_interval_minutes"`  // 定时同步KOL粉丝数据的间隔（分钟），0 表示不启动
+	SnapshotIntervalMinutes int                `yaml:"snapshot_interval_minutes"` // 保存KOL每日统计快照的间隔（分钟），每次运行覆盖当天快照，0 表示不启动
+	FixtureFile             string             `yaml:"fixture_file"`              // 录制的接口响应文件，非空时所有平台回放录制响应，不访问外部平台
+	TikTok                  TikTokStatsConfig  `yaml:"tiktok"`
+	YouTube                 YouTubeStatsConfig `yaml:"youtube"`
+	X                       XStatsConfig       `yaml:"x"`
+	Discord                 DiscordStatsConfig `yaml:"discord"`
+}
+
+// TikTokStatsConfig TikTok Research API 配置，client_key 为空时不启用
+type TikTokStatsConfig struct {
+	APIBase      string `yaml:"api_base"`      // API 地址，为空时使用官方地址
+	ClientKey    string `yaml:"client_key"`    // 应用 client key
+	ClientSecret string `yaml:"client_secret"` // 应用 client secret
+}
+
+// YouTubeStatsConfig YouTube Data API 配置，api_key 为空时不启用
+type YouTubeStatsConfig struct {
+	APIBase string `yaml:"api_base"` // API 地址，为空时使用官方地址
+	APIKey  string `yaml:"api_key"`  // Google Cloud API Key
+}
+
+// XStatsConfig X API v2 配置，bearer_token 为空时不启用
+type XStatsConfig struct {
+	APIBase     string `yaml:"api_base"`     // API 地址，为空时使用官方地址
+	BearerToken string `yaml:"bearer_token"` // App-only Bearer Token
+}
+
+// DiscordStatsConfig Discord 邀请链接接口配置（公开接口，无需密钥）
+type DiscordStatsConfig struct {
+	APIBase string `yaml:"api_base"` // API 地址，为空时使用官方地址
+	Enabled bool   `yaml:"enabled"`  // 是否启用
 }
 
 // LoadConfig 加载配置文件
@@ -108,7 +279,7 @@ func LoadConfig() error {
 	// 获取环境变量，默认为 dev
 	env := os.Getenv("ORBIA_ENV")
 	if env == "" {
-		env = "dev"
+		env = EnvDev
 	}
 
 	// 构建配置文件路径
@@ -129,10 +300,33 @@ func LoadConfig() error {
 		return fmt.Errorf("解析配置文件失败: %v", err)
 	}
 
+	config.Env = env
+	if err := config.validate(); err != nil {
+		return err
+	}
 	GlobalConfig = config
 	return nil
 }
 
+// validate 校验必须显式配置的安全相关配置项
+func (c *Config) validate() error {
+	// TOTP 密钥加密密钥不能为空，也不能复用 JWT 密钥；只有显式设置 ORBIA_ENV=dev 时允许为空（两步验证功能不可用）
+	key := c.TwoFactor.EncryptionKey
+	if key == "" && !IsExplicitDevEnv() {
+		return errors.New("two_factor.encryption_key must be configured (TOTP_ENCRYPTION_KEY)")
+	}
+	if key != "" && key == c.JWT.Secret {
+		return errors.New("two_factor.encryption_key must not reuse jwt.secret")
+	}
+	return nil
+}
+
+// IsExplicitDevEnv 是否显式设置了 ORBIA_ENV=dev
+// 未设置 ORBIA_ENV 时虽然默认加载 dev 配置，但不视为开发环境，测试模式等开发专用功能保持关闭
+func IsExplicitDevEnv() bool {
+	return os.Getenv("ORBIA_ENV") == EnvDev
+}
+
 // expandEnvVars 替换配置文件中的环境变量
 // 支持格式: ${VAR_NAME:default_value} 或 ${VAR_NAME}
 func expandEnvVars(content string) string {
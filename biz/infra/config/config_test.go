@@ -0,0 +1,33 @@
+package config
+
+import "testing"
+
+func TestValidateTwoFactorEncryptionKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		key     string
+		wantErr bool
+	}{
+		{name: "prod with dedicated key", env: EnvProd, key: "totp_key"},
+		{name: "prod without key", env: EnvProd, wantErr: true},
+		{name: "unset env without key", env: "", wantErr: true},
+		{name: "staging without key", env: "staging", wantErr: true},
+		{name: "explicit dev without key", env: EnvDev},
+		{name: "prod reusing jwt secret", env: EnvProd, key: "jwt_secret", wantErr: true},
+		{name: "dev reusing jwt secret", env: EnvDev, key: "jwt_secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ORBIA_ENV", tt.env)
+			cfg := &Config{
+				JWT:       JWTConfig{Secret: "jwt_secret"},
+				TwoFactor: TwoFactorConfig{EncryptionKey: tt.key},
+			}
+			if err := cfg.validate(); (err != nil) != tt.wantErr {
+				t.Fatalf("validate() err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Store 固定窗口计数器存储
+type Store interface {
+	// Incr 计数加一，窗口内首次计数时开始计时，返回当前计数和窗口剩余时间
+	Incr(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+	// Reset 清除计数
+	Reset(ctx context.Context, key string) error
+}
+
+// memorySweepInterval 内存存储清理过期计数的间隔
+const memorySweepInterval = time.Minute
+
+// memoryStore 进程内存储，单实例部署时使用
+type memoryStore struct {
+	mu        sync.Mutex
+	counters  map[string]*memoryCounter
+	lastSweep time.Time
+}
+
+type memoryCounter struct {
+	count     int64
+	expiresAt time.Time
+}
+
+// NewMemoryStore 创建进程内计数器存储
+func NewMemoryStore() Store {
+	return &memoryStore{
+		counters:  make(map[string]*memoryCounter),
+		lastSweep: time.Now(),
+	}
+}
+
+// Incr 计数加一
+func (s *memoryStore) Incr(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > memorySweepInterval {
+		for k, counter := range s.counters {
+			if !now.Before(counter.expiresAt) {
+				delete(s.counters, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	counter, ok := s.counters[key]
+	if !ok || !now.Before(counter.expiresAt) {
+		counter = &memoryCounter{expiresAt: now.Add(window)}
+		s.counters[key] = counter
+	}
+	counter.count++
+	return counter.count, counter.expiresAt.Sub(now), nil
+}
+
+// Reset 清除计数
+func (s *memoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.counters, key)
+	return nil
+}
+
+// redisKeyPrefix Redis 计数器键前缀
+const redisKeyPrefix = "orbia:ratelimit:"
+
+// redisIncrScript 计数加一，首次计数时设置过期时间，返回计数和剩余毫秒数
+var redisIncrScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl < 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+	ttl = tonumber(ARGV[1])
+end
+return {count, ttl}
+`)
+
+// redisStore Redis 存储，多实例部署时共享计数
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore 创建 Redis 计数器存储
+func NewRedisStore(client *redis.Client) Store {
+	return &redisStore{client: client}
+}
+
+// Incr 计数加一
+func (s *redisStore) Incr(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	result, err := redisIncrScript.Run(ctx, s.client, []string{redisKeyPrefix + key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	return result[0], time.Duration(result[1]) * time.Millisecond, nil
+}
+
+// Reset 清除计数
+func (s *redisStore) Reset(ctx context.Context, key string) error {
+	return s.client.Del(ctx, redisKeyPrefix+key).Err()
+}
@@ -24,7 +24,7 @@ type AdOrderInfo struct {
 	// 广告订单描述
 	Description string `thrift:"description,7" form:"description" json:"description" query:"description"`
 	// 广告预算（美元）
-	Budget float64 `thrift:"budget,8" form:"budget" json:"budget" query:"budget"`
+	Budget string `thrift:"budget,8" form:"budget" json:"budget" query:"budget"`
 	// 广告类型：banner, video, social_media, influencer
 	AdType string `thrift:"ad_type,9" form:"ad_type" json:"ad_type" query:"ad_type"`
 	// 目标受众
@@ -89,7 +89,7 @@ func (p *AdOrderInfo) GetDescription() (v string) {
 	return p.Description
 }
 
-func (p *AdOrderInfo) GetBudget() (v float64) {
+func (p *AdOrderInfo) GetBudget() (v string) {
 	return p.Budget
 }
 
@@ -279,7 +279,7 @@ func (p *AdOrderInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 8:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -482,8 +482,8 @@ func (p *AdOrderInfo) ReadField7(iprot thrift.TProtocol) error {
 }
 func (p *AdOrderInfo) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
@@ -837,10 +837,10 @@ WriteFieldEndError:
 }
 
 func (p *AdOrderInfo) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("budget", thrift.DOUBLE, 8); err != nil {
+	if err = oprot.WriteFieldBegin("budget", thrift.STRING, 8); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Budget); err != nil {
+	if err := oprot.WriteString(p.Budget); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1063,7 +1063,7 @@ type CreateAdOrderReq struct {
 	// 广告订单描述
 	Description string `thrift:"description,2" form:"description" json:"description"`
 	// 广告预算（美元）
-	Budget float64 `thrift:"budget,3" form:"budget" json:"budget"`
+	Budget string `thrift:"budget,3" form:"budget" json:"budget"`
 	// 广告类型
 	AdType string `thrift:"ad_type,4" form:"ad_type" json:"ad_type"`
 	// 目标受众
@@ -1091,7 +1091,7 @@ func (p *CreateAdOrderReq) GetDescription() (v string) {
 	return p.Description
 }
 
-func (p *CreateAdOrderReq) GetBudget() (v float64) {
+func (p *CreateAdOrderReq) GetBudget() (v string) {
 	return p.Budget
 }
 
@@ -1171,7 +1171,7 @@ func (p *CreateAdOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1271,8 +1271,8 @@ func (p *CreateAdOrderReq) ReadField2(iprot thrift.TProtocol) error {
 }
 func (p *CreateAdOrderReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
@@ -1427,10 +1427,10 @@ WriteFieldEndError:
 }
 
 func (p *CreateAdOrderReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("budget", thrift.DOUBLE, 3); err != nil {
+	if err = oprot.WriteFieldBegin("budget", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Budget); err != nil {
+	if err := oprot.WriteString(p.Budget); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
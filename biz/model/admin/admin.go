@@ -1610,124 +1610,57 @@ func (p *SetUserStatusResp) String() string {
 
 }
 
-// ==================== KOL管理 ====================
-// 管理员获取所有KOL列表请求
-type GetAllKolsReq struct {
-	// 搜索关键字（显示名称、国家）
-	Keyword *string `thrift:"keyword,1,optional" json:"keyword,omitempty" query:"keyword"`
-	// 状态筛选：pending, approved, rejected
-	Status *string `thrift:"status,2,optional" json:"status,omitempty" query:"status"`
-	// 国家筛选
-	Country *string `thrift:"country,3,optional" json:"country,omitempty" query:"country"`
-	// 标签筛选
-	Tag      *string `thrift:"tag,4,optional" json:"tag,omitempty" query:"tag"`
-	Page     int32   `thrift:"page,5,optional" json:"page,omitempty" query:"page"`
-	PageSize int32   `thrift:"page_size,6,optional" json:"page_size,omitempty" query:"page_size"`
-}
-
-func NewGetAllKolsReq() *GetAllKolsReq {
-	return &GetAllKolsReq{
-		Page:     1,
-		PageSize: 10,
-	}
-}
-
-func (p *GetAllKolsReq) InitDefault() {
-	p.Page = 1
-	p.PageSize = 10
-}
-
-var GetAllKolsReq_Keyword_DEFAULT string
-
-func (p *GetAllKolsReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return GetAllKolsReq_Keyword_DEFAULT
-	}
-	return *p.Keyword
+// 合并用户账号请求
+// 源账号的登录方式、钱包余额、团队、订单和KOL资料转移到目标账号，源账号随后被删除
+type MergeUsersReq struct {
+	// 被合并的账号（合并后删除）
+	SourceUserID int64 `thrift:"source_user_id,1,required" form:"source_user_id,required" json:"source_user_id,required"`
+	// 保留的账号
+	TargetUserID int64 `thrift:"target_user_id,2,required" form:"target_user_id,required" json:"target_user_id,required"`
+	// 备注
+	Remark *string `thrift:"remark,3,optional" form:"remark" json:"remark,omitempty"`
 }
 
-var GetAllKolsReq_Status_DEFAULT string
-
-func (p *GetAllKolsReq) GetStatus() (v string) {
-	if !p.IsSetStatus() {
-		return GetAllKolsReq_Status_DEFAULT
-	}
-	return *p.Status
+func NewMergeUsersReq() *MergeUsersReq {
+	return &MergeUsersReq{}
 }
 
-var GetAllKolsReq_Country_DEFAULT string
-
-func (p *GetAllKolsReq) GetCountry() (v string) {
-	if !p.IsSetCountry() {
-		return GetAllKolsReq_Country_DEFAULT
-	}
-	return *p.Country
+func (p *MergeUsersReq) InitDefault() {
 }
 
-var GetAllKolsReq_Tag_DEFAULT string
-
-func (p *GetAllKolsReq) GetTag() (v string) {
-	if !p.IsSetTag() {
-		return GetAllKolsReq_Tag_DEFAULT
-	}
-	return *p.Tag
+func (p *MergeUsersReq) GetSourceUserID() (v int64) {
+	return p.SourceUserID
 }
 
-var GetAllKolsReq_Page_DEFAULT int32 = 1
-
-func (p *GetAllKolsReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetAllKolsReq_Page_DEFAULT
-	}
-	return p.Page
+func (p *MergeUsersReq) GetTargetUserID() (v int64) {
+	return p.TargetUserID
 }
 
-var GetAllKolsReq_PageSize_DEFAULT int32 = 10
+var MergeUsersReq_Remark_DEFAULT string
 
-func (p *GetAllKolsReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetAllKolsReq_PageSize_DEFAULT
+func (p *MergeUsersReq) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return MergeUsersReq_Remark_DEFAULT
 	}
-	return p.PageSize
-}
-
-var fieldIDToName_GetAllKolsReq = map[int16]string{
-	1: "keyword",
-	2: "status",
-	3: "country",
-	4: "tag",
-	5: "page",
-	6: "page_size",
-}
-
-func (p *GetAllKolsReq) IsSetKeyword() bool {
-	return p.Keyword != nil
-}
-
-func (p *GetAllKolsReq) IsSetStatus() bool {
-	return p.Status != nil
-}
-
-func (p *GetAllKolsReq) IsSetCountry() bool {
-	return p.Country != nil
-}
-
-func (p *GetAllKolsReq) IsSetTag() bool {
-	return p.Tag != nil
+	return *p.Remark
 }
 
-func (p *GetAllKolsReq) IsSetPage() bool {
-	return p.Page != GetAllKolsReq_Page_DEFAULT
+var fieldIDToName_MergeUsersReq = map[int16]string{
+	1: "source_user_id",
+	2: "target_user_id",
+	3: "remark",
 }
 
-func (p *GetAllKolsReq) IsSetPageSize() bool {
-	return p.PageSize != GetAllKolsReq_PageSize_DEFAULT
+func (p *MergeUsersReq) IsSetRemark() bool {
+	return p.Remark != nil
 }
 
-func (p *GetAllKolsReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *MergeUsersReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetSourceUserID bool = false
+	var issetTargetUserID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1744,18 +1677,20 @@ func (p *GetAllKolsReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetSourceUserID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetTargetUserID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1767,30 +1702,6 @@ func (p *GetAllKolsReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1804,13 +1715,22 @@ func (p *GetAllKolsReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetSourceUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTargetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllKolsReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MergeUsersReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1818,42 +1738,33 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_MergeUsersReq[fieldId]))
 }
 
-func (p *GetAllKolsReq) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Keyword = _field
-	return nil
-}
-func (p *GetAllKolsReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *MergeUsersReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Status = _field
+	p.SourceUserID = _field
 	return nil
 }
-func (p *GetAllKolsReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *MergeUsersReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Country = _field
+	p.TargetUserID = _field
 	return nil
 }
-func (p *GetAllKolsReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *MergeUsersReq) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1861,35 +1772,13 @@ func (p *GetAllKolsReq) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Tag = _field
-	return nil
-}
-func (p *GetAllKolsReq) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Page = _field
-	return nil
-}
-func (p *GetAllKolsReq) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PageSize = _field
+	p.Remark = _field
 	return nil
 }
 
-func (p *GetAllKolsReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *MergeUsersReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllKolsReq"); err != nil {
+	if err = oprot.WriteStructBegin("MergeUsersReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1905,18 +1794,6 @@ func (p *GetAllKolsReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1935,17 +1812,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetAllKolsReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyword() {
-		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Keyword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *MergeUsersReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("source_user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SourceUserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -1954,17 +1829,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetAllKolsReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *MergeUsersReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TargetUserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -1973,12 +1846,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetAllKolsReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCountry() {
-		if err = oprot.WriteFieldBegin("country", thrift.STRING, 3); err != nil {
+func (p *MergeUsersReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemark() {
+		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Country); err != nil {
+		if err := oprot.WriteString(*p.Remark); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1992,150 +1865,60 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetAllKolsReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTag() {
-		if err = oprot.WriteFieldBegin("tag", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Tag); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *MergeUsersReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *GetAllKolsReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(p.Page); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *GetAllKolsReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(p.PageSize); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *GetAllKolsReq) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetAllKolsReq(%+v)", *p)
-
-}
-
-// KOL列表项（简化版，用于列表展示）
-type KolListItem struct {
-	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
-	UserID      int64  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
-	DisplayName string `thrift:"display_name,3" form:"display_name" json:"display_name" query:"display_name"`
-	AvatarURL   string `thrift:"avatar_url,4" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
-	Country     string `thrift:"country,5" form:"country" json:"country" query:"country"`
-	// pending, approved, rejected
-	Status         string `thrift:"status,6" form:"status" json:"status" query:"status"`
-	TotalFollowers *int64 `thrift:"total_followers,7,optional" form:"total_followers" json:"total_followers,omitempty" query:"total_followers"`
-	CreatedAt      string `thrift:"created_at,8" form:"created_at" json:"created_at" query:"created_at"`
-	UpdatedAt      string `thrift:"updated_at,9" form:"updated_at" json:"updated_at" query:"updated_at"`
-}
+	return fmt.Sprintf("MergeUsersReq(%+v)", *p)
 
-func NewKolListItem() *KolListItem {
-	return &KolListItem{}
-}
-
-func (p *KolListItem) InitDefault() {
-}
-
-func (p *KolListItem) GetID() (v int64) {
-	return p.ID
 }
 
-func (p *KolListItem) GetUserID() (v int64) {
-	return p.UserID
+// 合并用户账号响应
+type MergeUsersResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	// 合并后的目标账号
+	User *UserListItem `thrift:"user,2" form:"user" json:"user" query:"user"`
 }
 
-func (p *KolListItem) GetDisplayName() (v string) {
-	return p.DisplayName
+func NewMergeUsersResp() *MergeUsersResp {
+	return &MergeUsersResp{}
 }
 
-func (p *KolListItem) GetAvatarURL() (v string) {
-	return p.AvatarURL
+func (p *MergeUsersResp) InitDefault() {
 }
 
-func (p *KolListItem) GetCountry() (v string) {
-	return p.Country
-}
+var MergeUsersResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *KolListItem) GetStatus() (v string) {
-	return p.Status
+func (p *MergeUsersResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return MergeUsersResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
 }
 
-var KolListItem_TotalFollowers_DEFAULT int64
+var MergeUsersResp_User_DEFAULT *UserListItem
 
-func (p *KolListItem) GetTotalFollowers() (v int64) {
-	if !p.IsSetTotalFollowers() {
-		return KolListItem_TotalFollowers_DEFAULT
+func (p *MergeUsersResp) GetUser() (v *UserListItem) {
+	if !p.IsSetUser() {
+		return MergeUsersResp_User_DEFAULT
 	}
-	return *p.TotalFollowers
-}
-
-func (p *KolListItem) GetCreatedAt() (v string) {
-	return p.CreatedAt
+	return p.User
 }
 
-func (p *KolListItem) GetUpdatedAt() (v string) {
-	return p.UpdatedAt
+var fieldIDToName_MergeUsersResp = map[int16]string{
+	1: "base_resp",
+	2: "user",
 }
 
-var fieldIDToName_KolListItem = map[int16]string{
-	1: "id",
-	2: "user_id",
-	3: "display_name",
-	4: "avatar_url",
-	5: "country",
-	6: "status",
-	7: "total_followers",
-	8: "created_at",
-	9: "updated_at",
+func (p *MergeUsersResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *KolListItem) IsSetTotalFollowers() bool {
-	return p.TotalFollowers != nil
+func (p *MergeUsersResp) IsSetUser() bool {
+	return p.User != nil
 }
 
-func (p *KolListItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *MergeUsersResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2155,7 +1938,7 @@ func (p *KolListItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2163,69 +1946,13 @@ func (p *KolListItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2245,7 +1972,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolListItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MergeUsersResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2255,380 +1982,212 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolListItem) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *MergeUsersResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.BaseResp = _field
 	return nil
 }
-func (p *KolListItem) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *MergeUsersResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewUserListItem()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserID = _field
+	p.User = _field
 	return nil
 }
-func (p *KolListItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *MergeUsersResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MergeUsersResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.DisplayName = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *KolListItem) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *MergeUsersResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.AvatarURL = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *KolListItem) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *MergeUsersResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.User.Write(oprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Country = _field
-	return nil
-}
-func (p *KolListItem) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Status = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *KolListItem) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *MergeUsersResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.TotalFollowers = _field
-	return nil
-}
-func (p *KolListItem) ReadField8(iprot thrift.TProtocol) error {
+	return fmt.Sprintf("MergeUsersResp(%+v)", *p)
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatedAt = _field
-	return nil
 }
-func (p *KolListItem) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UpdatedAt = _field
-	return nil
+// ==================== KOL管理 ====================
+// 管理员获取所有KOL列表请求
+type GetAllKolsReq struct {
+	// 搜索关键字（显示名称、国家）
+	Keyword *string `thrift:"keyword,1,optional" json:"keyword,omitempty" query:"keyword"`
+	// 状态筛选：pending, approved, rejected
+	Status *string `thrift:"status,2,optional" json:"status,omitempty" query:"status"`
+	// 国家筛选
+	Country *string `thrift:"country,3,optional" json:"country,omitempty" query:"country"`
+	// 标签筛选
+	Tag      *string `thrift:"tag,4,optional" json:"tag,omitempty" query:"tag"`
+	Page     int32   `thrift:"page,5,optional" json:"page,omitempty" query:"page"`
+	PageSize int32   `thrift:"page_size,6,optional" json:"page_size,omitempty" query:"page_size"`
 }
 
-func (p *KolListItem) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("KolListItem"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+func NewGetAllKolsReq() *GetAllKolsReq {
+	return &GetAllKolsReq{
+		Page:     1,
+		PageSize: 10,
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolListItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+func (p *GetAllKolsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 10
 }
 
-func (p *KolListItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+var GetAllKolsReq_Keyword_DEFAULT string
+
+func (p *GetAllKolsReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return GetAllKolsReq_Keyword_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return *p.Keyword
 }
 
-func (p *KolListItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("display_name", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.DisplayName); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+var GetAllKolsReq_Status_DEFAULT string
+
+func (p *GetAllKolsReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return GetAllKolsReq_Status_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return *p.Status
 }
 
-func (p *KolListItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AvatarURL); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+var GetAllKolsReq_Country_DEFAULT string
+
+func (p *GetAllKolsReq) GetCountry() (v string) {
+	if !p.IsSetCountry() {
+		return GetAllKolsReq_Country_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return *p.Country
 }
 
-func (p *KolListItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("country", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Country); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+var GetAllKolsReq_Tag_DEFAULT string
+
+func (p *GetAllKolsReq) GetTag() (v string) {
+	if !p.IsSetTag() {
+		return GetAllKolsReq_Tag_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return *p.Tag
 }
 
-func (p *KolListItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+var GetAllKolsReq_Page_DEFAULT int32 = 1
+
+func (p *GetAllKolsReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetAllKolsReq_Page_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return p.Page
 }
 
-func (p *KolListItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTotalFollowers() {
-		if err = oprot.WriteFieldBegin("total_followers", thrift.I64, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.TotalFollowers); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+var GetAllKolsReq_PageSize_DEFAULT int32 = 10
+
+func (p *GetAllKolsReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetAllKolsReq_PageSize_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return p.PageSize
 }
 
-func (p *KolListItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *KolListItem) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.STRING, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
-}
-
-func (p *KolListItem) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("KolListItem(%+v)", *p)
-
-}
-
-// 管理员获取所有KOL列表响应
-type GetAllKolsResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Kols     []*KolListItem   `thrift:"kols,2,default,list<KolListItem>" form:"kols" json:"kols" query:"kols"`
-	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
-}
-
-func NewGetAllKolsResp() *GetAllKolsResp {
-	return &GetAllKolsResp{}
-}
-
-func (p *GetAllKolsResp) InitDefault() {
+var fieldIDToName_GetAllKolsReq = map[int16]string{
+	1: "keyword",
+	2: "status",
+	3: "country",
+	4: "tag",
+	5: "page",
+	6: "page_size",
 }
 
-var GetAllKolsResp_BaseResp_DEFAULT *common.BaseResp
-
-func (p *GetAllKolsResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetAllKolsResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+func (p *GetAllKolsReq) IsSetKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *GetAllKolsResp) GetKols() (v []*KolListItem) {
-	return p.Kols
+func (p *GetAllKolsReq) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-var GetAllKolsResp_PageInfo_DEFAULT *common.PageResp
-
-func (p *GetAllKolsResp) GetPageInfo() (v *common.PageResp) {
-	if !p.IsSetPageInfo() {
-		return GetAllKolsResp_PageInfo_DEFAULT
-	}
-	return p.PageInfo
+func (p *GetAllKolsReq) IsSetCountry() bool {
+	return p.Country != nil
 }
 
-var fieldIDToName_GetAllKolsResp = map[int16]string{
-	1: "base_resp",
-	2: "kols",
-	3: "page_info",
+func (p *GetAllKolsReq) IsSetTag() bool {
+	return p.Tag != nil
 }
 
-func (p *GetAllKolsResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GetAllKolsReq) IsSetPage() bool {
+	return p.Page != GetAllKolsReq_Page_DEFAULT
 }
 
-func (p *GetAllKolsResp) IsSetPageInfo() bool {
-	return p.PageInfo != nil
+func (p *GetAllKolsReq) IsSetPageSize() bool {
+	return p.PageSize != GetAllKolsReq_PageSize_DEFAULT
 }
 
-func (p *GetAllKolsResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetAllKolsReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2648,7 +2207,7 @@ func (p *GetAllKolsResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2656,7 +2215,7 @@ func (p *GetAllKolsResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2664,13 +2223,37 @@ func (p *GetAllKolsResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2690,7 +2273,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllKolsResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllKolsReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2700,49 +2283,76 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetAllKolsResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetAllKolsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.Keyword = _field
 	return nil
 }
-func (p *GetAllKolsResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *GetAllKolsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*KolListItem, 0, size)
-	values := make([]KolListItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
+	p.Status = _field
+	return nil
+}
+func (p *GetAllKolsReq) ReadField3(iprot thrift.TProtocol) error {
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Country = _field
+	return nil
+}
+func (p *GetAllKolsReq) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.Tag = _field
+	return nil
+}
+func (p *GetAllKolsReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Kols = _field
+	p.Page = _field
 	return nil
 }
-func (p *GetAllKolsResp) ReadField3(iprot thrift.TProtocol) error {
-	_field := common.NewPageResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetAllKolsReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.PageInfo = _field
+	p.PageSize = _field
 	return nil
 }
 
-func (p *GetAllKolsResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetAllKolsReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllKolsResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllKolsReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2758,6 +2368,18 @@ func (p *GetAllKolsResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2776,15 +2398,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetAllKolsResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.BaseResp.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetAllKolsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2793,24 +2417,18 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetAllKolsResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("kols", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Kols)); err != nil {
-		return err
-	}
-	for _, v := range p.Kols {
-		if err := v.Write(oprot); err != nil {
+func (p *GetAllKolsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
 			return err
 		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
 	return nil
 WriteFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
@@ -2818,15 +2436,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetAllKolsResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.PageInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetAllKolsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCountry() {
+		if err = oprot.WriteFieldBegin("country", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Country); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2835,57 +2455,150 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetAllKolsResp) String() string {
+func (p *GetAllKolsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTag() {
+		if err = oprot.WriteFieldBegin("tag", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Tag); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetAllKolsReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetAllKolsReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetAllKolsReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetAllKolsResp(%+v)", *p)
+	return fmt.Sprintf("GetAllKolsReq(%+v)", *p)
 
 }
 
-// 管理员审核KOL请求
-type AdminReviewKolReq struct {
-	KolID int64 `thrift:"kol_id,1" form:"kol_id" json:"kol_id"`
-	// approved, rejected
-	Status       string  `thrift:"status,2" form:"status" json:"status"`
-	RejectReason *string `thrift:"reject_reason,3,optional" form:"reject_reason" json:"reject_reason,omitempty"`
+// KOL列表项（简化版，用于列表展示）
+type KolListItem struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
+	UserID      int64  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	DisplayName string `thrift:"display_name,3" form:"display_name" json:"display_name" query:"display_name"`
+	AvatarURL   string `thrift:"avatar_url,4" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
+	Country     string `thrift:"country,5" form:"country" json:"country" query:"country"`
+	// pending, approved, rejected
+	Status         string `thrift:"status,6" form:"status" json:"status" query:"status"`
+	TotalFollowers *int64 `thrift:"total_followers,7,optional" form:"total_followers" json:"total_followers,omitempty" query:"total_followers"`
+	CreatedAt      string `thrift:"created_at,8" form:"created_at" json:"created_at" query:"created_at"`
+	UpdatedAt      string `thrift:"updated_at,9" form:"updated_at" json:"updated_at" query:"updated_at"`
 }
 
-func NewAdminReviewKolReq() *AdminReviewKolReq {
-	return &AdminReviewKolReq{}
+func NewKolListItem() *KolListItem {
+	return &KolListItem{}
 }
 
-func (p *AdminReviewKolReq) InitDefault() {
+func (p *KolListItem) InitDefault() {
 }
 
-func (p *AdminReviewKolReq) GetKolID() (v int64) {
-	return p.KolID
+func (p *KolListItem) GetID() (v int64) {
+	return p.ID
 }
 
-func (p *AdminReviewKolReq) GetStatus() (v string) {
+func (p *KolListItem) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *KolListItem) GetDisplayName() (v string) {
+	return p.DisplayName
+}
+
+func (p *KolListItem) GetAvatarURL() (v string) {
+	return p.AvatarURL
+}
+
+func (p *KolListItem) GetCountry() (v string) {
+	return p.Country
+}
+
+func (p *KolListItem) GetStatus() (v string) {
 	return p.Status
 }
 
-var AdminReviewKolReq_RejectReason_DEFAULT string
+var KolListItem_TotalFollowers_DEFAULT int64
 
-func (p *AdminReviewKolReq) GetRejectReason() (v string) {
-	if !p.IsSetRejectReason() {
-		return AdminReviewKolReq_RejectReason_DEFAULT
+func (p *KolListItem) GetTotalFollowers() (v int64) {
+	if !p.IsSetTotalFollowers() {
+		return KolListItem_TotalFollowers_DEFAULT
 	}
-	return *p.RejectReason
+	return *p.TotalFollowers
 }
 
-var fieldIDToName_AdminReviewKolReq = map[int16]string{
-	1: "kol_id",
-	2: "status",
-	3: "reject_reason",
+func (p *KolListItem) GetCreatedAt() (v string) {
+	return p.CreatedAt
 }
 
-func (p *AdminReviewKolReq) IsSetRejectReason() bool {
-	return p.RejectReason != nil
+func (p *KolListItem) GetUpdatedAt() (v string) {
+	return p.UpdatedAt
 }
 
-func (p *AdminReviewKolReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_KolListItem = map[int16]string{
+	1: "id",
+	2: "user_id",
+	3: "display_name",
+	4: "avatar_url",
+	5: "country",
+	6: "status",
+	7: "total_followers",
+	8: "created_at",
+	9: "updated_at",
+}
+
+func (p *KolListItem) IsSetTotalFollowers() bool {
+	return p.TotalFollowers != nil
+}
+
+func (p *KolListItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2913,7 +2626,7 @@ func (p *AdminReviewKolReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2928,6 +2641,54 @@ func (p *AdminReviewKolReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2947,7 +2708,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminReviewKolReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolListItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2957,7 +2718,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminReviewKolReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *KolListItem) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2965,10 +2726,21 @@ func (p *AdminReviewKolReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.KolID = _field
+	p.ID = _field
 	return nil
 }
-func (p *AdminReviewKolReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *KolListItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *KolListItem) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2976,26 +2748,81 @@ func (p *AdminReviewKolReq) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Status = _field
+	p.DisplayName = _field
 	return nil
 }
-func (p *AdminReviewKolReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *KolListItem) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.RejectReason = _field
+	p.AvatarURL = _field
 	return nil
 }
+func (p *KolListItem) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *AdminReviewKolReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminReviewKolReq"); err != nil {
-		goto WriteStructBeginError
-	}
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Country = _field
+	return nil
+}
+func (p *KolListItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *KolListItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TotalFollowers = _field
+	return nil
+}
+func (p *KolListItem) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *KolListItem) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *KolListItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("KolListItem"); err != nil {
+		goto WriteStructBeginError
+	}
 	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
 			fieldId = 1
@@ -3009,6 +2836,30 @@ func (p *AdminReviewKolReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3027,11 +2878,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminReviewKolReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+func (p *KolListItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.KolID); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3044,11 +2895,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminReviewKolReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+func (p *KolListItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Status); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3061,12 +2912,80 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AdminReviewKolReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRejectReason() {
-		if err = oprot.WriteFieldBegin("reject_reason", thrift.STRING, 3); err != nil {
+func (p *KolListItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("display_name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.DisplayName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *KolListItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AvatarURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *KolListItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("country", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Country); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *KolListItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *KolListItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalFollowers() {
+		if err = oprot.WriteFieldBegin("total_followers", thrift.I64, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RejectReason); err != nil {
+		if err := oprot.WriteI64(*p.TotalFollowers); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3075,49 +2994,104 @@ func (p *AdminReviewKolReq) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *AdminReviewKolReq) String() string {
+func (p *KolListItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *KolListItem) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *KolListItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminReviewKolReq(%+v)", *p)
+	return fmt.Sprintf("KolListItem(%+v)", *p)
 
 }
 
-// 管理员审核KOL响应
-type AdminReviewKolResp struct {
+// 管理员获取所有KOL列表响应
+type GetAllKolsResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Kols     []*KolListItem   `thrift:"kols,2,default,list<KolListItem>" form:"kols" json:"kols" query:"kols"`
+	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
 }
 
-func NewAdminReviewKolResp() *AdminReviewKolResp {
-	return &AdminReviewKolResp{}
+func NewGetAllKolsResp() *GetAllKolsResp {
+	return &GetAllKolsResp{}
 }
 
-func (p *AdminReviewKolResp) InitDefault() {
+func (p *GetAllKolsResp) InitDefault() {
 }
 
-var AdminReviewKolResp_BaseResp_DEFAULT *common.BaseResp
+var GetAllKolsResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *AdminReviewKolResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetAllKolsResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminReviewKolResp_BaseResp_DEFAULT
+		return GetAllKolsResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminReviewKolResp = map[int16]string{
+func (p *GetAllKolsResp) GetKols() (v []*KolListItem) {
+	return p.Kols
+}
+
+var GetAllKolsResp_PageInfo_DEFAULT *common.PageResp
+
+func (p *GetAllKolsResp) GetPageInfo() (v *common.PageResp) {
+	if !p.IsSetPageInfo() {
+		return GetAllKolsResp_PageInfo_DEFAULT
+	}
+	return p.PageInfo
+}
+
+var fieldIDToName_GetAllKolsResp = map[int16]string{
 	1: "base_resp",
+	2: "kols",
+	3: "page_info",
 }
 
-func (p *AdminReviewKolResp) IsSetBaseResp() bool {
+func (p *GetAllKolsResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminReviewKolResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetAllKolsResp) IsSetPageInfo() bool {
+	return p.PageInfo != nil
+}
+
+func (p *GetAllKolsResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3144,6 +3118,22 @@ func (p *AdminReviewKolResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3163,7 +3153,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminReviewKolResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllKolsResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3173,7 +3163,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminReviewKolResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetAllKolsResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3181,10 +3171,41 @@ func (p *AdminReviewKolResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
+func (p *GetAllKolsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolListItem, 0, size)
+	values := make([]KolListItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *AdminReviewKolResp) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Kols = _field
+	return nil
+}
+func (p *GetAllKolsResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := common.NewPageResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PageInfo = _field
+	return nil
+}
+
+func (p *GetAllKolsResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminReviewKolResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllKolsResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3192,6 +3213,14 @@ func (p *AdminReviewKolResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3210,7 +3239,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminReviewKolResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetAllKolsResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3227,81 +3256,99 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminReviewKolResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetAllKolsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kols", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("AdminReviewKolResp(%+v)", *p)
-
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Kols)); err != nil {
+		return err
+	}
+	for _, v := range p.Kols {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-// ==================== 团队管理 ====================
-// 管理员获取所有团队列表请求
-type GetAllTeamsReq struct {
-	// 搜索关键字（团队名称）
-	Keyword  *string `thrift:"keyword,1,optional" json:"keyword,omitempty" query:"keyword"`
-	Page     int32   `thrift:"page,2,optional" json:"page,omitempty" query:"page"`
-	PageSize int32   `thrift:"page_size,3,optional" json:"page_size,omitempty" query:"page_size"`
+func (p *GetAllKolsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.PageInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewGetAllTeamsReq() *GetAllTeamsReq {
-	return &GetAllTeamsReq{
-		Page:     1,
-		PageSize: 10,
+func (p *GetAllKolsResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-}
+	return fmt.Sprintf("GetAllKolsResp(%+v)", *p)
 
-func (p *GetAllTeamsReq) InitDefault() {
-	p.Page = 1
-	p.PageSize = 10
 }
 
-var GetAllTeamsReq_Keyword_DEFAULT string
-
-func (p *GetAllTeamsReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return GetAllTeamsReq_Keyword_DEFAULT
-	}
-	return *p.Keyword
+// 管理员审核KOL请求
+type AdminReviewKolReq struct {
+	KolID int64 `thrift:"kol_id,1" form:"kol_id" json:"kol_id"`
+	// approved, rejected
+	Status       string  `thrift:"status,2" form:"status" json:"status"`
+	RejectReason *string `thrift:"reject_reason,3,optional" form:"reject_reason" json:"reject_reason,omitempty"`
 }
 
-var GetAllTeamsReq_Page_DEFAULT int32 = 1
-
-func (p *GetAllTeamsReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetAllTeamsReq_Page_DEFAULT
-	}
-	return p.Page
+func NewAdminReviewKolReq() *AdminReviewKolReq {
+	return &AdminReviewKolReq{}
 }
 
-var GetAllTeamsReq_PageSize_DEFAULT int32 = 10
+func (p *AdminReviewKolReq) InitDefault() {
+}
 
-func (p *GetAllTeamsReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetAllTeamsReq_PageSize_DEFAULT
-	}
-	return p.PageSize
+func (p *AdminReviewKolReq) GetKolID() (v int64) {
+	return p.KolID
 }
 
-var fieldIDToName_GetAllTeamsReq = map[int16]string{
-	1: "keyword",
-	2: "page",
-	3: "page_size",
+func (p *AdminReviewKolReq) GetStatus() (v string) {
+	return p.Status
 }
 
-func (p *GetAllTeamsReq) IsSetKeyword() bool {
-	return p.Keyword != nil
+var AdminReviewKolReq_RejectReason_DEFAULT string
+
+func (p *AdminReviewKolReq) GetRejectReason() (v string) {
+	if !p.IsSetRejectReason() {
+		return AdminReviewKolReq_RejectReason_DEFAULT
+	}
+	return *p.RejectReason
 }
 
-func (p *GetAllTeamsReq) IsSetPage() bool {
-	return p.Page != GetAllTeamsReq_Page_DEFAULT
+var fieldIDToName_AdminReviewKolReq = map[int16]string{
+	1: "kol_id",
+	2: "status",
+	3: "reject_reason",
 }
 
-func (p *GetAllTeamsReq) IsSetPageSize() bool {
-	return p.PageSize != GetAllTeamsReq_PageSize_DEFAULT
+func (p *AdminReviewKolReq) IsSetRejectReason() bool {
+	return p.RejectReason != nil
 }
 
-func (p *GetAllTeamsReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminReviewKolReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3321,7 +3368,7 @@ func (p *GetAllTeamsReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3329,7 +3376,7 @@ func (p *GetAllTeamsReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3337,7 +3384,7 @@ func (p *GetAllTeamsReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3363,7 +3410,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllTeamsReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminReviewKolReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3373,43 +3420,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetAllTeamsReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *AdminReviewKolReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Keyword = _field
+	p.KolID = _field
 	return nil
 }
-func (p *GetAllTeamsReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *AdminReviewKolReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Page = _field
+	p.Status = _field
 	return nil
 }
-func (p *GetAllTeamsReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *AdminReviewKolReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.PageSize = _field
+	p.RejectReason = _field
 	return nil
 }
 
-func (p *GetAllTeamsReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminReviewKolReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllTeamsReq"); err != nil {
+	if err = oprot.WriteStructBegin("AdminReviewKolReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3443,17 +3490,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetAllTeamsReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyword() {
-		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Keyword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AdminReviewKolReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.KolID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3462,17 +3507,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetAllTeamsReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(p.Page); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AdminReviewKolReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3481,12 +3524,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetAllTeamsReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+func (p *AdminReviewKolReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRejectReason() {
+		if err = oprot.WriteFieldBegin("reject_reason", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(p.PageSize); err != nil {
+		if err := oprot.WriteString(*p.RejectReason); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3500,89 +3543,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetAllTeamsReq) String() string {
+func (p *AdminReviewKolReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetAllTeamsReq(%+v)", *p)
-
-}
-
-// 团队列表项
-type TeamListItem struct {
-	ID          int64   `thrift:"id,1" form:"id" json:"id" query:"id"`
-	Name        string  `thrift:"name,2" form:"name" json:"name" query:"name"`
-	IconURL     *string `thrift:"icon_url,3,optional" form:"icon_url" json:"icon_url,omitempty" query:"icon_url"`
-	CreatorID   int64   `thrift:"creator_id,4" form:"creator_id" json:"creator_id" query:"creator_id"`
-	CreatorName *string `thrift:"creator_name,5,optional" form:"creator_name" json:"creator_name,omitempty" query:"creator_name"`
-	MemberCount int64   `thrift:"member_count,6" form:"member_count" json:"member_count" query:"member_count"`
-	CreatedAt   string  `thrift:"created_at,7" form:"created_at" json:"created_at" query:"created_at"`
-}
-
-func NewTeamListItem() *TeamListItem {
-	return &TeamListItem{}
-}
-
-func (p *TeamListItem) InitDefault() {
-}
+	return fmt.Sprintf("AdminReviewKolReq(%+v)", *p)
 
-func (p *TeamListItem) GetID() (v int64) {
-	return p.ID
 }
 
-func (p *TeamListItem) GetName() (v string) {
-	return p.Name
+// 管理员审核KOL响应
+type AdminReviewKolResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
 }
 
-var TeamListItem_IconURL_DEFAULT string
-
-func (p *TeamListItem) GetIconURL() (v string) {
-	if !p.IsSetIconURL() {
-		return TeamListItem_IconURL_DEFAULT
-	}
-	return *p.IconURL
+func NewAdminReviewKolResp() *AdminReviewKolResp {
+	return &AdminReviewKolResp{}
 }
 
-func (p *TeamListItem) GetCreatorID() (v int64) {
-	return p.CreatorID
+func (p *AdminReviewKolResp) InitDefault() {
 }
 
-var TeamListItem_CreatorName_DEFAULT string
+var AdminReviewKolResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *TeamListItem) GetCreatorName() (v string) {
-	if !p.IsSetCreatorName() {
-		return TeamListItem_CreatorName_DEFAULT
+func (p *AdminReviewKolResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminReviewKolResp_BaseResp_DEFAULT
 	}
-	return *p.CreatorName
-}
-
-func (p *TeamListItem) GetMemberCount() (v int64) {
-	return p.MemberCount
-}
-
-func (p *TeamListItem) GetCreatedAt() (v string) {
-	return p.CreatedAt
-}
-
-var fieldIDToName_TeamListItem = map[int16]string{
-	1: "id",
-	2: "name",
-	3: "icon_url",
-	4: "creator_id",
-	5: "creator_name",
-	6: "member_count",
-	7: "created_at",
+	return p.BaseResp
 }
 
-func (p *TeamListItem) IsSetIconURL() bool {
-	return p.IconURL != nil
+var fieldIDToName_AdminReviewKolResp = map[int16]string{
+	1: "base_resp",
 }
 
-func (p *TeamListItem) IsSetCreatorName() bool {
-	return p.CreatorName != nil
+func (p *AdminReviewKolResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *TeamListItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminReviewKolResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3602,61 +3600,13 @@ func (p *TeamListItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3676,7 +3626,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminReviewKolResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3686,87 +3636,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamListItem) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *TeamListItem) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Name = _field
-	return nil
-}
-func (p *TeamListItem) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IconURL = _field
-	return nil
-}
-func (p *TeamListItem) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatorID = _field
-	return nil
-}
-func (p *TeamListItem) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.CreatorName = _field
-	return nil
-}
-func (p *TeamListItem) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.MemberCount = _field
-	return nil
-}
-func (p *TeamListItem) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *AdminReviewKolResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.CreatedAt = _field
+	p.BaseResp = _field
 	return nil
 }
 
-func (p *TeamListItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminReviewKolResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamListItem"); err != nil {
+	if err = oprot.WriteStructBegin("AdminReviewKolResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3774,30 +3655,6 @@ func (p *TeamListItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3816,11 +3673,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamListItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *AdminReviewKolResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := p.BaseResp.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3833,171 +3690,81 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamListItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Name); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *AdminReviewKolResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
+	return fmt.Sprintf("AdminReviewKolResp(%+v)", *p)
 
-func (p *TeamListItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIconURL() {
-		if err = oprot.WriteFieldBegin("icon_url", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.IconURL); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamListItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator_id", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatorID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+// ==================== 团队管理 ====================
+// 管理员获取所有团队列表请求
+type GetAllTeamsReq struct {
+	// 搜索关键字（团队名称）
+	Keyword  *string `thrift:"keyword,1,optional" json:"keyword,omitempty" query:"keyword"`
+	Page     int32   `thrift:"page,2,optional" json:"page,omitempty" query:"page"`
+	PageSize int32   `thrift:"page_size,3,optional" json:"page_size,omitempty" query:"page_size"`
 }
 
-func (p *TeamListItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatorName() {
-		if err = oprot.WriteFieldBegin("creator_name", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.CreatorName); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func NewGetAllTeamsReq() *GetAllTeamsReq {
+	return &GetAllTeamsReq{
+		Page:     1,
+		PageSize: 10,
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamListItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("member_count", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.MemberCount); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+func (p *GetAllTeamsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 10
 }
 
-func (p *TeamListItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
+var GetAllTeamsReq_Keyword_DEFAULT string
 
-func (p *TeamListItem) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetAllTeamsReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return GetAllTeamsReq_Keyword_DEFAULT
 	}
-	return fmt.Sprintf("TeamListItem(%+v)", *p)
-
-}
-
-// 管理员获取所有团队列表响应
-type GetAllTeamsResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Teams    []*TeamListItem  `thrift:"teams,2,default,list<TeamListItem>" form:"teams" json:"teams" query:"teams"`
-	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
+	return *p.Keyword
 }
 
-func NewGetAllTeamsResp() *GetAllTeamsResp {
-	return &GetAllTeamsResp{}
-}
+var GetAllTeamsReq_Page_DEFAULT int32 = 1
 
-func (p *GetAllTeamsResp) InitDefault() {
+func (p *GetAllTeamsReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetAllTeamsReq_Page_DEFAULT
+	}
+	return p.Page
 }
 
-var GetAllTeamsResp_BaseResp_DEFAULT *common.BaseResp
+var GetAllTeamsReq_PageSize_DEFAULT int32 = 10
 
-func (p *GetAllTeamsResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetAllTeamsResp_BaseResp_DEFAULT
+func (p *GetAllTeamsReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetAllTeamsReq_PageSize_DEFAULT
 	}
-	return p.BaseResp
-}
-
-func (p *GetAllTeamsResp) GetTeams() (v []*TeamListItem) {
-	return p.Teams
+	return p.PageSize
 }
 
-var GetAllTeamsResp_PageInfo_DEFAULT *common.PageResp
-
-func (p *GetAllTeamsResp) GetPageInfo() (v *common.PageResp) {
-	if !p.IsSetPageInfo() {
-		return GetAllTeamsResp_PageInfo_DEFAULT
-	}
-	return p.PageInfo
+var fieldIDToName_GetAllTeamsReq = map[int16]string{
+	1: "keyword",
+	2: "page",
+	3: "page_size",
 }
 
-var fieldIDToName_GetAllTeamsResp = map[int16]string{
-	1: "base_resp",
-	2: "teams",
-	3: "page_info",
+func (p *GetAllTeamsReq) IsSetKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *GetAllTeamsResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GetAllTeamsReq) IsSetPage() bool {
+	return p.Page != GetAllTeamsReq_Page_DEFAULT
 }
 
-func (p *GetAllTeamsResp) IsSetPageInfo() bool {
-	return p.PageInfo != nil
+func (p *GetAllTeamsReq) IsSetPageSize() bool {
+	return p.PageSize != GetAllTeamsReq_PageSize_DEFAULT
 }
 
-func (p *GetAllTeamsResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetAllTeamsReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4017,7 +3784,7 @@ func (p *GetAllTeamsResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4025,7 +3792,7 @@ func (p *GetAllTeamsResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4033,7 +3800,7 @@ func (p *GetAllTeamsResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4059,7 +3826,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllTeamsResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllTeamsReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4069,49 +3836,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetAllTeamsResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetAllTeamsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.Keyword = _field
 	return nil
 }
-func (p *GetAllTeamsResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*TeamListItem, 0, size)
-	values := make([]TeamListItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *GetAllTeamsReq) ReadField2(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Teams = _field
+	p.Page = _field
 	return nil
 }
-func (p *GetAllTeamsResp) ReadField3(iprot thrift.TProtocol) error {
-	_field := common.NewPageResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetAllTeamsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.PageInfo = _field
+	p.PageSize = _field
 	return nil
 }
 
-func (p *GetAllTeamsResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetAllTeamsReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllTeamsResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllTeamsReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4145,40 +3906,36 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetAllTeamsResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.BaseResp.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+func (p *GetAllTeamsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetAllTeamsResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("teams", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Teams)); err != nil {
-		return err
-	}
-	for _, v := range p.Teams {
-		if err := v.Write(oprot); err != nil {
+func (p *GetAllTeamsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Page); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4187,15 +3944,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetAllTeamsResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.PageInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetAllTeamsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4204,70 +3963,89 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetAllTeamsResp) String() string {
+func (p *GetAllTeamsReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetAllTeamsResp(%+v)", *p)
+	return fmt.Sprintf("GetAllTeamsReq(%+v)", *p)
 
 }
 
-// 管理员获取特定团队的所有用户请求
-type GetTeamMembersReq struct {
-	TeamID   int64 `thrift:"team_id,1" json:"team_id" path:"team_id"`
-	Page     int32 `thrift:"page,2,optional" json:"page,omitempty" query:"page"`
-	PageSize int32 `thrift:"page_size,3,optional" json:"page_size,omitempty" query:"page_size"`
+// 团队列表项
+type TeamListItem struct {
+	ID          int64   `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Name        string  `thrift:"name,2" form:"name" json:"name" query:"name"`
+	IconURL     *string `thrift:"icon_url,3,optional" form:"icon_url" json:"icon_url,omitempty" query:"icon_url"`
+	CreatorID   int64   `thrift:"creator_id,4" form:"creator_id" json:"creator_id" query:"creator_id"`
+	CreatorName *string `thrift:"creator_name,5,optional" form:"creator_name" json:"creator_name,omitempty" query:"creator_name"`
+	MemberCount int64   `thrift:"member_count,6" form:"member_count" json:"member_count" query:"member_count"`
+	CreatedAt   string  `thrift:"created_at,7" form:"created_at" json:"created_at" query:"created_at"`
 }
 
-func NewGetTeamMembersReq() *GetTeamMembersReq {
-	return &GetTeamMembersReq{
-		Page:     1,
-		PageSize: 10,
-	}
+func NewTeamListItem() *TeamListItem {
+	return &TeamListItem{}
 }
 
-func (p *GetTeamMembersReq) InitDefault() {
-	p.Page = 1
-	p.PageSize = 10
+func (p *TeamListItem) InitDefault() {
 }
 
-func (p *GetTeamMembersReq) GetTeamID() (v int64) {
-	return p.TeamID
+func (p *TeamListItem) GetID() (v int64) {
+	return p.ID
 }
 
-var GetTeamMembersReq_Page_DEFAULT int32 = 1
+func (p *TeamListItem) GetName() (v string) {
+	return p.Name
+}
 
-func (p *GetTeamMembersReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetTeamMembersReq_Page_DEFAULT
+var TeamListItem_IconURL_DEFAULT string
+
+func (p *TeamListItem) GetIconURL() (v string) {
+	if !p.IsSetIconURL() {
+		return TeamListItem_IconURL_DEFAULT
 	}
-	return p.Page
+	return *p.IconURL
 }
 
-var GetTeamMembersReq_PageSize_DEFAULT int32 = 10
+func (p *TeamListItem) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
 
-func (p *GetTeamMembersReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetTeamMembersReq_PageSize_DEFAULT
+var TeamListItem_CreatorName_DEFAULT string
+
+func (p *TeamListItem) GetCreatorName() (v string) {
+	if !p.IsSetCreatorName() {
+		return TeamListItem_CreatorName_DEFAULT
 	}
-	return p.PageSize
+	return *p.CreatorName
 }
 
-var fieldIDToName_GetTeamMembersReq = map[int16]string{
-	1: "team_id",
-	2: "page",
-	3: "page_size",
+func (p *TeamListItem) GetMemberCount() (v int64) {
+	return p.MemberCount
 }
 
-func (p *GetTeamMembersReq) IsSetPage() bool {
-	return p.Page != GetTeamMembersReq_Page_DEFAULT
+func (p *TeamListItem) GetCreatedAt() (v string) {
+	return p.CreatedAt
 }
 
-func (p *GetTeamMembersReq) IsSetPageSize() bool {
-	return p.PageSize != GetTeamMembersReq_PageSize_DEFAULT
+var fieldIDToName_TeamListItem = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "icon_url",
+	4: "creator_id",
+	5: "creator_name",
+	6: "member_count",
+	7: "created_at",
 }
 
-func (p *GetTeamMembersReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamListItem) IsSetIconURL() bool {
+	return p.IconURL != nil
+}
+
+func (p *TeamListItem) IsSetCreatorName() bool {
+	return p.CreatorName != nil
+}
+
+func (p *TeamListItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4295,7 +4073,7 @@ func (p *GetTeamMembersReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4303,13 +4081,45 @@ func (p *GetTeamMembersReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4329,7 +4139,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTeamMembersReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4339,7 +4149,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetTeamMembersReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamListItem) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4347,35 +4157,79 @@ func (p *GetTeamMembersReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TeamID = _field
+	p.ID = _field
 	return nil
 }
-func (p *GetTeamMembersReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamListItem) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Page = _field
+	p.Name = _field
 	return nil
 }
-func (p *GetTeamMembersReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamListItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IconURL = _field
+	return nil
+}
+func (p *TeamListItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PageSize = _field
+	p.CreatorID = _field
 	return nil
 }
+func (p *TeamListItem) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *GetTeamMembersReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatorName = _field
+	return nil
+}
+func (p *TeamListItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MemberCount = _field
+	return nil
+}
+func (p *TeamListItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *TeamListItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeamMembersReq"); err != nil {
+	if err = oprot.WriteStructBegin("TeamListItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4391,6 +4245,22 @@ func (p *GetTeamMembersReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4409,11 +4279,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetTeamMembersReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I64, 1); err != nil {
+func (p *TeamListItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.TeamID); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4426,12 +4296,29 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetTeamMembersReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
+func (p *TeamListItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamListItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIconURL() {
+		if err = oprot.WriteFieldBegin("icon_url", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(p.Page); err != nil {
+		if err := oprot.WriteString(*p.IconURL); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4440,17 +4327,34 @@ func (p *GetTeamMembersReq) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetTeamMembersReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+func (p *TeamListItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator_id", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamListItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatorName() {
+		if err = oprot.WriteFieldBegin("creator_name", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(p.PageSize); err != nil {
+		if err := oprot.WriteString(*p.CreatorName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4459,98 +4363,104 @@ func (p *GetTeamMembersReq) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *GetTeamMembersReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TeamListItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_count", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetTeamMembersReq(%+v)", *p)
-
+	if err := oprot.WriteI64(p.MemberCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-// 团队成员项
-type TeamMemberItem struct {
-	UserID    int64   `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
-	Nickname  *string `thrift:"nickname,2,optional" form:"nickname" json:"nickname,omitempty" query:"nickname"`
-	Email     *string `thrift:"email,3,optional" form:"email" json:"email,omitempty" query:"email"`
-	AvatarURL *string `thrift:"avatar_url,4,optional" form:"avatar_url" json:"avatar_url,omitempty" query:"avatar_url"`
-	// creator, owner, member
-	Role     string `thrift:"role,5" form:"role" json:"role" query:"role"`
-	JoinedAt string `thrift:"joined_at,6" form:"joined_at" json:"joined_at" query:"joined_at"`
+func (p *TeamListItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func NewTeamMemberItem() *TeamMemberItem {
-	return &TeamMemberItem{}
-}
+func (p *TeamListItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamListItem(%+v)", *p)
 
-func (p *TeamMemberItem) InitDefault() {
 }
 
-func (p *TeamMemberItem) GetUserID() (v int64) {
-	return p.UserID
+// 管理员获取所有团队列表响应
+type GetAllTeamsResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Teams    []*TeamListItem  `thrift:"teams,2,default,list<TeamListItem>" form:"teams" json:"teams" query:"teams"`
+	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
 }
 
-var TeamMemberItem_Nickname_DEFAULT string
-
-func (p *TeamMemberItem) GetNickname() (v string) {
-	if !p.IsSetNickname() {
-		return TeamMemberItem_Nickname_DEFAULT
-	}
-	return *p.Nickname
+func NewGetAllTeamsResp() *GetAllTeamsResp {
+	return &GetAllTeamsResp{}
 }
 
-var TeamMemberItem_Email_DEFAULT string
-
-func (p *TeamMemberItem) GetEmail() (v string) {
-	if !p.IsSetEmail() {
-		return TeamMemberItem_Email_DEFAULT
-	}
-	return *p.Email
+func (p *GetAllTeamsResp) InitDefault() {
 }
 
-var TeamMemberItem_AvatarURL_DEFAULT string
+var GetAllTeamsResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *TeamMemberItem) GetAvatarURL() (v string) {
-	if !p.IsSetAvatarURL() {
-		return TeamMemberItem_AvatarURL_DEFAULT
+func (p *GetAllTeamsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetAllTeamsResp_BaseResp_DEFAULT
 	}
-	return *p.AvatarURL
+	return p.BaseResp
 }
 
-func (p *TeamMemberItem) GetRole() (v string) {
-	return p.Role
+func (p *GetAllTeamsResp) GetTeams() (v []*TeamListItem) {
+	return p.Teams
 }
 
-func (p *TeamMemberItem) GetJoinedAt() (v string) {
-	return p.JoinedAt
-}
+var GetAllTeamsResp_PageInfo_DEFAULT *common.PageResp
 
-var fieldIDToName_TeamMemberItem = map[int16]string{
-	1: "user_id",
-	2: "nickname",
-	3: "email",
-	4: "avatar_url",
-	5: "role",
-	6: "joined_at",
+func (p *GetAllTeamsResp) GetPageInfo() (v *common.PageResp) {
+	if !p.IsSetPageInfo() {
+		return GetAllTeamsResp_PageInfo_DEFAULT
+	}
+	return p.PageInfo
 }
 
-func (p *TeamMemberItem) IsSetNickname() bool {
-	return p.Nickname != nil
+var fieldIDToName_GetAllTeamsResp = map[int16]string{
+	1: "base_resp",
+	2: "teams",
+	3: "page_info",
 }
 
-func (p *TeamMemberItem) IsSetEmail() bool {
-	return p.Email != nil
+func (p *GetAllTeamsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *TeamMemberItem) IsSetAvatarURL() bool {
-	return p.AvatarURL != nil
+func (p *GetAllTeamsResp) IsSetPageInfo() bool {
+	return p.PageInfo != nil
 }
 
-func (p *TeamMemberItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetAllTeamsResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4570,7 +4480,7 @@ func (p *TeamMemberItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4578,7 +4488,7 @@ func (p *TeamMemberItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4586,37 +4496,13 @@ func (p *TeamMemberItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4636,7 +4522,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamMemberItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllTeamsResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4646,76 +4532,49 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamMemberItem) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *GetAllTeamsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserID = _field
+	p.BaseResp = _field
 	return nil
 }
-func (p *TeamMemberItem) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetAllTeamsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Nickname = _field
-	return nil
-}
-func (p *TeamMemberItem) ReadField3(iprot thrift.TProtocol) error {
+	_field := make([]*TeamListItem, 0, size)
+	values := make([]TeamListItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Email = _field
-	return nil
-}
-func (p *TeamMemberItem) ReadField4(iprot thrift.TProtocol) error {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.AvatarURL = _field
-	return nil
-}
-func (p *TeamMemberItem) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Role = _field
+	p.Teams = _field
 	return nil
 }
-func (p *TeamMemberItem) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetAllTeamsResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := common.NewPageResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.JoinedAt = _field
+	p.PageInfo = _field
 	return nil
 }
 
-func (p *TeamMemberItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetAllTeamsResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamMemberItem"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllTeamsResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4731,18 +4590,6 @@ func (p *TeamMemberItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4761,11 +4608,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamMemberItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *GetAllTeamsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := p.BaseResp.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4778,68 +4625,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamMemberItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNickname() {
-		if err = oprot.WriteFieldBegin("nickname", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Nickname); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetAllTeamsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("teams", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *TeamMemberItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEmail() {
-		if err = oprot.WriteFieldBegin("email", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Email); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Teams)); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *TeamMemberItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAvatarURL() {
-		if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.AvatarURL); err != nil {
+	for _, v := range p.Teams {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *TeamMemberItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("role", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Role); err != nil {
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4847,16 +4645,16 @@ func (p *TeamMemberItem) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamMemberItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("joined_at", thrift.STRING, 6); err != nil {
+func (p *GetAllTeamsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.JoinedAt); err != nil {
+	if err := p.PageInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4864,70 +4662,75 @@ func (p *TeamMemberItem) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamMemberItem) String() string {
+func (p *GetAllTeamsResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamMemberItem(%+v)", *p)
+	return fmt.Sprintf("GetAllTeamsResp(%+v)", *p)
 
 }
 
-// 管理员获取特定团队的所有用户响应
-type GetTeamMembersResp struct {
-	BaseResp *common.BaseResp  `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Members  []*TeamMemberItem `thrift:"members,2,default,list<TeamMemberItem>" form:"members" json:"members" query:"members"`
-	PageInfo *common.PageResp  `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
+// 管理员获取特定团队的所有用户请求
+type GetTeamMembersReq struct {
+	TeamID   int64 `thrift:"team_id,1" json:"team_id" path:"team_id"`
+	Page     int32 `thrift:"page,2,optional" json:"page,omitempty" query:"page"`
+	PageSize int32 `thrift:"page_size,3,optional" json:"page_size,omitempty" query:"page_size"`
 }
 
-func NewGetTeamMembersResp() *GetTeamMembersResp {
-	return &GetTeamMembersResp{}
+func NewGetTeamMembersReq() *GetTeamMembersReq {
+	return &GetTeamMembersReq{
+		Page:     1,
+		PageSize: 10,
+	}
 }
 
-func (p *GetTeamMembersResp) InitDefault() {
+func (p *GetTeamMembersReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 10
 }
 
-var GetTeamMembersResp_BaseResp_DEFAULT *common.BaseResp
-
-func (p *GetTeamMembersResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetTeamMembersResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+func (p *GetTeamMembersReq) GetTeamID() (v int64) {
+	return p.TeamID
 }
 
-func (p *GetTeamMembersResp) GetMembers() (v []*TeamMemberItem) {
-	return p.Members
+var GetTeamMembersReq_Page_DEFAULT int32 = 1
+
+func (p *GetTeamMembersReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetTeamMembersReq_Page_DEFAULT
+	}
+	return p.Page
 }
 
-var GetTeamMembersResp_PageInfo_DEFAULT *common.PageResp
+var GetTeamMembersReq_PageSize_DEFAULT int32 = 10
 
-func (p *GetTeamMembersResp) GetPageInfo() (v *common.PageResp) {
-	if !p.IsSetPageInfo() {
-		return GetTeamMembersResp_PageInfo_DEFAULT
+func (p *GetTeamMembersReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetTeamMembersReq_PageSize_DEFAULT
 	}
-	return p.PageInfo
+	return p.PageSize
 }
 
-var fieldIDToName_GetTeamMembersResp = map[int16]string{
-	1: "base_resp",
-	2: "members",
-	3: "page_info",
+var fieldIDToName_GetTeamMembersReq = map[int16]string{
+	1: "team_id",
+	2: "page",
+	3: "page_size",
 }
 
-func (p *GetTeamMembersResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GetTeamMembersReq) IsSetPage() bool {
+	return p.Page != GetTeamMembersReq_Page_DEFAULT
 }
 
-func (p *GetTeamMembersResp) IsSetPageInfo() bool {
-	return p.PageInfo != nil
+func (p *GetTeamMembersReq) IsSetPageSize() bool {
+	return p.PageSize != GetTeamMembersReq_PageSize_DEFAULT
 }
 
-func (p *GetTeamMembersResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetTeamMembersReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4947,7 +4750,7 @@ func (p *GetTeamMembersResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4955,7 +4758,7 @@ func (p *GetTeamMembersResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4963,7 +4766,7 @@ func (p *GetTeamMembersResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4989,7 +4792,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTeamMembersResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTeamMembersReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4999,49 +4802,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetTeamMembersResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetTeamMembersReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.BaseResp = _field
+	p.TeamID = _field
 	return nil
 }
-func (p *GetTeamMembersResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *GetTeamMembersReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make([]*TeamMemberItem, 0, size)
-	values := make([]TeamMemberItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Members = _field
+	p.Page = _field
 	return nil
 }
-func (p *GetTeamMembersResp) ReadField3(iprot thrift.TProtocol) error {
-	_field := common.NewPageResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetTeamMembersReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.PageInfo = _field
+	p.PageSize = _field
 	return nil
 }
 
-func (p *GetTeamMembersResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetTeamMembersReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeamMembersResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetTeamMembersReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5075,11 +4872,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetTeamMembersResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *GetTeamMembersReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5092,23 +4889,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetTeamMembersResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("members", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
-		return err
-	}
-	for _, v := range p.Members {
-		if err := v.Write(oprot); err != nil {
+func (p *GetTeamMembersReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Page); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5117,15 +4908,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetTeamMembersResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.PageInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetTeamMembersReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5134,97 +4927,93 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetTeamMembersResp) String() string {
+func (p *GetTeamMembersReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetTeamMembersResp(%+v)", *p)
+	return fmt.Sprintf("GetTeamMembersReq(%+v)", *p)
 
 }
 
-// ==================== 订单管理 ====================
-// 管理员获取所有订单列表请求
-type GetAllOrdersReq struct {
-	// 搜索关键字（订单ID、用户名、邮箱、钱包地址）
-	Keyword *string `thrift:"keyword,1,optional" json:"keyword,omitempty" query:"keyword"`
-	// 状态筛选
-	Status   *string `thrift:"status,2,optional" json:"status,omitempty" query:"status"`
-	Page     int32   `thrift:"page,3,optional" json:"page,omitempty" query:"page"`
-	PageSize int32   `thrift:"page_size,4,optional" json:"page_size,omitempty" query:"page_size"`
+// 团队成员项
+type TeamMemberItem struct {
+	UserID    int64   `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
+	Nickname  *string `thrift:"nickname,2,optional" form:"nickname" json:"nickname,omitempty" query:"nickname"`
+	Email     *string `thrift:"email,3,optional" form:"email" json:"email,omitempty" query:"email"`
+	AvatarURL *string `thrift:"avatar_url,4,optional" form:"avatar_url" json:"avatar_url,omitempty" query:"avatar_url"`
+	// creator, owner, member
+	Role     string `thrift:"role,5" form:"role" json:"role" query:"role"`
+	JoinedAt string `thrift:"joined_at,6" form:"joined_at" json:"joined_at" query:"joined_at"`
 }
 
-func NewGetAllOrdersReq() *GetAllOrdersReq {
-	return &GetAllOrdersReq{
-		Page:     1,
-		PageSize: 10,
-	}
+func NewTeamMemberItem() *TeamMemberItem {
+	return &TeamMemberItem{}
 }
 
-func (p *GetAllOrdersReq) InitDefault() {
-	p.Page = 1
-	p.PageSize = 10
+func (p *TeamMemberItem) InitDefault() {
 }
 
-var GetAllOrdersReq_Keyword_DEFAULT string
-
-func (p *GetAllOrdersReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return GetAllOrdersReq_Keyword_DEFAULT
-	}
-	return *p.Keyword
+func (p *TeamMemberItem) GetUserID() (v int64) {
+	return p.UserID
 }
 
-var GetAllOrdersReq_Status_DEFAULT string
+var TeamMemberItem_Nickname_DEFAULT string
 
-func (p *GetAllOrdersReq) GetStatus() (v string) {
-	if !p.IsSetStatus() {
-		return GetAllOrdersReq_Status_DEFAULT
+func (p *TeamMemberItem) GetNickname() (v string) {
+	if !p.IsSetNickname() {
+		return TeamMemberItem_Nickname_DEFAULT
 	}
-	return *p.Status
+	return *p.Nickname
 }
 
-var GetAllOrdersReq_Page_DEFAULT int32 = 1
+var TeamMemberItem_Email_DEFAULT string
 
-func (p *GetAllOrdersReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetAllOrdersReq_Page_DEFAULT
+func (p *TeamMemberItem) GetEmail() (v string) {
+	if !p.IsSetEmail() {
+		return TeamMemberItem_Email_DEFAULT
 	}
-	return p.Page
+	return *p.Email
 }
 
-var GetAllOrdersReq_PageSize_DEFAULT int32 = 10
+var TeamMemberItem_AvatarURL_DEFAULT string
 
-func (p *GetAllOrdersReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetAllOrdersReq_PageSize_DEFAULT
+func (p *TeamMemberItem) GetAvatarURL() (v string) {
+	if !p.IsSetAvatarURL() {
+		return TeamMemberItem_AvatarURL_DEFAULT
 	}
-	return p.PageSize
+	return *p.AvatarURL
 }
 
-var fieldIDToName_GetAllOrdersReq = map[int16]string{
-	1: "keyword",
-	2: "status",
-	3: "page",
-	4: "page_size",
+func (p *TeamMemberItem) GetRole() (v string) {
+	return p.Role
 }
 
-func (p *GetAllOrdersReq) IsSetKeyword() bool {
-	return p.Keyword != nil
+func (p *TeamMemberItem) GetJoinedAt() (v string) {
+	return p.JoinedAt
 }
 
-func (p *GetAllOrdersReq) IsSetStatus() bool {
-	return p.Status != nil
+var fieldIDToName_TeamMemberItem = map[int16]string{
+	1: "user_id",
+	2: "nickname",
+	3: "email",
+	4: "avatar_url",
+	5: "role",
+	6: "joined_at",
 }
 
-func (p *GetAllOrdersReq) IsSetPage() bool {
-	return p.Page != GetAllOrdersReq_Page_DEFAULT
+func (p *TeamMemberItem) IsSetNickname() bool {
+	return p.Nickname != nil
 }
 
-func (p *GetAllOrdersReq) IsSetPageSize() bool {
-	return p.PageSize != GetAllOrdersReq_PageSize_DEFAULT
+func (p *TeamMemberItem) IsSetEmail() bool {
+	return p.Email != nil
 }
 
-func (p *GetAllOrdersReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamMemberItem) IsSetAvatarURL() bool {
+	return p.AvatarURL != nil
+}
+
+func (p *TeamMemberItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5244,7 +5033,7 @@ func (p *GetAllOrdersReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5260,7 +5049,7 @@ func (p *GetAllOrdersReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5268,13 +5057,29 @@ func (p *GetAllOrdersReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5294,7 +5099,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllOrdersReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamMemberItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5304,7 +5109,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetAllOrdersReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamMemberItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *TeamMemberItem) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5312,10 +5128,10 @@ func (p *GetAllOrdersReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Keyword = _field
+	p.Nickname = _field
 	return nil
 }
-func (p *GetAllOrdersReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamMemberItem) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5323,35 +5139,46 @@ func (p *GetAllOrdersReq) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Status = _field
+	p.Email = _field
 	return nil
 }
-func (p *GetAllOrdersReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamMemberItem) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AvatarURL = _field
+	return nil
+}
+func (p *TeamMemberItem) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Page = _field
+	p.Role = _field
 	return nil
 }
-func (p *GetAllOrdersReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *TeamMemberItem) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PageSize = _field
+	p.JoinedAt = _field
 	return nil
 }
 
-func (p *GetAllOrdersReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamMemberItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllOrdersReq"); err != nil {
+	if err = oprot.WriteStructBegin("TeamMemberItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5371,7 +5198,15 @@ func (p *GetAllOrdersReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-	}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
 	}
@@ -5389,17 +5224,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetAllOrdersReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyword() {
-		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Keyword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *TeamMemberItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -5408,12 +5241,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetAllOrdersReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+func (p *TeamMemberItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNickname() {
+		if err = oprot.WriteFieldBegin("nickname", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Status); err != nil {
+		if err := oprot.WriteString(*p.Nickname); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5427,12 +5260,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetAllOrdersReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
+func (p *TeamMemberItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEmail() {
+		if err = oprot.WriteFieldBegin("email", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(p.Page); err != nil {
+		if err := oprot.WriteString(*p.Email); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5446,12 +5279,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetAllOrdersReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
+func (p *TeamMemberItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatarURL() {
+		if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(p.PageSize); err != nil {
+		if err := oprot.WriteString(*p.AvatarURL); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5465,131 +5298,99 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetAllOrdersReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TeamMemberItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetAllOrdersReq(%+v)", *p)
-
-}
-
-// 订单列表项
-type OrderListItem struct {
-	OrderID     string  `thrift:"order_id,1" form:"order_id" json:"order_id" query:"order_id"`
-	UserID      int64   `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
-	UserName    *string `thrift:"user_name,3,optional" form:"user_name" json:"user_name,omitempty" query:"user_name"`
-	UserEmail   *string `thrift:"user_email,4,optional" form:"user_email" json:"user_email,omitempty" query:"user_email"`
-	KolID       int64   `thrift:"kol_id,5" form:"kol_id" json:"kol_id" query:"kol_id"`
-	KolName     *string `thrift:"kol_name,6,optional" form:"kol_name" json:"kol_name,omitempty" query:"kol_name"`
-	PlanTitle   string  `thrift:"plan_title,7" form:"plan_title" json:"plan_title" query:"plan_title"`
-	PlanPrice   float64 `thrift:"plan_price,8" form:"plan_price" json:"plan_price" query:"plan_price"`
-	Status      string  `thrift:"status,9" form:"status" json:"status" query:"status"`
-	CreatedAt   string  `thrift:"created_at,10" form:"created_at" json:"created_at" query:"created_at"`
-	CompletedAt *string `thrift:"completed_at,11,optional" form:"completed_at" json:"completed_at,omitempty" query:"completed_at"`
-}
-
-func NewOrderListItem() *OrderListItem {
-	return &OrderListItem{}
-}
-
-func (p *OrderListItem) InitDefault() {
-}
-
-func (p *OrderListItem) GetOrderID() (v string) {
-	return p.OrderID
-}
-
-func (p *OrderListItem) GetUserID() (v int64) {
-	return p.UserID
-}
-
-var OrderListItem_UserName_DEFAULT string
-
-func (p *OrderListItem) GetUserName() (v string) {
-	if !p.IsSetUserName() {
-		return OrderListItem_UserName_DEFAULT
+	if err := oprot.WriteString(p.Role); err != nil {
+		return err
 	}
-	return *p.UserName
-}
-
-var OrderListItem_UserEmail_DEFAULT string
-
-func (p *OrderListItem) GetUserEmail() (v string) {
-	if !p.IsSetUserEmail() {
-		return OrderListItem_UserEmail_DEFAULT
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return *p.UserEmail
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *OrderListItem) GetKolID() (v int64) {
-	return p.KolID
+func (p *TeamMemberItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("joined_at", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.JoinedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-var OrderListItem_KolName_DEFAULT string
-
-func (p *OrderListItem) GetKolName() (v string) {
-	if !p.IsSetKolName() {
-		return OrderListItem_KolName_DEFAULT
+func (p *TeamMemberItem) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.KolName
-}
+	return fmt.Sprintf("TeamMemberItem(%+v)", *p)
 
-func (p *OrderListItem) GetPlanTitle() (v string) {
-	return p.PlanTitle
 }
 
-func (p *OrderListItem) GetPlanPrice() (v float64) {
-	return p.PlanPrice
+// 管理员获取特定团队的所有用户响应
+type GetTeamMembersResp struct {
+	BaseResp *common.BaseResp  `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Members  []*TeamMemberItem `thrift:"members,2,default,list<TeamMemberItem>" form:"members" json:"members" query:"members"`
+	PageInfo *common.PageResp  `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
 }
 
-func (p *OrderListItem) GetStatus() (v string) {
-	return p.Status
+func NewGetTeamMembersResp() *GetTeamMembersResp {
+	return &GetTeamMembersResp{}
 }
 
-func (p *OrderListItem) GetCreatedAt() (v string) {
-	return p.CreatedAt
+func (p *GetTeamMembersResp) InitDefault() {
 }
 
-var OrderListItem_CompletedAt_DEFAULT string
+var GetTeamMembersResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *OrderListItem) GetCompletedAt() (v string) {
-	if !p.IsSetCompletedAt() {
-		return OrderListItem_CompletedAt_DEFAULT
+func (p *GetTeamMembersResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetTeamMembersResp_BaseResp_DEFAULT
 	}
-	return *p.CompletedAt
+	return p.BaseResp
 }
 
-var fieldIDToName_OrderListItem = map[int16]string{
-	1:  "order_id",
-	2:  "user_id",
-	3:  "user_name",
-	4:  "user_email",
-	5:  "kol_id",
-	6:  "kol_name",
-	7:  "plan_title",
-	8:  "plan_price",
-	9:  "status",
-	10: "created_at",
-	11: "completed_at",
+func (p *GetTeamMembersResp) GetMembers() (v []*TeamMemberItem) {
+	return p.Members
 }
 
-func (p *OrderListItem) IsSetUserName() bool {
-	return p.UserName != nil
+var GetTeamMembersResp_PageInfo_DEFAULT *common.PageResp
+
+func (p *GetTeamMembersResp) GetPageInfo() (v *common.PageResp) {
+	if !p.IsSetPageInfo() {
+		return GetTeamMembersResp_PageInfo_DEFAULT
+	}
+	return p.PageInfo
 }
 
-func (p *OrderListItem) IsSetUserEmail() bool {
-	return p.UserEmail != nil
+var fieldIDToName_GetTeamMembersResp = map[int16]string{
+	1: "base_resp",
+	2: "members",
+	3: "page_info",
 }
 
-func (p *OrderListItem) IsSetKolName() bool {
-	return p.KolName != nil
+func (p *GetTeamMembersResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *OrderListItem) IsSetCompletedAt() bool {
-	return p.CompletedAt != nil
+func (p *GetTeamMembersResp) IsSetPageInfo() bool {
+	return p.PageInfo != nil
 }
 
-func (p *OrderListItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetTeamMembersResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5609,7 +5410,7 @@ func (p *OrderListItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5617,7 +5418,7 @@ func (p *OrderListItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5625,79 +5426,15 @@ func (p *OrderListItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -5715,7 +5452,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderListItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTeamMembersResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5725,131 +5462,49 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderListItem) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.OrderID = _field
-	return nil
-}
-func (p *OrderListItem) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UserID = _field
-	return nil
-}
-func (p *OrderListItem) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserName = _field
-	return nil
-}
-func (p *OrderListItem) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserEmail = _field
-	return nil
-}
-func (p *OrderListItem) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.KolID = _field
-	return nil
-}
-func (p *OrderListItem) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetTeamMembersResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.KolName = _field
+	p.BaseResp = _field
 	return nil
 }
-func (p *OrderListItem) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetTeamMembersResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.PlanTitle = _field
-	return nil
-}
-func (p *OrderListItem) ReadField8(iprot thrift.TProtocol) error {
+	_field := make([]*TeamMemberItem, 0, size)
+	values := make([]TeamMemberItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PlanPrice = _field
-	return nil
-}
-func (p *OrderListItem) ReadField9(iprot thrift.TProtocol) error {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+		_field = append(_field, _elem)
 	}
-	p.Status = _field
-	return nil
-}
-func (p *OrderListItem) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.CreatedAt = _field
+	p.Members = _field
 	return nil
 }
-func (p *OrderListItem) ReadField11(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetTeamMembersResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := common.NewPageResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.CompletedAt = _field
+	p.PageInfo = _field
 	return nil
 }
 
-func (p *OrderListItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetTeamMembersResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("OrderListItem"); err != nil {
+	if err = oprot.WriteStructBegin("GetTeamMembersResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5865,38 +5520,6 @@ func (p *OrderListItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5915,11 +5538,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderListItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+func (p *GetTeamMembersResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.OrderID); err != nil {
+	if err := p.BaseResp.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5932,11 +5555,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderListItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+func (p *GetTeamMembersResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("members", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
+		return err
+	}
+	for _, v := range p.Members {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5949,49 +5580,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *OrderListItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserName() {
-		if err = oprot.WriteFieldBegin("user_name", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserName); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *OrderListItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserEmail() {
-		if err = oprot.WriteFieldBegin("user_email", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserEmail); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *OrderListItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 5); err != nil {
+func (p *GetTeamMembersResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.KolID); err != nil {
+	if err := p.PageInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5999,176 +5592,102 @@ func (p *OrderListItem) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *OrderListItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKolName() {
-		if err = oprot.WriteFieldBegin("kol_name", thrift.STRING, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.KolName); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetTeamMembersResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
+	return fmt.Sprintf("GetTeamMembersResp(%+v)", *p)
 
-func (p *OrderListItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plan_title", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.PlanTitle); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *OrderListItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plan_price", thrift.DOUBLE, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.PlanPrice); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+// ==================== 订单管理 ====================
+// 管理员获取所有订单列表请求
+type GetAllOrdersReq struct {
+	// 搜索关键字（订单ID、用户名、邮箱、钱包地址）
+	Keyword *string `thrift:"keyword,1,optional" json:"keyword,omitempty" query:"keyword"`
+	// 状态筛选
+	Status   *string `thrift:"status,2,optional" json:"status,omitempty" query:"status"`
+	Page     int32   `thrift:"page,3,optional" json:"page,omitempty" query:"page"`
+	PageSize int32   `thrift:"page_size,4,optional" json:"page_size,omitempty" query:"page_size"`
 }
 
-func (p *OrderListItem) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func NewGetAllOrdersReq() *GetAllOrdersReq {
+	return &GetAllOrdersReq{
+		Page:     1,
+		PageSize: 10,
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *OrderListItem) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 10); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+func (p *GetAllOrdersReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 10
 }
 
-func (p *OrderListItem) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCompletedAt() {
-		if err = oprot.WriteFieldBegin("completed_at", thrift.STRING, 11); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.CompletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
+var GetAllOrdersReq_Keyword_DEFAULT string
 
-func (p *OrderListItem) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetAllOrdersReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return GetAllOrdersReq_Keyword_DEFAULT
 	}
-	return fmt.Sprintf("OrderListItem(%+v)", *p)
-
+	return *p.Keyword
 }
 
-// 管理员获取所有订单列表响应
-type GetAllOrdersResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Orders   []*OrderListItem `thrift:"orders,2,default,list<OrderListItem>" form:"orders" json:"orders" query:"orders"`
-	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
-}
+var GetAllOrdersReq_Status_DEFAULT string
 
-func NewGetAllOrdersResp() *GetAllOrdersResp {
-	return &GetAllOrdersResp{}
+func (p *GetAllOrdersReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return GetAllOrdersReq_Status_DEFAULT
+	}
+	return *p.Status
 }
 
-func (p *GetAllOrdersResp) InitDefault() {
+var GetAllOrdersReq_Page_DEFAULT int32 = 1
+
+func (p *GetAllOrdersReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetAllOrdersReq_Page_DEFAULT
+	}
+	return p.Page
 }
 
-var GetAllOrdersResp_BaseResp_DEFAULT *common.BaseResp
+var GetAllOrdersReq_PageSize_DEFAULT int32 = 10
 
-func (p *GetAllOrdersResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetAllOrdersResp_BaseResp_DEFAULT
+func (p *GetAllOrdersReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetAllOrdersReq_PageSize_DEFAULT
 	}
-	return p.BaseResp
+	return p.PageSize
 }
 
-func (p *GetAllOrdersResp) GetOrders() (v []*OrderListItem) {
-	return p.Orders
+var fieldIDToName_GetAllOrdersReq = map[int16]string{
+	1: "keyword",
+	2: "status",
+	3: "page",
+	4: "page_size",
 }
 
-var GetAllOrdersResp_PageInfo_DEFAULT *common.PageResp
-
-func (p *GetAllOrdersResp) GetPageInfo() (v *common.PageResp) {
-	if !p.IsSetPageInfo() {
-		return GetAllOrdersResp_PageInfo_DEFAULT
-	}
-	return p.PageInfo
+func (p *GetAllOrdersReq) IsSetKeyword() bool {
+	return p.Keyword != nil
 }
 
-var fieldIDToName_GetAllOrdersResp = map[int16]string{
-	1: "base_resp",
-	2: "orders",
-	3: "page_info",
+func (p *GetAllOrdersReq) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-func (p *GetAllOrdersResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GetAllOrdersReq) IsSetPage() bool {
+	return p.Page != GetAllOrdersReq_Page_DEFAULT
 }
 
-func (p *GetAllOrdersResp) IsSetPageInfo() bool {
-	return p.PageInfo != nil
+func (p *GetAllOrdersReq) IsSetPageSize() bool {
+	return p.PageSize != GetAllOrdersReq_PageSize_DEFAULT
 }
 
-func (p *GetAllOrdersResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetAllOrdersReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6188,7 +5707,7 @@ func (p *GetAllOrdersResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6196,7 +5715,7 @@ func (p *GetAllOrdersResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6204,13 +5723,21 @@ func (p *GetAllOrdersResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6230,7 +5757,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllOrdersResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllOrdersReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6240,49 +5767,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetAllOrdersResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetAllOrdersReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.Keyword = _field
 	return nil
 }
-func (p *GetAllOrdersResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *GetAllOrdersReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*OrderListItem, 0, size)
-	values := make([]OrderListItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.Status = _field
+	return nil
+}
+func (p *GetAllOrdersReq) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Orders = _field
+	p.Page = _field
 	return nil
 }
-func (p *GetAllOrdersResp) ReadField3(iprot thrift.TProtocol) error {
-	_field := common.NewPageResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetAllOrdersReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.PageInfo = _field
+	p.PageSize = _field
 	return nil
 }
 
-func (p *GetAllOrdersResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetAllOrdersReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllOrdersResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllOrdersReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6298,6 +5830,10 @@ func (p *GetAllOrdersResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6316,15 +5852,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetAllOrdersResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.BaseResp.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetAllOrdersReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6333,23 +5871,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetAllOrdersResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
-		return err
-	}
-	for _, v := range p.Orders {
-		if err := v.Write(oprot); err != nil {
+func (p *GetAllOrdersReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6358,15 +5890,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetAllOrdersResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.PageInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetAllOrdersReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6375,36 +5909,150 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetAllOrdersResp) String() string {
+func (p *GetAllOrdersReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetAllOrdersReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetAllOrdersResp(%+v)", *p)
+	return fmt.Sprintf("GetAllOrdersReq(%+v)", *p)
 
 }
 
-// ==================== 钱包管理 ====================
-// 管理员获取特定用户钱包信息请求
-type GetUserWalletReq struct {
-	UserID int64 `thrift:"user_id,1" json:"user_id" path:"user_id"`
+// 订单列表项
+type OrderListItem struct {
+	OrderID     string  `thrift:"order_id,1" form:"order_id" json:"order_id" query:"order_id"`
+	UserID      int64   `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	UserName    *string `thrift:"user_name,3,optional" form:"user_name" json:"user_name,omitempty" query:"user_name"`
+	UserEmail   *string `thrift:"user_email,4,optional" form:"user_email" json:"user_email,omitempty" query:"user_email"`
+	KolID       int64   `thrift:"kol_id,5" form:"kol_id" json:"kol_id" query:"kol_id"`
+	KolName     *string `thrift:"kol_name,6,optional" form:"kol_name" json:"kol_name,omitempty" query:"kol_name"`
+	PlanTitle   string  `thrift:"plan_title,7" form:"plan_title" json:"plan_title" query:"plan_title"`
+	PlanPrice   string  `thrift:"plan_price,8" form:"plan_price" json:"plan_price" query:"plan_price"`
+	Status      string  `thrift:"status,9" form:"status" json:"status" query:"status"`
+	CreatedAt   string  `thrift:"created_at,10" form:"created_at" json:"created_at" query:"created_at"`
+	CompletedAt *string `thrift:"completed_at,11,optional" form:"completed_at" json:"completed_at,omitempty" query:"completed_at"`
 }
 
-func NewGetUserWalletReq() *GetUserWalletReq {
-	return &GetUserWalletReq{}
+func NewOrderListItem() *OrderListItem {
+	return &OrderListItem{}
 }
 
-func (p *GetUserWalletReq) InitDefault() {
+func (p *OrderListItem) InitDefault() {
 }
 
-func (p *GetUserWalletReq) GetUserID() (v int64) {
+func (p *OrderListItem) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+func (p *OrderListItem) GetUserID() (v int64) {
 	return p.UserID
 }
 
-var fieldIDToName_GetUserWalletReq = map[int16]string{
-	1: "user_id",
+var OrderListItem_UserName_DEFAULT string
+
+func (p *OrderListItem) GetUserName() (v string) {
+	if !p.IsSetUserName() {
+		return OrderListItem_UserName_DEFAULT
+	}
+	return *p.UserName
 }
 
-func (p *GetUserWalletReq) Read(iprot thrift.TProtocol) (err error) {
+var OrderListItem_UserEmail_DEFAULT string
+
+func (p *OrderListItem) GetUserEmail() (v string) {
+	if !p.IsSetUserEmail() {
+		return OrderListItem_UserEmail_DEFAULT
+	}
+	return *p.UserEmail
+}
+
+func (p *OrderListItem) GetKolID() (v int64) {
+	return p.KolID
+}
+
+var OrderListItem_KolName_DEFAULT string
+
+func (p *OrderListItem) GetKolName() (v string) {
+	if !p.IsSetKolName() {
+		return OrderListItem_KolName_DEFAULT
+	}
+	return *p.KolName
+}
+
+func (p *OrderListItem) GetPlanTitle() (v string) {
+	return p.PlanTitle
+}
+
+func (p *OrderListItem) GetPlanPrice() (v string) {
+	return p.PlanPrice
+}
+
+func (p *OrderListItem) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *OrderListItem) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+var OrderListItem_CompletedAt_DEFAULT string
+
+func (p *OrderListItem) GetCompletedAt() (v string) {
+	if !p.IsSetCompletedAt() {
+		return OrderListItem_CompletedAt_DEFAULT
+	}
+	return *p.CompletedAt
+}
+
+var fieldIDToName_OrderListItem = map[int16]string{
+	1:  "order_id",
+	2:  "user_id",
+	3:  "user_name",
+	4:  "user_email",
+	5:  "kol_id",
+	6:  "kol_name",
+	7:  "plan_title",
+	8:  "plan_price",
+	9:  "status",
+	10: "created_at",
+	11: "completed_at",
+}
+
+func (p *OrderListItem) IsSetUserName() bool {
+	return p.UserName != nil
+}
+
+func (p *OrderListItem) IsSetUserEmail() bool {
+	return p.UserEmail != nil
+}
+
+func (p *OrderListItem) IsSetKolName() bool {
+	return p.KolName != nil
+}
+
+func (p *OrderListItem) IsSetCompletedAt() bool {
+	return p.CompletedAt != nil
+}
+
+func (p *OrderListItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6424,226 +6072,19 @@ func (p *GetUserWalletReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserWalletReq[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *GetUserWalletReq) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UserID = _field
-	return nil
-}
-
-func (p *GetUserWalletReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserWalletReq"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *GetUserWalletReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *GetUserWalletReq) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetUserWalletReq(%+v)", *p)
-
-}
-
-// 用户钱包信息
-type UserWalletInfo struct {
-	UserID        int64   `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
-	UserName      *string `thrift:"user_name,2,optional" form:"user_name" json:"user_name,omitempty" query:"user_name"`
-	UserEmail     *string `thrift:"user_email,3,optional" form:"user_email" json:"user_email,omitempty" query:"user_email"`
-	Balance       float64 `thrift:"balance,4" form:"balance" json:"balance" query:"balance"`
-	FrozenBalance float64 `thrift:"frozen_balance,5" form:"frozen_balance" json:"frozen_balance" query:"frozen_balance"`
-	TotalRecharge float64 `thrift:"total_recharge,6" form:"total_recharge" json:"total_recharge" query:"total_recharge"`
-	TotalConsume  float64 `thrift:"total_consume,7" form:"total_consume" json:"total_consume" query:"total_consume"`
-	CreatedAt     string  `thrift:"created_at,8" form:"created_at" json:"created_at" query:"created_at"`
-	UpdatedAt     string  `thrift:"updated_at,9" form:"updated_at" json:"updated_at" query:"updated_at"`
-}
-
-func NewUserWalletInfo() *UserWalletInfo {
-	return &UserWalletInfo{}
-}
-
-func (p *UserWalletInfo) InitDefault() {
-}
-
-func (p *UserWalletInfo) GetUserID() (v int64) {
-	return p.UserID
-}
-
-var UserWalletInfo_UserName_DEFAULT string
-
-func (p *UserWalletInfo) GetUserName() (v string) {
-	if !p.IsSetUserName() {
-		return UserWalletInfo_UserName_DEFAULT
-	}
-	return *p.UserName
-}
-
-var UserWalletInfo_UserEmail_DEFAULT string
-
-func (p *UserWalletInfo) GetUserEmail() (v string) {
-	if !p.IsSetUserEmail() {
-		return UserWalletInfo_UserEmail_DEFAULT
-	}
-	return *p.UserEmail
-}
-
-func (p *UserWalletInfo) GetBalance() (v float64) {
-	return p.Balance
-}
-
-func (p *UserWalletInfo) GetFrozenBalance() (v float64) {
-	return p.FrozenBalance
-}
-
-func (p *UserWalletInfo) GetTotalRecharge() (v float64) {
-	return p.TotalRecharge
-}
-
-func (p *UserWalletInfo) GetTotalConsume() (v float64) {
-	return p.TotalConsume
-}
-
-func (p *UserWalletInfo) GetCreatedAt() (v string) {
-	return p.CreatedAt
-}
-
-func (p *UserWalletInfo) GetUpdatedAt() (v string) {
-	return p.UpdatedAt
-}
-
-var fieldIDToName_UserWalletInfo = map[int16]string{
-	1: "user_id",
-	2: "user_name",
-	3: "user_email",
-	4: "balance",
-	5: "frozen_balance",
-	6: "total_recharge",
-	7: "total_consume",
-	8: "created_at",
-	9: "updated_at",
-}
-
-func (p *UserWalletInfo) IsSetUserName() bool {
-	return p.UserName != nil
-}
-
-func (p *UserWalletInfo) IsSetUserEmail() bool {
-	return p.UserEmail != nil
-}
-
-func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
@@ -6655,7 +6096,7 @@ func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6663,7 +6104,7 @@ func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6671,7 +6112,7 @@ func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6679,7 +6120,7 @@ func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 7:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6702,6 +6143,22 @@ func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6721,7 +6178,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserWalletInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderListItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6731,7 +6188,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserWalletInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+func (p *OrderListItem) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6742,7 +6210,7 @@ func (p *UserWalletInfo) ReadField1(iprot thrift.TProtocol) error {
 	p.UserID = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6753,7 +6221,7 @@ func (p *UserWalletInfo) ReadField2(iprot thrift.TProtocol) error {
 	p.UserName = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6764,51 +6232,51 @@ func (p *UserWalletInfo) ReadField3(iprot thrift.TProtocol) error {
 	p.UserEmail = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField4(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Balance = _field
+	p.KolID = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField5(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.FrozenBalance = _field
+	p.KolName = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField6(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.TotalRecharge = _field
+	p.PlanTitle = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField7(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.TotalConsume = _field
+	p.PlanPrice = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField8(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField9(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6816,10 +6284,10 @@ func (p *UserWalletInfo) ReadField8(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CreatedAt = _field
+	p.Status = _field
 	return nil
 }
-func (p *UserWalletInfo) ReadField9(iprot thrift.TProtocol) error {
+func (p *OrderListItem) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6827,13 +6295,24 @@ func (p *UserWalletInfo) ReadField9(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.CreatedAt = _field
 	return nil
 }
+func (p *OrderListItem) ReadField11(iprot thrift.TProtocol) error {
 
-func (p *UserWalletInfo) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CompletedAt = _field
+	return nil
+}
+
+func (p *OrderListItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserWalletInfo"); err != nil {
+	if err = oprot.WriteStructBegin("OrderListItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6873,10 +6352,18 @@ func (p *UserWalletInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
 	if err = oprot.WriteStructEnd(); err != nil {
 		goto WriteStructEndError
 	}
@@ -6891,11 +6378,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *OrderListItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := oprot.WriteString(p.OrderID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6908,9 +6395,26 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *OrderListItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *OrderListItem) writeField3(oprot thrift.TProtocol) (err error) {
 	if p.IsSetUserName() {
-		if err = oprot.WriteFieldBegin("user_name", thrift.STRING, 2); err != nil {
+		if err = oprot.WriteFieldBegin("user_name", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteString(*p.UserName); err != nil {
@@ -6922,14 +6426,14 @@ func (p *UserWalletInfo) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *OrderListItem) writeField4(oprot thrift.TProtocol) (err error) {
 	if p.IsSetUserEmail() {
-		if err = oprot.WriteFieldBegin("user_email", thrift.STRING, 3); err != nil {
+		if err = oprot.WriteFieldBegin("user_email", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteString(*p.UserEmail); err != nil {
@@ -6941,16 +6445,16 @@ func (p *UserWalletInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("balance", thrift.DOUBLE, 4); err != nil {
+func (p *OrderListItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Balance); err != nil {
+	if err := oprot.WriteI64(p.KolID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6958,16 +6462,35 @@ func (p *UserWalletInfo) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("frozen_balance", thrift.DOUBLE, 5); err != nil {
+func (p *OrderListItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKolName() {
+		if err = oprot.WriteFieldBegin("kol_name", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.KolName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *OrderListItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("plan_title", thrift.STRING, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.FrozenBalance); err != nil {
+	if err := oprot.WriteString(p.PlanTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6975,16 +6498,16 @@ func (p *UserWalletInfo) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total_recharge", thrift.DOUBLE, 6); err != nil {
+func (p *OrderListItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("plan_price", thrift.STRING, 8); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.TotalRecharge); err != nil {
+	if err := oprot.WriteString(p.PlanPrice); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6992,16 +6515,16 @@ func (p *UserWalletInfo) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total_consume", thrift.DOUBLE, 7); err != nil {
+func (p *OrderListItem) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 9); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.TotalConsume); err != nil {
+	if err := oprot.WriteString(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7009,13 +6532,13 @@ func (p *UserWalletInfo) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 8); err != nil {
+func (p *OrderListItem) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 10); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := oprot.WriteString(p.CreatedAt); err != nil {
@@ -7026,81 +6549,89 @@ func (p *UserWalletInfo) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.STRING, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *OrderListItem) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCompletedAt() {
+		if err = oprot.WriteFieldBegin("completed_at", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CompletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *UserWalletInfo) String() string {
+func (p *OrderListItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserWalletInfo(%+v)", *p)
+	return fmt.Sprintf("OrderListItem(%+v)", *p)
 
 }
 
-// 管理员获取特定用户钱包信息响应
-type GetUserWalletResp struct {
+// 管理员获取所有订单列表响应
+type GetAllOrdersResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Wallet   *UserWalletInfo  `thrift:"wallet,2,optional" form:"wallet" json:"wallet,omitempty" query:"wallet"`
+	Orders   []*OrderListItem `thrift:"orders,2,default,list<OrderListItem>" form:"orders" json:"orders" query:"orders"`
+	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
 }
 
-func NewGetUserWalletResp() *GetUserWalletResp {
-	return &GetUserWalletResp{}
+func NewGetAllOrdersResp() *GetAllOrdersResp {
+	return &GetAllOrdersResp{}
 }
 
-func (p *GetUserWalletResp) InitDefault() {
+func (p *GetAllOrdersResp) InitDefault() {
 }
 
-var GetUserWalletResp_BaseResp_DEFAULT *common.BaseResp
+var GetAllOrdersResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *GetUserWalletResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetAllOrdersResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return GetUserWalletResp_BaseResp_DEFAULT
+		return GetAllOrdersResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var GetUserWalletResp_Wallet_DEFAULT *UserWalletInfo
+func (p *GetAllOrdersResp) GetOrders() (v []*OrderListItem) {
+	return p.Orders
+}
 
-func (p *GetUserWalletResp) GetWallet() (v *UserWalletInfo) {
-	if !p.IsSetWallet() {
-		return GetUserWalletResp_Wallet_DEFAULT
+var GetAllOrdersResp_PageInfo_DEFAULT *common.PageResp
+
+func (p *GetAllOrdersResp) GetPageInfo() (v *common.PageResp) {
+	if !p.IsSetPageInfo() {
+		return GetAllOrdersResp_PageInfo_DEFAULT
 	}
-	return p.Wallet
+	return p.PageInfo
 }
 
-var fieldIDToName_GetUserWalletResp = map[int16]string{
+var fieldIDToName_GetAllOrdersResp = map[int16]string{
 	1: "base_resp",
-	2: "wallet",
+	2: "orders",
+	3: "page_info",
 }
 
-func (p *GetUserWalletResp) IsSetBaseResp() bool {
+func (p *GetAllOrdersResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetUserWalletResp) IsSetWallet() bool {
-	return p.Wallet != nil
+func (p *GetAllOrdersResp) IsSetPageInfo() bool {
+	return p.PageInfo != nil
 }
 
-func (p *GetUserWalletResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetAllOrdersResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7128,13 +6659,21 @@ func (p *GetUserWalletResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7154,7 +6693,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserWalletResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAllOrdersResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7164,7 +6703,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserWalletResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetAllOrdersResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -7172,18 +6711,41 @@ func (p *GetUserWalletResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
-func (p *GetUserWalletResp) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewUserWalletInfo()
+func (p *GetAllOrdersResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*OrderListItem, 0, size)
+	values := make([]OrderListItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Orders = _field
+	return nil
+}
+func (p *GetAllOrdersResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := common.NewPageResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Wallet = _field
+	p.PageInfo = _field
 	return nil
 }
 
-func (p *GetUserWalletResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetAllOrdersResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserWalletResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllOrdersResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7195,6 +6757,10 @@ func (p *GetUserWalletResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7213,7 +6779,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserWalletResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetAllOrdersResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7230,78 +6796,78 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserWalletResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWallet() {
-		if err = oprot.WriteFieldBegin("wallet", thrift.STRUCT, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Wallet.Write(oprot); err != nil {
+func (p *GetAllOrdersResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
+		return err
+	}
+	for _, v := range p.Orders {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetUserWalletResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetAllOrdersResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetUserWalletResp(%+v)", *p)
-
-}
-
-// ==================== Campaign消费管理 ====================
-// 管理员给Campaign添加消费账单请求
-type AddCampaignConsumeReq struct {
-	CampaignID string `thrift:"campaign_id,1" form:"campaign_id" json:"campaign_id"`
-	// 消费金额（美元）
-	Amount float64 `thrift:"amount,2" form:"amount" json:"amount"`
-	// 备注说明
-	Remark *string `thrift:"remark,3,optional" form:"remark" json:"remark,omitempty"`
+	if err := p.PageInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewAddCampaignConsumeReq() *AddCampaignConsumeReq {
-	return &AddCampaignConsumeReq{}
-}
+func (p *GetAllOrdersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAllOrdersResp(%+v)", *p)
 
-func (p *AddCampaignConsumeReq) InitDefault() {
 }
 
-func (p *AddCampaignConsumeReq) GetCampaignID() (v string) {
-	return p.CampaignID
+// ==================== 钱包管理 ====================
+// 管理员获取特定用户钱包信息请求
+type GetUserWalletReq struct {
+	UserID int64 `thrift:"user_id,1" json:"user_id" path:"user_id"`
 }
 
-func (p *AddCampaignConsumeReq) GetAmount() (v float64) {
-	return p.Amount
+func NewGetUserWalletReq() *GetUserWalletReq {
+	return &GetUserWalletReq{}
 }
 
-var AddCampaignConsumeReq_Remark_DEFAULT string
-
-func (p *AddCampaignConsumeReq) GetRemark() (v string) {
-	if !p.IsSetRemark() {
-		return AddCampaignConsumeReq_Remark_DEFAULT
-	}
-	return *p.Remark
+func (p *GetUserWalletReq) InitDefault() {
 }
 
-var fieldIDToName_AddCampaignConsumeReq = map[int16]string{
-	1: "campaign_id",
-	2: "amount",
-	3: "remark",
+func (p *GetUserWalletReq) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *AddCampaignConsumeReq) IsSetRemark() bool {
-	return p.Remark != nil
+var fieldIDToName_GetUserWalletReq = map[int16]string{
+	1: "user_id",
 }
 
-func (p *AddCampaignConsumeReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserWalletReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7321,29 +6887,13 @@ func (p *AddCampaignConsumeReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7363,7 +6913,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddCampaignConsumeReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserWalletReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7373,43 +6923,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeReq) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CampaignID = _field
-	return nil
-}
-func (p *AddCampaignConsumeReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetUserWalletReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Amount = _field
-	return nil
-}
-func (p *AddCampaignConsumeReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Remark = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *AddCampaignConsumeReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetUserWalletReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddCampaignConsumeReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserWalletReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7417,14 +6945,6 @@ func (p *AddCampaignConsumeReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7443,11 +6963,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("campaign_id", thrift.STRING, 1); err != nil {
+func (p *GetUserWalletReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.CampaignID); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7460,116 +6980,121 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("amount", thrift.DOUBLE, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.Amount); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *AddCampaignConsumeReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemark() {
-		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Remark); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *AddCampaignConsumeReq) String() string {
+func (p *GetUserWalletReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddCampaignConsumeReq(%+v)", *p)
+	return fmt.Sprintf("GetUserWalletReq(%+v)", *p)
 
 }
 
-// 管理员给Campaign添加消费账单响应
-type AddCampaignConsumeResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	// 交易ID
-	TransactionID *string `thrift:"transaction_id,2,optional" form:"transaction_id" json:"transaction_id,omitempty" query:"transaction_id"`
+// 用户钱包信息
+type UserWalletInfo struct {
+	UserID        int64   `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
+	UserName      *string `thrift:"user_name,2,optional" form:"user_name" json:"user_name,omitempty" query:"user_name"`
+	UserEmail     *string `thrift:"user_email,3,optional" form:"user_email" json:"user_email,omitempty" query:"user_email"`
+	Balance       string  `thrift:"balance,4" form:"balance" json:"balance" query:"balance"`
+	FrozenBalance string  `thrift:"frozen_balance,5" form:"frozen_balance" json:"frozen_balance" query:"frozen_balance"`
+	TotalRecharge string  `thrift:"total_recharge,6" form:"total_recharge" json:"total_recharge" query:"total_recharge"`
+	TotalConsume  string  `thrift:"total_consume,7" form:"total_consume" json:"total_consume" query:"total_consume"`
+	CreatedAt     string  `thrift:"created_at,8" form:"created_at" json:"created_at" query:"created_at"`
+	UpdatedAt     string  `thrift:"updated_at,9" form:"updated_at" json:"updated_at" query:"updated_at"`
 }
 
-func NewAddCampaignConsumeResp() *AddCampaignConsumeResp {
-	return &AddCampaignConsumeResp{}
+func NewUserWalletInfo() *UserWalletInfo {
+	return &UserWalletInfo{}
 }
 
-func (p *AddCampaignConsumeResp) InitDefault() {
+func (p *UserWalletInfo) InitDefault() {
 }
 
-var AddCampaignConsumeResp_BaseResp_DEFAULT *common.BaseResp
+func (p *UserWalletInfo) GetUserID() (v int64) {
+	return p.UserID
+}
 
-func (p *AddCampaignConsumeResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return AddCampaignConsumeResp_BaseResp_DEFAULT
+var UserWalletInfo_UserName_DEFAULT string
+
+func (p *UserWalletInfo) GetUserName() (v string) {
+	if !p.IsSetUserName() {
+		return UserWalletInfo_UserName_DEFAULT
 	}
-	return p.BaseResp
+	return *p.UserName
 }
 
-var AddCampaignConsumeResp_TransactionID_DEFAULT string
+var UserWalletInfo_UserEmail_DEFAULT string
 
-func (p *AddCampaignConsumeResp) GetTransactionID() (v string) {
-	if !p.IsSetTransactionID() {
-		return AddCampaignConsumeResp_TransactionID_DEFAULT
+func (p *UserWalletInfo) GetUserEmail() (v string) {
+	if !p.IsSetUserEmail() {
+		return UserWalletInfo_UserEmail_DEFAULT
 	}
-	return *p.TransactionID
+	return *p.UserEmail
 }
 
-var fieldIDToName_AddCampaignConsumeResp = map[int16]string{
-	1: "base_resp",
-	2: "transaction_id",
+func (p *UserWalletInfo) GetBalance() (v string) {
+	return p.Balance
 }
 
-func (p *AddCampaignConsumeResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *UserWalletInfo) GetFrozenBalance() (v string) {
+	return p.FrozenBalance
 }
 
-func (p *AddCampaignConsumeResp) IsSetTransactionID() bool {
-	return p.TransactionID != nil
+func (p *UserWalletInfo) GetTotalRecharge() (v string) {
+	return p.TotalRecharge
 }
 
-func (p *AddCampaignConsumeResp) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
+func (p *UserWalletInfo) GetTotalConsume() (v string) {
+	return p.TotalConsume
+}
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
+func (p *UserWalletInfo) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
+func (p *UserWalletInfo) GetUpdatedAt() (v string) {
+	return p.UpdatedAt
+}
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
+var fieldIDToName_UserWalletInfo = map[int16]string{
+	1: "user_id",
+	2: "user_name",
+	3: "user_email",
+	4: "balance",
+	5: "frozen_balance",
+	6: "total_recharge",
+	7: "total_consume",
+	8: "created_at",
+	9: "updated_at",
+}
+
+func (p *UserWalletInfo) IsSetUserName() bool {
+	return p.UserName != nil
+}
+
+func (p *UserWalletInfo) IsSetUserEmail() bool {
+	return p.UserEmail != nil
+}
+
+func (p *UserWalletInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7584,6 +7109,62 @@ func (p *AddCampaignConsumeResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7603,7 +7184,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddCampaignConsumeResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserWalletInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7613,15 +7194,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *UserWalletInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.BaseResp = _field
+	p.UserID = _field
 	return nil
 }
-func (p *AddCampaignConsumeResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *UserWalletInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -7629,13 +7213,90 @@ func (p *AddCampaignConsumeResp) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.TransactionID = _field
+	p.UserName = _field
 	return nil
 }
+func (p *UserWalletInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *AddCampaignConsumeResp) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserEmail = _field
+	return nil
+}
+func (p *UserWalletInfo) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Balance = _field
+	return nil
+}
+func (p *UserWalletInfo) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FrozenBalance = _field
+	return nil
+}
+func (p *UserWalletInfo) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalRecharge = _field
+	return nil
+}
+func (p *UserWalletInfo) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalConsume = _field
+	return nil
+}
+func (p *UserWalletInfo) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *UserWalletInfo) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *UserWalletInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddCampaignConsumeResp"); err != nil {
+	if err = oprot.WriteStructBegin("UserWalletInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7647,6 +7308,34 @@ func (p *AddCampaignConsumeResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7665,11 +7354,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *UserWalletInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7682,12 +7371,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTransactionID() {
-		if err = oprot.WriteFieldBegin("transaction_id", thrift.STRING, 2); err != nil {
+func (p *UserWalletInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserName() {
+		if err = oprot.WriteFieldBegin("user_name", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.TransactionID); err != nil {
+		if err := oprot.WriteString(*p.UserName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7701,654 +7390,8168 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AddCampaignConsumeResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UserWalletInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserEmail() {
+		if err = oprot.WriteFieldBegin("user_email", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserEmail); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("AddCampaignConsumeResp(%+v)", *p)
-
-}
-
-// 管理员服务
-type AdminService interface {
-	// 用户管理
-	GetAllUsers(ctx context.Context, req *GetAllUsersReq) (r *GetAllUsersResp, err error)
-
-	SetUserStatus(ctx context.Context, req *SetUserStatusReq) (r *SetUserStatusResp, err error)
-	// KOL管理
-	GetAllKols(ctx context.Context, req *GetAllKolsReq) (r *GetAllKolsResp, err error)
-
-	AdminReviewKol(ctx context.Context, req *AdminReviewKolReq) (r *AdminReviewKolResp, err error)
-	// 团队管理
-	GetAllTeams(ctx context.Context, req *GetAllTeamsReq) (r *GetAllTeamsResp, err error)
-
-	GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error)
-	// 订单管理
-	GetAllOrders(ctx context.Context, req *GetAllOrdersReq) (r *GetAllOrdersResp, err error)
-	// 钱包管理
-	GetUserWallet(ctx context.Context, req *GetUserWalletReq) (r *GetUserWalletResp, err error)
-	// Campaign消费管理
-	AddCampaignConsume(ctx context.Context, req *AddCampaignConsumeReq) (r *AddCampaignConsumeResp, err error)
-}
-
-type AdminServiceClient struct {
-	c thrift.TClient
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminServiceClient {
-	return &AdminServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *UserWalletInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("balance", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-}
-
-func NewAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminServiceClient {
-	return &AdminServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if err := oprot.WriteString(p.Balance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func NewAdminServiceClient(c thrift.TClient) *AdminServiceClient {
-	return &AdminServiceClient{
-		c: c,
+func (p *UserWalletInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("frozen_balance", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FrozenBalance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *AdminServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *UserWalletInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_recharge", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TotalRecharge); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *AdminServiceClient) GetAllUsers(ctx context.Context, req *GetAllUsersReq) (r *GetAllUsersResp, err error) {
-	var _args AdminServiceGetAllUsersArgs
-	_args.Req = req
-	var _result AdminServiceGetAllUsersResult
-	if err = p.Client_().Call(ctx, "GetAllUsers", &_args, &_result); err != nil {
-		return
+func (p *UserWalletInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_consume", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminServiceClient) SetUserStatus(ctx context.Context, req *SetUserStatusReq) (r *SetUserStatusResp, err error) {
-	var _args AdminServiceSetUserStatusArgs
-	_args.Req = req
-	var _result AdminServiceSetUserStatusResult
-	if err = p.Client_().Call(ctx, "SetUserStatus", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteString(p.TotalConsume); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminServiceClient) GetAllKols(ctx context.Context, req *GetAllKolsReq) (r *GetAllKolsResp, err error) {
-	var _args AdminServiceGetAllKolsArgs
-	_args.Req = req
-	var _result AdminServiceGetAllKolsResult
-	if err = p.Client_().Call(ctx, "GetAllKols", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *AdminServiceClient) AdminReviewKol(ctx context.Context, req *AdminReviewKolReq) (r *AdminReviewKolResp, err error) {
-	var _args AdminServiceAdminReviewKolArgs
-	_args.Req = req
-	var _result AdminServiceAdminReviewKolResult
-	if err = p.Client_().Call(ctx, "AdminReviewKol", &_args, &_result); err != nil {
-		return
+
+func (p *UserWalletInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminServiceClient) GetAllTeams(ctx context.Context, req *GetAllTeamsReq) (r *GetAllTeamsResp, err error) {
-	var _args AdminServiceGetAllTeamsArgs
-	_args.Req = req
-	var _result AdminServiceGetAllTeamsResult
-	if err = p.Client_().Call(ctx, "GetAllTeams", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminServiceClient) GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error) {
-	var _args AdminServiceGetTeamMembersArgs
-	_args.Req = req
-	var _result AdminServiceGetTeamMembersResult
-	if err = p.Client_().Call(ctx, "GetTeamMembers", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *AdminServiceClient) GetAllOrders(ctx context.Context, req *GetAllOrdersReq) (r *GetAllOrdersResp, err error) {
-	var _args AdminServiceGetAllOrdersArgs
-	_args.Req = req
-	var _result AdminServiceGetAllOrdersResult
-	if err = p.Client_().Call(ctx, "GetAllOrders", &_args, &_result); err != nil {
-		return
+
+func (p *UserWalletInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminServiceClient) GetUserWallet(ctx context.Context, req *GetUserWalletReq) (r *GetUserWalletResp, err error) {
-	var _args AdminServiceGetUserWalletArgs
-	_args.Req = req
-	var _result AdminServiceGetUserWalletResult
-	if err = p.Client_().Call(ctx, "GetUserWallet", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteString(p.UpdatedAt); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminServiceClient) AddCampaignConsume(ctx context.Context, req *AddCampaignConsumeReq) (r *AddCampaignConsumeResp, err error) {
-	var _args AdminServiceAddCampaignConsumeArgs
-	_args.Req = req
-	var _result AdminServiceAddCampaignConsumeResult
-	if err = p.Client_().Call(ctx, "AddCampaignConsume", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-type AdminServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      AdminService
-}
+func (p *UserWalletInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserWalletInfo(%+v)", *p)
 
-func (p *AdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
 }
 
-func (p *AdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+// 管理员获取特定用户钱包信息响应
+type GetUserWalletResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Wallet   *UserWalletInfo  `thrift:"wallet,2,optional" form:"wallet" json:"wallet,omitempty" query:"wallet"`
 }
 
-func (p *AdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewGetUserWalletResp() *GetUserWalletResp {
+	return &GetUserWalletResp{}
 }
 
-func NewAdminServiceProcessor(handler AdminService) *AdminServiceProcessor {
-	self := &AdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetAllUsers", &adminServiceProcessorGetAllUsers{handler: handler})
-	self.AddToProcessorMap("SetUserStatus", &adminServiceProcessorSetUserStatus{handler: handler})
-	self.AddToProcessorMap("GetAllKols", &adminServiceProcessorGetAllKols{handler: handler})
-	self.AddToProcessorMap("AdminReviewKol", &adminServiceProcessorAdminReviewKol{handler: handler})
-	self.AddToProcessorMap("GetAllTeams", &adminServiceProcessorGetAllTeams{handler: handler})
-	self.AddToProcessorMap("GetTeamMembers", &adminServiceProcessorGetTeamMembers{handler: handler})
-	self.AddToProcessorMap("GetAllOrders", &adminServiceProcessorGetAllOrders{handler: handler})
-	self.AddToProcessorMap("GetUserWallet", &adminServiceProcessorGetUserWallet{handler: handler})
-	self.AddToProcessorMap("AddCampaignConsume", &adminServiceProcessorAddCampaignConsume{handler: handler})
-	return self
+func (p *GetUserWalletResp) InitDefault() {
 }
-func (p *AdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+var GetUserWalletResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetUserWalletResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetUserWalletResp_BaseResp_DEFAULT
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	return p.BaseResp
+}
+
+var GetUserWalletResp_Wallet_DEFAULT *UserWalletInfo
+
+func (p *GetUserWalletResp) GetWallet() (v *UserWalletInfo) {
+	if !p.IsSetWallet() {
+		return GetUserWalletResp_Wallet_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Wallet
 }
 
-type adminServiceProcessorGetAllUsers struct {
-	handler AdminService
+var fieldIDToName_GetUserWalletResp = map[int16]string{
+	1: "base_resp",
+	2: "wallet",
 }
 
-func (p *adminServiceProcessorGetAllUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceGetAllUsersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+func (p *GetUserWalletResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetUserWalletResp) IsSetWallet() bool {
+	return p.Wallet != nil
+}
+
+func (p *GetUserWalletResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserWalletResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUserWalletResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetUserWalletResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewUserWalletInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Wallet = _field
+	return nil
+}
+
+func (p *GetUserWalletResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserWalletResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUserWalletResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUserWalletResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWallet() {
+		if err = oprot.WriteFieldBegin("wallet", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Wallet.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetUserWalletResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserWalletResp(%+v)", *p)
+
+}
+
+// 管理员钱包对账请求
+type ReconcileWalletsReq struct {
+	// 仅核对指定用户，不传则核对全部钱包
+	UserID *int64 `thrift:"user_id,1,optional" form:"user_id" json:"user_id,omitempty"`
+}
+
+func NewReconcileWalletsReq() *ReconcileWalletsReq {
+	return &ReconcileWalletsReq{}
+}
+
+func (p *ReconcileWalletsReq) InitDefault() {
+}
+
+var ReconcileWalletsReq_UserID_DEFAULT int64
+
+func (p *ReconcileWalletsReq) GetUserID() (v int64) {
+	if !p.IsSetUserID() {
+		return ReconcileWalletsReq_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var fieldIDToName_ReconcileWalletsReq = map[int16]string{
+	1: "user_id",
+}
+
+func (p *ReconcileWalletsReq) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *ReconcileWalletsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReconcileWalletsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReconcileWalletsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+
+func (p *ReconcileWalletsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReconcileWalletsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReconcileWalletsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReconcileWalletsReq(%+v)", *p)
+
+}
+
+// 钱包对账差异项
+type WalletDriftItem struct {
+	UserID int64 `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
+	// 钱包可用余额
+	WalletBalance string `thrift:"wallet_balance,2" form:"wallet_balance" json:"wallet_balance" query:"wallet_balance"`
+	// 账本计算的可用余额
+	LedgerBalance string `thrift:"ledger_balance,3" form:"ledger_balance" json:"ledger_balance" query:"ledger_balance"`
+	// 可用余额差异（钱包 - 账本）
+	BalanceDrift string `thrift:"balance_drift,4" form:"balance_drift" json:"balance_drift" query:"balance_drift"`
+	// 钱包冻结余额
+	WalletFrozenBalance string `thrift:"wallet_frozen_balance,5" form:"wallet_frozen_balance" json:"wallet_frozen_balance" query:"wallet_frozen_balance"`
+	// 账本计算的冻结余额
+	LedgerFrozenBalance string `thrift:"ledger_frozen_balance,6" form:"ledger_frozen_balance" json:"ledger_frozen_balance" query:"ledger_frozen_balance"`
+	// 冻结余额差异（钱包 - 账本）
+	FrozenDrift string `thrift:"frozen_drift,7" form:"frozen_drift" json:"frozen_drift" query:"frozen_drift"`
+}
+
+func NewWalletDriftItem() *WalletDriftItem {
+	return &WalletDriftItem{}
+}
+
+func (p *WalletDriftItem) InitDefault() {
+}
+
+func (p *WalletDriftItem) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *WalletDriftItem) GetWalletBalance() (v string) {
+	return p.WalletBalance
+}
+
+func (p *WalletDriftItem) GetLedgerBalance() (v string) {
+	return p.LedgerBalance
+}
+
+func (p *WalletDriftItem) GetBalanceDrift() (v string) {
+	return p.BalanceDrift
+}
+
+func (p *WalletDriftItem) GetWalletFrozenBalance() (v string) {
+	return p.WalletFrozenBalance
+}
+
+func (p *WalletDriftItem) GetLedgerFrozenBalance() (v string) {
+	return p.LedgerFrozenBalance
+}
+
+func (p *WalletDriftItem) GetFrozenDrift() (v string) {
+	return p.FrozenDrift
+}
+
+var fieldIDToName_WalletDriftItem = map[int16]string{
+	1: "user_id",
+	2: "wallet_balance",
+	3: "ledger_balance",
+	4: "balance_drift",
+	5: "wallet_frozen_balance",
+	6: "ledger_frozen_balance",
+	7: "frozen_drift",
+}
+
+func (p *WalletDriftItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WalletDriftItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *WalletDriftItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *WalletDriftItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WalletBalance = _field
+	return nil
+}
+func (p *WalletDriftItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LedgerBalance = _field
+	return nil
+}
+func (p *WalletDriftItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.BalanceDrift = _field
+	return nil
+}
+func (p *WalletDriftItem) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WalletFrozenBalance = _field
+	return nil
+}
+func (p *WalletDriftItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LedgerFrozenBalance = _field
+	return nil
+}
+func (p *WalletDriftItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FrozenDrift = _field
+	return nil
+}
+
+func (p *WalletDriftItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("WalletDriftItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("wallet_balance", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.WalletBalance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ledger_balance", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.LedgerBalance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("balance_drift", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.BalanceDrift); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("wallet_frozen_balance", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.WalletFrozenBalance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ledger_frozen_balance", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.LedgerFrozenBalance); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("frozen_drift", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FrozenDrift); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *WalletDriftItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WalletDriftItem(%+v)", *p)
+
+}
+
+// 管理员钱包对账响应
+type ReconcileWalletsResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	// 核对的钱包数量
+	WalletCount int32 `thrift:"wallet_count,2" form:"wallet_count" json:"wallet_count" query:"wallet_count"`
+	// 存在差异的钱包数量
+	DriftCount int32 `thrift:"drift_count,3" form:"drift_count" json:"drift_count" query:"drift_count"`
+	// 差异明细
+	Drifts []*WalletDriftItem `thrift:"drifts,4,default,list<WalletDriftItem>" form:"drifts" json:"drifts" query:"drifts"`
+	// 账本借方合计
+	TotalDebit string `thrift:"total_debit,5" form:"total_debit" json:"total_debit" query:"total_debit"`
+	// 账本贷方合计
+	TotalCredit string `thrift:"total_credit,6" form:"total_credit" json:"total_credit" query:"total_credit"`
+	// 账本借贷是否平衡
+	Balanced bool `thrift:"balanced,7" form:"balanced" json:"balanced" query:"balanced"`
+	// 对账时间
+	CheckedAt string `thrift:"checked_at,8" form:"checked_at" json:"checked_at" query:"checked_at"`
+}
+
+func NewReconcileWalletsResp() *ReconcileWalletsResp {
+	return &ReconcileWalletsResp{}
+}
+
+func (p *ReconcileWalletsResp) InitDefault() {
+}
+
+var ReconcileWalletsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReconcileWalletsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReconcileWalletsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ReconcileWalletsResp) GetWalletCount() (v int32) {
+	return p.WalletCount
+}
+
+func (p *ReconcileWalletsResp) GetDriftCount() (v int32) {
+	return p.DriftCount
+}
+
+func (p *ReconcileWalletsResp) GetDrifts() (v []*WalletDriftItem) {
+	return p.Drifts
+}
+
+func (p *ReconcileWalletsResp) GetTotalDebit() (v string) {
+	return p.TotalDebit
+}
+
+func (p *ReconcileWalletsResp) GetTotalCredit() (v string) {
+	return p.TotalCredit
+}
+
+func (p *ReconcileWalletsResp) GetBalanced() (v bool) {
+	return p.Balanced
+}
+
+func (p *ReconcileWalletsResp) GetCheckedAt() (v string) {
+	return p.CheckedAt
+}
+
+var fieldIDToName_ReconcileWalletsResp = map[int16]string{
+	1: "base_resp",
+	2: "wallet_count",
+	3: "drift_count",
+	4: "drifts",
+	5: "total_debit",
+	6: "total_credit",
+	7: "balanced",
+	8: "checked_at",
+}
+
+func (p *ReconcileWalletsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReconcileWalletsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReconcileWalletsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WalletCount = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DriftCount = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*WalletDriftItem, 0, size)
+	values := make([]WalletDriftItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Drifts = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalDebit = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalCredit = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Balanced = _field
+	return nil
+}
+func (p *ReconcileWalletsResp) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CheckedAt = _field
+	return nil
+}
+
+func (p *ReconcileWalletsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReconcileWalletsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("wallet_count", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.WalletCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("drift_count", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.DriftCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("drifts", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Drifts)); err != nil {
+		return err
+	}
+	for _, v := range p.Drifts {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_debit", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TotalDebit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_credit", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TotalCredit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("balanced", thrift.BOOL, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Balanced); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("checked_at", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CheckedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ReconcileWalletsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReconcileWalletsResp(%+v)", *p)
+
+}
+
+// ==================== Campaign消费管理 ====================
+// 管理员给Campaign添加消费账单请求
+type AddCampaignConsumeReq struct {
+	CampaignID string `thrift:"campaign_id,1" form:"campaign_id" json:"campaign_id"`
+	// 消费金额（美元）
+	Amount string `thrift:"amount,2" form:"amount" json:"amount"`
+	// 备注说明
+	Remark *string `thrift:"remark,3,optional" form:"remark" json:"remark,omitempty"`
+}
+
+func NewAddCampaignConsumeReq() *AddCampaignConsumeReq {
+	return &AddCampaignConsumeReq{}
+}
+
+func (p *AddCampaignConsumeReq) InitDefault() {
+}
+
+func (p *AddCampaignConsumeReq) GetCampaignID() (v string) {
+	return p.CampaignID
+}
+
+func (p *AddCampaignConsumeReq) GetAmount() (v string) {
+	return p.Amount
+}
+
+var AddCampaignConsumeReq_Remark_DEFAULT string
+
+func (p *AddCampaignConsumeReq) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return AddCampaignConsumeReq_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+var fieldIDToName_AddCampaignConsumeReq = map[int16]string{
+	1: "campaign_id",
+	2: "amount",
+	3: "remark",
+}
+
+func (p *AddCampaignConsumeReq) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *AddCampaignConsumeReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddCampaignConsumeReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CampaignID = _field
+	return nil
+}
+func (p *AddCampaignConsumeReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Amount = _field
+	return nil
+}
+func (p *AddCampaignConsumeReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Remark = _field
+	return nil
+}
+
+func (p *AddCampaignConsumeReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddCampaignConsumeReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("campaign_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CampaignID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("amount", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Amount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemark() {
+		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Remark); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddCampaignConsumeReq(%+v)", *p)
+
+}
+
+// 管理员给Campaign添加消费账单响应
+type AddCampaignConsumeResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	// 交易ID
+	TransactionID *string `thrift:"transaction_id,2,optional" form:"transaction_id" json:"transaction_id,omitempty" query:"transaction_id"`
+}
+
+func NewAddCampaignConsumeResp() *AddCampaignConsumeResp {
+	return &AddCampaignConsumeResp{}
+}
+
+func (p *AddCampaignConsumeResp) InitDefault() {
+}
+
+var AddCampaignConsumeResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AddCampaignConsumeResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AddCampaignConsumeResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var AddCampaignConsumeResp_TransactionID_DEFAULT string
+
+func (p *AddCampaignConsumeResp) GetTransactionID() (v string) {
+	if !p.IsSetTransactionID() {
+		return AddCampaignConsumeResp_TransactionID_DEFAULT
+	}
+	return *p.TransactionID
+}
+
+var fieldIDToName_AddCampaignConsumeResp = map[int16]string{
+	1: "base_resp",
+	2: "transaction_id",
+}
+
+func (p *AddCampaignConsumeResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AddCampaignConsumeResp) IsSetTransactionID() bool {
+	return p.TransactionID != nil
+}
+
+func (p *AddCampaignConsumeResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddCampaignConsumeResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AddCampaignConsumeResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TransactionID = _field
+	return nil
+}
+
+func (p *AddCampaignConsumeResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddCampaignConsumeResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTransactionID() {
+		if err = oprot.WriteFieldBegin("transaction_id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TransactionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AddCampaignConsumeResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddCampaignConsumeResp(%+v)", *p)
+
+}
+
+// ==================== 后台角色管理 ====================
+// 后台角色
+type RoleInfo struct {
+	// super_admin, finance, moderator, content_editor, support
+	Role string `thrift:"role,1" form:"role" json:"role" query:"role"`
+	// 角色拥有的权限
+	Permissions []string `thrift:"permissions,2,default,list<string>" form:"permissions" json:"permissions" query:"permissions"`
+}
+
+func NewRoleInfo() *RoleInfo {
+	return &RoleInfo{}
+}
+
+func (p *RoleInfo) InitDefault() {
+}
+
+func (p *RoleInfo) GetRole() (v string) {
+	return p.Role
+}
+
+func (p *RoleInfo) GetPermissions() (v []string) {
+	return p.Permissions
+}
+
+var fieldIDToName_RoleInfo = map[int16]string{
+	1: "role",
+	2: "permissions",
+}
+
+func (p *RoleInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RoleInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Role = _field
+	return nil
+}
+func (p *RoleInfo) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Permissions = _field
+	return nil
+}
+
+func (p *RoleInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RoleInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RoleInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Role); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RoleInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permissions", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Permissions)); err != nil {
+		return err
+	}
+	for _, v := range p.Permissions {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RoleInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoleInfo(%+v)", *p)
+
+}
+
+// 获取后台角色列表请求
+type ListRolesReq struct {
+}
+
+func NewListRolesReq() *ListRolesReq {
+	return &ListRolesReq{}
+}
+
+func (p *ListRolesReq) InitDefault() {
+}
+
+var fieldIDToName_ListRolesReq = map[int16]string{}
+
+func (p *ListRolesReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListRolesReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListRolesReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListRolesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListRolesReq(%+v)", *p)
+
+}
+
+// 获取后台角色列表响应
+type ListRolesResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Roles    []*RoleInfo      `thrift:"roles,2,default,list<RoleInfo>" form:"roles" json:"roles" query:"roles"`
+}
+
+func NewListRolesResp() *ListRolesResp {
+	return &ListRolesResp{}
+}
+
+func (p *ListRolesResp) InitDefault() {
+}
+
+var ListRolesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListRolesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListRolesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListRolesResp) GetRoles() (v []*RoleInfo) {
+	return p.Roles
+}
+
+var fieldIDToName_ListRolesResp = map[int16]string{
+	1: "base_resp",
+	2: "roles",
+}
+
+func (p *ListRolesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListRolesResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListRolesResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListRolesResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListRolesResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RoleInfo, 0, size)
+	values := make([]RoleInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Roles = _field
+	return nil
+}
+
+func (p *ListRolesResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListRolesResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListRolesResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListRolesResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("roles", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Roles)); err != nil {
+		return err
+	}
+	for _, v := range p.Roles {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListRolesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListRolesResp(%+v)", *p)
+
+}
+
+// 获取用户后台角色请求
+type GetUserRolesReq struct {
+	UserID int64 `thrift:"user_id,1" json:"user_id" path:"user_id"`
+}
+
+func NewGetUserRolesReq() *GetUserRolesReq {
+	return &GetUserRolesReq{}
+}
+
+func (p *GetUserRolesReq) InitDefault() {
+}
+
+func (p *GetUserRolesReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+var fieldIDToName_GetUserRolesReq = map[int16]string{
+	1: "user_id",
+}
+
+func (p *GetUserRolesReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserRolesReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUserRolesReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+
+func (p *GetUserRolesReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserRolesReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUserRolesReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUserRolesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserRolesReq(%+v)", *p)
+
+}
+
+// 用户后台角色响应（分配/撤销后也返回最新角色）
+type UserRolesResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	UserID   int64            `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	// 已分配的后台角色
+	Roles []string `thrift:"roles,3,default,list<string>" form:"roles" json:"roles" query:"roles"`
+	// 角色权限的并集
+	Permissions []string `thrift:"permissions,4,default,list<string>" form:"permissions" json:"permissions" query:"permissions"`
+}
+
+func NewUserRolesResp() *UserRolesResp {
+	return &UserRolesResp{}
+}
+
+func (p *UserRolesResp) InitDefault() {
+}
+
+var UserRolesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UserRolesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UserRolesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *UserRolesResp) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *UserRolesResp) GetRoles() (v []string) {
+	return p.Roles
+}
+
+func (p *UserRolesResp) GetPermissions() (v []string) {
+	return p.Permissions
+}
+
+var fieldIDToName_UserRolesResp = map[int16]string{
+	1: "base_resp",
+	2: "user_id",
+	3: "roles",
+	4: "permissions",
+}
+
+func (p *UserRolesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UserRolesResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserRolesResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserRolesResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *UserRolesResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *UserRolesResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Roles = _field
+	return nil
+}
+func (p *UserRolesResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Permissions = _field
+	return nil
+}
+
+func (p *UserRolesResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRolesResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserRolesResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserRolesResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UserRolesResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("roles", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Roles)); err != nil {
+		return err
+	}
+	for _, v := range p.Roles {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UserRolesResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permissions", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Permissions)); err != nil {
+		return err
+	}
+	for _, v := range p.Permissions {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UserRolesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserRolesResp(%+v)", *p)
+
+}
+
+// 分配/撤销后台角色请求
+type UpdateUserRoleReq struct {
+	UserID int64  `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required"`
+	Role   string `thrift:"role,2,required" form:"role,required" json:"role,required"`
+}
+
+func NewUpdateUserRoleReq() *UpdateUserRoleReq {
+	return &UpdateUserRoleReq{}
+}
+
+func (p *UpdateUserRoleReq) InitDefault() {
+}
+
+func (p *UpdateUserRoleReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *UpdateUserRoleReq) GetRole() (v string) {
+	return p.Role
+}
+
+var fieldIDToName_UpdateUserRoleReq = map[int16]string{
+	1: "user_id",
+	2: "role",
+}
+
+func (p *UpdateUserRoleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+	var issetRole bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRole = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRole {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserRoleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateUserRoleReq[fieldId]))
+}
+
+func (p *UpdateUserRoleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *UpdateUserRoleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Role = _field
+	return nil
+}
+
+func (p *UpdateUserRoleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateUserRoleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateUserRoleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateUserRoleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Role); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateUserRoleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateUserRoleReq(%+v)", *p)
+
+}
+
+// ==================== 审计日志 ====================
+// 审计日志查询条件
+type GetAuditLogsReq struct {
+	// 操作人用户ID
+	ActorID *int64 `thrift:"actor_id,1,optional" json:"actor_id,omitempty" query:"actor_id"`
+	// 操作类型，如 user.set_status
+	Action *string `thrift:"action,2,optional" json:"action,omitempty" query:"action"`
+	// 目标实体类型，如 user, recharge_order
+	TargetType *string `thrift:"target_type,3,optional" json:"target_type,omitempty" query:"target_type"`
+	// 目标实体ID
+	TargetID *string `thrift:"target_id,4,optional" json:"target_id,omitempty" query:"target_id"`
+	// 请求ID
+	RequestID *string `thrift:"request_id,5,optional" json:"request_id,omitempty" query:"request_id"`
+	// 开始时间（RFC3339）
+	StartTime *string `thrift:"start_time,6,optional" json:"start_time,omitempty" query:"start_time"`
+	// 结束时间（RFC3339）
+	EndTime  *string `thrift:"end_time,7,optional" json:"end_time,omitempty" query:"end_time"`
+	Page     int32   `thrift:"page,8,optional" json:"page,omitempty" query:"page"`
+	PageSize int32   `thrift:"page_size,9,optional" json:"page_size,omitempty" query:"page_size"`
+}
+
+func NewGetAuditLogsReq() *GetAuditLogsReq {
+	return &GetAuditLogsReq{
+		Page:     1,
+		PageSize: 10,
+	}
+}
+
+func (p *GetAuditLogsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 10
+}
+
+var GetAuditLogsReq_ActorID_DEFAULT int64
+
+func (p *GetAuditLogsReq) GetActorID() (v int64) {
+	if !p.IsSetActorID() {
+		return GetAuditLogsReq_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var GetAuditLogsReq_Action_DEFAULT string
+
+func (p *GetAuditLogsReq) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return GetAuditLogsReq_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var GetAuditLogsReq_TargetType_DEFAULT string
+
+func (p *GetAuditLogsReq) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return GetAuditLogsReq_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var GetAuditLogsReq_TargetID_DEFAULT string
+
+func (p *GetAuditLogsReq) GetTargetID() (v string) {
+	if !p.IsSetTargetID() {
+		return GetAuditLogsReq_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var GetAuditLogsReq_RequestID_DEFAULT string
+
+func (p *GetAuditLogsReq) GetRequestID() (v string) {
+	if !p.IsSetRequestID() {
+		return GetAuditLogsReq_RequestID_DEFAULT
+	}
+	return *p.RequestID
+}
+
+var GetAuditLogsReq_StartTime_DEFAULT string
+
+func (p *GetAuditLogsReq) GetStartTime() (v string) {
+	if !p.IsSetStartTime() {
+		return GetAuditLogsReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var GetAuditLogsReq_EndTime_DEFAULT string
+
+func (p *GetAuditLogsReq) GetEndTime() (v string) {
+	if !p.IsSetEndTime() {
+		return GetAuditLogsReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var GetAuditLogsReq_Page_DEFAULT int32 = 1
+
+func (p *GetAuditLogsReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetAuditLogsReq_Page_DEFAULT
+	}
+	return p.Page
+}
+
+var GetAuditLogsReq_PageSize_DEFAULT int32 = 10
+
+func (p *GetAuditLogsReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetAuditLogsReq_PageSize_DEFAULT
+	}
+	return p.PageSize
+}
+
+var fieldIDToName_GetAuditLogsReq = map[int16]string{
+	1: "actor_id",
+	2: "action",
+	3: "target_type",
+	4: "target_id",
+	5: "request_id",
+	6: "start_time",
+	7: "end_time",
+	8: "page",
+	9: "page_size",
+}
+
+func (p *GetAuditLogsReq) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *GetAuditLogsReq) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *GetAuditLogsReq) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *GetAuditLogsReq) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *GetAuditLogsReq) IsSetRequestID() bool {
+	return p.RequestID != nil
+}
+
+func (p *GetAuditLogsReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *GetAuditLogsReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *GetAuditLogsReq) IsSetPage() bool {
+	return p.Page != GetAuditLogsReq_Page_DEFAULT
+}
+
+func (p *GetAuditLogsReq) IsSetPageSize() bool {
+	return p.PageSize != GetAuditLogsReq_PageSize_DEFAULT
+}
+
+func (p *GetAuditLogsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAuditLogsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActorID = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RequestID = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetAuditLogsReq) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetAuditLogsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAuditLogsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActorID() {
+		if err = oprot.WriteFieldBegin("actor_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ActorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("target_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("target_id", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestID() {
+		if err = oprot.WriteFieldBegin("request_id", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RequestID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *GetAuditLogsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAuditLogsReq(%+v)", *p)
+
+}
+
+// 审计日志项
+type AuditLogItem struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+	// 操作人用户ID（系统任务为0）
+	ActorID    int64  `thrift:"actor_id,2" form:"actor_id" json:"actor_id" query:"actor_id"`
+	Action     string `thrift:"action,3" form:"action" json:"action" query:"action"`
+	TargetType string `thrift:"target_type,4" form:"target_type" json:"target_type" query:"target_type"`
+	TargetID   string `thrift:"target_id,5" form:"target_id" json:"target_id" query:"target_id"`
+	// 操作前快照（JSON）
+	BeforeData *string `thrift:"before_data,6,optional" form:"before_data" json:"before_data,omitempty" query:"before_data"`
+	// 操作后快照（JSON）
+	AfterData *string `thrift:"after_data,7,optional" form:"after_data" json:"after_data,omitempty" query:"after_data"`
+	IP        string  `thrift:"ip,8" form:"ip" json:"ip" query:"ip"`
+	UserAgent string  `thrift:"user_agent,9" form:"user_agent" json:"user_agent" query:"user_agent"`
+	RequestID string  `thrift:"request_id,10" form:"request_id" json:"request_id" query:"request_id"`
+	CreatedAt string  `thrift:"created_at,11" form:"created_at" json:"created_at" query:"created_at"`
+}
+
+func NewAuditLogItem() *AuditLogItem {
+	return &AuditLogItem{}
+}
+
+func (p *AuditLogItem) InitDefault() {
+}
+
+func (p *AuditLogItem) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *AuditLogItem) GetActorID() (v int64) {
+	return p.ActorID
+}
+
+func (p *AuditLogItem) GetAction() (v string) {
+	return p.Action
+}
+
+func (p *AuditLogItem) GetTargetType() (v string) {
+	return p.TargetType
+}
+
+func (p *AuditLogItem) GetTargetID() (v string) {
+	return p.TargetID
+}
+
+var AuditLogItem_BeforeData_DEFAULT string
+
+func (p *AuditLogItem) GetBeforeData() (v string) {
+	if !p.IsSetBeforeData() {
+		return AuditLogItem_BeforeData_DEFAULT
+	}
+	return *p.BeforeData
+}
+
+var AuditLogItem_AfterData_DEFAULT string
+
+func (p *AuditLogItem) GetAfterData() (v string) {
+	if !p.IsSetAfterData() {
+		return AuditLogItem_AfterData_DEFAULT
+	}
+	return *p.AfterData
+}
+
+func (p *AuditLogItem) GetIP() (v string) {
+	return p.IP
+}
+
+func (p *AuditLogItem) GetUserAgent() (v string) {
+	return p.UserAgent
+}
+
+func (p *AuditLogItem) GetRequestID() (v string) {
+	return p.RequestID
+}
+
+func (p *AuditLogItem) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+var fieldIDToName_AuditLogItem = map[int16]string{
+	1:  "id",
+	2:  "actor_id",
+	3:  "action",
+	4:  "target_type",
+	5:  "target_id",
+	6:  "before_data",
+	7:  "after_data",
+	8:  "ip",
+	9:  "user_agent",
+	10: "request_id",
+	11: "created_at",
+}
+
+func (p *AuditLogItem) IsSetBeforeData() bool {
+	return p.BeforeData != nil
+}
+
+func (p *AuditLogItem) IsSetAfterData() bool {
+	return p.AfterData != nil
+}
+
+func (p *AuditLogItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditLogItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AuditLogItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ActorID = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BeforeData = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AfterData = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IP = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserAgent = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RequestID = _field
+	return nil
+}
+func (p *AuditLogItem) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *AuditLogItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditLogItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("actor_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ActorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_type", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TargetType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_id", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TargetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBeforeData() {
+		if err = oprot.WriteFieldBegin("before_data", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BeforeData); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAfterData() {
+		if err = oprot.WriteFieldBegin("after_data", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.AfterData); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ip", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.IP); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_agent", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserAgent); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request_id", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RequestID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *AuditLogItem) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *AuditLogItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditLogItem(%+v)", *p)
+
+}
+
+// 审计日志列表响应
+type GetAuditLogsResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Logs     []*AuditLogItem  `thrift:"logs,2,default,list<AuditLogItem>" form:"logs" json:"logs" query:"logs"`
+	PageInfo *common.PageResp `thrift:"page_info,3" form:"page_info" json:"page_info" query:"page_info"`
+}
+
+func NewGetAuditLogsResp() *GetAuditLogsResp {
+	return &GetAuditLogsResp{}
+}
+
+func (p *GetAuditLogsResp) InitDefault() {
+}
+
+var GetAuditLogsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetAuditLogsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetAuditLogsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetAuditLogsResp) GetLogs() (v []*AuditLogItem) {
+	return p.Logs
+}
+
+var GetAuditLogsResp_PageInfo_DEFAULT *common.PageResp
+
+func (p *GetAuditLogsResp) GetPageInfo() (v *common.PageResp) {
+	if !p.IsSetPageInfo() {
+		return GetAuditLogsResp_PageInfo_DEFAULT
+	}
+	return p.PageInfo
+}
+
+var fieldIDToName_GetAuditLogsResp = map[int16]string{
+	1: "base_resp",
+	2: "logs",
+	3: "page_info",
+}
+
+func (p *GetAuditLogsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetAuditLogsResp) IsSetPageInfo() bool {
+	return p.PageInfo != nil
+}
+
+func (p *GetAuditLogsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAuditLogsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetAuditLogsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetAuditLogsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AuditLogItem, 0, size)
+	values := make([]AuditLogItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Logs = _field
+	return nil
+}
+func (p *GetAuditLogsResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := common.NewPageResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PageInfo = _field
+	return nil
+}
+
+func (p *GetAuditLogsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAuditLogsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetAuditLogsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetAuditLogsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("logs", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Logs)); err != nil {
+		return err
+	}
+	for _, v := range p.Logs {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetAuditLogsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_info", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.PageInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetAuditLogsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAuditLogsResp(%+v)", *p)
+
+}
+
+// 导出审计日志响应（成功时直接返回 CSV 文件，失败时返回该结构）
+type ExportAuditLogsResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewExportAuditLogsResp() *ExportAuditLogsResp {
+	return &ExportAuditLogsResp{}
+}
+
+func (p *ExportAuditLogsResp) InitDefault() {
+}
+
+var ExportAuditLogsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ExportAuditLogsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ExportAuditLogsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ExportAuditLogsResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *ExportAuditLogsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ExportAuditLogsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportAuditLogsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExportAuditLogsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ExportAuditLogsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportAuditLogsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportAuditLogsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExportAuditLogsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportAuditLogsResp(%+v)", *p)
+
+}
+
+// 管理员服务
+type AdminService interface {
+	// 用户管理
+	GetAllUsers(ctx context.Context, req *GetAllUsersReq) (r *GetAllUsersResp, err error)
+
+	SetUserStatus(ctx context.Context, req *SetUserStatusReq) (r *SetUserStatusResp, err error)
+
+	MergeUsers(ctx context.Context, req *MergeUsersReq) (r *MergeUsersResp, err error)
+	// KOL管理
+	GetAllKols(ctx context.Context, req *GetAllKolsReq) (r *GetAllKolsResp, err error)
+
+	AdminReviewKol(ctx context.Context, req *AdminReviewKolReq) (r *AdminReviewKolResp, err error)
+	// 团队管理
+	GetAllTeams(ctx context.Context, req *GetAllTeamsReq) (r *GetAllTeamsResp, err error)
+
+	GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error)
+	// 订单管理
+	GetAllOrders(ctx context.Context, req *GetAllOrdersReq) (r *GetAllOrdersResp, err error)
+	// 钱包管理
+	GetUserWallet(ctx context.Context, req *GetUserWalletReq) (r *GetUserWalletResp, err error)
+
+	ReconcileWallets(ctx context.Context, req *ReconcileWalletsReq) (r *ReconcileWalletsResp, err error)
+	// Campaign消费管理
+	AddCampaignConsume(ctx context.Context, req *AddCampaignConsumeReq) (r *AddCampaignConsumeResp, err error)
+	// 后台角色管理
+	ListRoles(ctx context.Context, req *ListRolesReq) (r *ListRolesResp, err error)
+
+	GetUserRoles(ctx context.Context, req *GetUserRolesReq) (r *UserRolesResp, err error)
+
+	AssignUserRole(ctx context.Context, req *UpdateUserRoleReq) (r *UserRolesResp, err error)
+
+	RevokeUserRole(ctx context.Context, req *UpdateUserRoleReq) (r *UserRolesResp, err error)
+	// 审计日志
+	GetAuditLogs(ctx context.Context, req *GetAuditLogsReq) (r *GetAuditLogsResp, err error)
+
+	ExportAuditLogs(ctx context.Context, req *GetAuditLogsReq) (r *ExportAuditLogsResp, err error)
+}
+
+type AdminServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminServiceClient {
+	return &AdminServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminServiceClient {
+	return &AdminServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAdminServiceClient(c thrift.TClient) *AdminServiceClient {
+	return &AdminServiceClient{
+		c: c,
+	}
+}
+
+func (p *AdminServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AdminServiceClient) GetAllUsers(ctx context.Context, req *GetAllUsersReq) (r *GetAllUsersResp, err error) {
+	var _args AdminServiceGetAllUsersArgs
+	_args.Req = req
+	var _result AdminServiceGetAllUsersResult
+	if err = p.Client_().Call(ctx, "GetAllUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) SetUserStatus(ctx context.Context, req *SetUserStatusReq) (r *SetUserStatusResp, err error) {
+	var _args AdminServiceSetUserStatusArgs
+	_args.Req = req
+	var _result AdminServiceSetUserStatusResult
+	if err = p.Client_().Call(ctx, "SetUserStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) MergeUsers(ctx context.Context, req *MergeUsersReq) (r *MergeUsersResp, err error) {
+	var _args AdminServiceMergeUsersArgs
+	_args.Req = req
+	var _result AdminServiceMergeUsersResult
+	if err = p.Client_().Call(ctx, "MergeUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetAllKols(ctx context.Context, req *GetAllKolsReq) (r *GetAllKolsResp, err error) {
+	var _args AdminServiceGetAllKolsArgs
+	_args.Req = req
+	var _result AdminServiceGetAllKolsResult
+	if err = p.Client_().Call(ctx, "GetAllKols", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) AdminReviewKol(ctx context.Context, req *AdminReviewKolReq) (r *AdminReviewKolResp, err error) {
+	var _args AdminServiceAdminReviewKolArgs
+	_args.Req = req
+	var _result AdminServiceAdminReviewKolResult
+	if err = p.Client_().Call(ctx, "AdminReviewKol", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetAllTeams(ctx context.Context, req *GetAllTeamsReq) (r *GetAllTeamsResp, err error) {
+	var _args AdminServiceGetAllTeamsArgs
+	_args.Req = req
+	var _result AdminServiceGetAllTeamsResult
+	if err = p.Client_().Call(ctx, "GetAllTeams", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error) {
+	var _args AdminServiceGetTeamMembersArgs
+	_args.Req = req
+	var _result AdminServiceGetTeamMembersResult
+	if err = p.Client_().Call(ctx, "GetTeamMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetAllOrders(ctx context.Context, req *GetAllOrdersReq) (r *GetAllOrdersResp, err error) {
+	var _args AdminServiceGetAllOrdersArgs
+	_args.Req = req
+	var _result AdminServiceGetAllOrdersResult
+	if err = p.Client_().Call(ctx, "GetAllOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetUserWallet(ctx context.Context, req *GetUserWalletReq) (r *GetUserWalletResp, err error) {
+	var _args AdminServiceGetUserWalletArgs
+	_args.Req = req
+	var _result AdminServiceGetUserWalletResult
+	if err = p.Client_().Call(ctx, "GetUserWallet", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) ReconcileWallets(ctx context.Context, req *ReconcileWalletsReq) (r *ReconcileWalletsResp, err error) {
+	var _args AdminServiceReconcileWalletsArgs
+	_args.Req = req
+	var _result AdminServiceReconcileWalletsResult
+	if err = p.Client_().Call(ctx, "ReconcileWallets", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) AddCampaignConsume(ctx context.Context, req *AddCampaignConsumeReq) (r *AddCampaignConsumeResp, err error) {
+	var _args AdminServiceAddCampaignConsumeArgs
+	_args.Req = req
+	var _result AdminServiceAddCampaignConsumeResult
+	if err = p.Client_().Call(ctx, "AddCampaignConsume", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) ListRoles(ctx context.Context, req *ListRolesReq) (r *ListRolesResp, err error) {
+	var _args AdminServiceListRolesArgs
+	_args.Req = req
+	var _result AdminServiceListRolesResult
+	if err = p.Client_().Call(ctx, "ListRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetUserRoles(ctx context.Context, req *GetUserRolesReq) (r *UserRolesResp, err error) {
+	var _args AdminServiceGetUserRolesArgs
+	_args.Req = req
+	var _result AdminServiceGetUserRolesResult
+	if err = p.Client_().Call(ctx, "GetUserRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) AssignUserRole(ctx context.Context, req *UpdateUserRoleReq) (r *UserRolesResp, err error) {
+	var _args AdminServiceAssignUserRoleArgs
+	_args.Req = req
+	var _result AdminServiceAssignUserRoleResult
+	if err = p.Client_().Call(ctx, "AssignUserRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) RevokeUserRole(ctx context.Context, req *UpdateUserRoleReq) (r *UserRolesResp, err error) {
+	var _args AdminServiceRevokeUserRoleArgs
+	_args.Req = req
+	var _result AdminServiceRevokeUserRoleResult
+	if err = p.Client_().Call(ctx, "RevokeUserRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) GetAuditLogs(ctx context.Context, req *GetAuditLogsReq) (r *GetAuditLogsResp, err error) {
+	var _args AdminServiceGetAuditLogsArgs
+	_args.Req = req
+	var _result AdminServiceGetAuditLogsResult
+	if err = p.Client_().Call(ctx, "GetAuditLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminServiceClient) ExportAuditLogs(ctx context.Context, req *GetAuditLogsReq) (r *ExportAuditLogsResp, err error) {
+	var _args AdminServiceExportAuditLogsArgs
+	_args.Req = req
+	var _result AdminServiceExportAuditLogsResult
+	if err = p.Client_().Call(ctx, "ExportAuditLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AdminServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AdminService
+}
+
+func (p *AdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAdminServiceProcessor(handler AdminService) *AdminServiceProcessor {
+	self := &AdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetAllUsers", &adminServiceProcessorGetAllUsers{handler: handler})
+	self.AddToProcessorMap("SetUserStatus", &adminServiceProcessorSetUserStatus{handler: handler})
+	self.AddToProcessorMap("MergeUsers", &adminServiceProcessorMergeUsers{handler: handler})
+	self.AddToProcessorMap("GetAllKols", &adminServiceProcessorGetAllKols{handler: handler})
+	self.AddToProcessorMap("AdminReviewKol", &adminServiceProcessorAdminReviewKol{handler: handler})
+	self.AddToProcessorMap("GetAllTeams", &adminServiceProcessorGetAllTeams{handler: handler})
+	self.AddToProcessorMap("GetTeamMembers", &adminServiceProcessorGetTeamMembers{handler: handler})
+	self.AddToProcessorMap("GetAllOrders", &adminServiceProcessorGetAllOrders{handler: handler})
+	self.AddToProcessorMap("GetUserWallet", &adminServiceProcessorGetUserWallet{handler: handler})
+	self.AddToProcessorMap("ReconcileWallets", &adminServiceProcessorReconcileWallets{handler: handler})
+	self.AddToProcessorMap("AddCampaignConsume", &adminServiceProcessorAddCampaignConsume{handler: handler})
+	self.AddToProcessorMap("ListRoles", &adminServiceProcessorListRoles{handler: handler})
+	self.AddToProcessorMap("GetUserRoles", &adminServiceProcessorGetUserRoles{handler: handler})
+	self.AddToProcessorMap("AssignUserRole", &adminServiceProcessorAssignUserRole{handler: handler})
+	self.AddToProcessorMap("RevokeUserRole", &adminServiceProcessorRevokeUserRole{handler: handler})
+	self.AddToProcessorMap("GetAuditLogs", &adminServiceProcessorGetAuditLogs{handler: handler})
+	self.AddToProcessorMap("ExportAuditLogs", &adminServiceProcessorExportAuditLogs{handler: handler})
+	return self
+}
+func (p *AdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type adminServiceProcessorGetAllUsers struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetAllUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetAllUsersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetAllUsersResult{}
+	var retval *GetAllUsersResp
+	if retval, err2 = p.handler.GetAllUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllUsers: "+err2.Error())
 		oprot.WriteMessageBegin("GetAllUsers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllUsers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorSetUserStatus struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorSetUserStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceSetUserStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetUserStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceSetUserStatusResult{}
+	var retval *SetUserStatusResp
+	if retval, err2 = p.handler.SetUserStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetUserStatus: "+err2.Error())
+		oprot.WriteMessageBegin("SetUserStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SetUserStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorMergeUsers struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorMergeUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceMergeUsersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MergeUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceMergeUsersResult{}
+	var retval *MergeUsersResp
+	if retval, err2 = p.handler.MergeUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MergeUsers: "+err2.Error())
+		oprot.WriteMessageBegin("MergeUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MergeUsers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetAllKols struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetAllKols) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetAllKolsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllKols", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetAllKolsResult{}
+	var retval *GetAllKolsResp
+	if retval, err2 = p.handler.GetAllKols(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllKols: "+err2.Error())
+		oprot.WriteMessageBegin("GetAllKols", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllKols", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorAdminReviewKol struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorAdminReviewKol) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceAdminReviewKolArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminReviewKol", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceAdminReviewKolResult{}
+	var retval *AdminReviewKolResp
+	if retval, err2 = p.handler.AdminReviewKol(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminReviewKol: "+err2.Error())
+		oprot.WriteMessageBegin("AdminReviewKol", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminReviewKol", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetAllTeams struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetAllTeams) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetAllTeamsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllTeams", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetAllTeamsResult{}
+	var retval *GetAllTeamsResp
+	if retval, err2 = p.handler.GetAllTeams(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllTeams: "+err2.Error())
+		oprot.WriteMessageBegin("GetAllTeams", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllTeams", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetTeamMembers struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetTeamMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetTeamMembersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetTeamMembersResult{}
+	var retval *GetTeamMembersResp
+	if retval, err2 = p.handler.GetTeamMembers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTeamMembers: "+err2.Error())
+		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTeamMembers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetAllOrders struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetAllOrders) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetAllOrdersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllOrders", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetAllOrdersResult{}
+	var retval *GetAllOrdersResp
+	if retval, err2 = p.handler.GetAllOrders(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllOrders: "+err2.Error())
+		oprot.WriteMessageBegin("GetAllOrders", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllOrders", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetUserWallet struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetUserWallet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetUserWalletArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserWallet", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetUserWalletResult{}
+	var retval *GetUserWalletResp
+	if retval, err2 = p.handler.GetUserWallet(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserWallet: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserWallet", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserWallet", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorReconcileWallets struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorReconcileWallets) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceReconcileWalletsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReconcileWallets", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceReconcileWalletsResult{}
+	var retval *ReconcileWalletsResp
+	if retval, err2 = p.handler.ReconcileWallets(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReconcileWallets: "+err2.Error())
+		oprot.WriteMessageBegin("ReconcileWallets", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReconcileWallets", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorAddCampaignConsume struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorAddCampaignConsume) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceAddCampaignConsumeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddCampaignConsume", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceAddCampaignConsumeResult{}
+	var retval *AddCampaignConsumeResp
+	if retval, err2 = p.handler.AddCampaignConsume(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddCampaignConsume: "+err2.Error())
+		oprot.WriteMessageBegin("AddCampaignConsume", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddCampaignConsume", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorListRoles struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorListRoles) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceListRolesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListRoles", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceListRolesResult{}
+	var retval *ListRolesResp
+	if retval, err2 = p.handler.ListRoles(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListRoles: "+err2.Error())
+		oprot.WriteMessageBegin("ListRoles", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListRoles", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetUserRoles struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetUserRoles) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetUserRolesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserRoles", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetUserRolesResult{}
+	var retval *UserRolesResp
+	if retval, err2 = p.handler.GetUserRoles(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserRoles: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserRoles", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserRoles", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorAssignUserRole struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorAssignUserRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceAssignUserRoleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AssignUserRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceAssignUserRoleResult{}
+	var retval *UserRolesResp
+	if retval, err2 = p.handler.AssignUserRole(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AssignUserRole: "+err2.Error())
+		oprot.WriteMessageBegin("AssignUserRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AssignUserRole", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorRevokeUserRole struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorRevokeUserRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceRevokeUserRoleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RevokeUserRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceRevokeUserRoleResult{}
+	var retval *UserRolesResp
+	if retval, err2 = p.handler.RevokeUserRole(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevokeUserRole: "+err2.Error())
+		oprot.WriteMessageBegin("RevokeUserRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RevokeUserRole", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorGetAuditLogs struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorGetAuditLogs) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceGetAuditLogsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAuditLogs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceGetAuditLogsResult{}
+	var retval *GetAuditLogsResp
+	if retval, err2 = p.handler.GetAuditLogs(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAuditLogs: "+err2.Error())
+		oprot.WriteMessageBegin("GetAuditLogs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAuditLogs", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminServiceProcessorExportAuditLogs struct {
+	handler AdminService
+}
+
+func (p *adminServiceProcessorExportAuditLogs) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminServiceExportAuditLogsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportAuditLogs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceGetAllUsersResult{}
-	var retval *GetAllUsersResp
-	if retval, err2 = p.handler.GetAllUsers(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllUsers: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllUsers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetAllUsers", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminServiceExportAuditLogsResult{}
+	var retval *ExportAuditLogsResp
+	if retval, err2 = p.handler.ExportAuditLogs(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportAuditLogs: "+err2.Error())
+		oprot.WriteMessageBegin("ExportAuditLogs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportAuditLogs", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type AdminServiceGetAllUsersArgs struct {
+	Req *GetAllUsersReq `thrift:"req,1"`
+}
+
+func NewAdminServiceGetAllUsersArgs() *AdminServiceGetAllUsersArgs {
+	return &AdminServiceGetAllUsersArgs{}
+}
+
+func (p *AdminServiceGetAllUsersArgs) InitDefault() {
+}
+
+var AdminServiceGetAllUsersArgs_Req_DEFAULT *GetAllUsersReq
+
+func (p *AdminServiceGetAllUsersArgs) GetReq() (v *GetAllUsersReq) {
+	if !p.IsSetReq() {
+		return AdminServiceGetAllUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceGetAllUsersArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceGetAllUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceGetAllUsersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllUsersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllUsersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAllUsersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminServiceGetAllUsersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllUsers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllUsersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceGetAllUsersArgs(%+v)", *p)
+
+}
+
+type AdminServiceGetAllUsersResult struct {
+	Success *GetAllUsersResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceGetAllUsersResult() *AdminServiceGetAllUsersResult {
+	return &AdminServiceGetAllUsersResult{}
+}
+
+func (p *AdminServiceGetAllUsersResult) InitDefault() {
+}
+
+var AdminServiceGetAllUsersResult_Success_DEFAULT *GetAllUsersResp
+
+func (p *AdminServiceGetAllUsersResult) GetSuccess() (v *GetAllUsersResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceGetAllUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceGetAllUsersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceGetAllUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceGetAllUsersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllUsersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllUsersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetAllUsersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminServiceGetAllUsersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllUsers_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllUsersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceGetAllUsersResult(%+v)", *p)
+
+}
+
+type AdminServiceSetUserStatusArgs struct {
+	Req *SetUserStatusReq `thrift:"req,1"`
+}
+
+func NewAdminServiceSetUserStatusArgs() *AdminServiceSetUserStatusArgs {
+	return &AdminServiceSetUserStatusArgs{}
+}
+
+func (p *AdminServiceSetUserStatusArgs) InitDefault() {
+}
+
+var AdminServiceSetUserStatusArgs_Req_DEFAULT *SetUserStatusReq
+
+func (p *AdminServiceSetUserStatusArgs) GetReq() (v *SetUserStatusReq) {
+	if !p.IsSetReq() {
+		return AdminServiceSetUserStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceSetUserStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceSetUserStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceSetUserStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceSetUserStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceSetUserStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSetUserStatusReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminServiceSetUserStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SetUserStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceSetUserStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceSetUserStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceSetUserStatusArgs(%+v)", *p)
+
+}
+
+type AdminServiceSetUserStatusResult struct {
+	Success *SetUserStatusResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceSetUserStatusResult() *AdminServiceSetUserStatusResult {
+	return &AdminServiceSetUserStatusResult{}
+}
+
+func (p *AdminServiceSetUserStatusResult) InitDefault() {
+}
+
+var AdminServiceSetUserStatusResult_Success_DEFAULT *SetUserStatusResp
+
+func (p *AdminServiceSetUserStatusResult) GetSuccess() (v *SetUserStatusResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceSetUserStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceSetUserStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceSetUserStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceSetUserStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceSetUserStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceSetUserStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSetUserStatusResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminServiceSetUserStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SetUserStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceSetUserStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceSetUserStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceSetUserStatusResult(%+v)", *p)
+
+}
+
+type AdminServiceMergeUsersArgs struct {
+	Req *MergeUsersReq `thrift:"req,1"`
+}
+
+func NewAdminServiceMergeUsersArgs() *AdminServiceMergeUsersArgs {
+	return &AdminServiceMergeUsersArgs{}
+}
+
+func (p *AdminServiceMergeUsersArgs) InitDefault() {
+}
+
+var AdminServiceMergeUsersArgs_Req_DEFAULT *MergeUsersReq
+
+func (p *AdminServiceMergeUsersArgs) GetReq() (v *MergeUsersReq) {
+	if !p.IsSetReq() {
+		return AdminServiceMergeUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceMergeUsersArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceMergeUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceMergeUsersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceMergeUsersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceMergeUsersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewMergeUsersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminServiceMergeUsersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MergeUsers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceMergeUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceMergeUsersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceMergeUsersArgs(%+v)", *p)
+
+}
+
+type AdminServiceMergeUsersResult struct {
+	Success *MergeUsersResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceMergeUsersResult() *AdminServiceMergeUsersResult {
+	return &AdminServiceMergeUsersResult{}
+}
+
+func (p *AdminServiceMergeUsersResult) InitDefault() {
+}
+
+var AdminServiceMergeUsersResult_Success_DEFAULT *MergeUsersResp
+
+func (p *AdminServiceMergeUsersResult) GetSuccess() (v *MergeUsersResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceMergeUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceMergeUsersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceMergeUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceMergeUsersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceMergeUsersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceMergeUsersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewMergeUsersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminServiceMergeUsersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MergeUsers_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceMergeUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceMergeUsersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceMergeUsersResult(%+v)", *p)
+
+}
+
+type AdminServiceGetAllKolsArgs struct {
+	Req *GetAllKolsReq `thrift:"req,1"`
+}
+
+func NewAdminServiceGetAllKolsArgs() *AdminServiceGetAllKolsArgs {
+	return &AdminServiceGetAllKolsArgs{}
+}
+
+func (p *AdminServiceGetAllKolsArgs) InitDefault() {
+}
+
+var AdminServiceGetAllKolsArgs_Req_DEFAULT *GetAllKolsReq
+
+func (p *AdminServiceGetAllKolsArgs) GetReq() (v *GetAllKolsReq) {
+	if !p.IsSetReq() {
+		return AdminServiceGetAllKolsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceGetAllKolsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceGetAllKolsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceGetAllKolsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllKolsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllKolsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAllKolsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminServiceGetAllKolsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllKols_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllKolsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllKolsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceGetAllKolsArgs(%+v)", *p)
+
+}
+
+type AdminServiceGetAllKolsResult struct {
+	Success *GetAllKolsResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceGetAllKolsResult() *AdminServiceGetAllKolsResult {
+	return &AdminServiceGetAllKolsResult{}
+}
+
+func (p *AdminServiceGetAllKolsResult) InitDefault() {
+}
+
+var AdminServiceGetAllKolsResult_Success_DEFAULT *GetAllKolsResp
+
+func (p *AdminServiceGetAllKolsResult) GetSuccess() (v *GetAllKolsResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceGetAllKolsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceGetAllKolsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceGetAllKolsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceGetAllKolsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllKolsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllKolsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetAllKolsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminServiceGetAllKolsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllKols_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllKolsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllKolsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceGetAllKolsResult(%+v)", *p)
+
+}
+
+type AdminServiceAdminReviewKolArgs struct {
+	Req *AdminReviewKolReq `thrift:"req,1"`
+}
+
+func NewAdminServiceAdminReviewKolArgs() *AdminServiceAdminReviewKolArgs {
+	return &AdminServiceAdminReviewKolArgs{}
+}
+
+func (p *AdminServiceAdminReviewKolArgs) InitDefault() {
+}
+
+var AdminServiceAdminReviewKolArgs_Req_DEFAULT *AdminReviewKolReq
+
+func (p *AdminServiceAdminReviewKolArgs) GetReq() (v *AdminReviewKolReq) {
+	if !p.IsSetReq() {
+		return AdminServiceAdminReviewKolArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceAdminReviewKolArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceAdminReviewKolArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceAdminReviewKolArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAdminReviewKolArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceAdminReviewKolArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminReviewKolReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminServiceAdminReviewKolArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminReviewKol_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceAdminReviewKolArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceAdminReviewKolArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceAdminReviewKolArgs(%+v)", *p)
+
+}
+
+type AdminServiceAdminReviewKolResult struct {
+	Success *AdminReviewKolResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceAdminReviewKolResult() *AdminServiceAdminReviewKolResult {
+	return &AdminServiceAdminReviewKolResult{}
+}
+
+func (p *AdminServiceAdminReviewKolResult) InitDefault() {
+}
+
+var AdminServiceAdminReviewKolResult_Success_DEFAULT *AdminReviewKolResp
+
+func (p *AdminServiceAdminReviewKolResult) GetSuccess() (v *AdminReviewKolResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceAdminReviewKolResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceAdminReviewKolResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceAdminReviewKolResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceAdminReviewKolResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAdminReviewKolResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceAdminReviewKolResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminReviewKolResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminServiceAdminReviewKolResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminReviewKol_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceAdminReviewKolResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceAdminReviewKolResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceAdminReviewKolResult(%+v)", *p)
+
+}
+
+type AdminServiceGetAllTeamsArgs struct {
+	Req *GetAllTeamsReq `thrift:"req,1"`
+}
+
+func NewAdminServiceGetAllTeamsArgs() *AdminServiceGetAllTeamsArgs {
+	return &AdminServiceGetAllTeamsArgs{}
+}
+
+func (p *AdminServiceGetAllTeamsArgs) InitDefault() {
+}
+
+var AdminServiceGetAllTeamsArgs_Req_DEFAULT *GetAllTeamsReq
+
+func (p *AdminServiceGetAllTeamsArgs) GetReq() (v *GetAllTeamsReq) {
+	if !p.IsSetReq() {
+		return AdminServiceGetAllTeamsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceGetAllTeamsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceGetAllTeamsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceGetAllTeamsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllTeamsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllTeamsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAllTeamsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminServiceGetAllTeamsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllTeams_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllTeamsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllTeamsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceGetAllTeamsArgs(%+v)", *p)
+
+}
+
+type AdminServiceGetAllTeamsResult struct {
+	Success *GetAllTeamsResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceGetAllTeamsResult() *AdminServiceGetAllTeamsResult {
+	return &AdminServiceGetAllTeamsResult{}
+}
+
+func (p *AdminServiceGetAllTeamsResult) InitDefault() {
+}
+
+var AdminServiceGetAllTeamsResult_Success_DEFAULT *GetAllTeamsResp
+
+func (p *AdminServiceGetAllTeamsResult) GetSuccess() (v *GetAllTeamsResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceGetAllTeamsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceGetAllTeamsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceGetAllTeamsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceGetAllTeamsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllTeamsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllTeamsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetAllTeamsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminServiceGetAllTeamsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllTeams_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllTeamsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllTeamsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("AdminServiceGetAllTeamsResult(%+v)", *p)
+
+}
+
+type AdminServiceGetTeamMembersArgs struct {
+	Req *GetTeamMembersReq `thrift:"req,1"`
+}
+
+func NewAdminServiceGetTeamMembersArgs() *AdminServiceGetTeamMembersArgs {
+	return &AdminServiceGetTeamMembersArgs{}
+}
+
+func (p *AdminServiceGetTeamMembersArgs) InitDefault() {
+}
+
+var AdminServiceGetTeamMembersArgs_Req_DEFAULT *GetTeamMembersReq
+
+func (p *AdminServiceGetTeamMembersArgs) GetReq() (v *GetTeamMembersReq) {
+	if !p.IsSetReq() {
+		return AdminServiceGetTeamMembersArgs_Req_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Req
+}
+
+var fieldIDToName_AdminServiceGetTeamMembersArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminServiceGetTeamMembersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminServiceGetTeamMembersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetTeamMembersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type adminServiceProcessorSetUserStatus struct {
-	handler AdminService
+func (p *AdminServiceGetTeamMembersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTeamMembersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *adminServiceProcessorSetUserStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceSetUserStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SetUserStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminServiceGetTeamMembersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTeamMembers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceSetUserStatusResult{}
-	var retval *SetUserStatusResp
-	if retval, err2 = p.handler.SetUserStatus(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetUserStatus: "+err2.Error())
-		oprot.WriteMessageBegin("SetUserStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminServiceGetTeamMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("SetUserStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminServiceGetTeamMembersArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("AdminServiceGetTeamMembersArgs(%+v)", *p)
+
+}
+
+type AdminServiceGetTeamMembersResult struct {
+	Success *GetTeamMembersResp `thrift:"success,0,optional"`
+}
+
+func NewAdminServiceGetTeamMembersResult() *AdminServiceGetTeamMembersResult {
+	return &AdminServiceGetTeamMembersResult{}
+}
+
+func (p *AdminServiceGetTeamMembersResult) InitDefault() {
+}
+
+var AdminServiceGetTeamMembersResult_Success_DEFAULT *GetTeamMembersResp
+
+func (p *AdminServiceGetTeamMembersResult) GetSuccess() (v *GetTeamMembersResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceGetTeamMembersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceGetTeamMembersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceGetTeamMembersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceGetTeamMembersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type adminServiceProcessorGetAllKols struct {
-	handler AdminService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetTeamMembersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *adminServiceProcessorGetAllKols) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceGetAllKolsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetAllKols", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminServiceGetTeamMembersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTeamMembersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceGetAllKolsResult{}
-	var retval *GetAllKolsResp
-	if retval, err2 = p.handler.GetAllKols(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllKols: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllKols", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminServiceGetTeamMembersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTeamMembers_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetAllKols", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetTeamMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceGetTeamMembersResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("AdminServiceGetTeamMembersResult(%+v)", *p)
+
 }
 
-type adminServiceProcessorAdminReviewKol struct {
-	handler AdminService
+type AdminServiceGetAllOrdersArgs struct {
+	Req *GetAllOrdersReq `thrift:"req,1"`
 }
 
-func (p *adminServiceProcessorAdminReviewKol) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceAdminReviewKolArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminReviewKol", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewAdminServiceGetAllOrdersArgs() *AdminServiceGetAllOrdersArgs {
+	return &AdminServiceGetAllOrdersArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceAdminReviewKolResult{}
-	var retval *AdminReviewKolResp
-	if retval, err2 = p.handler.AdminReviewKol(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminReviewKol: "+err2.Error())
-		oprot.WriteMessageBegin("AdminReviewKol", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AdminReviewKol", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *AdminServiceGetAllOrdersArgs) InitDefault() {
+}
+
+var AdminServiceGetAllOrdersArgs_Req_DEFAULT *GetAllOrdersReq
+
+func (p *AdminServiceGetAllOrdersArgs) GetReq() (v *GetAllOrdersReq) {
+	if !p.IsSetReq() {
+		return AdminServiceGetAllOrdersArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type adminServiceProcessorGetAllTeams struct {
-	handler AdminService
+var fieldIDToName_AdminServiceGetAllOrdersArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *adminServiceProcessorGetAllTeams) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceGetAllTeamsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetAllTeams", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *AdminServiceGetAllOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceGetAllTeamsResult{}
-	var retval *GetAllTeamsResp
-	if retval, err2 = p.handler.GetAllTeams(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllTeams: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllTeams", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetAllTeams", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *AdminServiceGetAllOrdersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type adminServiceProcessorGetTeamMembers struct {
-	handler AdminService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllOrdersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *adminServiceProcessorGetTeamMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceGetTeamMembersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminServiceGetAllOrdersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAllOrdersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceGetTeamMembersResult{}
-	var retval *GetTeamMembersResp
-	if retval, err2 = p.handler.GetTeamMembers(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTeamMembers: "+err2.Error())
-		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminServiceGetAllOrdersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllOrders_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetTeamMembers", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllOrdersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type adminServiceProcessorGetAllOrders struct {
-	handler AdminService
+func (p *AdminServiceGetAllOrdersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminServiceGetAllOrdersArgs(%+v)", *p)
+
 }
 
-func (p *adminServiceProcessorGetAllOrders) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceGetAllOrdersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetAllOrders", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type AdminServiceGetAllOrdersResult struct {
+	Success *GetAllOrdersResp `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceGetAllOrdersResult{}
-	var retval *GetAllOrdersResp
-	if retval, err2 = p.handler.GetAllOrders(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllOrders: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllOrders", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetAllOrders", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewAdminServiceGetAllOrdersResult() *AdminServiceGetAllOrdersResult {
+	return &AdminServiceGetAllOrdersResult{}
 }
 
-type adminServiceProcessorGetUserWallet struct {
-	handler AdminService
+func (p *AdminServiceGetAllOrdersResult) InitDefault() {
 }
 
-func (p *adminServiceProcessorGetUserWallet) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceGetUserWalletArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserWallet", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var AdminServiceGetAllOrdersResult_Success_DEFAULT *GetAllOrdersResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceGetUserWalletResult{}
-	var retval *GetUserWalletResp
-	if retval, err2 = p.handler.GetUserWallet(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserWallet: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserWallet", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetUserWallet", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *AdminServiceGetAllOrdersResult) GetSuccess() (v *GetAllOrdersResp) {
+	if !p.IsSetSuccess() {
+		return AdminServiceGetAllOrdersResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_AdminServiceGetAllOrdersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminServiceGetAllOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminServiceGetAllOrdersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type adminServiceProcessorAddCampaignConsume struct {
-	handler AdminService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllOrdersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *adminServiceProcessorAddCampaignConsume) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminServiceAddCampaignConsumeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AddCampaignConsume", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminServiceGetAllOrdersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetAllOrdersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminServiceAddCampaignConsumeResult{}
-	var retval *AddCampaignConsumeResp
-	if retval, err2 = p.handler.AddCampaignConsume(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddCampaignConsume: "+err2.Error())
-		oprot.WriteMessageBegin("AddCampaignConsume", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminServiceGetAllOrdersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAllOrders_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("AddCampaignConsume", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllOrdersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminServiceGetAllOrdersResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("AdminServiceGetAllOrdersResult(%+v)", *p)
+
 }
 
-type AdminServiceGetAllUsersArgs struct {
-	Req *GetAllUsersReq `thrift:"req,1"`
+type AdminServiceGetUserWalletArgs struct {
+	Req *GetUserWalletReq `thrift:"req,1"`
 }
 
-func NewAdminServiceGetAllUsersArgs() *AdminServiceGetAllUsersArgs {
-	return &AdminServiceGetAllUsersArgs{}
+func NewAdminServiceGetUserWalletArgs() *AdminServiceGetUserWalletArgs {
+	return &AdminServiceGetUserWalletArgs{}
 }
 
-func (p *AdminServiceGetAllUsersArgs) InitDefault() {
+func (p *AdminServiceGetUserWalletArgs) InitDefault() {
 }
 
-var AdminServiceGetAllUsersArgs_Req_DEFAULT *GetAllUsersReq
+var AdminServiceGetUserWalletArgs_Req_DEFAULT *GetUserWalletReq
 
-func (p *AdminServiceGetAllUsersArgs) GetReq() (v *GetAllUsersReq) {
+func (p *AdminServiceGetUserWalletArgs) GetReq() (v *GetUserWalletReq) {
 	if !p.IsSetReq() {
-		return AdminServiceGetAllUsersArgs_Req_DEFAULT
+		return AdminServiceGetUserWalletArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceGetAllUsersArgs = map[int16]string{
+var fieldIDToName_AdminServiceGetUserWalletArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceGetAllUsersArgs) IsSetReq() bool {
+func (p *AdminServiceGetUserWalletArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceGetAllUsersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserWalletArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8394,7 +15597,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllUsersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetUserWalletArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8404,8 +15607,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllUsersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetAllUsersReq()
+func (p *AdminServiceGetUserWalletArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserWalletReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8413,9 +15616,9 @@ func (p *AdminServiceGetAllUsersArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AdminServiceGetAllUsersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserWalletArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllUsers_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserWallet_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8441,7 +15644,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserWalletArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8458,43 +15661,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllUsersArgs) String() string {
+func (p *AdminServiceGetUserWalletArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllUsersArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceGetUserWalletArgs(%+v)", *p)
 
 }
 
-type AdminServiceGetAllUsersResult struct {
-	Success *GetAllUsersResp `thrift:"success,0,optional"`
+type AdminServiceGetUserWalletResult struct {
+	Success *GetUserWalletResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceGetAllUsersResult() *AdminServiceGetAllUsersResult {
-	return &AdminServiceGetAllUsersResult{}
+func NewAdminServiceGetUserWalletResult() *AdminServiceGetUserWalletResult {
+	return &AdminServiceGetUserWalletResult{}
 }
 
-func (p *AdminServiceGetAllUsersResult) InitDefault() {
+func (p *AdminServiceGetUserWalletResult) InitDefault() {
 }
 
-var AdminServiceGetAllUsersResult_Success_DEFAULT *GetAllUsersResp
+var AdminServiceGetUserWalletResult_Success_DEFAULT *GetUserWalletResp
 
-func (p *AdminServiceGetAllUsersResult) GetSuccess() (v *GetAllUsersResp) {
+func (p *AdminServiceGetUserWalletResult) GetSuccess() (v *GetUserWalletResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceGetAllUsersResult_Success_DEFAULT
+		return AdminServiceGetUserWalletResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceGetAllUsersResult = map[int16]string{
+var fieldIDToName_AdminServiceGetUserWalletResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceGetAllUsersResult) IsSetSuccess() bool {
+func (p *AdminServiceGetUserWalletResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceGetAllUsersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserWalletResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8540,7 +15743,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllUsersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetUserWalletResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8550,8 +15753,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllUsersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetAllUsersResp()
+func (p *AdminServiceGetUserWalletResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserWalletResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8559,9 +15762,9 @@ func (p *AdminServiceGetAllUsersResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminServiceGetAllUsersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserWalletResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllUsers_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserWallet_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8587,7 +15790,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserWalletResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8606,43 +15809,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllUsersResult) String() string {
+func (p *AdminServiceGetUserWalletResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllUsersResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceGetUserWalletResult(%+v)", *p)
 
 }
 
-type AdminServiceSetUserStatusArgs struct {
-	Req *SetUserStatusReq `thrift:"req,1"`
+type AdminServiceReconcileWalletsArgs struct {
+	Req *ReconcileWalletsReq `thrift:"req,1"`
 }
 
-func NewAdminServiceSetUserStatusArgs() *AdminServiceSetUserStatusArgs {
-	return &AdminServiceSetUserStatusArgs{}
+func NewAdminServiceReconcileWalletsArgs() *AdminServiceReconcileWalletsArgs {
+	return &AdminServiceReconcileWalletsArgs{}
 }
 
-func (p *AdminServiceSetUserStatusArgs) InitDefault() {
+func (p *AdminServiceReconcileWalletsArgs) InitDefault() {
 }
 
-var AdminServiceSetUserStatusArgs_Req_DEFAULT *SetUserStatusReq
+var AdminServiceReconcileWalletsArgs_Req_DEFAULT *ReconcileWalletsReq
 
-func (p *AdminServiceSetUserStatusArgs) GetReq() (v *SetUserStatusReq) {
+func (p *AdminServiceReconcileWalletsArgs) GetReq() (v *ReconcileWalletsReq) {
 	if !p.IsSetReq() {
-		return AdminServiceSetUserStatusArgs_Req_DEFAULT
+		return AdminServiceReconcileWalletsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceSetUserStatusArgs = map[int16]string{
+var fieldIDToName_AdminServiceReconcileWalletsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceSetUserStatusArgs) IsSetReq() bool {
+func (p *AdminServiceReconcileWalletsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceSetUserStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceReconcileWalletsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8688,7 +15891,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceSetUserStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceReconcileWalletsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8698,8 +15901,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceSetUserStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSetUserStatusReq()
+func (p *AdminServiceReconcileWalletsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReconcileWalletsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8707,9 +15910,9 @@ func (p *AdminServiceSetUserStatusArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminServiceSetUserStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceReconcileWalletsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SetUserStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("ReconcileWallets_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8735,7 +15938,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceSetUserStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceReconcileWalletsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8752,43 +15955,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceSetUserStatusArgs) String() string {
+func (p *AdminServiceReconcileWalletsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceSetUserStatusArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceReconcileWalletsArgs(%+v)", *p)
 
 }
 
-type AdminServiceSetUserStatusResult struct {
-	Success *SetUserStatusResp `thrift:"success,0,optional"`
+type AdminServiceReconcileWalletsResult struct {
+	Success *ReconcileWalletsResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceSetUserStatusResult() *AdminServiceSetUserStatusResult {
-	return &AdminServiceSetUserStatusResult{}
+func NewAdminServiceReconcileWalletsResult() *AdminServiceReconcileWalletsResult {
+	return &AdminServiceReconcileWalletsResult{}
 }
 
-func (p *AdminServiceSetUserStatusResult) InitDefault() {
+func (p *AdminServiceReconcileWalletsResult) InitDefault() {
 }
 
-var AdminServiceSetUserStatusResult_Success_DEFAULT *SetUserStatusResp
+var AdminServiceReconcileWalletsResult_Success_DEFAULT *ReconcileWalletsResp
 
-func (p *AdminServiceSetUserStatusResult) GetSuccess() (v *SetUserStatusResp) {
+func (p *AdminServiceReconcileWalletsResult) GetSuccess() (v *ReconcileWalletsResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceSetUserStatusResult_Success_DEFAULT
+		return AdminServiceReconcileWalletsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceSetUserStatusResult = map[int16]string{
+var fieldIDToName_AdminServiceReconcileWalletsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceSetUserStatusResult) IsSetSuccess() bool {
+func (p *AdminServiceReconcileWalletsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceSetUserStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceReconcileWalletsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8834,7 +16037,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceSetUserStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceReconcileWalletsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8844,8 +16047,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceSetUserStatusResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSetUserStatusResp()
+func (p *AdminServiceReconcileWalletsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReconcileWalletsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8853,9 +16056,9 @@ func (p *AdminServiceSetUserStatusResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *AdminServiceSetUserStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceReconcileWalletsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SetUserStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("ReconcileWallets_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8881,7 +16084,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceSetUserStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceReconcileWalletsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8900,43 +16103,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceSetUserStatusResult) String() string {
+func (p *AdminServiceReconcileWalletsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceSetUserStatusResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceReconcileWalletsResult(%+v)", *p)
 
 }
 
-type AdminServiceGetAllKolsArgs struct {
-	Req *GetAllKolsReq `thrift:"req,1"`
+type AdminServiceAddCampaignConsumeArgs struct {
+	Req *AddCampaignConsumeReq `thrift:"req,1"`
 }
 
-func NewAdminServiceGetAllKolsArgs() *AdminServiceGetAllKolsArgs {
-	return &AdminServiceGetAllKolsArgs{}
+func NewAdminServiceAddCampaignConsumeArgs() *AdminServiceAddCampaignConsumeArgs {
+	return &AdminServiceAddCampaignConsumeArgs{}
 }
 
-func (p *AdminServiceGetAllKolsArgs) InitDefault() {
+func (p *AdminServiceAddCampaignConsumeArgs) InitDefault() {
 }
 
-var AdminServiceGetAllKolsArgs_Req_DEFAULT *GetAllKolsReq
+var AdminServiceAddCampaignConsumeArgs_Req_DEFAULT *AddCampaignConsumeReq
 
-func (p *AdminServiceGetAllKolsArgs) GetReq() (v *GetAllKolsReq) {
+func (p *AdminServiceAddCampaignConsumeArgs) GetReq() (v *AddCampaignConsumeReq) {
 	if !p.IsSetReq() {
-		return AdminServiceGetAllKolsArgs_Req_DEFAULT
+		return AdminServiceAddCampaignConsumeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceGetAllKolsArgs = map[int16]string{
+var fieldIDToName_AdminServiceAddCampaignConsumeArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceGetAllKolsArgs) IsSetReq() bool {
+func (p *AdminServiceAddCampaignConsumeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceGetAllKolsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAddCampaignConsumeArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8982,7 +16185,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllKolsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAddCampaignConsumeArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8992,8 +16195,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllKolsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetAllKolsReq()
+func (p *AdminServiceAddCampaignConsumeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAddCampaignConsumeReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9001,9 +16204,9 @@ func (p *AdminServiceGetAllKolsArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AdminServiceGetAllKolsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAddCampaignConsumeArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllKols_args"); err != nil {
+	if err = oprot.WriteStructBegin("AddCampaignConsume_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9029,7 +16232,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllKolsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAddCampaignConsumeArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9046,43 +16249,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllKolsArgs) String() string {
+func (p *AdminServiceAddCampaignConsumeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllKolsArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceAddCampaignConsumeArgs(%+v)", *p)
 
 }
 
-type AdminServiceGetAllKolsResult struct {
-	Success *GetAllKolsResp `thrift:"success,0,optional"`
+type AdminServiceAddCampaignConsumeResult struct {
+	Success *AddCampaignConsumeResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceGetAllKolsResult() *AdminServiceGetAllKolsResult {
-	return &AdminServiceGetAllKolsResult{}
+func NewAdminServiceAddCampaignConsumeResult() *AdminServiceAddCampaignConsumeResult {
+	return &AdminServiceAddCampaignConsumeResult{}
 }
 
-func (p *AdminServiceGetAllKolsResult) InitDefault() {
+func (p *AdminServiceAddCampaignConsumeResult) InitDefault() {
 }
 
-var AdminServiceGetAllKolsResult_Success_DEFAULT *GetAllKolsResp
+var AdminServiceAddCampaignConsumeResult_Success_DEFAULT *AddCampaignConsumeResp
 
-func (p *AdminServiceGetAllKolsResult) GetSuccess() (v *GetAllKolsResp) {
+func (p *AdminServiceAddCampaignConsumeResult) GetSuccess() (v *AddCampaignConsumeResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceGetAllKolsResult_Success_DEFAULT
+		return AdminServiceAddCampaignConsumeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceGetAllKolsResult = map[int16]string{
+var fieldIDToName_AdminServiceAddCampaignConsumeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceGetAllKolsResult) IsSetSuccess() bool {
+func (p *AdminServiceAddCampaignConsumeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceGetAllKolsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAddCampaignConsumeResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9128,7 +16331,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllKolsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAddCampaignConsumeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9138,8 +16341,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllKolsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetAllKolsResp()
+func (p *AdminServiceAddCampaignConsumeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAddCampaignConsumeResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9147,9 +16350,9 @@ func (p *AdminServiceGetAllKolsResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminServiceGetAllKolsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAddCampaignConsumeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllKols_result"); err != nil {
+	if err = oprot.WriteStructBegin("AddCampaignConsume_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9175,7 +16378,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllKolsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAddCampaignConsumeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9194,43 +16397,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllKolsResult) String() string {
+func (p *AdminServiceAddCampaignConsumeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllKolsResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceAddCampaignConsumeResult(%+v)", *p)
 
 }
 
-type AdminServiceAdminReviewKolArgs struct {
-	Req *AdminReviewKolReq `thrift:"req,1"`
+type AdminServiceListRolesArgs struct {
+	Req *ListRolesReq `thrift:"req,1"`
 }
 
-func NewAdminServiceAdminReviewKolArgs() *AdminServiceAdminReviewKolArgs {
-	return &AdminServiceAdminReviewKolArgs{}
+func NewAdminServiceListRolesArgs() *AdminServiceListRolesArgs {
+	return &AdminServiceListRolesArgs{}
 }
 
-func (p *AdminServiceAdminReviewKolArgs) InitDefault() {
+func (p *AdminServiceListRolesArgs) InitDefault() {
 }
 
-var AdminServiceAdminReviewKolArgs_Req_DEFAULT *AdminReviewKolReq
+var AdminServiceListRolesArgs_Req_DEFAULT *ListRolesReq
 
-func (p *AdminServiceAdminReviewKolArgs) GetReq() (v *AdminReviewKolReq) {
+func (p *AdminServiceListRolesArgs) GetReq() (v *ListRolesReq) {
 	if !p.IsSetReq() {
-		return AdminServiceAdminReviewKolArgs_Req_DEFAULT
+		return AdminServiceListRolesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceAdminReviewKolArgs = map[int16]string{
+var fieldIDToName_AdminServiceListRolesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceAdminReviewKolArgs) IsSetReq() bool {
+func (p *AdminServiceListRolesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceAdminReviewKolArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceListRolesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9276,7 +16479,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAdminReviewKolArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceListRolesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9286,8 +16489,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceAdminReviewKolArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminReviewKolReq()
+func (p *AdminServiceListRolesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListRolesReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9295,9 +16498,9 @@ func (p *AdminServiceAdminReviewKolArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AdminServiceAdminReviewKolArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceListRolesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminReviewKol_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListRoles_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9323,7 +16526,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceAdminReviewKolArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceListRolesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9340,43 +16543,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceAdminReviewKolArgs) String() string {
+func (p *AdminServiceListRolesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceAdminReviewKolArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceListRolesArgs(%+v)", *p)
 
 }
 
-type AdminServiceAdminReviewKolResult struct {
-	Success *AdminReviewKolResp `thrift:"success,0,optional"`
+type AdminServiceListRolesResult struct {
+	Success *ListRolesResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceAdminReviewKolResult() *AdminServiceAdminReviewKolResult {
-	return &AdminServiceAdminReviewKolResult{}
+func NewAdminServiceListRolesResult() *AdminServiceListRolesResult {
+	return &AdminServiceListRolesResult{}
 }
 
-func (p *AdminServiceAdminReviewKolResult) InitDefault() {
+func (p *AdminServiceListRolesResult) InitDefault() {
 }
 
-var AdminServiceAdminReviewKolResult_Success_DEFAULT *AdminReviewKolResp
+var AdminServiceListRolesResult_Success_DEFAULT *ListRolesResp
 
-func (p *AdminServiceAdminReviewKolResult) GetSuccess() (v *AdminReviewKolResp) {
+func (p *AdminServiceListRolesResult) GetSuccess() (v *ListRolesResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceAdminReviewKolResult_Success_DEFAULT
+		return AdminServiceListRolesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceAdminReviewKolResult = map[int16]string{
+var fieldIDToName_AdminServiceListRolesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceAdminReviewKolResult) IsSetSuccess() bool {
+func (p *AdminServiceListRolesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceAdminReviewKolResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceListRolesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9422,7 +16625,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAdminReviewKolResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceListRolesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9432,8 +16635,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceAdminReviewKolResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminReviewKolResp()
+func (p *AdminServiceListRolesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListRolesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9441,9 +16644,9 @@ func (p *AdminServiceAdminReviewKolResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *AdminServiceAdminReviewKolResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceListRolesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminReviewKol_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListRoles_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9469,7 +16672,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceAdminReviewKolResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceListRolesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9488,43 +16691,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceAdminReviewKolResult) String() string {
+func (p *AdminServiceListRolesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceAdminReviewKolResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceListRolesResult(%+v)", *p)
 
 }
 
-type AdminServiceGetAllTeamsArgs struct {
-	Req *GetAllTeamsReq `thrift:"req,1"`
+type AdminServiceGetUserRolesArgs struct {
+	Req *GetUserRolesReq `thrift:"req,1"`
 }
 
-func NewAdminServiceGetAllTeamsArgs() *AdminServiceGetAllTeamsArgs {
-	return &AdminServiceGetAllTeamsArgs{}
+func NewAdminServiceGetUserRolesArgs() *AdminServiceGetUserRolesArgs {
+	return &AdminServiceGetUserRolesArgs{}
 }
 
-func (p *AdminServiceGetAllTeamsArgs) InitDefault() {
+func (p *AdminServiceGetUserRolesArgs) InitDefault() {
 }
 
-var AdminServiceGetAllTeamsArgs_Req_DEFAULT *GetAllTeamsReq
+var AdminServiceGetUserRolesArgs_Req_DEFAULT *GetUserRolesReq
 
-func (p *AdminServiceGetAllTeamsArgs) GetReq() (v *GetAllTeamsReq) {
+func (p *AdminServiceGetUserRolesArgs) GetReq() (v *GetUserRolesReq) {
 	if !p.IsSetReq() {
-		return AdminServiceGetAllTeamsArgs_Req_DEFAULT
+		return AdminServiceGetUserRolesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceGetAllTeamsArgs = map[int16]string{
+var fieldIDToName_AdminServiceGetUserRolesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceGetAllTeamsArgs) IsSetReq() bool {
+func (p *AdminServiceGetUserRolesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceGetAllTeamsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserRolesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9570,7 +16773,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllTeamsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetUserRolesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9580,8 +16783,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllTeamsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetAllTeamsReq()
+func (p *AdminServiceGetUserRolesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserRolesReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9589,9 +16792,9 @@ func (p *AdminServiceGetAllTeamsArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AdminServiceGetAllTeamsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserRolesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllTeams_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserRoles_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9617,7 +16820,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllTeamsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserRolesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9634,43 +16837,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllTeamsArgs) String() string {
+func (p *AdminServiceGetUserRolesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllTeamsArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceGetUserRolesArgs(%+v)", *p)
 
 }
 
-type AdminServiceGetAllTeamsResult struct {
-	Success *GetAllTeamsResp `thrift:"success,0,optional"`
+type AdminServiceGetUserRolesResult struct {
+	Success *UserRolesResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceGetAllTeamsResult() *AdminServiceGetAllTeamsResult {
-	return &AdminServiceGetAllTeamsResult{}
+func NewAdminServiceGetUserRolesResult() *AdminServiceGetUserRolesResult {
+	return &AdminServiceGetUserRolesResult{}
 }
 
-func (p *AdminServiceGetAllTeamsResult) InitDefault() {
+func (p *AdminServiceGetUserRolesResult) InitDefault() {
 }
 
-var AdminServiceGetAllTeamsResult_Success_DEFAULT *GetAllTeamsResp
+var AdminServiceGetUserRolesResult_Success_DEFAULT *UserRolesResp
 
-func (p *AdminServiceGetAllTeamsResult) GetSuccess() (v *GetAllTeamsResp) {
+func (p *AdminServiceGetUserRolesResult) GetSuccess() (v *UserRolesResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceGetAllTeamsResult_Success_DEFAULT
+		return AdminServiceGetUserRolesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceGetAllTeamsResult = map[int16]string{
+var fieldIDToName_AdminServiceGetUserRolesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceGetAllTeamsResult) IsSetSuccess() bool {
+func (p *AdminServiceGetUserRolesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceGetAllTeamsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserRolesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9716,7 +16919,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllTeamsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetUserRolesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9726,8 +16929,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllTeamsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetAllTeamsResp()
+func (p *AdminServiceGetUserRolesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserRolesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9735,9 +16938,9 @@ func (p *AdminServiceGetAllTeamsResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminServiceGetAllTeamsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserRolesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllTeams_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserRoles_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9763,7 +16966,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllTeamsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetUserRolesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9782,43 +16985,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllTeamsResult) String() string {
+func (p *AdminServiceGetUserRolesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllTeamsResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceGetUserRolesResult(%+v)", *p)
 
 }
 
-type AdminServiceGetTeamMembersArgs struct {
-	Req *GetTeamMembersReq `thrift:"req,1"`
+type AdminServiceAssignUserRoleArgs struct {
+	Req *UpdateUserRoleReq `thrift:"req,1"`
 }
 
-func NewAdminServiceGetTeamMembersArgs() *AdminServiceGetTeamMembersArgs {
-	return &AdminServiceGetTeamMembersArgs{}
+func NewAdminServiceAssignUserRoleArgs() *AdminServiceAssignUserRoleArgs {
+	return &AdminServiceAssignUserRoleArgs{}
 }
 
-func (p *AdminServiceGetTeamMembersArgs) InitDefault() {
+func (p *AdminServiceAssignUserRoleArgs) InitDefault() {
 }
 
-var AdminServiceGetTeamMembersArgs_Req_DEFAULT *GetTeamMembersReq
+var AdminServiceAssignUserRoleArgs_Req_DEFAULT *UpdateUserRoleReq
 
-func (p *AdminServiceGetTeamMembersArgs) GetReq() (v *GetTeamMembersReq) {
+func (p *AdminServiceAssignUserRoleArgs) GetReq() (v *UpdateUserRoleReq) {
 	if !p.IsSetReq() {
-		return AdminServiceGetTeamMembersArgs_Req_DEFAULT
+		return AdminServiceAssignUserRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceGetTeamMembersArgs = map[int16]string{
+var fieldIDToName_AdminServiceAssignUserRoleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceGetTeamMembersArgs) IsSetReq() bool {
+func (p *AdminServiceAssignUserRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceGetTeamMembersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAssignUserRoleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9864,7 +17067,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetTeamMembersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAssignUserRoleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9874,8 +17077,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetTeamMembersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTeamMembersReq()
+func (p *AdminServiceAssignUserRoleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserRoleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9883,9 +17086,9 @@ func (p *AdminServiceGetTeamMembersArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AdminServiceGetTeamMembersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAssignUserRoleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeamMembers_args"); err != nil {
+	if err = oprot.WriteStructBegin("AssignUserRole_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9911,7 +17114,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetTeamMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAssignUserRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9928,43 +17131,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceGetTeamMembersArgs) String() string {
+func (p *AdminServiceAssignUserRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetTeamMembersArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceAssignUserRoleArgs(%+v)", *p)
 
 }
 
-type AdminServiceGetTeamMembersResult struct {
-	Success *GetTeamMembersResp `thrift:"success,0,optional"`
+type AdminServiceAssignUserRoleResult struct {
+	Success *UserRolesResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceGetTeamMembersResult() *AdminServiceGetTeamMembersResult {
-	return &AdminServiceGetTeamMembersResult{}
+func NewAdminServiceAssignUserRoleResult() *AdminServiceAssignUserRoleResult {
+	return &AdminServiceAssignUserRoleResult{}
 }
 
-func (p *AdminServiceGetTeamMembersResult) InitDefault() {
+func (p *AdminServiceAssignUserRoleResult) InitDefault() {
 }
 
-var AdminServiceGetTeamMembersResult_Success_DEFAULT *GetTeamMembersResp
+var AdminServiceAssignUserRoleResult_Success_DEFAULT *UserRolesResp
 
-func (p *AdminServiceGetTeamMembersResult) GetSuccess() (v *GetTeamMembersResp) {
+func (p *AdminServiceAssignUserRoleResult) GetSuccess() (v *UserRolesResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceGetTeamMembersResult_Success_DEFAULT
+		return AdminServiceAssignUserRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceGetTeamMembersResult = map[int16]string{
+var fieldIDToName_AdminServiceAssignUserRoleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceGetTeamMembersResult) IsSetSuccess() bool {
+func (p *AdminServiceAssignUserRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceGetTeamMembersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAssignUserRoleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10010,7 +17213,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetTeamMembersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAssignUserRoleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10020,8 +17223,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetTeamMembersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTeamMembersResp()
+func (p *AdminServiceAssignUserRoleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserRolesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10029,9 +17232,9 @@ func (p *AdminServiceGetTeamMembersResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *AdminServiceGetTeamMembersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAssignUserRoleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeamMembers_result"); err != nil {
+	if err = oprot.WriteStructBegin("AssignUserRole_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10057,7 +17260,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetTeamMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceAssignUserRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10076,43 +17279,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceGetTeamMembersResult) String() string {
+func (p *AdminServiceAssignUserRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetTeamMembersResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceAssignUserRoleResult(%+v)", *p)
 
 }
 
-type AdminServiceGetAllOrdersArgs struct {
-	Req *GetAllOrdersReq `thrift:"req,1"`
+type AdminServiceRevokeUserRoleArgs struct {
+	Req *UpdateUserRoleReq `thrift:"req,1"`
 }
 
-func NewAdminServiceGetAllOrdersArgs() *AdminServiceGetAllOrdersArgs {
-	return &AdminServiceGetAllOrdersArgs{}
+func NewAdminServiceRevokeUserRoleArgs() *AdminServiceRevokeUserRoleArgs {
+	return &AdminServiceRevokeUserRoleArgs{}
 }
 
-func (p *AdminServiceGetAllOrdersArgs) InitDefault() {
+func (p *AdminServiceRevokeUserRoleArgs) InitDefault() {
 }
 
-var AdminServiceGetAllOrdersArgs_Req_DEFAULT *GetAllOrdersReq
+var AdminServiceRevokeUserRoleArgs_Req_DEFAULT *UpdateUserRoleReq
 
-func (p *AdminServiceGetAllOrdersArgs) GetReq() (v *GetAllOrdersReq) {
+func (p *AdminServiceRevokeUserRoleArgs) GetReq() (v *UpdateUserRoleReq) {
 	if !p.IsSetReq() {
-		return AdminServiceGetAllOrdersArgs_Req_DEFAULT
+		return AdminServiceRevokeUserRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceGetAllOrdersArgs = map[int16]string{
+var fieldIDToName_AdminServiceRevokeUserRoleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceGetAllOrdersArgs) IsSetReq() bool {
+func (p *AdminServiceRevokeUserRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceGetAllOrdersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceRevokeUserRoleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10158,7 +17361,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllOrdersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceRevokeUserRoleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10168,8 +17371,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllOrdersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetAllOrdersReq()
+func (p *AdminServiceRevokeUserRoleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserRoleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10177,9 +17380,9 @@ func (p *AdminServiceGetAllOrdersArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminServiceGetAllOrdersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceRevokeUserRoleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllOrders_args"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeUserRole_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10205,7 +17408,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllOrdersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceRevokeUserRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10222,43 +17425,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllOrdersArgs) String() string {
+func (p *AdminServiceRevokeUserRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllOrdersArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceRevokeUserRoleArgs(%+v)", *p)
 
 }
 
-type AdminServiceGetAllOrdersResult struct {
-	Success *GetAllOrdersResp `thrift:"success,0,optional"`
+type AdminServiceRevokeUserRoleResult struct {
+	Success *UserRolesResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceGetAllOrdersResult() *AdminServiceGetAllOrdersResult {
-	return &AdminServiceGetAllOrdersResult{}
+func NewAdminServiceRevokeUserRoleResult() *AdminServiceRevokeUserRoleResult {
+	return &AdminServiceRevokeUserRoleResult{}
 }
 
-func (p *AdminServiceGetAllOrdersResult) InitDefault() {
+func (p *AdminServiceRevokeUserRoleResult) InitDefault() {
 }
 
-var AdminServiceGetAllOrdersResult_Success_DEFAULT *GetAllOrdersResp
+var AdminServiceRevokeUserRoleResult_Success_DEFAULT *UserRolesResp
 
-func (p *AdminServiceGetAllOrdersResult) GetSuccess() (v *GetAllOrdersResp) {
+func (p *AdminServiceRevokeUserRoleResult) GetSuccess() (v *UserRolesResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceGetAllOrdersResult_Success_DEFAULT
+		return AdminServiceRevokeUserRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceGetAllOrdersResult = map[int16]string{
+var fieldIDToName_AdminServiceRevokeUserRoleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceGetAllOrdersResult) IsSetSuccess() bool {
+func (p *AdminServiceRevokeUserRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceGetAllOrdersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceRevokeUserRoleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10304,7 +17507,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAllOrdersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceRevokeUserRoleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10314,8 +17517,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllOrdersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetAllOrdersResp()
+func (p *AdminServiceRevokeUserRoleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserRolesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10323,9 +17526,9 @@ func (p *AdminServiceGetAllOrdersResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AdminServiceGetAllOrdersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceRevokeUserRoleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllOrders_result"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeUserRole_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10351,7 +17554,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllOrdersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceRevokeUserRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10370,43 +17573,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceGetAllOrdersResult) String() string {
+func (p *AdminServiceRevokeUserRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetAllOrdersResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceRevokeUserRoleResult(%+v)", *p)
 
 }
 
-type AdminServiceGetUserWalletArgs struct {
-	Req *GetUserWalletReq `thrift:"req,1"`
+type AdminServiceGetAuditLogsArgs struct {
+	Req *GetAuditLogsReq `thrift:"req,1"`
 }
 
-func NewAdminServiceGetUserWalletArgs() *AdminServiceGetUserWalletArgs {
-	return &AdminServiceGetUserWalletArgs{}
+func NewAdminServiceGetAuditLogsArgs() *AdminServiceGetAuditLogsArgs {
+	return &AdminServiceGetAuditLogsArgs{}
 }
 
-func (p *AdminServiceGetUserWalletArgs) InitDefault() {
+func (p *AdminServiceGetAuditLogsArgs) InitDefault() {
 }
 
-var AdminServiceGetUserWalletArgs_Req_DEFAULT *GetUserWalletReq
+var AdminServiceGetAuditLogsArgs_Req_DEFAULT *GetAuditLogsReq
 
-func (p *AdminServiceGetUserWalletArgs) GetReq() (v *GetUserWalletReq) {
+func (p *AdminServiceGetAuditLogsArgs) GetReq() (v *GetAuditLogsReq) {
 	if !p.IsSetReq() {
-		return AdminServiceGetUserWalletArgs_Req_DEFAULT
+		return AdminServiceGetAuditLogsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceGetUserWalletArgs = map[int16]string{
+var fieldIDToName_AdminServiceGetAuditLogsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceGetUserWalletArgs) IsSetReq() bool {
+func (p *AdminServiceGetAuditLogsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceGetUserWalletArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetAuditLogsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10452,7 +17655,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetUserWalletArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAuditLogsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10462,8 +17665,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetUserWalletArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserWalletReq()
+func (p *AdminServiceGetAuditLogsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAuditLogsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10471,9 +17674,9 @@ func (p *AdminServiceGetUserWalletArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminServiceGetUserWalletArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetAuditLogsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserWallet_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetAuditLogs_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10499,7 +17702,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetUserWalletArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetAuditLogsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10516,43 +17719,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceGetUserWalletArgs) String() string {
+func (p *AdminServiceGetAuditLogsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetUserWalletArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceGetAuditLogsArgs(%+v)", *p)
 
 }
 
-type AdminServiceGetUserWalletResult struct {
-	Success *GetUserWalletResp `thrift:"success,0,optional"`
+type AdminServiceGetAuditLogsResult struct {
+	Success *GetAuditLogsResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceGetUserWalletResult() *AdminServiceGetUserWalletResult {
-	return &AdminServiceGetUserWalletResult{}
+func NewAdminServiceGetAuditLogsResult() *AdminServiceGetAuditLogsResult {
+	return &AdminServiceGetAuditLogsResult{}
 }
 
-func (p *AdminServiceGetUserWalletResult) InitDefault() {
+func (p *AdminServiceGetAuditLogsResult) InitDefault() {
 }
 
-var AdminServiceGetUserWalletResult_Success_DEFAULT *GetUserWalletResp
+var AdminServiceGetAuditLogsResult_Success_DEFAULT *GetAuditLogsResp
 
-func (p *AdminServiceGetUserWalletResult) GetSuccess() (v *GetUserWalletResp) {
+func (p *AdminServiceGetAuditLogsResult) GetSuccess() (v *GetAuditLogsResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceGetUserWalletResult_Success_DEFAULT
+		return AdminServiceGetAuditLogsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceGetUserWalletResult = map[int16]string{
+var fieldIDToName_AdminServiceGetAuditLogsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceGetUserWalletResult) IsSetSuccess() bool {
+func (p *AdminServiceGetAuditLogsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceGetUserWalletResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetAuditLogsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10598,7 +17801,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetUserWalletResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceGetAuditLogsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10608,8 +17811,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetUserWalletResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserWalletResp()
+func (p *AdminServiceGetAuditLogsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetAuditLogsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10617,9 +17820,9 @@ func (p *AdminServiceGetUserWalletResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *AdminServiceGetUserWalletResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetAuditLogsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserWallet_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetAuditLogs_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10645,7 +17848,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceGetUserWalletResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceGetAuditLogsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10664,43 +17867,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceGetUserWalletResult) String() string {
+func (p *AdminServiceGetAuditLogsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceGetUserWalletResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceGetAuditLogsResult(%+v)", *p)
 
 }
 
-type AdminServiceAddCampaignConsumeArgs struct {
-	Req *AddCampaignConsumeReq `thrift:"req,1"`
+type AdminServiceExportAuditLogsArgs struct {
+	Req *GetAuditLogsReq `thrift:"req,1"`
 }
 
-func NewAdminServiceAddCampaignConsumeArgs() *AdminServiceAddCampaignConsumeArgs {
-	return &AdminServiceAddCampaignConsumeArgs{}
+func NewAdminServiceExportAuditLogsArgs() *AdminServiceExportAuditLogsArgs {
+	return &AdminServiceExportAuditLogsArgs{}
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) InitDefault() {
+func (p *AdminServiceExportAuditLogsArgs) InitDefault() {
 }
 
-var AdminServiceAddCampaignConsumeArgs_Req_DEFAULT *AddCampaignConsumeReq
+var AdminServiceExportAuditLogsArgs_Req_DEFAULT *GetAuditLogsReq
 
-func (p *AdminServiceAddCampaignConsumeArgs) GetReq() (v *AddCampaignConsumeReq) {
+func (p *AdminServiceExportAuditLogsArgs) GetReq() (v *GetAuditLogsReq) {
 	if !p.IsSetReq() {
-		return AdminServiceAddCampaignConsumeArgs_Req_DEFAULT
+		return AdminServiceExportAuditLogsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminServiceAddCampaignConsumeArgs = map[int16]string{
+var fieldIDToName_AdminServiceExportAuditLogsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) IsSetReq() bool {
+func (p *AdminServiceExportAuditLogsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceExportAuditLogsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10746,7 +17949,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAddCampaignConsumeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceExportAuditLogsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10756,8 +17959,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAddCampaignConsumeReq()
+func (p *AdminServiceExportAuditLogsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAuditLogsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10765,9 +17968,9 @@ func (p *AdminServiceAddCampaignConsumeArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceExportAuditLogsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddCampaignConsume_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExportAuditLogs_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10793,7 +17996,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceExportAuditLogsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10810,43 +18013,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminServiceAddCampaignConsumeArgs) String() string {
+func (p *AdminServiceExportAuditLogsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceAddCampaignConsumeArgs(%+v)", *p)
+	return fmt.Sprintf("AdminServiceExportAuditLogsArgs(%+v)", *p)
 
 }
 
-type AdminServiceAddCampaignConsumeResult struct {
-	Success *AddCampaignConsumeResp `thrift:"success,0,optional"`
+type AdminServiceExportAuditLogsResult struct {
+	Success *ExportAuditLogsResp `thrift:"success,0,optional"`
 }
 
-func NewAdminServiceAddCampaignConsumeResult() *AdminServiceAddCampaignConsumeResult {
-	return &AdminServiceAddCampaignConsumeResult{}
+func NewAdminServiceExportAuditLogsResult() *AdminServiceExportAuditLogsResult {
+	return &AdminServiceExportAuditLogsResult{}
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) InitDefault() {
+func (p *AdminServiceExportAuditLogsResult) InitDefault() {
 }
 
-var AdminServiceAddCampaignConsumeResult_Success_DEFAULT *AddCampaignConsumeResp
+var AdminServiceExportAuditLogsResult_Success_DEFAULT *ExportAuditLogsResp
 
-func (p *AdminServiceAddCampaignConsumeResult) GetSuccess() (v *AddCampaignConsumeResp) {
+func (p *AdminServiceExportAuditLogsResult) GetSuccess() (v *ExportAuditLogsResp) {
 	if !p.IsSetSuccess() {
-		return AdminServiceAddCampaignConsumeResult_Success_DEFAULT
+		return AdminServiceExportAuditLogsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminServiceAddCampaignConsumeResult = map[int16]string{
+var fieldIDToName_AdminServiceExportAuditLogsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) IsSetSuccess() bool {
+func (p *AdminServiceExportAuditLogsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminServiceExportAuditLogsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10892,7 +18095,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceAddCampaignConsumeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminServiceExportAuditLogsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10902,8 +18105,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAddCampaignConsumeResp()
+func (p *AdminServiceExportAuditLogsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportAuditLogsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10911,9 +18114,9 @@ func (p *AdminServiceAddCampaignConsumeResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceExportAuditLogsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddCampaignConsume_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExportAuditLogs_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10939,7 +18142,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminServiceExportAuditLogsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10958,10 +18161,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminServiceAddCampaignConsumeResult) String() string {
+func (p *AdminServiceExportAuditLogsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminServiceAddCampaignConsumeResult(%+v)", *p)
+	return fmt.Sprintf("AdminServiceExportAuditLogsResult(%+v)", *p)
 
 }
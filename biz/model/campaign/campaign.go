@@ -426,7 +426,7 @@ type CampaignInfo struct {
 	FrequencyCapDays  *int32 `thrift:"frequency_cap_days,27,optional" form:"frequency_cap_days" json:"frequency_cap_days,omitempty" query:"frequency_cap_days"`
 	// 0-每日预算, 1-总预算
 	BudgetType         int32   `thrift:"budget_type,28" form:"budget_type" json:"budget_type" query:"budget_type"`
-	BudgetAmount       float64 `thrift:"budget_amount,29" form:"budget_amount" json:"budget_amount" query:"budget_amount"`
+	BudgetAmount       string  `thrift:"budget_amount,29" form:"budget_amount" json:"budget_amount" query:"budget_amount"`
 	Website            *string `thrift:"website,30,optional" form:"website" json:"website,omitempty" query:"website"`
 	IosDownloadURL     *string `thrift:"ios_download_url,31,optional" form:"ios_download_url" json:"ios_download_url,omitempty" query:"ios_download_url"`
 	AndroidDownloadURL *string `thrift:"android_download_url,32,optional" form:"android_download_url" json:"android_download_url,omitempty" query:"android_download_url"`
@@ -631,7 +631,7 @@ func (p *CampaignInfo) GetBudgetType() (v int32) {
 	return p.BudgetType
 }
 
-func (p *CampaignInfo) GetBudgetAmount() (v float64) {
+func (p *CampaignInfo) GetBudgetAmount() (v string) {
 	return p.BudgetAmount
 }
 
@@ -1033,7 +1033,7 @@ func (p *CampaignInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 29:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField29(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1495,8 +1495,8 @@ func (p *CampaignInfo) ReadField28(iprot thrift.TProtocol) error {
 }
 func (p *CampaignInfo) ReadField29(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
@@ -2309,10 +2309,10 @@ WriteFieldEndError:
 }
 
 func (p *CampaignInfo) writeField29(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("budget_amount", thrift.DOUBLE, 29); err != nil {
+	if err = oprot.WriteFieldBegin("budget_amount", thrift.STRING, 29); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.BudgetAmount); err != nil {
+	if err := oprot.WriteString(p.BudgetAmount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2492,7 +2492,7 @@ type CreateCampaignReq struct {
 	FrequencyCapTimes  *int32   `thrift:"frequency_cap_times,22,optional" form:"frequency_cap_times" json:"frequency_cap_times,omitempty"`
 	FrequencyCapDays   *int32   `thrift:"frequency_cap_days,23,optional" form:"frequency_cap_days" json:"frequency_cap_days,omitempty"`
 	BudgetType         int32    `thrift:"budget_type,24" form:"budget_type" json:"budget_type"`
-	BudgetAmount       float64  `thrift:"budget_amount,25" form:"budget_amount" json:"budget_amount"`
+	BudgetAmount       string   `thrift:"budget_amount,25" form:"budget_amount" json:"budget_amount"`
 	Website            *string  `thrift:"website,26,optional" form:"website" json:"website,omitempty"`
 	IosDownloadURL     *string  `thrift:"ios_download_url,27,optional" form:"ios_download_url" json:"ios_download_url,omitempty"`
 	AndroidDownloadURL *string  `thrift:"android_download_url,28,optional" form:"android_download_url" json:"android_download_url,omitempty"`
@@ -2678,7 +2678,7 @@ func (p *CreateCampaignReq) GetBudgetType() (v int32) {
 	return p.BudgetType
 }
 
-func (p *CreateCampaignReq) GetBudgetAmount() (v float64) {
+func (p *CreateCampaignReq) GetBudgetAmount() (v string) {
 	return p.BudgetAmount
 }
 
@@ -3038,7 +3038,7 @@ func (p *CreateCampaignReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 25:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField25(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3432,8 +3432,8 @@ func (p *CreateCampaignReq) ReadField24(iprot thrift.TProtocol) error {
 }
 func (p *CreateCampaignReq) ReadField25(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
@@ -4117,10 +4117,10 @@ WriteFieldEndError:
 }
 
 func (p *CreateCampaignReq) writeField25(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("budget_amount", thrift.DOUBLE, 25); err != nil {
+	if err = oprot.WriteFieldBegin("budget_amount", thrift.STRING, 25); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.BudgetAmount); err != nil {
+	if err := oprot.WriteString(p.BudgetAmount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4451,7 +4451,7 @@ type UpdateCampaignReq struct {
 	FrequencyCapTimes  *int32   `thrift:"frequency_cap_times,23,optional" form:"frequency_cap_times" json:"frequency_cap_times,omitempty"`
 	FrequencyCapDays   *int32   `thrift:"frequency_cap_days,24,optional" form:"frequency_cap_days" json:"frequency_cap_days,omitempty"`
 	BudgetType         *int32   `thrift:"budget_type,25,optional" form:"budget_type" json:"budget_type,omitempty"`
-	BudgetAmount       *float64 `thrift:"budget_amount,26,optional" form:"budget_amount" json:"budget_amount,omitempty"`
+	BudgetAmount       *string  `thrift:"budget_amount,26,optional" form:"budget_amount" json:"budget_amount,omitempty"`
 	Website            *string  `thrift:"website,27,optional" form:"website" json:"website,omitempty"`
 	IosDownloadURL     *string  `thrift:"ios_download_url,28,optional" form:"ios_download_url" json:"ios_download_url,omitempty"`
 	AndroidDownloadURL *string  `thrift:"android_download_url,29,optional" form:"android_download_url" json:"android_download_url,omitempty"`
@@ -4686,9 +4686,9 @@ func (p *UpdateCampaignReq) GetBudgetType() (v int32) {
 	return *p.BudgetType
 }
 
-var UpdateCampaignReq_BudgetAmount_DEFAULT float64
+var UpdateCampaignReq_BudgetAmount_DEFAULT string
 
-func (p *UpdateCampaignReq) GetBudgetAmount() (v float64) {
+func (p *UpdateCampaignReq) GetBudgetAmount() (v string) {
 	if !p.IsSetBudgetAmount() {
 		return UpdateCampaignReq_BudgetAmount_DEFAULT
 	}
@@ -5100,7 +5100,7 @@ func (p *UpdateCampaignReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 26:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField26(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5505,8 +5505,8 @@ func (p *UpdateCampaignReq) ReadField25(iprot thrift.TProtocol) error {
 }
 func (p *UpdateCampaignReq) ReadField26(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
@@ -6230,10 +6230,10 @@ WriteFieldEndError:
 
 func (p *UpdateCampaignReq) writeField26(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBudgetAmount() {
-		if err = oprot.WriteFieldBegin("budget_amount", thrift.DOUBLE, 26); err != nil {
+		if err = oprot.WriteFieldBegin("budget_amount", thrift.STRING, 26); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.BudgetAmount); err != nil {
+		if err := oprot.WriteString(*p.BudgetAmount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
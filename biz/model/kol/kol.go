@@ -346,6 +346,14 @@ type KolStats struct {
 	DiscordMembers     int64   `thrift:"discord_members,5" form:"discord_members" json:"discord_members" query:"discord_members"`
 	TiktokAvgViews     int64   `thrift:"tiktok_avg_views,6" form:"tiktok_avg_views" json:"tiktok_avg_views" query:"tiktok_avg_views"`
 	EngagementRate     float64 `thrift:"engagement_rate,7" form:"engagement_rate" json:"engagement_rate" query:"engagement_rate"`
+	// 所有已填写主页的平台粉丝数均已由平台接口核验
+	Verified bool `thrift:"verified,8" form:"verified" json:"verified" query:"verified"`
+	// 粉丝数已核验的平台：tiktok, youtube, x, discord；其余平台为KOL手动填写
+	VerifiedPlatforms []string `thrift:"verified_platforms,9,default,list<string>" form:"verified_platforms" json:"verified_platforms" query:"verified_platforms"`
+	// 最近一次核验成功时间
+	VerifiedAt *string `thrift:"verified_at,10,optional" form:"verified_at" json:"verified_at,omitempty" query:"verified_at"`
+	// 最近一次同步时间
+	LastRefreshedAt *string `thrift:"last_refreshed_at,11,optional" form:"last_refreshed_at" json:"last_refreshed_at,omitempty" query:"last_refreshed_at"`
 }
 
 func NewKolStats() *KolStats {
@@ -383,14 +391,52 @@ func (p *KolStats) GetEngagementRate() (v float64) {
 	return p.EngagementRate
 }
 
+func (p *KolStats) GetVerified() (v bool) {
+	return p.Verified
+}
+
+func (p *KolStats) GetVerifiedPlatforms() (v []string) {
+	return p.VerifiedPlatforms
+}
+
+var KolStats_VerifiedAt_DEFAULT string
+
+func (p *KolStats) GetVerifiedAt() (v string) {
+	if !p.IsSetVerifiedAt() {
+		return KolStats_VerifiedAt_DEFAULT
+	}
+	return *p.VerifiedAt
+}
+
+var KolStats_LastRefreshedAt_DEFAULT string
+
+func (p *KolStats) GetLastRefreshedAt() (v string) {
+	if !p.IsSetLastRefreshedAt() {
+		return KolStats_LastRefreshedAt_DEFAULT
+	}
+	return *p.LastRefreshedAt
+}
+
 var fieldIDToName_KolStats = map[int16]string{
-	1: "total_followers",
-	2: "tiktok_followers",
-	3: "youtube_subscribers",
-	4: "x_followers",
-	5: "discord_members",
-	6: "tiktok_avg_views",
-	7: "engagement_rate",
+	1:  "total_followers",
+	2:  "tiktok_followers",
+	3:  "youtube_subscribers",
+	4:  "x_followers",
+	5:  "discord_members",
+	6:  "tiktok_avg_views",
+	7:  "engagement_rate",
+	8:  "verified",
+	9:  "verified_platforms",
+	10: "verified_at",
+	11: "last_refreshed_at",
+}
+
+func (p *KolStats) IsSetVerifiedAt() bool {
+	return p.VerifiedAt != nil
+}
+
+func (p *KolStats) IsSetLastRefreshedAt() bool {
+	return p.LastRefreshedAt != nil
 }
 
 func (p *KolStats) Read(iprot thrift.TProtocol) (err error) {
@@ -468,6 +514,38 @@ func (p *KolStats) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -574,6 +652,62 @@ func (p *KolStats) ReadField7(iprot thrift.TProtocol) error {
 	p.EngagementRate = _field
 	return nil
 }
+func (p *KolStats) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Verified = _field
+	return nil
+}
+func (p *KolStats) ReadField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VerifiedPlatforms = _field
+	return nil
+}
+func (p *KolStats) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VerifiedAt = _field
+	return nil
+}
+func (p *KolStats) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastRefreshedAt = _field
+	return nil
+}
 
 func (p *KolStats) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -609,6 +743,22 @@ func (p *KolStats) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -746,6 +896,86 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
+func (p *KolStats) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verified", thrift.BOOL, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Verified); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *KolStats) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verified_platforms", thrift.LIST, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.VerifiedPlatforms)); err != nil {
+		return err
+	}
+	for _, v := range p.VerifiedPlatforms {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *KolStats) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerifiedAt() {
+		if err = oprot.WriteFieldBegin("verified_at", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.VerifiedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *KolStats) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastRefreshedAt() {
+		if err = oprot.WriteFieldBegin("last_refreshed_at", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LastRefreshedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
 func (p *KolStats) String() string {
 	if p == nil {
 		return "<nil>"
@@ -756,14 +986,16 @@ func (p *KolStats) String() string {
 
 // KOL报价Plan
 type KolPlan struct {
-	ID          int64   `thrift:"id,1" form:"id" json:"id" query:"id"`
-	Title       string  `thrift:"title,2" form:"title" json:"title" query:"title"`
-	Description string  `thrift:"description,3" form:"description" json:"description" query:"description"`
-	Price       float64 `thrift:"price,4" form:"price" json:"price" query:"price"`
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Title       string `thrift:"title,2" form:"title" json:"title" query:"title"`
+	Description string `thrift:"description,3" form:"description" json:"description" query:"description"`
+	Price       string `thrift:"price,4" form:"price" json:"price" query:"price"`
 	// basic, standard, premium
 	PlanType  string `thrift:"plan_type,5" form:"plan_type" json:"plan_type" query:"plan_type"`
 	CreatedAt string `thrift:"created_at,6" form:"created_at" json:"created_at" query:"created_at"`
 	UpdatedAt string `thrift:"updated_at,7" form:"updated_at" json:"updated_at" query:"updated_at"`
+	// 买家验收时最多可申请修改的次数
+	MaxRevisions int32 `thrift:"max_revisions,8" form:"max_revisions" json:"max_revisions" query:"max_revisions"`
 }
 
 func NewKolPlan() *KolPlan {
@@ -785,7 +1017,7 @@ func (p *KolPlan) GetDescription() (v string) {
 	return p.Description
 }
 
-func (p *KolPlan) GetPrice() (v float64) {
+func (p *KolPlan) GetPrice() (v string) {
 	return p.Price
 }
 
@@ -801,6 +1033,10 @@ func (p *KolPlan) GetUpdatedAt() (v string) {
 	return p.UpdatedAt
 }
 
+func (p *KolPlan) GetMaxRevisions() (v int32) {
+	return p.MaxRevisions
+}
+
 var fieldIDToName_KolPlan = map[int16]string{
 	1: "id",
 	2: "title",
@@ -809,6 +1045,7 @@ var fieldIDToName_KolPlan = map[int16]string{
 	5: "plan_type",
 	6: "created_at",
 	7: "updated_at",
+	8: "max_revisions",
 }
 
 func (p *KolPlan) Read(iprot thrift.TProtocol) (err error) {
@@ -855,7 +1092,7 @@ func (p *KolPlan) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -886,6 +1123,14 @@ func (p *KolPlan) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -950,8 +1195,8 @@ func (p *KolPlan) ReadField3(iprot thrift.TProtocol) error {
 }
 func (p *KolPlan) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
@@ -992,6 +1237,17 @@ func (p *KolPlan) ReadField7(iprot thrift.TProtocol) error {
 	p.UpdatedAt = _field
 	return nil
 }
+func (p *KolPlan) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MaxRevisions = _field
+	return nil
+}
 
 func (p *KolPlan) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1027,6 +1283,10 @@ func (p *KolPlan) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1097,10 +1357,10 @@ WriteFieldEndError:
 }
 
 func (p *KolPlan) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 4); err != nil {
+	if err = oprot.WriteFieldBegin("price", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Price); err != nil {
+	if err := oprot.WriteString(p.Price); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1164,6 +1424,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
+func (p *KolPlan) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_revisions", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MaxRevisions); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
 func (p *KolPlan) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1508,50 +1785,243 @@ func (p *KolVideo) String() string {
 
 }
 
-// KOL详细信息
-type KolInfo struct {
-	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
-	UserID      int64  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
-	AvatarURL   string `thrift:"avatar_url,3" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
-	DisplayName string `thrift:"display_name,4" form:"display_name" json:"display_name" query:"display_name"`
-	Description string `thrift:"description,5" form:"description" json:"description" query:"description"`
-	Country     string `thrift:"country,6" form:"country" json:"country" query:"country"`
-	TiktokURL   string `thrift:"tiktok_url,7" form:"tiktok_url" json:"tiktok_url" query:"tiktok_url"`
-	YoutubeURL  string `thrift:"youtube_url,8" form:"youtube_url" json:"youtube_url" query:"youtube_url"`
-	XURL        string `thrift:"x_url,9" form:"x_url" json:"x_url" query:"x_url"`
-	DiscordURL  string `thrift:"discord_url,10" form:"discord_url" json:"discord_url" query:"discord_url"`
-	// pending, approved, rejected
-	Status       string         `thrift:"status,11" form:"status" json:"status" query:"status"`
-	RejectReason string         `thrift:"reject_reason,12" form:"reject_reason" json:"reject_reason" query:"reject_reason"`
-	ApprovedAt   string         `thrift:"approved_at,13" form:"approved_at" json:"approved_at" query:"approved_at"`
-	Languages    []*KolLanguage `thrift:"languages,14,default,list<KolLanguage>" form:"languages" json:"languages" query:"languages"`
-	Tags         []*KolTag      `thrift:"tags,15,default,list<KolTag>" form:"tags" json:"tags" query:"tags"`
-	Stats        *KolStats      `thrift:"stats,16" form:"stats" json:"stats" query:"stats"`
-	CreatedAt    string         `thrift:"created_at,17" form:"created_at" json:"created_at" query:"created_at"`
-	UpdatedAt    string         `thrift:"updated_at,18" form:"updated_at" json:"updated_at" query:"updated_at"`
+// KOL评分汇总（只统计未被隐藏的评价）
+type KolRating struct {
+	// 平均分（1-5），没有评价时为0
+	AverageRating float64 `thrift:"average_rating,1" form:"average_rating" json:"average_rating" query:"average_rating"`
+	// 评价数量
+	ReviewCount int64 `thrift:"review_count,2" form:"review_count" json:"review_count" query:"review_count"`
 }
 
-func NewKolInfo() *KolInfo {
-	return &KolInfo{}
+func NewKolRating() *KolRating {
+	return &KolRating{}
 }
 
-func (p *KolInfo) InitDefault() {
+func (p *KolRating) InitDefault() {
 }
 
-func (p *KolInfo) GetID() (v int64) {
-	return p.ID
+func (p *KolRating) GetAverageRating() (v float64) {
+	return p.AverageRating
 }
 
-func (p *KolInfo) GetUserID() (v int64) {
-	return p.UserID
+func (p *KolRating) GetReviewCount() (v int64) {
+	return p.ReviewCount
 }
 
-func (p *KolInfo) GetAvatarURL() (v string) {
-	return p.AvatarURL
+var fieldIDToName_KolRating = map[int16]string{
+	1: "average_rating",
+	2: "review_count",
 }
 
-func (p *KolInfo) GetDisplayName() (v string) {
-	return p.DisplayName
+func (p *KolRating) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolRating[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolRating) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AverageRating = _field
+	return nil
+}
+func (p *KolRating) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewCount = _field
+	return nil
+}
+
+func (p *KolRating) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("KolRating"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolRating) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("average_rating", thrift.DOUBLE, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.AverageRating); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolRating) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *KolRating) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolRating(%+v)", *p)
+
+}
+
+// KOL详细信息
+type KolInfo struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
+	UserID      int64  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	AvatarURL   string `thrift:"avatar_url,3" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
+	DisplayName string `thrift:"display_name,4" form:"display_name" json:"display_name" query:"display_name"`
+	Description string `thrift:"description,5" form:"description" json:"description" query:"description"`
+	Country     string `thrift:"country,6" form:"country" json:"country" query:"country"`
+	TiktokURL   string `thrift:"tiktok_url,7" form:"tiktok_url" json:"tiktok_url" query:"tiktok_url"`
+	YoutubeURL  string `thrift:"youtube_url,8" form:"youtube_url" json:"youtube_url" query:"youtube_url"`
+	XURL        string `thrift:"x_url,9" form:"x_url" json:"x_url" query:"x_url"`
+	DiscordURL  string `thrift:"discord_url,10" form:"discord_url" json:"discord_url" query:"discord_url"`
+	// pending, approved, rejected
+	Status       string         `thrift:"status,11" form:"status" json:"status" query:"status"`
+	RejectReason string         `thrift:"reject_reason,12" form:"reject_reason" json:"reject_reason" query:"reject_reason"`
+	ApprovedAt   string         `thrift:"approved_at,13" form:"approved_at" json:"approved_at" query:"approved_at"`
+	Languages    []*KolLanguage `thrift:"languages,14,default,list<KolLanguage>" form:"languages" json:"languages" query:"languages"`
+	Tags         []*KolTag      `thrift:"tags,15,default,list<KolTag>" form:"tags" json:"tags" query:"tags"`
+	Stats        *KolStats      `thrift:"stats,16" form:"stats" json:"stats" query:"stats"`
+	CreatedAt    string         `thrift:"created_at,17" form:"created_at" json:"created_at" query:"created_at"`
+	UpdatedAt    string         `thrift:"updated_at,18" form:"updated_at" json:"updated_at" query:"updated_at"`
+	// 最低报价（列表接口返回），没有报价Plan时为空
+	MinPrice *string `thrift:"min_price,19,optional" form:"min_price" json:"min_price,omitempty" query:"min_price"`
+	// 评分汇总（详情和列表接口返回）
+	Rating *KolRating `thrift:"rating,20,optional" form:"rating" json:"rating,omitempty" query:"rating"`
+}
+
+func NewKolInfo() *KolInfo {
+	return &KolInfo{}
+}
+
+func (p *KolInfo) InitDefault() {
+}
+
+func (p *KolInfo) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *KolInfo) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *KolInfo) GetAvatarURL() (v string) {
+	return p.AvatarURL
+}
+
+func (p *KolInfo) GetDisplayName() (v string) {
+	return p.DisplayName
 }
 
 func (p *KolInfo) GetDescription() (v string) {
@@ -1615,6 +2085,24 @@ func (p *KolInfo) GetUpdatedAt() (v string) {
 	return p.UpdatedAt
 }
 
+var KolInfo_MinPrice_DEFAULT string
+
+func (p *KolInfo) GetMinPrice() (v string) {
+	if !p.IsSetMinPrice() {
+		return KolInfo_MinPrice_DEFAULT
+	}
+	return *p.MinPrice
+}
+
+var KolInfo_Rating_DEFAULT *KolRating
+
+func (p *KolInfo) GetRating() (v *KolRating) {
+	if !p.IsSetRating() {
+		return KolInfo_Rating_DEFAULT
+	}
+	return p.Rating
+}
+
 var fieldIDToName_KolInfo = map[int16]string{
 	1:  "id",
 	2:  "user_id",
@@ -1634,12 +2122,22 @@ var fieldIDToName_KolInfo = map[int16]string{
 	16: "stats",
 	17: "created_at",
 	18: "updated_at",
+	19: "min_price",
+	20: "rating",
 }
 
 func (p *KolInfo) IsSetStats() bool {
 	return p.Stats != nil
 }
 
+func (p *KolInfo) IsSetMinPrice() bool {
+	return p.MinPrice != nil
+}
+
+func (p *KolInfo) IsSetRating() bool {
+	return p.Rating != nil
+}
+
 func (p *KolInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1803,6 +2301,22 @@ func (p *KolInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 19:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2051,6 +2565,25 @@ func (p *KolInfo) ReadField18(iprot thrift.TProtocol) error {
 	p.UpdatedAt = _field
 	return nil
 }
+func (p *KolInfo) ReadField19(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MinPrice = _field
+	return nil
+}
+func (p *KolInfo) ReadField20(iprot thrift.TProtocol) error {
+	_field := NewKolRating()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rating = _field
+	return nil
+}
 
 func (p *KolInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2130,6 +2663,14 @@ func (p *KolInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 18
 			goto WriteFieldError
 		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2470,24 +3011,62 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
 }
 
-func (p *KolInfo) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *KolInfo) writeField19(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinPrice() {
+		if err = oprot.WriteFieldBegin("min_price", thrift.STRING, 19); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MinPrice); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("KolInfo(%+v)", *p)
-
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
 }
 
-// 申请成为KOL请求
-type ApplyKolReq struct {
-	DisplayName string  `thrift:"display_name,1" form:"display_name" json:"display_name"`
-	Description string  `thrift:"description,2" form:"description" json:"description"`
-	Country     string  `thrift:"country,3" form:"country" json:"country"`
-	AvatarURL   *string `thrift:"avatar_url,4,optional" form:"avatar_url" json:"avatar_url,omitempty"`
-	TiktokURL   *string `thrift:"tiktok_url,5,optional" form:"tiktok_url" json:"tiktok_url,omitempty"`
-	YoutubeURL  *string `thrift:"youtube_url,6,optional" form:"youtube_url" json:"youtube_url,omitempty"`
-	XURL        *string `thrift:"x_url,7,optional" form:"x_url" json:"x_url,omitempty"`
-	DiscordURL  *string `thrift:"discord_url,8,optional" form:"discord_url" json:"discord_url,omitempty"`
+func (p *KolInfo) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRating() {
+		if err = oprot.WriteFieldBegin("rating", thrift.STRUCT, 20); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Rating.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+
+func (p *KolInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolInfo(%+v)", *p)
+
+}
+
+// 申请成为KOL请求
+type ApplyKolReq struct {
+	DisplayName string  `thrift:"display_name,1" form:"display_name" json:"display_name"`
+	Description string  `thrift:"description,2" form:"description" json:"description"`
+	Country     string  `thrift:"country,3" form:"country" json:"country"`
+	AvatarURL   *string `thrift:"avatar_url,4,optional" form:"avatar_url" json:"avatar_url,omitempty"`
+	TiktokURL   *string `thrift:"tiktok_url,5,optional" form:"tiktok_url" json:"tiktok_url,omitempty"`
+	YoutubeURL  *string `thrift:"youtube_url,6,optional" form:"youtube_url" json:"youtube_url,omitempty"`
+	XURL        *string `thrift:"x_url,7,optional" form:"x_url" json:"x_url,omitempty"`
+	DiscordURL  *string `thrift:"discord_url,8,optional" form:"discord_url" json:"discord_url,omitempty"`
 	// ["en", "zh"]
 	LanguageCodes []string `thrift:"language_codes,9,default,list<string>" form:"language_codes" json:"language_codes"`
 	// ["English", "中文"]
@@ -5717,62 +6296,76 @@ func (p *UpdateKolStatsResp) String() string {
 
 }
 
-// 创建/更新KOL报价Plan请求
-type SaveKolPlanReq struct {
-	// 不传则创建，传了则更新
-	ID          *int64  `thrift:"id,1,optional" form:"id" json:"id,omitempty"`
-	Title       string  `thrift:"title,2" form:"title" json:"title"`
-	Description string  `thrift:"description,3" form:"description" json:"description"`
-	Price       float64 `thrift:"price,4" form:"price" json:"price"`
-	// basic, standard, premium
-	PlanType string `thrift:"plan_type,5" form:"plan_type" json:"plan_type"`
+// KOL每日统计快照
+type KolStatsSnapshot struct {
+	// 快照日期，格式 2006-01-02
+	Date               string  `thrift:"date,1" form:"date" json:"date" query:"date"`
+	TotalFollowers     int64   `thrift:"total_followers,2" form:"total_followers" json:"total_followers" query:"total_followers"`
+	TiktokFollowers    int64   `thrift:"tiktok_followers,3" form:"tiktok_followers" json:"tiktok_followers" query:"tiktok_followers"`
+	YoutubeSubscribers int64   `thrift:"youtube_subscribers,4" form:"youtube_subscribers" json:"youtube_subscribers" query:"youtube_subscribers"`
+	XFollowers         int64   `thrift:"x_followers,5" form:"x_followers" json:"x_followers" query:"x_followers"`
+	DiscordMembers     int64   `thrift:"discord_members,6" form:"discord_members" json:"discord_members" query:"discord_members"`
+	TiktokAvgViews     int64   `thrift:"tiktok_avg_views,7" form:"tiktok_avg_views" json:"tiktok_avg_views" query:"tiktok_avg_views"`
+	EngagementRate     float64 `thrift:"engagement_rate,8" form:"engagement_rate" json:"engagement_rate" query:"engagement_rate"`
+	Verified           bool    `thrift:"verified,9" form:"verified" json:"verified" query:"verified"`
 }
 
-func NewSaveKolPlanReq() *SaveKolPlanReq {
-	return &SaveKolPlanReq{}
+func NewKolStatsSnapshot() *KolStatsSnapshot {
+	return &KolStatsSnapshot{}
 }
 
-func (p *SaveKolPlanReq) InitDefault() {
+func (p *KolStatsSnapshot) InitDefault() {
 }
 
-var SaveKolPlanReq_ID_DEFAULT int64
+func (p *KolStatsSnapshot) GetDate() (v string) {
+	return p.Date
+}
 
-func (p *SaveKolPlanReq) GetID() (v int64) {
-	if !p.IsSetID() {
-		return SaveKolPlanReq_ID_DEFAULT
-	}
-	return *p.ID
+func (p *KolStatsSnapshot) GetTotalFollowers() (v int64) {
+	return p.TotalFollowers
 }
 
-func (p *SaveKolPlanReq) GetTitle() (v string) {
-	return p.Title
+func (p *KolStatsSnapshot) GetTiktokFollowers() (v int64) {
+	return p.TiktokFollowers
 }
 
-func (p *SaveKolPlanReq) GetDescription() (v string) {
-	return p.Description
+func (p *KolStatsSnapshot) GetYoutubeSubscribers() (v int64) {
+	return p.YoutubeSubscribers
 }
 
-func (p *SaveKolPlanReq) GetPrice() (v float64) {
-	return p.Price
+func (p *KolStatsSnapshot) GetXFollowers() (v int64) {
+	return p.XFollowers
 }
 
-func (p *SaveKolPlanReq) GetPlanType() (v string) {
-	return p.PlanType
+func (p *KolStatsSnapshot) GetDiscordMembers() (v int64) {
+	return p.DiscordMembers
 }
 
-var fieldIDToName_SaveKolPlanReq = map[int16]string{
-	1: "id",
-	2: "title",
-	3: "description",
-	4: "price",
-	5: "plan_type",
+func (p *KolStatsSnapshot) GetTiktokAvgViews() (v int64) {
+	return p.TiktokAvgViews
 }
 
-func (p *SaveKolPlanReq) IsSetID() bool {
-	return p.ID != nil
+func (p *KolStatsSnapshot) GetEngagementRate() (v float64) {
+	return p.EngagementRate
 }
 
-func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolStatsSnapshot) GetVerified() (v bool) {
+	return p.Verified
+}
+
+var fieldIDToName_KolStatsSnapshot = map[int16]string{
+	1: "date",
+	2: "total_followers",
+	3: "tiktok_followers",
+	4: "youtube_subscribers",
+	5: "x_followers",
+	6: "discord_members",
+	7: "tiktok_avg_views",
+	8: "engagement_rate",
+	9: "verified",
+}
+
+func (p *KolStatsSnapshot) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5792,7 +6385,7 @@ func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5800,7 +6393,7 @@ func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5808,7 +6401,7 @@ func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5816,7 +6409,7 @@ func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5824,13 +6417,45 @@ func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5850,7 +6475,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SaveKolPlanReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolStatsSnapshot[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5860,40 +6485,84 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *KolStatsSnapshot) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Date = _field
+	return nil
+}
+func (p *KolStatsSnapshot) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.ID = _field
+	p.TotalFollowers = _field
 	return nil
 }
-func (p *SaveKolPlanReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *KolStatsSnapshot) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.TiktokFollowers = _field
 	return nil
 }
-func (p *SaveKolPlanReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *KolStatsSnapshot) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Description = _field
+	p.YoutubeSubscribers = _field
 	return nil
 }
-func (p *SaveKolPlanReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *KolStatsSnapshot) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.XFollowers = _field
+	return nil
+}
+func (p *KolStatsSnapshot) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DiscordMembers = _field
+	return nil
+}
+func (p *KolStatsSnapshot) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TiktokAvgViews = _field
+	return nil
+}
+func (p *KolStatsSnapshot) ReadField8(iprot thrift.TProtocol) error {
 
 	var _field float64
 	if v, err := iprot.ReadDouble(); err != nil {
@@ -5901,24 +6570,24 @@ func (p *SaveKolPlanReq) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Price = _field
+	p.EngagementRate = _field
 	return nil
 }
-func (p *SaveKolPlanReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *KolStatsSnapshot) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PlanType = _field
+	p.Verified = _field
 	return nil
 }
 
-func (p *SaveKolPlanReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolStatsSnapshot) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SaveKolPlanReq"); err != nil {
+	if err = oprot.WriteStructBegin("KolStatsSnapshot"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5942,6 +6611,22 @@ func (p *SaveKolPlanReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5960,17 +6645,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *KolStatsSnapshot) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("date", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Date); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -5979,11 +6662,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *KolStatsSnapshot) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_followers", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteI64(p.TotalFollowers); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5996,11 +6679,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+func (p *KolStatsSnapshot) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tiktok_followers", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := oprot.WriteI64(p.TiktokFollowers); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6013,11 +6696,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("price", thrift.DOUBLE, 4); err != nil {
+func (p *KolStatsSnapshot) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("youtube_subscribers", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.Price); err != nil {
+	if err := oprot.WriteI64(p.YoutubeSubscribers); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6030,11 +6713,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plan_type", thrift.STRING, 5); err != nil {
+func (p *KolStatsSnapshot) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("x_followers", thrift.I64, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.PlanType); err != nil {
+	if err := oprot.WriteI64(p.XFollowers); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6047,59 +6730,166 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *SaveKolPlanReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *KolStatsSnapshot) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("discord_members", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("SaveKolPlanReq(%+v)", *p)
-
-}
-
-// 创建/更新KOL报价Plan响应
-type SaveKolPlanResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	PlanID   *int64           `thrift:"plan_id,2,optional" form:"plan_id" json:"plan_id,omitempty" query:"plan_id"`
+	if err := oprot.WriteI64(p.DiscordMembers); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func NewSaveKolPlanResp() *SaveKolPlanResp {
-	return &SaveKolPlanResp{}
+func (p *KolStatsSnapshot) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tiktok_avg_views", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TiktokAvgViews); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *SaveKolPlanResp) InitDefault() {
+func (p *KolStatsSnapshot) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("engagement_rate", thrift.DOUBLE, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.EngagementRate); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-var SaveKolPlanResp_BaseResp_DEFAULT *common.BaseResp
+func (p *KolStatsSnapshot) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verified", thrift.BOOL, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Verified); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
 
-func (p *SaveKolPlanResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return SaveKolPlanResp_BaseResp_DEFAULT
+func (p *KolStatsSnapshot) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.BaseResp
+	return fmt.Sprintf("KolStatsSnapshot(%+v)", *p)
+
 }
 
-var SaveKolPlanResp_PlanID_DEFAULT int64
+// KOL指标周期增长
+type KolStatsGrowth struct {
+	// 7, 30, 90
+	PeriodDays int32 `thrift:"period_days,1" form:"period_days" json:"period_days" query:"period_days"`
+	// total_followers, tiktok_followers, youtube_subscribers, x_followers, discord_members, tiktok_avg_views, engagement_rate
+	Metric string `thrift:"metric,2" form:"metric" json:"metric" query:"metric"`
+	// 周期起点的值，快照不足一个周期时不返回
+	StartValue *float64 `thrift:"start_value,3,optional" form:"start_value" json:"start_value,omitempty" query:"start_value"`
+	// 最新快照的值
+	EndValue float64 `thrift:"end_value,4" form:"end_value" json:"end_value" query:"end_value"`
+	// 增长量
+	Change *float64 `thrift:"change,5,optional" form:"change" json:"change,omitempty" query:"change"`
+	// 增长率（百分比），起点为0时不返回
+	ChangeRate *float64 `thrift:"change_rate,6,optional" form:"change_rate" json:"change_rate,omitempty" query:"change_rate"`
+}
 
-func (p *SaveKolPlanResp) GetPlanID() (v int64) {
-	if !p.IsSetPlanID() {
-		return SaveKolPlanResp_PlanID_DEFAULT
+func NewKolStatsGrowth() *KolStatsGrowth {
+	return &KolStatsGrowth{}
+}
+
+func (p *KolStatsGrowth) InitDefault() {
+}
+
+func (p *KolStatsGrowth) GetPeriodDays() (v int32) {
+	return p.PeriodDays
+}
+
+func (p *KolStatsGrowth) GetMetric() (v string) {
+	return p.Metric
+}
+
+var KolStatsGrowth_StartValue_DEFAULT float64
+
+func (p *KolStatsGrowth) GetStartValue() (v float64) {
+	if !p.IsSetStartValue() {
+		return KolStatsGrowth_StartValue_DEFAULT
 	}
-	return *p.PlanID
+	return *p.StartValue
 }
 
-var fieldIDToName_SaveKolPlanResp = map[int16]string{
-	1: "base_resp",
-	2: "plan_id",
+func (p *KolStatsGrowth) GetEndValue() (v float64) {
+	return p.EndValue
 }
 
-func (p *SaveKolPlanResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var KolStatsGrowth_Change_DEFAULT float64
+
+func (p *KolStatsGrowth) GetChange() (v float64) {
+	if !p.IsSetChange() {
+		return KolStatsGrowth_Change_DEFAULT
+	}
+	return *p.Change
 }
 
-func (p *SaveKolPlanResp) IsSetPlanID() bool {
-	return p.PlanID != nil
+var KolStatsGrowth_ChangeRate_DEFAULT float64
+
+func (p *KolStatsGrowth) GetChangeRate() (v float64) {
+	if !p.IsSetChangeRate() {
+		return KolStatsGrowth_ChangeRate_DEFAULT
+	}
+	return *p.ChangeRate
 }
 
-func (p *SaveKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_KolStatsGrowth = map[int16]string{
+	1: "period_days",
+	2: "metric",
+	3: "start_value",
+	4: "end_value",
+	5: "change",
+	6: "change_rate",
+}
+
+func (p *KolStatsGrowth) IsSetStartValue() bool {
+	return p.StartValue != nil
+}
+
+func (p *KolStatsGrowth) IsSetChange() bool {
+	return p.Change != nil
+}
+
+func (p *KolStatsGrowth) IsSetChangeRate() bool {
+	return p.ChangeRate != nil
+}
+
+func (p *KolStatsGrowth) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6119,7 +6909,7 @@ func (p *SaveKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6127,13 +6917,45 @@ func (p *SaveKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6153,7 +6975,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SaveKolPlanResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolStatsGrowth[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6163,29 +6985,76 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SaveKolPlanResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *KolStatsGrowth) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.BaseResp = _field
+	p.PeriodDays = _field
 	return nil
 }
-func (p *SaveKolPlanResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *KolStatsGrowth) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Metric = _field
+	return nil
+}
+func (p *KolStatsGrowth) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.PlanID = _field
+	p.StartValue = _field
 	return nil
 }
+func (p *KolStatsGrowth) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *SaveKolPlanResp) Write(oprot thrift.TProtocol) (err error) {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndValue = _field
+	return nil
+}
+func (p *KolStatsGrowth) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Change = _field
+	return nil
+}
+func (p *KolStatsGrowth) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ChangeRate = _field
+	return nil
+}
+
+func (p *KolStatsGrowth) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SaveKolPlanResp"); err != nil {
+	if err = oprot.WriteStructBegin("KolStatsGrowth"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6197,6 +7066,22 @@ func (p *SaveKolPlanResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6215,11 +7100,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SaveKolPlanResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *KolStatsGrowth) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("period_days", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.PeriodDays); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6232,12 +7117,29 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SaveKolPlanResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPlanID() {
-		if err = oprot.WriteFieldBegin("plan_id", thrift.I64, 2); err != nil {
+func (p *KolStatsGrowth) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("metric", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Metric); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *KolStatsGrowth) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartValue() {
+		if err = oprot.WriteFieldBegin("start_value", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.PlanID); err != nil {
+		if err := oprot.WriteDouble(*p.StartValue); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6246,40 +7148,121 @@ func (p *SaveKolPlanResp) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SaveKolPlanResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *KolStatsGrowth) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_value", thrift.DOUBLE, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("SaveKolPlanResp(%+v)", *p)
-
-}
-
-// 删除KOL报价Plan请求
-type DeleteKolPlanReq struct {
-	PlanID int64 `thrift:"plan_id,1" form:"plan_id" json:"plan_id"`
-}
-
-func NewDeleteKolPlanReq() *DeleteKolPlanReq {
-	return &DeleteKolPlanReq{}
-}
-
-func (p *DeleteKolPlanReq) InitDefault() {
+	if err := oprot.WriteDouble(p.EndValue); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *DeleteKolPlanReq) GetPlanID() (v int64) {
-	return p.PlanID
+func (p *KolStatsGrowth) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChange() {
+		if err = oprot.WriteFieldBegin("change", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Change); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-var fieldIDToName_DeleteKolPlanReq = map[int16]string{
-	1: "plan_id",
+func (p *KolStatsGrowth) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangeRate() {
+		if err = oprot.WriteFieldBegin("change_rate", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ChangeRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *DeleteKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolStatsGrowth) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolStatsGrowth(%+v)", *p)
+
+}
+
+// 获取KOL统计趋势请求
+type GetKolStatsHistoryReq struct {
+	// 不传则获取当前登录用户的KOL统计趋势
+	KolID *int64 `thrift:"kol_id,1,optional" json:"kol_id,omitempty" query:"kol_id"`
+	// 返回最近多少天的快照，默认90，最大365
+	Days *int32 `thrift:"days,2,optional" json:"days,omitempty" query:"days"`
+}
+
+func NewGetKolStatsHistoryReq() *GetKolStatsHistoryReq {
+	return &GetKolStatsHistoryReq{}
+}
+
+func (p *GetKolStatsHistoryReq) InitDefault() {
+}
+
+var GetKolStatsHistoryReq_KolID_DEFAULT int64
+
+func (p *GetKolStatsHistoryReq) GetKolID() (v int64) {
+	if !p.IsSetKolID() {
+		return GetKolStatsHistoryReq_KolID_DEFAULT
+	}
+	return *p.KolID
+}
+
+var GetKolStatsHistoryReq_Days_DEFAULT int32
+
+func (p *GetKolStatsHistoryReq) GetDays() (v int32) {
+	if !p.IsSetDays() {
+		return GetKolStatsHistoryReq_Days_DEFAULT
+	}
+	return *p.Days
+}
+
+var fieldIDToName_GetKolStatsHistoryReq = map[int16]string{
+	1: "kol_id",
+	2: "days",
+}
+
+func (p *GetKolStatsHistoryReq) IsSetKolID() bool {
+	return p.KolID != nil
+}
+
+func (p *GetKolStatsHistoryReq) IsSetDays() bool {
+	return p.Days != nil
+}
+
+func (p *GetKolStatsHistoryReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6306,6 +7289,14 @@ func (p *DeleteKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6325,7 +7316,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolPlanReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolStatsHistoryReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6335,21 +7326,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteKolPlanReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolStatsHistoryReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.PlanID = _field
+	p.KolID = _field
 	return nil
 }
+func (p *GetKolStatsHistoryReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *DeleteKolPlanReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Days = _field
+	return nil
+}
+
+func (p *GetKolStatsHistoryReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteKolPlanReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolStatsHistoryReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6357,6 +7359,10 @@ func (p *DeleteKolPlanReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6375,15 +7381,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteKolPlanReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plan_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.PlanID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetKolStatsHistoryReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKolID() {
+		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.KolID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6392,44 +7400,83 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteKolPlanReq) String() string {
+func (p *GetKolStatsHistoryReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDays() {
+		if err = oprot.WriteFieldBegin("days", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Days); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolStatsHistoryReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteKolPlanReq(%+v)", *p)
+	return fmt.Sprintf("GetKolStatsHistoryReq(%+v)", *p)
 
 }
 
-// 删除KOL报价Plan响应
-type DeleteKolPlanResp struct {
+// 获取KOL统计趋势响应
+type GetKolStatsHistoryResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	KolID    int64            `thrift:"kol_id,2" form:"kol_id" json:"kol_id" query:"kol_id"`
+	// 按日期升序
+	Snapshots []*KolStatsSnapshot `thrift:"snapshots,3,default,list<KolStatsSnapshot>" form:"snapshots" json:"snapshots" query:"snapshots"`
+	// 7、30、90天的各指标增长
+	Growth []*KolStatsGrowth `thrift:"growth,4,default,list<KolStatsGrowth>" form:"growth" json:"growth" query:"growth"`
 }
 
-func NewDeleteKolPlanResp() *DeleteKolPlanResp {
-	return &DeleteKolPlanResp{}
+func NewGetKolStatsHistoryResp() *GetKolStatsHistoryResp {
+	return &GetKolStatsHistoryResp{}
 }
 
-func (p *DeleteKolPlanResp) InitDefault() {
+func (p *GetKolStatsHistoryResp) InitDefault() {
 }
 
-var DeleteKolPlanResp_BaseResp_DEFAULT *common.BaseResp
+var GetKolStatsHistoryResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *DeleteKolPlanResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetKolStatsHistoryResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return DeleteKolPlanResp_BaseResp_DEFAULT
+		return GetKolStatsHistoryResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_DeleteKolPlanResp = map[int16]string{
+func (p *GetKolStatsHistoryResp) GetKolID() (v int64) {
+	return p.KolID
+}
+
+func (p *GetKolStatsHistoryResp) GetSnapshots() (v []*KolStatsSnapshot) {
+	return p.Snapshots
+}
+
+func (p *GetKolStatsHistoryResp) GetGrowth() (v []*KolStatsGrowth) {
+	return p.Growth
+}
+
+var fieldIDToName_GetKolStatsHistoryResp = map[int16]string{
 	1: "base_resp",
+	2: "kol_id",
+	3: "snapshots",
+	4: "growth",
 }
 
-func (p *DeleteKolPlanResp) IsSetBaseResp() bool {
+func (p *GetKolStatsHistoryResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *DeleteKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetKolStatsHistoryResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6456,6 +7503,30 @@ func (p *DeleteKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6475,7 +7546,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolPlanResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolStatsHistoryResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6485,7 +7556,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteKolPlanResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolStatsHistoryResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6493,10 +7564,67 @@ func (p *DeleteKolPlanResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
+func (p *GetKolStatsHistoryResp) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *DeleteKolPlanResp) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.KolID = _field
+	return nil
+}
+func (p *GetKolStatsHistoryResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolStatsSnapshot, 0, size)
+	values := make([]KolStatsSnapshot, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Snapshots = _field
+	return nil
+}
+func (p *GetKolStatsHistoryResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolStatsGrowth, 0, size)
+	values := make([]KolStatsGrowth, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Growth = _field
+	return nil
+}
+
+func (p *GetKolStatsHistoryResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteKolPlanResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolStatsHistoryResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6504,6 +7632,18 @@ func (p *DeleteKolPlanResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6522,7 +7662,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteKolPlanResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetKolStatsHistoryResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6539,45 +7679,153 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteKolPlanResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetKolStatsHistoryResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("DeleteKolPlanResp(%+v)", *p)
-
-}
-
-// 获取KOL报价Plans请求
-type GetKolPlansReq struct {
-	// 不传则获取当前登录用户的Plans
-	KolID *int64 `thrift:"kol_id,1,optional" json:"kol_id,omitempty" query:"kol_id"`
-}
-
-func NewGetKolPlansReq() *GetKolPlansReq {
-	return &GetKolPlansReq{}
-}
-
-func (p *GetKolPlansReq) InitDefault() {
+	if err := oprot.WriteI64(p.KolID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-var GetKolPlansReq_KolID_DEFAULT int64
-
-func (p *GetKolPlansReq) GetKolID() (v int64) {
-	if !p.IsSetKolID() {
-		return GetKolPlansReq_KolID_DEFAULT
+func (p *GetKolStatsHistoryResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("snapshots", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.KolID
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Snapshots)); err != nil {
+		return err
+	}
+	for _, v := range p.Snapshots {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-var fieldIDToName_GetKolPlansReq = map[int16]string{
-	1: "kol_id",
+func (p *GetKolStatsHistoryResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("growth", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Growth)); err != nil {
+		return err
+	}
+	for _, v := range p.Growth {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetKolPlansReq) IsSetKolID() bool {
-	return p.KolID != nil
+func (p *GetKolStatsHistoryResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolStatsHistoryResp(%+v)", *p)
+
 }
 
-func (p *GetKolPlansReq) Read(iprot thrift.TProtocol) (err error) {
+// 创建/更新KOL报价Plan请求
+type SaveKolPlanReq struct {
+	// 不传则创建，传了则更新
+	ID          *int64 `thrift:"id,1,optional" form:"id" json:"id,omitempty"`
+	Title       string `thrift:"title,2" form:"title" json:"title"`
+	Description string `thrift:"description,3" form:"description" json:"description"`
+	Price       string `thrift:"price,4" form:"price" json:"price"`
+	// basic, standard, premium
+	PlanType string `thrift:"plan_type,5" form:"plan_type" json:"plan_type"`
+	// 可修改次数（0-10），创建时默认2，更新时不传则保持不变
+	MaxRevisions *int32 `thrift:"max_revisions,6,optional" form:"max_revisions" json:"max_revisions,omitempty"`
+}
+
+func NewSaveKolPlanReq() *SaveKolPlanReq {
+	return &SaveKolPlanReq{}
+}
+
+func (p *SaveKolPlanReq) InitDefault() {
+}
+
+var SaveKolPlanReq_ID_DEFAULT int64
+
+func (p *SaveKolPlanReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return SaveKolPlanReq_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+func (p *SaveKolPlanReq) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *SaveKolPlanReq) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *SaveKolPlanReq) GetPrice() (v string) {
+	return p.Price
+}
+
+func (p *SaveKolPlanReq) GetPlanType() (v string) {
+	return p.PlanType
+}
+
+var SaveKolPlanReq_MaxRevisions_DEFAULT int32
+
+func (p *SaveKolPlanReq) GetMaxRevisions() (v int32) {
+	if !p.IsSetMaxRevisions() {
+		return SaveKolPlanReq_MaxRevisions_DEFAULT
+	}
+	return *p.MaxRevisions
+}
+
+var fieldIDToName_SaveKolPlanReq = map[int16]string{
+	1: "id",
+	2: "title",
+	3: "description",
+	4: "price",
+	5: "plan_type",
+	6: "max_revisions",
+}
+
+func (p *SaveKolPlanReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *SaveKolPlanReq) IsSetMaxRevisions() bool {
+	return p.MaxRevisions != nil
+}
+
+func (p *SaveKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6604,6 +7852,46 @@ func (p *GetKolPlansReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6623,7 +7911,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolPlansReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SaveKolPlanReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6633,7 +7921,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolPlansReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *SaveKolPlanReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6641,13 +7929,68 @@ func (p *GetKolPlansReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.KolID = _field
+	p.ID = _field
 	return nil
 }
+func (p *SaveKolPlanReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *GetKolPlansReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *SaveKolPlanReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *SaveKolPlanReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Price = _field
+	return nil
+}
+func (p *SaveKolPlanReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PlanType = _field
+	return nil
+}
+func (p *SaveKolPlanReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxRevisions = _field
+	return nil
+}
+
+func (p *SaveKolPlanReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolPlansReq"); err != nil {
+	if err = oprot.WriteStructBegin("SaveKolPlanReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6655,6 +7998,26 @@ func (p *GetKolPlansReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6673,12 +8036,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolPlansReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKolID() {
-		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+func (p *SaveKolPlanReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.KolID); err != nil {
+		if err := oprot.WriteI64(*p.ID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6692,50 +8055,146 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolPlansReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *SaveKolPlanReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetKolPlansReq(%+v)", *p)
-
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-// 获取KOL报价Plans响应
-type GetKolPlansResp struct {
+func (p *SaveKolPlanReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SaveKolPlanReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Price); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SaveKolPlanReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("plan_type", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PlanType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SaveKolPlanReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxRevisions() {
+		if err = oprot.WriteFieldBegin("max_revisions", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxRevisions); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SaveKolPlanReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SaveKolPlanReq(%+v)", *p)
+
+}
+
+// 创建/更新KOL报价Plan响应
+type SaveKolPlanResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Plans    []*KolPlan       `thrift:"plans,2,default,list<KolPlan>" form:"plans" json:"plans" query:"plans"`
+	PlanID   *int64           `thrift:"plan_id,2,optional" form:"plan_id" json:"plan_id,omitempty" query:"plan_id"`
 }
 
-func NewGetKolPlansResp() *GetKolPlansResp {
-	return &GetKolPlansResp{}
+func NewSaveKolPlanResp() *SaveKolPlanResp {
+	return &SaveKolPlanResp{}
 }
 
-func (p *GetKolPlansResp) InitDefault() {
+func (p *SaveKolPlanResp) InitDefault() {
 }
 
-var GetKolPlansResp_BaseResp_DEFAULT *common.BaseResp
+var SaveKolPlanResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *GetKolPlansResp) GetBaseResp() (v *common.BaseResp) {
+func (p *SaveKolPlanResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return GetKolPlansResp_BaseResp_DEFAULT
+		return SaveKolPlanResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-func (p *GetKolPlansResp) GetPlans() (v []*KolPlan) {
-	return p.Plans
+var SaveKolPlanResp_PlanID_DEFAULT int64
+
+func (p *SaveKolPlanResp) GetPlanID() (v int64) {
+	if !p.IsSetPlanID() {
+		return SaveKolPlanResp_PlanID_DEFAULT
+	}
+	return *p.PlanID
 }
 
-var fieldIDToName_GetKolPlansResp = map[int16]string{
+var fieldIDToName_SaveKolPlanResp = map[int16]string{
 	1: "base_resp",
-	2: "plans",
+	2: "plan_id",
 }
 
-func (p *GetKolPlansResp) IsSetBaseResp() bool {
+func (p *SaveKolPlanResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetKolPlansResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *SaveKolPlanResp) IsSetPlanID() bool {
+	return p.PlanID != nil
+}
+
+func (p *SaveKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6763,7 +8222,7 @@ func (p *GetKolPlansResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6789,7 +8248,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolPlansResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SaveKolPlanResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6799,7 +8258,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolPlansResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *SaveKolPlanResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6807,33 +8266,21 @@ func (p *GetKolPlansResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
-func (p *GetKolPlansResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*KolPlan, 0, size)
-	values := make([]KolPlan, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *SaveKolPlanResp) ReadField2(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Plans = _field
+	p.PlanID = _field
 	return nil
 }
 
-func (p *GetKolPlansResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *SaveKolPlanResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolPlansResp"); err != nil {
+	if err = oprot.WriteStructBegin("SaveKolPlanResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6863,7 +8310,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolPlansResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SaveKolPlanResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6880,23 +8327,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolPlansResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plans", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Plans)); err != nil {
-		return err
-	}
-	for _, v := range p.Plans {
-		if err := v.Write(oprot); err != nil {
+func (p *SaveKolPlanResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlanID() {
+		if err = oprot.WriteFieldBegin("plan_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PlanID); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6905,50 +8346,35 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetKolPlansResp) String() string {
+func (p *SaveKolPlanResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetKolPlansResp(%+v)", *p)
-
-}
-
-// 创建KOL视频请求
-type CreateKolVideoReq struct {
-	EmbedCode string  `thrift:"embed_code,1" form:"embed_code" json:"embed_code"`
-	CoverURL  *string `thrift:"cover_url,2,optional" form:"cover_url" json:"cover_url,omitempty"`
-}
+	return fmt.Sprintf("SaveKolPlanResp(%+v)", *p)
 
-func NewCreateKolVideoReq() *CreateKolVideoReq {
-	return &CreateKolVideoReq{}
 }
 
-func (p *CreateKolVideoReq) InitDefault() {
+// 删除KOL报价Plan请求
+type DeleteKolPlanReq struct {
+	PlanID int64 `thrift:"plan_id,1" form:"plan_id" json:"plan_id"`
 }
 
-func (p *CreateKolVideoReq) GetEmbedCode() (v string) {
-	return p.EmbedCode
+func NewDeleteKolPlanReq() *DeleteKolPlanReq {
+	return &DeleteKolPlanReq{}
 }
 
-var CreateKolVideoReq_CoverURL_DEFAULT string
-
-func (p *CreateKolVideoReq) GetCoverURL() (v string) {
-	if !p.IsSetCoverURL() {
-		return CreateKolVideoReq_CoverURL_DEFAULT
-	}
-	return *p.CoverURL
+func (p *DeleteKolPlanReq) InitDefault() {
 }
 
-var fieldIDToName_CreateKolVideoReq = map[int16]string{
-	1: "embed_code",
-	2: "cover_url",
+func (p *DeleteKolPlanReq) GetPlanID() (v int64) {
+	return p.PlanID
 }
 
-func (p *CreateKolVideoReq) IsSetCoverURL() bool {
-	return p.CoverURL != nil
+var fieldIDToName_DeleteKolPlanReq = map[int16]string{
+	1: "plan_id",
 }
 
-func (p *CreateKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteKolPlanReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6968,21 +8394,13 @@ func (p *CreateKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7002,7 +8420,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolVideoReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolPlanReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7012,32 +8430,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateKolVideoReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteKolPlanReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.EmbedCode = _field
-	return nil
-}
-func (p *CreateKolVideoReq) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.CoverURL = _field
+	p.PlanID = _field
 	return nil
 }
 
-func (p *CreateKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteKolPlanReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateKolVideoReq"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteKolPlanReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7045,10 +8452,6 @@ func (p *CreateKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7067,11 +8470,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateKolVideoReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("embed_code", thrift.STRING, 1); err != nil {
+func (p *DeleteKolPlanReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("plan_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.EmbedCode); err != nil {
+	if err := oprot.WriteI64(p.PlanID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7084,78 +8487,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateKolVideoReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCoverURL() {
-		if err = oprot.WriteFieldBegin("cover_url", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.CoverURL); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CreateKolVideoReq) String() string {
+func (p *DeleteKolPlanReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateKolVideoReq(%+v)", *p)
+	return fmt.Sprintf("DeleteKolPlanReq(%+v)", *p)
 
 }
 
-// 创建KOL视频响应
-type CreateKolVideoResp struct {
+// 删除KOL报价Plan响应
+type DeleteKolPlanResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	VideoID  *int64           `thrift:"video_id,2,optional" form:"video_id" json:"video_id,omitempty" query:"video_id"`
 }
 
-func NewCreateKolVideoResp() *CreateKolVideoResp {
-	return &CreateKolVideoResp{}
+func NewDeleteKolPlanResp() *DeleteKolPlanResp {
+	return &DeleteKolPlanResp{}
 }
 
-func (p *CreateKolVideoResp) InitDefault() {
+func (p *DeleteKolPlanResp) InitDefault() {
 }
 
-var CreateKolVideoResp_BaseResp_DEFAULT *common.BaseResp
+var DeleteKolPlanResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *CreateKolVideoResp) GetBaseResp() (v *common.BaseResp) {
+func (p *DeleteKolPlanResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return CreateKolVideoResp_BaseResp_DEFAULT
+		return DeleteKolPlanResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var CreateKolVideoResp_VideoID_DEFAULT int64
-
-func (p *CreateKolVideoResp) GetVideoID() (v int64) {
-	if !p.IsSetVideoID() {
-		return CreateKolVideoResp_VideoID_DEFAULT
-	}
-	return *p.VideoID
-}
-
-var fieldIDToName_CreateKolVideoResp = map[int16]string{
+var fieldIDToName_DeleteKolPlanResp = map[int16]string{
 	1: "base_resp",
-	2: "video_id",
 }
 
-func (p *CreateKolVideoResp) IsSetBaseResp() bool {
+func (p *DeleteKolPlanResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *CreateKolVideoResp) IsSetVideoID() bool {
-	return p.VideoID != nil
-}
-
-func (p *CreateKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteKolPlanResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7182,14 +8551,6 @@ func (p *CreateKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7209,7 +8570,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolVideoResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolPlanResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7219,7 +8580,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteKolPlanResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -7227,21 +8588,10 @@ func (p *CreateKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
-func (p *CreateKolVideoResp) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.VideoID = _field
-	return nil
-}
 
-func (p *CreateKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteKolPlanResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateKolVideoResp"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteKolPlanResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7249,10 +8599,6 @@ func (p *CreateKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7271,7 +8617,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateKolVideoResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DeleteKolPlanResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7288,75 +8634,45 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateKolVideoResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVideoID() {
-		if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.VideoID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CreateKolVideoResp) String() string {
+func (p *DeleteKolPlanResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateKolVideoResp(%+v)", *p)
-
-}
-
-// 更新KOL视频请求
-type UpdateKolVideoReq struct {
-	VideoID   int64   `thrift:"video_id,1" form:"video_id" json:"video_id"`
-	EmbedCode string  `thrift:"embed_code,2" form:"embed_code" json:"embed_code"`
-	CoverURL  *string `thrift:"cover_url,3,optional" form:"cover_url" json:"cover_url,omitempty"`
-}
+	return fmt.Sprintf("DeleteKolPlanResp(%+v)", *p)
 
-func NewUpdateKolVideoReq() *UpdateKolVideoReq {
-	return &UpdateKolVideoReq{}
 }
 
-func (p *UpdateKolVideoReq) InitDefault() {
+// 获取KOL报价Plans请求
+type GetKolPlansReq struct {
+	// 不传则获取当前登录用户的Plans
+	KolID *int64 `thrift:"kol_id,1,optional" json:"kol_id,omitempty" query:"kol_id"`
 }
 
-func (p *UpdateKolVideoReq) GetVideoID() (v int64) {
-	return p.VideoID
+func NewGetKolPlansReq() *GetKolPlansReq {
+	return &GetKolPlansReq{}
 }
 
-func (p *UpdateKolVideoReq) GetEmbedCode() (v string) {
-	return p.EmbedCode
+func (p *GetKolPlansReq) InitDefault() {
 }
 
-var UpdateKolVideoReq_CoverURL_DEFAULT string
+var GetKolPlansReq_KolID_DEFAULT int64
 
-func (p *UpdateKolVideoReq) GetCoverURL() (v string) {
-	if !p.IsSetCoverURL() {
-		return UpdateKolVideoReq_CoverURL_DEFAULT
+func (p *GetKolPlansReq) GetKolID() (v int64) {
+	if !p.IsSetKolID() {
+		return GetKolPlansReq_KolID_DEFAULT
 	}
-	return *p.CoverURL
+	return *p.KolID
 }
 
-var fieldIDToName_UpdateKolVideoReq = map[int16]string{
-	1: "video_id",
-	2: "embed_code",
-	3: "cover_url",
+var fieldIDToName_GetKolPlansReq = map[int16]string{
+	1: "kol_id",
 }
 
-func (p *UpdateKolVideoReq) IsSetCoverURL() bool {
-	return p.CoverURL != nil
+func (p *GetKolPlansReq) IsSetKolID() bool {
+	return p.KolID != nil
 }
 
-func (p *UpdateKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetKolPlansReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7383,22 +8699,6 @@ func (p *UpdateKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7418,7 +8718,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolVideoReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolPlansReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7428,43 +8728,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateKolVideoReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolPlansReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
-	} else {
-		_field = v
-	}
-	p.VideoID = _field
-	return nil
-}
-func (p *UpdateKolVideoReq) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.EmbedCode = _field
-	return nil
-}
-func (p *UpdateKolVideoReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
 	} else {
 		_field = &v
 	}
-	p.CoverURL = _field
+	p.KolID = _field
 	return nil
 }
 
-func (p *UpdateKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetKolPlansReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateKolVideoReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolPlansReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7472,14 +8750,6 @@ func (p *UpdateKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7498,15 +8768,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateKolVideoReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.VideoID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetKolPlansReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKolID() {
+		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.KolID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -7515,80 +8787,50 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateKolVideoReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("embed_code", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.EmbedCode); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetKolPlansReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *UpdateKolVideoReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCoverURL() {
-		if err = oprot.WriteFieldBegin("cover_url", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.CoverURL); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *UpdateKolVideoReq) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("UpdateKolVideoReq(%+v)", *p)
+	return fmt.Sprintf("GetKolPlansReq(%+v)", *p)
 
 }
 
-// 更新KOL视频响应
-type UpdateKolVideoResp struct {
+// 获取KOL报价Plans响应
+type GetKolPlansResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Plans    []*KolPlan       `thrift:"plans,2,default,list<KolPlan>" form:"plans" json:"plans" query:"plans"`
 }
 
-func NewUpdateKolVideoResp() *UpdateKolVideoResp {
-	return &UpdateKolVideoResp{}
+func NewGetKolPlansResp() *GetKolPlansResp {
+	return &GetKolPlansResp{}
 }
 
-func (p *UpdateKolVideoResp) InitDefault() {
+func (p *GetKolPlansResp) InitDefault() {
 }
 
-var UpdateKolVideoResp_BaseResp_DEFAULT *common.BaseResp
+var GetKolPlansResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *UpdateKolVideoResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetKolPlansResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return UpdateKolVideoResp_BaseResp_DEFAULT
+		return GetKolPlansResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_UpdateKolVideoResp = map[int16]string{
+func (p *GetKolPlansResp) GetPlans() (v []*KolPlan) {
+	return p.Plans
+}
+
+var fieldIDToName_GetKolPlansResp = map[int16]string{
 	1: "base_resp",
+	2: "plans",
 }
 
-func (p *UpdateKolVideoResp) IsSetBaseResp() bool {
+func (p *GetKolPlansResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *UpdateKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetKolPlansResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7615,6 +8857,14 @@ func (p *UpdateKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7634,7 +8884,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolVideoResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolPlansResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7644,7 +8894,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolPlansResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -7652,10 +8902,33 @@ func (p *UpdateKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
+func (p *GetKolPlansResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolPlan, 0, size)
+	values := make([]KolPlan, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *UpdateKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Plans = _field
+	return nil
+}
+
+func (p *GetKolPlansResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateKolVideoResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolPlansResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7663,6 +8936,10 @@ func (p *UpdateKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7681,7 +8958,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateKolVideoResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetKolPlansResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7698,35 +8975,75 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateKolVideoResp) String() string {
+func (p *GetKolPlansResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("plans", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Plans)); err != nil {
+		return err
+	}
+	for _, v := range p.Plans {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolPlansResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateKolVideoResp(%+v)", *p)
+	return fmt.Sprintf("GetKolPlansResp(%+v)", *p)
 
 }
 
-// 删除KOL视频请求
-type DeleteKolVideoReq struct {
-	VideoID int64 `thrift:"video_id,1" form:"video_id" json:"video_id"`
+// 创建KOL视频请求
+type CreateKolVideoReq struct {
+	EmbedCode string  `thrift:"embed_code,1" form:"embed_code" json:"embed_code"`
+	CoverURL  *string `thrift:"cover_url,2,optional" form:"cover_url" json:"cover_url,omitempty"`
 }
 
-func NewDeleteKolVideoReq() *DeleteKolVideoReq {
-	return &DeleteKolVideoReq{}
+func NewCreateKolVideoReq() *CreateKolVideoReq {
+	return &CreateKolVideoReq{}
 }
 
-func (p *DeleteKolVideoReq) InitDefault() {
+func (p *CreateKolVideoReq) InitDefault() {
 }
 
-func (p *DeleteKolVideoReq) GetVideoID() (v int64) {
-	return p.VideoID
+func (p *CreateKolVideoReq) GetEmbedCode() (v string) {
+	return p.EmbedCode
 }
 
-var fieldIDToName_DeleteKolVideoReq = map[int16]string{
-	1: "video_id",
+var CreateKolVideoReq_CoverURL_DEFAULT string
+
+func (p *CreateKolVideoReq) GetCoverURL() (v string) {
+	if !p.IsSetCoverURL() {
+		return CreateKolVideoReq_CoverURL_DEFAULT
+	}
+	return *p.CoverURL
 }
 
-func (p *DeleteKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_CreateKolVideoReq = map[int16]string{
+	1: "embed_code",
+	2: "cover_url",
+}
+
+func (p *CreateKolVideoReq) IsSetCoverURL() bool {
+	return p.CoverURL != nil
+}
+
+func (p *CreateKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7746,13 +9063,21 @@ func (p *DeleteKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7772,7 +9097,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolVideoReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolVideoReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7782,21 +9107,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteKolVideoReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateKolVideoReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.VideoID = _field
+	p.EmbedCode = _field
 	return nil
 }
+func (p *CreateKolVideoReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *DeleteKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CoverURL = _field
+	return nil
+}
+
+func (p *CreateKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteKolVideoReq"); err != nil {
+	if err = oprot.WriteStructBegin("CreateKolVideoReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7804,6 +9140,10 @@ func (p *DeleteKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7822,11 +9162,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteKolVideoReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
+func (p *CreateKolVideoReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embed_code", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VideoID); err != nil {
+	if err := oprot.WriteString(p.EmbedCode); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7839,44 +9179,78 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteKolVideoReq) String() string {
+func (p *CreateKolVideoReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCoverURL() {
+		if err = oprot.WriteFieldBegin("cover_url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CoverURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateKolVideoReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteKolVideoReq(%+v)", *p)
+	return fmt.Sprintf("CreateKolVideoReq(%+v)", *p)
 
 }
 
-// 删除KOL视频响应
-type DeleteKolVideoResp struct {
+// 创建KOL视频响应
+type CreateKolVideoResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	VideoID  *int64           `thrift:"video_id,2,optional" form:"video_id" json:"video_id,omitempty" query:"video_id"`
 }
 
-func NewDeleteKolVideoResp() *DeleteKolVideoResp {
-	return &DeleteKolVideoResp{}
+func NewCreateKolVideoResp() *CreateKolVideoResp {
+	return &CreateKolVideoResp{}
 }
 
-func (p *DeleteKolVideoResp) InitDefault() {
+func (p *CreateKolVideoResp) InitDefault() {
 }
 
-var DeleteKolVideoResp_BaseResp_DEFAULT *common.BaseResp
+var CreateKolVideoResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *DeleteKolVideoResp) GetBaseResp() (v *common.BaseResp) {
+func (p *CreateKolVideoResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return DeleteKolVideoResp_BaseResp_DEFAULT
+		return CreateKolVideoResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_DeleteKolVideoResp = map[int16]string{
-	1: "base_resp",
-}
+var CreateKolVideoResp_VideoID_DEFAULT int64
 
-func (p *DeleteKolVideoResp) IsSetBaseResp() bool {
+func (p *CreateKolVideoResp) GetVideoID() (v int64) {
+	if !p.IsSetVideoID() {
+		return CreateKolVideoResp_VideoID_DEFAULT
+	}
+	return *p.VideoID
+}
+
+var fieldIDToName_CreateKolVideoResp = map[int16]string{
+	1: "base_resp",
+	2: "video_id",
+}
+
+func (p *CreateKolVideoResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *DeleteKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateKolVideoResp) IsSetVideoID() bool {
+	return p.VideoID != nil
+}
+
+func (p *CreateKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7903,6 +9277,14 @@ func (p *DeleteKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7922,7 +9304,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolVideoResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolVideoResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7932,7 +9314,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -7940,10 +9322,21 @@ func (p *DeleteKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
+func (p *CreateKolVideoResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VideoID = _field
+	return nil
+}
 
-func (p *DeleteKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteKolVideoResp"); err != nil {
+	if err = oprot.WriteStructBegin("CreateKolVideoResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7951,6 +9344,10 @@ func (p *DeleteKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7969,7 +9366,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteKolVideoResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CreateKolVideoResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7986,77 +9383,75 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteKolVideoResp) String() string {
+func (p *CreateKolVideoResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVideoID() {
+		if err = oprot.WriteFieldBegin("video_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.VideoID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateKolVideoResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteKolVideoResp(%+v)", *p)
+	return fmt.Sprintf("CreateKolVideoResp(%+v)", *p)
 
 }
 
-// 获取KOL视频列表请求
-type GetKolVideosReq struct {
-	// 不传则获取当前登录用户的视频
-	KolID *int64 `thrift:"kol_id,1,optional" json:"kol_id,omitempty" query:"kol_id"`
-	// 默认1
-	Page *int32 `thrift:"page,2,optional" json:"page,omitempty" query:"page"`
-	// 默认10
-	PageSize *int32 `thrift:"page_size,3,optional" json:"page_size,omitempty" query:"page_size"`
+// 更新KOL视频请求
+type UpdateKolVideoReq struct {
+	VideoID   int64   `thrift:"video_id,1" form:"video_id" json:"video_id"`
+	EmbedCode string  `thrift:"embed_code,2" form:"embed_code" json:"embed_code"`
+	CoverURL  *string `thrift:"cover_url,3,optional" form:"cover_url" json:"cover_url,omitempty"`
 }
 
-func NewGetKolVideosReq() *GetKolVideosReq {
-	return &GetKolVideosReq{}
+func NewUpdateKolVideoReq() *UpdateKolVideoReq {
+	return &UpdateKolVideoReq{}
 }
 
-func (p *GetKolVideosReq) InitDefault() {
+func (p *UpdateKolVideoReq) InitDefault() {
 }
 
-var GetKolVideosReq_KolID_DEFAULT int64
-
-func (p *GetKolVideosReq) GetKolID() (v int64) {
-	if !p.IsSetKolID() {
-		return GetKolVideosReq_KolID_DEFAULT
-	}
-	return *p.KolID
+func (p *UpdateKolVideoReq) GetVideoID() (v int64) {
+	return p.VideoID
 }
 
-var GetKolVideosReq_Page_DEFAULT int32
-
-func (p *GetKolVideosReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetKolVideosReq_Page_DEFAULT
-	}
-	return *p.Page
+func (p *UpdateKolVideoReq) GetEmbedCode() (v string) {
+	return p.EmbedCode
 }
 
-var GetKolVideosReq_PageSize_DEFAULT int32
+var UpdateKolVideoReq_CoverURL_DEFAULT string
 
-func (p *GetKolVideosReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetKolVideosReq_PageSize_DEFAULT
+func (p *UpdateKolVideoReq) GetCoverURL() (v string) {
+	if !p.IsSetCoverURL() {
+		return UpdateKolVideoReq_CoverURL_DEFAULT
 	}
-	return *p.PageSize
-}
-
-var fieldIDToName_GetKolVideosReq = map[int16]string{
-	1: "kol_id",
-	2: "page",
-	3: "page_size",
-}
-
-func (p *GetKolVideosReq) IsSetKolID() bool {
-	return p.KolID != nil
+	return *p.CoverURL
 }
 
-func (p *GetKolVideosReq) IsSetPage() bool {
-	return p.Page != nil
+var fieldIDToName_UpdateKolVideoReq = map[int16]string{
+	1: "video_id",
+	2: "embed_code",
+	3: "cover_url",
 }
 
-func (p *GetKolVideosReq) IsSetPageSize() bool {
-	return p.PageSize != nil
+func (p *UpdateKolVideoReq) IsSetCoverURL() bool {
+	return p.CoverURL != nil
 }
 
-func (p *GetKolVideosReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8084,7 +9479,7 @@ func (p *GetKolVideosReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8092,7 +9487,7 @@ func (p *GetKolVideosReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8118,7 +9513,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolVideosReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolVideoReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8128,43 +9523,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolVideosReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateKolVideoReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.KolID = _field
+	p.VideoID = _field
 	return nil
 }
-func (p *GetKolVideosReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateKolVideoReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Page = _field
+	p.EmbedCode = _field
 	return nil
 }
-func (p *GetKolVideosReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *UpdateKolVideoReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.PageSize = _field
+	p.CoverURL = _field
 	return nil
 }
 
-func (p *GetKolVideosReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolVideosReq"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateKolVideoReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8198,17 +9593,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolVideosReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKolID() {
-		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.KolID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *UpdateKolVideoReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8217,17 +9610,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolVideosReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Page); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *UpdateKolVideoReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embed_code", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.EmbedCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8236,12 +9627,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetKolVideosReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+func (p *UpdateKolVideoReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCoverURL() {
+		if err = oprot.WriteFieldBegin("cover_url", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.PageSize); err != nil {
+		if err := oprot.WriteString(*p.CoverURL); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8255,56 +9646,2205 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetKolVideosReq) String() string {
+func (p *UpdateKolVideoReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateKolVideoReq(%+v)", *p)
+
+}
+
+// 更新KOL视频响应
+type UpdateKolVideoResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewUpdateKolVideoResp() *UpdateKolVideoResp {
+	return &UpdateKolVideoResp{}
+}
+
+func (p *UpdateKolVideoResp) InitDefault() {
+}
+
+var UpdateKolVideoResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UpdateKolVideoResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateKolVideoResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_UpdateKolVideoResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *UpdateKolVideoResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolVideoResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *UpdateKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateKolVideoResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateKolVideoResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateKolVideoResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateKolVideoResp(%+v)", *p)
+
+}
+
+// 删除KOL视频请求
+type DeleteKolVideoReq struct {
+	VideoID int64 `thrift:"video_id,1" form:"video_id" json:"video_id"`
+}
+
+func NewDeleteKolVideoReq() *DeleteKolVideoReq {
+	return &DeleteKolVideoReq{}
+}
+
+func (p *DeleteKolVideoReq) InitDefault() {
+}
+
+func (p *DeleteKolVideoReq) GetVideoID() (v int64) {
+	return p.VideoID
+}
+
+var fieldIDToName_DeleteKolVideoReq = map[int16]string{
+	1: "video_id",
+}
+
+func (p *DeleteKolVideoReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolVideoReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteKolVideoReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoID = _field
+	return nil
+}
+
+func (p *DeleteKolVideoReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteKolVideoReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteKolVideoReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteKolVideoReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteKolVideoReq(%+v)", *p)
+
+}
+
+// 删除KOL视频响应
+type DeleteKolVideoResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewDeleteKolVideoResp() *DeleteKolVideoResp {
+	return &DeleteKolVideoResp{}
+}
+
+func (p *DeleteKolVideoResp) InitDefault() {
+}
+
+var DeleteKolVideoResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteKolVideoResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteKolVideoResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_DeleteKolVideoResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *DeleteKolVideoResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteKolVideoResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteKolVideoResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteKolVideoResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *DeleteKolVideoResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteKolVideoResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteKolVideoResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteKolVideoResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteKolVideoResp(%+v)", *p)
+
+}
+
+// 获取KOL视频列表请求
+type GetKolVideosReq struct {
+	// 不传则获取当前登录用户的视频
+	KolID *int64 `thrift:"kol_id,1,optional" json:"kol_id,omitempty" query:"kol_id"`
+	// 默认1
+	Page *int32 `thrift:"page,2,optional" json:"page,omitempty" query:"page"`
+	// 默认10
+	PageSize *int32 `thrift:"page_size,3,optional" json:"page_size,omitempty" query:"page_size"`
+}
+
+func NewGetKolVideosReq() *GetKolVideosReq {
+	return &GetKolVideosReq{}
+}
+
+func (p *GetKolVideosReq) InitDefault() {
+}
+
+var GetKolVideosReq_KolID_DEFAULT int64
+
+func (p *GetKolVideosReq) GetKolID() (v int64) {
+	if !p.IsSetKolID() {
+		return GetKolVideosReq_KolID_DEFAULT
+	}
+	return *p.KolID
+}
+
+var GetKolVideosReq_Page_DEFAULT int32
+
+func (p *GetKolVideosReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetKolVideosReq_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetKolVideosReq_PageSize_DEFAULT int32
+
+func (p *GetKolVideosReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetKolVideosReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var fieldIDToName_GetKolVideosReq = map[int16]string{
+	1: "kol_id",
+	2: "page",
+	3: "page_size",
+}
+
+func (p *GetKolVideosReq) IsSetKolID() bool {
+	return p.KolID != nil
+}
+
+func (p *GetKolVideosReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetKolVideosReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *GetKolVideosReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolVideosReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolVideosReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KolID = _field
+	return nil
+}
+func (p *GetKolVideosReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetKolVideosReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetKolVideosReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolVideosReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolVideosReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKolID() {
+		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.KolID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolVideosReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolVideosReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolVideosReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolVideosReq(%+v)", *p)
+
+}
+
+// 获取KOL视频列表响应
+type GetKolVideosResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Videos   []*KolVideo      `thrift:"videos,2,default,list<KolVideo>" form:"videos" json:"videos" query:"videos"`
+	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
+}
+
+func NewGetKolVideosResp() *GetKolVideosResp {
+	return &GetKolVideosResp{}
+}
+
+func (p *GetKolVideosResp) InitDefault() {
+}
+
+var GetKolVideosResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetKolVideosResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetKolVideosResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetKolVideosResp) GetVideos() (v []*KolVideo) {
+	return p.Videos
+}
+
+func (p *GetKolVideosResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_GetKolVideosResp = map[int16]string{
+	1: "base_resp",
+	2: "videos",
+	3: "total",
+}
+
+func (p *GetKolVideosResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetKolVideosResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolVideosResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolVideosResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetKolVideosResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolVideo, 0, size)
+	values := make([]KolVideo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Videos = _field
+	return nil
+}
+func (p *GetKolVideosResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *GetKolVideosResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolVideosResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolVideosResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolVideosResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("videos", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Videos)); err != nil {
+		return err
+	}
+	for _, v := range p.Videos {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolVideosResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolVideosResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolVideosResp(%+v)", *p)
+
+}
+
+// KOL列表请求
+type GetKolListReq struct {
+	// pending, approved, rejected，不传则查所有approved
+	Status *string `thrift:"status,1,optional" json:"status,omitempty" query:"status"`
+	// 兼容旧参数，与 countries 合并
+	Country *string `thrift:"country,2,optional" json:"country,omitempty" query:"country"`
+	// 兼容旧参数，与 tags 合并
+	Tag *string `thrift:"tag,3,optional" json:"tag,omitempty" query:"tag"`
+	// 默认1
+	Page *int32 `thrift:"page,4,optional" json:"page,omitempty" query:"page"`
+	// 默认10，最大100
+	PageSize *int32 `thrift:"page_size,5,optional" json:"page_size,omitempty" query:"page_size"`
+	// 匹配显示名称和描述
+	Keyword *string `thrift:"keyword,6,optional" json:"keyword,omitempty" query:"keyword"`
+	// 任一匹配
+	Countries []string `thrift:"countries,7,optional,list<string>" json:"countries,omitempty" query:"countries"`
+	// 任一匹配
+	Tags []string `thrift:"tags,8,optional,list<string>" json:"tags,omitempty" query:"tags"`
+	// 语言代码，任一匹配
+	Languages         []string `thrift:"languages,9,optional,list<string>" json:"languages,omitempty" query:"languages"`
+	MinFollowers      *int64   `thrift:"min_followers,10,optional" json:"min_followers,omitempty" query:"min_followers"`
+	MaxFollowers      *int64   `thrift:"max_followers,11,optional" json:"max_followers,omitempty" query:"max_followers"`
+	MinEngagementRate *float64 `thrift:"min_engagement_rate,12,optional" json:"min_engagement_rate,omitempty" query:"min_engagement_rate"`
+	MaxEngagementRate *float64 `thrift:"max_engagement_rate,13,optional" json:"max_engagement_rate,omitempty" query:"max_engagement_rate"`
+	// 有任一报价Plan在区间内
+	MinPrice *string `thrift:"min_price,14,optional" json:"min_price,omitempty" query:"min_price"`
+	MaxPrice *string `thrift:"max_price,15,optional" json:"max_price,omitempty" query:"max_price"`
+	// relevance, followers, engagement, price, rating, recency；有关键词时默认relevance，否则默认recency
+	SortBy *string `thrift:"sort_by,16,optional" json:"sort_by,omitempty" query:"sort_by"`
+	// asc, desc，默认desc
+	SortOrder *string `thrift:"sort_order,17,optional" json:"sort_order,omitempty" query:"sort_order"`
+	// 是否返回分面统计
+	WithFacets *bool `thrift:"with_facets,18,optional" json:"with_facets,omitempty" query:"with_facets"`
+}
+
+func NewGetKolListReq() *GetKolListReq {
+	return &GetKolListReq{}
+}
+
+func (p *GetKolListReq) InitDefault() {
+}
+
+var GetKolListReq_Status_DEFAULT string
+
+func (p *GetKolListReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return GetKolListReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var GetKolListReq_Country_DEFAULT string
+
+func (p *GetKolListReq) GetCountry() (v string) {
+	if !p.IsSetCountry() {
+		return GetKolListReq_Country_DEFAULT
+	}
+	return *p.Country
+}
+
+var GetKolListReq_Tag_DEFAULT string
+
+func (p *GetKolListReq) GetTag() (v string) {
+	if !p.IsSetTag() {
+		return GetKolListReq_Tag_DEFAULT
+	}
+	return *p.Tag
+}
+
+var GetKolListReq_Page_DEFAULT int32
+
+func (p *GetKolListReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetKolListReq_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetKolListReq_PageSize_DEFAULT int32
+
+func (p *GetKolListReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetKolListReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var GetKolListReq_Keyword_DEFAULT string
+
+func (p *GetKolListReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return GetKolListReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+var GetKolListReq_Countries_DEFAULT []string
+
+func (p *GetKolListReq) GetCountries() (v []string) {
+	if !p.IsSetCountries() {
+		return GetKolListReq_Countries_DEFAULT
+	}
+	return p.Countries
+}
+
+var GetKolListReq_Tags_DEFAULT []string
+
+func (p *GetKolListReq) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return GetKolListReq_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var GetKolListReq_Languages_DEFAULT []string
+
+func (p *GetKolListReq) GetLanguages() (v []string) {
+	if !p.IsSetLanguages() {
+		return GetKolListReq_Languages_DEFAULT
+	}
+	return p.Languages
+}
+
+var GetKolListReq_MinFollowers_DEFAULT int64
+
+func (p *GetKolListReq) GetMinFollowers() (v int64) {
+	if !p.IsSetMinFollowers() {
+		return GetKolListReq_MinFollowers_DEFAULT
+	}
+	return *p.MinFollowers
+}
+
+var GetKolListReq_MaxFollowers_DEFAULT int64
+
+func (p *GetKolListReq) GetMaxFollowers() (v int64) {
+	if !p.IsSetMaxFollowers() {
+		return GetKolListReq_MaxFollowers_DEFAULT
+	}
+	return *p.MaxFollowers
+}
+
+var GetKolListReq_MinEngagementRate_DEFAULT float64
+
+func (p *GetKolListReq) GetMinEngagementRate() (v float64) {
+	if !p.IsSetMinEngagementRate() {
+		return GetKolListReq_MinEngagementRate_DEFAULT
+	}
+	return *p.MinEngagementRate
+}
+
+var GetKolListReq_MaxEngagementRate_DEFAULT float64
+
+func (p *GetKolListReq) GetMaxEngagementRate() (v float64) {
+	if !p.IsSetMaxEngagementRate() {
+		return GetKolListReq_MaxEngagementRate_DEFAULT
+	}
+	return *p.MaxEngagementRate
+}
+
+var GetKolListReq_MinPrice_DEFAULT string
+
+func (p *GetKolListReq) GetMinPrice() (v string) {
+	if !p.IsSetMinPrice() {
+		return GetKolListReq_MinPrice_DEFAULT
+	}
+	return *p.MinPrice
+}
+
+var GetKolListReq_MaxPrice_DEFAULT string
+
+func (p *GetKolListReq) GetMaxPrice() (v string) {
+	if !p.IsSetMaxPrice() {
+		return GetKolListReq_MaxPrice_DEFAULT
+	}
+	return *p.MaxPrice
+}
+
+var GetKolListReq_SortBy_DEFAULT string
+
+func (p *GetKolListReq) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return GetKolListReq_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
+
+var GetKolListReq_SortOrder_DEFAULT string
+
+func (p *GetKolListReq) GetSortOrder() (v string) {
+	if !p.IsSetSortOrder() {
+		return GetKolListReq_SortOrder_DEFAULT
+	}
+	return *p.SortOrder
+}
+
+var GetKolListReq_WithFacets_DEFAULT bool
+
+func (p *GetKolListReq) GetWithFacets() (v bool) {
+	if !p.IsSetWithFacets() {
+		return GetKolListReq_WithFacets_DEFAULT
+	}
+	return *p.WithFacets
+}
+
+var fieldIDToName_GetKolListReq = map[int16]string{
+	1:  "status",
+	2:  "country",
+	3:  "tag",
+	4:  "page",
+	5:  "page_size",
+	6:  "keyword",
+	7:  "countries",
+	8:  "tags",
+	9:  "languages",
+	10: "min_followers",
+	11: "max_followers",
+	12: "min_engagement_rate",
+	13: "max_engagement_rate",
+	14: "min_price",
+	15: "max_price",
+	16: "sort_by",
+	17: "sort_order",
+	18: "with_facets",
+}
+
+func (p *GetKolListReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *GetKolListReq) IsSetCountry() bool {
+	return p.Country != nil
+}
+
+func (p *GetKolListReq) IsSetTag() bool {
+	return p.Tag != nil
+}
+
+func (p *GetKolListReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetKolListReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *GetKolListReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *GetKolListReq) IsSetCountries() bool {
+	return p.Countries != nil
+}
+
+func (p *GetKolListReq) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *GetKolListReq) IsSetLanguages() bool {
+	return p.Languages != nil
+}
+
+func (p *GetKolListReq) IsSetMinFollowers() bool {
+	return p.MinFollowers != nil
+}
+
+func (p *GetKolListReq) IsSetMaxFollowers() bool {
+	return p.MaxFollowers != nil
+}
+
+func (p *GetKolListReq) IsSetMinEngagementRate() bool {
+	return p.MinEngagementRate != nil
+}
+
+func (p *GetKolListReq) IsSetMaxEngagementRate() bool {
+	return p.MaxEngagementRate != nil
+}
+
+func (p *GetKolListReq) IsSetMinPrice() bool {
+	return p.MinPrice != nil
+}
+
+func (p *GetKolListReq) IsSetMaxPrice() bool {
+	return p.MaxPrice != nil
+}
+
+func (p *GetKolListReq) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
+
+func (p *GetKolListReq) IsSetSortOrder() bool {
+	return p.SortOrder != nil
+}
+
+func (p *GetKolListReq) IsSetWithFacets() bool {
+	return p.WithFacets != nil
+}
+
+func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 18:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Country = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Tag = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Keyword = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Countries = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Languages = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MinFollowers = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxFollowers = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MinEngagementRate = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxEngagementRate = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MinPrice = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxPrice = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SortBy = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField17(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SortOrder = _field
+	return nil
+}
+func (p *GetKolListReq) ReadField18(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WithFacets = _field
+	return nil
+}
+
+func (p *GetKolListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCountry() {
+		if err = oprot.WriteFieldBegin("country", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Country); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTag() {
+		if err = oprot.WriteFieldBegin("tag", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Tag); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCountries() {
+		if err = oprot.WriteFieldBegin("countries", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Countries)); err != nil {
+			return err
+		}
+		for _, v := range p.Countries {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+			return err
+		}
+		for _, v := range p.Tags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLanguages() {
+		if err = oprot.WriteFieldBegin("languages", thrift.LIST, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Languages)); err != nil {
+			return err
+		}
+		for _, v := range p.Languages {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinFollowers() {
+		if err = oprot.WriteFieldBegin("min_followers", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MinFollowers); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxFollowers() {
+		if err = oprot.WriteFieldBegin("max_followers", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxFollowers); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinEngagementRate() {
+		if err = oprot.WriteFieldBegin("min_engagement_rate", thrift.DOUBLE, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MinEngagementRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxEngagementRate() {
+		if err = oprot.WriteFieldBegin("max_engagement_rate", thrift.DOUBLE, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxEngagementRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinPrice() {
+		if err = oprot.WriteFieldBegin("min_price", thrift.STRING, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MinPrice); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxPrice() {
+		if err = oprot.WriteFieldBegin("max_price", thrift.STRING, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MaxPrice); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSortBy() {
+		if err = oprot.WriteFieldBegin("sort_by", thrift.STRING, 16); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SortBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField17(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSortOrder() {
+		if err = oprot.WriteFieldBegin("sort_order", thrift.STRING, 17); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SortOrder); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
+
+func (p *GetKolListReq) writeField18(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWithFacets() {
+		if err = oprot.WriteFieldBegin("with_facets", thrift.BOOL, 18); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.WithFacets); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+}
+
+func (p *GetKolListReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetKolVideosReq(%+v)", *p)
-
-}
+	return fmt.Sprintf("GetKolListReq(%+v)", *p)
 
-// 获取KOL视频列表响应
-type GetKolVideosResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Videos   []*KolVideo      `thrift:"videos,2,default,list<KolVideo>" form:"videos" json:"videos" query:"videos"`
-	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
 }
 
-func NewGetKolVideosResp() *GetKolVideosResp {
-	return &GetKolVideosResp{}
+// 分面取值及命中数量
+type KolFacetBucket struct {
+	// 取值；区间分面为区间标识，如 10000-100000、1000000+
+	Value string `thrift:"value,1" form:"value" json:"value" query:"value"`
+	Label string `thrift:"label,2" form:"label" json:"label" query:"label"`
+	Count int64  `thrift:"count,3" form:"count" json:"count" query:"count"`
 }
 
-func (p *GetKolVideosResp) InitDefault() {
+func NewKolFacetBucket() *KolFacetBucket {
+	return &KolFacetBucket{}
 }
 
-var GetKolVideosResp_BaseResp_DEFAULT *common.BaseResp
-
-func (p *GetKolVideosResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetKolVideosResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+func (p *KolFacetBucket) InitDefault() {
 }
 
-func (p *GetKolVideosResp) GetVideos() (v []*KolVideo) {
-	return p.Videos
+func (p *KolFacetBucket) GetValue() (v string) {
+	return p.Value
 }
 
-func (p *GetKolVideosResp) GetTotal() (v int64) {
-	return p.Total
+func (p *KolFacetBucket) GetLabel() (v string) {
+	return p.Label
 }
 
-var fieldIDToName_GetKolVideosResp = map[int16]string{
-	1: "base_resp",
-	2: "videos",
-	3: "total",
+func (p *KolFacetBucket) GetCount() (v int64) {
+	return p.Count
 }
 
-func (p *GetKolVideosResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var fieldIDToName_KolFacetBucket = map[int16]string{
+	1: "value",
+	2: "label",
+	3: "count",
 }
 
-func (p *GetKolVideosResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolFacetBucket) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8324,7 +11864,7 @@ func (p *GetKolVideosResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8332,7 +11872,7 @@ func (p *GetKolVideosResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8366,7 +11906,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolVideosResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolFacetBucket[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8376,38 +11916,29 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolVideosResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *KolFacetBucket) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.BaseResp = _field
+	p.Value = _field
 	return nil
 }
-func (p *GetKolVideosResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*KolVideo, 0, size)
-	values := make([]KolVideo, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *KolFacetBucket) ReadField2(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Videos = _field
+	p.Label = _field
 	return nil
 }
-func (p *GetKolVideosResp) ReadField3(iprot thrift.TProtocol) error {
+func (p *KolFacetBucket) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -8415,13 +11946,13 @@ func (p *GetKolVideosResp) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Total = _field
+	p.Count = _field
 	return nil
 }
 
-func (p *GetKolVideosResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolFacetBucket) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolVideosResp"); err != nil {
+	if err = oprot.WriteStructBegin("KolFacetBucket"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8455,11 +11986,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolVideosResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *KolFacetBucket) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("value", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.Value); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8472,19 +12003,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolVideosResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("videos", thrift.LIST, 2); err != nil {
+func (p *KolFacetBucket) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("label", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Videos)); err != nil {
-		return err
-	}
-	for _, v := range p.Videos {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.Label); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8497,11 +12020,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetKolVideosResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+func (p *KolFacetBucket) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Total); err != nil {
+	if err := oprot.WriteI64(p.Count); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8514,107 +12037,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetKolVideosResp) String() string {
+func (p *KolFacetBucket) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetKolVideosResp(%+v)", *p)
-
-}
-
-// KOL列表请求
-type GetKolListReq struct {
-	// pending, approved, rejected，不传则查所有approved
-	Status  *string `thrift:"status,1,optional" json:"status,omitempty" query:"status"`
-	Country *string `thrift:"country,2,optional" json:"country,omitempty" query:"country"`
-	Tag     *string `thrift:"tag,3,optional" json:"tag,omitempty" query:"tag"`
-	// 默认1
-	Page *int32 `thrift:"page,4,optional" json:"page,omitempty" query:"page"`
-	// 默认10
-	PageSize *int32 `thrift:"page_size,5,optional" json:"page_size,omitempty" query:"page_size"`
-}
-
-func NewGetKolListReq() *GetKolListReq {
-	return &GetKolListReq{}
-}
-
-func (p *GetKolListReq) InitDefault() {
-}
+	return fmt.Sprintf("KolFacetBucket(%+v)", *p)
 
-var GetKolListReq_Status_DEFAULT string
-
-func (p *GetKolListReq) GetStatus() (v string) {
-	if !p.IsSetStatus() {
-		return GetKolListReq_Status_DEFAULT
-	}
-	return *p.Status
 }
 
-var GetKolListReq_Country_DEFAULT string
-
-func (p *GetKolListReq) GetCountry() (v string) {
-	if !p.IsSetCountry() {
-		return GetKolListReq_Country_DEFAULT
-	}
-	return *p.Country
+// KOL搜索分面统计（每个分面统计时忽略自身条件）
+type KolSearchFacets struct {
+	Tags      []*KolFacetBucket `thrift:"tags,1,default,list<KolFacetBucket>" form:"tags" json:"tags" query:"tags"`
+	Languages []*KolFacetBucket `thrift:"languages,2,default,list<KolFacetBucket>" form:"languages" json:"languages" query:"languages"`
+	Countries []*KolFacetBucket `thrift:"countries,3,default,list<KolFacetBucket>" form:"countries" json:"countries" query:"countries"`
+	// 区间为 [下限, 上限)
+	FollowerRanges   []*KolFacetBucket `thrift:"follower_ranges,4,default,list<KolFacetBucket>" form:"follower_ranges" json:"follower_ranges" query:"follower_ranges"`
+	EngagementRanges []*KolFacetBucket `thrift:"engagement_ranges,5,default,list<KolFacetBucket>" form:"engagement_ranges" json:"engagement_ranges" query:"engagement_ranges"`
+	// 有任一报价Plan在区间内即计入
+	PriceRanges []*KolFacetBucket `thrift:"price_ranges,6,default,list<KolFacetBucket>" form:"price_ranges" json:"price_ranges" query:"price_ranges"`
 }
 
-var GetKolListReq_Tag_DEFAULT string
-
-func (p *GetKolListReq) GetTag() (v string) {
-	if !p.IsSetTag() {
-		return GetKolListReq_Tag_DEFAULT
-	}
-	return *p.Tag
+func NewKolSearchFacets() *KolSearchFacets {
+	return &KolSearchFacets{}
 }
 
-var GetKolListReq_Page_DEFAULT int32
-
-func (p *GetKolListReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetKolListReq_Page_DEFAULT
-	}
-	return *p.Page
+func (p *KolSearchFacets) InitDefault() {
 }
 
-var GetKolListReq_PageSize_DEFAULT int32
-
-func (p *GetKolListReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetKolListReq_PageSize_DEFAULT
-	}
-	return *p.PageSize
+func (p *KolSearchFacets) GetTags() (v []*KolFacetBucket) {
+	return p.Tags
 }
 
-var fieldIDToName_GetKolListReq = map[int16]string{
-	1: "status",
-	2: "country",
-	3: "tag",
-	4: "page",
-	5: "page_size",
+func (p *KolSearchFacets) GetLanguages() (v []*KolFacetBucket) {
+	return p.Languages
 }
 
-func (p *GetKolListReq) IsSetStatus() bool {
-	return p.Status != nil
+func (p *KolSearchFacets) GetCountries() (v []*KolFacetBucket) {
+	return p.Countries
 }
 
-func (p *GetKolListReq) IsSetCountry() bool {
-	return p.Country != nil
+func (p *KolSearchFacets) GetFollowerRanges() (v []*KolFacetBucket) {
+	return p.FollowerRanges
 }
 
-func (p *GetKolListReq) IsSetTag() bool {
-	return p.Tag != nil
+func (p *KolSearchFacets) GetEngagementRanges() (v []*KolFacetBucket) {
+	return p.EngagementRanges
 }
 
-func (p *GetKolListReq) IsSetPage() bool {
-	return p.Page != nil
+func (p *KolSearchFacets) GetPriceRanges() (v []*KolFacetBucket) {
+	return p.PriceRanges
 }
 
-func (p *GetKolListReq) IsSetPageSize() bool {
-	return p.PageSize != nil
+var fieldIDToName_KolSearchFacets = map[int16]string{
+	1: "tags",
+	2: "languages",
+	3: "countries",
+	4: "follower_ranges",
+	5: "engagement_ranges",
+	6: "price_ranges",
 }
 
-func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolSearchFacets) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8634,7 +12117,7 @@ func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8642,7 +12125,7 @@ func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8650,7 +12133,7 @@ func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8658,7 +12141,7 @@ func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8666,13 +12149,21 @@ func (p *GetKolListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8692,7 +12183,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolListReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolSearchFacets[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8702,65 +12193,148 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolListReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *KolSearchFacets) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolFacetBucket, 0, size)
+	values := make([]KolFacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Status = _field
+	p.Tags = _field
 	return nil
 }
-func (p *GetKolListReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *KolSearchFacets) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolFacetBucket, 0, size)
+	values := make([]KolFacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Country = _field
+	p.Languages = _field
 	return nil
 }
-func (p *GetKolListReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *KolSearchFacets) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolFacetBucket, 0, size)
+	values := make([]KolFacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Countries = _field
+	return nil
+}
+func (p *KolSearchFacets) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolFacetBucket, 0, size)
+	values := make([]KolFacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Tag = _field
+	p.FollowerRanges = _field
 	return nil
 }
-func (p *GetKolListReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *KolSearchFacets) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolFacetBucket, 0, size)
+	values := make([]KolFacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Page = _field
+	p.EngagementRanges = _field
 	return nil
 }
-func (p *GetKolListReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *KolSearchFacets) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolFacetBucket, 0, size)
+	values := make([]KolFacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.PageSize = _field
+	p.PriceRanges = _field
 	return nil
 }
 
-func (p *GetKolListReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolSearchFacets) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolListReq"); err != nil {
+	if err = oprot.WriteStructBegin("KolSearchFacets"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8784,6 +12358,10 @@ func (p *GetKolListReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8802,17 +12380,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolListReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Status); err != nil {
+func (p *KolSearchFacets) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8821,17 +12405,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolListReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCountry() {
-		if err = oprot.WriteFieldBegin("country", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Country); err != nil {
+func (p *KolSearchFacets) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("languages", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Languages)); err != nil {
+		return err
+	}
+	for _, v := range p.Languages {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8840,17 +12430,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetKolListReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTag() {
-		if err = oprot.WriteFieldBegin("tag", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Tag); err != nil {
+func (p *KolSearchFacets) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("countries", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Countries)); err != nil {
+		return err
+	}
+	for _, v := range p.Countries {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8859,17 +12455,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetKolListReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Page); err != nil {
+func (p *KolSearchFacets) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("follower_ranges", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FollowerRanges)); err != nil {
+		return err
+	}
+	for _, v := range p.FollowerRanges {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8878,17 +12480,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetKolListReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PageSize); err != nil {
+func (p *KolSearchFacets) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("engagement_ranges", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EngagementRanges)); err != nil {
+		return err
+	}
+	for _, v := range p.EngagementRanges {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -8897,11 +12505,36 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *GetKolListReq) String() string {
+func (p *KolSearchFacets) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price_ranges", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PriceRanges)); err != nil {
+		return err
+	}
+	for _, v := range p.PriceRanges {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *KolSearchFacets) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetKolListReq(%+v)", *p)
+	return fmt.Sprintf("KolSearchFacets(%+v)", *p)
 
 }
 
@@ -8910,6 +12543,8 @@ type GetKolListResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
 	KolList  []*KolInfo       `thrift:"kol_list,2,default,list<KolInfo>" form:"kol_list" json:"kol_list" query:"kol_list"`
 	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
+	// with_facets 为 true 时返回
+	Facets *KolSearchFacets `thrift:"facets,4,optional" form:"facets" json:"facets,omitempty" query:"facets"`
 }
 
 func NewGetKolListResp() *GetKolListResp {
@@ -8936,16 +12571,30 @@ func (p *GetKolListResp) GetTotal() (v int64) {
 	return p.Total
 }
 
+var GetKolListResp_Facets_DEFAULT *KolSearchFacets
+
+func (p *GetKolListResp) GetFacets() (v *KolSearchFacets) {
+	if !p.IsSetFacets() {
+		return GetKolListResp_Facets_DEFAULT
+	}
+	return p.Facets
+}
+
 var fieldIDToName_GetKolListResp = map[int16]string{
 	1: "base_resp",
 	2: "kol_list",
 	3: "total",
+	4: "facets",
 }
 
 func (p *GetKolListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *GetKolListResp) IsSetFacets() bool {
+	return p.Facets != nil
+}
+
 func (p *GetKolListResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -8989,6 +12638,14 @@ func (p *GetKolListResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -9060,6 +12717,14 @@ func (p *GetKolListResp) ReadField3(iprot thrift.TProtocol) error {
 	p.Total = _field
 	return nil
 }
+func (p *GetKolListResp) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewKolSearchFacets()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Facets = _field
+	return nil
+}
 
 func (p *GetKolListResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -9079,6 +12744,10 @@ func (p *GetKolListResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9156,6 +12825,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *GetKolListResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFacets() {
+		if err = oprot.WriteFieldBegin("facets", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Facets.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *GetKolListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -9178,6 +12866,8 @@ type KolService interface {
 	GetKolList(ctx context.Context, req *GetKolListReq) (r *GetKolListResp, err error)
 	// KOL统计数据管理
 	UpdateKolStats(ctx context.Context, req *UpdateKolStatsReq) (r *UpdateKolStatsResp, err error)
+
+	GetKolStatsHistory(ctx context.Context, req *GetKolStatsHistoryReq) (r *GetKolStatsHistoryResp, err error)
 	// KOL报价Plans管理
 	SaveKolPlan(ctx context.Context, req *SaveKolPlanReq) (r *SaveKolPlanResp, err error)
 
@@ -9274,6 +12964,15 @@ func (p *KolServiceClient) UpdateKolStats(ctx context.Context, req *UpdateKolSta
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *KolServiceClient) GetKolStatsHistory(ctx context.Context, req *GetKolStatsHistoryReq) (r *GetKolStatsHistoryResp, err error) {
+	var _args KolServiceGetKolStatsHistoryArgs
+	_args.Req = req
+	var _result KolServiceGetKolStatsHistoryResult
+	if err = p.Client_().Call(ctx, "GetKolStatsHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *KolServiceClient) SaveKolPlan(ctx context.Context, req *SaveKolPlanReq) (r *SaveKolPlanResp, err error) {
 	var _args KolServiceSaveKolPlanArgs
 	_args.Req = req
@@ -9364,6 +13063,7 @@ func NewKolServiceProcessor(handler KolService) *KolServiceProcessor {
 	self.AddToProcessorMap("ReviewKol", &kolServiceProcessorReviewKol{handler: handler})
 	self.AddToProcessorMap("GetKolList", &kolServiceProcessorGetKolList{handler: handler})
 	self.AddToProcessorMap("UpdateKolStats", &kolServiceProcessorUpdateKolStats{handler: handler})
+	self.AddToProcessorMap("GetKolStatsHistory", &kolServiceProcessorGetKolStatsHistory{handler: handler})
 	self.AddToProcessorMap("SaveKolPlan", &kolServiceProcessorSaveKolPlan{handler: handler})
 	self.AddToProcessorMap("DeleteKolPlan", &kolServiceProcessorDeleteKolPlan{handler: handler})
 	self.AddToProcessorMap("GetKolPlans", &kolServiceProcessorGetKolPlans{handler: handler})
@@ -9679,6 +13379,54 @@ func (p *kolServiceProcessorUpdateKolStats) Process(ctx context.Context, seqId i
 	return true, err
 }
 
+type kolServiceProcessorGetKolStatsHistory struct {
+	handler KolService
+}
+
+func (p *kolServiceProcessorGetKolStatsHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolServiceGetKolStatsHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetKolStatsHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolServiceGetKolStatsHistoryResult{}
+	var retval *GetKolStatsHistoryResp
+	if retval, err2 = p.handler.GetKolStatsHistory(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolStatsHistory: "+err2.Error())
+		oprot.WriteMessageBegin("GetKolStatsHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetKolStatsHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type kolServiceProcessorSaveKolPlan struct {
 	handler KolService
 }
@@ -11779,6 +15527,300 @@ func (p *KolServiceUpdateKolStatsResult) String() string {
 
 }
 
+type KolServiceGetKolStatsHistoryArgs struct {
+	Req *GetKolStatsHistoryReq `thrift:"req,1"`
+}
+
+func NewKolServiceGetKolStatsHistoryArgs() *KolServiceGetKolStatsHistoryArgs {
+	return &KolServiceGetKolStatsHistoryArgs{}
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) InitDefault() {
+}
+
+var KolServiceGetKolStatsHistoryArgs_Req_DEFAULT *GetKolStatsHistoryReq
+
+func (p *KolServiceGetKolStatsHistoryArgs) GetReq() (v *GetKolStatsHistoryReq) {
+	if !p.IsSetReq() {
+		return KolServiceGetKolStatsHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolServiceGetKolStatsHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolServiceGetKolStatsHistoryArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetKolStatsHistoryReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolStatsHistory_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolServiceGetKolStatsHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolServiceGetKolStatsHistoryArgs(%+v)", *p)
+
+}
+
+type KolServiceGetKolStatsHistoryResult struct {
+	Success *GetKolStatsHistoryResp `thrift:"success,0,optional"`
+}
+
+func NewKolServiceGetKolStatsHistoryResult() *KolServiceGetKolStatsHistoryResult {
+	return &KolServiceGetKolStatsHistoryResult{}
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) InitDefault() {
+}
+
+var KolServiceGetKolStatsHistoryResult_Success_DEFAULT *GetKolStatsHistoryResp
+
+func (p *KolServiceGetKolStatsHistoryResult) GetSuccess() (v *GetKolStatsHistoryResp) {
+	if !p.IsSetSuccess() {
+		return KolServiceGetKolStatsHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolServiceGetKolStatsHistoryResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolServiceGetKolStatsHistoryResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetKolStatsHistoryResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolStatsHistory_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolServiceGetKolStatsHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolServiceGetKolStatsHistoryResult(%+v)", *p)
+
+}
+
 type KolServiceSaveKolPlanArgs struct {
 	Req *SaveKolPlanReq `thrift:"req,1"`
 }
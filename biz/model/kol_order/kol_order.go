@@ -30,7 +30,7 @@ type KolOrderInfo struct {
 	// Plan描述（快照）
 	PlanDescription string `thrift:"plan_description,11" form:"plan_description" json:"plan_description" query:"plan_description"`
 	// Plan价格（快照，美元）
-	PlanPrice float64 `thrift:"plan_price,12" form:"plan_price" json:"plan_price" query:"plan_price"`
+	PlanPrice string `thrift:"plan_price,12" form:"plan_price" json:"plan_price" query:"plan_price"`
 	// Plan类型（快照）：basic, standard, premium
 	PlanType string `thrift:"plan_type,13" form:"plan_type" json:"plan_type" query:"plan_type"`
 	// 订单标题
@@ -47,7 +47,7 @@ type KolOrderInfo struct {
 	ExpectedDeliveryDate string `thrift:"expected_delivery_date,19" form:"expected_delivery_date" json:"expected_delivery_date" query:"expected_delivery_date"`
 	// 额外要求
 	AdditionalRequirements *string `thrift:"additional_requirements,20,optional" form:"additional_requirements" json:"additional_requirements,omitempty" query:"additional_requirements"`
-	// pending_payment-待支付, pending-待确认, confirmed-已确认, in_progress-进行中, completed-已完成, cancelled-已取消, refunded-已退款
+	// pending_payment-待支付, pending-待确认, confirmed-已确认, in_progress-进行中, delivered-已交付（待买家验收）, completed-已完成, cancelled-已取消, refunded-已退款
 	Status string `thrift:"status,21" form:"status" json:"status" query:"status"`
 	// 拒绝/取消原因
 	RejectReason *string `thrift:"reject_reason,22,optional" form:"reject_reason" json:"reject_reason,omitempty" query:"reject_reason"`
@@ -58,6 +58,19 @@ type KolOrderInfo struct {
 	UpdatedAt    string  `thrift:"updated_at,27" form:"updated_at" json:"updated_at" query:"updated_at"`
 	// 会话ID（用于聊天）
 	ConversationID *string `thrift:"conversation_id,28,optional" form:"conversation_id" json:"conversation_id,omitempty" query:"conversation_id"`
+	// 退款金额（美元，部分退款时小于订单金额）
+	RefundAmount *string `thrift:"refund_amount,29,optional" form:"refund_amount" json:"refund_amount,omitempty" query:"refund_amount"`
+	RefundedAt   *string `thrift:"refunded_at,30,optional" form:"refunded_at" json:"refunded_at,omitempty" query:"refunded_at"`
+	// 订单评价（已评价时返回）
+	Review *KolReviewInfo `thrift:"review,31,optional" form:"review" json:"review,omitempty" query:"review"`
+	// 最近一次交付时间
+	DeliveredAt *string `thrift:"delivered_at,32,optional" form:"delivered_at" json:"delivered_at,omitempty" query:"delivered_at"`
+	// 买家最多可申请修改的次数（Plan快照）
+	MaxRevisions int32 `thrift:"max_revisions,33" form:"max_revisions" json:"max_revisions" query:"max_revisions"`
+	// 买家已申请修改的次数
+	RevisionCount int32 `thrift:"revision_count,34" form:"revision_count" json:"revision_count" query:"revision_count"`
+	// 已交付订单的自动验收时间（未开启自动验收时不返回）
+	AutoAcceptAt *string `thrift:"auto_accept_at,35,optional" form:"auto_accept_at" json:"auto_accept_at,omitempty" query:"auto_accept_at"`
 }
 
 func NewKolOrderInfo() *KolOrderInfo {
@@ -121,7 +134,7 @@ func (p *KolOrderInfo) GetPlanDescription() (v string) {
 	return p.PlanDescription
 }
 
-func (p *KolOrderInfo) GetPlanPrice() (v float64) {
+func (p *KolOrderInfo) GetPlanPrice() (v string) {
 	return p.PlanPrice
 }
 
@@ -219,6 +232,59 @@ func (p *KolOrderInfo) GetConversationID() (v string) {
 	return *p.ConversationID
 }
 
+var KolOrderInfo_RefundAmount_DEFAULT string
+
+func (p *KolOrderInfo) GetRefundAmount() (v string) {
+	if !p.IsSetRefundAmount() {
+		return KolOrderInfo_RefundAmount_DEFAULT
+	}
+	return *p.RefundAmount
+}
+
+var KolOrderInfo_RefundedAt_DEFAULT string
+
+func (p *KolOrderInfo) GetRefundedAt() (v string) {
+	if !p.IsSetRefundedAt() {
+		return KolOrderInfo_RefundedAt_DEFAULT
+	}
+	return *p.RefundedAt
+}
+
+var KolOrderInfo_Review_DEFAULT *KolReviewInfo
+
+func (p *KolOrderInfo) GetReview() (v *KolReviewInfo) {
+	if !p.IsSetReview() {
+		return KolOrderInfo_Review_DEFAULT
+	}
+	return p.Review
+}
+
+var KolOrderInfo_DeliveredAt_DEFAULT string
+
+func (p *KolOrderInfo) GetDeliveredAt() (v string) {
+	if !p.IsSetDeliveredAt() {
+		return KolOrderInfo_DeliveredAt_DEFAULT
+	}
+	return *p.DeliveredAt
+}
+
+func (p *KolOrderInfo) GetMaxRevisions() (v int32) {
+	return p.MaxRevisions
+}
+
+func (p *KolOrderInfo) GetRevisionCount() (v int32) {
+	return p.RevisionCount
+}
+
+var KolOrderInfo_AutoAcceptAt_DEFAULT string
+
+func (p *KolOrderInfo) GetAutoAcceptAt() (v string) {
+	if !p.IsSetAutoAcceptAt() {
+		return KolOrderInfo_AutoAcceptAt_DEFAULT
+	}
+	return *p.AutoAcceptAt
+}
+
 var fieldIDToName_KolOrderInfo = map[int16]string{
 	1:  "order_id",
 	2:  "user_id",
@@ -248,6 +314,13 @@ var fieldIDToName_KolOrderInfo = map[int16]string{
 	26: "created_at",
 	27: "updated_at",
 	28: "conversation_id",
+	29: "refund_amount",
+	30: "refunded_at",
+	31: "review",
+	32: "delivered_at",
+	33: "max_revisions",
+	34: "revision_count",
+	35: "auto_accept_at",
 }
 
 func (p *KolOrderInfo) IsSetTeamID() bool {
@@ -282,6 +355,26 @@ func (p *KolOrderInfo) IsSetConversationID() bool {
 	return p.ConversationID != nil
 }
 
+func (p *KolOrderInfo) IsSetRefundAmount() bool {
+	return p.RefundAmount != nil
+}
+
+func (p *KolOrderInfo) IsSetRefundedAt() bool {
+	return p.RefundedAt != nil
+}
+
+func (p *KolOrderInfo) IsSetReview() bool {
+	return p.Review != nil
+}
+
+func (p *KolOrderInfo) IsSetDeliveredAt() bool {
+	return p.DeliveredAt != nil
+}
+
+func (p *KolOrderInfo) IsSetAutoAcceptAt() bool {
+	return p.AutoAcceptAt != nil
+}
+
 func (p *KolOrderInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -390,7 +483,7 @@ func (p *KolOrderInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 12:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField12(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -525,6 +618,62 @@ func (p *KolOrderInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 29:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField29(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 30:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField30(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 31:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField31(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 32:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField32(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 33:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField33(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 34:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField34(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 35:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField35(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -677,8 +826,8 @@ func (p *KolOrderInfo) ReadField11(iprot thrift.TProtocol) error {
 }
 func (p *KolOrderInfo) ReadField12(iprot thrift.TProtocol) error {
 
-	var _field float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
@@ -862,6 +1011,80 @@ func (p *KolOrderInfo) ReadField28(iprot thrift.TProtocol) error {
 	p.ConversationID = _field
 	return nil
 }
+func (p *KolOrderInfo) ReadField29(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RefundAmount = _field
+	return nil
+}
+func (p *KolOrderInfo) ReadField30(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RefundedAt = _field
+	return nil
+}
+func (p *KolOrderInfo) ReadField31(iprot thrift.TProtocol) error {
+	_field := NewKolReviewInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Review = _field
+	return nil
+}
+func (p *KolOrderInfo) ReadField32(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeliveredAt = _field
+	return nil
+}
+func (p *KolOrderInfo) ReadField33(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MaxRevisions = _field
+	return nil
+}
+func (p *KolOrderInfo) ReadField34(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RevisionCount = _field
+	return nil
+}
+func (p *KolOrderInfo) ReadField35(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AutoAcceptAt = _field
+	return nil
+}
 
 func (p *KolOrderInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -981,6 +1204,34 @@ func (p *KolOrderInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 28
 			goto WriteFieldError
 		}
+		if err = p.writeField29(oprot); err != nil {
+			fieldId = 29
+			goto WriteFieldError
+		}
+		if err = p.writeField30(oprot); err != nil {
+			fieldId = 30
+			goto WriteFieldError
+		}
+		if err = p.writeField31(oprot); err != nil {
+			fieldId = 31
+			goto WriteFieldError
+		}
+		if err = p.writeField32(oprot); err != nil {
+			fieldId = 32
+			goto WriteFieldError
+		}
+		if err = p.writeField33(oprot); err != nil {
+			fieldId = 33
+			goto WriteFieldError
+		}
+		if err = p.writeField34(oprot); err != nil {
+			fieldId = 34
+			goto WriteFieldError
+		}
+		if err = p.writeField35(oprot); err != nil {
+			fieldId = 35
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1191,10 +1442,10 @@ WriteFieldEndError:
 }
 
 func (p *KolOrderInfo) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plan_price", thrift.DOUBLE, 12); err != nil {
+	if err = oprot.WriteFieldBegin("plan_price", thrift.STRING, 12); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteDouble(p.PlanPrice); err != nil {
+	if err := oprot.WriteString(p.PlanPrice); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1491,115 +1742,259 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 28 end error: ", p), err)
 }
 
-func (p *KolOrderInfo) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *KolOrderInfo) writeField29(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefundAmount() {
+		if err = oprot.WriteFieldBegin("refund_amount", thrift.STRING, 29); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RefundAmount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("KolOrderInfo(%+v)", *p)
-
-}
-
-// 创建KOL订单请求
-type CreateKolOrderReq struct {
-	KolID  int64 `thrift:"kol_id,1" form:"kol_id" json:"kol_id"`
-	PlanID int64 `thrift:"plan_id,2" form:"plan_id" json:"plan_id"`
-	// 订单标题
-	Title string `thrift:"title,3" form:"title" json:"title"`
-	// 合作需求描述
-	RequirementDescription string `thrift:"requirement_description,4" form:"requirement_description" json:"requirement_description"`
-	// 视频类型
-	VideoType string `thrift:"video_type,5" form:"video_type" json:"video_type"`
-	// 视频预计时长（秒数）
-	VideoDuration int32 `thrift:"video_duration,6" form:"video_duration" json:"video_duration"`
-	// 目标受众
-	TargetAudience string `thrift:"target_audience,7" form:"target_audience" json:"target_audience"`
-	// 期望交付日期（YYYY-MM-DD）
-	ExpectedDeliveryDate string `thrift:"expected_delivery_date,8" form:"expected_delivery_date" json:"expected_delivery_date"`
-	// 额外要求
-	AdditionalRequirements *string `thrift:"additional_requirements,9,optional" form:"additional_requirements" json:"additional_requirements,omitempty"`
-	// 如果是团队下单，传递团队ID
-	TeamID *int64 `thrift:"team_id,10,optional" form:"team_id" json:"team_id,omitempty"`
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 29 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 29 end error: ", p), err)
 }
 
-func NewCreateKolOrderReq() *CreateKolOrderReq {
-	return &CreateKolOrderReq{}
+func (p *KolOrderInfo) writeField30(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefundedAt() {
+		if err = oprot.WriteFieldBegin("refunded_at", thrift.STRING, 30); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RefundedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) InitDefault() {
+func (p *KolOrderInfo) writeField31(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReview() {
+		if err = oprot.WriteFieldBegin("review", thrift.STRUCT, 31); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Review.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) GetKolID() (v int64) {
-	return p.KolID
+func (p *KolOrderInfo) writeField32(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeliveredAt() {
+		if err = oprot.WriteFieldBegin("delivered_at", thrift.STRING, 32); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DeliveredAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 32 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 32 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) GetPlanID() (v int64) {
-	return p.PlanID
+func (p *KolOrderInfo) writeField33(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_revisions", thrift.I32, 33); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MaxRevisions); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 33 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 33 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) GetTitle() (v string) {
-	return p.Title
+func (p *KolOrderInfo) writeField34(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("revision_count", thrift.I32, 34); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.RevisionCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 34 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 34 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) GetRequirementDescription() (v string) {
-	return p.RequirementDescription
+func (p *KolOrderInfo) writeField35(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAutoAcceptAt() {
+		if err = oprot.WriteFieldBegin("auto_accept_at", thrift.STRING, 35); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.AutoAcceptAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 35 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 35 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) GetVideoType() (v string) {
-	return p.VideoType
+func (p *KolOrderInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderInfo(%+v)", *p)
+
 }
 
-func (p *CreateKolOrderReq) GetVideoDuration() (v int32) {
-	return p.VideoDuration
+// KOL订单交付内容（KOL每次提交一轮）
+type KolOrderDeliverableInfo struct {
+	ID      int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
+	OrderID string `thrift:"order_id,2" form:"order_id" json:"order_id" query:"order_id"`
+	// 提交轮次，从1开始
+	Round int32 `thrift:"round,3" form:"round" json:"round" query:"round"`
+	// 视频链接
+	VideoUrls []string `thrift:"video_urls,4,default,list<string>" form:"video_urls" json:"video_urls" query:"video_urls"`
+	// 上传的文件
+	FileUrls []string `thrift:"file_urls,5,default,list<string>" form:"file_urls" json:"file_urls" query:"file_urls"`
+	// KOL交付说明
+	Note *string `thrift:"note,6,optional" form:"note" json:"note,omitempty" query:"note"`
+	// submitted-待验收, accepted-已验收, revision_requested-已申请修改
+	Status string `thrift:"status,7" form:"status" json:"status" query:"status"`
+	// 买家修改意见
+	Feedback *string `thrift:"feedback,8,optional" form:"feedback" json:"feedback,omitempty" query:"feedback"`
+	// 是否超时自动验收
+	AutoAccepted bool `thrift:"auto_accepted,9" form:"auto_accepted" json:"auto_accepted" query:"auto_accepted"`
+	// 验收/申请修改时间
+	ReviewedAt *string `thrift:"reviewed_at,10,optional" form:"reviewed_at" json:"reviewed_at,omitempty" query:"reviewed_at"`
+	CreatedAt  string  `thrift:"created_at,11" form:"created_at" json:"created_at" query:"created_at"`
 }
 
-func (p *CreateKolOrderReq) GetTargetAudience() (v string) {
-	return p.TargetAudience
+func NewKolOrderDeliverableInfo() *KolOrderDeliverableInfo {
+	return &KolOrderDeliverableInfo{}
 }
 
-func (p *CreateKolOrderReq) GetExpectedDeliveryDate() (v string) {
-	return p.ExpectedDeliveryDate
+func (p *KolOrderDeliverableInfo) InitDefault() {
 }
 
-var CreateKolOrderReq_AdditionalRequirements_DEFAULT string
+func (p *KolOrderDeliverableInfo) GetID() (v int64) {
+	return p.ID
+}
 
-func (p *CreateKolOrderReq) GetAdditionalRequirements() (v string) {
-	if !p.IsSetAdditionalRequirements() {
-		return CreateKolOrderReq_AdditionalRequirements_DEFAULT
+func (p *KolOrderDeliverableInfo) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+func (p *KolOrderDeliverableInfo) GetRound() (v int32) {
+	return p.Round
+}
+
+func (p *KolOrderDeliverableInfo) GetVideoUrls() (v []string) {
+	return p.VideoUrls
+}
+
+func (p *KolOrderDeliverableInfo) GetFileUrls() (v []string) {
+	return p.FileUrls
+}
+
+var KolOrderDeliverableInfo_Note_DEFAULT string
+
+func (p *KolOrderDeliverableInfo) GetNote() (v string) {
+	if !p.IsSetNote() {
+		return KolOrderDeliverableInfo_Note_DEFAULT
 	}
-	return *p.AdditionalRequirements
+	return *p.Note
 }
 
-var CreateKolOrderReq_TeamID_DEFAULT int64
+func (p *KolOrderDeliverableInfo) GetStatus() (v string) {
+	return p.Status
+}
 
-func (p *CreateKolOrderReq) GetTeamID() (v int64) {
-	if !p.IsSetTeamID() {
-		return CreateKolOrderReq_TeamID_DEFAULT
+var KolOrderDeliverableInfo_Feedback_DEFAULT string
+
+func (p *KolOrderDeliverableInfo) GetFeedback() (v string) {
+	if !p.IsSetFeedback() {
+		return KolOrderDeliverableInfo_Feedback_DEFAULT
 	}
-	return *p.TeamID
+	return *p.Feedback
 }
 
-var fieldIDToName_CreateKolOrderReq = map[int16]string{
-	1:  "kol_id",
-	2:  "plan_id",
-	3:  "title",
-	4:  "requirement_description",
-	5:  "video_type",
-	6:  "video_duration",
-	7:  "target_audience",
-	8:  "expected_delivery_date",
-	9:  "additional_requirements",
-	10: "team_id",
+func (p *KolOrderDeliverableInfo) GetAutoAccepted() (v bool) {
+	return p.AutoAccepted
 }
 
-func (p *CreateKolOrderReq) IsSetAdditionalRequirements() bool {
-	return p.AdditionalRequirements != nil
+var KolOrderDeliverableInfo_ReviewedAt_DEFAULT string
+
+func (p *KolOrderDeliverableInfo) GetReviewedAt() (v string) {
+	if !p.IsSetReviewedAt() {
+		return KolOrderDeliverableInfo_ReviewedAt_DEFAULT
+	}
+	return *p.ReviewedAt
 }
 
-func (p *CreateKolOrderReq) IsSetTeamID() bool {
-	return p.TeamID != nil
+func (p *KolOrderDeliverableInfo) GetCreatedAt() (v string) {
+	return p.CreatedAt
 }
 
-func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_KolOrderDeliverableInfo = map[int16]string{
+	1:  "id",
+	2:  "order_id",
+	3:  "round",
+	4:  "video_urls",
+	5:  "file_urls",
+	6:  "note",
+	7:  "status",
+	8:  "feedback",
+	9:  "auto_accepted",
+	10: "reviewed_at",
+	11: "created_at",
+}
+
+func (p *KolOrderDeliverableInfo) IsSetNote() bool {
+	return p.Note != nil
+}
+
+func (p *KolOrderDeliverableInfo) IsSetFeedback() bool {
+	return p.Feedback != nil
+}
+
+func (p *KolOrderDeliverableInfo) IsSetReviewedAt() bool {
+	return p.ReviewedAt != nil
+}
+
+func (p *KolOrderDeliverableInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1627,7 +2022,7 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1635,7 +2030,7 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1643,7 +2038,7 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1651,7 +2046,7 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1659,7 +2054,7 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1683,7 +2078,7 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 9:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1691,13 +2086,21 @@ func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 10:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1717,7 +2120,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolOrderReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderDeliverableInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1727,7 +2130,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1735,65 +2138,89 @@ func (p *CreateKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.KolID = _field
+	p.ID = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PlanID = _field
+	p.OrderID = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.Round = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.RequirementDescription = _field
+	p.VideoUrls = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.VideoType = _field
+	p.FileUrls = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField6(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.VideoDuration = _field
+	p.Note = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField7(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1801,21 +2228,32 @@ func (p *CreateKolOrderReq) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TargetAudience = _field
+	p.Status = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField8(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
+	}
+	p.Feedback = _field
+	return nil
+}
+func (p *KolOrderDeliverableInfo) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
 	} else {
 		_field = v
 	}
-	p.ExpectedDeliveryDate = _field
+	p.AutoAccepted = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField9(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1823,24 +2261,24 @@ func (p *CreateKolOrderReq) ReadField9(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.AdditionalRequirements = _field
+	p.ReviewedAt = _field
 	return nil
 }
-func (p *CreateKolOrderReq) ReadField10(iprot thrift.TProtocol) error {
+func (p *KolOrderDeliverableInfo) ReadField11(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.TeamID = _field
+	p.CreatedAt = _field
 	return nil
 }
 
-func (p *CreateKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderDeliverableInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateKolOrderReq"); err != nil {
+	if err = oprot.WriteStructBegin("KolOrderDeliverableInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1884,6 +2322,10 @@ func (p *CreateKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 10
 			goto WriteFieldError
 		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1902,11 +2344,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+func (p *KolOrderDeliverableInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.KolID); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1919,11 +2361,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("plan_id", thrift.I64, 2); err != nil {
+func (p *KolOrderDeliverableInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.PlanID); err != nil {
+	if err := oprot.WriteString(p.OrderID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1936,11 +2378,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
+func (p *KolOrderDeliverableInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("round", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteI32(p.Round); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1953,11 +2395,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("requirement_description", thrift.STRING, 4); err != nil {
+func (p *KolOrderDeliverableInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_urls", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.RequirementDescription); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.VideoUrls)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoUrls {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1970,11 +2420,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_type", thrift.STRING, 5); err != nil {
+func (p *KolOrderDeliverableInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_urls", thrift.LIST, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.VideoType); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.FileUrls)); err != nil {
+		return err
+	}
+	for _, v := range p.FileUrls {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1987,15 +2445,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_duration", thrift.I32, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.VideoDuration); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *KolOrderDeliverableInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNote() {
+		if err = oprot.WriteFieldBegin("note", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Note); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2004,11 +2464,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("target_audience", thrift.STRING, 7); err != nil {
+func (p *KolOrderDeliverableInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.TargetAudience); err != nil {
+	if err := oprot.WriteString(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2021,11 +2481,30 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("expected_delivery_date", thrift.STRING, 8); err != nil {
+func (p *KolOrderDeliverableInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFeedback() {
+		if err = oprot.WriteFieldBegin("feedback", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Feedback); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *KolOrderDeliverableInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("auto_accepted", thrift.BOOL, 9); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ExpectedDeliveryDate); err != nil {
+	if err := oprot.WriteBool(p.AutoAccepted); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2033,17 +2512,17 @@ func (p *CreateKolOrderReq) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAdditionalRequirements() {
-		if err = oprot.WriteFieldBegin("additional_requirements", thrift.STRING, 9); err != nil {
+func (p *KolOrderDeliverableInfo) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReviewedAt() {
+		if err = oprot.WriteFieldBegin("reviewed_at", thrift.STRING, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.AdditionalRequirements); err != nil {
+		if err := oprot.WriteString(*p.ReviewedAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2052,83 +2531,176 @@ func (p *CreateKolOrderReq) writeField9(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTeamID() {
-		if err = oprot.WriteFieldBegin("team_id", thrift.I64, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.TeamID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *KolOrderDeliverableInfo) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *CreateKolOrderReq) String() string {
+func (p *KolOrderDeliverableInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateKolOrderReq(%+v)", *p)
+	return fmt.Sprintf("KolOrderDeliverableInfo(%+v)", *p)
 
 }
 
-// 创建KOL订单响应
-type CreateKolOrderResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	OrderID  *string          `thrift:"order_id,2,optional" form:"order_id" json:"order_id,omitempty" query:"order_id"`
+// KOL订单评价信息
+type KolReviewInfo struct {
+	ReviewID int64  `thrift:"review_id,1" form:"review_id" json:"review_id" query:"review_id"`
+	OrderID  string `thrift:"order_id,2" form:"order_id" json:"order_id" query:"order_id"`
+	KolID    int64  `thrift:"kol_id,3" form:"kol_id" json:"kol_id" query:"kol_id"`
+	// KOL显示名称（关联查询）
+	KolDisplayName string `thrift:"kol_display_name,4" form:"kol_display_name" json:"kol_display_name" query:"kol_display_name"`
+	UserID         int64  `thrift:"user_id,5" form:"user_id" json:"user_id" query:"user_id"`
+	// 评价用户昵称（关联查询）
+	UserNickname string `thrift:"user_nickname,6" form:"user_nickname" json:"user_nickname" query:"user_nickname"`
+	// 评价用户头像（关联查询）
+	UserAvatarURL string `thrift:"user_avatar_url,7" form:"user_avatar_url" json:"user_avatar_url" query:"user_avatar_url"`
+	// 评分：1-5星
+	Rating int32 `thrift:"rating,8" form:"rating" json:"rating" query:"rating"`
+	// 评价内容
+	Content string `thrift:"content,9" form:"content" json:"content" query:"content"`
+	// KOL公开回复
+	Reply     *string `thrift:"reply,10,optional" form:"reply" json:"reply,omitempty" query:"reply"`
+	RepliedAt *string `thrift:"replied_at,11,optional" form:"replied_at" json:"replied_at,omitempty" query:"replied_at"`
+	// 是否被管理员隐藏，隐藏的评价不公开展示、不计入评分
+	Hidden bool `thrift:"hidden,12" form:"hidden" json:"hidden" query:"hidden"`
+	// 隐藏原因
+	HiddenReason *string `thrift:"hidden_reason,13,optional" form:"hidden_reason" json:"hidden_reason,omitempty" query:"hidden_reason"`
+	CreatedAt    string  `thrift:"created_at,14" form:"created_at" json:"created_at" query:"created_at"`
+	UpdatedAt    string  `thrift:"updated_at,15" form:"updated_at" json:"updated_at" query:"updated_at"`
 }
 
-func NewCreateKolOrderResp() *CreateKolOrderResp {
-	return &CreateKolOrderResp{}
+func NewKolReviewInfo() *KolReviewInfo {
+	return &KolReviewInfo{}
 }
 
-func (p *CreateKolOrderResp) InitDefault() {
+func (p *KolReviewInfo) InitDefault() {
 }
 
-var CreateKolOrderResp_BaseResp_DEFAULT *common.BaseResp
+func (p *KolReviewInfo) GetReviewID() (v int64) {
+	return p.ReviewID
+}
 
-func (p *CreateKolOrderResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return CreateKolOrderResp_BaseResp_DEFAULT
+func (p *KolReviewInfo) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+func (p *KolReviewInfo) GetKolID() (v int64) {
+	return p.KolID
+}
+
+func (p *KolReviewInfo) GetKolDisplayName() (v string) {
+	return p.KolDisplayName
+}
+
+func (p *KolReviewInfo) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *KolReviewInfo) GetUserNickname() (v string) {
+	return p.UserNickname
+}
+
+func (p *KolReviewInfo) GetUserAvatarURL() (v string) {
+	return p.UserAvatarURL
+}
+
+func (p *KolReviewInfo) GetRating() (v int32) {
+	return p.Rating
+}
+
+func (p *KolReviewInfo) GetContent() (v string) {
+	return p.Content
+}
+
+var KolReviewInfo_Reply_DEFAULT string
+
+func (p *KolReviewInfo) GetReply() (v string) {
+	if !p.IsSetReply() {
+		return KolReviewInfo_Reply_DEFAULT
 	}
-	return p.BaseResp
+	return *p.Reply
 }
 
-var CreateKolOrderResp_OrderID_DEFAULT string
+var KolReviewInfo_RepliedAt_DEFAULT string
 
-func (p *CreateKolOrderResp) GetOrderID() (v string) {
-	if !p.IsSetOrderID() {
-		return CreateKolOrderResp_OrderID_DEFAULT
+func (p *KolReviewInfo) GetRepliedAt() (v string) {
+	if !p.IsSetRepliedAt() {
+		return KolReviewInfo_RepliedAt_DEFAULT
 	}
-	return *p.OrderID
+	return *p.RepliedAt
 }
 
-var fieldIDToName_CreateKolOrderResp = map[int16]string{
-	1: "base_resp",
-	2: "order_id",
+func (p *KolReviewInfo) GetHidden() (v bool) {
+	return p.Hidden
 }
 
-func (p *CreateKolOrderResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var KolReviewInfo_HiddenReason_DEFAULT string
+
+func (p *KolReviewInfo) GetHiddenReason() (v string) {
+	if !p.IsSetHiddenReason() {
+		return KolReviewInfo_HiddenReason_DEFAULT
+	}
+	return *p.HiddenReason
 }
 
-func (p *CreateKolOrderResp) IsSetOrderID() bool {
-	return p.OrderID != nil
+func (p *KolReviewInfo) GetCreatedAt() (v string) {
+	return p.CreatedAt
 }
 
-func (p *CreateKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolReviewInfo) GetUpdatedAt() (v string) {
+	return p.UpdatedAt
+}
+
+var fieldIDToName_KolReviewInfo = map[int16]string{
+	1:  "review_id",
+	2:  "order_id",
+	3:  "kol_id",
+	4:  "kol_display_name",
+	5:  "user_id",
+	6:  "user_nickname",
+	7:  "user_avatar_url",
+	8:  "rating",
+	9:  "content",
+	10: "reply",
+	11: "replied_at",
+	12: "hidden",
+	13: "hidden_reason",
+	14: "created_at",
+	15: "updated_at",
+}
+
+func (p *KolReviewInfo) IsSetReply() bool {
+	return p.Reply != nil
+}
+
+func (p *KolReviewInfo) IsSetRepliedAt() bool {
+	return p.RepliedAt != nil
+}
+
+func (p *KolReviewInfo) IsSetHiddenReason() bool {
+	return p.HiddenReason != nil
+}
+
+func (p *KolReviewInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2148,7 +2720,7 @@ func (p *CreateKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2163,6 +2735,110 @@ func (p *CreateKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2182,7 +2858,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolOrderResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolReviewInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2192,179 +2868,161 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *KolReviewInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.BaseResp = _field
+	p.ReviewID = _field
 	return nil
 }
-func (p *CreateKolOrderResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *KolReviewInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
 	p.OrderID = _field
 	return nil
 }
+func (p *KolReviewInfo) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *CreateKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateKolOrderResp"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.KolID = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *KolReviewInfo) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *CreateKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.KolDisplayName = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *KolReviewInfo) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *CreateKolOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOrderID() {
-		if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.OrderID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.UserID = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *KolReviewInfo) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *CreateKolOrderResp) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return fmt.Sprintf("CreateKolOrderResp(%+v)", *p)
-
+	p.UserNickname = _field
+	return nil
 }
+func (p *KolReviewInfo) ReadField7(iprot thrift.TProtocol) error {
 
-// 获取KOL订单详情请求
-type GetKolOrderReq struct {
-	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserAvatarURL = _field
+	return nil
 }
+func (p *KolReviewInfo) ReadField8(iprot thrift.TProtocol) error {
 
-func NewGetKolOrderReq() *GetKolOrderReq {
-	return &GetKolOrderReq{}
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Rating = _field
+	return nil
 }
+func (p *KolReviewInfo) ReadField9(iprot thrift.TProtocol) error {
 
-func (p *GetKolOrderReq) InitDefault() {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
 }
+func (p *KolReviewInfo) ReadField10(iprot thrift.TProtocol) error {
 
-func (p *GetKolOrderReq) GetOrderID() (v string) {
-	return p.OrderID
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reply = _field
+	return nil
 }
+func (p *KolReviewInfo) ReadField11(iprot thrift.TProtocol) error {
 
-var fieldIDToName_GetKolOrderReq = map[int16]string{
-	1: "order_id",
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RepliedAt = _field
+	return nil
 }
+func (p *KolReviewInfo) ReadField12(iprot thrift.TProtocol) error {
 
-func (p *GetKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.Hidden = _field
+	return nil
+}
+func (p *KolReviewInfo) ReadField13(iprot thrift.TProtocol) error {
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-
+	p.HiddenReason = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolOrderReq[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
+func (p *KolReviewInfo) ReadField14(iprot thrift.TProtocol) error {
 
-func (p *GetKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *KolReviewInfo) ReadField15(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2372,13 +3030,13 @@ func (p *GetKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.OrderID = _field
+	p.UpdatedAt = _field
 	return nil
 }
 
-func (p *GetKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolReviewInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolOrderReq"); err != nil {
+	if err = oprot.WriteStructBegin("KolReviewInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2386,6 +3044,62 @@ func (p *GetKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2404,11 +3118,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+func (p *KolReviewInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.OrderID); err != nil {
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2421,176 +3135,185 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolOrderReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *KolReviewInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetKolOrderReq(%+v)", *p)
-
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-// 获取KOL订单详情响应
-type GetKolOrderResp struct {
-	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Order    *KolOrderInfo    `thrift:"order,2,optional" form:"order" json:"order,omitempty" query:"order"`
+func (p *KolReviewInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.KolID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewGetKolOrderResp() *GetKolOrderResp {
-	return &GetKolOrderResp{}
-}
-
-func (p *GetKolOrderResp) InitDefault() {
-}
-
-var GetKolOrderResp_BaseResp_DEFAULT *common.BaseResp
-
-func (p *GetKolOrderResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetKolOrderResp_BaseResp_DEFAULT
+func (p *KolReviewInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_display_name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return p.BaseResp
-}
-
-var GetKolOrderResp_Order_DEFAULT *KolOrderInfo
-
-func (p *GetKolOrderResp) GetOrder() (v *KolOrderInfo) {
-	if !p.IsSetOrder() {
-		return GetKolOrderResp_Order_DEFAULT
+	if err := oprot.WriteString(p.KolDisplayName); err != nil {
+		return err
 	}
-	return p.Order
-}
-
-var fieldIDToName_GetKolOrderResp = map[int16]string{
-	1: "base_resp",
-	2: "order",
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *KolReviewInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) IsSetOrder() bool {
-	return p.Order != nil
+func (p *KolReviewInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_nickname", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserNickname); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *KolReviewInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_avatar_url", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	if err := oprot.WriteString(p.UserAvatarURL); err != nil {
+		return err
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolOrderResp[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := common.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *KolReviewInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Rating); err != nil {
 		return err
 	}
-	p.BaseResp = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *GetKolOrderResp) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewKolOrderInfo()
-	if err := _field.Read(iprot); err != nil {
+
+func (p *KolReviewInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
 		return err
 	}
-	p.Order = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolOrderResp"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+func (p *KolReviewInfo) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReply() {
+		if err = oprot.WriteFieldBegin("reply", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err := oprot.WriteString(*p.Reply); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *KolReviewInfo) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRepliedAt() {
+		if err = oprot.WriteFieldBegin("replied_at", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RepliedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *KolReviewInfo) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("hidden", thrift.BOOL, 12); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteBool(p.Hidden); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2598,17 +3321,17 @@ func (p *GetKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOrder() {
-		if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 2); err != nil {
+func (p *KolReviewInfo) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHiddenReason() {
+		if err = oprot.WriteFieldBegin("hidden_reason", thrift.STRING, 13); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Order.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.HiddenReason); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2617,130 +3340,154 @@ func (p *GetKolOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *GetKolOrderResp) String() string {
+func (p *KolReviewInfo) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *KolReviewInfo) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.STRING, 15); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *KolReviewInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetKolOrderResp(%+v)", *p)
+	return fmt.Sprintf("KolReviewInfo(%+v)", *p)
 
 }
 
-// 获取用户自己的KOL订单列表请求
-type GetUserKolOrderListReq struct {
-	// 订单状态筛选
-	Status *string `thrift:"status,1,optional" form:"status" json:"status,omitempty"`
-	// 模糊搜索关键词（搜索标题、KOL名称等）
-	Keyword *string `thrift:"keyword,2,optional" form:"keyword" json:"keyword,omitempty"`
-	// 筛选指定KOL的订单
-	KolID *int64 `thrift:"kol_id,3,optional" form:"kol_id" json:"kol_id,omitempty"`
-	// 筛选指定团队的订单
-	TeamID *int64 `thrift:"team_id,4,optional" form:"team_id" json:"team_id,omitempty"`
-	// 默认1
-	Page *int32 `thrift:"page,5,optional" form:"page" json:"page,omitempty"`
-	// 默认10
-	PageSize *int32 `thrift:"page_size,6,optional" form:"page_size" json:"page_size,omitempty"`
+// 创建KOL订单请求
+type CreateKolOrderReq struct {
+	KolID  int64 `thrift:"kol_id,1" form:"kol_id" json:"kol_id"`
+	PlanID int64 `thrift:"plan_id,2" form:"plan_id" json:"plan_id"`
+	// 订单标题
+	Title string `thrift:"title,3" form:"title" json:"title"`
+	// 合作需求描述
+	RequirementDescription string `thrift:"requirement_description,4" form:"requirement_description" json:"requirement_description"`
+	// 视频类型
+	VideoType string `thrift:"video_type,5" form:"video_type" json:"video_type"`
+	// 视频预计时长（秒数）
+	VideoDuration int32 `thrift:"video_duration,6" form:"video_duration" json:"video_duration"`
+	// 目标受众
+	TargetAudience string `thrift:"target_audience,7" form:"target_audience" json:"target_audience"`
+	// 期望交付日期（YYYY-MM-DD）
+	ExpectedDeliveryDate string `thrift:"expected_delivery_date,8" form:"expected_delivery_date" json:"expected_delivery_date"`
+	// 额外要求
+	AdditionalRequirements *string `thrift:"additional_requirements,9,optional" form:"additional_requirements" json:"additional_requirements,omitempty"`
+	// 如果是团队下单，传递团队ID
+	TeamID *int64 `thrift:"team_id,10,optional" form:"team_id" json:"team_id,omitempty"`
 }
 
-func NewGetUserKolOrderListReq() *GetUserKolOrderListReq {
-	return &GetUserKolOrderListReq{}
+func NewCreateKolOrderReq() *CreateKolOrderReq {
+	return &CreateKolOrderReq{}
 }
 
-func (p *GetUserKolOrderListReq) InitDefault() {
+func (p *CreateKolOrderReq) InitDefault() {
 }
 
-var GetUserKolOrderListReq_Status_DEFAULT string
-
-func (p *GetUserKolOrderListReq) GetStatus() (v string) {
-	if !p.IsSetStatus() {
-		return GetUserKolOrderListReq_Status_DEFAULT
-	}
-	return *p.Status
+func (p *CreateKolOrderReq) GetKolID() (v int64) {
+	return p.KolID
 }
 
-var GetUserKolOrderListReq_Keyword_DEFAULT string
-
-func (p *GetUserKolOrderListReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return GetUserKolOrderListReq_Keyword_DEFAULT
-	}
-	return *p.Keyword
+func (p *CreateKolOrderReq) GetPlanID() (v int64) {
+	return p.PlanID
 }
 
-var GetUserKolOrderListReq_KolID_DEFAULT int64
+func (p *CreateKolOrderReq) GetTitle() (v string) {
+	return p.Title
+}
 
-func (p *GetUserKolOrderListReq) GetKolID() (v int64) {
-	if !p.IsSetKolID() {
-		return GetUserKolOrderListReq_KolID_DEFAULT
-	}
-	return *p.KolID
+func (p *CreateKolOrderReq) GetRequirementDescription() (v string) {
+	return p.RequirementDescription
 }
 
-var GetUserKolOrderListReq_TeamID_DEFAULT int64
+func (p *CreateKolOrderReq) GetVideoType() (v string) {
+	return p.VideoType
+}
 
-func (p *GetUserKolOrderListReq) GetTeamID() (v int64) {
-	if !p.IsSetTeamID() {
-		return GetUserKolOrderListReq_TeamID_DEFAULT
-	}
-	return *p.TeamID
+func (p *CreateKolOrderReq) GetVideoDuration() (v int32) {
+	return p.VideoDuration
 }
 
-var GetUserKolOrderListReq_Page_DEFAULT int32
+func (p *CreateKolOrderReq) GetTargetAudience() (v string) {
+	return p.TargetAudience
+}
 
-func (p *GetUserKolOrderListReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetUserKolOrderListReq_Page_DEFAULT
-	}
-	return *p.Page
+func (p *CreateKolOrderReq) GetExpectedDeliveryDate() (v string) {
+	return p.ExpectedDeliveryDate
 }
 
-var GetUserKolOrderListReq_PageSize_DEFAULT int32
+var CreateKolOrderReq_AdditionalRequirements_DEFAULT string
 
-func (p *GetUserKolOrderListReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetUserKolOrderListReq_PageSize_DEFAULT
+func (p *CreateKolOrderReq) GetAdditionalRequirements() (v string) {
+	if !p.IsSetAdditionalRequirements() {
+		return CreateKolOrderReq_AdditionalRequirements_DEFAULT
 	}
-	return *p.PageSize
+	return *p.AdditionalRequirements
 }
 
-var fieldIDToName_GetUserKolOrderListReq = map[int16]string{
-	1: "status",
-	2: "keyword",
-	3: "kol_id",
-	4: "team_id",
-	5: "page",
-	6: "page_size",
-}
+var CreateKolOrderReq_TeamID_DEFAULT int64
 
-func (p *GetUserKolOrderListReq) IsSetStatus() bool {
-	return p.Status != nil
+func (p *CreateKolOrderReq) GetTeamID() (v int64) {
+	if !p.IsSetTeamID() {
+		return CreateKolOrderReq_TeamID_DEFAULT
+	}
+	return *p.TeamID
 }
 
-func (p *GetUserKolOrderListReq) IsSetKeyword() bool {
-	return p.Keyword != nil
+var fieldIDToName_CreateKolOrderReq = map[int16]string{
+	1:  "kol_id",
+	2:  "plan_id",
+	3:  "title",
+	4:  "requirement_description",
+	5:  "video_type",
+	6:  "video_duration",
+	7:  "target_audience",
+	8:  "expected_delivery_date",
+	9:  "additional_requirements",
+	10: "team_id",
 }
 
-func (p *GetUserKolOrderListReq) IsSetKolID() bool {
-	return p.KolID != nil
+func (p *CreateKolOrderReq) IsSetAdditionalRequirements() bool {
+	return p.AdditionalRequirements != nil
 }
 
-func (p *GetUserKolOrderListReq) IsSetTeamID() bool {
+func (p *CreateKolOrderReq) IsSetTeamID() bool {
 	return p.TeamID != nil
 }
 
-func (p *GetUserKolOrderListReq) IsSetPage() bool {
-	return p.Page != nil
-}
-
-func (p *GetUserKolOrderListReq) IsSetPageSize() bool {
-	return p.PageSize != nil
-}
-
-func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2760,7 +3507,7 @@ func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2768,7 +3515,7 @@ func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2776,7 +3523,7 @@ func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2784,7 +3531,7 @@ func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2792,7 +3539,7 @@ func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2807,6 +3554,38 @@ func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2826,7 +3605,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserKolOrderListReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolOrderReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2836,77 +3615,121 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Status = _field
+	p.KolID = _field
 	return nil
 }
-func (p *GetUserKolOrderListReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Keyword = _field
+	p.PlanID = _field
 	return nil
 }
-func (p *GetUserKolOrderListReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.KolID = _field
+	p.Title = _field
 	return nil
 }
-func (p *GetUserKolOrderListReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderReq) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.TeamID = _field
+	p.RequirementDescription = _field
 	return nil
 }
-func (p *GetUserKolOrderListReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderReq) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Page = _field
+	p.VideoType = _field
 	return nil
 }
-func (p *GetUserKolOrderListReq) ReadField6(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderReq) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field *int32
+	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.PageSize = _field
+	p.VideoDuration = _field
 	return nil
 }
+func (p *CreateKolOrderReq) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *GetUserKolOrderListReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserKolOrderListReq"); err != nil {
-		goto WriteStructBeginError
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TargetAudience = _field
+	return nil
+}
+func (p *CreateKolOrderReq) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpectedDeliveryDate = _field
+	return nil
+}
+func (p *CreateKolOrderReq) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AdditionalRequirements = _field
+	return nil
+}
+func (p *CreateKolOrderReq) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TeamID = _field
+	return nil
+}
+
+func (p *CreateKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateKolOrderReq"); err != nil {
+		goto WriteStructBeginError
 	}
 	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
@@ -2933,6 +3756,22 @@ func (p *GetUserKolOrderListReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2951,17 +3790,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CreateKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.KolID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2970,17 +3807,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyword() {
-		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Keyword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CreateKolOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("plan_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PlanID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2989,17 +3824,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKolID() {
-		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.KolID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CreateKolOrderReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3008,17 +3841,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTeamID() {
-		if err = oprot.WriteFieldBegin("team_id", thrift.I64, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.TeamID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CreateKolOrderReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("requirement_description", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RequirementDescription); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3027,12 +3858,80 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 5); err != nil {
+func (p *CreateKolOrderReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_type", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.VideoType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_duration", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.VideoDuration); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_audience", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TargetAudience); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expected_delivery_date", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ExpectedDeliveryDate); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReq) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAdditionalRequirements() {
+		if err = oprot.WriteFieldBegin("additional_requirements", thrift.STRING, 9); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.Page); err != nil {
+		if err := oprot.WriteString(*p.AdditionalRequirements); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3041,17 +3940,17 @@ func (p *GetUserKolOrderListReq) writeField5(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 6); err != nil {
+func (p *CreateKolOrderReq) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTeamID() {
+		if err = oprot.WriteFieldBegin("team_id", thrift.I64, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.PageSize); err != nil {
+		if err := oprot.WriteI64(*p.TeamID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3060,61 +3959,64 @@ func (p *GetUserKolOrderListReq) writeField6(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListReq) String() string {
+func (p *CreateKolOrderReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserKolOrderListReq(%+v)", *p)
+	return fmt.Sprintf("CreateKolOrderReq(%+v)", *p)
 
 }
 
-// 获取用户自己的KOL订单列表响应
-type GetUserKolOrderListResp struct {
+// 创建KOL订单响应
+type CreateKolOrderResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Orders   []*KolOrderInfo  `thrift:"orders,2,default,list<KolOrderInfo>" form:"orders" json:"orders" query:"orders"`
-	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
+	OrderID  *string          `thrift:"order_id,2,optional" form:"order_id" json:"order_id,omitempty" query:"order_id"`
 }
 
-func NewGetUserKolOrderListResp() *GetUserKolOrderListResp {
-	return &GetUserKolOrderListResp{}
+func NewCreateKolOrderResp() *CreateKolOrderResp {
+	return &CreateKolOrderResp{}
 }
 
-func (p *GetUserKolOrderListResp) InitDefault() {
+func (p *CreateKolOrderResp) InitDefault() {
 }
 
-var GetUserKolOrderListResp_BaseResp_DEFAULT *common.BaseResp
+var CreateKolOrderResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *GetUserKolOrderListResp) GetBaseResp() (v *common.BaseResp) {
+func (p *CreateKolOrderResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return GetUserKolOrderListResp_BaseResp_DEFAULT
+		return CreateKolOrderResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-func (p *GetUserKolOrderListResp) GetOrders() (v []*KolOrderInfo) {
-	return p.Orders
-}
+var CreateKolOrderResp_OrderID_DEFAULT string
 
-func (p *GetUserKolOrderListResp) GetTotal() (v int64) {
-	return p.Total
+func (p *CreateKolOrderResp) GetOrderID() (v string) {
+	if !p.IsSetOrderID() {
+		return CreateKolOrderResp_OrderID_DEFAULT
+	}
+	return *p.OrderID
 }
 
-var fieldIDToName_GetUserKolOrderListResp = map[int16]string{
+var fieldIDToName_CreateKolOrderResp = map[int16]string{
 	1: "base_resp",
-	2: "orders",
-	3: "total",
+	2: "order_id",
 }
 
-func (p *GetUserKolOrderListResp) IsSetBaseResp() bool {
+func (p *CreateKolOrderResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetUserKolOrderListResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateKolOrderResp) IsSetOrderID() bool {
+	return p.OrderID != nil
+}
+
+func (p *CreateKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3142,21 +4044,13 @@ func (p *GetUserKolOrderListResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3176,7 +4070,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserKolOrderListResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolOrderResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3186,7 +4080,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3194,44 +4088,21 @@ func (p *GetUserKolOrderListResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
-func (p *GetUserKolOrderListResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*KolOrderInfo, 0, size)
-	values := make([]KolOrderInfo, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Orders = _field
-	return nil
-}
-func (p *GetUserKolOrderListResp) ReadField3(iprot thrift.TProtocol) error {
+func (p *CreateKolOrderResp) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Total = _field
+	p.OrderID = _field
 	return nil
 }
 
-func (p *GetUserKolOrderListResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserKolOrderListResp"); err != nil {
+	if err = oprot.WriteStructBegin("CreateKolOrderResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3243,10 +4114,6 @@ func (p *GetUserKolOrderListResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3265,7 +4132,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CreateKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3282,23 +4149,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
-		return err
-	}
-	for _, v := range p.Orders {
-		if err := v.Write(oprot); err != nil {
+func (p *CreateKolOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrderID() {
+		if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OrderID); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3307,110 +4168,35 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetUserKolOrderListResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.Total); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *GetUserKolOrderListResp) String() string {
+func (p *CreateKolOrderResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserKolOrderListResp(%+v)", *p)
-
-}
-
-// 获取KOL收到的订单列表请求（KOL端使用）
-type GetKolReceivedOrderListReq struct {
-	// 订单状态筛选
-	Status *string `thrift:"status,1,optional" form:"status" json:"status,omitempty"`
-	// 模糊搜索关键词（搜索标题、用户名称等）
-	Keyword *string `thrift:"keyword,2,optional" form:"keyword" json:"keyword,omitempty"`
-	// 默认1
-	Page *int32 `thrift:"page,3,optional" form:"page" json:"page,omitempty"`
-	// 默认10
-	PageSize *int32 `thrift:"page_size,4,optional" form:"page_size" json:"page_size,omitempty"`
-}
-
-func NewGetKolReceivedOrderListReq() *GetKolReceivedOrderListReq {
-	return &GetKolReceivedOrderListReq{}
-}
-
-func (p *GetKolReceivedOrderListReq) InitDefault() {
-}
-
-var GetKolReceivedOrderListReq_Status_DEFAULT string
-
-func (p *GetKolReceivedOrderListReq) GetStatus() (v string) {
-	if !p.IsSetStatus() {
-		return GetKolReceivedOrderListReq_Status_DEFAULT
-	}
-	return *p.Status
-}
-
-var GetKolReceivedOrderListReq_Keyword_DEFAULT string
-
-func (p *GetKolReceivedOrderListReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return GetKolReceivedOrderListReq_Keyword_DEFAULT
-	}
-	return *p.Keyword
-}
-
-var GetKolReceivedOrderListReq_Page_DEFAULT int32
-
-func (p *GetKolReceivedOrderListReq) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return GetKolReceivedOrderListReq_Page_DEFAULT
-	}
-	return *p.Page
-}
-
-var GetKolReceivedOrderListReq_PageSize_DEFAULT int32
+	return fmt.Sprintf("CreateKolOrderResp(%+v)", *p)
 
-func (p *GetKolReceivedOrderListReq) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return GetKolReceivedOrderListReq_PageSize_DEFAULT
-	}
-	return *p.PageSize
 }
 
-var fieldIDToName_GetKolReceivedOrderListReq = map[int16]string{
-	1: "status",
-	2: "keyword",
-	3: "page",
-	4: "page_size",
+// 获取KOL订单详情请求
+type GetKolOrderReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
 }
 
-func (p *GetKolReceivedOrderListReq) IsSetStatus() bool {
-	return p.Status != nil
+func NewGetKolOrderReq() *GetKolOrderReq {
+	return &GetKolOrderReq{}
 }
 
-func (p *GetKolReceivedOrderListReq) IsSetKeyword() bool {
-	return p.Keyword != nil
+func (p *GetKolOrderReq) InitDefault() {
 }
 
-func (p *GetKolReceivedOrderListReq) IsSetPage() bool {
-	return p.Page != nil
+func (p *GetKolOrderReq) GetOrderID() (v string) {
+	return p.OrderID
 }
 
-func (p *GetKolReceivedOrderListReq) IsSetPageSize() bool {
-	return p.PageSize != nil
+var fieldIDToName_GetKolOrderReq = map[int16]string{
+	1: "order_id",
 }
 
-func (p *GetKolReceivedOrderListReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3437,30 +4223,6 @@ func (p *GetKolReceivedOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3480,7 +4242,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolReceivedOrderListReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolOrderReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3490,73 +4252,28 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Status = _field
+	p.OrderID = _field
 	return nil
 }
-func (p *GetKolReceivedOrderListReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Keyword = _field
-	return nil
-}
-func (p *GetKolReceivedOrderListReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Page = _field
-	return nil
-}
-func (p *GetKolReceivedOrderListReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PageSize = _field
-	return nil
-}
-
-func (p *GetKolReceivedOrderListReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolReceivedOrderListReq"); err != nil {
-		goto WriteStructBeginError
+func (p *GetKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrderReq"); err != nil {
+		goto WriteStructBeginError
 	}
 	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3575,132 +4292,76 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *GetKolReceivedOrderListReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyword() {
-		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Keyword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *GetKolReceivedOrderListReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Page); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *GetKolReceivedOrderListReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PageSize); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListReq) String() string {
+func (p *GetKolOrderReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetKolReceivedOrderListReq(%+v)", *p)
+	return fmt.Sprintf("GetKolOrderReq(%+v)", *p)
 
 }
 
-// 获取KOL收到的订单列表响应
-type GetKolReceivedOrderListResp struct {
+// 获取KOL订单详情响应
+type GetKolOrderResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
-	Orders   []*KolOrderInfo  `thrift:"orders,2,default,list<KolOrderInfo>" form:"orders" json:"orders" query:"orders"`
-	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
+	Order    *KolOrderInfo    `thrift:"order,2,optional" form:"order" json:"order,omitempty" query:"order"`
 }
 
-func NewGetKolReceivedOrderListResp() *GetKolReceivedOrderListResp {
-	return &GetKolReceivedOrderListResp{}
+func NewGetKolOrderResp() *GetKolOrderResp {
+	return &GetKolOrderResp{}
 }
 
-func (p *GetKolReceivedOrderListResp) InitDefault() {
+func (p *GetKolOrderResp) InitDefault() {
 }
 
-var GetKolReceivedOrderListResp_BaseResp_DEFAULT *common.BaseResp
+var GetKolOrderResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *GetKolReceivedOrderListResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetKolOrderResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return GetKolReceivedOrderListResp_BaseResp_DEFAULT
+		return GetKolOrderResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-func (p *GetKolReceivedOrderListResp) GetOrders() (v []*KolOrderInfo) {
-	return p.Orders
-}
+var GetKolOrderResp_Order_DEFAULT *KolOrderInfo
 
-func (p *GetKolReceivedOrderListResp) GetTotal() (v int64) {
-	return p.Total
+func (p *GetKolOrderResp) GetOrder() (v *KolOrderInfo) {
+	if !p.IsSetOrder() {
+		return GetKolOrderResp_Order_DEFAULT
+	}
+	return p.Order
 }
 
-var fieldIDToName_GetKolReceivedOrderListResp = map[int16]string{
+var fieldIDToName_GetKolOrderResp = map[int16]string{
 	1: "base_resp",
-	2: "orders",
-	3: "total",
+	2: "order",
 }
 
-func (p *GetKolReceivedOrderListResp) IsSetBaseResp() bool {
+func (p *GetKolOrderResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetKolReceivedOrderListResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetKolOrderResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *GetKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3728,21 +4389,13 @@ func (p *GetKolReceivedOrderListResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3762,7 +4415,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolReceivedOrderListResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolOrderResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3772,7 +4425,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3780,44 +4433,18 @@ func (p *GetKolReceivedOrderListResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
-func (p *GetKolReceivedOrderListResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*KolOrderInfo, 0, size)
-	values := make([]KolOrderInfo, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Orders = _field
-	return nil
-}
-func (p *GetKolReceivedOrderListResp) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *GetKolOrderResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewKolOrderInfo()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Total = _field
+	p.Order = _field
 	return nil
 }
 
-func (p *GetKolReceivedOrderListResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolReceivedOrderListResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolOrderResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3829,10 +4456,6 @@ func (p *GetKolReceivedOrderListResp) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3851,7 +4474,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3868,23 +4491,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
-		return err
-	}
-	for _, v := range p.Orders {
-		if err := v.Write(oprot); err != nil {
+func (p *GetKolOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrder() {
+		if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Order.Write(oprot); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3893,75 +4510,125 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetKolReceivedOrderListResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.Total); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetKolOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return fmt.Sprintf("GetKolOrderResp(%+v)", *p)
+
 }
 
-func (p *GetKolReceivedOrderListResp) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetKolReceivedOrderListResp(%+v)", *p)
+// 获取用户自己的KOL订单列表请求
+type GetUserKolOrderListReq struct {
+	// 订单状态筛选
+	Status *string `thrift:"status,1,optional" form:"status" json:"status,omitempty"`
+	// 模糊搜索关键词（搜索标题、KOL名称等）
+	Keyword *string `thrift:"keyword,2,optional" form:"keyword" json:"keyword,omitempty"`
+	// 筛选指定KOL的订单
+	KolID *int64 `thrift:"kol_id,3,optional" form:"kol_id" json:"kol_id,omitempty"`
+	// 筛选指定团队的订单
+	TeamID *int64 `thrift:"team_id,4,optional" form:"team_id" json:"team_id,omitempty"`
+	// 默认1
+	Page *int32 `thrift:"page,5,optional" form:"page" json:"page,omitempty"`
+	// 默认10
+	PageSize *int32 `thrift:"page_size,6,optional" form:"page_size" json:"page_size,omitempty"`
+}
 
+func NewGetUserKolOrderListReq() *GetUserKolOrderListReq {
+	return &GetUserKolOrderListReq{}
 }
 
-// 更新KOL订单状态请求（KOL使用）
-type UpdateKolOrderStatusReq struct {
-	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
-	// pending, confirmed, in_progress, completed, cancelled, refunded
-	Status string `thrift:"status,2" form:"status" json:"status"`
-	// 取消时需要提供原因
-	RejectReason *string `thrift:"reject_reason,3,optional" form:"reject_reason" json:"reject_reason,omitempty"`
+func (p *GetUserKolOrderListReq) InitDefault() {
 }
 
-func NewUpdateKolOrderStatusReq() *UpdateKolOrderStatusReq {
-	return &UpdateKolOrderStatusReq{}
+var GetUserKolOrderListReq_Status_DEFAULT string
+
+func (p *GetUserKolOrderListReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return GetUserKolOrderListReq_Status_DEFAULT
+	}
+	return *p.Status
 }
 
-func (p *UpdateKolOrderStatusReq) InitDefault() {
+var GetUserKolOrderListReq_Keyword_DEFAULT string
+
+func (p *GetUserKolOrderListReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return GetUserKolOrderListReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
 }
 
-func (p *UpdateKolOrderStatusReq) GetOrderID() (v string) {
-	return p.OrderID
+var GetUserKolOrderListReq_KolID_DEFAULT int64
+
+func (p *GetUserKolOrderListReq) GetKolID() (v int64) {
+	if !p.IsSetKolID() {
+		return GetUserKolOrderListReq_KolID_DEFAULT
+	}
+	return *p.KolID
 }
 
-func (p *UpdateKolOrderStatusReq) GetStatus() (v string) {
-	return p.Status
+var GetUserKolOrderListReq_TeamID_DEFAULT int64
+
+func (p *GetUserKolOrderListReq) GetTeamID() (v int64) {
+	if !p.IsSetTeamID() {
+		return GetUserKolOrderListReq_TeamID_DEFAULT
+	}
+	return *p.TeamID
 }
 
-var UpdateKolOrderStatusReq_RejectReason_DEFAULT string
+var GetUserKolOrderListReq_Page_DEFAULT int32
 
-func (p *UpdateKolOrderStatusReq) GetRejectReason() (v string) {
-	if !p.IsSetRejectReason() {
-		return UpdateKolOrderStatusReq_RejectReason_DEFAULT
+func (p *GetUserKolOrderListReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetUserKolOrderListReq_Page_DEFAULT
 	}
-	return *p.RejectReason
+	return *p.Page
 }
 
-var fieldIDToName_UpdateKolOrderStatusReq = map[int16]string{
-	1: "order_id",
-	2: "status",
-	3: "reject_reason",
+var GetUserKolOrderListReq_PageSize_DEFAULT int32
+
+func (p *GetUserKolOrderListReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetUserKolOrderListReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
 }
 
-func (p *UpdateKolOrderStatusReq) IsSetRejectReason() bool {
-	return p.RejectReason != nil
+var fieldIDToName_GetUserKolOrderListReq = map[int16]string{
+	1: "status",
+	2: "keyword",
+	3: "kol_id",
+	4: "team_id",
+	5: "page",
+	6: "page_size",
 }
 
-func (p *UpdateKolOrderStatusReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserKolOrderListReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *GetUserKolOrderListReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *GetUserKolOrderListReq) IsSetKolID() bool {
+	return p.KolID != nil
+}
+
+func (p *GetUserKolOrderListReq) IsSetTeamID() bool {
+	return p.TeamID != nil
+}
+
+func (p *GetUserKolOrderListReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetUserKolOrderListReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *GetUserKolOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3997,13 +4664,37 @@ func (p *UpdateKolOrderStatusReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4023,7 +4714,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolOrderStatusReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserKolOrderListReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4033,43 +4724,76 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetUserKolOrderListReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.OrderID = _field
+	p.Status = _field
 	return nil
 }
-func (p *UpdateKolOrderStatusReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetUserKolOrderListReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Status = _field
+	p.Keyword = _field
 	return nil
 }
-func (p *UpdateKolOrderStatusReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *GetUserKolOrderListReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.RejectReason = _field
+	p.KolID = _field
+	return nil
+}
+func (p *GetUserKolOrderListReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TeamID = _field
 	return nil
 }
+func (p *GetUserKolOrderListReq) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *UpdateKolOrderStatusReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetUserKolOrderListReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetUserKolOrderListReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateKolOrderStatusReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserKolOrderListReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4085,6 +4809,18 @@ func (p *UpdateKolOrderStatusReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4103,15 +4839,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.OrderID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetUserKolOrderListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4120,29 +4858,31 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
+func (p *GetUserKolOrderListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRejectReason() {
-		if err = oprot.WriteFieldBegin("reject_reason", thrift.STRING, 3); err != nil {
+func (p *GetUserKolOrderListReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKolID() {
+		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RejectReason); err != nil {
+		if err := oprot.WriteI64(*p.KolID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4156,44 +4896,113 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusReq) String() string {
+func (p *GetUserKolOrderListReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTeamID() {
+		if err = oprot.WriteFieldBegin("team_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TeamID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetUserKolOrderListReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetUserKolOrderListReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetUserKolOrderListReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateKolOrderStatusReq(%+v)", *p)
+	return fmt.Sprintf("GetUserKolOrderListReq(%+v)", *p)
 
 }
 
-// 更新KOL订单状态响应
-type UpdateKolOrderStatusResp struct {
+// 获取用户自己的KOL订单列表响应
+type GetUserKolOrderListResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Orders   []*KolOrderInfo  `thrift:"orders,2,default,list<KolOrderInfo>" form:"orders" json:"orders" query:"orders"`
+	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
 }
 
-func NewUpdateKolOrderStatusResp() *UpdateKolOrderStatusResp {
-	return &UpdateKolOrderStatusResp{}
+func NewGetUserKolOrderListResp() *GetUserKolOrderListResp {
+	return &GetUserKolOrderListResp{}
 }
 
-func (p *UpdateKolOrderStatusResp) InitDefault() {
+func (p *GetUserKolOrderListResp) InitDefault() {
 }
 
-var UpdateKolOrderStatusResp_BaseResp_DEFAULT *common.BaseResp
+var GetUserKolOrderListResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *UpdateKolOrderStatusResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetUserKolOrderListResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return UpdateKolOrderStatusResp_BaseResp_DEFAULT
+		return GetUserKolOrderListResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_UpdateKolOrderStatusResp = map[int16]string{
+func (p *GetUserKolOrderListResp) GetOrders() (v []*KolOrderInfo) {
+	return p.Orders
+}
+
+func (p *GetUserKolOrderListResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_GetUserKolOrderListResp = map[int16]string{
 	1: "base_resp",
+	2: "orders",
+	3: "total",
 }
 
-func (p *UpdateKolOrderStatusResp) IsSetBaseResp() bool {
+func (p *GetUserKolOrderListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *UpdateKolOrderStatusResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserKolOrderListResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4220,6 +5029,22 @@ func (p *UpdateKolOrderStatusResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4239,7 +5064,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolOrderStatusResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserKolOrderListResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4249,7 +5074,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetUserKolOrderListResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4257,10 +5082,44 @@ func (p *UpdateKolOrderStatusResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
+func (p *GetUserKolOrderListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolOrderInfo, 0, size)
+	values := make([]KolOrderInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *UpdateKolOrderStatusResp) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Orders = _field
+	return nil
+}
+func (p *GetUserKolOrderListResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *GetUserKolOrderListResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateKolOrderStatusResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserKolOrderListResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4268,6 +5127,14 @@ func (p *UpdateKolOrderStatusResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4286,7 +5153,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetUserKolOrderListResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4303,42 +5170,135 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateKolOrderStatusResp) String() string {
+func (p *GetUserKolOrderListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
+		return err
+	}
+	for _, v := range p.Orders {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetUserKolOrderListResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetUserKolOrderListResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateKolOrderStatusResp(%+v)", *p)
+	return fmt.Sprintf("GetUserKolOrderListResp(%+v)", *p)
 
 }
 
-// 取消KOL订单请求（用户使用）
-type CancelKolOrderReq struct {
-	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
-	// 取消原因
-	Reason string `thrift:"reason,2" form:"reason" json:"reason"`
+// 获取KOL收到的订单列表请求（KOL端使用）
+type GetKolReceivedOrderListReq struct {
+	// 订单状态筛选
+	Status *string `thrift:"status,1,optional" form:"status" json:"status,omitempty"`
+	// 模糊搜索关键词（搜索标题、用户名称等）
+	Keyword *string `thrift:"keyword,2,optional" form:"keyword" json:"keyword,omitempty"`
+	// 默认1
+	Page *int32 `thrift:"page,3,optional" form:"page" json:"page,omitempty"`
+	// 默认10
+	PageSize *int32 `thrift:"page_size,4,optional" form:"page_size" json:"page_size,omitempty"`
 }
 
-func NewCancelKolOrderReq() *CancelKolOrderReq {
-	return &CancelKolOrderReq{}
+func NewGetKolReceivedOrderListReq() *GetKolReceivedOrderListReq {
+	return &GetKolReceivedOrderListReq{}
 }
 
-func (p *CancelKolOrderReq) InitDefault() {
+func (p *GetKolReceivedOrderListReq) InitDefault() {
 }
 
-func (p *CancelKolOrderReq) GetOrderID() (v string) {
-	return p.OrderID
-}
+var GetKolReceivedOrderListReq_Status_DEFAULT string
 
-func (p *CancelKolOrderReq) GetReason() (v string) {
-	return p.Reason
+func (p *GetKolReceivedOrderListReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return GetKolReceivedOrderListReq_Status_DEFAULT
+	}
+	return *p.Status
 }
 
-var fieldIDToName_CancelKolOrderReq = map[int16]string{
-	1: "order_id",
-	2: "reason",
+var GetKolReceivedOrderListReq_Keyword_DEFAULT string
+
+func (p *GetKolReceivedOrderListReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return GetKolReceivedOrderListReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
 }
 
-func (p *CancelKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
+var GetKolReceivedOrderListReq_Page_DEFAULT int32
+
+func (p *GetKolReceivedOrderListReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetKolReceivedOrderListReq_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetKolReceivedOrderListReq_PageSize_DEFAULT int32
+
+func (p *GetKolReceivedOrderListReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetKolReceivedOrderListReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var fieldIDToName_GetKolReceivedOrderListReq = map[int16]string{
+	1: "status",
+	2: "keyword",
+	3: "page",
+	4: "page_size",
+}
+
+func (p *GetKolReceivedOrderListReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *GetKolReceivedOrderListReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *GetKolReceivedOrderListReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetKolReceivedOrderListReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *GetKolReceivedOrderListReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4373,6 +5333,22 @@ func (p *CancelKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4392,7 +5368,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelKolOrderReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolReceivedOrderListReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4402,32 +5378,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CancelKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolReceivedOrderListReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.OrderID = _field
+	p.Status = _field
 	return nil
 }
-func (p *CancelKolOrderReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetKolReceivedOrderListReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Reason = _field
+	p.Keyword = _field
 	return nil
 }
+func (p *GetKolReceivedOrderListReq) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *CancelKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetKolReceivedOrderListReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetKolReceivedOrderListReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelKolOrderReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolReceivedOrderListReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4439,6 +5437,14 @@ func (p *CancelKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4457,15 +5463,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CancelKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.OrderID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetKolReceivedOrderListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4474,15 +5482,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CancelKolOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Reason); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetKolReceivedOrderListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4491,44 +5501,94 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CancelKolOrderReq) String() string {
+func (p *GetKolReceivedOrderListReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolReceivedOrderListReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetKolReceivedOrderListReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CancelKolOrderReq(%+v)", *p)
+	return fmt.Sprintf("GetKolReceivedOrderListReq(%+v)", *p)
 
 }
 
-// 取消KOL订单响应
-type CancelKolOrderResp struct {
+// 获取KOL收到的订单列表响应
+type GetKolReceivedOrderListResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Orders   []*KolOrderInfo  `thrift:"orders,2,default,list<KolOrderInfo>" form:"orders" json:"orders" query:"orders"`
+	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
 }
 
-func NewCancelKolOrderResp() *CancelKolOrderResp {
-	return &CancelKolOrderResp{}
+func NewGetKolReceivedOrderListResp() *GetKolReceivedOrderListResp {
+	return &GetKolReceivedOrderListResp{}
 }
 
-func (p *CancelKolOrderResp) InitDefault() {
+func (p *GetKolReceivedOrderListResp) InitDefault() {
 }
 
-var CancelKolOrderResp_BaseResp_DEFAULT *common.BaseResp
+var GetKolReceivedOrderListResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *CancelKolOrderResp) GetBaseResp() (v *common.BaseResp) {
+func (p *GetKolReceivedOrderListResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return CancelKolOrderResp_BaseResp_DEFAULT
+		return GetKolReceivedOrderListResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_CancelKolOrderResp = map[int16]string{
+func (p *GetKolReceivedOrderListResp) GetOrders() (v []*KolOrderInfo) {
+	return p.Orders
+}
+
+func (p *GetKolReceivedOrderListResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_GetKolReceivedOrderListResp = map[int16]string{
 	1: "base_resp",
+	2: "orders",
+	3: "total",
 }
 
-func (p *CancelKolOrderResp) IsSetBaseResp() bool {
+func (p *GetKolReceivedOrderListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *CancelKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetKolReceivedOrderListResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4555,14 +5615,30 @@ func (p *CancelKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
 	if err = iprot.ReadStructEnd(); err != nil {
 		goto ReadStructEndError
@@ -4574,7 +5650,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelKolOrderResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolReceivedOrderListResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4584,7 +5660,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CancelKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetKolReceivedOrderListResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4592,10 +5668,44 @@ func (p *CancelKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
+func (p *GetKolReceivedOrderListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolOrderInfo, 0, size)
+	values := make([]KolOrderInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *CancelKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Orders = _field
+	return nil
+}
+func (p *GetKolReceivedOrderListResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *GetKolReceivedOrderListResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelKolOrderResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolReceivedOrderListResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4603,6 +5713,14 @@ func (p *CancelKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4621,7 +5739,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CancelKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetKolReceivedOrderListResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4638,35 +5756,100 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CancelKolOrderResp) String() string {
+func (p *GetKolReceivedOrderListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
+		return err
+	}
+	for _, v := range p.Orders {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolReceivedOrderListResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolReceivedOrderListResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CancelKolOrderResp(%+v)", *p)
+	return fmt.Sprintf("GetKolReceivedOrderListResp(%+v)", *p)
 
 }
 
-// 确认KOL订单支付请求（用户支付完成后调用）
-type ConfirmKolOrderPaymentReq struct {
+// 更新KOL订单状态请求（KOL使用）
+type UpdateKolOrderStatusReq struct {
 	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	// confirmed, in_progress, cancelled（完成订单需提交交付内容并由买家验收）
+	Status string `thrift:"status,2" form:"status" json:"status"`
+	// 取消时需要提供原因
+	RejectReason *string `thrift:"reject_reason,3,optional" form:"reject_reason" json:"reject_reason,omitempty"`
 }
 
-func NewConfirmKolOrderPaymentReq() *ConfirmKolOrderPaymentReq {
-	return &ConfirmKolOrderPaymentReq{}
+func NewUpdateKolOrderStatusReq() *UpdateKolOrderStatusReq {
+	return &UpdateKolOrderStatusReq{}
 }
 
-func (p *ConfirmKolOrderPaymentReq) InitDefault() {
+func (p *UpdateKolOrderStatusReq) InitDefault() {
 }
 
-func (p *ConfirmKolOrderPaymentReq) GetOrderID() (v string) {
+func (p *UpdateKolOrderStatusReq) GetOrderID() (v string) {
 	return p.OrderID
 }
 
-var fieldIDToName_ConfirmKolOrderPaymentReq = map[int16]string{
+func (p *UpdateKolOrderStatusReq) GetStatus() (v string) {
+	return p.Status
+}
+
+var UpdateKolOrderStatusReq_RejectReason_DEFAULT string
+
+func (p *UpdateKolOrderStatusReq) GetRejectReason() (v string) {
+	if !p.IsSetRejectReason() {
+		return UpdateKolOrderStatusReq_RejectReason_DEFAULT
+	}
+	return *p.RejectReason
+}
+
+var fieldIDToName_UpdateKolOrderStatusReq = map[int16]string{
 	1: "order_id",
+	2: "status",
+	3: "reject_reason",
 }
 
-func (p *ConfirmKolOrderPaymentReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateKolOrderStatusReq) IsSetRejectReason() bool {
+	return p.RejectReason != nil
+}
+
+func (p *UpdateKolOrderStatusReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4693,6 +5876,22 @@ func (p *ConfirmKolOrderPaymentReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4712,7 +5911,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmKolOrderPaymentReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolOrderStatusReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4722,7 +5921,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ConfirmKolOrderPaymentReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateKolOrderStatusReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4733,10 +5932,32 @@ func (p *ConfirmKolOrderPaymentReq) ReadField1(iprot thrift.TProtocol) error {
 	p.OrderID = _field
 	return nil
 }
+func (p *UpdateKolOrderStatusReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *ConfirmKolOrderPaymentReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *UpdateKolOrderStatusReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RejectReason = _field
+	return nil
+}
+
+func (p *UpdateKolOrderStatusReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConfirmKolOrderPaymentReq"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateKolOrderStatusReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4744,6 +5965,14 @@ func (p *ConfirmKolOrderPaymentReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4762,7 +5991,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ConfirmKolOrderPaymentReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateKolOrderStatusReq) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4779,44 +6008,80 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ConfirmKolOrderPaymentReq) String() string {
+func (p *UpdateKolOrderStatusReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateKolOrderStatusReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRejectReason() {
+		if err = oprot.WriteFieldBegin("reject_reason", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RejectReason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateKolOrderStatusReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ConfirmKolOrderPaymentReq(%+v)", *p)
+	return fmt.Sprintf("UpdateKolOrderStatusReq(%+v)", *p)
 
 }
 
-// 确认KOL订单支付响应
-type ConfirmKolOrderPaymentResp struct {
+// 更新KOL订单状态响应
+type UpdateKolOrderStatusResp struct {
 	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
 }
 
-func NewConfirmKolOrderPaymentResp() *ConfirmKolOrderPaymentResp {
-	return &ConfirmKolOrderPaymentResp{}
+func NewUpdateKolOrderStatusResp() *UpdateKolOrderStatusResp {
+	return &UpdateKolOrderStatusResp{}
 }
 
-func (p *ConfirmKolOrderPaymentResp) InitDefault() {
+func (p *UpdateKolOrderStatusResp) InitDefault() {
 }
 
-var ConfirmKolOrderPaymentResp_BaseResp_DEFAULT *common.BaseResp
+var UpdateKolOrderStatusResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *ConfirmKolOrderPaymentResp) GetBaseResp() (v *common.BaseResp) {
+func (p *UpdateKolOrderStatusResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return ConfirmKolOrderPaymentResp_BaseResp_DEFAULT
+		return UpdateKolOrderStatusResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_ConfirmKolOrderPaymentResp = map[int16]string{
+var fieldIDToName_UpdateKolOrderStatusResp = map[int16]string{
 	1: "base_resp",
 }
 
-func (p *ConfirmKolOrderPaymentResp) IsSetBaseResp() bool {
+func (p *UpdateKolOrderStatusResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ConfirmKolOrderPaymentResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateKolOrderStatusResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4862,7 +6127,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmKolOrderPaymentResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateKolOrderStatusResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4872,7 +6137,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ConfirmKolOrderPaymentResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateKolOrderStatusResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := common.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4881,9 +6146,9 @@ func (p *ConfirmKolOrderPaymentResp) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ConfirmKolOrderPaymentResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateKolOrderStatusResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConfirmKolOrderPaymentResp"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateKolOrderStatusResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4909,7 +6174,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ConfirmKolOrderPaymentResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateKolOrderStatusResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4926,534 +6191,9082 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ConfirmKolOrderPaymentResp) String() string {
+func (p *UpdateKolOrderStatusResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ConfirmKolOrderPaymentResp(%+v)", *p)
+	return fmt.Sprintf("UpdateKolOrderStatusResp(%+v)", *p)
 
 }
 
-// KOL订单服务
-type KolOrderService interface {
-	// 用户订单管理
-	CreateKolOrder(ctx context.Context, req *CreateKolOrderReq) (r *CreateKolOrderResp, err error)
-
-	GetKolOrder(ctx context.Context, req *GetKolOrderReq) (r *GetKolOrderResp, err error)
+// 取消KOL订单请求（用户使用）
+type CancelKolOrderReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	// 取消原因
+	Reason string `thrift:"reason,2" form:"reason" json:"reason"`
+}
 
-	GetUserKolOrderList(ctx context.Context, req *GetUserKolOrderListReq) (r *GetUserKolOrderListResp, err error)
+func NewCancelKolOrderReq() *CancelKolOrderReq {
+	return &CancelKolOrderReq{}
+}
 
-	CancelKolOrder(ctx context.Context, req *CancelKolOrderReq) (r *CancelKolOrderResp, err error)
+func (p *CancelKolOrderReq) InitDefault() {
+}
 
-	ConfirmKolOrderPayment(ctx context.Context, req *ConfirmKolOrderPaymentReq) (r *ConfirmKolOrderPaymentResp, err error)
-	// KOL订单管理
-	GetKolReceivedOrderList(ctx context.Context, req *GetKolReceivedOrderListReq) (r *GetKolReceivedOrderListResp, err error)
+func (p *CancelKolOrderReq) GetOrderID() (v string) {
+	return p.OrderID
+}
 
-	UpdateKolOrderStatus(ctx context.Context, req *UpdateKolOrderStatusReq) (r *UpdateKolOrderStatusResp, err error)
+func (p *CancelKolOrderReq) GetReason() (v string) {
+	return p.Reason
 }
 
-type KolOrderServiceClient struct {
-	c thrift.TClient
+var fieldIDToName_CancelKolOrderReq = map[int16]string{
+	1: "order_id",
+	2: "reason",
 }
 
-func NewKolOrderServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *KolOrderServiceClient {
-	return &KolOrderServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *CancelKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewKolOrderServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *KolOrderServiceClient {
-	return &KolOrderServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelKolOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func NewKolOrderServiceClient(c thrift.TClient) *KolOrderServiceClient {
-	return &KolOrderServiceClient{
-		c: c,
+func (p *CancelKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.OrderID = _field
+	return nil
 }
+func (p *CancelKolOrderReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *KolOrderServiceClient) Client_() thrift.TClient {
-	return p.c
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
 }
 
-func (p *KolOrderServiceClient) CreateKolOrder(ctx context.Context, req *CreateKolOrderReq) (r *CreateKolOrderResp, err error) {
-	var _args KolOrderServiceCreateKolOrderArgs
-	_args.Req = req
-	var _result KolOrderServiceCreateKolOrderResult
-	if err = p.Client_().Call(ctx, "CreateKolOrder", &_args, &_result); err != nil {
-		return
+func (p *CancelKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelKolOrderReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *KolOrderServiceClient) GetKolOrder(ctx context.Context, req *GetKolOrderReq) (r *GetKolOrderResp, err error) {
-	var _args KolOrderServiceGetKolOrderArgs
-	_args.Req = req
-	var _result KolOrderServiceGetKolOrderResult
-	if err = p.Client_().Call(ctx, "GetKolOrder", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *KolOrderServiceClient) GetUserKolOrderList(ctx context.Context, req *GetUserKolOrderListReq) (r *GetUserKolOrderListResp, err error) {
-	var _args KolOrderServiceGetUserKolOrderListArgs
-	_args.Req = req
-	var _result KolOrderServiceGetUserKolOrderListResult
-	if err = p.Client_().Call(ctx, "GetUserKolOrderList", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *KolOrderServiceClient) CancelKolOrder(ctx context.Context, req *CancelKolOrderReq) (r *CancelKolOrderResp, err error) {
-	var _args KolOrderServiceCancelKolOrderArgs
-	_args.Req = req
-	var _result KolOrderServiceCancelKolOrderResult
-	if err = p.Client_().Call(ctx, "CancelKolOrder", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *KolOrderServiceClient) ConfirmKolOrderPayment(ctx context.Context, req *ConfirmKolOrderPaymentReq) (r *ConfirmKolOrderPaymentResp, err error) {
-	var _args KolOrderServiceConfirmKolOrderPaymentArgs
-	_args.Req = req
-	var _result KolOrderServiceConfirmKolOrderPaymentResult
-	if err = p.Client_().Call(ctx, "ConfirmKolOrderPayment", &_args, &_result); err != nil {
-		return
+
+func (p *CancelKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *KolOrderServiceClient) GetKolReceivedOrderList(ctx context.Context, req *GetKolReceivedOrderListReq) (r *GetKolReceivedOrderListResp, err error) {
-	var _args KolOrderServiceGetKolReceivedOrderListArgs
-	_args.Req = req
-	var _result KolOrderServiceGetKolReceivedOrderListResult
-	if err = p.Client_().Call(ctx, "GetKolReceivedOrderList", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *KolOrderServiceClient) UpdateKolOrderStatus(ctx context.Context, req *UpdateKolOrderStatusReq) (r *UpdateKolOrderStatusResp, err error) {
-	var _args KolOrderServiceUpdateKolOrderStatusArgs
-	_args.Req = req
-	var _result KolOrderServiceUpdateKolOrderStatusResult
-	if err = p.Client_().Call(ctx, "UpdateKolOrderStatus", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
-}
-
-type KolOrderServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      KolOrderService
-}
-
-func (p *KolOrderServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+func (p *CancelKolOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CancelKolOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelKolOrderReq(%+v)", *p)
 
-func (p *KolOrderServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
 }
 
-func NewKolOrderServiceProcessor(handler KolOrderService) *KolOrderServiceProcessor {
-	self := &KolOrderServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CreateKolOrder", &kolOrderServiceProcessorCreateKolOrder{handler: handler})
-	self.AddToProcessorMap("GetKolOrder", &kolOrderServiceProcessorGetKolOrder{handler: handler})
-	self.AddToProcessorMap("GetUserKolOrderList", &kolOrderServiceProcessorGetUserKolOrderList{handler: handler})
-	self.AddToProcessorMap("CancelKolOrder", &kolOrderServiceProcessorCancelKolOrder{handler: handler})
-	self.AddToProcessorMap("ConfirmKolOrderPayment", &kolOrderServiceProcessorConfirmKolOrderPayment{handler: handler})
-	self.AddToProcessorMap("GetKolReceivedOrderList", &kolOrderServiceProcessorGetKolReceivedOrderList{handler: handler})
-	self.AddToProcessorMap("UpdateKolOrderStatus", &kolOrderServiceProcessorUpdateKolOrderStatus{handler: handler})
-	return self
+// 取消KOL订单响应
+type CancelKolOrderResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
 }
-func (p *KolOrderServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+
+func NewCancelKolOrderResp() *CancelKolOrderResp {
+	return &CancelKolOrderResp{}
 }
 
-type kolOrderServiceProcessorCreateKolOrder struct {
-	handler KolOrderService
+func (p *CancelKolOrderResp) InitDefault() {
 }
 
-func (p *kolOrderServiceProcessorCreateKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceCreateKolOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateKolOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var CancelKolOrderResp_BaseResp_DEFAULT *common.BaseResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceCreateKolOrderResult{}
-	var retval *CreateKolOrderResp
-	if retval, err2 = p.handler.CreateKolOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateKolOrder: "+err2.Error())
-		oprot.WriteMessageBegin("CreateKolOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateKolOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *CancelKolOrderResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CancelKolOrderResp_BaseResp_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.BaseResp
+}
+
+var fieldIDToName_CancelKolOrderResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *CancelKolOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CancelKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type kolOrderServiceProcessorGetKolOrder struct {
-	handler KolOrderService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelKolOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *kolOrderServiceProcessorGetKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceGetKolOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetKolOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CancelKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResp = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceGetKolOrderResult{}
-	var retval *GetKolOrderResp
-	if retval, err2 = p.handler.GetKolOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolOrder: "+err2.Error())
-		oprot.WriteMessageBegin("GetKolOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *CancelKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelKolOrderResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetKolOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CancelKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type kolOrderServiceProcessorGetUserKolOrderList struct {
-	handler KolOrderService
+func (p *CancelKolOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelKolOrderResp(%+v)", *p)
+
 }
 
-func (p *kolOrderServiceProcessorGetUserKolOrderList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceGetUserKolOrderListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserKolOrderList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+// 确认KOL订单支付请求（用户支付完成后调用）
+type ConfirmKolOrderPaymentReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+}
+
+func NewConfirmKolOrderPaymentReq() *ConfirmKolOrderPaymentReq {
+	return &ConfirmKolOrderPaymentReq{}
+}
+
+func (p *ConfirmKolOrderPaymentReq) InitDefault() {
+}
+
+func (p *ConfirmKolOrderPaymentReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+var fieldIDToName_ConfirmKolOrderPaymentReq = map[int16]string{
+	1: "order_id",
+}
+
+func (p *ConfirmKolOrderPaymentReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceGetUserKolOrderListResult{}
-	var retval *GetUserKolOrderListResp
-	if retval, err2 = p.handler.GetUserKolOrderList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserKolOrderList: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserKolOrderList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmKolOrderPaymentReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ConfirmKolOrderPaymentReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("GetUserKolOrderList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.OrderID = _field
+	return nil
+}
+
+func (p *ConfirmKolOrderPaymentReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmKolOrderPaymentReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfirmKolOrderPaymentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true, err
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type kolOrderServiceProcessorCancelKolOrder struct {
-	handler KolOrderService
+func (p *ConfirmKolOrderPaymentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmKolOrderPaymentReq(%+v)", *p)
+
 }
 
-func (p *kolOrderServiceProcessorCancelKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceCancelKolOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CancelKolOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+// 确认KOL订单支付响应
+type ConfirmKolOrderPaymentResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewConfirmKolOrderPaymentResp() *ConfirmKolOrderPaymentResp {
+	return &ConfirmKolOrderPaymentResp{}
+}
+
+func (p *ConfirmKolOrderPaymentResp) InitDefault() {
+}
+
+var ConfirmKolOrderPaymentResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ConfirmKolOrderPaymentResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ConfirmKolOrderPaymentResp_BaseResp_DEFAULT
 	}
+	return p.BaseResp
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceCancelKolOrderResult{}
-	var retval *CancelKolOrderResp
-	if retval, err2 = p.handler.CancelKolOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CancelKolOrder: "+err2.Error())
-		oprot.WriteMessageBegin("CancelKolOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+var fieldIDToName_ConfirmKolOrderPaymentResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *ConfirmKolOrderPaymentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ConfirmKolOrderPaymentResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("CancelKolOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmKolOrderPaymentResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ConfirmKolOrderPaymentResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ConfirmKolOrderPaymentResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmKolOrderPaymentResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfirmKolOrderPaymentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ConfirmKolOrderPaymentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmKolOrderPaymentResp(%+v)", *p)
+
+}
+
+// 管理员退款请求（纠纷处理）
+type AdminRefundKolOrderReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	// 退款金额（美元），为空时全额退款
+	Amount *string `thrift:"amount,2,optional" form:"amount" json:"amount,omitempty"`
+	// 退款原因
+	Reason string `thrift:"reason,3" form:"reason" json:"reason"`
+}
+
+func NewAdminRefundKolOrderReq() *AdminRefundKolOrderReq {
+	return &AdminRefundKolOrderReq{}
+}
+
+func (p *AdminRefundKolOrderReq) InitDefault() {
+}
+
+func (p *AdminRefundKolOrderReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+var AdminRefundKolOrderReq_Amount_DEFAULT string
+
+func (p *AdminRefundKolOrderReq) GetAmount() (v string) {
+	if !p.IsSetAmount() {
+		return AdminRefundKolOrderReq_Amount_DEFAULT
+	}
+	return *p.Amount
+}
+
+func (p *AdminRefundKolOrderReq) GetReason() (v string) {
+	return p.Reason
+}
+
+var fieldIDToName_AdminRefundKolOrderReq = map[int16]string{
+	1: "order_id",
+	2: "amount",
+	3: "reason",
+}
+
+func (p *AdminRefundKolOrderReq) IsSetAmount() bool {
+	return p.Amount != nil
+}
+
+func (p *AdminRefundKolOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRefundKolOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+func (p *AdminRefundKolOrderReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Amount = _field
+	return nil
+}
+func (p *AdminRefundKolOrderReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *AdminRefundKolOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRefundKolOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAmount() {
+		if err = oprot.WriteFieldBegin("amount", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Amount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRefundKolOrderReq(%+v)", *p)
+
+}
+
+// 管理员退款响应
+type AdminRefundKolOrderResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	// 实际退款金额
+	RefundAmount *string `thrift:"refund_amount,2,optional" form:"refund_amount" json:"refund_amount,omitempty" query:"refund_amount"`
+}
+
+func NewAdminRefundKolOrderResp() *AdminRefundKolOrderResp {
+	return &AdminRefundKolOrderResp{}
+}
+
+func (p *AdminRefundKolOrderResp) InitDefault() {
+}
+
+var AdminRefundKolOrderResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AdminRefundKolOrderResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminRefundKolOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var AdminRefundKolOrderResp_RefundAmount_DEFAULT string
+
+func (p *AdminRefundKolOrderResp) GetRefundAmount() (v string) {
+	if !p.IsSetRefundAmount() {
+		return AdminRefundKolOrderResp_RefundAmount_DEFAULT
+	}
+	return *p.RefundAmount
+}
+
+var fieldIDToName_AdminRefundKolOrderResp = map[int16]string{
+	1: "base_resp",
+	2: "refund_amount",
+}
+
+func (p *AdminRefundKolOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminRefundKolOrderResp) IsSetRefundAmount() bool {
+	return p.RefundAmount != nil
+}
+
+func (p *AdminRefundKolOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRefundKolOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AdminRefundKolOrderResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RefundAmount = _field
+	return nil
+}
+
+func (p *AdminRefundKolOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRefundKolOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefundAmount() {
+		if err = oprot.WriteFieldBegin("refund_amount", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RefundAmount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminRefundKolOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRefundKolOrderResp(%+v)", *p)
+
+}
+
+// 创建订单评价请求（下单用户对已完成的订单评价，每个订单只能评价一次）
+type CreateKolOrderReviewReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	// 评分：1-5星
+	Rating int32 `thrift:"rating,2" form:"rating" json:"rating"`
+	// 评价内容，最多2000字
+	Content string `thrift:"content,3" form:"content" json:"content"`
+}
+
+func NewCreateKolOrderReviewReq() *CreateKolOrderReviewReq {
+	return &CreateKolOrderReviewReq{}
+}
+
+func (p *CreateKolOrderReviewReq) InitDefault() {
+}
+
+func (p *CreateKolOrderReviewReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+func (p *CreateKolOrderReviewReq) GetRating() (v int32) {
+	return p.Rating
+}
+
+func (p *CreateKolOrderReviewReq) GetContent() (v string) {
+	return p.Content
+}
+
+var fieldIDToName_CreateKolOrderReviewReq = map[int16]string{
+	1: "order_id",
+	2: "rating",
+	3: "content",
+}
+
+func (p *CreateKolOrderReviewReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolOrderReviewReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+func (p *CreateKolOrderReviewReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Rating = _field
+	return nil
+}
+func (p *CreateKolOrderReviewReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+
+func (p *CreateKolOrderReviewReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateKolOrderReviewReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Rating); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateKolOrderReviewReq(%+v)", *p)
+
+}
+
+// 创建订单评价响应
+type CreateKolOrderReviewResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	ReviewID *int64           `thrift:"review_id,2,optional" form:"review_id" json:"review_id,omitempty" query:"review_id"`
+}
+
+func NewCreateKolOrderReviewResp() *CreateKolOrderReviewResp {
+	return &CreateKolOrderReviewResp{}
+}
+
+func (p *CreateKolOrderReviewResp) InitDefault() {
+}
+
+var CreateKolOrderReviewResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CreateKolOrderReviewResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateKolOrderReviewResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var CreateKolOrderReviewResp_ReviewID_DEFAULT int64
+
+func (p *CreateKolOrderReviewResp) GetReviewID() (v int64) {
+	if !p.IsSetReviewID() {
+		return CreateKolOrderReviewResp_ReviewID_DEFAULT
+	}
+	return *p.ReviewID
+}
+
+var fieldIDToName_CreateKolOrderReviewResp = map[int16]string{
+	1: "base_resp",
+	2: "review_id",
+}
+
+func (p *CreateKolOrderReviewResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateKolOrderReviewResp) IsSetReviewID() bool {
+	return p.ReviewID != nil
+}
+
+func (p *CreateKolOrderReviewResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateKolOrderReviewResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *CreateKolOrderReviewResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReviewID = _field
+	return nil
+}
+
+func (p *CreateKolOrderReviewResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateKolOrderReviewResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReviewID() {
+		if err = oprot.WriteFieldBegin("review_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ReviewID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateKolOrderReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateKolOrderReviewResp(%+v)", *p)
+
+}
+
+// KOL回复评价请求（重复回复会覆盖之前的回复）
+type ReplyKolReviewReq struct {
+	ReviewID int64 `thrift:"review_id,1" form:"review_id" json:"review_id"`
+	// 回复内容，最多2000字
+	Reply string `thrift:"reply,2" form:"reply" json:"reply"`
+}
+
+func NewReplyKolReviewReq() *ReplyKolReviewReq {
+	return &ReplyKolReviewReq{}
+}
+
+func (p *ReplyKolReviewReq) InitDefault() {
+}
+
+func (p *ReplyKolReviewReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+func (p *ReplyKolReviewReq) GetReply() (v string) {
+	return p.Reply
+}
+
+var fieldIDToName_ReplyKolReviewReq = map[int16]string{
+	1: "review_id",
+	2: "reply",
+}
+
+func (p *ReplyKolReviewReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplyKolReviewReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplyKolReviewReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *ReplyKolReviewReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reply = _field
+	return nil
+}
+
+func (p *ReplyKolReviewReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplyKolReviewReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplyKolReviewReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplyKolReviewReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reply", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reply); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ReplyKolReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplyKolReviewReq(%+v)", *p)
+
+}
+
+// KOL回复评价响应
+type ReplyKolReviewResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewReplyKolReviewResp() *ReplyKolReviewResp {
+	return &ReplyKolReviewResp{}
+}
+
+func (p *ReplyKolReviewResp) InitDefault() {
+}
+
+var ReplyKolReviewResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReplyKolReviewResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReplyKolReviewResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ReplyKolReviewResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *ReplyKolReviewResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReplyKolReviewResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReplyKolReviewResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReplyKolReviewResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ReplyKolReviewResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReplyKolReviewResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReplyKolReviewResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReplyKolReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplyKolReviewResp(%+v)", *p)
+
+}
+
+// 获取KOL公开评价列表请求
+type GetKolReviewListReq struct {
+	KolID int64 `thrift:"kol_id,1" form:"kol_id" json:"kol_id"`
+	// 默认1
+	Page *int32 `thrift:"page,2,optional" form:"page" json:"page,omitempty"`
+	// 默认10
+	PageSize *int32 `thrift:"page_size,3,optional" form:"page_size" json:"page_size,omitempty"`
+}
+
+func NewGetKolReviewListReq() *GetKolReviewListReq {
+	return &GetKolReviewListReq{}
+}
+
+func (p *GetKolReviewListReq) InitDefault() {
+}
+
+func (p *GetKolReviewListReq) GetKolID() (v int64) {
+	return p.KolID
+}
+
+var GetKolReviewListReq_Page_DEFAULT int32
+
+func (p *GetKolReviewListReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetKolReviewListReq_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetKolReviewListReq_PageSize_DEFAULT int32
+
+func (p *GetKolReviewListReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetKolReviewListReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var fieldIDToName_GetKolReviewListReq = map[int16]string{
+	1: "kol_id",
+	2: "page",
+	3: "page_size",
+}
+
+func (p *GetKolReviewListReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetKolReviewListReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *GetKolReviewListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolReviewListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolReviewListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.KolID = _field
+	return nil
+}
+func (p *GetKolReviewListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetKolReviewListReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetKolReviewListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolReviewListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolReviewListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.KolID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolReviewListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolReviewListReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolReviewListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolReviewListReq(%+v)", *p)
+
+}
+
+// 获取KOL公开评价列表响应
+type GetKolReviewListResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Reviews  []*KolReviewInfo `thrift:"reviews,2,default,list<KolReviewInfo>" form:"reviews" json:"reviews" query:"reviews"`
+	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
+	// 平均分（1-5），没有评价时为0
+	AverageRating float64 `thrift:"average_rating,4" form:"average_rating" json:"average_rating" query:"average_rating"`
+	ReviewCount   int64   `thrift:"review_count,5" form:"review_count" json:"review_count" query:"review_count"`
+}
+
+func NewGetKolReviewListResp() *GetKolReviewListResp {
+	return &GetKolReviewListResp{}
+}
+
+func (p *GetKolReviewListResp) InitDefault() {
+}
+
+var GetKolReviewListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetKolReviewListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetKolReviewListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetKolReviewListResp) GetReviews() (v []*KolReviewInfo) {
+	return p.Reviews
+}
+
+func (p *GetKolReviewListResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+func (p *GetKolReviewListResp) GetAverageRating() (v float64) {
+	return p.AverageRating
+}
+
+func (p *GetKolReviewListResp) GetReviewCount() (v int64) {
+	return p.ReviewCount
+}
+
+var fieldIDToName_GetKolReviewListResp = map[int16]string{
+	1: "base_resp",
+	2: "reviews",
+	3: "total",
+	4: "average_rating",
+	5: "review_count",
+}
+
+func (p *GetKolReviewListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetKolReviewListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolReviewListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetKolReviewListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolReviewInfo, 0, size)
+	values := make([]KolReviewInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Reviews = _field
+	return nil
+}
+func (p *GetKolReviewListResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *GetKolReviewListResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AverageRating = _field
+	return nil
+}
+func (p *GetKolReviewListResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewCount = _field
+	return nil
+}
+
+func (p *GetKolReviewListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolReviewListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reviews", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Reviews)); err != nil {
+		return err
+	}
+	for _, v := range p.Reviews {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("average_rating", thrift.DOUBLE, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.AverageRating); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_count", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetKolReviewListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolReviewListResp(%+v)", *p)
+
+}
+
+// 管理员获取评价列表请求
+type AdminGetKolReviewListReq struct {
+	KolID *int64 `thrift:"kol_id,1,optional" form:"kol_id" json:"kol_id,omitempty"`
+	// 评分筛选
+	Rating *int32 `thrift:"rating,2,optional" form:"rating" json:"rating,omitempty"`
+	// 是否隐藏筛选，不传则查询全部
+	Hidden *bool `thrift:"hidden,3,optional" form:"hidden" json:"hidden,omitempty"`
+	// 默认1
+	Page *int32 `thrift:"page,4,optional" form:"page" json:"page,omitempty"`
+	// 默认10
+	PageSize *int32 `thrift:"page_size,5,optional" form:"page_size" json:"page_size,omitempty"`
+}
+
+func NewAdminGetKolReviewListReq() *AdminGetKolReviewListReq {
+	return &AdminGetKolReviewListReq{}
+}
+
+func (p *AdminGetKolReviewListReq) InitDefault() {
+}
+
+var AdminGetKolReviewListReq_KolID_DEFAULT int64
+
+func (p *AdminGetKolReviewListReq) GetKolID() (v int64) {
+	if !p.IsSetKolID() {
+		return AdminGetKolReviewListReq_KolID_DEFAULT
+	}
+	return *p.KolID
+}
+
+var AdminGetKolReviewListReq_Rating_DEFAULT int32
+
+func (p *AdminGetKolReviewListReq) GetRating() (v int32) {
+	if !p.IsSetRating() {
+		return AdminGetKolReviewListReq_Rating_DEFAULT
+	}
+	return *p.Rating
+}
+
+var AdminGetKolReviewListReq_Hidden_DEFAULT bool
+
+func (p *AdminGetKolReviewListReq) GetHidden() (v bool) {
+	if !p.IsSetHidden() {
+		return AdminGetKolReviewListReq_Hidden_DEFAULT
+	}
+	return *p.Hidden
+}
+
+var AdminGetKolReviewListReq_Page_DEFAULT int32
+
+func (p *AdminGetKolReviewListReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return AdminGetKolReviewListReq_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var AdminGetKolReviewListReq_PageSize_DEFAULT int32
+
+func (p *AdminGetKolReviewListReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return AdminGetKolReviewListReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var fieldIDToName_AdminGetKolReviewListReq = map[int16]string{
+	1: "kol_id",
+	2: "rating",
+	3: "hidden",
+	4: "page",
+	5: "page_size",
+}
+
+func (p *AdminGetKolReviewListReq) IsSetKolID() bool {
+	return p.KolID != nil
+}
+
+func (p *AdminGetKolReviewListReq) IsSetRating() bool {
+	return p.Rating != nil
+}
+
+func (p *AdminGetKolReviewListReq) IsSetHidden() bool {
+	return p.Hidden != nil
+}
+
+func (p *AdminGetKolReviewListReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *AdminGetKolReviewListReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *AdminGetKolReviewListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminGetKolReviewListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KolID = _field
+	return nil
+}
+func (p *AdminGetKolReviewListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Rating = _field
+	return nil
+}
+func (p *AdminGetKolReviewListReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Hidden = _field
+	return nil
+}
+func (p *AdminGetKolReviewListReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *AdminGetKolReviewListReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *AdminGetKolReviewListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminGetKolReviewListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKolID() {
+		if err = oprot.WriteFieldBegin("kol_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.KolID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRating() {
+		if err = oprot.WriteFieldBegin("rating", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Rating); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHidden() {
+		if err = oprot.WriteFieldBegin("hidden", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Hidden); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminGetKolReviewListReq(%+v)", *p)
+
+}
+
+// 管理员获取评价列表响应
+type AdminGetKolReviewListResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	Reviews  []*KolReviewInfo `thrift:"reviews,2,default,list<KolReviewInfo>" form:"reviews" json:"reviews" query:"reviews"`
+	Total    int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
+}
+
+func NewAdminGetKolReviewListResp() *AdminGetKolReviewListResp {
+	return &AdminGetKolReviewListResp{}
+}
+
+func (p *AdminGetKolReviewListResp) InitDefault() {
+}
+
+var AdminGetKolReviewListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AdminGetKolReviewListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminGetKolReviewListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AdminGetKolReviewListResp) GetReviews() (v []*KolReviewInfo) {
+	return p.Reviews
+}
+
+func (p *AdminGetKolReviewListResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_AdminGetKolReviewListResp = map[int16]string{
+	1: "base_resp",
+	2: "reviews",
+	3: "total",
+}
+
+func (p *AdminGetKolReviewListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminGetKolReviewListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminGetKolReviewListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AdminGetKolReviewListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolReviewInfo, 0, size)
+	values := make([]KolReviewInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Reviews = _field
+	return nil
+}
+func (p *AdminGetKolReviewListResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *AdminGetKolReviewListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminGetKolReviewListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reviews", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Reviews)); err != nil {
+		return err
+	}
+	for _, v := range p.Reviews {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdminGetKolReviewListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminGetKolReviewListResp(%+v)", *p)
+
+}
+
+// 管理员隐藏/恢复评价请求
+type AdminModerateKolReviewReq struct {
+	ReviewID int64 `thrift:"review_id,1" form:"review_id" json:"review_id"`
+	// true-隐藏，false-恢复展示
+	Hidden bool `thrift:"hidden,2" form:"hidden" json:"hidden"`
+	// 隐藏原因，隐藏时必填
+	Reason *string `thrift:"reason,3,optional" form:"reason" json:"reason,omitempty"`
+}
+
+func NewAdminModerateKolReviewReq() *AdminModerateKolReviewReq {
+	return &AdminModerateKolReviewReq{}
+}
+
+func (p *AdminModerateKolReviewReq) InitDefault() {
+}
+
+func (p *AdminModerateKolReviewReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+func (p *AdminModerateKolReviewReq) GetHidden() (v bool) {
+	return p.Hidden
+}
+
+var AdminModerateKolReviewReq_Reason_DEFAULT string
+
+func (p *AdminModerateKolReviewReq) GetReason() (v string) {
+	if !p.IsSetReason() {
+		return AdminModerateKolReviewReq_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+
+var fieldIDToName_AdminModerateKolReviewReq = map[int16]string{
+	1: "review_id",
+	2: "hidden",
+	3: "reason",
+}
+
+func (p *AdminModerateKolReviewReq) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *AdminModerateKolReviewReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminModerateKolReviewReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *AdminModerateKolReviewReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Hidden = _field
+	return nil
+}
+func (p *AdminModerateKolReviewReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *AdminModerateKolReviewReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminModerateKolReviewReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("hidden", thrift.BOOL, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Hidden); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReason() {
+		if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminModerateKolReviewReq(%+v)", *p)
+
+}
+
+// 管理员隐藏/恢复评价响应
+type AdminModerateKolReviewResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewAdminModerateKolReviewResp() *AdminModerateKolReviewResp {
+	return &AdminModerateKolReviewResp{}
+}
+
+func (p *AdminModerateKolReviewResp) InitDefault() {
+}
+
+var AdminModerateKolReviewResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AdminModerateKolReviewResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminModerateKolReviewResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminModerateKolReviewResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminModerateKolReviewResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminModerateKolReviewResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminModerateKolReviewResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AdminModerateKolReviewResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminModerateKolReviewResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminModerateKolReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminModerateKolReviewResp(%+v)", *p)
+
+}
+
+// 提交交付内容请求（KOL使用，订单需处于进行中）
+type SubmitKolOrderDeliverableReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	// 视频链接（TikTok、YouTube、X、Discord 等平台地址），最多10个
+	VideoUrls []string `thrift:"video_urls,2,default,list<string>" form:"video_urls" json:"video_urls"`
+	// 已上传文件的URL，最多10个；视频链接和文件至少提供一项
+	FileUrls []string `thrift:"file_urls,3,default,list<string>" form:"file_urls" json:"file_urls"`
+	// 交付说明，最多2000字
+	Note *string `thrift:"note,4,optional" form:"note" json:"note,omitempty"`
+}
+
+func NewSubmitKolOrderDeliverableReq() *SubmitKolOrderDeliverableReq {
+	return &SubmitKolOrderDeliverableReq{}
+}
+
+func (p *SubmitKolOrderDeliverableReq) InitDefault() {
+}
+
+func (p *SubmitKolOrderDeliverableReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+func (p *SubmitKolOrderDeliverableReq) GetVideoUrls() (v []string) {
+	return p.VideoUrls
+}
+
+func (p *SubmitKolOrderDeliverableReq) GetFileUrls() (v []string) {
+	return p.FileUrls
+}
+
+var SubmitKolOrderDeliverableReq_Note_DEFAULT string
+
+func (p *SubmitKolOrderDeliverableReq) GetNote() (v string) {
+	if !p.IsSetNote() {
+		return SubmitKolOrderDeliverableReq_Note_DEFAULT
+	}
+	return *p.Note
+}
+
+var fieldIDToName_SubmitKolOrderDeliverableReq = map[int16]string{
+	1: "order_id",
+	2: "video_urls",
+	3: "file_urls",
+	4: "note",
+}
+
+func (p *SubmitKolOrderDeliverableReq) IsSetNote() bool {
+	return p.Note != nil
+}
+
+func (p *SubmitKolOrderDeliverableReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitKolOrderDeliverableReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+func (p *SubmitKolOrderDeliverableReq) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.VideoUrls = _field
+	return nil
+}
+func (p *SubmitKolOrderDeliverableReq) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FileUrls = _field
+	return nil
+}
+func (p *SubmitKolOrderDeliverableReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Note = _field
+	return nil
+}
+
+func (p *SubmitKolOrderDeliverableReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitKolOrderDeliverableReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_urls", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.VideoUrls)); err != nil {
+		return err
+	}
+	for _, v := range p.VideoUrls {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_urls", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.FileUrls)); err != nil {
+		return err
+	}
+	for _, v := range p.FileUrls {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNote() {
+		if err = oprot.WriteFieldBegin("note", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Note); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitKolOrderDeliverableReq(%+v)", *p)
+
+}
+
+// 提交交付内容响应
+type SubmitKolOrderDeliverableResp struct {
+	BaseResp      *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	DeliverableID *int64           `thrift:"deliverable_id,2,optional" form:"deliverable_id" json:"deliverable_id,omitempty" query:"deliverable_id"`
+}
+
+func NewSubmitKolOrderDeliverableResp() *SubmitKolOrderDeliverableResp {
+	return &SubmitKolOrderDeliverableResp{}
+}
+
+func (p *SubmitKolOrderDeliverableResp) InitDefault() {
+}
+
+var SubmitKolOrderDeliverableResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SubmitKolOrderDeliverableResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SubmitKolOrderDeliverableResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var SubmitKolOrderDeliverableResp_DeliverableID_DEFAULT int64
+
+func (p *SubmitKolOrderDeliverableResp) GetDeliverableID() (v int64) {
+	if !p.IsSetDeliverableID() {
+		return SubmitKolOrderDeliverableResp_DeliverableID_DEFAULT
+	}
+	return *p.DeliverableID
+}
+
+var fieldIDToName_SubmitKolOrderDeliverableResp = map[int16]string{
+	1: "base_resp",
+	2: "deliverable_id",
+}
+
+func (p *SubmitKolOrderDeliverableResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SubmitKolOrderDeliverableResp) IsSetDeliverableID() bool {
+	return p.DeliverableID != nil
+}
+
+func (p *SubmitKolOrderDeliverableResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitKolOrderDeliverableResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *SubmitKolOrderDeliverableResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeliverableID = _field
+	return nil
+}
+
+func (p *SubmitKolOrderDeliverableResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitKolOrderDeliverableResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeliverableID() {
+		if err = oprot.WriteFieldBegin("deliverable_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeliverableID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SubmitKolOrderDeliverableResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitKolOrderDeliverableResp(%+v)", *p)
+
+}
+
+// 获取订单交付内容请求（下单用户或KOL使用）
+type GetKolOrderDeliverablesReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+}
+
+func NewGetKolOrderDeliverablesReq() *GetKolOrderDeliverablesReq {
+	return &GetKolOrderDeliverablesReq{}
+}
+
+func (p *GetKolOrderDeliverablesReq) InitDefault() {
+}
+
+func (p *GetKolOrderDeliverablesReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+var fieldIDToName_GetKolOrderDeliverablesReq = map[int16]string{
+	1: "order_id",
+}
+
+func (p *GetKolOrderDeliverablesReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolOrderDeliverablesReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+
+func (p *GetKolOrderDeliverablesReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrderDeliverablesReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolOrderDeliverablesReq(%+v)", *p)
+
+}
+
+// 获取订单交付内容响应
+type GetKolOrderDeliverablesResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+	// 按提交轮次升序
+	Deliverables []*KolOrderDeliverableInfo `thrift:"deliverables,2,default,list<KolOrderDeliverableInfo>" form:"deliverables" json:"deliverables" query:"deliverables"`
+}
+
+func NewGetKolOrderDeliverablesResp() *GetKolOrderDeliverablesResp {
+	return &GetKolOrderDeliverablesResp{}
+}
+
+func (p *GetKolOrderDeliverablesResp) InitDefault() {
+}
+
+var GetKolOrderDeliverablesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetKolOrderDeliverablesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetKolOrderDeliverablesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetKolOrderDeliverablesResp) GetDeliverables() (v []*KolOrderDeliverableInfo) {
+	return p.Deliverables
+}
+
+var fieldIDToName_GetKolOrderDeliverablesResp = map[int16]string{
+	1: "base_resp",
+	2: "deliverables",
+}
+
+func (p *GetKolOrderDeliverablesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetKolOrderDeliverablesResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetKolOrderDeliverablesResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetKolOrderDeliverablesResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*KolOrderDeliverableInfo, 0, size)
+	values := make([]KolOrderDeliverableInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Deliverables = _field
+	return nil
+}
+
+func (p *GetKolOrderDeliverablesResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrderDeliverablesResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deliverables", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Deliverables)); err != nil {
+		return err
+	}
+	for _, v := range p.Deliverables {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetKolOrderDeliverablesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetKolOrderDeliverablesResp(%+v)", *p)
+
+}
+
+// 验收交付内容请求（下单用户使用，验收后订单完成并结算给KOL）
+type AcceptKolOrderDeliverableReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+}
+
+func NewAcceptKolOrderDeliverableReq() *AcceptKolOrderDeliverableReq {
+	return &AcceptKolOrderDeliverableReq{}
+}
+
+func (p *AcceptKolOrderDeliverableReq) InitDefault() {
+}
+
+func (p *AcceptKolOrderDeliverableReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+var fieldIDToName_AcceptKolOrderDeliverableReq = map[int16]string{
+	1: "order_id",
+}
+
+func (p *AcceptKolOrderDeliverableReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AcceptKolOrderDeliverableReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AcceptKolOrderDeliverableReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+
+func (p *AcceptKolOrderDeliverableReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AcceptKolOrderDeliverableReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AcceptKolOrderDeliverableReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AcceptKolOrderDeliverableReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AcceptKolOrderDeliverableReq(%+v)", *p)
+
+}
+
+// 验收交付内容响应
+type AcceptKolOrderDeliverableResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewAcceptKolOrderDeliverableResp() *AcceptKolOrderDeliverableResp {
+	return &AcceptKolOrderDeliverableResp{}
+}
+
+func (p *AcceptKolOrderDeliverableResp) InitDefault() {
+}
+
+var AcceptKolOrderDeliverableResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AcceptKolOrderDeliverableResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AcceptKolOrderDeliverableResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AcceptKolOrderDeliverableResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AcceptKolOrderDeliverableResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AcceptKolOrderDeliverableResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AcceptKolOrderDeliverableResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AcceptKolOrderDeliverableResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AcceptKolOrderDeliverableResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AcceptKolOrderDeliverableResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AcceptKolOrderDeliverableResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AcceptKolOrderDeliverableResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AcceptKolOrderDeliverableResp(%+v)", *p)
+
+}
+
+// 申请修改请求（下单用户使用，次数受Plan限制，订单回到进行中）
+type RequestKolOrderRevisionReq struct {
+	OrderID string `thrift:"order_id,1" form:"order_id" json:"order_id"`
+	// 修改意见，最多2000字
+	Feedback string `thrift:"feedback,2" form:"feedback" json:"feedback"`
+}
+
+func NewRequestKolOrderRevisionReq() *RequestKolOrderRevisionReq {
+	return &RequestKolOrderRevisionReq{}
+}
+
+func (p *RequestKolOrderRevisionReq) InitDefault() {
+}
+
+func (p *RequestKolOrderRevisionReq) GetOrderID() (v string) {
+	return p.OrderID
+}
+
+func (p *RequestKolOrderRevisionReq) GetFeedback() (v string) {
+	return p.Feedback
+}
+
+var fieldIDToName_RequestKolOrderRevisionReq = map[int16]string{
+	1: "order_id",
+	2: "feedback",
+}
+
+func (p *RequestKolOrderRevisionReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RequestKolOrderRevisionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OrderID = _field
+	return nil
+}
+func (p *RequestKolOrderRevisionReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Feedback = _field
+	return nil
+}
+
+func (p *RequestKolOrderRevisionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RequestKolOrderRevisionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("feedback", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Feedback); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RequestKolOrderRevisionReq(%+v)", *p)
+
+}
+
+// 申请修改响应
+type RequestKolOrderRevisionResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewRequestKolOrderRevisionResp() *RequestKolOrderRevisionResp {
+	return &RequestKolOrderRevisionResp{}
+}
+
+func (p *RequestKolOrderRevisionResp) InitDefault() {
+}
+
+var RequestKolOrderRevisionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RequestKolOrderRevisionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RequestKolOrderRevisionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_RequestKolOrderRevisionResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *RequestKolOrderRevisionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RequestKolOrderRevisionResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RequestKolOrderRevisionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RequestKolOrderRevisionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RequestKolOrderRevisionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RequestKolOrderRevisionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RequestKolOrderRevisionResp(%+v)", *p)
+
+}
+
+// KOL订单服务
+type KolOrderService interface {
+	// 用户订单管理
+	CreateKolOrder(ctx context.Context, req *CreateKolOrderReq) (r *CreateKolOrderResp, err error)
+
+	GetKolOrder(ctx context.Context, req *GetKolOrderReq) (r *GetKolOrderResp, err error)
+
+	GetUserKolOrderList(ctx context.Context, req *GetUserKolOrderListReq) (r *GetUserKolOrderListResp, err error)
+
+	CancelKolOrder(ctx context.Context, req *CancelKolOrderReq) (r *CancelKolOrderResp, err error)
+
+	ConfirmKolOrderPayment(ctx context.Context, req *ConfirmKolOrderPaymentReq) (r *ConfirmKolOrderPaymentResp, err error)
+	// KOL订单管理
+	GetKolReceivedOrderList(ctx context.Context, req *GetKolReceivedOrderListReq) (r *GetKolReceivedOrderListResp, err error)
+
+	UpdateKolOrderStatus(ctx context.Context, req *UpdateKolOrderStatusReq) (r *UpdateKolOrderStatusResp, err error)
+	// 订单交付验收
+	SubmitKolOrderDeliverable(ctx context.Context, req *SubmitKolOrderDeliverableReq) (r *SubmitKolOrderDeliverableResp, err error)
+
+	GetKolOrderDeliverables(ctx context.Context, req *GetKolOrderDeliverablesReq) (r *GetKolOrderDeliverablesResp, err error)
+
+	AcceptKolOrderDeliverable(ctx context.Context, req *AcceptKolOrderDeliverableReq) (r *AcceptKolOrderDeliverableResp, err error)
+
+	RequestKolOrderRevision(ctx context.Context, req *RequestKolOrderRevisionReq) (r *RequestKolOrderRevisionResp, err error)
+	// 订单评价
+	CreateKolOrderReview(ctx context.Context, req *CreateKolOrderReviewReq) (r *CreateKolOrderReviewResp, err error)
+
+	ReplyKolReview(ctx context.Context, req *ReplyKolReviewReq) (r *ReplyKolReviewResp, err error)
+
+	GetKolReviewList(ctx context.Context, req *GetKolReviewListReq) (r *GetKolReviewListResp, err error)
+	// 管理员订单管理
+	AdminRefundKolOrder(ctx context.Context, req *AdminRefundKolOrderReq) (r *AdminRefundKolOrderResp, err error)
+
+	AdminGetKolReviewList(ctx context.Context, req *AdminGetKolReviewListReq) (r *AdminGetKolReviewListResp, err error)
+
+	AdminModerateKolReview(ctx context.Context, req *AdminModerateKolReviewReq) (r *AdminModerateKolReviewResp, err error)
+}
+
+type KolOrderServiceClient struct {
+	c thrift.TClient
+}
+
+func NewKolOrderServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *KolOrderServiceClient {
+	return &KolOrderServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewKolOrderServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *KolOrderServiceClient {
+	return &KolOrderServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewKolOrderServiceClient(c thrift.TClient) *KolOrderServiceClient {
+	return &KolOrderServiceClient{
+		c: c,
+	}
+}
+
+func (p *KolOrderServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *KolOrderServiceClient) CreateKolOrder(ctx context.Context, req *CreateKolOrderReq) (r *CreateKolOrderResp, err error) {
+	var _args KolOrderServiceCreateKolOrderArgs
+	_args.Req = req
+	var _result KolOrderServiceCreateKolOrderResult
+	if err = p.Client_().Call(ctx, "CreateKolOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) GetKolOrder(ctx context.Context, req *GetKolOrderReq) (r *GetKolOrderResp, err error) {
+	var _args KolOrderServiceGetKolOrderArgs
+	_args.Req = req
+	var _result KolOrderServiceGetKolOrderResult
+	if err = p.Client_().Call(ctx, "GetKolOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) GetUserKolOrderList(ctx context.Context, req *GetUserKolOrderListReq) (r *GetUserKolOrderListResp, err error) {
+	var _args KolOrderServiceGetUserKolOrderListArgs
+	_args.Req = req
+	var _result KolOrderServiceGetUserKolOrderListResult
+	if err = p.Client_().Call(ctx, "GetUserKolOrderList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) CancelKolOrder(ctx context.Context, req *CancelKolOrderReq) (r *CancelKolOrderResp, err error) {
+	var _args KolOrderServiceCancelKolOrderArgs
+	_args.Req = req
+	var _result KolOrderServiceCancelKolOrderResult
+	if err = p.Client_().Call(ctx, "CancelKolOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) ConfirmKolOrderPayment(ctx context.Context, req *ConfirmKolOrderPaymentReq) (r *ConfirmKolOrderPaymentResp, err error) {
+	var _args KolOrderServiceConfirmKolOrderPaymentArgs
+	_args.Req = req
+	var _result KolOrderServiceConfirmKolOrderPaymentResult
+	if err = p.Client_().Call(ctx, "ConfirmKolOrderPayment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) GetKolReceivedOrderList(ctx context.Context, req *GetKolReceivedOrderListReq) (r *GetKolReceivedOrderListResp, err error) {
+	var _args KolOrderServiceGetKolReceivedOrderListArgs
+	_args.Req = req
+	var _result KolOrderServiceGetKolReceivedOrderListResult
+	if err = p.Client_().Call(ctx, "GetKolReceivedOrderList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) UpdateKolOrderStatus(ctx context.Context, req *UpdateKolOrderStatusReq) (r *UpdateKolOrderStatusResp, err error) {
+	var _args KolOrderServiceUpdateKolOrderStatusArgs
+	_args.Req = req
+	var _result KolOrderServiceUpdateKolOrderStatusResult
+	if err = p.Client_().Call(ctx, "UpdateKolOrderStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) SubmitKolOrderDeliverable(ctx context.Context, req *SubmitKolOrderDeliverableReq) (r *SubmitKolOrderDeliverableResp, err error) {
+	var _args KolOrderServiceSubmitKolOrderDeliverableArgs
+	_args.Req = req
+	var _result KolOrderServiceSubmitKolOrderDeliverableResult
+	if err = p.Client_().Call(ctx, "SubmitKolOrderDeliverable", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) GetKolOrderDeliverables(ctx context.Context, req *GetKolOrderDeliverablesReq) (r *GetKolOrderDeliverablesResp, err error) {
+	var _args KolOrderServiceGetKolOrderDeliverablesArgs
+	_args.Req = req
+	var _result KolOrderServiceGetKolOrderDeliverablesResult
+	if err = p.Client_().Call(ctx, "GetKolOrderDeliverables", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) AcceptKolOrderDeliverable(ctx context.Context, req *AcceptKolOrderDeliverableReq) (r *AcceptKolOrderDeliverableResp, err error) {
+	var _args KolOrderServiceAcceptKolOrderDeliverableArgs
+	_args.Req = req
+	var _result KolOrderServiceAcceptKolOrderDeliverableResult
+	if err = p.Client_().Call(ctx, "AcceptKolOrderDeliverable", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) RequestKolOrderRevision(ctx context.Context, req *RequestKolOrderRevisionReq) (r *RequestKolOrderRevisionResp, err error) {
+	var _args KolOrderServiceRequestKolOrderRevisionArgs
+	_args.Req = req
+	var _result KolOrderServiceRequestKolOrderRevisionResult
+	if err = p.Client_().Call(ctx, "RequestKolOrderRevision", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) CreateKolOrderReview(ctx context.Context, req *CreateKolOrderReviewReq) (r *CreateKolOrderReviewResp, err error) {
+	var _args KolOrderServiceCreateKolOrderReviewArgs
+	_args.Req = req
+	var _result KolOrderServiceCreateKolOrderReviewResult
+	if err = p.Client_().Call(ctx, "CreateKolOrderReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) ReplyKolReview(ctx context.Context, req *ReplyKolReviewReq) (r *ReplyKolReviewResp, err error) {
+	var _args KolOrderServiceReplyKolReviewArgs
+	_args.Req = req
+	var _result KolOrderServiceReplyKolReviewResult
+	if err = p.Client_().Call(ctx, "ReplyKolReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) GetKolReviewList(ctx context.Context, req *GetKolReviewListReq) (r *GetKolReviewListResp, err error) {
+	var _args KolOrderServiceGetKolReviewListArgs
+	_args.Req = req
+	var _result KolOrderServiceGetKolReviewListResult
+	if err = p.Client_().Call(ctx, "GetKolReviewList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) AdminRefundKolOrder(ctx context.Context, req *AdminRefundKolOrderReq) (r *AdminRefundKolOrderResp, err error) {
+	var _args KolOrderServiceAdminRefundKolOrderArgs
+	_args.Req = req
+	var _result KolOrderServiceAdminRefundKolOrderResult
+	if err = p.Client_().Call(ctx, "AdminRefundKolOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) AdminGetKolReviewList(ctx context.Context, req *AdminGetKolReviewListReq) (r *AdminGetKolReviewListResp, err error) {
+	var _args KolOrderServiceAdminGetKolReviewListArgs
+	_args.Req = req
+	var _result KolOrderServiceAdminGetKolReviewListResult
+	if err = p.Client_().Call(ctx, "AdminGetKolReviewList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *KolOrderServiceClient) AdminModerateKolReview(ctx context.Context, req *AdminModerateKolReviewReq) (r *AdminModerateKolReviewResp, err error) {
+	var _args KolOrderServiceAdminModerateKolReviewArgs
+	_args.Req = req
+	var _result KolOrderServiceAdminModerateKolReviewResult
+	if err = p.Client_().Call(ctx, "AdminModerateKolReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type KolOrderServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      KolOrderService
+}
+
+func (p *KolOrderServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *KolOrderServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *KolOrderServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewKolOrderServiceProcessor(handler KolOrderService) *KolOrderServiceProcessor {
+	self := &KolOrderServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreateKolOrder", &kolOrderServiceProcessorCreateKolOrder{handler: handler})
+	self.AddToProcessorMap("GetKolOrder", &kolOrderServiceProcessorGetKolOrder{handler: handler})
+	self.AddToProcessorMap("GetUserKolOrderList", &kolOrderServiceProcessorGetUserKolOrderList{handler: handler})
+	self.AddToProcessorMap("CancelKolOrder", &kolOrderServiceProcessorCancelKolOrder{handler: handler})
+	self.AddToProcessorMap("ConfirmKolOrderPayment", &kolOrderServiceProcessorConfirmKolOrderPayment{handler: handler})
+	self.AddToProcessorMap("GetKolReceivedOrderList", &kolOrderServiceProcessorGetKolReceivedOrderList{handler: handler})
+	self.AddToProcessorMap("UpdateKolOrderStatus", &kolOrderServiceProcessorUpdateKolOrderStatus{handler: handler})
+	self.AddToProcessorMap("SubmitKolOrderDeliverable", &kolOrderServiceProcessorSubmitKolOrderDeliverable{handler: handler})
+	self.AddToProcessorMap("GetKolOrderDeliverables", &kolOrderServiceProcessorGetKolOrderDeliverables{handler: handler})
+	self.AddToProcessorMap("AcceptKolOrderDeliverable", &kolOrderServiceProcessorAcceptKolOrderDeliverable{handler: handler})
+	self.AddToProcessorMap("RequestKolOrderRevision", &kolOrderServiceProcessorRequestKolOrderRevision{handler: handler})
+	self.AddToProcessorMap("CreateKolOrderReview", &kolOrderServiceProcessorCreateKolOrderReview{handler: handler})
+	self.AddToProcessorMap("ReplyKolReview", &kolOrderServiceProcessorReplyKolReview{handler: handler})
+	self.AddToProcessorMap("GetKolReviewList", &kolOrderServiceProcessorGetKolReviewList{handler: handler})
+	self.AddToProcessorMap("AdminRefundKolOrder", &kolOrderServiceProcessorAdminRefundKolOrder{handler: handler})
+	self.AddToProcessorMap("AdminGetKolReviewList", &kolOrderServiceProcessorAdminGetKolReviewList{handler: handler})
+	self.AddToProcessorMap("AdminModerateKolReview", &kolOrderServiceProcessorAdminModerateKolReview{handler: handler})
+	return self
+}
+func (p *KolOrderServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type kolOrderServiceProcessorCreateKolOrder struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorCreateKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceCreateKolOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceCreateKolOrderResult{}
+	var retval *CreateKolOrderResp
+	if retval, err2 = p.handler.CreateKolOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateKolOrder: "+err2.Error())
+		oprot.WriteMessageBegin("CreateKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateKolOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorGetKolOrder struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorGetKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceGetKolOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceGetKolOrderResult{}
+	var retval *GetKolOrderResp
+	if retval, err2 = p.handler.GetKolOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolOrder: "+err2.Error())
+		oprot.WriteMessageBegin("GetKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetKolOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorGetUserKolOrderList struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorGetUserKolOrderList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceGetUserKolOrderListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserKolOrderList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceGetUserKolOrderListResult{}
+	var retval *GetUserKolOrderListResp
+	if retval, err2 = p.handler.GetUserKolOrderList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserKolOrderList: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserKolOrderList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserKolOrderList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorCancelKolOrder struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorCancelKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceCancelKolOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CancelKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceCancelKolOrderResult{}
+	var retval *CancelKolOrderResp
+	if retval, err2 = p.handler.CancelKolOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CancelKolOrder: "+err2.Error())
+		oprot.WriteMessageBegin("CancelKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CancelKolOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorConfirmKolOrderPayment struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorConfirmKolOrderPayment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceConfirmKolOrderPaymentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ConfirmKolOrderPayment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceConfirmKolOrderPaymentResult{}
+	var retval *ConfirmKolOrderPaymentResp
+	if retval, err2 = p.handler.ConfirmKolOrderPayment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ConfirmKolOrderPayment: "+err2.Error())
+		oprot.WriteMessageBegin("ConfirmKolOrderPayment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ConfirmKolOrderPayment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorGetKolReceivedOrderList struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorGetKolReceivedOrderList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceGetKolReceivedOrderListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetKolReceivedOrderList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceGetKolReceivedOrderListResult{}
+	var retval *GetKolReceivedOrderListResp
+	if retval, err2 = p.handler.GetKolReceivedOrderList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolReceivedOrderList: "+err2.Error())
+		oprot.WriteMessageBegin("GetKolReceivedOrderList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetKolReceivedOrderList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorUpdateKolOrderStatus struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorUpdateKolOrderStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceUpdateKolOrderStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateKolOrderStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceUpdateKolOrderStatusResult{}
+	var retval *UpdateKolOrderStatusResp
+	if retval, err2 = p.handler.UpdateKolOrderStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateKolOrderStatus: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateKolOrderStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateKolOrderStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorSubmitKolOrderDeliverable struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorSubmitKolOrderDeliverable) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceSubmitKolOrderDeliverableArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SubmitKolOrderDeliverable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceSubmitKolOrderDeliverableResult{}
+	var retval *SubmitKolOrderDeliverableResp
+	if retval, err2 = p.handler.SubmitKolOrderDeliverable(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitKolOrderDeliverable: "+err2.Error())
+		oprot.WriteMessageBegin("SubmitKolOrderDeliverable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SubmitKolOrderDeliverable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorGetKolOrderDeliverables struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorGetKolOrderDeliverables) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceGetKolOrderDeliverablesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetKolOrderDeliverables", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceGetKolOrderDeliverablesResult{}
+	var retval *GetKolOrderDeliverablesResp
+	if retval, err2 = p.handler.GetKolOrderDeliverables(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolOrderDeliverables: "+err2.Error())
+		oprot.WriteMessageBegin("GetKolOrderDeliverables", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetKolOrderDeliverables", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorAcceptKolOrderDeliverable struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorAcceptKolOrderDeliverable) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceAcceptKolOrderDeliverableArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AcceptKolOrderDeliverable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceAcceptKolOrderDeliverableResult{}
+	var retval *AcceptKolOrderDeliverableResp
+	if retval, err2 = p.handler.AcceptKolOrderDeliverable(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AcceptKolOrderDeliverable: "+err2.Error())
+		oprot.WriteMessageBegin("AcceptKolOrderDeliverable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AcceptKolOrderDeliverable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorRequestKolOrderRevision struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorRequestKolOrderRevision) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceRequestKolOrderRevisionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RequestKolOrderRevision", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceRequestKolOrderRevisionResult{}
+	var retval *RequestKolOrderRevisionResp
+	if retval, err2 = p.handler.RequestKolOrderRevision(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestKolOrderRevision: "+err2.Error())
+		oprot.WriteMessageBegin("RequestKolOrderRevision", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RequestKolOrderRevision", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorCreateKolOrderReview struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorCreateKolOrderReview) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceCreateKolOrderReviewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateKolOrderReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceCreateKolOrderReviewResult{}
+	var retval *CreateKolOrderReviewResp
+	if retval, err2 = p.handler.CreateKolOrderReview(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateKolOrderReview: "+err2.Error())
+		oprot.WriteMessageBegin("CreateKolOrderReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateKolOrderReview", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorReplyKolReview struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorReplyKolReview) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceReplyKolReviewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReplyKolReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceReplyKolReviewResult{}
+	var retval *ReplyKolReviewResp
+	if retval, err2 = p.handler.ReplyKolReview(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReplyKolReview: "+err2.Error())
+		oprot.WriteMessageBegin("ReplyKolReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReplyKolReview", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorGetKolReviewList struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorGetKolReviewList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceGetKolReviewListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetKolReviewList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceGetKolReviewListResult{}
+	var retval *GetKolReviewListResp
+	if retval, err2 = p.handler.GetKolReviewList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolReviewList: "+err2.Error())
+		oprot.WriteMessageBegin("GetKolReviewList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetKolReviewList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorAdminRefundKolOrder struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorAdminRefundKolOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceAdminRefundKolOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminRefundKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceAdminRefundKolOrderResult{}
+	var retval *AdminRefundKolOrderResp
+	if retval, err2 = p.handler.AdminRefundKolOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminRefundKolOrder: "+err2.Error())
+		oprot.WriteMessageBegin("AdminRefundKolOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminRefundKolOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorAdminGetKolReviewList struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorAdminGetKolReviewList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceAdminGetKolReviewListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminGetKolReviewList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceAdminGetKolReviewListResult{}
+	var retval *AdminGetKolReviewListResp
+	if retval, err2 = p.handler.AdminGetKolReviewList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminGetKolReviewList: "+err2.Error())
+		oprot.WriteMessageBegin("AdminGetKolReviewList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminGetKolReviewList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type kolOrderServiceProcessorAdminModerateKolReview struct {
+	handler KolOrderService
+}
+
+func (p *kolOrderServiceProcessorAdminModerateKolReview) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := KolOrderServiceAdminModerateKolReviewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminModerateKolReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := KolOrderServiceAdminModerateKolReviewResult{}
+	var retval *AdminModerateKolReviewResp
+	if retval, err2 = p.handler.AdminModerateKolReview(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminModerateKolReview: "+err2.Error())
+		oprot.WriteMessageBegin("AdminModerateKolReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminModerateKolReview", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type KolOrderServiceCreateKolOrderArgs struct {
+	Req *CreateKolOrderReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceCreateKolOrderArgs() *KolOrderServiceCreateKolOrderArgs {
+	return &KolOrderServiceCreateKolOrderArgs{}
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) InitDefault() {
+}
+
+var KolOrderServiceCreateKolOrderArgs_Req_DEFAULT *CreateKolOrderReq
+
+func (p *KolOrderServiceCreateKolOrderArgs) GetReq() (v *CreateKolOrderReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceCreateKolOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceCreateKolOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCreateKolOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateKolOrderReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateKolOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceCreateKolOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceCreateKolOrderArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceCreateKolOrderResult struct {
+	Success *CreateKolOrderResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceCreateKolOrderResult() *KolOrderServiceCreateKolOrderResult {
+	return &KolOrderServiceCreateKolOrderResult{}
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) InitDefault() {
+}
+
+var KolOrderServiceCreateKolOrderResult_Success_DEFAULT *CreateKolOrderResp
+
+func (p *KolOrderServiceCreateKolOrderResult) GetSuccess() (v *CreateKolOrderResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceCreateKolOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceCreateKolOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCreateKolOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateKolOrderResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateKolOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceCreateKolOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceCreateKolOrderResult(%+v)", *p)
+
+}
+
+type KolOrderServiceGetKolOrderArgs struct {
+	Req *GetKolOrderReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceGetKolOrderArgs() *KolOrderServiceGetKolOrderArgs {
+	return &KolOrderServiceGetKolOrderArgs{}
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) InitDefault() {
+}
+
+var KolOrderServiceGetKolOrderArgs_Req_DEFAULT *GetKolOrderReq
+
+func (p *KolOrderServiceGetKolOrderArgs) GetReq() (v *GetKolOrderReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceGetKolOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceGetKolOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetKolOrderReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetKolOrderArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceGetKolOrderResult struct {
+	Success *GetKolOrderResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceGetKolOrderResult() *KolOrderServiceGetKolOrderResult {
+	return &KolOrderServiceGetKolOrderResult{}
+}
+
+func (p *KolOrderServiceGetKolOrderResult) InitDefault() {
+}
+
+var KolOrderServiceGetKolOrderResult_Success_DEFAULT *GetKolOrderResp
+
+func (p *KolOrderServiceGetKolOrderResult) GetSuccess() (v *GetKolOrderResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceGetKolOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceGetKolOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceGetKolOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceGetKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetKolOrderResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetKolOrderResult(%+v)", *p)
+
+}
+
+type KolOrderServiceGetUserKolOrderListArgs struct {
+	Req *GetUserKolOrderListReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceGetUserKolOrderListArgs() *KolOrderServiceGetUserKolOrderListArgs {
+	return &KolOrderServiceGetUserKolOrderListArgs{}
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) InitDefault() {
+}
+
+var KolOrderServiceGetUserKolOrderListArgs_Req_DEFAULT *GetUserKolOrderListReq
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) GetReq() (v *GetUserKolOrderListReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceGetUserKolOrderListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceGetUserKolOrderListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetUserKolOrderListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserKolOrderListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserKolOrderList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetUserKolOrderListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetUserKolOrderListArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceGetUserKolOrderListResult struct {
+	Success *GetUserKolOrderListResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceGetUserKolOrderListResult() *KolOrderServiceGetUserKolOrderListResult {
+	return &KolOrderServiceGetUserKolOrderListResult{}
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) InitDefault() {
+}
+
+var KolOrderServiceGetUserKolOrderListResult_Success_DEFAULT *GetUserKolOrderListResp
+
+func (p *KolOrderServiceGetUserKolOrderListResult) GetSuccess() (v *GetUserKolOrderListResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceGetUserKolOrderListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceGetUserKolOrderListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetUserKolOrderListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserKolOrderListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserKolOrderList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetUserKolOrderListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetUserKolOrderListResult(%+v)", *p)
+
+}
+
+type KolOrderServiceCancelKolOrderArgs struct {
+	Req *CancelKolOrderReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceCancelKolOrderArgs() *KolOrderServiceCancelKolOrderArgs {
+	return &KolOrderServiceCancelKolOrderArgs{}
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) InitDefault() {
+}
+
+var KolOrderServiceCancelKolOrderArgs_Req_DEFAULT *CancelKolOrderReq
+
+func (p *KolOrderServiceCancelKolOrderArgs) GetReq() (v *CancelKolOrderReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceCancelKolOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceCancelKolOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCancelKolOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCancelKolOrderReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelKolOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceCancelKolOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceCancelKolOrderArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceCancelKolOrderResult struct {
+	Success *CancelKolOrderResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceCancelKolOrderResult() *KolOrderServiceCancelKolOrderResult {
+	return &KolOrderServiceCancelKolOrderResult{}
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) InitDefault() {
+}
+
+var KolOrderServiceCancelKolOrderResult_Success_DEFAULT *CancelKolOrderResp
+
+func (p *KolOrderServiceCancelKolOrderResult) GetSuccess() (v *CancelKolOrderResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceCancelKolOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceCancelKolOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCancelKolOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCancelKolOrderResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelKolOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceCancelKolOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceCancelKolOrderResult(%+v)", *p)
+
+}
+
+type KolOrderServiceConfirmKolOrderPaymentArgs struct {
+	Req *ConfirmKolOrderPaymentReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceConfirmKolOrderPaymentArgs() *KolOrderServiceConfirmKolOrderPaymentArgs {
+	return &KolOrderServiceConfirmKolOrderPaymentArgs{}
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) InitDefault() {
+}
+
+var KolOrderServiceConfirmKolOrderPaymentArgs_Req_DEFAULT *ConfirmKolOrderPaymentReq
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) GetReq() (v *ConfirmKolOrderPaymentReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceConfirmKolOrderPaymentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceConfirmKolOrderPaymentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceConfirmKolOrderPaymentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewConfirmKolOrderPaymentReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmKolOrderPayment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceConfirmKolOrderPaymentArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceConfirmKolOrderPaymentResult struct {
+	Success *ConfirmKolOrderPaymentResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceConfirmKolOrderPaymentResult() *KolOrderServiceConfirmKolOrderPaymentResult {
+	return &KolOrderServiceConfirmKolOrderPaymentResult{}
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) InitDefault() {
+}
+
+var KolOrderServiceConfirmKolOrderPaymentResult_Success_DEFAULT *ConfirmKolOrderPaymentResp
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) GetSuccess() (v *ConfirmKolOrderPaymentResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceConfirmKolOrderPaymentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceConfirmKolOrderPaymentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceConfirmKolOrderPaymentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewConfirmKolOrderPaymentResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmKolOrderPayment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceConfirmKolOrderPaymentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceConfirmKolOrderPaymentResult(%+v)", *p)
+
+}
+
+type KolOrderServiceGetKolReceivedOrderListArgs struct {
+	Req *GetKolReceivedOrderListReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceGetKolReceivedOrderListArgs() *KolOrderServiceGetKolReceivedOrderListArgs {
+	return &KolOrderServiceGetKolReceivedOrderListArgs{}
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) InitDefault() {
+}
+
+var KolOrderServiceGetKolReceivedOrderListArgs_Req_DEFAULT *GetKolReceivedOrderListReq
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) GetReq() (v *GetKolReceivedOrderListReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceGetKolReceivedOrderListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceGetKolReceivedOrderListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolReceivedOrderListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetKolReceivedOrderListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolReceivedOrderList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetKolReceivedOrderListArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceGetKolReceivedOrderListResult struct {
+	Success *GetKolReceivedOrderListResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceGetKolReceivedOrderListResult() *KolOrderServiceGetKolReceivedOrderListResult {
+	return &KolOrderServiceGetKolReceivedOrderListResult{}
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) InitDefault() {
+}
+
+var KolOrderServiceGetKolReceivedOrderListResult_Success_DEFAULT *GetKolReceivedOrderListResp
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) GetSuccess() (v *GetKolReceivedOrderListResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceGetKolReceivedOrderListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceGetKolReceivedOrderListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolReceivedOrderListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetKolReceivedOrderListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolReceivedOrderList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolReceivedOrderListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetKolReceivedOrderListResult(%+v)", *p)
+
+}
+
+type KolOrderServiceUpdateKolOrderStatusArgs struct {
+	Req *UpdateKolOrderStatusReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceUpdateKolOrderStatusArgs() *KolOrderServiceUpdateKolOrderStatusArgs {
+	return &KolOrderServiceUpdateKolOrderStatusArgs{}
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) InitDefault() {
+}
+
+var KolOrderServiceUpdateKolOrderStatusArgs_Req_DEFAULT *UpdateKolOrderStatusReq
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) GetReq() (v *UpdateKolOrderStatusReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceUpdateKolOrderStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceUpdateKolOrderStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceUpdateKolOrderStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateKolOrderStatusReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateKolOrderStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceUpdateKolOrderStatusArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceUpdateKolOrderStatusResult struct {
+	Success *UpdateKolOrderStatusResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceUpdateKolOrderStatusResult() *KolOrderServiceUpdateKolOrderStatusResult {
+	return &KolOrderServiceUpdateKolOrderStatusResult{}
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) InitDefault() {
+}
+
+var KolOrderServiceUpdateKolOrderStatusResult_Success_DEFAULT *UpdateKolOrderStatusResp
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) GetSuccess() (v *UpdateKolOrderStatusResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceUpdateKolOrderStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceUpdateKolOrderStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceUpdateKolOrderStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateKolOrderStatusResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateKolOrderStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceUpdateKolOrderStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceUpdateKolOrderStatusResult(%+v)", *p)
+
+}
+
+type KolOrderServiceSubmitKolOrderDeliverableArgs struct {
+	Req *SubmitKolOrderDeliverableReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceSubmitKolOrderDeliverableArgs() *KolOrderServiceSubmitKolOrderDeliverableArgs {
+	return &KolOrderServiceSubmitKolOrderDeliverableArgs{}
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) InitDefault() {
+}
+
+var KolOrderServiceSubmitKolOrderDeliverableArgs_Req_DEFAULT *SubmitKolOrderDeliverableReq
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) GetReq() (v *SubmitKolOrderDeliverableReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceSubmitKolOrderDeliverableArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceSubmitKolOrderDeliverableArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceSubmitKolOrderDeliverableArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitKolOrderDeliverableReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitKolOrderDeliverable_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceSubmitKolOrderDeliverableArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceSubmitKolOrderDeliverableResult struct {
+	Success *SubmitKolOrderDeliverableResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceSubmitKolOrderDeliverableResult() *KolOrderServiceSubmitKolOrderDeliverableResult {
+	return &KolOrderServiceSubmitKolOrderDeliverableResult{}
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) InitDefault() {
+}
+
+var KolOrderServiceSubmitKolOrderDeliverableResult_Success_DEFAULT *SubmitKolOrderDeliverableResp
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) GetSuccess() (v *SubmitKolOrderDeliverableResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceSubmitKolOrderDeliverableResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceSubmitKolOrderDeliverableResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceSubmitKolOrderDeliverableResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitKolOrderDeliverableResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitKolOrderDeliverable_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceSubmitKolOrderDeliverableResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceSubmitKolOrderDeliverableResult(%+v)", *p)
+
+}
+
+type KolOrderServiceGetKolOrderDeliverablesArgs struct {
+	Req *GetKolOrderDeliverablesReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceGetKolOrderDeliverablesArgs() *KolOrderServiceGetKolOrderDeliverablesArgs {
+	return &KolOrderServiceGetKolOrderDeliverablesArgs{}
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) InitDefault() {
+}
+
+var KolOrderServiceGetKolOrderDeliverablesArgs_Req_DEFAULT *GetKolOrderDeliverablesReq
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) GetReq() (v *GetKolOrderDeliverablesReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceGetKolOrderDeliverablesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceGetKolOrderDeliverablesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolOrderDeliverablesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetKolOrderDeliverablesReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrderDeliverables_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetKolOrderDeliverablesArgs(%+v)", *p)
+
+}
+
+type KolOrderServiceGetKolOrderDeliverablesResult struct {
+	Success *GetKolOrderDeliverablesResp `thrift:"success,0,optional"`
+}
+
+func NewKolOrderServiceGetKolOrderDeliverablesResult() *KolOrderServiceGetKolOrderDeliverablesResult {
+	return &KolOrderServiceGetKolOrderDeliverablesResult{}
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) InitDefault() {
+}
+
+var KolOrderServiceGetKolOrderDeliverablesResult_Success_DEFAULT *GetKolOrderDeliverablesResp
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) GetSuccess() (v *GetKolOrderDeliverablesResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceGetKolOrderDeliverablesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_KolOrderServiceGetKolOrderDeliverablesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolOrderDeliverablesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetKolOrderDeliverablesResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetKolOrderDeliverables_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceGetKolOrderDeliverablesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KolOrderServiceGetKolOrderDeliverablesResult(%+v)", *p)
+
+}
+
+type KolOrderServiceAcceptKolOrderDeliverableArgs struct {
+	Req *AcceptKolOrderDeliverableReq `thrift:"req,1"`
+}
+
+func NewKolOrderServiceAcceptKolOrderDeliverableArgs() *KolOrderServiceAcceptKolOrderDeliverableArgs {
+	return &KolOrderServiceAcceptKolOrderDeliverableArgs{}
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) InitDefault() {
+}
+
+var KolOrderServiceAcceptKolOrderDeliverableArgs_Req_DEFAULT *AcceptKolOrderDeliverableReq
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) GetReq() (v *AcceptKolOrderDeliverableReq) {
+	if !p.IsSetReq() {
+		return KolOrderServiceAcceptKolOrderDeliverableArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_KolOrderServiceAcceptKolOrderDeliverableArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAcceptKolOrderDeliverableArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAcceptKolOrderDeliverableReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AcceptKolOrderDeliverable_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("KolOrderServiceAcceptKolOrderDeliverableArgs(%+v)", *p)
+
 }
 
-type kolOrderServiceProcessorConfirmKolOrderPayment struct {
-	handler KolOrderService
+type KolOrderServiceAcceptKolOrderDeliverableResult struct {
+	Success *AcceptKolOrderDeliverableResp `thrift:"success,0,optional"`
 }
 
-func (p *kolOrderServiceProcessorConfirmKolOrderPayment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceConfirmKolOrderPaymentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ConfirmKolOrderPayment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewKolOrderServiceAcceptKolOrderDeliverableResult() *KolOrderServiceAcceptKolOrderDeliverableResult {
+	return &KolOrderServiceAcceptKolOrderDeliverableResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceConfirmKolOrderPaymentResult{}
-	var retval *ConfirmKolOrderPaymentResp
-	if retval, err2 = p.handler.ConfirmKolOrderPayment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ConfirmKolOrderPayment: "+err2.Error())
-		oprot.WriteMessageBegin("ConfirmKolOrderPayment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ConfirmKolOrderPayment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) InitDefault() {
+}
+
+var KolOrderServiceAcceptKolOrderDeliverableResult_Success_DEFAULT *AcceptKolOrderDeliverableResp
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) GetSuccess() (v *AcceptKolOrderDeliverableResp) {
+	if !p.IsSetSuccess() {
+		return KolOrderServiceAcceptKolOrderDeliverableResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type kolOrderServiceProcessorGetKolReceivedOrderList struct {
-	handler KolOrderService
+var fieldIDToName_KolOrderServiceAcceptKolOrderDeliverableResult = map[int16]string{
+	0: "success",
 }
 
-func (p *kolOrderServiceProcessorGetKolReceivedOrderList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceGetKolReceivedOrderListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetKolReceivedOrderList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceGetKolReceivedOrderListResult{}
-	var retval *GetKolReceivedOrderListResp
-	if retval, err2 = p.handler.GetKolReceivedOrderList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetKolReceivedOrderList: "+err2.Error())
-		oprot.WriteMessageBegin("GetKolReceivedOrderList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetKolReceivedOrderList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type kolOrderServiceProcessorUpdateKolOrderStatus struct {
-	handler KolOrderService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAcceptKolOrderDeliverableResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *kolOrderServiceProcessorUpdateKolOrderStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := KolOrderServiceUpdateKolOrderStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateKolOrderStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAcceptKolOrderDeliverableResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := KolOrderServiceUpdateKolOrderStatusResult{}
-	var retval *UpdateKolOrderStatusResp
-	if retval, err2 = p.handler.UpdateKolOrderStatus(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateKolOrderStatus: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateKolOrderStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AcceptKolOrderDeliverable_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateKolOrderStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *KolOrderServiceAcceptKolOrderDeliverableResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("KolOrderServiceAcceptKolOrderDeliverableResult(%+v)", *p)
+
 }
 
-type KolOrderServiceCreateKolOrderArgs struct {
-	Req *CreateKolOrderReq `thrift:"req,1"`
+type KolOrderServiceRequestKolOrderRevisionArgs struct {
+	Req *RequestKolOrderRevisionReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceCreateKolOrderArgs() *KolOrderServiceCreateKolOrderArgs {
-	return &KolOrderServiceCreateKolOrderArgs{}
+func NewKolOrderServiceRequestKolOrderRevisionArgs() *KolOrderServiceRequestKolOrderRevisionArgs {
+	return &KolOrderServiceRequestKolOrderRevisionArgs{}
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) InitDefault() {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) InitDefault() {
 }
 
-var KolOrderServiceCreateKolOrderArgs_Req_DEFAULT *CreateKolOrderReq
+var KolOrderServiceRequestKolOrderRevisionArgs_Req_DEFAULT *RequestKolOrderRevisionReq
 
-func (p *KolOrderServiceCreateKolOrderArgs) GetReq() (v *CreateKolOrderReq) {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) GetReq() (v *RequestKolOrderRevisionReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceCreateKolOrderArgs_Req_DEFAULT
+		return KolOrderServiceRequestKolOrderRevisionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceCreateKolOrderArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceRequestKolOrderRevisionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) IsSetReq() bool {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5499,7 +15312,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCreateKolOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceRequestKolOrderRevisionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5509,8 +15322,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateKolOrderReq()
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRequestKolOrderRevisionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5518,9 +15331,9 @@ func (p *KolOrderServiceCreateKolOrderArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateKolOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("RequestKolOrderRevision_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5546,7 +15359,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5563,43 +15376,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceCreateKolOrderArgs) String() string {
+func (p *KolOrderServiceRequestKolOrderRevisionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceCreateKolOrderArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceRequestKolOrderRevisionArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceCreateKolOrderResult struct {
-	Success *CreateKolOrderResp `thrift:"success,0,optional"`
+type KolOrderServiceRequestKolOrderRevisionResult struct {
+	Success *RequestKolOrderRevisionResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceCreateKolOrderResult() *KolOrderServiceCreateKolOrderResult {
-	return &KolOrderServiceCreateKolOrderResult{}
+func NewKolOrderServiceRequestKolOrderRevisionResult() *KolOrderServiceRequestKolOrderRevisionResult {
+	return &KolOrderServiceRequestKolOrderRevisionResult{}
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) InitDefault() {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) InitDefault() {
 }
 
-var KolOrderServiceCreateKolOrderResult_Success_DEFAULT *CreateKolOrderResp
+var KolOrderServiceRequestKolOrderRevisionResult_Success_DEFAULT *RequestKolOrderRevisionResp
 
-func (p *KolOrderServiceCreateKolOrderResult) GetSuccess() (v *CreateKolOrderResp) {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) GetSuccess() (v *RequestKolOrderRevisionResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceCreateKolOrderResult_Success_DEFAULT
+		return KolOrderServiceRequestKolOrderRevisionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceCreateKolOrderResult = map[int16]string{
+var fieldIDToName_KolOrderServiceRequestKolOrderRevisionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) IsSetSuccess() bool {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5645,7 +15458,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCreateKolOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceRequestKolOrderRevisionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5655,8 +15468,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateKolOrderResp()
+func (p *KolOrderServiceRequestKolOrderRevisionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRequestKolOrderRevisionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5664,9 +15477,9 @@ func (p *KolOrderServiceCreateKolOrderResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateKolOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("RequestKolOrderRevision_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5692,7 +15505,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5711,43 +15524,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceCreateKolOrderResult) String() string {
+func (p *KolOrderServiceRequestKolOrderRevisionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceCreateKolOrderResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceRequestKolOrderRevisionResult(%+v)", *p)
 
 }
 
-type KolOrderServiceGetKolOrderArgs struct {
-	Req *GetKolOrderReq `thrift:"req,1"`
+type KolOrderServiceCreateKolOrderReviewArgs struct {
+	Req *CreateKolOrderReviewReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceGetKolOrderArgs() *KolOrderServiceGetKolOrderArgs {
-	return &KolOrderServiceGetKolOrderArgs{}
+func NewKolOrderServiceCreateKolOrderReviewArgs() *KolOrderServiceCreateKolOrderReviewArgs {
+	return &KolOrderServiceCreateKolOrderReviewArgs{}
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) InitDefault() {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) InitDefault() {
 }
 
-var KolOrderServiceGetKolOrderArgs_Req_DEFAULT *GetKolOrderReq
+var KolOrderServiceCreateKolOrderReviewArgs_Req_DEFAULT *CreateKolOrderReviewReq
 
-func (p *KolOrderServiceGetKolOrderArgs) GetReq() (v *GetKolOrderReq) {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) GetReq() (v *CreateKolOrderReviewReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceGetKolOrderArgs_Req_DEFAULT
+		return KolOrderServiceCreateKolOrderReviewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceGetKolOrderArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceCreateKolOrderReviewArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) IsSetReq() bool {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5793,7 +15606,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCreateKolOrderReviewArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5803,8 +15616,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetKolOrderReq()
+func (p *KolOrderServiceCreateKolOrderReviewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateKolOrderReviewReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5812,9 +15625,9 @@ func (p *KolOrderServiceGetKolOrderArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateKolOrderReview_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5840,7 +15653,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5857,43 +15670,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolOrderArgs) String() string {
+func (p *KolOrderServiceCreateKolOrderReviewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceGetKolOrderArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceCreateKolOrderReviewArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceGetKolOrderResult struct {
-	Success *GetKolOrderResp `thrift:"success,0,optional"`
+type KolOrderServiceCreateKolOrderReviewResult struct {
+	Success *CreateKolOrderReviewResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceGetKolOrderResult() *KolOrderServiceGetKolOrderResult {
-	return &KolOrderServiceGetKolOrderResult{}
+func NewKolOrderServiceCreateKolOrderReviewResult() *KolOrderServiceCreateKolOrderReviewResult {
+	return &KolOrderServiceCreateKolOrderReviewResult{}
 }
 
-func (p *KolOrderServiceGetKolOrderResult) InitDefault() {
+func (p *KolOrderServiceCreateKolOrderReviewResult) InitDefault() {
 }
 
-var KolOrderServiceGetKolOrderResult_Success_DEFAULT *GetKolOrderResp
+var KolOrderServiceCreateKolOrderReviewResult_Success_DEFAULT *CreateKolOrderReviewResp
 
-func (p *KolOrderServiceGetKolOrderResult) GetSuccess() (v *GetKolOrderResp) {
+func (p *KolOrderServiceCreateKolOrderReviewResult) GetSuccess() (v *CreateKolOrderReviewResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceGetKolOrderResult_Success_DEFAULT
+		return KolOrderServiceCreateKolOrderReviewResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceGetKolOrderResult = map[int16]string{
+var fieldIDToName_KolOrderServiceCreateKolOrderReviewResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceGetKolOrderResult) IsSetSuccess() bool {
+func (p *KolOrderServiceCreateKolOrderReviewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceGetKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceCreateKolOrderReviewResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5939,7 +15752,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCreateKolOrderReviewResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5949,8 +15762,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetKolOrderResp()
+func (p *KolOrderServiceCreateKolOrderReviewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateKolOrderReviewResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5958,9 +15771,9 @@ func (p *KolOrderServiceGetKolOrderResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *KolOrderServiceGetKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceCreateKolOrderReviewResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateKolOrderReview_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5986,7 +15799,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceCreateKolOrderReviewResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6005,43 +15818,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolOrderResult) String() string {
+func (p *KolOrderServiceCreateKolOrderReviewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceGetKolOrderResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceCreateKolOrderReviewResult(%+v)", *p)
 
 }
 
-type KolOrderServiceGetUserKolOrderListArgs struct {
-	Req *GetUserKolOrderListReq `thrift:"req,1"`
+type KolOrderServiceReplyKolReviewArgs struct {
+	Req *ReplyKolReviewReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceGetUserKolOrderListArgs() *KolOrderServiceGetUserKolOrderListArgs {
-	return &KolOrderServiceGetUserKolOrderListArgs{}
+func NewKolOrderServiceReplyKolReviewArgs() *KolOrderServiceReplyKolReviewArgs {
+	return &KolOrderServiceReplyKolReviewArgs{}
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) InitDefault() {
+func (p *KolOrderServiceReplyKolReviewArgs) InitDefault() {
 }
 
-var KolOrderServiceGetUserKolOrderListArgs_Req_DEFAULT *GetUserKolOrderListReq
+var KolOrderServiceReplyKolReviewArgs_Req_DEFAULT *ReplyKolReviewReq
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) GetReq() (v *GetUserKolOrderListReq) {
+func (p *KolOrderServiceReplyKolReviewArgs) GetReq() (v *ReplyKolReviewReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceGetUserKolOrderListArgs_Req_DEFAULT
+		return KolOrderServiceReplyKolReviewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceGetUserKolOrderListArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceReplyKolReviewArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) IsSetReq() bool {
+func (p *KolOrderServiceReplyKolReviewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceReplyKolReviewArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6087,7 +15900,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetUserKolOrderListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceReplyKolReviewArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6097,8 +15910,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserKolOrderListReq()
+func (p *KolOrderServiceReplyKolReviewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReplyKolReviewReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6106,9 +15919,9 @@ func (p *KolOrderServiceGetUserKolOrderListArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceReplyKolReviewArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserKolOrderList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ReplyKolReview_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6134,7 +15947,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceReplyKolReviewArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6151,43 +15964,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetUserKolOrderListArgs) String() string {
+func (p *KolOrderServiceReplyKolReviewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceGetUserKolOrderListArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceReplyKolReviewArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceGetUserKolOrderListResult struct {
-	Success *GetUserKolOrderListResp `thrift:"success,0,optional"`
+type KolOrderServiceReplyKolReviewResult struct {
+	Success *ReplyKolReviewResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceGetUserKolOrderListResult() *KolOrderServiceGetUserKolOrderListResult {
-	return &KolOrderServiceGetUserKolOrderListResult{}
+func NewKolOrderServiceReplyKolReviewResult() *KolOrderServiceReplyKolReviewResult {
+	return &KolOrderServiceReplyKolReviewResult{}
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) InitDefault() {
+func (p *KolOrderServiceReplyKolReviewResult) InitDefault() {
 }
 
-var KolOrderServiceGetUserKolOrderListResult_Success_DEFAULT *GetUserKolOrderListResp
+var KolOrderServiceReplyKolReviewResult_Success_DEFAULT *ReplyKolReviewResp
 
-func (p *KolOrderServiceGetUserKolOrderListResult) GetSuccess() (v *GetUserKolOrderListResp) {
+func (p *KolOrderServiceReplyKolReviewResult) GetSuccess() (v *ReplyKolReviewResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceGetUserKolOrderListResult_Success_DEFAULT
+		return KolOrderServiceReplyKolReviewResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceGetUserKolOrderListResult = map[int16]string{
+var fieldIDToName_KolOrderServiceReplyKolReviewResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) IsSetSuccess() bool {
+func (p *KolOrderServiceReplyKolReviewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceReplyKolReviewResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6233,7 +16046,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetUserKolOrderListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceReplyKolReviewResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6243,8 +16056,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserKolOrderListResp()
+func (p *KolOrderServiceReplyKolReviewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReplyKolReviewResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6252,9 +16065,9 @@ func (p *KolOrderServiceGetUserKolOrderListResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceReplyKolReviewResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserKolOrderList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ReplyKolReview_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6280,7 +16093,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceReplyKolReviewResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6299,43 +16112,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetUserKolOrderListResult) String() string {
+func (p *KolOrderServiceReplyKolReviewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceGetUserKolOrderListResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceReplyKolReviewResult(%+v)", *p)
 
 }
 
-type KolOrderServiceCancelKolOrderArgs struct {
-	Req *CancelKolOrderReq `thrift:"req,1"`
+type KolOrderServiceGetKolReviewListArgs struct {
+	Req *GetKolReviewListReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceCancelKolOrderArgs() *KolOrderServiceCancelKolOrderArgs {
-	return &KolOrderServiceCancelKolOrderArgs{}
+func NewKolOrderServiceGetKolReviewListArgs() *KolOrderServiceGetKolReviewListArgs {
+	return &KolOrderServiceGetKolReviewListArgs{}
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) InitDefault() {
+func (p *KolOrderServiceGetKolReviewListArgs) InitDefault() {
 }
 
-var KolOrderServiceCancelKolOrderArgs_Req_DEFAULT *CancelKolOrderReq
+var KolOrderServiceGetKolReviewListArgs_Req_DEFAULT *GetKolReviewListReq
 
-func (p *KolOrderServiceCancelKolOrderArgs) GetReq() (v *CancelKolOrderReq) {
+func (p *KolOrderServiceGetKolReviewListArgs) GetReq() (v *GetKolReviewListReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceCancelKolOrderArgs_Req_DEFAULT
+		return KolOrderServiceGetKolReviewListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceCancelKolOrderArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceGetKolReviewListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) IsSetReq() bool {
+func (p *KolOrderServiceGetKolReviewListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceGetKolReviewListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6381,7 +16194,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCancelKolOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolReviewListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6391,8 +16204,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCancelKolOrderReq()
+func (p *KolOrderServiceGetKolReviewListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetKolReviewListReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6400,9 +16213,9 @@ func (p *KolOrderServiceCancelKolOrderArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceGetKolReviewListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelKolOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolReviewList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6428,7 +16241,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceGetKolReviewListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6445,43 +16258,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceCancelKolOrderArgs) String() string {
+func (p *KolOrderServiceGetKolReviewListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceCancelKolOrderArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceGetKolReviewListArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceCancelKolOrderResult struct {
-	Success *CancelKolOrderResp `thrift:"success,0,optional"`
+type KolOrderServiceGetKolReviewListResult struct {
+	Success *GetKolReviewListResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceCancelKolOrderResult() *KolOrderServiceCancelKolOrderResult {
-	return &KolOrderServiceCancelKolOrderResult{}
+func NewKolOrderServiceGetKolReviewListResult() *KolOrderServiceGetKolReviewListResult {
+	return &KolOrderServiceGetKolReviewListResult{}
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) InitDefault() {
+func (p *KolOrderServiceGetKolReviewListResult) InitDefault() {
 }
 
-var KolOrderServiceCancelKolOrderResult_Success_DEFAULT *CancelKolOrderResp
+var KolOrderServiceGetKolReviewListResult_Success_DEFAULT *GetKolReviewListResp
 
-func (p *KolOrderServiceCancelKolOrderResult) GetSuccess() (v *CancelKolOrderResp) {
+func (p *KolOrderServiceGetKolReviewListResult) GetSuccess() (v *GetKolReviewListResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceCancelKolOrderResult_Success_DEFAULT
+		return KolOrderServiceGetKolReviewListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceCancelKolOrderResult = map[int16]string{
+var fieldIDToName_KolOrderServiceGetKolReviewListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) IsSetSuccess() bool {
+func (p *KolOrderServiceGetKolReviewListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceGetKolReviewListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6527,7 +16340,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceCancelKolOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolReviewListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6537,8 +16350,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCancelKolOrderResp()
+func (p *KolOrderServiceGetKolReviewListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetKolReviewListResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6546,9 +16359,9 @@ func (p *KolOrderServiceCancelKolOrderResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceGetKolReviewListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelKolOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetKolReviewList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6574,7 +16387,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceGetKolReviewListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6593,43 +16406,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceCancelKolOrderResult) String() string {
+func (p *KolOrderServiceGetKolReviewListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceCancelKolOrderResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceGetKolReviewListResult(%+v)", *p)
 
 }
 
-type KolOrderServiceConfirmKolOrderPaymentArgs struct {
-	Req *ConfirmKolOrderPaymentReq `thrift:"req,1"`
+type KolOrderServiceAdminRefundKolOrderArgs struct {
+	Req *AdminRefundKolOrderReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceConfirmKolOrderPaymentArgs() *KolOrderServiceConfirmKolOrderPaymentArgs {
-	return &KolOrderServiceConfirmKolOrderPaymentArgs{}
+func NewKolOrderServiceAdminRefundKolOrderArgs() *KolOrderServiceAdminRefundKolOrderArgs {
+	return &KolOrderServiceAdminRefundKolOrderArgs{}
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) InitDefault() {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) InitDefault() {
 }
 
-var KolOrderServiceConfirmKolOrderPaymentArgs_Req_DEFAULT *ConfirmKolOrderPaymentReq
+var KolOrderServiceAdminRefundKolOrderArgs_Req_DEFAULT *AdminRefundKolOrderReq
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) GetReq() (v *ConfirmKolOrderPaymentReq) {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) GetReq() (v *AdminRefundKolOrderReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceConfirmKolOrderPaymentArgs_Req_DEFAULT
+		return KolOrderServiceAdminRefundKolOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceConfirmKolOrderPaymentArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceAdminRefundKolOrderArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) IsSetReq() bool {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6675,7 +16488,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceConfirmKolOrderPaymentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAdminRefundKolOrderArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6685,8 +16498,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewConfirmKolOrderPaymentReq()
+func (p *KolOrderServiceAdminRefundKolOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminRefundKolOrderReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6694,9 +16507,9 @@ func (p *KolOrderServiceConfirmKolOrderPaymentArgs) ReadField1(iprot thrift.TPro
 	return nil
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConfirmKolOrderPayment_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRefundKolOrder_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6722,7 +16535,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6739,43 +16552,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentArgs) String() string {
+func (p *KolOrderServiceAdminRefundKolOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceConfirmKolOrderPaymentArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceAdminRefundKolOrderArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceConfirmKolOrderPaymentResult struct {
-	Success *ConfirmKolOrderPaymentResp `thrift:"success,0,optional"`
+type KolOrderServiceAdminRefundKolOrderResult struct {
+	Success *AdminRefundKolOrderResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceConfirmKolOrderPaymentResult() *KolOrderServiceConfirmKolOrderPaymentResult {
-	return &KolOrderServiceConfirmKolOrderPaymentResult{}
+func NewKolOrderServiceAdminRefundKolOrderResult() *KolOrderServiceAdminRefundKolOrderResult {
+	return &KolOrderServiceAdminRefundKolOrderResult{}
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) InitDefault() {
+func (p *KolOrderServiceAdminRefundKolOrderResult) InitDefault() {
 }
 
-var KolOrderServiceConfirmKolOrderPaymentResult_Success_DEFAULT *ConfirmKolOrderPaymentResp
+var KolOrderServiceAdminRefundKolOrderResult_Success_DEFAULT *AdminRefundKolOrderResp
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) GetSuccess() (v *ConfirmKolOrderPaymentResp) {
+func (p *KolOrderServiceAdminRefundKolOrderResult) GetSuccess() (v *AdminRefundKolOrderResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceConfirmKolOrderPaymentResult_Success_DEFAULT
+		return KolOrderServiceAdminRefundKolOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceConfirmKolOrderPaymentResult = map[int16]string{
+var fieldIDToName_KolOrderServiceAdminRefundKolOrderResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) IsSetSuccess() bool {
+func (p *KolOrderServiceAdminRefundKolOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminRefundKolOrderResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6821,7 +16634,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceConfirmKolOrderPaymentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAdminRefundKolOrderResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6831,8 +16644,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewConfirmKolOrderPaymentResp()
+func (p *KolOrderServiceAdminRefundKolOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminRefundKolOrderResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6840,9 +16653,9 @@ func (p *KolOrderServiceConfirmKolOrderPaymentResult) ReadField0(iprot thrift.TP
 	return nil
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminRefundKolOrderResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConfirmKolOrderPayment_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRefundKolOrder_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6868,7 +16681,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminRefundKolOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6887,43 +16700,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceConfirmKolOrderPaymentResult) String() string {
+func (p *KolOrderServiceAdminRefundKolOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceConfirmKolOrderPaymentResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceAdminRefundKolOrderResult(%+v)", *p)
 
 }
 
-type KolOrderServiceGetKolReceivedOrderListArgs struct {
-	Req *GetKolReceivedOrderListReq `thrift:"req,1"`
+type KolOrderServiceAdminGetKolReviewListArgs struct {
+	Req *AdminGetKolReviewListReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceGetKolReceivedOrderListArgs() *KolOrderServiceGetKolReceivedOrderListArgs {
-	return &KolOrderServiceGetKolReceivedOrderListArgs{}
+func NewKolOrderServiceAdminGetKolReviewListArgs() *KolOrderServiceAdminGetKolReviewListArgs {
+	return &KolOrderServiceAdminGetKolReviewListArgs{}
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) InitDefault() {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) InitDefault() {
 }
 
-var KolOrderServiceGetKolReceivedOrderListArgs_Req_DEFAULT *GetKolReceivedOrderListReq
+var KolOrderServiceAdminGetKolReviewListArgs_Req_DEFAULT *AdminGetKolReviewListReq
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) GetReq() (v *GetKolReceivedOrderListReq) {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) GetReq() (v *AdminGetKolReviewListReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceGetKolReceivedOrderListArgs_Req_DEFAULT
+		return KolOrderServiceAdminGetKolReviewListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceGetKolReceivedOrderListArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceAdminGetKolReviewListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) IsSetReq() bool {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6969,7 +16782,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolReceivedOrderListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAdminGetKolReviewListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6979,8 +16792,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetKolReceivedOrderListReq()
+func (p *KolOrderServiceAdminGetKolReviewListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminGetKolReviewListReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6988,9 +16801,9 @@ func (p *KolOrderServiceGetKolReceivedOrderListArgs) ReadField1(iprot thrift.TPr
 	return nil
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolReceivedOrderList_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminGetKolReviewList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7016,7 +16829,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7033,43 +16846,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListArgs) String() string {
+func (p *KolOrderServiceAdminGetKolReviewListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceGetKolReceivedOrderListArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceAdminGetKolReviewListArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceGetKolReceivedOrderListResult struct {
-	Success *GetKolReceivedOrderListResp `thrift:"success,0,optional"`
+type KolOrderServiceAdminGetKolReviewListResult struct {
+	Success *AdminGetKolReviewListResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceGetKolReceivedOrderListResult() *KolOrderServiceGetKolReceivedOrderListResult {
-	return &KolOrderServiceGetKolReceivedOrderListResult{}
+func NewKolOrderServiceAdminGetKolReviewListResult() *KolOrderServiceAdminGetKolReviewListResult {
+	return &KolOrderServiceAdminGetKolReviewListResult{}
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) InitDefault() {
+func (p *KolOrderServiceAdminGetKolReviewListResult) InitDefault() {
 }
 
-var KolOrderServiceGetKolReceivedOrderListResult_Success_DEFAULT *GetKolReceivedOrderListResp
+var KolOrderServiceAdminGetKolReviewListResult_Success_DEFAULT *AdminGetKolReviewListResp
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) GetSuccess() (v *GetKolReceivedOrderListResp) {
+func (p *KolOrderServiceAdminGetKolReviewListResult) GetSuccess() (v *AdminGetKolReviewListResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceGetKolReceivedOrderListResult_Success_DEFAULT
+		return KolOrderServiceAdminGetKolReviewListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceGetKolReceivedOrderListResult = map[int16]string{
+var fieldIDToName_KolOrderServiceAdminGetKolReviewListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) IsSetSuccess() bool {
+func (p *KolOrderServiceAdminGetKolReviewListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminGetKolReviewListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7115,7 +16928,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceGetKolReceivedOrderListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAdminGetKolReviewListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7125,8 +16938,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetKolReceivedOrderListResp()
+func (p *KolOrderServiceAdminGetKolReviewListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminGetKolReviewListResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7134,9 +16947,9 @@ func (p *KolOrderServiceGetKolReceivedOrderListResult) ReadField0(iprot thrift.T
 	return nil
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminGetKolReviewListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetKolReceivedOrderList_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminGetKolReviewList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7162,7 +16975,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminGetKolReviewListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7181,43 +16994,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceGetKolReceivedOrderListResult) String() string {
+func (p *KolOrderServiceAdminGetKolReviewListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceGetKolReceivedOrderListResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceAdminGetKolReviewListResult(%+v)", *p)
 
 }
 
-type KolOrderServiceUpdateKolOrderStatusArgs struct {
-	Req *UpdateKolOrderStatusReq `thrift:"req,1"`
+type KolOrderServiceAdminModerateKolReviewArgs struct {
+	Req *AdminModerateKolReviewReq `thrift:"req,1"`
 }
 
-func NewKolOrderServiceUpdateKolOrderStatusArgs() *KolOrderServiceUpdateKolOrderStatusArgs {
-	return &KolOrderServiceUpdateKolOrderStatusArgs{}
+func NewKolOrderServiceAdminModerateKolReviewArgs() *KolOrderServiceAdminModerateKolReviewArgs {
+	return &KolOrderServiceAdminModerateKolReviewArgs{}
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) InitDefault() {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) InitDefault() {
 }
 
-var KolOrderServiceUpdateKolOrderStatusArgs_Req_DEFAULT *UpdateKolOrderStatusReq
+var KolOrderServiceAdminModerateKolReviewArgs_Req_DEFAULT *AdminModerateKolReviewReq
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) GetReq() (v *UpdateKolOrderStatusReq) {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) GetReq() (v *AdminModerateKolReviewReq) {
 	if !p.IsSetReq() {
-		return KolOrderServiceUpdateKolOrderStatusArgs_Req_DEFAULT
+		return KolOrderServiceAdminModerateKolReviewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_KolOrderServiceUpdateKolOrderStatusArgs = map[int16]string{
+var fieldIDToName_KolOrderServiceAdminModerateKolReviewArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) IsSetReq() bool {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7263,7 +17076,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceUpdateKolOrderStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAdminModerateKolReviewArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7273,8 +17086,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateKolOrderStatusReq()
+func (p *KolOrderServiceAdminModerateKolReviewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminModerateKolReviewReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7282,9 +17095,9 @@ func (p *KolOrderServiceUpdateKolOrderStatusArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateKolOrderStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminModerateKolReview_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7310,7 +17123,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7327,43 +17140,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusArgs) String() string {
+func (p *KolOrderServiceAdminModerateKolReviewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceUpdateKolOrderStatusArgs(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceAdminModerateKolReviewArgs(%+v)", *p)
 
 }
 
-type KolOrderServiceUpdateKolOrderStatusResult struct {
-	Success *UpdateKolOrderStatusResp `thrift:"success,0,optional"`
+type KolOrderServiceAdminModerateKolReviewResult struct {
+	Success *AdminModerateKolReviewResp `thrift:"success,0,optional"`
 }
 
-func NewKolOrderServiceUpdateKolOrderStatusResult() *KolOrderServiceUpdateKolOrderStatusResult {
-	return &KolOrderServiceUpdateKolOrderStatusResult{}
+func NewKolOrderServiceAdminModerateKolReviewResult() *KolOrderServiceAdminModerateKolReviewResult {
+	return &KolOrderServiceAdminModerateKolReviewResult{}
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) InitDefault() {
+func (p *KolOrderServiceAdminModerateKolReviewResult) InitDefault() {
 }
 
-var KolOrderServiceUpdateKolOrderStatusResult_Success_DEFAULT *UpdateKolOrderStatusResp
+var KolOrderServiceAdminModerateKolReviewResult_Success_DEFAULT *AdminModerateKolReviewResp
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) GetSuccess() (v *UpdateKolOrderStatusResp) {
+func (p *KolOrderServiceAdminModerateKolReviewResult) GetSuccess() (v *AdminModerateKolReviewResp) {
 	if !p.IsSetSuccess() {
-		return KolOrderServiceUpdateKolOrderStatusResult_Success_DEFAULT
+		return KolOrderServiceAdminModerateKolReviewResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_KolOrderServiceUpdateKolOrderStatusResult = map[int16]string{
+var fieldIDToName_KolOrderServiceAdminModerateKolReviewResult = map[int16]string{
 	0: "success",
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) IsSetSuccess() bool {
+func (p *KolOrderServiceAdminModerateKolReviewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminModerateKolReviewResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7409,7 +17222,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceUpdateKolOrderStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KolOrderServiceAdminModerateKolReviewResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7419,8 +17232,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateKolOrderStatusResp()
+func (p *KolOrderServiceAdminModerateKolReviewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminModerateKolReviewResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7428,9 +17241,9 @@ func (p *KolOrderServiceUpdateKolOrderStatusResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminModerateKolReviewResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateKolOrderStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminModerateKolReview_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7456,7 +17269,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *KolOrderServiceAdminModerateKolReviewResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7475,10 +17288,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *KolOrderServiceUpdateKolOrderStatusResult) String() string {
+func (p *KolOrderServiceAdminModerateKolReviewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KolOrderServiceUpdateKolOrderStatusResult(%+v)", *p)
+	return fmt.Sprintf("KolOrderServiceAdminModerateKolReviewResult(%+v)", *p)
 
 }
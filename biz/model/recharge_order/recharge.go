@@ -151,6 +151,12 @@ type RechargeOrder struct {
 	Remark    *string `thrift:"remark,19,optional" form:"remark" json:"remark,omitempty" query:"remark"`
 	CreatedAt string  `thrift:"created_at,20" form:"created_at" json:"created_at" query:"created_at"`
 	UpdatedAt string  `thrift:"updated_at,21" form:"updated_at" json:"updated_at" query:"updated_at"`
+	// 链上校验状态：unverified, verified, flagged
+	VerifyStatus string `thrift:"verify_status,22" form:"verify_status" json:"verify_status" query:"verify_status"`
+	// 链上校验结果说明
+	VerifyMessage *string `thrift:"verify_message,23,optional" form:"verify_message" json:"verify_message,omitempty" query:"verify_message"`
+	// 最近一次链上校验时间
+	VerifiedAt *string `thrift:"verified_at,24,optional" form:"verified_at" json:"verified_at,omitempty" query:"verified_at"`
 }
 
 func NewRechargeOrder() *RechargeOrder {
@@ -309,6 +315,28 @@ func (p *RechargeOrder) GetUpdatedAt() (v string) {
 	return p.UpdatedAt
 }
 
+func (p *RechargeOrder) GetVerifyStatus() (v string) {
+	return p.VerifyStatus
+}
+
+var RechargeOrder_VerifyMessage_DEFAULT string
+
+func (p *RechargeOrder) GetVerifyMessage() (v string) {
+	if !p.IsSetVerifyMessage() {
+		return RechargeOrder_VerifyMessage_DEFAULT
+	}
+	return *p.VerifyMessage
+}
+
+var RechargeOrder_VerifiedAt_DEFAULT string
+
+func (p *RechargeOrder) GetVerifiedAt() (v string) {
+	if !p.IsSetVerifiedAt() {
+		return RechargeOrder_VerifiedAt_DEFAULT
+	}
+	return *p.VerifiedAt
+}
+
 var fieldIDToName_RechargeOrder = map[int16]string{
 	1:  "id",
 	2:  "order_id",
@@ -331,6 +359,9 @@ var fieldIDToName_RechargeOrder = map[int16]string{
 	19: "remark",
 	20: "created_at",
 	21: "updated_at",
+	22: "verify_status",
+	23: "verify_message",
+	24: "verified_at",
 }
 
 func (p *RechargeOrder) IsSetPaymentSettingID() bool {
@@ -385,6 +416,14 @@ func (p *RechargeOrder) IsSetRemark() bool {
 	return p.Remark != nil
 }
 
+func (p *RechargeOrder) IsSetVerifyMessage() bool {
+	return p.VerifyMessage != nil
+}
+
+func (p *RechargeOrder) IsSetVerifiedAt() bool {
+	return p.VerifiedAt != nil
+}
+
 func (p *RechargeOrder) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -572,6 +611,30 @@ func (p *RechargeOrder) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 22:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField22(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 23:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -832,6 +895,39 @@ func (p *RechargeOrder) ReadField21(iprot thrift.TProtocol) error {
 	p.UpdatedAt = _field
 	return nil
 }
+func (p *RechargeOrder) ReadField22(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VerifyStatus = _field
+	return nil
+}
+func (p *RechargeOrder) ReadField23(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VerifyMessage = _field
+	return nil
+}
+func (p *RechargeOrder) ReadField24(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VerifiedAt = _field
+	return nil
+}
 
 func (p *RechargeOrder) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -923,6 +1019,18 @@ func (p *RechargeOrder) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 21
 			goto WriteFieldError
 		}
+		if err = p.writeField22(oprot); err != nil {
+			fieldId = 22
+			goto WriteFieldError
+		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1324,6 +1432,61 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
 }
 
+func (p *RechargeOrder) writeField22(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verify_status", thrift.STRING, 22); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.VerifyStatus); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
+}
+
+func (p *RechargeOrder) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerifyMessage() {
+		if err = oprot.WriteFieldBegin("verify_message", thrift.STRING, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.VerifyMessage); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
+}
+
+func (p *RechargeOrder) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerifiedAt() {
+		if err = oprot.WriteFieldBegin("verified_at", thrift.STRING, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.VerifiedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
+
 func (p *RechargeOrder) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1911,7 +2074,7 @@ func (p *CreateRechargeOrderResp) String() string {
 type CreateOnlineRechargeOrderReq struct {
 	// 充值金额（美元）
 	Amount string `thrift:"amount,1,required" form:"amount,required" json:"amount,required"`
-	// 支付平台：stripe, paypal
+	// 支付平台：stripe, paypal, fake（仅本地联调）
 	Platform string `thrift:"platform,2,required" form:"platform,required" json:"platform,required"`
 }
 
@@ -2739,6 +2902,8 @@ type GetAllRechargeOrdersReq struct {
 	UserID   *int64 `thrift:"user_id,3,optional" form:"user_id" json:"user_id,omitempty"`
 	Page     *int32 `thrift:"page,4,optional" form:"page" json:"page,omitempty"`
 	PageSize *int32 `thrift:"page_size,5,optional" form:"page_size" json:"page_size,omitempty"`
+	// 链上校验状态筛选：unverified, verified, flagged
+	VerifyStatus *string `thrift:"verify_status,6,optional" form:"verify_status" json:"verify_status,omitempty"`
 }
 
 func NewGetAllRechargeOrdersReq() *GetAllRechargeOrdersReq {
@@ -2793,12 +2958,22 @@ func (p *GetAllRechargeOrdersReq) GetPageSize() (v int32) {
 	return *p.PageSize
 }
 
+var GetAllRechargeOrdersReq_VerifyStatus_DEFAULT string
+
+func (p *GetAllRechargeOrdersReq) GetVerifyStatus() (v string) {
+	if !p.IsSetVerifyStatus() {
+		return GetAllRechargeOrdersReq_VerifyStatus_DEFAULT
+	}
+	return *p.VerifyStatus
+}
+
 var fieldIDToName_GetAllRechargeOrdersReq = map[int16]string{
 	1: "status",
 	2: "payment_type",
 	3: "user_id",
 	4: "page",
 	5: "page_size",
+	6: "verify_status",
 }
 
 func (p *GetAllRechargeOrdersReq) IsSetStatus() bool {
@@ -2821,6 +2996,10 @@ func (p *GetAllRechargeOrdersReq) IsSetPageSize() bool {
 	return p.PageSize != nil
 }
 
+func (p *GetAllRechargeOrdersReq) IsSetVerifyStatus() bool {
+	return p.VerifyStatus != nil
+}
+
 func (p *GetAllRechargeOrdersReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -2880,6 +3059,14 @@ func (p *GetAllRechargeOrdersReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2964,6 +3151,17 @@ func (p *GetAllRechargeOrdersReq) ReadField5(iprot thrift.TProtocol) error {
 	p.PageSize = _field
 	return nil
 }
+func (p *GetAllRechargeOrdersReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VerifyStatus = _field
+	return nil
+}
 
 func (p *GetAllRechargeOrdersReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2991,6 +3189,10 @@ func (p *GetAllRechargeOrdersReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3104,6 +3306,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
+func (p *GetAllRechargeOrdersReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerifyStatus() {
+		if err = oprot.WriteFieldBegin("verify_status", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.VerifyStatus); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
 func (p *GetAllRechargeOrdersReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -4332,526 +4553,4667 @@ func (p *RejectRechargeOrderResp) String() string {
 
 }
 
-// 充值订单服务
-type RechargeOrderService interface {
-	// normal用户创建充值订单
-	CreateCryptoRechargeOrder(ctx context.Context, req *CreateCryptoRechargeOrderReq) (r *CreateRechargeOrderResp, err error)
+// admin触发链上校验请求
+type VerifyRechargeOrderReq struct {
+	OrderID string `thrift:"order_id,1,required" form:"order_id,required" json:"order_id,required"`
+}
 
-	CreateOnlineRechargeOrder(ctx context.Context, req *CreateOnlineRechargeOrderReq) (r *CreateRechargeOrderResp, err error)
-	// normal用户查询自己的充值订单
-	GetMyRechargeOrders(ctx context.Context, req *GetMyRechargeOrdersReq) (r *GetRechargeOrdersResp, err error)
+func NewVerifyRechargeOrderReq() *VerifyRechargeOrderReq {
+	return &VerifyRechargeOrderReq{}
+}
 
-	GetRechargeOrderDetail(ctx context.Context, req *GetRechargeOrderDetailReq) (r *GetRechargeOrderDetailResp, err error)
-	// admin查询所有充值订单
-	GetAllRechargeOrders(ctx context.Context, req *GetAllRechargeOrdersReq) (r *GetRechargeOrdersResp, err error)
-	// admin确认/拒绝充值订单
-	ConfirmRechargeOrder(ctx context.Context, req *ConfirmRechargeOrderReq) (r *ConfirmRechargeOrderResp, err error)
+func (p *VerifyRechargeOrderReq) InitDefault() {
+}
 
-	RejectRechargeOrder(ctx context.Context, req *RejectRechargeOrderReq) (r *RejectRechargeOrderResp, err error)
+func (p *VerifyRechargeOrderReq) GetOrderID() (v string) {
+	return p.OrderID
 }
 
-type RechargeOrderServiceClient struct {
-	c thrift.TClient
+var fieldIDToName_VerifyRechargeOrderReq = map[int16]string{
+	1: "order_id",
 }
 
-func NewRechargeOrderServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RechargeOrderServiceClient {
-	return &RechargeOrderServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *VerifyRechargeOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewRechargeOrderServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RechargeOrderServiceClient {
-	return &RechargeOrderServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewRechargeOrderServiceClient(c thrift.TClient) *RechargeOrderServiceClient {
-	return &RechargeOrderServiceClient{
-		c: c,
+	if !issetOrderID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyRechargeOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *RechargeOrderServiceClient) Client_() thrift.TClient {
-	return p.c
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_VerifyRechargeOrderReq[fieldId]))
 }
 
-func (p *RechargeOrderServiceClient) CreateCryptoRechargeOrder(ctx context.Context, req *CreateCryptoRechargeOrderReq) (r *CreateRechargeOrderResp, err error) {
-	var _args RechargeOrderServiceCreateCryptoRechargeOrderArgs
-	_args.Req = req
-	var _result RechargeOrderServiceCreateCryptoRechargeOrderResult
-	if err = p.Client_().Call(ctx, "CreateCryptoRechargeOrder", &_args, &_result); err != nil {
-		return
+func (p *VerifyRechargeOrderReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.OrderID = _field
+	return nil
 }
-func (p *RechargeOrderServiceClient) CreateOnlineRechargeOrder(ctx context.Context, req *CreateOnlineRechargeOrderReq) (r *CreateRechargeOrderResp, err error) {
-	var _args RechargeOrderServiceCreateOnlineRechargeOrderArgs
-	_args.Req = req
-	var _result RechargeOrderServiceCreateOnlineRechargeOrderResult
-	if err = p.Client_().Call(ctx, "CreateOnlineRechargeOrder", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *RechargeOrderServiceClient) GetMyRechargeOrders(ctx context.Context, req *GetMyRechargeOrdersReq) (r *GetRechargeOrdersResp, err error) {
-	var _args RechargeOrderServiceGetMyRechargeOrdersArgs
-	_args.Req = req
-	var _result RechargeOrderServiceGetMyRechargeOrdersResult
-	if err = p.Client_().Call(ctx, "GetMyRechargeOrders", &_args, &_result); err != nil {
-		return
+
+func (p *VerifyRechargeOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyRechargeOrderReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RechargeOrderServiceClient) GetRechargeOrderDetail(ctx context.Context, req *GetRechargeOrderDetailReq) (r *GetRechargeOrderDetailResp, err error) {
-	var _args RechargeOrderServiceGetRechargeOrderDetailArgs
-	_args.Req = req
-	var _result RechargeOrderServiceGetRechargeOrderDetailResult
-	if err = p.Client_().Call(ctx, "GetRechargeOrderDetail", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RechargeOrderServiceClient) GetAllRechargeOrders(ctx context.Context, req *GetAllRechargeOrdersReq) (r *GetRechargeOrdersResp, err error) {
-	var _args RechargeOrderServiceGetAllRechargeOrdersArgs
-	_args.Req = req
-	var _result RechargeOrderServiceGetAllRechargeOrdersResult
-	if err = p.Client_().Call(ctx, "GetAllRechargeOrders", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RechargeOrderServiceClient) ConfirmRechargeOrder(ctx context.Context, req *ConfirmRechargeOrderReq) (r *ConfirmRechargeOrderResp, err error) {
-	var _args RechargeOrderServiceConfirmRechargeOrderArgs
-	_args.Req = req
-	var _result RechargeOrderServiceConfirmRechargeOrderResult
-	if err = p.Client_().Call(ctx, "ConfirmRechargeOrder", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *RechargeOrderServiceClient) RejectRechargeOrder(ctx context.Context, req *RejectRechargeOrderReq) (r *RejectRechargeOrderResp, err error) {
-	var _args RechargeOrderServiceRejectRechargeOrderArgs
-	_args.Req = req
-	var _result RechargeOrderServiceRejectRechargeOrderResult
-	if err = p.Client_().Call(ctx, "RejectRechargeOrder", &_args, &_result); err != nil {
-		return
+
+func (p *VerifyRechargeOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteString(p.OrderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type RechargeOrderServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      RechargeOrderService
-}
+func (p *VerifyRechargeOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyRechargeOrderReq(%+v)", *p)
 
-func (p *RechargeOrderServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
 }
 
-func (p *RechargeOrderServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+// admin触发链上校验响应
+type VerifyRechargeOrderResp struct {
+	Order    *RechargeOrder   `thrift:"order,1,optional" form:"order" json:"order,omitempty" query:"order"`
+	BaseResp *common.BaseResp `thrift:"base_resp,2" form:"base_resp" json:"base_resp" query:"base_resp"`
 }
 
-func (p *RechargeOrderServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewVerifyRechargeOrderResp() *VerifyRechargeOrderResp {
+	return &VerifyRechargeOrderResp{}
 }
 
-func NewRechargeOrderServiceProcessor(handler RechargeOrderService) *RechargeOrderServiceProcessor {
-	self := &RechargeOrderServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CreateCryptoRechargeOrder", &rechargeOrderServiceProcessorCreateCryptoRechargeOrder{handler: handler})
-	self.AddToProcessorMap("CreateOnlineRechargeOrder", &rechargeOrderServiceProcessorCreateOnlineRechargeOrder{handler: handler})
-	self.AddToProcessorMap("GetMyRechargeOrders", &rechargeOrderServiceProcessorGetMyRechargeOrders{handler: handler})
-	self.AddToProcessorMap("GetRechargeOrderDetail", &rechargeOrderServiceProcessorGetRechargeOrderDetail{handler: handler})
-	self.AddToProcessorMap("GetAllRechargeOrders", &rechargeOrderServiceProcessorGetAllRechargeOrders{handler: handler})
-	self.AddToProcessorMap("ConfirmRechargeOrder", &rechargeOrderServiceProcessorConfirmRechargeOrder{handler: handler})
-	self.AddToProcessorMap("RejectRechargeOrder", &rechargeOrderServiceProcessorRejectRechargeOrder{handler: handler})
-	return self
-}
-func (p *RechargeOrderServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+func (p *VerifyRechargeOrderResp) InitDefault() {
 }
 
-type rechargeOrderServiceProcessorCreateCryptoRechargeOrder struct {
-	handler RechargeOrderService
-}
+var VerifyRechargeOrderResp_Order_DEFAULT *RechargeOrder
 
-func (p *rechargeOrderServiceProcessorCreateCryptoRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceCreateCryptoRechargeOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateCryptoRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VerifyRechargeOrderResp) GetOrder() (v *RechargeOrder) {
+	if !p.IsSetOrder() {
+		return VerifyRechargeOrderResp_Order_DEFAULT
 	}
+	return p.Order
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceCreateCryptoRechargeOrderResult{}
-	var retval *CreateRechargeOrderResp
-	if retval, err2 = p.handler.CreateCryptoRechargeOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateCryptoRechargeOrder: "+err2.Error())
-		oprot.WriteMessageBegin("CreateCryptoRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateCryptoRechargeOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+var VerifyRechargeOrderResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *VerifyRechargeOrderResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VerifyRechargeOrderResp_BaseResp_DEFAULT
 	}
-	return true, err
+	return p.BaseResp
 }
 
-type rechargeOrderServiceProcessorCreateOnlineRechargeOrder struct {
-	handler RechargeOrderService
+var fieldIDToName_VerifyRechargeOrderResp = map[int16]string{
+	1: "order",
+	2: "base_resp",
 }
 
-func (p *rechargeOrderServiceProcessorCreateOnlineRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceCreateOnlineRechargeOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateOnlineRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceCreateOnlineRechargeOrderResult{}
-	var retval *CreateRechargeOrderResp
-	if retval, err2 = p.handler.CreateOnlineRechargeOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateOnlineRechargeOrder: "+err2.Error())
-		oprot.WriteMessageBegin("CreateOnlineRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateOnlineRechargeOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *VerifyRechargeOrderResp) IsSetOrder() bool {
+	return p.Order != nil
 }
 
-type rechargeOrderServiceProcessorGetMyRechargeOrders struct {
-	handler RechargeOrderService
+func (p *VerifyRechargeOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *rechargeOrderServiceProcessorGetMyRechargeOrders) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceGetMyRechargeOrdersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetMyRechargeOrders", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *VerifyRechargeOrderResp) Read(iprot thrift.TProtocol) (err error) {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceGetMyRechargeOrdersResult{}
-	var retval *GetRechargeOrdersResp
-	if retval, err2 = p.handler.GetMyRechargeOrders(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetMyRechargeOrders: "+err2.Error())
-		oprot.WriteMessageBegin("GetMyRechargeOrders", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetMyRechargeOrders", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyRechargeOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerifyRechargeOrderResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRechargeOrder()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Order = _field
+	return nil
+}
+func (p *VerifyRechargeOrderResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *VerifyRechargeOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyRechargeOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyRechargeOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrder() {
+		if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Order.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyRechargeOrderResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyRechargeOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyRechargeOrderResp(%+v)", *p)
+
+}
+
+// 链上入账记录
+type ChainDeposit struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+	// 区块链网络标识
+	Network string `thrift:"network,2" form:"network" json:"network" query:"network"`
+	TxHash  string `thrift:"tx_hash,3" form:"tx_hash" json:"tx_hash" query:"tx_hash"`
+	// 转账在交易中的序号
+	LogIndex    int64 `thrift:"log_index,4" form:"log_index" json:"log_index" query:"log_index"`
+	BlockNumber int64 `thrift:"block_number,5" form:"block_number" json:"block_number" query:"block_number"`
+	// USDT, USDC
+	TokenSymbol   string `thrift:"token_symbol,6" form:"token_symbol" json:"token_symbol" query:"token_symbol"`
+	TokenContract string `thrift:"token_contract,7" form:"token_contract" json:"token_contract" query:"token_contract"`
+	FromAddress   string `thrift:"from_address,8" form:"from_address" json:"from_address" query:"from_address"`
+	ToAddress     string `thrift:"to_address,9" form:"to_address" json:"to_address" query:"to_address"`
+	// 入账金额（美元）
+	Amount string `thrift:"amount,10" form:"amount" json:"amount" query:"amount"`
+	// unmatched, matched, ignored
+	Status string `thrift:"status,11" form:"status" json:"status" query:"status"`
+	// 匹配的充值订单ID
+	RechargeOrderID *string `thrift:"recharge_order_id,12,optional" form:"recharge_order_id" json:"recharge_order_id,omitempty" query:"recharge_order_id"`
+	// 处理人ID（管理员）
+	ResolvedBy *int64  `thrift:"resolved_by,13,optional" form:"resolved_by" json:"resolved_by,omitempty" query:"resolved_by"`
+	ResolvedAt *string `thrift:"resolved_at,14,optional" form:"resolved_at" json:"resolved_at,omitempty" query:"resolved_at"`
+	Remark     *string `thrift:"remark,15,optional" form:"remark" json:"remark,omitempty" query:"remark"`
+	CreatedAt  string  `thrift:"created_at,16" form:"created_at" json:"created_at" query:"created_at"`
+}
+
+func NewChainDeposit() *ChainDeposit {
+	return &ChainDeposit{}
+}
+
+func (p *ChainDeposit) InitDefault() {
+}
+
+func (p *ChainDeposit) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ChainDeposit) GetNetwork() (v string) {
+	return p.Network
+}
+
+func (p *ChainDeposit) GetTxHash() (v string) {
+	return p.TxHash
+}
+
+func (p *ChainDeposit) GetLogIndex() (v int64) {
+	return p.LogIndex
+}
+
+func (p *ChainDeposit) GetBlockNumber() (v int64) {
+	return p.BlockNumber
+}
+
+func (p *ChainDeposit) GetTokenSymbol() (v string) {
+	return p.TokenSymbol
+}
+
+func (p *ChainDeposit) GetTokenContract() (v string) {
+	return p.TokenContract
+}
+
+func (p *ChainDeposit) GetFromAddress() (v string) {
+	return p.FromAddress
+}
+
+func (p *ChainDeposit) GetToAddress() (v string) {
+	return p.ToAddress
+}
+
+func (p *ChainDeposit) GetAmount() (v string) {
+	return p.Amount
+}
+
+func (p *ChainDeposit) GetStatus() (v string) {
+	return p.Status
+}
+
+var ChainDeposit_RechargeOrderID_DEFAULT string
+
+func (p *ChainDeposit) GetRechargeOrderID() (v string) {
+	if !p.IsSetRechargeOrderID() {
+		return ChainDeposit_RechargeOrderID_DEFAULT
+	}
+	return *p.RechargeOrderID
+}
+
+var ChainDeposit_ResolvedBy_DEFAULT int64
+
+func (p *ChainDeposit) GetResolvedBy() (v int64) {
+	if !p.IsSetResolvedBy() {
+		return ChainDeposit_ResolvedBy_DEFAULT
+	}
+	return *p.ResolvedBy
+}
+
+var ChainDeposit_ResolvedAt_DEFAULT string
+
+func (p *ChainDeposit) GetResolvedAt() (v string) {
+	if !p.IsSetResolvedAt() {
+		return ChainDeposit_ResolvedAt_DEFAULT
+	}
+	return *p.ResolvedAt
+}
+
+var ChainDeposit_Remark_DEFAULT string
+
+func (p *ChainDeposit) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return ChainDeposit_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+func (p *ChainDeposit) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+var fieldIDToName_ChainDeposit = map[int16]string{
+	1:  "id",
+	2:  "network",
+	3:  "tx_hash",
+	4:  "log_index",
+	5:  "block_number",
+	6:  "token_symbol",
+	7:  "token_contract",
+	8:  "from_address",
+	9:  "to_address",
+	10: "amount",
+	11: "status",
+	12: "recharge_order_id",
+	13: "resolved_by",
+	14: "resolved_at",
+	15: "remark",
+	16: "created_at",
+}
+
+func (p *ChainDeposit) IsSetRechargeOrderID() bool {
+	return p.RechargeOrderID != nil
+}
+
+func (p *ChainDeposit) IsSetResolvedBy() bool {
+	return p.ResolvedBy != nil
+}
+
+func (p *ChainDeposit) IsSetResolvedAt() bool {
+	return p.ResolvedAt != nil
+}
+
+func (p *ChainDeposit) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *ChainDeposit) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChainDeposit[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ChainDeposit) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Network = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TxHash = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LogIndex = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.BlockNumber = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TokenSymbol = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TokenContract = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FromAddress = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ToAddress = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Amount = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RechargeOrderID = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ResolvedBy = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ResolvedAt = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Remark = _field
+	return nil
+}
+func (p *ChainDeposit) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *ChainDeposit) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChainDeposit"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("network", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Network); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tx_hash", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TxHash); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("log_index", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LogIndex); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("block_number", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.BlockNumber); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token_symbol", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TokenSymbol); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token_contract", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TokenContract); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("from_address", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FromAddress); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("to_address", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ToAddress); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("amount", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Amount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRechargeOrderID() {
+		if err = oprot.WriteFieldBegin("recharge_order_id", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RechargeOrderID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResolvedBy() {
+		if err = oprot.WriteFieldBegin("resolved_by", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ResolvedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResolvedAt() {
+		if err = oprot.WriteFieldBegin("resolved_at", thrift.STRING, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ResolvedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemark() {
+		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Remark); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *ChainDeposit) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 16); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+
+func (p *ChainDeposit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChainDeposit(%+v)", *p)
+
+}
+
+// admin查询链上入账记录请求
+type GetChainDepositsReq struct {
+	// 状态筛选：unmatched（待审核）, matched, ignored
+	Status *string `thrift:"status,1,optional" form:"status" json:"status,omitempty"`
+	// 网络筛选
+	Network  *string `thrift:"network,2,optional" form:"network" json:"network,omitempty"`
+	Page     *int32  `thrift:"page,3,optional" form:"page" json:"page,omitempty"`
+	PageSize *int32  `thrift:"page_size,4,optional" form:"page_size" json:"page_size,omitempty"`
+}
+
+func NewGetChainDepositsReq() *GetChainDepositsReq {
+	return &GetChainDepositsReq{}
+}
+
+func (p *GetChainDepositsReq) InitDefault() {
+}
+
+var GetChainDepositsReq_Status_DEFAULT string
+
+func (p *GetChainDepositsReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return GetChainDepositsReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var GetChainDepositsReq_Network_DEFAULT string
+
+func (p *GetChainDepositsReq) GetNetwork() (v string) {
+	if !p.IsSetNetwork() {
+		return GetChainDepositsReq_Network_DEFAULT
+	}
+	return *p.Network
+}
+
+var GetChainDepositsReq_Page_DEFAULT int32
+
+func (p *GetChainDepositsReq) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetChainDepositsReq_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetChainDepositsReq_PageSize_DEFAULT int32
+
+func (p *GetChainDepositsReq) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return GetChainDepositsReq_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var fieldIDToName_GetChainDepositsReq = map[int16]string{
+	1: "status",
+	2: "network",
+	3: "page",
+	4: "page_size",
+}
+
+func (p *GetChainDepositsReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *GetChainDepositsReq) IsSetNetwork() bool {
+	return p.Network != nil
+}
+
+func (p *GetChainDepositsReq) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetChainDepositsReq) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *GetChainDepositsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetChainDepositsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetChainDepositsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *GetChainDepositsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Network = _field
+	return nil
+}
+func (p *GetChainDepositsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetChainDepositsReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetChainDepositsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetChainDepositsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetChainDepositsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetChainDepositsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNetwork() {
+		if err = oprot.WriteFieldBegin("network", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Network); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetChainDepositsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Page); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetChainDepositsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetChainDepositsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetChainDepositsReq(%+v)", *p)
+
+}
+
+// admin查询链上入账记录响应
+type GetChainDepositsResp struct {
+	Deposits []*ChainDeposit  `thrift:"deposits,1,default,list<ChainDeposit>" form:"deposits" json:"deposits" query:"deposits"`
+	Total    int64            `thrift:"total,2" form:"total" json:"total" query:"total"`
+	Page     int32            `thrift:"page,3" form:"page" json:"page" query:"page"`
+	PageSize int32            `thrift:"page_size,4" form:"page_size" json:"page_size" query:"page_size"`
+	BaseResp *common.BaseResp `thrift:"base_resp,5" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewGetChainDepositsResp() *GetChainDepositsResp {
+	return &GetChainDepositsResp{}
+}
+
+func (p *GetChainDepositsResp) InitDefault() {
+}
+
+func (p *GetChainDepositsResp) GetDeposits() (v []*ChainDeposit) {
+	return p.Deposits
+}
+
+func (p *GetChainDepositsResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+func (p *GetChainDepositsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetChainDepositsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var GetChainDepositsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetChainDepositsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetChainDepositsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_GetChainDepositsResp = map[int16]string{
+	1: "deposits",
+	2: "total",
+	3: "page",
+	4: "page_size",
+	5: "base_resp",
+}
+
+func (p *GetChainDepositsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetChainDepositsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetChainDepositsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ChainDeposit, 0, size)
+	values := make([]ChainDeposit, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Deposits = _field
+	return nil
+}
+func (p *GetChainDepositsResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *GetChainDepositsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetChainDepositsResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *GetChainDepositsResp) ReadField5(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GetChainDepositsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetChainDepositsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deposits", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Deposits)); err != nil {
+		return err
+	}
+	for _, v := range p.Deposits {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Page); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetChainDepositsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetChainDepositsResp(%+v)", *p)
+
+}
+
+// admin处理未匹配入账记录请求
+type ResolveChainDepositReq struct {
+	DepositID int64 `thrift:"deposit_id,1,required" form:"deposit_id,required" json:"deposit_id,required"`
+	// match-匹配到充值订单并入账，ignore-忽略
+	Action string `thrift:"action,2,required" form:"action,required" json:"action,required"`
+	// action 为 match 时必填，待确认的充值订单ID
+	OrderID *string `thrift:"order_id,3,optional" form:"order_id" json:"order_id,omitempty"`
+	// 备注
+	Remark *string `thrift:"remark,4,optional" form:"remark" json:"remark,omitempty"`
+}
+
+func NewResolveChainDepositReq() *ResolveChainDepositReq {
+	return &ResolveChainDepositReq{}
+}
+
+func (p *ResolveChainDepositReq) InitDefault() {
+}
+
+func (p *ResolveChainDepositReq) GetDepositID() (v int64) {
+	return p.DepositID
+}
+
+func (p *ResolveChainDepositReq) GetAction() (v string) {
+	return p.Action
+}
+
+var ResolveChainDepositReq_OrderID_DEFAULT string
+
+func (p *ResolveChainDepositReq) GetOrderID() (v string) {
+	if !p.IsSetOrderID() {
+		return ResolveChainDepositReq_OrderID_DEFAULT
+	}
+	return *p.OrderID
+}
+
+var ResolveChainDepositReq_Remark_DEFAULT string
+
+func (p *ResolveChainDepositReq) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return ResolveChainDepositReq_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+var fieldIDToName_ResolveChainDepositReq = map[int16]string{
+	1: "deposit_id",
+	2: "action",
+	3: "order_id",
+	4: "remark",
+}
+
+func (p *ResolveChainDepositReq) IsSetOrderID() bool {
+	return p.OrderID != nil
+}
+
+func (p *ResolveChainDepositReq) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *ResolveChainDepositReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetDepositID bool = false
+	var issetAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDepositID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetDepositID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResolveChainDepositReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ResolveChainDepositReq[fieldId]))
+}
+
+func (p *ResolveChainDepositReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DepositID = _field
+	return nil
+}
+func (p *ResolveChainDepositReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *ResolveChainDepositReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OrderID = _field
+	return nil
+}
+func (p *ResolveChainDepositReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Remark = _field
+	return nil
+}
+
+func (p *ResolveChainDepositReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolveChainDepositReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResolveChainDepositReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deposit_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DepositID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResolveChainDepositReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ResolveChainDepositReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrderID() {
+		if err = oprot.WriteFieldBegin("order_id", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OrderID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ResolveChainDepositReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemark() {
+		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Remark); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ResolveChainDepositReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveChainDepositReq(%+v)", *p)
+
+}
+
+// admin处理未匹配入账记录响应
+type ResolveChainDepositResp struct {
+	Deposit  *ChainDeposit    `thrift:"deposit,1,optional" form:"deposit" json:"deposit,omitempty" query:"deposit"`
+	BaseResp *common.BaseResp `thrift:"base_resp,2" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewResolveChainDepositResp() *ResolveChainDepositResp {
+	return &ResolveChainDepositResp{}
+}
+
+func (p *ResolveChainDepositResp) InitDefault() {
+}
+
+var ResolveChainDepositResp_Deposit_DEFAULT *ChainDeposit
+
+func (p *ResolveChainDepositResp) GetDeposit() (v *ChainDeposit) {
+	if !p.IsSetDeposit() {
+		return ResolveChainDepositResp_Deposit_DEFAULT
+	}
+	return p.Deposit
+}
+
+var ResolveChainDepositResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ResolveChainDepositResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ResolveChainDepositResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ResolveChainDepositResp = map[int16]string{
+	1: "deposit",
+	2: "base_resp",
+}
+
+func (p *ResolveChainDepositResp) IsSetDeposit() bool {
+	return p.Deposit != nil
+}
+
+func (p *ResolveChainDepositResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ResolveChainDepositResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResolveChainDepositResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResolveChainDepositResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChainDeposit()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Deposit = _field
+	return nil
+}
+func (p *ResolveChainDepositResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ResolveChainDepositResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolveChainDepositResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResolveChainDepositResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeposit() {
+		if err = oprot.WriteFieldBegin("deposit", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Deposit.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResolveChainDepositResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ResolveChainDepositResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveChainDepositResp(%+v)", *p)
+
+}
+
+// 充值订单服务
+// 在线支付平台 webhook 通知（请求体为支付平台原始事件，按平台规则验签）
+type PaymentWebhookReq struct {
+	// 支付平台：stripe, paypal, fake
+	Platform string `thrift:"platform,1,required" json:"platform,required" path:"platform,required"`
+}
+
+func NewPaymentWebhookReq() *PaymentWebhookReq {
+	return &PaymentWebhookReq{}
+}
+
+func (p *PaymentWebhookReq) InitDefault() {
+}
+
+func (p *PaymentWebhookReq) GetPlatform() (v string) {
+	return p.Platform
+}
+
+var fieldIDToName_PaymentWebhookReq = map[int16]string{
+	1: "platform",
+}
+
+func (p *PaymentWebhookReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPlatform bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPlatform = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPlatform {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentWebhookReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PaymentWebhookReq[fieldId]))
+}
+
+func (p *PaymentWebhookReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Platform = _field
+	return nil
+}
+
+func (p *PaymentWebhookReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PaymentWebhookReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PaymentWebhookReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("platform", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Platform); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PaymentWebhookReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentWebhookReq(%+v)", *p)
+
+}
+
+// 在线支付平台 webhook 响应
+type PaymentWebhookResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewPaymentWebhookResp() *PaymentWebhookResp {
+	return &PaymentWebhookResp{}
+}
+
+func (p *PaymentWebhookResp) InitDefault() {
+}
+
+var PaymentWebhookResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *PaymentWebhookResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return PaymentWebhookResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_PaymentWebhookResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *PaymentWebhookResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *PaymentWebhookResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PaymentWebhookResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PaymentWebhookResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *PaymentWebhookResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PaymentWebhookResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PaymentWebhookResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PaymentWebhookResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PaymentWebhookResp(%+v)", *p)
+
+}
+
+type RechargeOrderService interface {
+	// normal用户创建充值订单
+	CreateCryptoRechargeOrder(ctx context.Context, req *CreateCryptoRechargeOrderReq) (r *CreateRechargeOrderResp, err error)
+
+	CreateOnlineRechargeOrder(ctx context.Context, req *CreateOnlineRechargeOrderReq) (r *CreateRechargeOrderResp, err error)
+	// 在线支付平台 webhook（无需登录，按平台签名校验）
+	PaymentWebhook(ctx context.Context, req *PaymentWebhookReq) (r *PaymentWebhookResp, err error)
+	// normal用户查询自己的充值订单
+	GetMyRechargeOrders(ctx context.Context, req *GetMyRechargeOrdersReq) (r *GetRechargeOrdersResp, err error)
+
+	GetRechargeOrderDetail(ctx context.Context, req *GetRechargeOrderDetailReq) (r *GetRechargeOrderDetailResp, err error)
+	// admin查询所有充值订单
+	GetAllRechargeOrders(ctx context.Context, req *GetAllRechargeOrdersReq) (r *GetRechargeOrdersResp, err error)
+	// admin确认/拒绝充值订单
+	ConfirmRechargeOrder(ctx context.Context, req *ConfirmRechargeOrderReq) (r *ConfirmRechargeOrderResp, err error)
+
+	RejectRechargeOrder(ctx context.Context, req *RejectRechargeOrderReq) (r *RejectRechargeOrderResp, err error)
+	// admin触发链上校验（校验通过自动确认，不通过标记待人工处理）
+	VerifyRechargeOrder(ctx context.Context, req *VerifyRechargeOrderReq) (r *VerifyRechargeOrderResp, err error)
+	// admin链上入账审核队列（未匹配到充值订单的入账）
+	GetChainDeposits(ctx context.Context, req *GetChainDepositsReq) (r *GetChainDepositsResp, err error)
+
+	ResolveChainDeposit(ctx context.Context, req *ResolveChainDepositReq) (r *ResolveChainDepositResp, err error)
+}
+
+type RechargeOrderServiceClient struct {
+	c thrift.TClient
+}
+
+func NewRechargeOrderServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RechargeOrderServiceClient {
+	return &RechargeOrderServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewRechargeOrderServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RechargeOrderServiceClient {
+	return &RechargeOrderServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewRechargeOrderServiceClient(c thrift.TClient) *RechargeOrderServiceClient {
+	return &RechargeOrderServiceClient{
+		c: c,
+	}
+}
+
+func (p *RechargeOrderServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *RechargeOrderServiceClient) CreateCryptoRechargeOrder(ctx context.Context, req *CreateCryptoRechargeOrderReq) (r *CreateRechargeOrderResp, err error) {
+	var _args RechargeOrderServiceCreateCryptoRechargeOrderArgs
+	_args.Req = req
+	var _result RechargeOrderServiceCreateCryptoRechargeOrderResult
+	if err = p.Client_().Call(ctx, "CreateCryptoRechargeOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) CreateOnlineRechargeOrder(ctx context.Context, req *CreateOnlineRechargeOrderReq) (r *CreateRechargeOrderResp, err error) {
+	var _args RechargeOrderServiceCreateOnlineRechargeOrderArgs
+	_args.Req = req
+	var _result RechargeOrderServiceCreateOnlineRechargeOrderResult
+	if err = p.Client_().Call(ctx, "CreateOnlineRechargeOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) PaymentWebhook(ctx context.Context, req *PaymentWebhookReq) (r *PaymentWebhookResp, err error) {
+	var _args RechargeOrderServicePaymentWebhookArgs
+	_args.Req = req
+	var _result RechargeOrderServicePaymentWebhookResult
+	if err = p.Client_().Call(ctx, "PaymentWebhook", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) GetMyRechargeOrders(ctx context.Context, req *GetMyRechargeOrdersReq) (r *GetRechargeOrdersResp, err error) {
+	var _args RechargeOrderServiceGetMyRechargeOrdersArgs
+	_args.Req = req
+	var _result RechargeOrderServiceGetMyRechargeOrdersResult
+	if err = p.Client_().Call(ctx, "GetMyRechargeOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) GetRechargeOrderDetail(ctx context.Context, req *GetRechargeOrderDetailReq) (r *GetRechargeOrderDetailResp, err error) {
+	var _args RechargeOrderServiceGetRechargeOrderDetailArgs
+	_args.Req = req
+	var _result RechargeOrderServiceGetRechargeOrderDetailResult
+	if err = p.Client_().Call(ctx, "GetRechargeOrderDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) GetAllRechargeOrders(ctx context.Context, req *GetAllRechargeOrdersReq) (r *GetRechargeOrdersResp, err error) {
+	var _args RechargeOrderServiceGetAllRechargeOrdersArgs
+	_args.Req = req
+	var _result RechargeOrderServiceGetAllRechargeOrdersResult
+	if err = p.Client_().Call(ctx, "GetAllRechargeOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) ConfirmRechargeOrder(ctx context.Context, req *ConfirmRechargeOrderReq) (r *ConfirmRechargeOrderResp, err error) {
+	var _args RechargeOrderServiceConfirmRechargeOrderArgs
+	_args.Req = req
+	var _result RechargeOrderServiceConfirmRechargeOrderResult
+	if err = p.Client_().Call(ctx, "ConfirmRechargeOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) RejectRechargeOrder(ctx context.Context, req *RejectRechargeOrderReq) (r *RejectRechargeOrderResp, err error) {
+	var _args RechargeOrderServiceRejectRechargeOrderArgs
+	_args.Req = req
+	var _result RechargeOrderServiceRejectRechargeOrderResult
+	if err = p.Client_().Call(ctx, "RejectRechargeOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) VerifyRechargeOrder(ctx context.Context, req *VerifyRechargeOrderReq) (r *VerifyRechargeOrderResp, err error) {
+	var _args RechargeOrderServiceVerifyRechargeOrderArgs
+	_args.Req = req
+	var _result RechargeOrderServiceVerifyRechargeOrderResult
+	if err = p.Client_().Call(ctx, "VerifyRechargeOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) GetChainDeposits(ctx context.Context, req *GetChainDepositsReq) (r *GetChainDepositsResp, err error) {
+	var _args RechargeOrderServiceGetChainDepositsArgs
+	_args.Req = req
+	var _result RechargeOrderServiceGetChainDepositsResult
+	if err = p.Client_().Call(ctx, "GetChainDeposits", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RechargeOrderServiceClient) ResolveChainDeposit(ctx context.Context, req *ResolveChainDepositReq) (r *ResolveChainDepositResp, err error) {
+	var _args RechargeOrderServiceResolveChainDepositArgs
+	_args.Req = req
+	var _result RechargeOrderServiceResolveChainDepositResult
+	if err = p.Client_().Call(ctx, "ResolveChainDeposit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type RechargeOrderServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      RechargeOrderService
+}
+
+func (p *RechargeOrderServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *RechargeOrderServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *RechargeOrderServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewRechargeOrderServiceProcessor(handler RechargeOrderService) *RechargeOrderServiceProcessor {
+	self := &RechargeOrderServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreateCryptoRechargeOrder", &rechargeOrderServiceProcessorCreateCryptoRechargeOrder{handler: handler})
+	self.AddToProcessorMap("CreateOnlineRechargeOrder", &rechargeOrderServiceProcessorCreateOnlineRechargeOrder{handler: handler})
+	self.AddToProcessorMap("PaymentWebhook", &rechargeOrderServiceProcessorPaymentWebhook{handler: handler})
+	self.AddToProcessorMap("GetMyRechargeOrders", &rechargeOrderServiceProcessorGetMyRechargeOrders{handler: handler})
+	self.AddToProcessorMap("GetRechargeOrderDetail", &rechargeOrderServiceProcessorGetRechargeOrderDetail{handler: handler})
+	self.AddToProcessorMap("GetAllRechargeOrders", &rechargeOrderServiceProcessorGetAllRechargeOrders{handler: handler})
+	self.AddToProcessorMap("ConfirmRechargeOrder", &rechargeOrderServiceProcessorConfirmRechargeOrder{handler: handler})
+	self.AddToProcessorMap("RejectRechargeOrder", &rechargeOrderServiceProcessorRejectRechargeOrder{handler: handler})
+	self.AddToProcessorMap("VerifyRechargeOrder", &rechargeOrderServiceProcessorVerifyRechargeOrder{handler: handler})
+	self.AddToProcessorMap("GetChainDeposits", &rechargeOrderServiceProcessorGetChainDeposits{handler: handler})
+	self.AddToProcessorMap("ResolveChainDeposit", &rechargeOrderServiceProcessorResolveChainDeposit{handler: handler})
+	return self
+}
+func (p *RechargeOrderServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type rechargeOrderServiceProcessorCreateCryptoRechargeOrder struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorCreateCryptoRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceCreateCryptoRechargeOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateCryptoRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceCreateCryptoRechargeOrderResult{}
+	var retval *CreateRechargeOrderResp
+	if retval, err2 = p.handler.CreateCryptoRechargeOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateCryptoRechargeOrder: "+err2.Error())
+		oprot.WriteMessageBegin("CreateCryptoRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateCryptoRechargeOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorCreateOnlineRechargeOrder struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorCreateOnlineRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceCreateOnlineRechargeOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateOnlineRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceCreateOnlineRechargeOrderResult{}
+	var retval *CreateRechargeOrderResp
+	if retval, err2 = p.handler.CreateOnlineRechargeOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateOnlineRechargeOrder: "+err2.Error())
+		oprot.WriteMessageBegin("CreateOnlineRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateOnlineRechargeOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorPaymentWebhook struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorPaymentWebhook) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServicePaymentWebhookArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PaymentWebhook", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServicePaymentWebhookResult{}
+	var retval *PaymentWebhookResp
+	if retval, err2 = p.handler.PaymentWebhook(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PaymentWebhook: "+err2.Error())
+		oprot.WriteMessageBegin("PaymentWebhook", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PaymentWebhook", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorGetMyRechargeOrders struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorGetMyRechargeOrders) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceGetMyRechargeOrdersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetMyRechargeOrders", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceGetMyRechargeOrdersResult{}
+	var retval *GetRechargeOrdersResp
+	if retval, err2 = p.handler.GetMyRechargeOrders(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetMyRechargeOrders: "+err2.Error())
+		oprot.WriteMessageBegin("GetMyRechargeOrders", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetMyRechargeOrders", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorGetRechargeOrderDetail struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorGetRechargeOrderDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceGetRechargeOrderDetailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRechargeOrderDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceGetRechargeOrderDetailResult{}
+	var retval *GetRechargeOrderDetailResp
+	if retval, err2 = p.handler.GetRechargeOrderDetail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRechargeOrderDetail: "+err2.Error())
+		oprot.WriteMessageBegin("GetRechargeOrderDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRechargeOrderDetail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorGetAllRechargeOrders struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorGetAllRechargeOrders) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceGetAllRechargeOrdersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllRechargeOrders", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceGetAllRechargeOrdersResult{}
+	var retval *GetRechargeOrdersResp
+	if retval, err2 = p.handler.GetAllRechargeOrders(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllRechargeOrders: "+err2.Error())
+		oprot.WriteMessageBegin("GetAllRechargeOrders", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllRechargeOrders", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorConfirmRechargeOrder struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorConfirmRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceConfirmRechargeOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ConfirmRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceConfirmRechargeOrderResult{}
+	var retval *ConfirmRechargeOrderResp
+	if retval, err2 = p.handler.ConfirmRechargeOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ConfirmRechargeOrder: "+err2.Error())
+		oprot.WriteMessageBegin("ConfirmRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ConfirmRechargeOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorRejectRechargeOrder struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorRejectRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceRejectRechargeOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RejectRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceRejectRechargeOrderResult{}
+	var retval *RejectRechargeOrderResp
+	if retval, err2 = p.handler.RejectRechargeOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RejectRechargeOrder: "+err2.Error())
+		oprot.WriteMessageBegin("RejectRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RejectRechargeOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
 	if err != nil {
 		return
 	}
-	return true, err
-}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorVerifyRechargeOrder struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorVerifyRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceVerifyRechargeOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VerifyRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceVerifyRechargeOrderResult{}
+	var retval *VerifyRechargeOrderResp
+	if retval, err2 = p.handler.VerifyRechargeOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VerifyRechargeOrder: "+err2.Error())
+		oprot.WriteMessageBegin("VerifyRechargeOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VerifyRechargeOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorGetChainDeposits struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorGetChainDeposits) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceGetChainDepositsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetChainDeposits", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceGetChainDepositsResult{}
+	var retval *GetChainDepositsResp
+	if retval, err2 = p.handler.GetChainDeposits(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetChainDeposits: "+err2.Error())
+		oprot.WriteMessageBegin("GetChainDeposits", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetChainDeposits", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type rechargeOrderServiceProcessorResolveChainDeposit struct {
+	handler RechargeOrderService
+}
+
+func (p *rechargeOrderServiceProcessorResolveChainDeposit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RechargeOrderServiceResolveChainDepositArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ResolveChainDeposit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RechargeOrderServiceResolveChainDepositResult{}
+	var retval *ResolveChainDepositResp
+	if retval, err2 = p.handler.ResolveChainDeposit(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResolveChainDeposit: "+err2.Error())
+		oprot.WriteMessageBegin("ResolveChainDeposit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ResolveChainDeposit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type RechargeOrderServiceCreateCryptoRechargeOrderArgs struct {
+	Req *CreateCryptoRechargeOrderReq `thrift:"req,1"`
+}
+
+func NewRechargeOrderServiceCreateCryptoRechargeOrderArgs() *RechargeOrderServiceCreateCryptoRechargeOrderArgs {
+	return &RechargeOrderServiceCreateCryptoRechargeOrderArgs{}
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) InitDefault() {
+}
+
+var RechargeOrderServiceCreateCryptoRechargeOrderArgs_Req_DEFAULT *CreateCryptoRechargeOrderReq
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) GetReq() (v *CreateCryptoRechargeOrderReq) {
+	if !p.IsSetReq() {
+		return RechargeOrderServiceCreateCryptoRechargeOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateCryptoRechargeOrderReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateCryptoRechargeOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServiceCreateCryptoRechargeOrderArgs(%+v)", *p)
+
+}
+
+type RechargeOrderServiceCreateCryptoRechargeOrderResult struct {
+	Success *CreateRechargeOrderResp `thrift:"success,0,optional"`
+}
+
+func NewRechargeOrderServiceCreateCryptoRechargeOrderResult() *RechargeOrderServiceCreateCryptoRechargeOrderResult {
+	return &RechargeOrderServiceCreateCryptoRechargeOrderResult{}
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) InitDefault() {
+}
+
+var RechargeOrderServiceCreateCryptoRechargeOrderResult_Success_DEFAULT *CreateRechargeOrderResp
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) GetSuccess() (v *CreateRechargeOrderResp) {
+	if !p.IsSetSuccess() {
+		return RechargeOrderServiceCreateCryptoRechargeOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateRechargeOrderResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateCryptoRechargeOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServiceCreateCryptoRechargeOrderResult(%+v)", *p)
+
+}
+
+type RechargeOrderServiceCreateOnlineRechargeOrderArgs struct {
+	Req *CreateOnlineRechargeOrderReq `thrift:"req,1"`
+}
+
+func NewRechargeOrderServiceCreateOnlineRechargeOrderArgs() *RechargeOrderServiceCreateOnlineRechargeOrderArgs {
+	return &RechargeOrderServiceCreateOnlineRechargeOrderArgs{}
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) InitDefault() {
+}
+
+var RechargeOrderServiceCreateOnlineRechargeOrderArgs_Req_DEFAULT *CreateOnlineRechargeOrderReq
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) GetReq() (v *CreateOnlineRechargeOrderReq) {
+	if !p.IsSetReq() {
+		return RechargeOrderServiceCreateOnlineRechargeOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateOnlineRechargeOrderReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateOnlineRechargeOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServiceCreateOnlineRechargeOrderArgs(%+v)", *p)
+
+}
+
+type RechargeOrderServiceCreateOnlineRechargeOrderResult struct {
+	Success *CreateRechargeOrderResp `thrift:"success,0,optional"`
+}
+
+func NewRechargeOrderServiceCreateOnlineRechargeOrderResult() *RechargeOrderServiceCreateOnlineRechargeOrderResult {
+	return &RechargeOrderServiceCreateOnlineRechargeOrderResult{}
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) InitDefault() {
+}
+
+var RechargeOrderServiceCreateOnlineRechargeOrderResult_Success_DEFAULT *CreateRechargeOrderResp
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) GetSuccess() (v *CreateRechargeOrderResp) {
+	if !p.IsSetSuccess() {
+		return RechargeOrderServiceCreateOnlineRechargeOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateRechargeOrderResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateOnlineRechargeOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServiceCreateOnlineRechargeOrderResult(%+v)", *p)
+
+}
+
+type RechargeOrderServicePaymentWebhookArgs struct {
+	Req *PaymentWebhookReq `thrift:"req,1"`
+}
+
+func NewRechargeOrderServicePaymentWebhookArgs() *RechargeOrderServicePaymentWebhookArgs {
+	return &RechargeOrderServicePaymentWebhookArgs{}
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) InitDefault() {
+}
+
+var RechargeOrderServicePaymentWebhookArgs_Req_DEFAULT *PaymentWebhookReq
+
+func (p *RechargeOrderServicePaymentWebhookArgs) GetReq() (v *PaymentWebhookReq) {
+	if !p.IsSetReq() {
+		return RechargeOrderServicePaymentWebhookArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RechargeOrderServicePaymentWebhookArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServicePaymentWebhookArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPaymentWebhookReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PaymentWebhook_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RechargeOrderServicePaymentWebhookArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServicePaymentWebhookArgs(%+v)", *p)
+
+}
+
+type RechargeOrderServicePaymentWebhookResult struct {
+	Success *PaymentWebhookResp `thrift:"success,0,optional"`
+}
+
+func NewRechargeOrderServicePaymentWebhookResult() *RechargeOrderServicePaymentWebhookResult {
+	return &RechargeOrderServicePaymentWebhookResult{}
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) InitDefault() {
+}
+
+var RechargeOrderServicePaymentWebhookResult_Success_DEFAULT *PaymentWebhookResp
+
+func (p *RechargeOrderServicePaymentWebhookResult) GetSuccess() (v *PaymentWebhookResp) {
+	if !p.IsSetSuccess() {
+		return RechargeOrderServicePaymentWebhookResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RechargeOrderServicePaymentWebhookResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServicePaymentWebhookResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPaymentWebhookResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PaymentWebhook_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RechargeOrderServicePaymentWebhookResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServicePaymentWebhookResult(%+v)", *p)
+
+}
+
+type RechargeOrderServiceGetMyRechargeOrdersArgs struct {
+	Req *GetMyRechargeOrdersReq `thrift:"req,1"`
+}
+
+func NewRechargeOrderServiceGetMyRechargeOrdersArgs() *RechargeOrderServiceGetMyRechargeOrdersArgs {
+	return &RechargeOrderServiceGetMyRechargeOrdersArgs{}
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) InitDefault() {
+}
+
+var RechargeOrderServiceGetMyRechargeOrdersArgs_Req_DEFAULT *GetMyRechargeOrdersReq
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) GetReq() (v *GetMyRechargeOrdersReq) {
+	if !p.IsSetReq() {
+		return RechargeOrderServiceGetMyRechargeOrdersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
 
-type rechargeOrderServiceProcessorGetRechargeOrderDetail struct {
-	handler RechargeOrderService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *rechargeOrderServiceProcessorGetRechargeOrderDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceGetRechargeOrderDetailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetRechargeOrderDetail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetMyRechargeOrdersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceGetRechargeOrderDetailResult{}
-	var retval *GetRechargeOrderDetailResp
-	if retval, err2 = p.handler.GetRechargeOrderDetail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRechargeOrderDetail: "+err2.Error())
-		oprot.WriteMessageBegin("GetRechargeOrderDetail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMyRechargeOrders_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetRechargeOrderDetail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type rechargeOrderServiceProcessorGetAllRechargeOrders struct {
-	handler RechargeOrderService
+func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RechargeOrderServiceGetMyRechargeOrdersArgs(%+v)", *p)
+
 }
 
-func (p *rechargeOrderServiceProcessorGetAllRechargeOrders) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceGetAllRechargeOrdersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetAllRechargeOrders", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type RechargeOrderServiceGetMyRechargeOrdersResult struct {
+	Success *GetRechargeOrdersResp `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceGetAllRechargeOrdersResult{}
-	var retval *GetRechargeOrdersResp
-	if retval, err2 = p.handler.GetAllRechargeOrders(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllRechargeOrders: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllRechargeOrders", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetAllRechargeOrders", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewRechargeOrderServiceGetMyRechargeOrdersResult() *RechargeOrderServiceGetMyRechargeOrdersResult {
+	return &RechargeOrderServiceGetMyRechargeOrdersResult{}
 }
 
-type rechargeOrderServiceProcessorConfirmRechargeOrder struct {
-	handler RechargeOrderService
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) InitDefault() {
 }
 
-func (p *rechargeOrderServiceProcessorConfirmRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceConfirmRechargeOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ConfirmRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var RechargeOrderServiceGetMyRechargeOrdersResult_Success_DEFAULT *GetRechargeOrdersResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceConfirmRechargeOrderResult{}
-	var retval *ConfirmRechargeOrderResp
-	if retval, err2 = p.handler.ConfirmRechargeOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ConfirmRechargeOrder: "+err2.Error())
-		oprot.WriteMessageBegin("ConfirmRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ConfirmRechargeOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) GetSuccess() (v *GetRechargeOrdersResp) {
+	if !p.IsSetSuccess() {
+		return RechargeOrderServiceGetMyRechargeOrdersResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type rechargeOrderServiceProcessorRejectRechargeOrder struct {
-	handler RechargeOrderService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *rechargeOrderServiceProcessorRejectRechargeOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RechargeOrderServiceRejectRechargeOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RejectRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRechargeOrdersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RechargeOrderServiceRejectRechargeOrderResult{}
-	var retval *RejectRechargeOrderResp
-	if retval, err2 = p.handler.RejectRechargeOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RejectRechargeOrder: "+err2.Error())
-		oprot.WriteMessageBegin("RejectRechargeOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMyRechargeOrders_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("RejectRechargeOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RechargeOrderServiceGetMyRechargeOrdersResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("RechargeOrderServiceGetMyRechargeOrdersResult(%+v)", *p)
+
 }
 
-type RechargeOrderServiceCreateCryptoRechargeOrderArgs struct {
-	Req *CreateCryptoRechargeOrderReq `thrift:"req,1"`
+type RechargeOrderServiceGetRechargeOrderDetailArgs struct {
+	Req *GetRechargeOrderDetailReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceCreateCryptoRechargeOrderArgs() *RechargeOrderServiceCreateCryptoRechargeOrderArgs {
-	return &RechargeOrderServiceCreateCryptoRechargeOrderArgs{}
+func NewRechargeOrderServiceGetRechargeOrderDetailArgs() *RechargeOrderServiceGetRechargeOrderDetailArgs {
+	return &RechargeOrderServiceGetRechargeOrderDetailArgs{}
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) InitDefault() {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) InitDefault() {
 }
 
-var RechargeOrderServiceCreateCryptoRechargeOrderArgs_Req_DEFAULT *CreateCryptoRechargeOrderReq
+var RechargeOrderServiceGetRechargeOrderDetailArgs_Req_DEFAULT *GetRechargeOrderDetailReq
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) GetReq() (v *CreateCryptoRechargeOrderReq) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) GetReq() (v *GetRechargeOrderDetailReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceCreateCryptoRechargeOrderArgs_Req_DEFAULT
+		return RechargeOrderServiceGetRechargeOrderDetailArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4897,7 +9259,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4907,8 +9269,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateCryptoRechargeOrderReq()
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRechargeOrderDetailReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4916,9 +9278,9 @@ func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) ReadField1(iprot thr
 	return nil
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateCryptoRechargeOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetRechargeOrderDetail_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4944,7 +9306,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4961,43 +9323,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderArgs) String() string {
+func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceCreateCryptoRechargeOrderArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceGetRechargeOrderDetailArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceCreateCryptoRechargeOrderResult struct {
-	Success *CreateRechargeOrderResp `thrift:"success,0,optional"`
+type RechargeOrderServiceGetRechargeOrderDetailResult struct {
+	Success *GetRechargeOrderDetailResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceCreateCryptoRechargeOrderResult() *RechargeOrderServiceCreateCryptoRechargeOrderResult {
-	return &RechargeOrderServiceCreateCryptoRechargeOrderResult{}
+func NewRechargeOrderServiceGetRechargeOrderDetailResult() *RechargeOrderServiceGetRechargeOrderDetailResult {
+	return &RechargeOrderServiceGetRechargeOrderDetailResult{}
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) InitDefault() {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) InitDefault() {
 }
 
-var RechargeOrderServiceCreateCryptoRechargeOrderResult_Success_DEFAULT *CreateRechargeOrderResp
+var RechargeOrderServiceGetRechargeOrderDetailResult_Success_DEFAULT *GetRechargeOrderDetailResp
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) GetSuccess() (v *CreateRechargeOrderResp) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) GetSuccess() (v *GetRechargeOrderDetailResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceCreateCryptoRechargeOrderResult_Success_DEFAULT
+		return RechargeOrderServiceGetRechargeOrderDetailResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5043,7 +9405,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateCryptoRechargeOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5053,8 +9415,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateRechargeOrderResp()
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRechargeOrderDetailResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5062,9 +9424,9 @@ func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) ReadField0(iprot t
 	return nil
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateCryptoRechargeOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetRechargeOrderDetail_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5090,7 +9452,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5109,43 +9471,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateCryptoRechargeOrderResult) String() string {
+func (p *RechargeOrderServiceGetRechargeOrderDetailResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceCreateCryptoRechargeOrderResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceGetRechargeOrderDetailResult(%+v)", *p)
 
 }
 
-type RechargeOrderServiceCreateOnlineRechargeOrderArgs struct {
-	Req *CreateOnlineRechargeOrderReq `thrift:"req,1"`
+type RechargeOrderServiceGetAllRechargeOrdersArgs struct {
+	Req *GetAllRechargeOrdersReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceCreateOnlineRechargeOrderArgs() *RechargeOrderServiceCreateOnlineRechargeOrderArgs {
-	return &RechargeOrderServiceCreateOnlineRechargeOrderArgs{}
+func NewRechargeOrderServiceGetAllRechargeOrdersArgs() *RechargeOrderServiceGetAllRechargeOrdersArgs {
+	return &RechargeOrderServiceGetAllRechargeOrdersArgs{}
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) InitDefault() {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) InitDefault() {
 }
 
-var RechargeOrderServiceCreateOnlineRechargeOrderArgs_Req_DEFAULT *CreateOnlineRechargeOrderReq
+var RechargeOrderServiceGetAllRechargeOrdersArgs_Req_DEFAULT *GetAllRechargeOrdersReq
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) GetReq() (v *CreateOnlineRechargeOrderReq) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) GetReq() (v *GetAllRechargeOrdersReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceCreateOnlineRechargeOrderArgs_Req_DEFAULT
+		return RechargeOrderServiceGetAllRechargeOrdersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5191,7 +9553,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5201,8 +9563,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateOnlineRechargeOrderReq()
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAllRechargeOrdersReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5210,9 +9572,9 @@ func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) ReadField1(iprot thr
 	return nil
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOnlineRechargeOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllRechargeOrders_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5238,7 +9600,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5255,43 +9617,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderArgs) String() string {
+func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceCreateOnlineRechargeOrderArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceGetAllRechargeOrdersArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceCreateOnlineRechargeOrderResult struct {
-	Success *CreateRechargeOrderResp `thrift:"success,0,optional"`
+type RechargeOrderServiceGetAllRechargeOrdersResult struct {
+	Success *GetRechargeOrdersResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceCreateOnlineRechargeOrderResult() *RechargeOrderServiceCreateOnlineRechargeOrderResult {
-	return &RechargeOrderServiceCreateOnlineRechargeOrderResult{}
+func NewRechargeOrderServiceGetAllRechargeOrdersResult() *RechargeOrderServiceGetAllRechargeOrdersResult {
+	return &RechargeOrderServiceGetAllRechargeOrdersResult{}
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) InitDefault() {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) InitDefault() {
 }
 
-var RechargeOrderServiceCreateOnlineRechargeOrderResult_Success_DEFAULT *CreateRechargeOrderResp
+var RechargeOrderServiceGetAllRechargeOrdersResult_Success_DEFAULT *GetRechargeOrdersResp
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) GetSuccess() (v *CreateRechargeOrderResp) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) GetSuccess() (v *GetRechargeOrdersResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceCreateOnlineRechargeOrderResult_Success_DEFAULT
+		return RechargeOrderServiceGetAllRechargeOrdersResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5337,7 +9699,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceCreateOnlineRechargeOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5347,8 +9709,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateRechargeOrderResp()
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRechargeOrdersResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5356,9 +9718,9 @@ func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) ReadField0(iprot t
 	return nil
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOnlineRechargeOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllRechargeOrders_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5384,7 +9746,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5403,43 +9765,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceCreateOnlineRechargeOrderResult) String() string {
+func (p *RechargeOrderServiceGetAllRechargeOrdersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceCreateOnlineRechargeOrderResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceGetAllRechargeOrdersResult(%+v)", *p)
 
 }
 
-type RechargeOrderServiceGetMyRechargeOrdersArgs struct {
-	Req *GetMyRechargeOrdersReq `thrift:"req,1"`
+type RechargeOrderServiceConfirmRechargeOrderArgs struct {
+	Req *ConfirmRechargeOrderReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceGetMyRechargeOrdersArgs() *RechargeOrderServiceGetMyRechargeOrdersArgs {
-	return &RechargeOrderServiceGetMyRechargeOrdersArgs{}
+func NewRechargeOrderServiceConfirmRechargeOrderArgs() *RechargeOrderServiceConfirmRechargeOrderArgs {
+	return &RechargeOrderServiceConfirmRechargeOrderArgs{}
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) InitDefault() {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) InitDefault() {
 }
 
-var RechargeOrderServiceGetMyRechargeOrdersArgs_Req_DEFAULT *GetMyRechargeOrdersReq
+var RechargeOrderServiceConfirmRechargeOrderArgs_Req_DEFAULT *ConfirmRechargeOrderReq
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) GetReq() (v *GetMyRechargeOrdersReq) {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) GetReq() (v *ConfirmRechargeOrderReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceGetMyRechargeOrdersArgs_Req_DEFAULT
+		return RechargeOrderServiceConfirmRechargeOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceConfirmRechargeOrderArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5485,7 +9847,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceConfirmRechargeOrderArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5495,8 +9857,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetMyRechargeOrdersReq()
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewConfirmRechargeOrderReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5504,9 +9866,9 @@ func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) ReadField1(iprot thrift.TP
 	return nil
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetMyRechargeOrders_args"); err != nil {
+	if err = oprot.WriteStructBegin("ConfirmRechargeOrder_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5532,7 +9894,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5549,43 +9911,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersArgs) String() string {
+func (p *RechargeOrderServiceConfirmRechargeOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceGetMyRechargeOrdersArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceConfirmRechargeOrderArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceGetMyRechargeOrdersResult struct {
-	Success *GetRechargeOrdersResp `thrift:"success,0,optional"`
+type RechargeOrderServiceConfirmRechargeOrderResult struct {
+	Success *ConfirmRechargeOrderResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceGetMyRechargeOrdersResult() *RechargeOrderServiceGetMyRechargeOrdersResult {
-	return &RechargeOrderServiceGetMyRechargeOrdersResult{}
+func NewRechargeOrderServiceConfirmRechargeOrderResult() *RechargeOrderServiceConfirmRechargeOrderResult {
+	return &RechargeOrderServiceConfirmRechargeOrderResult{}
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) InitDefault() {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) InitDefault() {
 }
 
-var RechargeOrderServiceGetMyRechargeOrdersResult_Success_DEFAULT *GetRechargeOrdersResp
+var RechargeOrderServiceConfirmRechargeOrderResult_Success_DEFAULT *ConfirmRechargeOrderResp
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) GetSuccess() (v *GetRechargeOrdersResp) {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) GetSuccess() (v *ConfirmRechargeOrderResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceGetMyRechargeOrdersResult_Success_DEFAULT
+		return RechargeOrderServiceConfirmRechargeOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceConfirmRechargeOrderResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5631,7 +9993,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetMyRechargeOrdersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceConfirmRechargeOrderResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5641,8 +10003,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetRechargeOrdersResp()
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewConfirmRechargeOrderResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5650,9 +10012,9 @@ func (p *RechargeOrderServiceGetMyRechargeOrdersResult) ReadField0(iprot thrift.
 	return nil
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetMyRechargeOrders_result"); err != nil {
+	if err = oprot.WriteStructBegin("ConfirmRechargeOrder_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5678,7 +10040,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5697,43 +10059,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetMyRechargeOrdersResult) String() string {
+func (p *RechargeOrderServiceConfirmRechargeOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceGetMyRechargeOrdersResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceConfirmRechargeOrderResult(%+v)", *p)
 
 }
 
-type RechargeOrderServiceGetRechargeOrderDetailArgs struct {
-	Req *GetRechargeOrderDetailReq `thrift:"req,1"`
+type RechargeOrderServiceRejectRechargeOrderArgs struct {
+	Req *RejectRechargeOrderReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceGetRechargeOrderDetailArgs() *RechargeOrderServiceGetRechargeOrderDetailArgs {
-	return &RechargeOrderServiceGetRechargeOrderDetailArgs{}
+func NewRechargeOrderServiceRejectRechargeOrderArgs() *RechargeOrderServiceRejectRechargeOrderArgs {
+	return &RechargeOrderServiceRejectRechargeOrderArgs{}
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) InitDefault() {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) InitDefault() {
 }
 
-var RechargeOrderServiceGetRechargeOrderDetailArgs_Req_DEFAULT *GetRechargeOrderDetailReq
+var RechargeOrderServiceRejectRechargeOrderArgs_Req_DEFAULT *RejectRechargeOrderReq
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) GetReq() (v *GetRechargeOrderDetailReq) {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) GetReq() (v *RejectRechargeOrderReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceGetRechargeOrderDetailArgs_Req_DEFAULT
+		return RechargeOrderServiceRejectRechargeOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceRejectRechargeOrderArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5779,7 +10141,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceRejectRechargeOrderArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5789,8 +10151,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetRechargeOrderDetailReq()
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRejectRechargeOrderReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5798,9 +10160,9 @@ func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) ReadField1(iprot thrift
 	return nil
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRechargeOrderDetail_args"); err != nil {
+	if err = oprot.WriteStructBegin("RejectRechargeOrder_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5826,7 +10188,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5843,43 +10205,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailArgs) String() string {
+func (p *RechargeOrderServiceRejectRechargeOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceGetRechargeOrderDetailArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceRejectRechargeOrderArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceGetRechargeOrderDetailResult struct {
-	Success *GetRechargeOrderDetailResp `thrift:"success,0,optional"`
+type RechargeOrderServiceRejectRechargeOrderResult struct {
+	Success *RejectRechargeOrderResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceGetRechargeOrderDetailResult() *RechargeOrderServiceGetRechargeOrderDetailResult {
-	return &RechargeOrderServiceGetRechargeOrderDetailResult{}
+func NewRechargeOrderServiceRejectRechargeOrderResult() *RechargeOrderServiceRejectRechargeOrderResult {
+	return &RechargeOrderServiceRejectRechargeOrderResult{}
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) InitDefault() {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) InitDefault() {
 }
 
-var RechargeOrderServiceGetRechargeOrderDetailResult_Success_DEFAULT *GetRechargeOrderDetailResp
+var RechargeOrderServiceRejectRechargeOrderResult_Success_DEFAULT *RejectRechargeOrderResp
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) GetSuccess() (v *GetRechargeOrderDetailResp) {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) GetSuccess() (v *RejectRechargeOrderResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceGetRechargeOrderDetailResult_Success_DEFAULT
+		return RechargeOrderServiceRejectRechargeOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceRejectRechargeOrderResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5925,7 +10287,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetRechargeOrderDetailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceRejectRechargeOrderResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5935,8 +10297,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetRechargeOrderDetailResp()
+func (p *RechargeOrderServiceRejectRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRejectRechargeOrderResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5944,9 +10306,9 @@ func (p *RechargeOrderServiceGetRechargeOrderDetailResult) ReadField0(iprot thri
 	return nil
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRechargeOrderDetail_result"); err != nil {
+	if err = oprot.WriteStructBegin("RejectRechargeOrder_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5972,7 +10334,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5991,43 +10353,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetRechargeOrderDetailResult) String() string {
+func (p *RechargeOrderServiceRejectRechargeOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceGetRechargeOrderDetailResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceRejectRechargeOrderResult(%+v)", *p)
 
 }
 
-type RechargeOrderServiceGetAllRechargeOrdersArgs struct {
-	Req *GetAllRechargeOrdersReq `thrift:"req,1"`
+type RechargeOrderServiceVerifyRechargeOrderArgs struct {
+	Req *VerifyRechargeOrderReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceGetAllRechargeOrdersArgs() *RechargeOrderServiceGetAllRechargeOrdersArgs {
-	return &RechargeOrderServiceGetAllRechargeOrdersArgs{}
+func NewRechargeOrderServiceVerifyRechargeOrderArgs() *RechargeOrderServiceVerifyRechargeOrderArgs {
+	return &RechargeOrderServiceVerifyRechargeOrderArgs{}
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) InitDefault() {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) InitDefault() {
 }
 
-var RechargeOrderServiceGetAllRechargeOrdersArgs_Req_DEFAULT *GetAllRechargeOrdersReq
+var RechargeOrderServiceVerifyRechargeOrderArgs_Req_DEFAULT *VerifyRechargeOrderReq
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) GetReq() (v *GetAllRechargeOrdersReq) {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) GetReq() (v *VerifyRechargeOrderReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceGetAllRechargeOrdersArgs_Req_DEFAULT
+		return RechargeOrderServiceVerifyRechargeOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceVerifyRechargeOrderArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6073,7 +10435,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceVerifyRechargeOrderArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6083,8 +10445,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetAllRechargeOrdersReq()
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVerifyRechargeOrderReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6092,9 +10454,9 @@ func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) ReadField1(iprot thrift.T
 	return nil
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllRechargeOrders_args"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyRechargeOrder_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6120,7 +10482,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6137,43 +10499,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersArgs) String() string {
+func (p *RechargeOrderServiceVerifyRechargeOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceGetAllRechargeOrdersArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceVerifyRechargeOrderArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceGetAllRechargeOrdersResult struct {
-	Success *GetRechargeOrdersResp `thrift:"success,0,optional"`
+type RechargeOrderServiceVerifyRechargeOrderResult struct {
+	Success *VerifyRechargeOrderResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceGetAllRechargeOrdersResult() *RechargeOrderServiceGetAllRechargeOrdersResult {
-	return &RechargeOrderServiceGetAllRechargeOrdersResult{}
+func NewRechargeOrderServiceVerifyRechargeOrderResult() *RechargeOrderServiceVerifyRechargeOrderResult {
+	return &RechargeOrderServiceVerifyRechargeOrderResult{}
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) InitDefault() {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) InitDefault() {
 }
 
-var RechargeOrderServiceGetAllRechargeOrdersResult_Success_DEFAULT *GetRechargeOrdersResp
+var RechargeOrderServiceVerifyRechargeOrderResult_Success_DEFAULT *VerifyRechargeOrderResp
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) GetSuccess() (v *GetRechargeOrdersResp) {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) GetSuccess() (v *VerifyRechargeOrderResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceGetAllRechargeOrdersResult_Success_DEFAULT
+		return RechargeOrderServiceVerifyRechargeOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceVerifyRechargeOrderResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6219,7 +10581,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetAllRechargeOrdersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceVerifyRechargeOrderResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6229,8 +10591,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetRechargeOrdersResp()
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVerifyRechargeOrderResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6238,9 +10600,9 @@ func (p *RechargeOrderServiceGetAllRechargeOrdersResult) ReadField0(iprot thrift
 	return nil
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllRechargeOrders_result"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyRechargeOrder_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6266,7 +10628,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6285,43 +10647,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceGetAllRechargeOrdersResult) String() string {
+func (p *RechargeOrderServiceVerifyRechargeOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceGetAllRechargeOrdersResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceVerifyRechargeOrderResult(%+v)", *p)
 
 }
 
-type RechargeOrderServiceConfirmRechargeOrderArgs struct {
-	Req *ConfirmRechargeOrderReq `thrift:"req,1"`
+type RechargeOrderServiceGetChainDepositsArgs struct {
+	Req *GetChainDepositsReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceConfirmRechargeOrderArgs() *RechargeOrderServiceConfirmRechargeOrderArgs {
-	return &RechargeOrderServiceConfirmRechargeOrderArgs{}
+func NewRechargeOrderServiceGetChainDepositsArgs() *RechargeOrderServiceGetChainDepositsArgs {
+	return &RechargeOrderServiceGetChainDepositsArgs{}
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) InitDefault() {
+func (p *RechargeOrderServiceGetChainDepositsArgs) InitDefault() {
 }
 
-var RechargeOrderServiceConfirmRechargeOrderArgs_Req_DEFAULT *ConfirmRechargeOrderReq
+var RechargeOrderServiceGetChainDepositsArgs_Req_DEFAULT *GetChainDepositsReq
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) GetReq() (v *ConfirmRechargeOrderReq) {
+func (p *RechargeOrderServiceGetChainDepositsArgs) GetReq() (v *GetChainDepositsReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceConfirmRechargeOrderArgs_Req_DEFAULT
+		return RechargeOrderServiceGetChainDepositsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceConfirmRechargeOrderArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceGetChainDepositsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceGetChainDepositsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetChainDepositsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6367,7 +10729,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceConfirmRechargeOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetChainDepositsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6377,8 +10739,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewConfirmRechargeOrderReq()
+func (p *RechargeOrderServiceGetChainDepositsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetChainDepositsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6386,9 +10748,9 @@ func (p *RechargeOrderServiceConfirmRechargeOrderArgs) ReadField1(iprot thrift.T
 	return nil
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetChainDepositsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConfirmRechargeOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetChainDeposits_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6414,7 +10776,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetChainDepositsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6431,43 +10793,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderArgs) String() string {
+func (p *RechargeOrderServiceGetChainDepositsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceConfirmRechargeOrderArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceGetChainDepositsArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceConfirmRechargeOrderResult struct {
-	Success *ConfirmRechargeOrderResp `thrift:"success,0,optional"`
+type RechargeOrderServiceGetChainDepositsResult struct {
+	Success *GetChainDepositsResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceConfirmRechargeOrderResult() *RechargeOrderServiceConfirmRechargeOrderResult {
-	return &RechargeOrderServiceConfirmRechargeOrderResult{}
+func NewRechargeOrderServiceGetChainDepositsResult() *RechargeOrderServiceGetChainDepositsResult {
+	return &RechargeOrderServiceGetChainDepositsResult{}
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) InitDefault() {
+func (p *RechargeOrderServiceGetChainDepositsResult) InitDefault() {
 }
 
-var RechargeOrderServiceConfirmRechargeOrderResult_Success_DEFAULT *ConfirmRechargeOrderResp
+var RechargeOrderServiceGetChainDepositsResult_Success_DEFAULT *GetChainDepositsResp
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) GetSuccess() (v *ConfirmRechargeOrderResp) {
+func (p *RechargeOrderServiceGetChainDepositsResult) GetSuccess() (v *GetChainDepositsResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceConfirmRechargeOrderResult_Success_DEFAULT
+		return RechargeOrderServiceGetChainDepositsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceConfirmRechargeOrderResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceGetChainDepositsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceGetChainDepositsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetChainDepositsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6513,7 +10875,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceConfirmRechargeOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceGetChainDepositsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6523,8 +10885,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewConfirmRechargeOrderResp()
+func (p *RechargeOrderServiceGetChainDepositsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetChainDepositsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6532,9 +10894,9 @@ func (p *RechargeOrderServiceConfirmRechargeOrderResult) ReadField0(iprot thrift
 	return nil
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetChainDepositsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ConfirmRechargeOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetChainDeposits_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6560,7 +10922,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceGetChainDepositsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6579,43 +10941,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceConfirmRechargeOrderResult) String() string {
+func (p *RechargeOrderServiceGetChainDepositsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceConfirmRechargeOrderResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceGetChainDepositsResult(%+v)", *p)
 
 }
 
-type RechargeOrderServiceRejectRechargeOrderArgs struct {
-	Req *RejectRechargeOrderReq `thrift:"req,1"`
+type RechargeOrderServiceResolveChainDepositArgs struct {
+	Req *ResolveChainDepositReq `thrift:"req,1"`
 }
 
-func NewRechargeOrderServiceRejectRechargeOrderArgs() *RechargeOrderServiceRejectRechargeOrderArgs {
-	return &RechargeOrderServiceRejectRechargeOrderArgs{}
+func NewRechargeOrderServiceResolveChainDepositArgs() *RechargeOrderServiceResolveChainDepositArgs {
+	return &RechargeOrderServiceResolveChainDepositArgs{}
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) InitDefault() {
+func (p *RechargeOrderServiceResolveChainDepositArgs) InitDefault() {
 }
 
-var RechargeOrderServiceRejectRechargeOrderArgs_Req_DEFAULT *RejectRechargeOrderReq
+var RechargeOrderServiceResolveChainDepositArgs_Req_DEFAULT *ResolveChainDepositReq
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) GetReq() (v *RejectRechargeOrderReq) {
+func (p *RechargeOrderServiceResolveChainDepositArgs) GetReq() (v *ResolveChainDepositReq) {
 	if !p.IsSetReq() {
-		return RechargeOrderServiceRejectRechargeOrderArgs_Req_DEFAULT
+		return RechargeOrderServiceResolveChainDepositArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RechargeOrderServiceRejectRechargeOrderArgs = map[int16]string{
+var fieldIDToName_RechargeOrderServiceResolveChainDepositArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) IsSetReq() bool {
+func (p *RechargeOrderServiceResolveChainDepositArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceResolveChainDepositArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6661,7 +11023,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceRejectRechargeOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceResolveChainDepositArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6671,8 +11033,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRejectRechargeOrderReq()
+func (p *RechargeOrderServiceResolveChainDepositArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewResolveChainDepositReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6680,9 +11042,9 @@ func (p *RechargeOrderServiceRejectRechargeOrderArgs) ReadField1(iprot thrift.TP
 	return nil
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceResolveChainDepositArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RejectRechargeOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("ResolveChainDeposit_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6708,7 +11070,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceResolveChainDepositArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6725,43 +11087,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderArgs) String() string {
+func (p *RechargeOrderServiceResolveChainDepositArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceRejectRechargeOrderArgs(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceResolveChainDepositArgs(%+v)", *p)
 
 }
 
-type RechargeOrderServiceRejectRechargeOrderResult struct {
-	Success *RejectRechargeOrderResp `thrift:"success,0,optional"`
+type RechargeOrderServiceResolveChainDepositResult struct {
+	Success *ResolveChainDepositResp `thrift:"success,0,optional"`
 }
 
-func NewRechargeOrderServiceRejectRechargeOrderResult() *RechargeOrderServiceRejectRechargeOrderResult {
-	return &RechargeOrderServiceRejectRechargeOrderResult{}
+func NewRechargeOrderServiceResolveChainDepositResult() *RechargeOrderServiceResolveChainDepositResult {
+	return &RechargeOrderServiceResolveChainDepositResult{}
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) InitDefault() {
+func (p *RechargeOrderServiceResolveChainDepositResult) InitDefault() {
 }
 
-var RechargeOrderServiceRejectRechargeOrderResult_Success_DEFAULT *RejectRechargeOrderResp
+var RechargeOrderServiceResolveChainDepositResult_Success_DEFAULT *ResolveChainDepositResp
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) GetSuccess() (v *RejectRechargeOrderResp) {
+func (p *RechargeOrderServiceResolveChainDepositResult) GetSuccess() (v *ResolveChainDepositResp) {
 	if !p.IsSetSuccess() {
-		return RechargeOrderServiceRejectRechargeOrderResult_Success_DEFAULT
+		return RechargeOrderServiceResolveChainDepositResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RechargeOrderServiceRejectRechargeOrderResult = map[int16]string{
+var fieldIDToName_RechargeOrderServiceResolveChainDepositResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) IsSetSuccess() bool {
+func (p *RechargeOrderServiceResolveChainDepositResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceResolveChainDepositResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6807,7 +11169,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceRejectRechargeOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RechargeOrderServiceResolveChainDepositResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6817,8 +11179,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRejectRechargeOrderResp()
+func (p *RechargeOrderServiceResolveChainDepositResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewResolveChainDepositResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6826,9 +11188,9 @@ func (p *RechargeOrderServiceRejectRechargeOrderResult) ReadField0(iprot thrift.
 	return nil
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceResolveChainDepositResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RejectRechargeOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("ResolveChainDeposit_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6854,7 +11216,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RechargeOrderServiceResolveChainDepositResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6873,10 +11235,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RechargeOrderServiceRejectRechargeOrderResult) String() string {
+func (p *RechargeOrderServiceResolveChainDepositResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RechargeOrderServiceRejectRechargeOrderResult(%+v)", *p)
+	return fmt.Sprintf("RechargeOrderServiceResolveChainDepositResult(%+v)", *p)
 
 }
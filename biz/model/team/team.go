@@ -5685,706 +5685,3711 @@ func (p *GetUserInvitationsResp) String() string {
 
 }
 
-// 团队服务接口
-type TeamService interface {
-	// 创建团队
-	CreateTeam(ctx context.Context, req *CreateTeamReq) (r *CreateTeamResp, err error)
-	// 获取团队详情
-	GetTeam(ctx context.Context, req *GetTeamReq) (r *GetTeamResp, err error)
-	// 更新团队
-	UpdateTeam(ctx context.Context, req *UpdateTeamReq) (r *UpdateTeamResp, err error)
-	// 获取用户团队列表
-	GetUserTeams(ctx context.Context, req *GetUserTeamsReq) (r *GetUserTeamsResp, err error)
-	// 邀请用户加入团队
-	InviteUser(ctx context.Context, req *InviteUserReq) (r *InviteUserResp, err error)
-	// 获取团队成员列表
-	GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error)
-	// 移除团队成员
-	RemoveTeamMember(ctx context.Context, req *RemoveTeamMemberReq) (r *RemoveTeamMemberResp, err error)
-	// 接受邀请
-	AcceptInvitation(ctx context.Context, req *AcceptInvitationReq) (r *AcceptInvitationResp, err error)
-	// 拒绝邀请
-	RejectInvitation(ctx context.Context, req *RejectInvitationReq) (r *RejectInvitationResp, err error)
-	// 获取用户邀请列表
-	GetUserInvitations(ctx context.Context, req *GetUserInvitationsReq) (r *GetUserInvitationsResp, err error)
+// 团队API Key信息（不包含明文密钥）
+type TeamAPIKey struct {
+	ID         int64    `thrift:"id,1" form:"id" json:"id" query:"id"`
+	TeamID     int64    `thrift:"team_id,2" form:"team_id" json:"team_id" query:"team_id"`
+	CreatorID  int64    `thrift:"creator_id,3" form:"creator_id" json:"creator_id" query:"creator_id"`
+	Name       string   `thrift:"name,4" form:"name" json:"name" query:"name"`
+	KeyPrefix  string   `thrift:"key_prefix,5" form:"key_prefix" json:"key_prefix" query:"key_prefix"`
+	Scopes     []string `thrift:"scopes,6,default,list<string>" form:"scopes" json:"scopes" query:"scopes"`
+	Status     string   `thrift:"status,7" form:"status" json:"status" query:"status"`
+	ExpiresAt  string   `thrift:"expires_at,8" form:"expires_at" json:"expires_at" query:"expires_at"`
+	LastUsedAt *string  `thrift:"last_used_at,9,optional" form:"last_used_at" json:"last_used_at,omitempty" query:"last_used_at"`
+	LastUsedIP *string  `thrift:"last_used_ip,10,optional" form:"last_used_ip" json:"last_used_ip,omitempty" query:"last_used_ip"`
+	RevokedAt  *string  `thrift:"revoked_at,11,optional" form:"revoked_at" json:"revoked_at,omitempty" query:"revoked_at"`
+	CreatedAt  string   `thrift:"created_at,12" form:"created_at" json:"created_at" query:"created_at"`
 }
 
-type TeamServiceClient struct {
-	c thrift.TClient
+func NewTeamAPIKey() *TeamAPIKey {
+	return &TeamAPIKey{}
 }
 
-func NewTeamServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TeamServiceClient {
-	return &TeamServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *TeamAPIKey) InitDefault() {
 }
 
-func NewTeamServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TeamServiceClient {
-	return &TeamServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *TeamAPIKey) GetID() (v int64) {
+	return p.ID
 }
 
-func NewTeamServiceClient(c thrift.TClient) *TeamServiceClient {
-	return &TeamServiceClient{
-		c: c,
-	}
+func (p *TeamAPIKey) GetTeamID() (v int64) {
+	return p.TeamID
 }
 
-func (p *TeamServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *TeamAPIKey) GetCreatorID() (v int64) {
+	return p.CreatorID
 }
 
-func (p *TeamServiceClient) CreateTeam(ctx context.Context, req *CreateTeamReq) (r *CreateTeamResp, err error) {
-	var _args TeamServiceCreateTeamArgs
-	_args.Req = req
-	var _result TeamServiceCreateTeamResult
-	if err = p.Client_().Call(ctx, "CreateTeam", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *TeamServiceClient) GetTeam(ctx context.Context, req *GetTeamReq) (r *GetTeamResp, err error) {
-	var _args TeamServiceGetTeamArgs
-	_args.Req = req
-	var _result TeamServiceGetTeamResult
-	if err = p.Client_().Call(ctx, "GetTeam", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *TeamServiceClient) UpdateTeam(ctx context.Context, req *UpdateTeamReq) (r *UpdateTeamResp, err error) {
-	var _args TeamServiceUpdateTeamArgs
-	_args.Req = req
-	var _result TeamServiceUpdateTeamResult
-	if err = p.Client_().Call(ctx, "UpdateTeam", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *TeamAPIKey) GetName() (v string) {
+	return p.Name
 }
-func (p *TeamServiceClient) GetUserTeams(ctx context.Context, req *GetUserTeamsReq) (r *GetUserTeamsResp, err error) {
-	var _args TeamServiceGetUserTeamsArgs
-	_args.Req = req
-	var _result TeamServiceGetUserTeamsResult
-	if err = p.Client_().Call(ctx, "GetUserTeams", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *TeamAPIKey) GetKeyPrefix() (v string) {
+	return p.KeyPrefix
 }
-func (p *TeamServiceClient) InviteUser(ctx context.Context, req *InviteUserReq) (r *InviteUserResp, err error) {
-	var _args TeamServiceInviteUserArgs
-	_args.Req = req
-	var _result TeamServiceInviteUserResult
-	if err = p.Client_().Call(ctx, "InviteUser", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *TeamAPIKey) GetScopes() (v []string) {
+	return p.Scopes
 }
-func (p *TeamServiceClient) GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error) {
-	var _args TeamServiceGetTeamMembersArgs
-	_args.Req = req
-	var _result TeamServiceGetTeamMembersResult
-	if err = p.Client_().Call(ctx, "GetTeamMembers", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *TeamAPIKey) GetStatus() (v string) {
+	return p.Status
 }
-func (p *TeamServiceClient) RemoveTeamMember(ctx context.Context, req *RemoveTeamMemberReq) (r *RemoveTeamMemberResp, err error) {
-	var _args TeamServiceRemoveTeamMemberArgs
-	_args.Req = req
-	var _result TeamServiceRemoveTeamMemberResult
-	if err = p.Client_().Call(ctx, "RemoveTeamMember", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *TeamAPIKey) GetExpiresAt() (v string) {
+	return p.ExpiresAt
 }
-func (p *TeamServiceClient) AcceptInvitation(ctx context.Context, req *AcceptInvitationReq) (r *AcceptInvitationResp, err error) {
-	var _args TeamServiceAcceptInvitationArgs
-	_args.Req = req
-	var _result TeamServiceAcceptInvitationResult
-	if err = p.Client_().Call(ctx, "AcceptInvitation", &_args, &_result); err != nil {
-		return
+
+var TeamAPIKey_LastUsedAt_DEFAULT string
+
+func (p *TeamAPIKey) GetLastUsedAt() (v string) {
+	if !p.IsSetLastUsedAt() {
+		return TeamAPIKey_LastUsedAt_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.LastUsedAt
 }
-func (p *TeamServiceClient) RejectInvitation(ctx context.Context, req *RejectInvitationReq) (r *RejectInvitationResp, err error) {
-	var _args TeamServiceRejectInvitationArgs
-	_args.Req = req
-	var _result TeamServiceRejectInvitationResult
-	if err = p.Client_().Call(ctx, "RejectInvitation", &_args, &_result); err != nil {
-		return
+
+var TeamAPIKey_LastUsedIP_DEFAULT string
+
+func (p *TeamAPIKey) GetLastUsedIP() (v string) {
+	if !p.IsSetLastUsedIP() {
+		return TeamAPIKey_LastUsedIP_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.LastUsedIP
 }
-func (p *TeamServiceClient) GetUserInvitations(ctx context.Context, req *GetUserInvitationsReq) (r *GetUserInvitationsResp, err error) {
-	var _args TeamServiceGetUserInvitationsArgs
-	_args.Req = req
-	var _result TeamServiceGetUserInvitationsResult
-	if err = p.Client_().Call(ctx, "GetUserInvitations", &_args, &_result); err != nil {
-		return
+
+var TeamAPIKey_RevokedAt_DEFAULT string
+
+func (p *TeamAPIKey) GetRevokedAt() (v string) {
+	if !p.IsSetRevokedAt() {
+		return TeamAPIKey_RevokedAt_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.RevokedAt
 }
 
-type TeamServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      TeamService
+func (p *TeamAPIKey) GetCreatedAt() (v string) {
+	return p.CreatedAt
 }
 
-func (p *TeamServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+var fieldIDToName_TeamAPIKey = map[int16]string{
+	1:  "id",
+	2:  "team_id",
+	3:  "creator_id",
+	4:  "name",
+	5:  "key_prefix",
+	6:  "scopes",
+	7:  "status",
+	8:  "expires_at",
+	9:  "last_used_at",
+	10: "last_used_ip",
+	11: "revoked_at",
+	12: "created_at",
 }
 
-func (p *TeamServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *TeamAPIKey) IsSetLastUsedAt() bool {
+	return p.LastUsedAt != nil
 }
 
-func (p *TeamServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *TeamAPIKey) IsSetLastUsedIP() bool {
+	return p.LastUsedIP != nil
 }
 
-func NewTeamServiceProcessor(handler TeamService) *TeamServiceProcessor {
-	self := &TeamServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CreateTeam", &teamServiceProcessorCreateTeam{handler: handler})
-	self.AddToProcessorMap("GetTeam", &teamServiceProcessorGetTeam{handler: handler})
-	self.AddToProcessorMap("UpdateTeam", &teamServiceProcessorUpdateTeam{handler: handler})
-	self.AddToProcessorMap("GetUserTeams", &teamServiceProcessorGetUserTeams{handler: handler})
-	self.AddToProcessorMap("InviteUser", &teamServiceProcessorInviteUser{handler: handler})
-	self.AddToProcessorMap("GetTeamMembers", &teamServiceProcessorGetTeamMembers{handler: handler})
-	self.AddToProcessorMap("RemoveTeamMember", &teamServiceProcessorRemoveTeamMember{handler: handler})
-	self.AddToProcessorMap("AcceptInvitation", &teamServiceProcessorAcceptInvitation{handler: handler})
-	self.AddToProcessorMap("RejectInvitation", &teamServiceProcessorRejectInvitation{handler: handler})
-	self.AddToProcessorMap("GetUserInvitations", &teamServiceProcessorGetUserInvitations{handler: handler})
-	return self
-}
-func (p *TeamServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+func (p *TeamAPIKey) IsSetRevokedAt() bool {
+	return p.RevokedAt != nil
 }
 
-type teamServiceProcessorCreateTeam struct {
-	handler TeamService
-}
+func (p *TeamAPIKey) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *teamServiceProcessorCreateTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceCreateTeamArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceCreateTeamResult{}
-	var retval *CreateTeamResp
-	if retval, err2 = p.handler.CreateTeam(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTeam: "+err2.Error())
-		oprot.WriteMessageBegin("CreateTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateTeam", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return true, err
-}
-
-type teamServiceProcessorGetTeam struct {
-	handler TeamService
-}
 
-func (p *teamServiceProcessorGetTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceGetTeamArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceGetTeamResult{}
-	var retval *GetTeamResp
-	if retval, err2 = p.handler.GetTeam(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTeam: "+err2.Error())
-		oprot.WriteMessageBegin("GetTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamAPIKey[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamAPIKey) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("GetTeam", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.ID = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.TeamID = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.CreatorID = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.Name = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.KeyPrefix = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Scopes = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpiresAt = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastUsedAt = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastUsedIP = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RevokedAt = _field
+	return nil
+}
+func (p *TeamAPIKey) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *TeamAPIKey) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamAPIKey"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key_prefix", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.KeyPrefix); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scopes", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Scopes)); err != nil {
+		return err
+	}
+	for _, v := range p.Scopes {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expires_at", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ExpiresAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastUsedAt() {
+		if err = oprot.WriteFieldBegin("last_used_at", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LastUsedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastUsedIP() {
+		if err = oprot.WriteFieldBegin("last_used_ip", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LastUsedIP); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRevokedAt() {
+		if err = oprot.WriteFieldBegin("revoked_at", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RevokedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *TeamAPIKey) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamAPIKey(%+v)", *p)
+
+}
+
+// 创建团队API Key请求
+type CreateTeamAPIKeyReq struct {
+	TeamID        string   `thrift:"team_id,1" form:"team_id" json:"team_id"`
+	Name          string   `thrift:"name,2" form:"name" json:"name"`
+	Scopes        []string `thrift:"scopes,3,default,list<string>" form:"scopes" json:"scopes"`
+	ExpiresInDays *int32   `thrift:"expires_in_days,4,optional" form:"expires_in_days" json:"expires_in_days,omitempty"`
+}
+
+func NewCreateTeamAPIKeyReq() *CreateTeamAPIKeyReq {
+	return &CreateTeamAPIKeyReq{}
+}
+
+func (p *CreateTeamAPIKeyReq) InitDefault() {
+}
+
+func (p *CreateTeamAPIKeyReq) GetTeamID() (v string) {
+	return p.TeamID
+}
+
+func (p *CreateTeamAPIKeyReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateTeamAPIKeyReq) GetScopes() (v []string) {
+	return p.Scopes
+}
+
+var CreateTeamAPIKeyReq_ExpiresInDays_DEFAULT int32
+
+func (p *CreateTeamAPIKeyReq) GetExpiresInDays() (v int32) {
+	if !p.IsSetExpiresInDays() {
+		return CreateTeamAPIKeyReq_ExpiresInDays_DEFAULT
+	}
+	return *p.ExpiresInDays
+}
+
+var fieldIDToName_CreateTeamAPIKeyReq = map[int16]string{
+	1: "team_id",
+	2: "name",
+	3: "scopes",
+	4: "expires_in_days",
+}
+
+func (p *CreateTeamAPIKeyReq) IsSetExpiresInDays() bool {
+	return p.ExpiresInDays != nil
+}
+
+func (p *CreateTeamAPIKeyReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTeamAPIKeyReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TeamID = _field
+	return nil
+}
+func (p *CreateTeamAPIKeyReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *CreateTeamAPIKeyReq) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Scopes = _field
+	return nil
+}
+func (p *CreateTeamAPIKeyReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExpiresInDays = _field
+	return nil
+}
+
+func (p *CreateTeamAPIKeyReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateTeamAPIKeyReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scopes", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Scopes)); err != nil {
+		return err
+	}
+	for _, v := range p.Scopes {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresInDays() {
+		if err = oprot.WriteFieldBegin("expires_in_days", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ExpiresInDays); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTeamAPIKeyReq(%+v)", *p)
+
+}
+
+// 创建团队API Key响应（明文密钥只返回这一次）
+type CreateTeamAPIKeyResp struct {
+	APIKey   *TeamAPIKey      `thrift:"api_key,1" form:"api_key" json:"api_key" query:"api_key"`
+	Key      string           `thrift:"key,2" form:"key" json:"key" query:"key"`
+	BaseResp *common.BaseResp `thrift:"base_resp,3" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewCreateTeamAPIKeyResp() *CreateTeamAPIKeyResp {
+	return &CreateTeamAPIKeyResp{}
+}
+
+func (p *CreateTeamAPIKeyResp) InitDefault() {
+}
+
+var CreateTeamAPIKeyResp_APIKey_DEFAULT *TeamAPIKey
+
+func (p *CreateTeamAPIKeyResp) GetAPIKey() (v *TeamAPIKey) {
+	if !p.IsSetAPIKey() {
+		return CreateTeamAPIKeyResp_APIKey_DEFAULT
+	}
+	return p.APIKey
+}
+
+func (p *CreateTeamAPIKeyResp) GetKey() (v string) {
+	return p.Key
+}
+
+var CreateTeamAPIKeyResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CreateTeamAPIKeyResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateTeamAPIKeyResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_CreateTeamAPIKeyResp = map[int16]string{
+	1: "api_key",
+	2: "key",
+	3: "base_resp",
+}
+
+func (p *CreateTeamAPIKeyResp) IsSetAPIKey() bool {
+	return p.APIKey != nil
+}
+
+func (p *CreateTeamAPIKeyResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateTeamAPIKeyResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTeamAPIKeyResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTeamAPIKey()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.APIKey = _field
+	return nil
+}
+func (p *CreateTeamAPIKeyResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Key = _field
+	return nil
+}
+func (p *CreateTeamAPIKeyResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *CreateTeamAPIKeyResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateTeamAPIKeyResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("api_key", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.APIKey.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Key); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CreateTeamAPIKeyResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTeamAPIKeyResp(%+v)", *p)
+
+}
+
+// 获取团队API Key列表请求
+type ListTeamAPIKeysReq struct {
+	TeamID string `thrift:"team_id,1" form:"team_id" json:"team_id"`
+}
+
+func NewListTeamAPIKeysReq() *ListTeamAPIKeysReq {
+	return &ListTeamAPIKeysReq{}
+}
+
+func (p *ListTeamAPIKeysReq) InitDefault() {
+}
+
+func (p *ListTeamAPIKeysReq) GetTeamID() (v string) {
+	return p.TeamID
+}
+
+var fieldIDToName_ListTeamAPIKeysReq = map[int16]string{
+	1: "team_id",
+}
+
+func (p *ListTeamAPIKeysReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTeamAPIKeysReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TeamID = _field
+	return nil
+}
+
+func (p *ListTeamAPIKeysReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListTeamAPIKeysReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTeamAPIKeysReq(%+v)", *p)
+
+}
+
+// 获取团队API Key列表响应
+type ListTeamAPIKeysResp struct {
+	APIKeys  []*TeamAPIKey    `thrift:"api_keys,1,default,list<TeamAPIKey>" form:"api_keys" json:"api_keys" query:"api_keys"`
+	BaseResp *common.BaseResp `thrift:"base_resp,2" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewListTeamAPIKeysResp() *ListTeamAPIKeysResp {
+	return &ListTeamAPIKeysResp{}
+}
+
+func (p *ListTeamAPIKeysResp) InitDefault() {
+}
+
+func (p *ListTeamAPIKeysResp) GetAPIKeys() (v []*TeamAPIKey) {
+	return p.APIKeys
+}
+
+var ListTeamAPIKeysResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListTeamAPIKeysResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListTeamAPIKeysResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ListTeamAPIKeysResp = map[int16]string{
+	1: "api_keys",
+	2: "base_resp",
+}
+
+func (p *ListTeamAPIKeysResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListTeamAPIKeysResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTeamAPIKeysResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TeamAPIKey, 0, size)
+	values := make([]TeamAPIKey, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.APIKeys = _field
+	return nil
+}
+func (p *ListTeamAPIKeysResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListTeamAPIKeysResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListTeamAPIKeysResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("api_keys", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.APIKeys)); err != nil {
+		return err
+	}
+	for _, v := range p.APIKeys {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListTeamAPIKeysResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTeamAPIKeysResp(%+v)", *p)
+
+}
+
+// 吊销团队API Key请求
+type RevokeTeamAPIKeyReq struct {
+	TeamID string `thrift:"team_id,1" form:"team_id" json:"team_id"`
+	KeyID  int64  `thrift:"key_id,2" form:"key_id" json:"key_id"`
+}
+
+func NewRevokeTeamAPIKeyReq() *RevokeTeamAPIKeyReq {
+	return &RevokeTeamAPIKeyReq{}
+}
+
+func (p *RevokeTeamAPIKeyReq) InitDefault() {
+}
+
+func (p *RevokeTeamAPIKeyReq) GetTeamID() (v string) {
+	return p.TeamID
+}
+
+func (p *RevokeTeamAPIKeyReq) GetKeyID() (v int64) {
+	return p.KeyID
+}
+
+var fieldIDToName_RevokeTeamAPIKeyReq = map[int16]string{
+	1: "team_id",
+	2: "key_id",
+}
+
+func (p *RevokeTeamAPIKeyReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeTeamAPIKeyReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TeamID = _field
+	return nil
+}
+func (p *RevokeTeamAPIKeyReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.KeyID = _field
+	return nil
+}
+
+func (p *RevokeTeamAPIKeyReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeTeamAPIKeyReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.KeyID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeTeamAPIKeyReq(%+v)", *p)
+
+}
+
+// 吊销团队API Key响应
+type RevokeTeamAPIKeyResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewRevokeTeamAPIKeyResp() *RevokeTeamAPIKeyResp {
+	return &RevokeTeamAPIKeyResp{}
+}
+
+func (p *RevokeTeamAPIKeyResp) InitDefault() {
+}
+
+var RevokeTeamAPIKeyResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RevokeTeamAPIKeyResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RevokeTeamAPIKeyResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_RevokeTeamAPIKeyResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *RevokeTeamAPIKeyResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RevokeTeamAPIKeyResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeTeamAPIKeyResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RevokeTeamAPIKeyResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeTeamAPIKeyResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeTeamAPIKeyResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeTeamAPIKeyResp(%+v)", *p)
+
+}
+
+// 团队服务接口
+type TeamService interface {
+	// 创建团队
+	CreateTeam(ctx context.Context, req *CreateTeamReq) (r *CreateTeamResp, err error)
+	// 获取团队详情
+	GetTeam(ctx context.Context, req *GetTeamReq) (r *GetTeamResp, err error)
+	// 更新团队
+	UpdateTeam(ctx context.Context, req *UpdateTeamReq) (r *UpdateTeamResp, err error)
+	// 获取用户团队列表
+	GetUserTeams(ctx context.Context, req *GetUserTeamsReq) (r *GetUserTeamsResp, err error)
+	// 邀请用户加入团队
+	InviteUser(ctx context.Context, req *InviteUserReq) (r *InviteUserResp, err error)
+	// 获取团队成员列表
+	GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error)
+	// 移除团队成员
+	RemoveTeamMember(ctx context.Context, req *RemoveTeamMemberReq) (r *RemoveTeamMemberResp, err error)
+	// 接受邀请
+	AcceptInvitation(ctx context.Context, req *AcceptInvitationReq) (r *AcceptInvitationResp, err error)
+	// 拒绝邀请
+	RejectInvitation(ctx context.Context, req *RejectInvitationReq) (r *RejectInvitationResp, err error)
+	// 获取用户邀请列表
+	GetUserInvitations(ctx context.Context, req *GetUserInvitationsReq) (r *GetUserInvitationsResp, err error)
+	// 创建团队API Key（仅 creator 和 owner）
+	CreateTeamAPIKey(ctx context.Context, req *CreateTeamAPIKeyReq) (r *CreateTeamAPIKeyResp, err error)
+	// 获取团队API Key列表（仅 creator 和 owner）
+	ListTeamAPIKeys(ctx context.Context, req *ListTeamAPIKeysReq) (r *ListTeamAPIKeysResp, err error)
+	// 吊销团队API Key（仅 creator 和 owner）
+	RevokeTeamAPIKey(ctx context.Context, req *RevokeTeamAPIKeyReq) (r *RevokeTeamAPIKeyResp, err error)
+}
+
+type TeamServiceClient struct {
+	c thrift.TClient
+}
+
+func NewTeamServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TeamServiceClient {
+	return &TeamServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewTeamServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TeamServiceClient {
+	return &TeamServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewTeamServiceClient(c thrift.TClient) *TeamServiceClient {
+	return &TeamServiceClient{
+		c: c,
+	}
+}
+
+func (p *TeamServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *TeamServiceClient) CreateTeam(ctx context.Context, req *CreateTeamReq) (r *CreateTeamResp, err error) {
+	var _args TeamServiceCreateTeamArgs
+	_args.Req = req
+	var _result TeamServiceCreateTeamResult
+	if err = p.Client_().Call(ctx, "CreateTeam", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) GetTeam(ctx context.Context, req *GetTeamReq) (r *GetTeamResp, err error) {
+	var _args TeamServiceGetTeamArgs
+	_args.Req = req
+	var _result TeamServiceGetTeamResult
+	if err = p.Client_().Call(ctx, "GetTeam", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) UpdateTeam(ctx context.Context, req *UpdateTeamReq) (r *UpdateTeamResp, err error) {
+	var _args TeamServiceUpdateTeamArgs
+	_args.Req = req
+	var _result TeamServiceUpdateTeamResult
+	if err = p.Client_().Call(ctx, "UpdateTeam", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) GetUserTeams(ctx context.Context, req *GetUserTeamsReq) (r *GetUserTeamsResp, err error) {
+	var _args TeamServiceGetUserTeamsArgs
+	_args.Req = req
+	var _result TeamServiceGetUserTeamsResult
+	if err = p.Client_().Call(ctx, "GetUserTeams", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) InviteUser(ctx context.Context, req *InviteUserReq) (r *InviteUserResp, err error) {
+	var _args TeamServiceInviteUserArgs
+	_args.Req = req
+	var _result TeamServiceInviteUserResult
+	if err = p.Client_().Call(ctx, "InviteUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) GetTeamMembers(ctx context.Context, req *GetTeamMembersReq) (r *GetTeamMembersResp, err error) {
+	var _args TeamServiceGetTeamMembersArgs
+	_args.Req = req
+	var _result TeamServiceGetTeamMembersResult
+	if err = p.Client_().Call(ctx, "GetTeamMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) RemoveTeamMember(ctx context.Context, req *RemoveTeamMemberReq) (r *RemoveTeamMemberResp, err error) {
+	var _args TeamServiceRemoveTeamMemberArgs
+	_args.Req = req
+	var _result TeamServiceRemoveTeamMemberResult
+	if err = p.Client_().Call(ctx, "RemoveTeamMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) AcceptInvitation(ctx context.Context, req *AcceptInvitationReq) (r *AcceptInvitationResp, err error) {
+	var _args TeamServiceAcceptInvitationArgs
+	_args.Req = req
+	var _result TeamServiceAcceptInvitationResult
+	if err = p.Client_().Call(ctx, "AcceptInvitation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) RejectInvitation(ctx context.Context, req *RejectInvitationReq) (r *RejectInvitationResp, err error) {
+	var _args TeamServiceRejectInvitationArgs
+	_args.Req = req
+	var _result TeamServiceRejectInvitationResult
+	if err = p.Client_().Call(ctx, "RejectInvitation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) GetUserInvitations(ctx context.Context, req *GetUserInvitationsReq) (r *GetUserInvitationsResp, err error) {
+	var _args TeamServiceGetUserInvitationsArgs
+	_args.Req = req
+	var _result TeamServiceGetUserInvitationsResult
+	if err = p.Client_().Call(ctx, "GetUserInvitations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) CreateTeamAPIKey(ctx context.Context, req *CreateTeamAPIKeyReq) (r *CreateTeamAPIKeyResp, err error) {
+	var _args TeamServiceCreateTeamAPIKeyArgs
+	_args.Req = req
+	var _result TeamServiceCreateTeamAPIKeyResult
+	if err = p.Client_().Call(ctx, "CreateTeamAPIKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) ListTeamAPIKeys(ctx context.Context, req *ListTeamAPIKeysReq) (r *ListTeamAPIKeysResp, err error) {
+	var _args TeamServiceListTeamAPIKeysArgs
+	_args.Req = req
+	var _result TeamServiceListTeamAPIKeysResult
+	if err = p.Client_().Call(ctx, "ListTeamAPIKeys", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) RevokeTeamAPIKey(ctx context.Context, req *RevokeTeamAPIKeyReq) (r *RevokeTeamAPIKeyResp, err error) {
+	var _args TeamServiceRevokeTeamAPIKeyArgs
+	_args.Req = req
+	var _result TeamServiceRevokeTeamAPIKeyResult
+	if err = p.Client_().Call(ctx, "RevokeTeamAPIKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type TeamServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      TeamService
+}
+
+func (p *TeamServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *TeamServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *TeamServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewTeamServiceProcessor(handler TeamService) *TeamServiceProcessor {
+	self := &TeamServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreateTeam", &teamServiceProcessorCreateTeam{handler: handler})
+	self.AddToProcessorMap("GetTeam", &teamServiceProcessorGetTeam{handler: handler})
+	self.AddToProcessorMap("UpdateTeam", &teamServiceProcessorUpdateTeam{handler: handler})
+	self.AddToProcessorMap("GetUserTeams", &teamServiceProcessorGetUserTeams{handler: handler})
+	self.AddToProcessorMap("InviteUser", &teamServiceProcessorInviteUser{handler: handler})
+	self.AddToProcessorMap("GetTeamMembers", &teamServiceProcessorGetTeamMembers{handler: handler})
+	self.AddToProcessorMap("RemoveTeamMember", &teamServiceProcessorRemoveTeamMember{handler: handler})
+	self.AddToProcessorMap("AcceptInvitation", &teamServiceProcessorAcceptInvitation{handler: handler})
+	self.AddToProcessorMap("RejectInvitation", &teamServiceProcessorRejectInvitation{handler: handler})
+	self.AddToProcessorMap("GetUserInvitations", &teamServiceProcessorGetUserInvitations{handler: handler})
+	self.AddToProcessorMap("CreateTeamAPIKey", &teamServiceProcessorCreateTeamAPIKey{handler: handler})
+	self.AddToProcessorMap("ListTeamAPIKeys", &teamServiceProcessorListTeamAPIKeys{handler: handler})
+	self.AddToProcessorMap("RevokeTeamAPIKey", &teamServiceProcessorRevokeTeamAPIKey{handler: handler})
+	return self
+}
+func (p *TeamServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type teamServiceProcessorCreateTeam struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorCreateTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceCreateTeamArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceCreateTeamResult{}
+	var retval *CreateTeamResp
+	if retval, err2 = p.handler.CreateTeam(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTeam: "+err2.Error())
+		oprot.WriteMessageBegin("CreateTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateTeam", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorGetTeam struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorGetTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceGetTeamArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceGetTeamResult{}
+	var retval *GetTeamResp
+	if retval, err2 = p.handler.GetTeam(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTeam: "+err2.Error())
+		oprot.WriteMessageBegin("GetTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTeam", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorUpdateTeam struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorUpdateTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceUpdateTeamArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceUpdateTeamResult{}
+	var retval *UpdateTeamResp
+	if retval, err2 = p.handler.UpdateTeam(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTeam: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateTeam", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorGetUserTeams struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorGetUserTeams) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceGetUserTeamsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserTeams", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceGetUserTeamsResult{}
+	var retval *GetUserTeamsResp
+	if retval, err2 = p.handler.GetUserTeams(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserTeams: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserTeams", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserTeams", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorInviteUser struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorInviteUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceInviteUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("InviteUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceInviteUserResult{}
+	var retval *InviteUserResp
+	if retval, err2 = p.handler.InviteUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing InviteUser: "+err2.Error())
+		oprot.WriteMessageBegin("InviteUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("InviteUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorGetTeamMembers struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorGetTeamMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceGetTeamMembersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceGetTeamMembersResult{}
+	var retval *GetTeamMembersResp
+	if retval, err2 = p.handler.GetTeamMembers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTeamMembers: "+err2.Error())
+		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTeamMembers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorRemoveTeamMember struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorRemoveTeamMember) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceRemoveTeamMemberArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RemoveTeamMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceRemoveTeamMemberResult{}
+	var retval *RemoveTeamMemberResp
+	if retval, err2 = p.handler.RemoveTeamMember(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveTeamMember: "+err2.Error())
+		oprot.WriteMessageBegin("RemoveTeamMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RemoveTeamMember", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorAcceptInvitation struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorAcceptInvitation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceAcceptInvitationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AcceptInvitation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceAcceptInvitationResult{}
+	var retval *AcceptInvitationResp
+	if retval, err2 = p.handler.AcceptInvitation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AcceptInvitation: "+err2.Error())
+		oprot.WriteMessageBegin("AcceptInvitation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AcceptInvitation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorRejectInvitation struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorRejectInvitation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceRejectInvitationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RejectInvitation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceRejectInvitationResult{}
+	var retval *RejectInvitationResp
+	if retval, err2 = p.handler.RejectInvitation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RejectInvitation: "+err2.Error())
+		oprot.WriteMessageBegin("RejectInvitation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RejectInvitation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorGetUserInvitations struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorGetUserInvitations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceGetUserInvitationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserInvitations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceGetUserInvitationsResult{}
+	var retval *GetUserInvitationsResp
+	if retval, err2 = p.handler.GetUserInvitations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserInvitations: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserInvitations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserInvitations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorCreateTeamAPIKey struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorCreateTeamAPIKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceCreateTeamAPIKeyArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateTeamAPIKey", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceCreateTeamAPIKeyResult{}
+	var retval *CreateTeamAPIKeyResp
+	if retval, err2 = p.handler.CreateTeamAPIKey(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTeamAPIKey: "+err2.Error())
+		oprot.WriteMessageBegin("CreateTeamAPIKey", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateTeamAPIKey", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorListTeamAPIKeys struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorListTeamAPIKeys) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceListTeamAPIKeysArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListTeamAPIKeys", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceListTeamAPIKeysResult{}
+	var retval *ListTeamAPIKeysResp
+	if retval, err2 = p.handler.ListTeamAPIKeys(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTeamAPIKeys: "+err2.Error())
+		oprot.WriteMessageBegin("ListTeamAPIKeys", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListTeamAPIKeys", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorRevokeTeamAPIKey struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorRevokeTeamAPIKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceRevokeTeamAPIKeyArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RevokeTeamAPIKey", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceRevokeTeamAPIKeyResult{}
+	var retval *RevokeTeamAPIKeyResp
+	if retval, err2 = p.handler.RevokeTeamAPIKey(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevokeTeamAPIKey: "+err2.Error())
+		oprot.WriteMessageBegin("RevokeTeamAPIKey", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RevokeTeamAPIKey", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type TeamServiceCreateTeamArgs struct {
+	Req *CreateTeamReq `thrift:"req,1"`
+}
+
+func NewTeamServiceCreateTeamArgs() *TeamServiceCreateTeamArgs {
+	return &TeamServiceCreateTeamArgs{}
+}
+
+func (p *TeamServiceCreateTeamArgs) InitDefault() {
+}
+
+var TeamServiceCreateTeamArgs_Req_DEFAULT *CreateTeamReq
+
+func (p *TeamServiceCreateTeamArgs) GetReq() (v *CreateTeamReq) {
+	if !p.IsSetReq() {
+		return TeamServiceCreateTeamArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_TeamServiceCreateTeamArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceCreateTeamArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceCreateTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceCreateTeamArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceCreateTeamArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateTeamReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TeamServiceCreateTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateTeam_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceCreateTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceCreateTeamArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceCreateTeamArgs(%+v)", *p)
+
+}
+
+type TeamServiceCreateTeamResult struct {
+	Success *CreateTeamResp `thrift:"success,0,optional"`
+}
+
+func NewTeamServiceCreateTeamResult() *TeamServiceCreateTeamResult {
+	return &TeamServiceCreateTeamResult{}
+}
+
+func (p *TeamServiceCreateTeamResult) InitDefault() {
+}
+
+var TeamServiceCreateTeamResult_Success_DEFAULT *CreateTeamResp
+
+func (p *TeamServiceCreateTeamResult) GetSuccess() (v *CreateTeamResp) {
+	if !p.IsSetSuccess() {
+		return TeamServiceCreateTeamResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_TeamServiceCreateTeamResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceCreateTeamResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceCreateTeamResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceCreateTeamResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceCreateTeamResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateTeamResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TeamServiceCreateTeamResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateTeam_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceCreateTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceCreateTeamResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceCreateTeamResult(%+v)", *p)
+
+}
+
+type TeamServiceGetTeamArgs struct {
+	Req *GetTeamReq `thrift:"req,1"`
+}
+
+func NewTeamServiceGetTeamArgs() *TeamServiceGetTeamArgs {
+	return &TeamServiceGetTeamArgs{}
+}
+
+func (p *TeamServiceGetTeamArgs) InitDefault() {
+}
+
+var TeamServiceGetTeamArgs_Req_DEFAULT *GetTeamReq
+
+func (p *TeamServiceGetTeamArgs) GetReq() (v *GetTeamReq) {
+	if !p.IsSetReq() {
+		return TeamServiceGetTeamArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_TeamServiceGetTeamArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceGetTeamArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceGetTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceGetTeamArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTeamReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TeamServiceGetTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTeam_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceGetTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceGetTeamArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceGetTeamArgs(%+v)", *p)
+
+}
+
+type TeamServiceGetTeamResult struct {
+	Success *GetTeamResp `thrift:"success,0,optional"`
+}
+
+func NewTeamServiceGetTeamResult() *TeamServiceGetTeamResult {
+	return &TeamServiceGetTeamResult{}
+}
+
+func (p *TeamServiceGetTeamResult) InitDefault() {
+}
+
+var TeamServiceGetTeamResult_Success_DEFAULT *GetTeamResp
+
+func (p *TeamServiceGetTeamResult) GetSuccess() (v *GetTeamResp) {
+	if !p.IsSetSuccess() {
+		return TeamServiceGetTeamResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_TeamServiceGetTeamResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceGetTeamResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceGetTeamResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type teamServiceProcessorUpdateTeam struct {
-	handler TeamService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *teamServiceProcessorUpdateTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceUpdateTeamArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamServiceGetTeamResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTeamResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceUpdateTeamResult{}
-	var retval *UpdateTeamResp
-	if retval, err2 = p.handler.UpdateTeam(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTeam: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *TeamServiceGetTeamResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTeam_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateTeam", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceGetTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceGetTeamResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("TeamServiceGetTeamResult(%+v)", *p)
+
 }
 
-type teamServiceProcessorGetUserTeams struct {
-	handler TeamService
+type TeamServiceUpdateTeamArgs struct {
+	Req *UpdateTeamReq `thrift:"req,1"`
 }
 
-func (p *teamServiceProcessorGetUserTeams) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceGetUserTeamsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserTeams", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewTeamServiceUpdateTeamArgs() *TeamServiceUpdateTeamArgs {
+	return &TeamServiceUpdateTeamArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceGetUserTeamsResult{}
-	var retval *GetUserTeamsResp
-	if retval, err2 = p.handler.GetUserTeams(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserTeams: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserTeams", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetUserTeams", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *TeamServiceUpdateTeamArgs) InitDefault() {
+}
+
+var TeamServiceUpdateTeamArgs_Req_DEFAULT *UpdateTeamReq
+
+func (p *TeamServiceUpdateTeamArgs) GetReq() (v *UpdateTeamReq) {
+	if !p.IsSetReq() {
+		return TeamServiceUpdateTeamArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type teamServiceProcessorInviteUser struct {
-	handler TeamService
+var fieldIDToName_TeamServiceUpdateTeamArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *teamServiceProcessorInviteUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceInviteUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("InviteUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *TeamServiceUpdateTeamArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceInviteUserResult{}
-	var retval *InviteUserResp
-	if retval, err2 = p.handler.InviteUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing InviteUser: "+err2.Error())
-		oprot.WriteMessageBegin("InviteUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("InviteUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *TeamServiceUpdateTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type teamServiceProcessorGetTeamMembers struct {
-	handler TeamService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceUpdateTeamArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *teamServiceProcessorGetTeamMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceGetTeamMembersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamServiceUpdateTeamArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateTeamReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceGetTeamMembersResult{}
-	var retval *GetTeamMembersResp
-	if retval, err2 = p.handler.GetTeamMembers(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTeamMembers: "+err2.Error())
-		oprot.WriteMessageBegin("GetTeamMembers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *TeamServiceUpdateTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateTeam_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetTeamMembers", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceUpdateTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type teamServiceProcessorRemoveTeamMember struct {
-	handler TeamService
+func (p *TeamServiceUpdateTeamArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceUpdateTeamArgs(%+v)", *p)
+
 }
 
-func (p *teamServiceProcessorRemoveTeamMember) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceRemoveTeamMemberArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RemoveTeamMember", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type TeamServiceUpdateTeamResult struct {
+	Success *UpdateTeamResp `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceRemoveTeamMemberResult{}
-	var retval *RemoveTeamMemberResp
-	if retval, err2 = p.handler.RemoveTeamMember(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveTeamMember: "+err2.Error())
-		oprot.WriteMessageBegin("RemoveTeamMember", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RemoveTeamMember", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewTeamServiceUpdateTeamResult() *TeamServiceUpdateTeamResult {
+	return &TeamServiceUpdateTeamResult{}
 }
 
-type teamServiceProcessorAcceptInvitation struct {
-	handler TeamService
+func (p *TeamServiceUpdateTeamResult) InitDefault() {
 }
 
-func (p *teamServiceProcessorAcceptInvitation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceAcceptInvitationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AcceptInvitation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var TeamServiceUpdateTeamResult_Success_DEFAULT *UpdateTeamResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceAcceptInvitationResult{}
-	var retval *AcceptInvitationResp
-	if retval, err2 = p.handler.AcceptInvitation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AcceptInvitation: "+err2.Error())
-		oprot.WriteMessageBegin("AcceptInvitation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AcceptInvitation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *TeamServiceUpdateTeamResult) GetSuccess() (v *UpdateTeamResp) {
+	if !p.IsSetSuccess() {
+		return TeamServiceUpdateTeamResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type teamServiceProcessorRejectInvitation struct {
-	handler TeamService
+var fieldIDToName_TeamServiceUpdateTeamResult = map[int16]string{
+	0: "success",
 }
 
-func (p *teamServiceProcessorRejectInvitation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceRejectInvitationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RejectInvitation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *TeamServiceUpdateTeamResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceRejectInvitationResult{}
-	var retval *RejectInvitationResp
-	if retval, err2 = p.handler.RejectInvitation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RejectInvitation: "+err2.Error())
-		oprot.WriteMessageBegin("RejectInvitation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RejectInvitation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *TeamServiceUpdateTeamResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type teamServiceProcessorGetUserInvitations struct {
-	handler TeamService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceUpdateTeamResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *teamServiceProcessorGetUserInvitations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceGetUserInvitationsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserInvitations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamServiceUpdateTeamResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateTeamResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceGetUserInvitationsResult{}
-	var retval *GetUserInvitationsResp
-	if retval, err2 = p.handler.GetUserInvitations(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserInvitations: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserInvitations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *TeamServiceUpdateTeamResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateTeam_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetUserInvitations", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceUpdateTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceUpdateTeamResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("TeamServiceUpdateTeamResult(%+v)", *p)
+
 }
 
-type TeamServiceCreateTeamArgs struct {
-	Req *CreateTeamReq `thrift:"req,1"`
+type TeamServiceGetUserTeamsArgs struct {
+	Req *GetUserTeamsReq `thrift:"req,1"`
 }
 
-func NewTeamServiceCreateTeamArgs() *TeamServiceCreateTeamArgs {
-	return &TeamServiceCreateTeamArgs{}
+func NewTeamServiceGetUserTeamsArgs() *TeamServiceGetUserTeamsArgs {
+	return &TeamServiceGetUserTeamsArgs{}
 }
 
-func (p *TeamServiceCreateTeamArgs) InitDefault() {
+func (p *TeamServiceGetUserTeamsArgs) InitDefault() {
 }
 
-var TeamServiceCreateTeamArgs_Req_DEFAULT *CreateTeamReq
+var TeamServiceGetUserTeamsArgs_Req_DEFAULT *GetUserTeamsReq
 
-func (p *TeamServiceCreateTeamArgs) GetReq() (v *CreateTeamReq) {
+func (p *TeamServiceGetUserTeamsArgs) GetReq() (v *GetUserTeamsReq) {
 	if !p.IsSetReq() {
-		return TeamServiceCreateTeamArgs_Req_DEFAULT
+		return TeamServiceGetUserTeamsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceCreateTeamArgs = map[int16]string{
+var fieldIDToName_TeamServiceGetUserTeamsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceCreateTeamArgs) IsSetReq() bool {
+func (p *TeamServiceGetUserTeamsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceCreateTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserTeamsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6430,7 +9435,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceCreateTeamArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserTeamsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6440,8 +9445,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceCreateTeamArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateTeamReq()
+func (p *TeamServiceGetUserTeamsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserTeamsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6449,9 +9454,9 @@ func (p *TeamServiceCreateTeamArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceCreateTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserTeamsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTeam_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserTeams_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6477,7 +9482,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceCreateTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserTeamsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6494,43 +9499,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceCreateTeamArgs) String() string {
+func (p *TeamServiceGetUserTeamsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceCreateTeamArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceGetUserTeamsArgs(%+v)", *p)
 
 }
 
-type TeamServiceCreateTeamResult struct {
-	Success *CreateTeamResp `thrift:"success,0,optional"`
+type TeamServiceGetUserTeamsResult struct {
+	Success *GetUserTeamsResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceCreateTeamResult() *TeamServiceCreateTeamResult {
-	return &TeamServiceCreateTeamResult{}
+func NewTeamServiceGetUserTeamsResult() *TeamServiceGetUserTeamsResult {
+	return &TeamServiceGetUserTeamsResult{}
 }
 
-func (p *TeamServiceCreateTeamResult) InitDefault() {
+func (p *TeamServiceGetUserTeamsResult) InitDefault() {
 }
 
-var TeamServiceCreateTeamResult_Success_DEFAULT *CreateTeamResp
+var TeamServiceGetUserTeamsResult_Success_DEFAULT *GetUserTeamsResp
 
-func (p *TeamServiceCreateTeamResult) GetSuccess() (v *CreateTeamResp) {
+func (p *TeamServiceGetUserTeamsResult) GetSuccess() (v *GetUserTeamsResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceCreateTeamResult_Success_DEFAULT
+		return TeamServiceGetUserTeamsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceCreateTeamResult = map[int16]string{
+var fieldIDToName_TeamServiceGetUserTeamsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceCreateTeamResult) IsSetSuccess() bool {
+func (p *TeamServiceGetUserTeamsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceCreateTeamResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserTeamsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6576,7 +9581,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceCreateTeamResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserTeamsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6586,8 +9591,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceCreateTeamResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateTeamResp()
+func (p *TeamServiceGetUserTeamsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserTeamsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6595,9 +9600,9 @@ func (p *TeamServiceCreateTeamResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceCreateTeamResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserTeamsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTeam_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserTeams_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6623,7 +9628,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceCreateTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserTeamsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6642,43 +9647,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceCreateTeamResult) String() string {
+func (p *TeamServiceGetUserTeamsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceCreateTeamResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceGetUserTeamsResult(%+v)", *p)
 
 }
 
-type TeamServiceGetTeamArgs struct {
-	Req *GetTeamReq `thrift:"req,1"`
+type TeamServiceInviteUserArgs struct {
+	Req *InviteUserReq `thrift:"req,1"`
 }
 
-func NewTeamServiceGetTeamArgs() *TeamServiceGetTeamArgs {
-	return &TeamServiceGetTeamArgs{}
+func NewTeamServiceInviteUserArgs() *TeamServiceInviteUserArgs {
+	return &TeamServiceInviteUserArgs{}
 }
 
-func (p *TeamServiceGetTeamArgs) InitDefault() {
+func (p *TeamServiceInviteUserArgs) InitDefault() {
 }
 
-var TeamServiceGetTeamArgs_Req_DEFAULT *GetTeamReq
+var TeamServiceInviteUserArgs_Req_DEFAULT *InviteUserReq
 
-func (p *TeamServiceGetTeamArgs) GetReq() (v *GetTeamReq) {
+func (p *TeamServiceInviteUserArgs) GetReq() (v *InviteUserReq) {
 	if !p.IsSetReq() {
-		return TeamServiceGetTeamArgs_Req_DEFAULT
+		return TeamServiceInviteUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceGetTeamArgs = map[int16]string{
+var fieldIDToName_TeamServiceInviteUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceGetTeamArgs) IsSetReq() bool {
+func (p *TeamServiceInviteUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceGetTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceInviteUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6724,7 +9729,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceInviteUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6734,8 +9739,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTeamReq()
+func (p *TeamServiceInviteUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewInviteUserReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6743,9 +9748,9 @@ func (p *TeamServiceGetTeamArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceGetTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceInviteUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeam_args"); err != nil {
+	if err = oprot.WriteStructBegin("InviteUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6771,7 +9776,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceInviteUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6788,43 +9793,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamArgs) String() string {
+func (p *TeamServiceInviteUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetTeamArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceInviteUserArgs(%+v)", *p)
 
 }
 
-type TeamServiceGetTeamResult struct {
-	Success *GetTeamResp `thrift:"success,0,optional"`
+type TeamServiceInviteUserResult struct {
+	Success *InviteUserResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceGetTeamResult() *TeamServiceGetTeamResult {
-	return &TeamServiceGetTeamResult{}
+func NewTeamServiceInviteUserResult() *TeamServiceInviteUserResult {
+	return &TeamServiceInviteUserResult{}
 }
 
-func (p *TeamServiceGetTeamResult) InitDefault() {
+func (p *TeamServiceInviteUserResult) InitDefault() {
 }
 
-var TeamServiceGetTeamResult_Success_DEFAULT *GetTeamResp
+var TeamServiceInviteUserResult_Success_DEFAULT *InviteUserResp
 
-func (p *TeamServiceGetTeamResult) GetSuccess() (v *GetTeamResp) {
+func (p *TeamServiceInviteUserResult) GetSuccess() (v *InviteUserResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceGetTeamResult_Success_DEFAULT
+		return TeamServiceInviteUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceGetTeamResult = map[int16]string{
+var fieldIDToName_TeamServiceInviteUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceGetTeamResult) IsSetSuccess() bool {
+func (p *TeamServiceInviteUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceGetTeamResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceInviteUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6870,7 +9875,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceInviteUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6880,8 +9885,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTeamResp()
+func (p *TeamServiceInviteUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewInviteUserResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6889,9 +9894,9 @@ func (p *TeamServiceGetTeamResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceGetTeamResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceInviteUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeam_result"); err != nil {
+	if err = oprot.WriteStructBegin("InviteUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6917,7 +9922,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceInviteUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6936,43 +9941,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamResult) String() string {
+func (p *TeamServiceInviteUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetTeamResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceInviteUserResult(%+v)", *p)
 
 }
 
-type TeamServiceUpdateTeamArgs struct {
-	Req *UpdateTeamReq `thrift:"req,1"`
+type TeamServiceGetTeamMembersArgs struct {
+	Req *GetTeamMembersReq `thrift:"req,1"`
 }
 
-func NewTeamServiceUpdateTeamArgs() *TeamServiceUpdateTeamArgs {
-	return &TeamServiceUpdateTeamArgs{}
+func NewTeamServiceGetTeamMembersArgs() *TeamServiceGetTeamMembersArgs {
+	return &TeamServiceGetTeamMembersArgs{}
 }
 
-func (p *TeamServiceUpdateTeamArgs) InitDefault() {
+func (p *TeamServiceGetTeamMembersArgs) InitDefault() {
 }
 
-var TeamServiceUpdateTeamArgs_Req_DEFAULT *UpdateTeamReq
+var TeamServiceGetTeamMembersArgs_Req_DEFAULT *GetTeamMembersReq
 
-func (p *TeamServiceUpdateTeamArgs) GetReq() (v *UpdateTeamReq) {
+func (p *TeamServiceGetTeamMembersArgs) GetReq() (v *GetTeamMembersReq) {
 	if !p.IsSetReq() {
-		return TeamServiceUpdateTeamArgs_Req_DEFAULT
+		return TeamServiceGetTeamMembersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceUpdateTeamArgs = map[int16]string{
+var fieldIDToName_TeamServiceGetTeamMembersArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceUpdateTeamArgs) IsSetReq() bool {
+func (p *TeamServiceGetTeamMembersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceUpdateTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetTeamMembersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7018,7 +10023,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceUpdateTeamArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamMembersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7028,8 +10033,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceUpdateTeamArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateTeamReq()
+func (p *TeamServiceGetTeamMembersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTeamMembersReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7037,9 +10042,9 @@ func (p *TeamServiceUpdateTeamArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceUpdateTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetTeamMembersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTeam_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTeamMembers_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7065,7 +10070,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceUpdateTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetTeamMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7082,43 +10087,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceUpdateTeamArgs) String() string {
+func (p *TeamServiceGetTeamMembersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceUpdateTeamArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceGetTeamMembersArgs(%+v)", *p)
 
 }
 
-type TeamServiceUpdateTeamResult struct {
-	Success *UpdateTeamResp `thrift:"success,0,optional"`
+type TeamServiceGetTeamMembersResult struct {
+	Success *GetTeamMembersResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceUpdateTeamResult() *TeamServiceUpdateTeamResult {
-	return &TeamServiceUpdateTeamResult{}
+func NewTeamServiceGetTeamMembersResult() *TeamServiceGetTeamMembersResult {
+	return &TeamServiceGetTeamMembersResult{}
 }
 
-func (p *TeamServiceUpdateTeamResult) InitDefault() {
+func (p *TeamServiceGetTeamMembersResult) InitDefault() {
 }
 
-var TeamServiceUpdateTeamResult_Success_DEFAULT *UpdateTeamResp
+var TeamServiceGetTeamMembersResult_Success_DEFAULT *GetTeamMembersResp
 
-func (p *TeamServiceUpdateTeamResult) GetSuccess() (v *UpdateTeamResp) {
+func (p *TeamServiceGetTeamMembersResult) GetSuccess() (v *GetTeamMembersResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceUpdateTeamResult_Success_DEFAULT
+		return TeamServiceGetTeamMembersResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceUpdateTeamResult = map[int16]string{
+var fieldIDToName_TeamServiceGetTeamMembersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceUpdateTeamResult) IsSetSuccess() bool {
+func (p *TeamServiceGetTeamMembersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceUpdateTeamResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetTeamMembersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7164,7 +10169,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceUpdateTeamResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamMembersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7174,8 +10179,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceUpdateTeamResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateTeamResp()
+func (p *TeamServiceGetTeamMembersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTeamMembersResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7183,9 +10188,9 @@ func (p *TeamServiceUpdateTeamResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceUpdateTeamResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetTeamMembersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTeam_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTeamMembers_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7211,7 +10216,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceUpdateTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetTeamMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7230,43 +10235,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceUpdateTeamResult) String() string {
+func (p *TeamServiceGetTeamMembersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceUpdateTeamResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceGetTeamMembersResult(%+v)", *p)
 
 }
 
-type TeamServiceGetUserTeamsArgs struct {
-	Req *GetUserTeamsReq `thrift:"req,1"`
+type TeamServiceRemoveTeamMemberArgs struct {
+	Req *RemoveTeamMemberReq `thrift:"req,1"`
 }
 
-func NewTeamServiceGetUserTeamsArgs() *TeamServiceGetUserTeamsArgs {
-	return &TeamServiceGetUserTeamsArgs{}
+func NewTeamServiceRemoveTeamMemberArgs() *TeamServiceRemoveTeamMemberArgs {
+	return &TeamServiceRemoveTeamMemberArgs{}
 }
 
-func (p *TeamServiceGetUserTeamsArgs) InitDefault() {
+func (p *TeamServiceRemoveTeamMemberArgs) InitDefault() {
 }
 
-var TeamServiceGetUserTeamsArgs_Req_DEFAULT *GetUserTeamsReq
+var TeamServiceRemoveTeamMemberArgs_Req_DEFAULT *RemoveTeamMemberReq
 
-func (p *TeamServiceGetUserTeamsArgs) GetReq() (v *GetUserTeamsReq) {
+func (p *TeamServiceRemoveTeamMemberArgs) GetReq() (v *RemoveTeamMemberReq) {
 	if !p.IsSetReq() {
-		return TeamServiceGetUserTeamsArgs_Req_DEFAULT
+		return TeamServiceRemoveTeamMemberArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceGetUserTeamsArgs = map[int16]string{
+var fieldIDToName_TeamServiceRemoveTeamMemberArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceGetUserTeamsArgs) IsSetReq() bool {
+func (p *TeamServiceRemoveTeamMemberArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceGetUserTeamsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRemoveTeamMemberArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7312,7 +10317,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserTeamsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRemoveTeamMemberArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7322,8 +10327,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserTeamsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserTeamsReq()
+func (p *TeamServiceRemoveTeamMemberArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRemoveTeamMemberReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7331,9 +10336,9 @@ func (p *TeamServiceGetUserTeamsArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceGetUserTeamsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRemoveTeamMemberArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserTeams_args"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveTeamMember_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7359,7 +10364,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserTeamsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRemoveTeamMemberArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7376,43 +10381,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserTeamsArgs) String() string {
+func (p *TeamServiceRemoveTeamMemberArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetUserTeamsArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceRemoveTeamMemberArgs(%+v)", *p)
 
 }
 
-type TeamServiceGetUserTeamsResult struct {
-	Success *GetUserTeamsResp `thrift:"success,0,optional"`
+type TeamServiceRemoveTeamMemberResult struct {
+	Success *RemoveTeamMemberResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceGetUserTeamsResult() *TeamServiceGetUserTeamsResult {
-	return &TeamServiceGetUserTeamsResult{}
+func NewTeamServiceRemoveTeamMemberResult() *TeamServiceRemoveTeamMemberResult {
+	return &TeamServiceRemoveTeamMemberResult{}
 }
 
-func (p *TeamServiceGetUserTeamsResult) InitDefault() {
+func (p *TeamServiceRemoveTeamMemberResult) InitDefault() {
 }
 
-var TeamServiceGetUserTeamsResult_Success_DEFAULT *GetUserTeamsResp
+var TeamServiceRemoveTeamMemberResult_Success_DEFAULT *RemoveTeamMemberResp
 
-func (p *TeamServiceGetUserTeamsResult) GetSuccess() (v *GetUserTeamsResp) {
+func (p *TeamServiceRemoveTeamMemberResult) GetSuccess() (v *RemoveTeamMemberResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceGetUserTeamsResult_Success_DEFAULT
+		return TeamServiceRemoveTeamMemberResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceGetUserTeamsResult = map[int16]string{
+var fieldIDToName_TeamServiceRemoveTeamMemberResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceGetUserTeamsResult) IsSetSuccess() bool {
+func (p *TeamServiceRemoveTeamMemberResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceGetUserTeamsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRemoveTeamMemberResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7458,7 +10463,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserTeamsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRemoveTeamMemberResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7468,8 +10473,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserTeamsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserTeamsResp()
+func (p *TeamServiceRemoveTeamMemberResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRemoveTeamMemberResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7477,9 +10482,9 @@ func (p *TeamServiceGetUserTeamsResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamServiceGetUserTeamsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRemoveTeamMemberResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserTeams_result"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveTeamMember_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7505,7 +10510,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserTeamsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRemoveTeamMemberResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7524,43 +10529,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserTeamsResult) String() string {
+func (p *TeamServiceRemoveTeamMemberResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetUserTeamsResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceRemoveTeamMemberResult(%+v)", *p)
 
 }
 
-type TeamServiceInviteUserArgs struct {
-	Req *InviteUserReq `thrift:"req,1"`
+type TeamServiceAcceptInvitationArgs struct {
+	Req *AcceptInvitationReq `thrift:"req,1"`
 }
 
-func NewTeamServiceInviteUserArgs() *TeamServiceInviteUserArgs {
-	return &TeamServiceInviteUserArgs{}
+func NewTeamServiceAcceptInvitationArgs() *TeamServiceAcceptInvitationArgs {
+	return &TeamServiceAcceptInvitationArgs{}
 }
 
-func (p *TeamServiceInviteUserArgs) InitDefault() {
+func (p *TeamServiceAcceptInvitationArgs) InitDefault() {
 }
 
-var TeamServiceInviteUserArgs_Req_DEFAULT *InviteUserReq
+var TeamServiceAcceptInvitationArgs_Req_DEFAULT *AcceptInvitationReq
 
-func (p *TeamServiceInviteUserArgs) GetReq() (v *InviteUserReq) {
+func (p *TeamServiceAcceptInvitationArgs) GetReq() (v *AcceptInvitationReq) {
 	if !p.IsSetReq() {
-		return TeamServiceInviteUserArgs_Req_DEFAULT
+		return TeamServiceAcceptInvitationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceInviteUserArgs = map[int16]string{
+var fieldIDToName_TeamServiceAcceptInvitationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceInviteUserArgs) IsSetReq() bool {
+func (p *TeamServiceAcceptInvitationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceInviteUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceAcceptInvitationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7606,7 +10611,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceInviteUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceAcceptInvitationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7616,8 +10621,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceInviteUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewInviteUserReq()
+func (p *TeamServiceAcceptInvitationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAcceptInvitationReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7625,9 +10630,9 @@ func (p *TeamServiceInviteUserArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceInviteUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceAcceptInvitationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("InviteUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("AcceptInvitation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7653,7 +10658,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceInviteUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceAcceptInvitationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7670,43 +10675,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceInviteUserArgs) String() string {
+func (p *TeamServiceAcceptInvitationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceInviteUserArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceAcceptInvitationArgs(%+v)", *p)
 
 }
 
-type TeamServiceInviteUserResult struct {
-	Success *InviteUserResp `thrift:"success,0,optional"`
+type TeamServiceAcceptInvitationResult struct {
+	Success *AcceptInvitationResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceInviteUserResult() *TeamServiceInviteUserResult {
-	return &TeamServiceInviteUserResult{}
+func NewTeamServiceAcceptInvitationResult() *TeamServiceAcceptInvitationResult {
+	return &TeamServiceAcceptInvitationResult{}
 }
 
-func (p *TeamServiceInviteUserResult) InitDefault() {
+func (p *TeamServiceAcceptInvitationResult) InitDefault() {
 }
 
-var TeamServiceInviteUserResult_Success_DEFAULT *InviteUserResp
+var TeamServiceAcceptInvitationResult_Success_DEFAULT *AcceptInvitationResp
 
-func (p *TeamServiceInviteUserResult) GetSuccess() (v *InviteUserResp) {
+func (p *TeamServiceAcceptInvitationResult) GetSuccess() (v *AcceptInvitationResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceInviteUserResult_Success_DEFAULT
+		return TeamServiceAcceptInvitationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceInviteUserResult = map[int16]string{
+var fieldIDToName_TeamServiceAcceptInvitationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceInviteUserResult) IsSetSuccess() bool {
+func (p *TeamServiceAcceptInvitationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceInviteUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceAcceptInvitationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7752,7 +10757,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceInviteUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceAcceptInvitationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7762,8 +10767,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceInviteUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewInviteUserResp()
+func (p *TeamServiceAcceptInvitationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAcceptInvitationResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7771,9 +10776,9 @@ func (p *TeamServiceInviteUserResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamServiceInviteUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceAcceptInvitationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("InviteUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("AcceptInvitation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7799,7 +10804,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceInviteUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceAcceptInvitationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7818,43 +10823,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceInviteUserResult) String() string {
+func (p *TeamServiceAcceptInvitationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceInviteUserResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceAcceptInvitationResult(%+v)", *p)
 
 }
 
-type TeamServiceGetTeamMembersArgs struct {
-	Req *GetTeamMembersReq `thrift:"req,1"`
+type TeamServiceRejectInvitationArgs struct {
+	Req *RejectInvitationReq `thrift:"req,1"`
 }
 
-func NewTeamServiceGetTeamMembersArgs() *TeamServiceGetTeamMembersArgs {
-	return &TeamServiceGetTeamMembersArgs{}
+func NewTeamServiceRejectInvitationArgs() *TeamServiceRejectInvitationArgs {
+	return &TeamServiceRejectInvitationArgs{}
 }
 
-func (p *TeamServiceGetTeamMembersArgs) InitDefault() {
+func (p *TeamServiceRejectInvitationArgs) InitDefault() {
 }
 
-var TeamServiceGetTeamMembersArgs_Req_DEFAULT *GetTeamMembersReq
+var TeamServiceRejectInvitationArgs_Req_DEFAULT *RejectInvitationReq
 
-func (p *TeamServiceGetTeamMembersArgs) GetReq() (v *GetTeamMembersReq) {
+func (p *TeamServiceRejectInvitationArgs) GetReq() (v *RejectInvitationReq) {
 	if !p.IsSetReq() {
-		return TeamServiceGetTeamMembersArgs_Req_DEFAULT
+		return TeamServiceRejectInvitationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceGetTeamMembersArgs = map[int16]string{
+var fieldIDToName_TeamServiceRejectInvitationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceGetTeamMembersArgs) IsSetReq() bool {
+func (p *TeamServiceRejectInvitationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceGetTeamMembersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRejectInvitationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7900,7 +10905,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamMembersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRejectInvitationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7910,8 +10915,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamMembersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTeamMembersReq()
+func (p *TeamServiceRejectInvitationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRejectInvitationReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7919,9 +10924,9 @@ func (p *TeamServiceGetTeamMembersArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamServiceGetTeamMembersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRejectInvitationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeamMembers_args"); err != nil {
+	if err = oprot.WriteStructBegin("RejectInvitation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7947,7 +10952,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRejectInvitationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7964,43 +10969,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamMembersArgs) String() string {
+func (p *TeamServiceRejectInvitationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetTeamMembersArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceRejectInvitationArgs(%+v)", *p)
 
 }
 
-type TeamServiceGetTeamMembersResult struct {
-	Success *GetTeamMembersResp `thrift:"success,0,optional"`
+type TeamServiceRejectInvitationResult struct {
+	Success *RejectInvitationResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceGetTeamMembersResult() *TeamServiceGetTeamMembersResult {
-	return &TeamServiceGetTeamMembersResult{}
+func NewTeamServiceRejectInvitationResult() *TeamServiceRejectInvitationResult {
+	return &TeamServiceRejectInvitationResult{}
 }
 
-func (p *TeamServiceGetTeamMembersResult) InitDefault() {
+func (p *TeamServiceRejectInvitationResult) InitDefault() {
 }
 
-var TeamServiceGetTeamMembersResult_Success_DEFAULT *GetTeamMembersResp
+var TeamServiceRejectInvitationResult_Success_DEFAULT *RejectInvitationResp
 
-func (p *TeamServiceGetTeamMembersResult) GetSuccess() (v *GetTeamMembersResp) {
+func (p *TeamServiceRejectInvitationResult) GetSuccess() (v *RejectInvitationResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceGetTeamMembersResult_Success_DEFAULT
+		return TeamServiceRejectInvitationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceGetTeamMembersResult = map[int16]string{
+var fieldIDToName_TeamServiceRejectInvitationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceGetTeamMembersResult) IsSetSuccess() bool {
+func (p *TeamServiceRejectInvitationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceGetTeamMembersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRejectInvitationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8046,7 +11051,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetTeamMembersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRejectInvitationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8056,8 +11061,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamMembersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTeamMembersResp()
+func (p *TeamServiceRejectInvitationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRejectInvitationResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8065,9 +11070,9 @@ func (p *TeamServiceGetTeamMembersResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TeamServiceGetTeamMembersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRejectInvitationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTeamMembers_result"); err != nil {
+	if err = oprot.WriteStructBegin("RejectInvitation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8093,7 +11098,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRejectInvitationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8112,43 +11117,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceGetTeamMembersResult) String() string {
+func (p *TeamServiceRejectInvitationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetTeamMembersResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceRejectInvitationResult(%+v)", *p)
 
 }
 
-type TeamServiceRemoveTeamMemberArgs struct {
-	Req *RemoveTeamMemberReq `thrift:"req,1"`
+type TeamServiceGetUserInvitationsArgs struct {
+	Req *GetUserInvitationsReq `thrift:"req,1"`
 }
 
-func NewTeamServiceRemoveTeamMemberArgs() *TeamServiceRemoveTeamMemberArgs {
-	return &TeamServiceRemoveTeamMemberArgs{}
+func NewTeamServiceGetUserInvitationsArgs() *TeamServiceGetUserInvitationsArgs {
+	return &TeamServiceGetUserInvitationsArgs{}
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) InitDefault() {
+func (p *TeamServiceGetUserInvitationsArgs) InitDefault() {
 }
 
-var TeamServiceRemoveTeamMemberArgs_Req_DEFAULT *RemoveTeamMemberReq
+var TeamServiceGetUserInvitationsArgs_Req_DEFAULT *GetUserInvitationsReq
 
-func (p *TeamServiceRemoveTeamMemberArgs) GetReq() (v *RemoveTeamMemberReq) {
+func (p *TeamServiceGetUserInvitationsArgs) GetReq() (v *GetUserInvitationsReq) {
 	if !p.IsSetReq() {
-		return TeamServiceRemoveTeamMemberArgs_Req_DEFAULT
+		return TeamServiceGetUserInvitationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceRemoveTeamMemberArgs = map[int16]string{
+var fieldIDToName_TeamServiceGetUserInvitationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) IsSetReq() bool {
+func (p *TeamServiceGetUserInvitationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserInvitationsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8194,7 +11199,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRemoveTeamMemberArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserInvitationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8204,8 +11209,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRemoveTeamMemberReq()
+func (p *TeamServiceGetUserInvitationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserInvitationsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8213,9 +11218,9 @@ func (p *TeamServiceRemoveTeamMemberArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserInvitationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RemoveTeamMember_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInvitations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8241,7 +11246,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserInvitationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8258,43 +11263,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceRemoveTeamMemberArgs) String() string {
+func (p *TeamServiceGetUserInvitationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceRemoveTeamMemberArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceGetUserInvitationsArgs(%+v)", *p)
 
 }
 
-type TeamServiceRemoveTeamMemberResult struct {
-	Success *RemoveTeamMemberResp `thrift:"success,0,optional"`
+type TeamServiceGetUserInvitationsResult struct {
+	Success *GetUserInvitationsResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceRemoveTeamMemberResult() *TeamServiceRemoveTeamMemberResult {
-	return &TeamServiceRemoveTeamMemberResult{}
+func NewTeamServiceGetUserInvitationsResult() *TeamServiceGetUserInvitationsResult {
+	return &TeamServiceGetUserInvitationsResult{}
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) InitDefault() {
+func (p *TeamServiceGetUserInvitationsResult) InitDefault() {
 }
 
-var TeamServiceRemoveTeamMemberResult_Success_DEFAULT *RemoveTeamMemberResp
+var TeamServiceGetUserInvitationsResult_Success_DEFAULT *GetUserInvitationsResp
 
-func (p *TeamServiceRemoveTeamMemberResult) GetSuccess() (v *RemoveTeamMemberResp) {
+func (p *TeamServiceGetUserInvitationsResult) GetSuccess() (v *GetUserInvitationsResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceRemoveTeamMemberResult_Success_DEFAULT
+		return TeamServiceGetUserInvitationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceRemoveTeamMemberResult = map[int16]string{
+var fieldIDToName_TeamServiceGetUserInvitationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) IsSetSuccess() bool {
+func (p *TeamServiceGetUserInvitationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserInvitationsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8340,7 +11345,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRemoveTeamMemberResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserInvitationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8350,8 +11355,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRemoveTeamMemberResp()
+func (p *TeamServiceGetUserInvitationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserInvitationsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8359,9 +11364,9 @@ func (p *TeamServiceRemoveTeamMemberResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserInvitationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RemoveTeamMember_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInvitations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8387,7 +11392,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceGetUserInvitationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8406,43 +11411,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceRemoveTeamMemberResult) String() string {
+func (p *TeamServiceGetUserInvitationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceRemoveTeamMemberResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceGetUserInvitationsResult(%+v)", *p)
 
 }
 
-type TeamServiceAcceptInvitationArgs struct {
-	Req *AcceptInvitationReq `thrift:"req,1"`
+type TeamServiceCreateTeamAPIKeyArgs struct {
+	Req *CreateTeamAPIKeyReq `thrift:"req,1"`
 }
 
-func NewTeamServiceAcceptInvitationArgs() *TeamServiceAcceptInvitationArgs {
-	return &TeamServiceAcceptInvitationArgs{}
+func NewTeamServiceCreateTeamAPIKeyArgs() *TeamServiceCreateTeamAPIKeyArgs {
+	return &TeamServiceCreateTeamAPIKeyArgs{}
 }
 
-func (p *TeamServiceAcceptInvitationArgs) InitDefault() {
+func (p *TeamServiceCreateTeamAPIKeyArgs) InitDefault() {
 }
 
-var TeamServiceAcceptInvitationArgs_Req_DEFAULT *AcceptInvitationReq
+var TeamServiceCreateTeamAPIKeyArgs_Req_DEFAULT *CreateTeamAPIKeyReq
 
-func (p *TeamServiceAcceptInvitationArgs) GetReq() (v *AcceptInvitationReq) {
+func (p *TeamServiceCreateTeamAPIKeyArgs) GetReq() (v *CreateTeamAPIKeyReq) {
 	if !p.IsSetReq() {
-		return TeamServiceAcceptInvitationArgs_Req_DEFAULT
+		return TeamServiceCreateTeamAPIKeyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceAcceptInvitationArgs = map[int16]string{
+var fieldIDToName_TeamServiceCreateTeamAPIKeyArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceAcceptInvitationArgs) IsSetReq() bool {
+func (p *TeamServiceCreateTeamAPIKeyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceAcceptInvitationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceCreateTeamAPIKeyArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8488,7 +11493,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceAcceptInvitationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceCreateTeamAPIKeyArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8498,8 +11503,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceAcceptInvitationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAcceptInvitationReq()
+func (p *TeamServiceCreateTeamAPIKeyArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateTeamAPIKeyReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8507,9 +11512,9 @@ func (p *TeamServiceAcceptInvitationArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TeamServiceAcceptInvitationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceCreateTeamAPIKeyArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AcceptInvitation_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateTeamAPIKey_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8535,7 +11540,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceAcceptInvitationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceCreateTeamAPIKeyArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8552,43 +11557,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceAcceptInvitationArgs) String() string {
+func (p *TeamServiceCreateTeamAPIKeyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceAcceptInvitationArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceCreateTeamAPIKeyArgs(%+v)", *p)
 
 }
 
-type TeamServiceAcceptInvitationResult struct {
-	Success *AcceptInvitationResp `thrift:"success,0,optional"`
+type TeamServiceCreateTeamAPIKeyResult struct {
+	Success *CreateTeamAPIKeyResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceAcceptInvitationResult() *TeamServiceAcceptInvitationResult {
-	return &TeamServiceAcceptInvitationResult{}
+func NewTeamServiceCreateTeamAPIKeyResult() *TeamServiceCreateTeamAPIKeyResult {
+	return &TeamServiceCreateTeamAPIKeyResult{}
 }
 
-func (p *TeamServiceAcceptInvitationResult) InitDefault() {
+func (p *TeamServiceCreateTeamAPIKeyResult) InitDefault() {
 }
 
-var TeamServiceAcceptInvitationResult_Success_DEFAULT *AcceptInvitationResp
+var TeamServiceCreateTeamAPIKeyResult_Success_DEFAULT *CreateTeamAPIKeyResp
 
-func (p *TeamServiceAcceptInvitationResult) GetSuccess() (v *AcceptInvitationResp) {
+func (p *TeamServiceCreateTeamAPIKeyResult) GetSuccess() (v *CreateTeamAPIKeyResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceAcceptInvitationResult_Success_DEFAULT
+		return TeamServiceCreateTeamAPIKeyResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceAcceptInvitationResult = map[int16]string{
+var fieldIDToName_TeamServiceCreateTeamAPIKeyResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceAcceptInvitationResult) IsSetSuccess() bool {
+func (p *TeamServiceCreateTeamAPIKeyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceAcceptInvitationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceCreateTeamAPIKeyResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8634,7 +11639,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceAcceptInvitationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceCreateTeamAPIKeyResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8644,8 +11649,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceAcceptInvitationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAcceptInvitationResp()
+func (p *TeamServiceCreateTeamAPIKeyResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateTeamAPIKeyResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8653,9 +11658,9 @@ func (p *TeamServiceAcceptInvitationResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TeamServiceAcceptInvitationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceCreateTeamAPIKeyResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AcceptInvitation_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateTeamAPIKey_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8681,7 +11686,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceAcceptInvitationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceCreateTeamAPIKeyResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8700,43 +11705,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceAcceptInvitationResult) String() string {
+func (p *TeamServiceCreateTeamAPIKeyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceAcceptInvitationResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceCreateTeamAPIKeyResult(%+v)", *p)
 
 }
 
-type TeamServiceRejectInvitationArgs struct {
-	Req *RejectInvitationReq `thrift:"req,1"`
+type TeamServiceListTeamAPIKeysArgs struct {
+	Req *ListTeamAPIKeysReq `thrift:"req,1"`
 }
 
-func NewTeamServiceRejectInvitationArgs() *TeamServiceRejectInvitationArgs {
-	return &TeamServiceRejectInvitationArgs{}
+func NewTeamServiceListTeamAPIKeysArgs() *TeamServiceListTeamAPIKeysArgs {
+	return &TeamServiceListTeamAPIKeysArgs{}
 }
 
-func (p *TeamServiceRejectInvitationArgs) InitDefault() {
+func (p *TeamServiceListTeamAPIKeysArgs) InitDefault() {
 }
 
-var TeamServiceRejectInvitationArgs_Req_DEFAULT *RejectInvitationReq
+var TeamServiceListTeamAPIKeysArgs_Req_DEFAULT *ListTeamAPIKeysReq
 
-func (p *TeamServiceRejectInvitationArgs) GetReq() (v *RejectInvitationReq) {
+func (p *TeamServiceListTeamAPIKeysArgs) GetReq() (v *ListTeamAPIKeysReq) {
 	if !p.IsSetReq() {
-		return TeamServiceRejectInvitationArgs_Req_DEFAULT
+		return TeamServiceListTeamAPIKeysArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceRejectInvitationArgs = map[int16]string{
+var fieldIDToName_TeamServiceListTeamAPIKeysArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceRejectInvitationArgs) IsSetReq() bool {
+func (p *TeamServiceListTeamAPIKeysArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceRejectInvitationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceListTeamAPIKeysArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8782,7 +11787,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRejectInvitationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceListTeamAPIKeysArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8792,8 +11797,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceRejectInvitationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRejectInvitationReq()
+func (p *TeamServiceListTeamAPIKeysArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListTeamAPIKeysReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8801,9 +11806,9 @@ func (p *TeamServiceRejectInvitationArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TeamServiceRejectInvitationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceListTeamAPIKeysArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RejectInvitation_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListTeamAPIKeys_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8829,7 +11834,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceRejectInvitationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceListTeamAPIKeysArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8846,43 +11851,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceRejectInvitationArgs) String() string {
+func (p *TeamServiceListTeamAPIKeysArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceRejectInvitationArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceListTeamAPIKeysArgs(%+v)", *p)
 
 }
 
-type TeamServiceRejectInvitationResult struct {
-	Success *RejectInvitationResp `thrift:"success,0,optional"`
+type TeamServiceListTeamAPIKeysResult struct {
+	Success *ListTeamAPIKeysResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceRejectInvitationResult() *TeamServiceRejectInvitationResult {
-	return &TeamServiceRejectInvitationResult{}
+func NewTeamServiceListTeamAPIKeysResult() *TeamServiceListTeamAPIKeysResult {
+	return &TeamServiceListTeamAPIKeysResult{}
 }
 
-func (p *TeamServiceRejectInvitationResult) InitDefault() {
+func (p *TeamServiceListTeamAPIKeysResult) InitDefault() {
 }
 
-var TeamServiceRejectInvitationResult_Success_DEFAULT *RejectInvitationResp
+var TeamServiceListTeamAPIKeysResult_Success_DEFAULT *ListTeamAPIKeysResp
 
-func (p *TeamServiceRejectInvitationResult) GetSuccess() (v *RejectInvitationResp) {
+func (p *TeamServiceListTeamAPIKeysResult) GetSuccess() (v *ListTeamAPIKeysResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceRejectInvitationResult_Success_DEFAULT
+		return TeamServiceListTeamAPIKeysResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceRejectInvitationResult = map[int16]string{
+var fieldIDToName_TeamServiceListTeamAPIKeysResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceRejectInvitationResult) IsSetSuccess() bool {
+func (p *TeamServiceListTeamAPIKeysResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceRejectInvitationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceListTeamAPIKeysResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8928,7 +11933,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRejectInvitationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceListTeamAPIKeysResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8938,8 +11943,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceRejectInvitationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRejectInvitationResp()
+func (p *TeamServiceListTeamAPIKeysResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListTeamAPIKeysResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8947,9 +11952,9 @@ func (p *TeamServiceRejectInvitationResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TeamServiceRejectInvitationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceListTeamAPIKeysResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RejectInvitation_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListTeamAPIKeys_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8975,7 +11980,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceRejectInvitationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceListTeamAPIKeysResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8994,43 +11999,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceRejectInvitationResult) String() string {
+func (p *TeamServiceListTeamAPIKeysResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceRejectInvitationResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceListTeamAPIKeysResult(%+v)", *p)
 
 }
 
-type TeamServiceGetUserInvitationsArgs struct {
-	Req *GetUserInvitationsReq `thrift:"req,1"`
+type TeamServiceRevokeTeamAPIKeyArgs struct {
+	Req *RevokeTeamAPIKeyReq `thrift:"req,1"`
 }
 
-func NewTeamServiceGetUserInvitationsArgs() *TeamServiceGetUserInvitationsArgs {
-	return &TeamServiceGetUserInvitationsArgs{}
+func NewTeamServiceRevokeTeamAPIKeyArgs() *TeamServiceRevokeTeamAPIKeyArgs {
+	return &TeamServiceRevokeTeamAPIKeyArgs{}
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) InitDefault() {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) InitDefault() {
 }
 
-var TeamServiceGetUserInvitationsArgs_Req_DEFAULT *GetUserInvitationsReq
+var TeamServiceRevokeTeamAPIKeyArgs_Req_DEFAULT *RevokeTeamAPIKeyReq
 
-func (p *TeamServiceGetUserInvitationsArgs) GetReq() (v *GetUserInvitationsReq) {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) GetReq() (v *RevokeTeamAPIKeyReq) {
 	if !p.IsSetReq() {
-		return TeamServiceGetUserInvitationsArgs_Req_DEFAULT
+		return TeamServiceRevokeTeamAPIKeyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_TeamServiceGetUserInvitationsArgs = map[int16]string{
+var fieldIDToName_TeamServiceRevokeTeamAPIKeyArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) IsSetReq() bool {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9076,7 +12081,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserInvitationsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRevokeTeamAPIKeyArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9086,8 +12091,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserInvitationsReq()
+func (p *TeamServiceRevokeTeamAPIKeyArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeTeamAPIKeyReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9095,9 +12100,9 @@ func (p *TeamServiceGetUserInvitationsArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInvitations_args"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeTeamAPIKey_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9123,7 +12128,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9140,43 +12145,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserInvitationsArgs) String() string {
+func (p *TeamServiceRevokeTeamAPIKeyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetUserInvitationsArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceRevokeTeamAPIKeyArgs(%+v)", *p)
 
 }
 
-type TeamServiceGetUserInvitationsResult struct {
-	Success *GetUserInvitationsResp `thrift:"success,0,optional"`
+type TeamServiceRevokeTeamAPIKeyResult struct {
+	Success *RevokeTeamAPIKeyResp `thrift:"success,0,optional"`
 }
 
-func NewTeamServiceGetUserInvitationsResult() *TeamServiceGetUserInvitationsResult {
-	return &TeamServiceGetUserInvitationsResult{}
+func NewTeamServiceRevokeTeamAPIKeyResult() *TeamServiceRevokeTeamAPIKeyResult {
+	return &TeamServiceRevokeTeamAPIKeyResult{}
 }
 
-func (p *TeamServiceGetUserInvitationsResult) InitDefault() {
+func (p *TeamServiceRevokeTeamAPIKeyResult) InitDefault() {
 }
 
-var TeamServiceGetUserInvitationsResult_Success_DEFAULT *GetUserInvitationsResp
+var TeamServiceRevokeTeamAPIKeyResult_Success_DEFAULT *RevokeTeamAPIKeyResp
 
-func (p *TeamServiceGetUserInvitationsResult) GetSuccess() (v *GetUserInvitationsResp) {
+func (p *TeamServiceRevokeTeamAPIKeyResult) GetSuccess() (v *RevokeTeamAPIKeyResp) {
 	if !p.IsSetSuccess() {
-		return TeamServiceGetUserInvitationsResult_Success_DEFAULT
+		return TeamServiceRevokeTeamAPIKeyResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_TeamServiceGetUserInvitationsResult = map[int16]string{
+var fieldIDToName_TeamServiceRevokeTeamAPIKeyResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceGetUserInvitationsResult) IsSetSuccess() bool {
+func (p *TeamServiceRevokeTeamAPIKeyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceGetUserInvitationsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRevokeTeamAPIKeyResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9222,7 +12227,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceGetUserInvitationsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceRevokeTeamAPIKeyResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9232,8 +12237,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserInvitationsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserInvitationsResp()
+func (p *TeamServiceRevokeTeamAPIKeyResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevokeTeamAPIKeyResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9241,9 +12246,9 @@ func (p *TeamServiceGetUserInvitationsResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *TeamServiceGetUserInvitationsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRevokeTeamAPIKeyResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInvitations_result"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeTeamAPIKey_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9269,7 +12274,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserInvitationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceRevokeTeamAPIKeyResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9288,10 +12293,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceGetUserInvitationsResult) String() string {
+func (p *TeamServiceRevokeTeamAPIKeyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceGetUserInvitationsResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceRevokeTeamAPIKeyResult(%+v)", *p)
 
 }
@@ -2027,345 +2027,2214 @@ func (p *GetUserByIdResp) String() string {
 
 }
 
-// 用户服务
-type UserService interface {
-	GetProfile(ctx context.Context, req *GetProfileReq) (r *GetProfileResp, err error)
-
-	UpdateProfile(ctx context.Context, req *UpdateProfileReq) (r *UpdateProfileResp, err error)
+// 登录会话信息
+type UserSession struct {
+	SessionID  string  `thrift:"session_id,1" form:"session_id" json:"session_id" query:"session_id"`
+	DeviceName *string `thrift:"device_name,2,optional" form:"device_name" json:"device_name,omitempty" query:"device_name"`
+	UserAgent  *string `thrift:"user_agent,3,optional" form:"user_agent" json:"user_agent,omitempty" query:"user_agent"`
+	// 登录IP
+	IP *string `thrift:"ip,4,optional" form:"ip" json:"ip,omitempty" query:"ip"`
+	// 登录时间
+	CreatedAt  string  `thrift:"created_at,5" form:"created_at" json:"created_at" query:"created_at"`
+	LastSeenAt *string `thrift:"last_seen_at,6,optional" form:"last_seen_at" json:"last_seen_at,omitempty" query:"last_seen_at"`
+	LastSeenIP *string `thrift:"last_seen_ip,7,optional" form:"last_seen_ip" json:"last_seen_ip,omitempty" query:"last_seen_ip"`
+	// 是否为当前请求所用的会话
+	IsCurrent bool `thrift:"is_current,8" form:"is_current" json:"is_current" query:"is_current"`
+	// 是否已通过两步验证
+	MfaVerified bool `thrift:"mfa_verified,9" form:"mfa_verified" json:"mfa_verified" query:"mfa_verified"`
+}
 
-	SwitchCurrentTeam(ctx context.Context, req *SwitchCurrentTeamReq) (r *SwitchCurrentTeamResp, err error)
+func NewUserSession() *UserSession {
+	return &UserSession{}
+}
 
-	GetUserById(ctx context.Context, req *GetUserByIdReq) (r *GetUserByIdResp, err error)
+func (p *UserSession) InitDefault() {
 }
 
-type UserServiceClient struct {
-	c thrift.TClient
+func (p *UserSession) GetSessionID() (v string) {
+	return p.SessionID
 }
 
-func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+var UserSession_DeviceName_DEFAULT string
+
+func (p *UserSession) GetDeviceName() (v string) {
+	if !p.IsSetDeviceName() {
+		return UserSession_DeviceName_DEFAULT
 	}
+	return *p.DeviceName
 }
 
-func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+var UserSession_UserAgent_DEFAULT string
+
+func (p *UserSession) GetUserAgent() (v string) {
+	if !p.IsSetUserAgent() {
+		return UserSession_UserAgent_DEFAULT
 	}
+	return *p.UserAgent
 }
 
-func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
-	return &UserServiceClient{
-		c: c,
+var UserSession_IP_DEFAULT string
+
+func (p *UserSession) GetIP() (v string) {
+	if !p.IsSetIP() {
+		return UserSession_IP_DEFAULT
 	}
+	return *p.IP
 }
 
-func (p *UserServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *UserSession) GetCreatedAt() (v string) {
+	return p.CreatedAt
 }
 
-func (p *UserServiceClient) GetProfile(ctx context.Context, req *GetProfileReq) (r *GetProfileResp, err error) {
-	var _args UserServiceGetProfileArgs
-	_args.Req = req
-	var _result UserServiceGetProfileResult
-	if err = p.Client_().Call(ctx, "GetProfile", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UpdateProfile(ctx context.Context, req *UpdateProfileReq) (r *UpdateProfileResp, err error) {
-	var _args UserServiceUpdateProfileArgs
-	_args.Req = req
-	var _result UserServiceUpdateProfileResult
-	if err = p.Client_().Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
-		return
+var UserSession_LastSeenAt_DEFAULT string
+
+func (p *UserSession) GetLastSeenAt() (v string) {
+	if !p.IsSetLastSeenAt() {
+		return UserSession_LastSeenAt_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.LastSeenAt
 }
-func (p *UserServiceClient) SwitchCurrentTeam(ctx context.Context, req *SwitchCurrentTeamReq) (r *SwitchCurrentTeamResp, err error) {
-	var _args UserServiceSwitchCurrentTeamArgs
-	_args.Req = req
-	var _result UserServiceSwitchCurrentTeamResult
-	if err = p.Client_().Call(ctx, "SwitchCurrentTeam", &_args, &_result); err != nil {
-		return
+
+var UserSession_LastSeenIP_DEFAULT string
+
+func (p *UserSession) GetLastSeenIP() (v string) {
+	if !p.IsSetLastSeenIP() {
+		return UserSession_LastSeenIP_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.LastSeenIP
 }
-func (p *UserServiceClient) GetUserById(ctx context.Context, req *GetUserByIdReq) (r *GetUserByIdResp, err error) {
-	var _args UserServiceGetUserByIdArgs
-	_args.Req = req
-	var _result UserServiceGetUserByIdResult
-	if err = p.Client_().Call(ctx, "GetUserById", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *UserSession) GetIsCurrent() (v bool) {
+	return p.IsCurrent
 }
 
-type UserServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      UserService
+func (p *UserSession) GetMfaVerified() (v bool) {
+	return p.MfaVerified
 }
 
-func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+var fieldIDToName_UserSession = map[int16]string{
+	1: "session_id",
+	2: "device_name",
+	3: "user_agent",
+	4: "ip",
+	5: "created_at",
+	6: "last_seen_at",
+	7: "last_seen_ip",
+	8: "is_current",
+	9: "mfa_verified",
 }
 
-func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *UserSession) IsSetDeviceName() bool {
+	return p.DeviceName != nil
 }
 
-func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *UserSession) IsSetUserAgent() bool {
+	return p.UserAgent != nil
 }
 
-func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
-	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetProfile", &userServiceProcessorGetProfile{handler: handler})
-	self.AddToProcessorMap("UpdateProfile", &userServiceProcessorUpdateProfile{handler: handler})
-	self.AddToProcessorMap("SwitchCurrentTeam", &userServiceProcessorSwitchCurrentTeam{handler: handler})
-	self.AddToProcessorMap("GetUserById", &userServiceProcessorGetUserById{handler: handler})
-	return self
+func (p *UserSession) IsSetIP() bool {
+	return p.IP != nil
 }
-func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+
+func (p *UserSession) IsSetLastSeenAt() bool {
+	return p.LastSeenAt != nil
 }
 
-type userServiceProcessorGetProfile struct {
-	handler UserService
+func (p *UserSession) IsSetLastSeenIP() bool {
+	return p.LastSeenIP != nil
 }
 
-func (p *userServiceProcessorGetProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceGetProfileArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UserSession) Read(iprot thrift.TProtocol) (err error) {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceGetProfileResult{}
-	var retval *GetProfileResp
-	if retval, err2 = p.handler.GetProfile(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetProfile: "+err2.Error())
-		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetProfile", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserSession[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type userServiceProcessorUpdateProfile struct {
-	handler UserService
+func (p *UserSession) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SessionID = _field
+	return nil
 }
+func (p *UserSession) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *userServiceProcessorUpdateProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUpdateProfileArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.DeviceName = _field
+	return nil
+}
+func (p *UserSession) ReadField3(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUpdateProfileResult{}
-	var retval *UpdateProfileResp
-	if retval, err2 = p.handler.UpdateProfile(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateProfile: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateProfile", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+		_field = &v
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.UserAgent = _field
+	return nil
+}
+func (p *UserSession) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.IP = _field
+	return nil
+}
+func (p *UserSession) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if err != nil {
-		return
+	p.CreatedAt = _field
+	return nil
+}
+func (p *UserSession) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true, err
+	p.LastSeenAt = _field
+	return nil
 }
+func (p *UserSession) ReadField7(iprot thrift.TProtocol) error {
 
-type userServiceProcessorSwitchCurrentTeam struct {
-	handler UserService
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastSeenIP = _field
+	return nil
 }
+func (p *UserSession) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *userServiceProcessorSwitchCurrentTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceSwitchCurrentTeamArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SwitchCurrentTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.IsCurrent = _field
+	return nil
+}
+func (p *UserSession) ReadField9(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceSwitchCurrentTeamResult{}
-	var retval *SwitchCurrentTeamResp
-	if retval, err2 = p.handler.SwitchCurrentTeam(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SwitchCurrentTeam: "+err2.Error())
-		oprot.WriteMessageBegin("SwitchCurrentTeam", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("SwitchCurrentTeam", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.MfaVerified = _field
+	return nil
+}
+
+func (p *UserSession) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserSession"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserSession) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("session_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true, err
+	if err := oprot.WriteString(p.SessionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type userServiceProcessorGetUserById struct {
-	handler UserService
+func (p *UserSession) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeviceName() {
+		if err = oprot.WriteFieldBegin("device_name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DeviceName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *userServiceProcessorGetUserById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceGetUserByIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserSession) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserAgent() {
+		if err = oprot.WriteFieldBegin("user_agent", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserAgent); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceGetUserByIdResult{}
-	var retval *GetUserByIdResp
-	if retval, err2 = p.handler.GetUserById(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserById: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserSession) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIP() {
+		if err = oprot.WriteFieldBegin("ip", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.IP); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("GetUserById", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UserSession) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UserSession) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastSeenAt() {
+		if err = oprot.WriteFieldBegin("last_seen_at", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LastSeenAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *UserSession) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastSeenIP() {
+		if err = oprot.WriteFieldBegin("last_seen_ip", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LastSeenIP); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *UserSession) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_current", thrift.BOOL, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsCurrent); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *UserSession) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("mfa_verified", thrift.BOOL, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.MfaVerified); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *UserSession) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserSession(%+v)", *p)
+
+}
+
+// 获取登录会话列表请求
+type ListSessionsReq struct {
+}
+
+func NewListSessionsReq() *ListSessionsReq {
+	return &ListSessionsReq{}
+}
+
+func (p *ListSessionsReq) InitDefault() {
+}
+
+var fieldIDToName_ListSessionsReq = map[int16]string{}
+
+func (p *ListSessionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListSessionsReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListSessionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSessionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsReq(%+v)", *p)
+
+}
+
+// 获取登录会话列表响应
+type ListSessionsResp struct {
+	Sessions []*UserSession   `thrift:"sessions,1,default,list<UserSession>" form:"sessions" json:"sessions" query:"sessions"`
+	BaseResp *common.BaseResp `thrift:"base_resp,2" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewListSessionsResp() *ListSessionsResp {
+	return &ListSessionsResp{}
+}
+
+func (p *ListSessionsResp) InitDefault() {
+}
+
+func (p *ListSessionsResp) GetSessions() (v []*UserSession) {
+	return p.Sessions
+}
+
+var ListSessionsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListSessionsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListSessionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ListSessionsResp = map[int16]string{
+	1: "sessions",
+	2: "base_resp",
+}
+
+func (p *ListSessionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListSessionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSessionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListSessionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UserSession, 0, size)
+	values := make([]UserSession, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sessions = _field
+	return nil
+}
+func (p *ListSessionsResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListSessionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSessionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSessionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sessions", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sessions)); err != nil {
+		return err
+	}
+	for _, v := range p.Sessions {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListSessionsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListSessionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsResp(%+v)", *p)
+
+}
+
+// 吊销登录会话请求
+type RevokeSessionReq struct {
+	SessionID string `thrift:"session_id,1,required" form:"session_id,required" json:"session_id,required"`
+}
+
+func NewRevokeSessionReq() *RevokeSessionReq {
+	return &RevokeSessionReq{}
+}
+
+func (p *RevokeSessionReq) InitDefault() {
+}
+
+func (p *RevokeSessionReq) GetSessionID() (v string) {
+	return p.SessionID
+}
+
+var fieldIDToName_RevokeSessionReq = map[int16]string{
+	1: "session_id",
+}
+
+func (p *RevokeSessionReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSessionID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSessionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSessionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeSessionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokeSessionReq[fieldId]))
+}
+
+func (p *RevokeSessionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SessionID = _field
+	return nil
+}
+
+func (p *RevokeSessionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeSessionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeSessionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("session_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SessionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeSessionReq(%+v)", *p)
+
+}
+
+// 吊销登录会话响应
+type RevokeSessionResp struct {
+	BaseResp *common.BaseResp `thrift:"base_resp,1" form:"base_resp" json:"base_resp" query:"base_resp"`
+}
+
+func NewRevokeSessionResp() *RevokeSessionResp {
+	return &RevokeSessionResp{}
+}
+
+func (p *RevokeSessionResp) InitDefault() {
+}
+
+var RevokeSessionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RevokeSessionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RevokeSessionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_RevokeSessionResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *RevokeSessionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RevokeSessionResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeSessionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RevokeSessionResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RevokeSessionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeSessionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeSessionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeSessionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeSessionResp(%+v)", *p)
+
+}
+
+// 用户服务
+type UserService interface {
+	GetProfile(ctx context.Context, req *GetProfileReq) (r *GetProfileResp, err error)
+
+	UpdateProfile(ctx context.Context, req *UpdateProfileReq) (r *UpdateProfileResp, err error)
+
+	SwitchCurrentTeam(ctx context.Context, req *SwitchCurrentTeamReq) (r *SwitchCurrentTeamResp, err error)
+
+	GetUserById(ctx context.Context, req *GetUserByIdReq) (r *GetUserByIdResp, err error)
+
+	ListSessions(ctx context.Context, req *ListSessionsReq) (r *ListSessionsResp, err error)
+
+	RevokeSession(ctx context.Context, req *RevokeSessionReq) (r *RevokeSessionResp, err error)
+}
+
+type UserServiceClient struct {
+	c thrift.TClient
+}
+
+func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
+	return &UserServiceClient{
+		c: c,
+	}
+}
+
+func (p *UserServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *UserServiceClient) GetProfile(ctx context.Context, req *GetProfileReq) (r *GetProfileResp, err error) {
+	var _args UserServiceGetProfileArgs
+	_args.Req = req
+	var _result UserServiceGetProfileResult
+	if err = p.Client_().Call(ctx, "GetProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdateProfile(ctx context.Context, req *UpdateProfileReq) (r *UpdateProfileResp, err error) {
+	var _args UserServiceUpdateProfileArgs
+	_args.Req = req
+	var _result UserServiceUpdateProfileResult
+	if err = p.Client_().Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) SwitchCurrentTeam(ctx context.Context, req *SwitchCurrentTeamReq) (r *SwitchCurrentTeamResp, err error) {
+	var _args UserServiceSwitchCurrentTeamArgs
+	_args.Req = req
+	var _result UserServiceSwitchCurrentTeamResult
+	if err = p.Client_().Call(ctx, "SwitchCurrentTeam", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) GetUserById(ctx context.Context, req *GetUserByIdReq) (r *GetUserByIdResp, err error) {
+	var _args UserServiceGetUserByIdArgs
+	_args.Req = req
+	var _result UserServiceGetUserByIdResult
+	if err = p.Client_().Call(ctx, "GetUserById", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ListSessions(ctx context.Context, req *ListSessionsReq) (r *ListSessionsResp, err error) {
+	var _args UserServiceListSessionsArgs
+	_args.Req = req
+	var _result UserServiceListSessionsResult
+	if err = p.Client_().Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) RevokeSession(ctx context.Context, req *RevokeSessionReq) (r *RevokeSessionResp, err error) {
+	var _args UserServiceRevokeSessionArgs
+	_args.Req = req
+	var _result UserServiceRevokeSessionResult
+	if err = p.Client_().Call(ctx, "RevokeSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type UserServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      UserService
+}
+
+func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
+	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetProfile", &userServiceProcessorGetProfile{handler: handler})
+	self.AddToProcessorMap("UpdateProfile", &userServiceProcessorUpdateProfile{handler: handler})
+	self.AddToProcessorMap("SwitchCurrentTeam", &userServiceProcessorSwitchCurrentTeam{handler: handler})
+	self.AddToProcessorMap("GetUserById", &userServiceProcessorGetUserById{handler: handler})
+	self.AddToProcessorMap("ListSessions", &userServiceProcessorListSessions{handler: handler})
+	self.AddToProcessorMap("RevokeSession", &userServiceProcessorRevokeSession{handler: handler})
+	return self
+}
+func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type userServiceProcessorGetProfile struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorGetProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetProfileArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceGetProfileResult{}
+	var retval *GetProfileResp
+	if retval, err2 = p.handler.GetProfile(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetProfile: "+err2.Error())
+		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetProfile", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdateProfile struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdateProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdateProfileArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdateProfileResult{}
+	var retval *UpdateProfileResp
+	if retval, err2 = p.handler.UpdateProfile(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateProfile: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateProfile", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorSwitchCurrentTeam struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorSwitchCurrentTeam) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceSwitchCurrentTeamArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SwitchCurrentTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceSwitchCurrentTeamResult{}
+	var retval *SwitchCurrentTeamResp
+	if retval, err2 = p.handler.SwitchCurrentTeam(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SwitchCurrentTeam: "+err2.Error())
+		oprot.WriteMessageBegin("SwitchCurrentTeam", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SwitchCurrentTeam", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorGetUserById struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorGetUserById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetUserByIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceGetUserByIdResult{}
+	var retval *GetUserByIdResp
+	if retval, err2 = p.handler.GetUserById(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserById: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserById", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorListSessions struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorListSessions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceListSessionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSessions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceListSessionsResult{}
+	var retval *ListSessionsResp
+	if retval, err2 = p.handler.ListSessions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSessions: "+err2.Error())
+		oprot.WriteMessageBegin("ListSessions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSessions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorRevokeSession struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorRevokeSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceRevokeSessionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RevokeSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceRevokeSessionResult{}
+	var retval *RevokeSessionResp
+	if retval, err2 = p.handler.RevokeSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevokeSession: "+err2.Error())
+		oprot.WriteMessageBegin("RevokeSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RevokeSession", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
 		err = err2
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type UserServiceGetProfileArgs struct {
+	Req *GetProfileReq `thrift:"req,1"`
+}
+
+func NewUserServiceGetProfileArgs() *UserServiceGetProfileArgs {
+	return &UserServiceGetProfileArgs{}
+}
+
+func (p *UserServiceGetProfileArgs) InitDefault() {
+}
+
+var UserServiceGetProfileArgs_Req_DEFAULT *GetProfileReq
+
+func (p *UserServiceGetProfileArgs) GetReq() (v *GetProfileReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceGetProfileArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceGetProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceGetProfileArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetProfileReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceGetProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetProfile_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceGetProfileArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetProfileArgs(%+v)", *p)
+
+}
+
+type UserServiceGetProfileResult struct {
+	Success *GetProfileResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceGetProfileResult() *UserServiceGetProfileResult {
+	return &UserServiceGetProfileResult{}
+}
+
+func (p *UserServiceGetProfileResult) InitDefault() {
+}
+
+var UserServiceGetProfileResult_Success_DEFAULT *GetProfileResp
+
+func (p *UserServiceGetProfileResult) GetSuccess() (v *GetProfileResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceGetProfileResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceGetProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetProfileResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceGetProfileResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetProfileResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceGetProfileResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetProfile_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceGetProfileResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetProfileResult(%+v)", *p)
+
+}
+
+type UserServiceUpdateProfileArgs struct {
+	Req *UpdateProfileReq `thrift:"req,1"`
+}
+
+func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
+	return &UserServiceUpdateProfileArgs{}
+}
+
+func (p *UserServiceUpdateProfileArgs) InitDefault() {
+}
+
+var UserServiceUpdateProfileArgs_Req_DEFAULT *UpdateProfileReq
+
+func (p *UserServiceUpdateProfileArgs) GetReq() (v *UpdateProfileReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateProfileArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateProfileReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceUpdateProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateProfile_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUpdateProfileArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+
+}
+
+type UserServiceUpdateProfileResult struct {
+	Success *UpdateProfileResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
+	return &UserServiceUpdateProfileResult{}
+}
+
+func (p *UserServiceUpdateProfileResult) InitDefault() {
+}
+
+var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateProfileResp
+
+func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateProfileResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateProfileResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateProfileResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateProfileResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceUpdateProfileResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateProfile_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUpdateProfileResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+
 }
 
-type UserServiceGetProfileArgs struct {
-	Req *GetProfileReq `thrift:"req,1"`
+type UserServiceSwitchCurrentTeamArgs struct {
+	Req *SwitchCurrentTeamReq `thrift:"req,1"`
 }
 
-func NewUserServiceGetProfileArgs() *UserServiceGetProfileArgs {
-	return &UserServiceGetProfileArgs{}
+func NewUserServiceSwitchCurrentTeamArgs() *UserServiceSwitchCurrentTeamArgs {
+	return &UserServiceSwitchCurrentTeamArgs{}
 }
 
-func (p *UserServiceGetProfileArgs) InitDefault() {
+func (p *UserServiceSwitchCurrentTeamArgs) InitDefault() {
 }
 
-var UserServiceGetProfileArgs_Req_DEFAULT *GetProfileReq
+var UserServiceSwitchCurrentTeamArgs_Req_DEFAULT *SwitchCurrentTeamReq
 
-func (p *UserServiceGetProfileArgs) GetReq() (v *GetProfileReq) {
+func (p *UserServiceSwitchCurrentTeamArgs) GetReq() (v *SwitchCurrentTeamReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetProfileArgs_Req_DEFAULT
+		return UserServiceSwitchCurrentTeamArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceGetProfileArgs = map[int16]string{
+var fieldIDToName_UserServiceSwitchCurrentTeamArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceGetProfileArgs) IsSetReq() bool {
+func (p *UserServiceSwitchCurrentTeamArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceSwitchCurrentTeamArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2411,7 +4280,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSwitchCurrentTeamArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2421,8 +4290,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetProfileReq()
+func (p *UserServiceSwitchCurrentTeamArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSwitchCurrentTeamReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2430,9 +4299,9 @@ func (p *UserServiceGetProfileArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceSwitchCurrentTeamArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetProfile_args"); err != nil {
+	if err = oprot.WriteStructBegin("SwitchCurrentTeam_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2458,7 +4327,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceSwitchCurrentTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2475,43 +4344,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileArgs) String() string {
+func (p *UserServiceSwitchCurrentTeamArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetProfileArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceSwitchCurrentTeamArgs(%+v)", *p)
 
 }
 
-type UserServiceGetProfileResult struct {
-	Success *GetProfileResp `thrift:"success,0,optional"`
+type UserServiceSwitchCurrentTeamResult struct {
+	Success *SwitchCurrentTeamResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetProfileResult() *UserServiceGetProfileResult {
-	return &UserServiceGetProfileResult{}
+func NewUserServiceSwitchCurrentTeamResult() *UserServiceSwitchCurrentTeamResult {
+	return &UserServiceSwitchCurrentTeamResult{}
 }
 
-func (p *UserServiceGetProfileResult) InitDefault() {
+func (p *UserServiceSwitchCurrentTeamResult) InitDefault() {
 }
 
-var UserServiceGetProfileResult_Success_DEFAULT *GetProfileResp
+var UserServiceSwitchCurrentTeamResult_Success_DEFAULT *SwitchCurrentTeamResp
 
-func (p *UserServiceGetProfileResult) GetSuccess() (v *GetProfileResp) {
+func (p *UserServiceSwitchCurrentTeamResult) GetSuccess() (v *SwitchCurrentTeamResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetProfileResult_Success_DEFAULT
+		return UserServiceSwitchCurrentTeamResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetProfileResult = map[int16]string{
+var fieldIDToName_UserServiceSwitchCurrentTeamResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetProfileResult) IsSetSuccess() bool {
+func (p *UserServiceSwitchCurrentTeamResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetProfileResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceSwitchCurrentTeamResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2557,7 +4426,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSwitchCurrentTeamResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2567,8 +4436,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetProfileResp()
+func (p *UserServiceSwitchCurrentTeamResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSwitchCurrentTeamResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2576,9 +4445,9 @@ func (p *UserServiceGetProfileResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetProfileResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceSwitchCurrentTeamResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetProfile_result"); err != nil {
+	if err = oprot.WriteStructBegin("SwitchCurrentTeam_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2604,7 +4473,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceSwitchCurrentTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2623,43 +4492,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileResult) String() string {
+func (p *UserServiceSwitchCurrentTeamResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceSwitchCurrentTeamResult(%+v)", *p)
 
 }
 
-type UserServiceUpdateProfileArgs struct {
-	Req *UpdateProfileReq `thrift:"req,1"`
+type UserServiceGetUserByIdArgs struct {
+	Req *GetUserByIdReq `thrift:"req,1"`
 }
 
-func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
-	return &UserServiceUpdateProfileArgs{}
+func NewUserServiceGetUserByIdArgs() *UserServiceGetUserByIdArgs {
+	return &UserServiceGetUserByIdArgs{}
 }
 
-func (p *UserServiceUpdateProfileArgs) InitDefault() {
+func (p *UserServiceGetUserByIdArgs) InitDefault() {
 }
 
-var UserServiceUpdateProfileArgs_Req_DEFAULT *UpdateProfileReq
+var UserServiceGetUserByIdArgs_Req_DEFAULT *GetUserByIdReq
 
-func (p *UserServiceUpdateProfileArgs) GetReq() (v *UpdateProfileReq) {
+func (p *UserServiceGetUserByIdArgs) GetReq() (v *GetUserByIdReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateProfileArgs_Req_DEFAULT
+		return UserServiceGetUserByIdArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
+var fieldIDToName_UserServiceGetUserByIdArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUpdateProfileArgs) IsSetReq() bool {
+func (p *UserServiceGetUserByIdArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserByIdArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2705,7 +4574,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserByIdArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2715,8 +4584,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateProfileReq()
+func (p *UserServiceGetUserByIdArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserByIdReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2724,9 +4593,9 @@ func (p *UserServiceUpdateProfileArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUpdateProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserByIdArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateProfile_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserById_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2752,7 +4621,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserByIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2769,43 +4638,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileArgs) String() string {
+func (p *UserServiceGetUserByIdArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserByIdArgs(%+v)", *p)
 
 }
 
-type UserServiceUpdateProfileResult struct {
-	Success *UpdateProfileResp `thrift:"success,0,optional"`
+type UserServiceGetUserByIdResult struct {
+	Success *GetUserByIdResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
-	return &UserServiceUpdateProfileResult{}
+func NewUserServiceGetUserByIdResult() *UserServiceGetUserByIdResult {
+	return &UserServiceGetUserByIdResult{}
 }
 
-func (p *UserServiceUpdateProfileResult) InitDefault() {
+func (p *UserServiceGetUserByIdResult) InitDefault() {
 }
 
-var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateProfileResp
+var UserServiceGetUserByIdResult_Success_DEFAULT *GetUserByIdResp
 
-func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateProfileResp) {
+func (p *UserServiceGetUserByIdResult) GetSuccess() (v *GetUserByIdResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateProfileResult_Success_DEFAULT
+		return UserServiceGetUserByIdResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+var fieldIDToName_UserServiceGetUserByIdResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+func (p *UserServiceGetUserByIdResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateProfileResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserByIdResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2851,7 +4720,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserByIdResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2861,8 +4730,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateProfileResp()
+func (p *UserServiceGetUserByIdResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserByIdResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2870,9 +4739,9 @@ func (p *UserServiceUpdateProfileResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceUpdateProfileResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserByIdResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateProfile_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserById_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2898,7 +4767,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserByIdResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2917,43 +4786,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileResult) String() string {
+func (p *UserServiceGetUserByIdResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserByIdResult(%+v)", *p)
 
 }
 
-type UserServiceSwitchCurrentTeamArgs struct {
-	Req *SwitchCurrentTeamReq `thrift:"req,1"`
+type UserServiceListSessionsArgs struct {
+	Req *ListSessionsReq `thrift:"req,1"`
 }
 
-func NewUserServiceSwitchCurrentTeamArgs() *UserServiceSwitchCurrentTeamArgs {
-	return &UserServiceSwitchCurrentTeamArgs{}
+func NewUserServiceListSessionsArgs() *UserServiceListSessionsArgs {
+	return &UserServiceListSessionsArgs{}
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) InitDefault() {
+func (p *UserServiceListSessionsArgs) InitDefault() {
 }
 
-var UserServiceSwitchCurrentTeamArgs_Req_DEFAULT *SwitchCurrentTeamReq
+var UserServiceListSessionsArgs_Req_DEFAULT *ListSessionsReq
 
-func (p *UserServiceSwitchCurrentTeamArgs) GetReq() (v *SwitchCurrentTeamReq) {
+func (p *UserServiceListSessionsArgs) GetReq() (v *ListSessionsReq) {
 	if !p.IsSetReq() {
-		return UserServiceSwitchCurrentTeamArgs_Req_DEFAULT
+		return UserServiceListSessionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceSwitchCurrentTeamArgs = map[int16]string{
+var fieldIDToName_UserServiceListSessionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) IsSetReq() bool {
+func (p *UserServiceListSessionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceListSessionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2999,7 +4868,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSwitchCurrentTeamArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListSessionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3009,8 +4878,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSwitchCurrentTeamReq()
+func (p *UserServiceListSessionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListSessionsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3018,9 +4887,9 @@ func (p *UserServiceSwitchCurrentTeamArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceListSessionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SwitchCurrentTeam_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListSessions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3046,7 +4915,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceListSessionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3063,43 +4932,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceSwitchCurrentTeamArgs) String() string {
+func (p *UserServiceListSessionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSwitchCurrentTeamArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceListSessionsArgs(%+v)", *p)
 
 }
 
-type UserServiceSwitchCurrentTeamResult struct {
-	Success *SwitchCurrentTeamResp `thrift:"success,0,optional"`
+type UserServiceListSessionsResult struct {
+	Success *ListSessionsResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceSwitchCurrentTeamResult() *UserServiceSwitchCurrentTeamResult {
-	return &UserServiceSwitchCurrentTeamResult{}
+func NewUserServiceListSessionsResult() *UserServiceListSessionsResult {
+	return &UserServiceListSessionsResult{}
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) InitDefault() {
+func (p *UserServiceListSessionsResult) InitDefault() {
 }
 
-var UserServiceSwitchCurrentTeamResult_Success_DEFAULT *SwitchCurrentTeamResp
+var UserServiceListSessionsResult_Success_DEFAULT *ListSessionsResp
 
-func (p *UserServiceSwitchCurrentTeamResult) GetSuccess() (v *SwitchCurrentTeamResp) {
+func (p *UserServiceListSessionsResult) GetSuccess() (v *ListSessionsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceSwitchCurrentTeamResult_Success_DEFAULT
+		return UserServiceListSessionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceSwitchCurrentTeamResult = map[int16]string{
+var fieldIDToName_UserServiceListSessionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) IsSetSuccess() bool {
+func (p *UserServiceListSessionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceListSessionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3145,7 +5014,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSwitchCurrentTeamResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListSessionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3155,8 +5024,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSwitchCurrentTeamResp()
+func (p *UserServiceListSessionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSessionsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3164,9 +5033,9 @@ func (p *UserServiceSwitchCurrentTeamResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceListSessionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SwitchCurrentTeam_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListSessions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3192,7 +5061,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceListSessionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3211,43 +5080,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceSwitchCurrentTeamResult) String() string {
+func (p *UserServiceListSessionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSwitchCurrentTeamResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceListSessionsResult(%+v)", *p)
 
 }
 
-type UserServiceGetUserByIdArgs struct {
-	Req *GetUserByIdReq `thrift:"req,1"`
+type UserServiceRevokeSessionArgs struct {
+	Req *RevokeSessionReq `thrift:"req,1"`
 }
 
-func NewUserServiceGetUserByIdArgs() *UserServiceGetUserByIdArgs {
-	return &UserServiceGetUserByIdArgs{}
+func NewUserServiceRevokeSessionArgs() *UserServiceRevokeSessionArgs {
+	return &UserServiceRevokeSessionArgs{}
 }
 
-func (p *UserServiceGetUserByIdArgs) InitDefault() {
+func (p *UserServiceRevokeSessionArgs) InitDefault() {
 }
 
-var UserServiceGetUserByIdArgs_Req_DEFAULT *GetUserByIdReq
+var UserServiceRevokeSessionArgs_Req_DEFAULT *RevokeSessionReq
 
-func (p *UserServiceGetUserByIdArgs) GetReq() (v *GetUserByIdReq) {
+func (p *UserServiceRevokeSessionArgs) GetReq() (v *RevokeSessionReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserByIdArgs_Req_DEFAULT
+		return UserServiceRevokeSessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceGetUserByIdArgs = map[int16]string{
+var fieldIDToName_UserServiceRevokeSessionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceGetUserByIdArgs) IsSetReq() bool {
+func (p *UserServiceRevokeSessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserByIdArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceRevokeSessionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3293,7 +5162,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserByIdArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRevokeSessionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3303,8 +5172,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserByIdArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserByIdReq()
+func (p *UserServiceRevokeSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeSessionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3312,9 +5181,9 @@ func (p *UserServiceGetUserByIdArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetUserByIdArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRevokeSessionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserById_args"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeSession_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3340,7 +5209,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserByIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRevokeSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3357,43 +5226,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserByIdArgs) String() string {
+func (p *UserServiceRevokeSessionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserByIdArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceRevokeSessionArgs(%+v)", *p)
 
 }
 
-type UserServiceGetUserByIdResult struct {
-	Success *GetUserByIdResp `thrift:"success,0,optional"`
+type UserServiceRevokeSessionResult struct {
+	Success *RevokeSessionResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetUserByIdResult() *UserServiceGetUserByIdResult {
-	return &UserServiceGetUserByIdResult{}
+func NewUserServiceRevokeSessionResult() *UserServiceRevokeSessionResult {
+	return &UserServiceRevokeSessionResult{}
 }
 
-func (p *UserServiceGetUserByIdResult) InitDefault() {
+func (p *UserServiceRevokeSessionResult) InitDefault() {
 }
 
-var UserServiceGetUserByIdResult_Success_DEFAULT *GetUserByIdResp
+var UserServiceRevokeSessionResult_Success_DEFAULT *RevokeSessionResp
 
-func (p *UserServiceGetUserByIdResult) GetSuccess() (v *GetUserByIdResp) {
+func (p *UserServiceRevokeSessionResult) GetSuccess() (v *RevokeSessionResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserByIdResult_Success_DEFAULT
+		return UserServiceRevokeSessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetUserByIdResult = map[int16]string{
+var fieldIDToName_UserServiceRevokeSessionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetUserByIdResult) IsSetSuccess() bool {
+func (p *UserServiceRevokeSessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserByIdResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceRevokeSessionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3439,7 +5308,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserByIdResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRevokeSessionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3449,8 +5318,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserByIdResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserByIdResp()
+func (p *UserServiceRevokeSessionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevokeSessionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3458,9 +5327,9 @@ func (p *UserServiceGetUserByIdResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceGetUserByIdResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRevokeSessionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserById_result"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeSession_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3486,7 +5355,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserByIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRevokeSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3505,10 +5374,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserByIdResult) String() string {
+func (p *UserServiceRevokeSessionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserByIdResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceRevokeSessionResult(%+v)", *p)
 
 }
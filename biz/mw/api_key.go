@@ -0,0 +1,188 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	// AuthAPIKeyKey 当前请求使用的团队API Key
+	AuthAPIKeyKey = "auth_api_key"
+	// apiKeyScopeKey 当前接口允许API Key访问所需的授权范围
+	apiKeyScopeKey = "api_key_scope"
+	// apiKeyTouchInterval 最后使用时间的记录间隔
+	apiKeyTouchInterval = time.Minute
+)
+
+var (
+	apiKeyRepo     mysql.TeamAPIKeyRepository
+	apiKeyTeamRepo mysql.TeamRepository
+)
+
+// InitAPIKeyMiddleware 初始化团队API Key认证
+func InitAPIKeyMiddleware(repo mysql.TeamAPIKeyRepository, teamRepo mysql.TeamRepository) {
+	apiKeyRepo = repo
+	apiKeyTeamRepo = teamRepo
+}
+
+// AllowAPIKey 允许接口使用团队API Key访问，必须放在 AuthMiddleware 之前
+// 未声明的接口只接受JWT；API Key必须拥有指定的授权范围
+// 使用示例:
+//   - AllowAPIKey(consts.APIKeyScopeCampaignRead), AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)
+func AllowAPIKey(scope consts.APIKeyScope) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		c.Set(apiKeyScopeKey, scope)
+		c.Next(ctx)
+	}
+}
+
+// authenticateAPIKey 团队API Key认证
+// API Key以创建者身份、在所属团队内以普通用户角色操作，且视为未通过两步验证，不能访问后台接口
+func authenticateAPIKey(ctx context.Context, c *app.RequestContext, key string, allowedRoles []consts.UserRole) {
+	value, exists := c.Get(apiKeyScopeKey)
+	scope, ok := value.(consts.APIKeyScope)
+	if !exists || !ok {
+		c.JSON(http.StatusForbidden, map[string]interface{}{
+			"code":    403,
+			"message": "API keys are not allowed for this endpoint",
+		})
+		c.Abort()
+		return
+	}
+
+	if apiKeyRepo == nil || apiKeyTeamRepo == nil || userRPC == nil {
+		hlog.Error("api key middleware is not initialized")
+		c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"code":    500,
+			"message": "Internal server error",
+		})
+		c.Abort()
+		return
+	}
+
+	apiKey, err := apiKeyRepo.GetAPIKeyByHash(utils.HashAPIKey(key))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("Failed to get api key: %v", err)
+		}
+		c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"code":    401,
+			"message": "Invalid API key",
+		})
+		c.Abort()
+		return
+	}
+
+	now := time.Now()
+	if !apiKey.IsUsable(now) {
+		c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"code":    401,
+			"message": "API key has been revoked or has expired",
+		})
+		c.Abort()
+		return
+	}
+
+	if !apiKey.HasScope(scope.String()) {
+		hlog.Warnf("API key %d tried to access resource requiring scope %s", apiKey.ID, scope)
+		c.JSON(http.StatusForbidden, map[string]interface{}{
+			"code":    403,
+			"message": "API key does not have the required scope: " + scope.String(),
+		})
+		c.Abort()
+		return
+	}
+
+	// 团队已删除或创建者已离开团队时，API Key随之失效
+	if _, err := apiKeyTeamRepo.GetTeamByID(apiKey.TeamID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("Failed to get team %d for api key %d: %v", apiKey.TeamID, apiKey.ID, err)
+		}
+		c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"code":    401,
+			"message": "Team not found",
+		})
+		c.Abort()
+		return
+	}
+	if _, err := apiKeyTeamRepo.GetTeamMember(apiKey.TeamID, apiKey.CreatorID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("Failed to get team member for api key %d: %v", apiKey.ID, err)
+		}
+		c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"code":    401,
+			"message": "API key creator is no longer a team member",
+		})
+		c.Abort()
+		return
+	}
+
+	user, err := userRPC.GetUserByID(apiKey.CreatorID)
+	if err != nil {
+		hlog.Errorf("Failed to get user info for api key %d: %v", apiKey.ID, err)
+		c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"code":    401,
+			"message": "User not found",
+		})
+		c.Abort()
+		return
+	}
+	if user.Status != "normal" {
+		hlog.Warnf("API key %d of user %d with status '%s' tried to access resource", apiKey.ID, user.ID, user.Status)
+		c.JSON(http.StatusForbidden, map[string]interface{}{
+			"code":    403,
+			"message": "User account is not in normal status",
+		})
+		c.Abort()
+		return
+	}
+
+	if !roleAllowed(consts.RoleNormal, allowedRoles) {
+		c.JSON(http.StatusForbidden, map[string]interface{}{
+			"code":    403,
+			"message": "Access denied: insufficient permissions",
+		})
+		c.Abort()
+		return
+	}
+
+	// 当前团队固定为API Key所属团队，handler 通过 CurrentTeamID 获取团队上下文
+	teamUser := *user
+	teamUser.CurrentTeamID = &apiKey.TeamID
+
+	c.Set(AuthUserIDKey, user.ID)
+	c.Set(AuthUserKey, &teamUser)
+	c.Set(AuthUserRoleKey, consts.RoleNormal)
+	c.Set(AuthMFAVerifiedKey, false)
+	c.Set(AuthAPIKeyKey, apiKey)
+
+	if err := apiKeyRepo.TouchAPIKey(apiKey.ID, c.ClientIP(), now, apiKeyTouchInterval); err != nil {
+		hlog.Errorf("Failed to record last use of api key %d: %v", apiKey.ID, err)
+	}
+
+	c.Next(ctx)
+}
+
+// GetAuthAPIKey 从上下文中获取当前请求使用的团队API Key，JWT认证的请求返回 false
+func GetAuthAPIKey(c *app.RequestContext) (*mysql.TeamAPIKey, bool) {
+	apiKey, exists := c.Get(AuthAPIKeyKey)
+	if !exists {
+		return nil, false
+	}
+
+	if k, ok := apiKey.(*mysql.TeamAPIKey); ok {
+		return k, true
+	}
+
+	return nil, false
+}
@@ -2,8 +2,10 @@ package mw
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"orbia_api/biz/consts"
 	"orbia_api/biz/dal/mysql"
@@ -12,22 +14,34 @@ import (
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
 )
 
 const (
-	AuthUserIDKey   = "auth_user_id"
-	AuthUserKey     = "auth_user"
-	AuthUserRoleKey = "auth_user_role"
+	AuthUserIDKey    = "auth_user_id"
+	AuthUserKey      = "auth_user"
+	AuthUserRoleKey  = "auth_user_role"
+	AuthSessionIDKey = "auth_session_id"
+	// AuthMFAVerifiedKey 当前会话是否已通过两步验证
+	AuthMFAVerifiedKey = "auth_mfa_verified"
+
+	// sessionTouchInterval 会话最后活跃时间的记录间隔
+	sessionTouchInterval = time.Minute
 )
 
-var userRPC *rpc.UserRPC
+var (
+	userRPC     *rpc.UserRPC
+	sessionRepo mysql.AuthSessionRepository
+)
 
 // InitAuthMiddleware 初始化认证中间件
-func InitAuthMiddleware(userRepo mysql.UserRepository) {
+func InitAuthMiddleware(userRepo mysql.UserRepository, authSessionRepo mysql.AuthSessionRepository) {
 	userRPC = rpc.NewUserRPC(userRepo)
+	sessionRepo = authSessionRepo
 }
 
 // AuthMiddleware JWT认证和角色鉴权中间件
+// 同时接受团队API Key（Bearer orbia_sk_...），但仅限通过 AllowAPIKey 声明了授权范围的接口
 // 支持多个角色参数，只要用户拥有其中任意一个角色即可访问
 // 使用示例:
 //   - AuthMiddleware(consts.RoleNormal) - 仅普通用户可访问
@@ -67,8 +81,14 @@ func AuthMiddleware(allowedRoles ...consts.UserRole) app.HandlerFunc {
 			return
 		}
 
+		// 团队API Key（仅限通过 AllowAPIKey 声明了授权范围的接口）
+		if utils.IsAPIKey(token) {
+			authenticateAPIKey(ctx, c, token, allowedRoles)
+			return
+		}
+
 		// 验证token
-		userID, err := utils.ValidateToken(token)
+		claims, err := utils.ValidateToken(token)
 		if err != nil {
 			hlog.Errorf("JWT validation failed: %v", err)
 			c.JSON(http.StatusUnauthorized, map[string]interface{}{
@@ -78,10 +98,11 @@ func AuthMiddleware(allowedRoles ...consts.UserRole) app.HandlerFunc {
 			c.Abort()
 			return
 		}
+		userID := claims.UserID
 
 		// 从数据库获取用户信息
-		if userRPC == nil {
-			hlog.Error("userRPC is not initialized")
+		if userRPC == nil || sessionRepo == nil {
+			hlog.Error("auth middleware is not initialized")
 			c.JSON(http.StatusInternalServerError, map[string]interface{}{
 				"code":    500,
 				"message": "Internal server error",
@@ -90,6 +111,20 @@ func AuthMiddleware(allowedRoles ...consts.UserRole) app.HandlerFunc {
 			return
 		}
 
+		// 检查会话是否已吊销（退出登录、退出所有会话或刷新令牌被重用）
+		session, err := sessionRepo.GetSessionBySessionID(claims.SessionID)
+		if err != nil || session.UserID != userID || session.Status != "active" {
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				hlog.Errorf("Failed to get session %s: %v", claims.SessionID, err)
+			}
+			c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"code":    401,
+				"message": "Session has been revoked",
+			})
+			c.Abort()
+			return
+		}
+
 		user, err := userRPC.GetUserByID(userID)
 		if err != nil {
 			hlog.Errorf("Failed to get user info: %v", err)
@@ -117,32 +152,41 @@ func AuthMiddleware(allowedRoles ...consts.UserRole) app.HandlerFunc {
 		c.Set(AuthUserIDKey, userID)
 		c.Set(AuthUserKey, user)
 		c.Set(AuthUserRoleKey, userRole)
+		c.Set(AuthSessionIDKey, claims.SessionID)
+		c.Set(AuthMFAVerifiedKey, session.MFAVerifiedAt != nil)
 
 		// 如果指定了角色要求，进行角色验证
-		if len(allowedRoles) > 0 {
-			hasPermission := false
-			for _, allowedRole := range allowedRoles {
-				if userRole == allowedRole {
-					hasPermission = true
-					break
-				}
-			}
+		if !roleAllowed(userRole, allowedRoles) {
+			hlog.Warnf("User %d with role '%s' tried to access resource requiring roles: %v", userID, userRole, allowedRoles)
+			c.JSON(http.StatusForbidden, map[string]interface{}{
+				"code":    403,
+				"message": "Access denied: insufficient permissions",
+			})
+			c.Abort()
+			return
+		}
 
-			if !hasPermission {
-				hlog.Warnf("User %d with role '%s' tried to access resource requiring roles: %v", userID, userRole, allowedRoles)
-				c.JSON(http.StatusForbidden, map[string]interface{}{
-					"code":    403,
-					"message": "Access denied: insufficient permissions",
-				})
-				c.Abort()
-				return
-			}
+		if err := sessionRepo.TouchSessionLastSeen(claims.SessionID, c.ClientIP(), time.Now(), sessionTouchInterval); err != nil {
+			hlog.Errorf("Failed to record last seen of session %s: %v", claims.SessionID, err)
 		}
 
 		c.Next(ctx)
 	}
 }
 
+// roleAllowed 检查角色是否在允许的角色列表中，未指定角色要求时允许所有角色
+func roleAllowed(role consts.UserRole, allowedRoles []consts.UserRole) bool {
+	if len(allowedRoles) == 0 {
+		return true
+	}
+	for _, allowedRole := range allowedRoles {
+		if role == allowedRole {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAuthUserID 从上下文中获取用户ID
 func GetAuthUserID(c *app.RequestContext) (int64, bool) {
 	userID, exists := c.Get(AuthUserIDKey)
@@ -157,6 +201,30 @@ func GetAuthUserID(c *app.RequestContext) (int64, bool) {
 	return 0, false
 }
 
+// GetAuthSessionID 从上下文中获取当前登录会话ID
+func GetAuthSessionID(c *app.RequestContext) (string, bool) {
+	sessionID, exists := c.Get(AuthSessionIDKey)
+	if !exists {
+		return "", false
+	}
+
+	if id, ok := sessionID.(string); ok {
+		return id, true
+	}
+
+	return "", false
+}
+
+// IsAuthMFAVerified 当前登录会话是否已通过两步验证
+func IsAuthMFAVerified(c *app.RequestContext) bool {
+	verified, exists := c.Get(AuthMFAVerifiedKey)
+	if !exists {
+		return false
+	}
+	v, ok := verified.(bool)
+	return ok && v
+}
+
 // GetAuthUser 从上下文中获取用户信息
 func GetAuthUser(c *app.RequestContext) (*mysql.User, bool) {
 	user, exists := c.Get(AuthUserKey)
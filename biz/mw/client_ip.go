@@ -0,0 +1,53 @@
+package mw
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// clientIPHeaders 可信代理传递客户端地址的请求头，按顺序读取
+var clientIPHeaders = []string{"X-Forwarded-For", "X-Real-IP"}
+
+// NewClientIPFunc 创建获取客户端 IP 的函数，通过 engine.SetClientIPFunc 注册
+// Hertz 默认信任所有来源的 X-Forwarded-For，客户端可伪造任意 IP 绕过按 IP 限流；
+// 这里只有直连地址在 trustedProxies（IP 或 CIDR）中时才读取请求头，并从右向左跳过可信代理，
+// 未配置可信代理时始终使用直连地址
+func NewClientIPFunc(trustedProxies []string) (app.ClientIP, error) {
+	cidrs := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		cidr, err := parseTrustedProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	return app.ClientIPWithOption(app.ClientIPOptions{
+		RemoteIPHeaders: clientIPHeaders,
+		TrustedCIDRs:    cidrs,
+	}), nil
+}
+
+// parseTrustedProxy 解析可信代理配置，单个 IP 视为 /32（IPv6 为 /128）
+func parseTrustedProxy(proxy string) (*net.IPNet, error) {
+	proxy = strings.TrimSpace(proxy)
+	if !strings.Contains(proxy, "/") {
+		ip := net.ParseIP(proxy)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	_, cidr, err := net.ParseCIDR(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted proxy %q: %v", proxy, err)
+	}
+	return cidr, nil
+}
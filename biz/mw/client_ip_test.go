@@ -0,0 +1,105 @@
+package mw
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/test/mock"
+)
+
+// peerConn 指定直连地址的测试连接
+type peerConn struct {
+	*mock.Conn
+	remote net.Addr
+}
+
+func (c *peerConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func newClientIPContext(t *testing.T, peer string, headers map[string]string) *app.RequestContext {
+	t.Helper()
+	addr, err := net.ResolveTCPAddr("tcp", peer)
+	if err != nil {
+		t.Fatalf("resolve %s: %v", peer, err)
+	}
+
+	c := app.NewContext(0)
+	c.SetConn(&peerConn{Conn: mock.NewConn(""), remote: addr})
+	for name, value := range headers {
+		c.Request.Header.Set(name, value)
+	}
+	return c
+}
+
+func TestNewClientIPFunc(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		peer           string
+		headers        map[string]string
+		want           string
+	}{
+		{
+			name:    "no trusted proxies ignores forwarded headers",
+			peer:    "203.0.113.7:5000",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1", "X-Real-IP": "198.51.100.2"},
+			want:    "203.0.113.7",
+		},
+		{
+			name:           "untrusted peer cannot spoof forwarded headers",
+			trustedProxies: []string{"10.0.0.0/8"},
+			peer:           "203.0.113.7:5000",
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "trusted proxy forwards the client address",
+			trustedProxies: []string{"10.0.0.0/8"},
+			peer:           "10.1.2.3:5000",
+			headers:        map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:           "198.51.100.1",
+		},
+		{
+			name:           "spoofed leftmost entry is skipped",
+			trustedProxies: []string{"10.0.0.0/8"},
+			peer:           "10.1.2.3:5000",
+			headers:        map[string]string{"X-Forwarded-For": "1.1.1.1, 198.51.100.1, 10.9.9.9"},
+			want:           "198.51.100.1",
+		},
+		{
+			name:           "single trusted ip and x-real-ip",
+			trustedProxies: []string{"127.0.0.1"},
+			peer:           "127.0.0.1:5000",
+			headers:        map[string]string{"X-Real-IP": "198.51.100.9"},
+			want:           "198.51.100.9",
+		},
+		{
+			name:           "trusted proxy without headers",
+			trustedProxies: []string{"127.0.0.1"},
+			peer:           "127.0.0.1:5000",
+			want:           "127.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientIP, err := NewClientIPFunc(tt.trustedProxies)
+			if err != nil {
+				t.Fatalf("new client ip func: %v", err)
+			}
+			if got := clientIP(newClientIPContext(t, tt.peer, tt.headers)); got != tt.want {
+				t.Fatalf("client ip = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientIPFuncInvalidProxy(t *testing.T) {
+	for _, proxy := range []string{"not-an-ip", "10.0.0.0/33", ""} {
+		if _, err := NewClientIPFunc([]string{proxy}); err == nil {
+			t.Fatalf("expected an error for trusted proxy %q", proxy)
+		}
+	}
+}
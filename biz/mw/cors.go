@@ -23,12 +23,14 @@ func CORS() app.HandlerFunc {
 			"Origin", "Content-Length", "Content-Type",
 			"Authorization",
 			"X-Requested-With", "X-CSRF-Token",
+			"X-Request-ID", "X-Device-ID",
 		},
 
 		// 暴露的响应头
 		ExposeHeaders: []string{
 			"Content-Length", "Access-Control-Allow-Origin",
 			"Access-Control-Allow-Headers", "Content-Disposition",
+			"X-Request-ID",
 		},
 
 		// 允许携带认证信息
@@ -0,0 +1,221 @@
+package mw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// IdempotencyKeyHeader 幂等键请求头
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader 响应为重放首次响应时返回该响应头
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// idempotencyKeyMaxLength 幂等键最大长度，与 orbia_idempotency_key.idem_key 字段一致
+	idempotencyKeyMaxLength = 128
+	// idempotencyKeyTTL 幂等键有效期，过期后相同的幂等键视为新请求
+	idempotencyKeyTTL = 24 * time.Hour
+)
+
+var idempotencyRepo mysql.IdempotencyRepository
+
+// InitIdempotencyMiddleware 初始化幂等中间件
+func InitIdempotencyMiddleware(repo mysql.IdempotencyRepository) {
+	idempotencyRepo = repo
+}
+
+// IdempotencyMiddleware 幂等中间件，用于充值确认、订单支付、Campaign消费等资金类接口
+// 客户端通过 Idempotency-Key 请求头标识一次业务操作，超时重试时携带相同的幂等键：
+//   - 相同幂等键、相同请求体：不再执行业务逻辑，直接返回首次响应
+//   - 相同幂等键、不同请求体：返回 422
+//   - 首次请求仍在处理中：返回 409，客户端稍后重试
+//
+// 未携带该请求头时不做处理；首次请求失败（业务未执行）时释放幂等键，允许客户端重试。
+// 幂等键按用户和路由隔离，需放在 AuthMiddleware 之后使用
+func IdempotencyMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		key := string(c.GetHeader(IdempotencyKeyHeader))
+		if key == "" {
+			c.Next(ctx)
+			return
+		}
+
+		if len(key) > idempotencyKeyMaxLength {
+			c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"code":    400,
+				"message": "Idempotency-Key must be at most 128 characters",
+			})
+			c.Abort()
+			return
+		}
+
+		if idempotencyRepo == nil {
+			hlog.Error("idempotencyRepo is not initialized")
+			c.JSON(http.StatusInternalServerError, map[string]interface{}{
+				"code":    500,
+				"message": "Internal server error",
+			})
+			c.Abort()
+			return
+		}
+
+		userID, _ := GetAuthUserID(c)
+		path := c.FullPath()
+		record := &model.OrbiaIdempotencyKey{
+			IdemKey:     key,
+			UserID:      userID,
+			Method:      string(c.Method()),
+			Path:        path,
+			RequestHash: hashIdempotentRequest(c),
+			Status:      "processing",
+			ExpiresAt:   time.Now().Add(idempotencyKeyTTL),
+		}
+
+		created, err := acquireIdempotencyKey(record)
+		if err != nil {
+			hlog.Errorf("Failed to acquire idempotency key: %v", err)
+			c.JSON(http.StatusInternalServerError, map[string]interface{}{
+				"code":    500,
+				"message": "Internal server error",
+			})
+			c.Abort()
+			return
+		}
+
+		if !created {
+			replayIdempotentResponse(c, userID, path, key, record.RequestHash)
+			return
+		}
+
+		// 业务处理失败（资金操作在事务中执行，失败时已回滚）或发生 panic 时释放幂等键，
+		// 避免记录一直处于处理中，客户端可以用同一幂等键重试
+		completed := false
+		defer func() {
+			if !completed {
+				if err := idempotencyRepo.Delete(record.ID); err != nil {
+					hlog.Errorf("Failed to release idempotency key %d: %v", record.ID, err)
+				}
+			}
+		}()
+
+		c.Next(ctx)
+
+		statusCode := c.Response.StatusCode()
+		if !isSuccessfulResponse(statusCode, c.Response.Body()) {
+			return
+		}
+
+		// 业务已执行，保存响应失败时也不释放幂等键，宁可让重试返回 409，也不能重复扣款
+		completed = true
+		if err := idempotencyRepo.Complete(record.ID, int32(statusCode), string(c.Response.Body())); err != nil {
+			hlog.Errorf("Failed to save idempotent response for key %d: %v", record.ID, err)
+		}
+	}
+}
+
+// isSuccessfulResponse 判断业务是否执行成功
+// 错误响应（utils.Error 等）的 HTTP 状态码也是 200，需要根据响应体中的 code 判断：
+// 顶层 code 或 base_resp.code 为 0（thrift 风格接口也可能为 200）时表示成功
+func isSuccessfulResponse(statusCode int, body []byte) bool {
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return false
+	}
+
+	var resp struct {
+		Code     *int `json:"code"`
+		BaseResp *struct {
+			Code *int `json:"code"`
+		} `json:"base_resp"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false
+	}
+
+	code := resp.Code
+	if code == nil && resp.BaseResp != nil {
+		code = resp.BaseResp.Code
+	}
+	if code == nil {
+		return false
+	}
+	return *code == 0 || *code == http.StatusOK
+}
+
+// acquireIdempotencyKey 占用幂等键，已存在但已过期的记录会被删除后重新占用
+func acquireIdempotencyKey(record *model.OrbiaIdempotencyKey) (bool, error) {
+	created, err := idempotencyRepo.CreateIfAbsent(record)
+	if err != nil || created {
+		return created, err
+	}
+
+	existing, err := idempotencyRepo.GetByKey(record.UserID, record.Path, record.IdemKey)
+	if err != nil {
+		return false, err
+	}
+	if existing.ExpiresAt.After(time.Now()) {
+		return false, nil
+	}
+
+	if err := idempotencyRepo.Delete(existing.ID); err != nil {
+		return false, err
+	}
+	return idempotencyRepo.CreateIfAbsent(record)
+}
+
+// replayIdempotentResponse 处理重复的幂等键：校验请求指纹并返回首次响应
+func replayIdempotentResponse(c *app.RequestContext, userID int64, path, key, requestHash string) {
+	existing, err := idempotencyRepo.GetByKey(userID, path, key)
+	if err != nil {
+		hlog.Errorf("Failed to get idempotency key: %v", err)
+		c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"code":    500,
+			"message": "Internal server error",
+		})
+		c.Abort()
+		return
+	}
+
+	if existing.RequestHash != requestHash {
+		hlog.Warnf("User %d reused idempotency key '%s' on %s with a different payload", userID, key, path)
+		c.JSON(http.StatusUnprocessableEntity, map[string]interface{}{
+			"code":    422,
+			"message": "Idempotency-Key has already been used with a different request payload",
+		})
+		c.Abort()
+		return
+	}
+
+	if existing.Status != "completed" || existing.ResponseStatus == nil || existing.ResponseBody == nil {
+		c.JSON(http.StatusConflict, map[string]interface{}{
+			"code":    409,
+			"message": "A request with the same Idempotency-Key is still being processed",
+		})
+		c.Abort()
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(int(*existing.ResponseStatus), "application/json; charset=utf-8", []byte(*existing.ResponseBody))
+	c.Abort()
+}
+
+// hashIdempotentRequest 计算请求指纹（请求方法、URI 和请求体）
+func hashIdempotentRequest(c *app.RequestContext) string {
+	h := sha256.New()
+	h.Write(c.Method())
+	h.Write([]byte{'\n'})
+	h.Write(c.Request.URI().RequestURI())
+	h.Write([]byte{'\n'})
+	h.Write(c.Request.Body())
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,140 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/service/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// AuthPermissionsKey 当前用户权限集合在上下文中的键
+const AuthPermissionsKey = "auth_permissions"
+
+var userRoleRepo mysql.UserRoleRepository
+
+// InitPermissionMiddleware 初始化权限中间件
+func InitPermissionMiddleware(repo mysql.UserRoleRepository) {
+	userRoleRepo = repo
+}
+
+// RequirePermission 权限鉴权中间件，必须放在 AuthMiddleware 之后
+// 支持多个权限参数，只要用户的后台角色拥有其中任意一个权限即可访问；
+// 后台用户必须启用两步验证，且当前会话已通过两步验证
+// 鉴权通过后操作人信息（用户ID、IP、请求ID）写入 ctx，供服务层记录审计日志
+// 使用示例:
+//   - RequirePermission(consts.PermFinanceWrite) - 仅拥有资金操作权限的后台用户可访问
+func RequirePermission(required ...consts.Permission) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, exists := GetAuthUserID(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"code":    401,
+				"message": "User not authenticated",
+			})
+			c.Abort()
+			return
+		}
+
+		permissions, err := loadPermissions(c, userID)
+		if err != nil {
+			hlog.Errorf("Failed to load permissions for user %d: %v", userID, err)
+			c.JSON(http.StatusInternalServerError, map[string]interface{}{
+				"code":    500,
+				"message": "Internal server error",
+			})
+			c.Abort()
+			return
+		}
+
+		// 团队API Key不能访问后台接口
+		if _, isAPIKey := GetAuthAPIKey(c); isAPIKey {
+			c.JSON(http.StatusForbidden, map[string]interface{}{
+				"code":    403,
+				"message": "API keys cannot access admin resources",
+			})
+			c.Abort()
+			return
+		}
+
+		// 后台接口只接受通过两步验证的会话，未启用的管理员需先调用 /auth/2fa/setup 和 /auth/2fa/enable
+		if !IsAuthMFAVerified(c) {
+			hlog.Warnf("User %d tried to access admin resource without two-factor authentication", userID)
+			c.JSON(http.StatusForbidden, map[string]interface{}{
+				"code":    403,
+				"message": "Two-factor authentication is required for admin access",
+			})
+			c.Abort()
+			return
+		}
+
+		for _, permission := range required {
+			if permissions[permission] {
+				// 将操作人信息写入 ctx，服务层据此记录审计日志
+				c.Next(audit.WithActor(ctx, audit.Actor{
+					UserID:    userID,
+					IP:        c.ClientIP(),
+					UserAgent: string(c.UserAgent()),
+					RequestID: GetRequestID(c),
+				}))
+				return
+			}
+		}
+
+		hlog.Warnf("User %d tried to access resource requiring permissions: %v", userID, required)
+		c.JSON(http.StatusForbidden, map[string]interface{}{
+			"code":    403,
+			"message": "Access denied: insufficient permissions",
+		})
+		c.Abort()
+	}
+}
+
+// HasPermission 判断当前登录用户是否拥有某个权限，用于在 handler 中区分后台用户和普通用户
+// 未通过两步验证的会话按普通用户处理
+func HasPermission(c *app.RequestContext, permission consts.Permission) bool {
+	userID, exists := GetAuthUserID(c)
+	if !exists || !IsAuthMFAVerified(c) {
+		return false
+	}
+
+	permissions, err := loadPermissions(c, userID)
+	if err != nil {
+		hlog.Errorf("Failed to load permissions for user %d: %v", userID, err)
+		return false
+	}
+	return permissions[permission]
+}
+
+// loadPermissions 加载用户后台角色的权限并集，同一请求内只查询一次
+func loadPermissions(c *app.RequestContext, userID int64) (map[consts.Permission]bool, error) {
+	if cached, exists := c.Get(AuthPermissionsKey); exists {
+		if permissions, ok := cached.(map[consts.Permission]bool); ok {
+			return permissions, nil
+		}
+	}
+
+	if userRoleRepo == nil {
+		return nil, errors.New("permission middleware is not initialized")
+	}
+
+	roles, err := userRoleRepo.GetRolesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[consts.Permission]bool)
+	for _, role := range roles {
+		for _, permission := range consts.StaffRole(role.Role).Permissions() {
+			permissions[permission] = true
+		}
+	}
+
+	c.Set(AuthPermissionsKey, permissions)
+	return permissions, nil
+}
@@ -0,0 +1,102 @@
+package mw
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/infra/ratelimit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// rateLimitKeyIP 按客户端 IP 计数的规则维度
+const rateLimitKeyIP = "ip"
+
+var rateLimitStore ratelimit.Store
+
+// InitRateLimitMiddleware 初始化限流中间件
+func InitRateLimitMiddleware(store ratelimit.Store) {
+	rateLimitStore = store
+}
+
+// RateLimitMiddleware 按策略限流中间件
+// 策略规则来自配置 rate_limit.policies.<policy>，每条规则独立计数，任一规则超限即返回 429；
+// 策略未配置时不限流，计数存储出错时放行并记录日志
+func RateLimitMiddleware(policy string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		rules := config.GlobalConfig.RateLimit.Policies[policy]
+		if rateLimitStore == nil || len(rules) == 0 {
+			c.Next(ctx)
+			return
+		}
+
+		var body map[string]interface{}
+		for i, rule := range rules {
+			if rule.Limit <= 0 || rule.WindowSeconds <= 0 {
+				continue
+			}
+
+			var value string
+			if rule.Key == rateLimitKeyIP {
+				value = c.ClientIP()
+			} else {
+				if body == nil {
+					body = parseRateLimitBody(c)
+				}
+				value = rateLimitBodyValue(c, body, rule.Key)
+			}
+			if value == "" {
+				continue
+			}
+
+			key := fmt.Sprintf("%s:%d:%s:%s", policy, i, rule.Key, value)
+			count, ttl, err := rateLimitStore.Incr(ctx, key, time.Duration(rule.WindowSeconds)*time.Second)
+			if err != nil {
+				hlog.Errorf("Rate limit store error for %s: %v", key, err)
+				continue
+			}
+
+			if count > rule.Limit {
+				retryAfter := int64(math.Ceil(ttl.Seconds()))
+				if retryAfter < 1 {
+					retryAfter = 1
+				}
+				hlog.Warnf("Rate limit exceeded: policy=%s key=%s value=%s count=%d", policy, rule.Key, value, count)
+				c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+				c.JSON(http.StatusTooManyRequests, map[string]interface{}{
+					"code":    429,
+					"message": fmt.Sprintf("Too many requests, please retry after %d seconds", retryAfter),
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next(ctx)
+	}
+}
+
+// parseRateLimitBody 解析 JSON 请求体，非 JSON 时返回空 map
+func parseRateLimitBody(c *app.RequestContext) map[string]interface{} {
+	body := make(map[string]interface{})
+	if strings.HasPrefix(string(c.ContentType()), "application/json") {
+		_ = json.Unmarshal(c.Request.Body(), &body)
+	}
+	return body
+}
+
+// rateLimitBodyValue 获取请求体字段值，统一小写以免大小写绕过
+func rateLimitBodyValue(c *app.RequestContext, body map[string]interface{}, field string) string {
+	if value, ok := body[field].(string); ok {
+		return strings.ToLower(strings.TrimSpace(value))
+	}
+	return strings.ToLower(strings.TrimSpace(string(c.PostForm(field))))
+}
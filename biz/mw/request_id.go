@@ -0,0 +1,43 @@
+package mw
+
+import (
+	"context"
+
+	"orbia_api/biz/utils"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const (
+	// RequestIDHeader 请求ID请求头/响应头
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDKey 请求ID在上下文中的键
+	RequestIDKey = "request_id"
+)
+
+// RequestID 请求ID中间件
+// 优先使用客户端传入的 X-Request-ID（超长时忽略），否则生成新的请求ID，并写回响应头
+func RequestID() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		requestID := string(c.GetHeader(RequestIDHeader))
+		if requestID == "" || len(requestID) > 64 {
+			requestID = utils.GenerateRequestID()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+		c.Next(ctx)
+	}
+}
+
+// GetRequestID 从上下文中获取请求ID
+func GetRequestID(c *app.RequestContext) string {
+	requestID, exists := c.Get(RequestIDKey)
+	if !exists {
+		return ""
+	}
+	if id, ok := requestID.(string); ok {
+		return id
+	}
+	return ""
+}
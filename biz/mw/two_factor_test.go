@@ -0,0 +1,101 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/infra/ratelimit"
+	"orbia_api/biz/service/twofactor"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const stepUpTestCode = "123456"
+
+// fakeTwoFactorService 只实现 VerifyCode 的两步验证服务，记录校验次数
+type fakeTwoFactorService struct {
+	twofactor.TwoFactorService
+	verifications int
+}
+
+func (s *fakeTwoFactorService) VerifyCode(userID int64, code string) error {
+	s.verifications++
+	if code != stepUpTestCode {
+		return errors.New("invalid verification code")
+	}
+	return nil
+}
+
+// setupStepUp 初始化两步验证中间件依赖，测试结束后恢复
+func setupStepUp(t *testing.T, maxAttempts int) *fakeTwoFactorService {
+	t.Helper()
+	prevConfig, prevSvc, prevStore := config.GlobalConfig, twoFactorSvc, rateLimitStore
+	t.Cleanup(func() {
+		config.GlobalConfig, twoFactorSvc, rateLimitStore = prevConfig, prevSvc, prevStore
+	})
+
+	config.GlobalConfig = &config.Config{TwoFactor: config.TwoFactorConfig{StepUpMaxAttempts: maxAttempts, StepUpLockoutMinutes: 15}}
+	svc := &fakeTwoFactorService{}
+	twoFactorSvc = svc
+	rateLimitStore = ratelimit.NewMemoryStore()
+	return svc
+}
+
+// runStepUp 以 userID 携带验证码执行中间件，返回响应状态码和是否通过了两步验证
+func runStepUp(userID int64, code string) (int, bool) {
+	c := app.NewContext(0)
+	c.Set(AuthUserIDKey, userID)
+	c.Request.Header.Set(StepUpCodeHeader, code)
+
+	verified := false
+	c.SetHandlers(app.HandlersChain{
+		TwoFactorStepUp(),
+		func(ctx context.Context, c *app.RequestContext) {
+			verified = twofactor.StepUpVerified(ctx)
+		},
+	})
+	c.Next(context.Background())
+	return c.Response.StatusCode(), verified
+}
+
+func TestTwoFactorStepUpLocksAfterMaxAttempts(t *testing.T) {
+	svc := setupStepUp(t, 3)
+
+	for i := 0; i < 3; i++ {
+		if status, _ := runStepUp(1, "000000"); status != http.StatusForbidden {
+			t.Fatalf("attempt %d: status = %d, want 403", i+1, status)
+		}
+	}
+
+	// 超过次数后即使验证码正确也被拒绝，且不再校验验证码
+	status, verified := runStepUp(1, stepUpTestCode)
+	if status != http.StatusTooManyRequests || verified {
+		t.Fatalf("status = %d, verified = %v; want 429 and not verified", status, verified)
+	}
+	if svc.verifications != 3 {
+		t.Fatalf("verified %d codes, want 3", svc.verifications)
+	}
+
+	// 其他用户不受影响
+	if status, verified := runStepUp(2, stepUpTestCode); status != http.StatusOK || !verified {
+		t.Fatalf("other user: status = %d, verified = %v; want 200 and verified", status, verified)
+	}
+}
+
+func TestTwoFactorStepUpResetsAfterSuccess(t *testing.T) {
+	setupStepUp(t, 3)
+
+	for round := 0; round < 3; round++ {
+		for i := 0; i < 2; i++ {
+			if status, _ := runStepUp(1, "000000"); status != http.StatusForbidden {
+				t.Fatalf("round %d attempt %d: status = %d, want 403", round, i+1, status)
+			}
+		}
+		if status, verified := runStepUp(1, stepUpTestCode); status != http.StatusOK || !verified {
+			t.Fatalf("round %d: status = %d, verified = %v; want 200 and verified", round, status, verified)
+		}
+	}
+}
@@ -25,23 +25,23 @@ func _v1Mw() []app.HandlerFunc {
 }
 
 func _ad_orderMw() []app.HandlerFunc {
-	// 广告订单需要用户认证
-	return []app.HandlerFunc{mw.AuthMiddleware()}
+	// 广告订单需要用户认证，在各路由上声明，以便部分路由允许团队API Key访问
+	return nil
 }
 
 func _canceladorderMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _createadorderMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证，允许拥有 orders:write 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeOrdersWrite), mw.AuthMiddleware()}
 }
 
 func _getadorderMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _adminMw() []app.HandlerFunc {
@@ -49,14 +49,14 @@ func _adminMw() []app.HandlerFunc {
 	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
 }
 
+// admin查询广告订单 - 需要订单查看权限
 func _getadorderlistMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermOrderRead)}
 }
 
 func _statusMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _updateadorderstatusMw() []app.HandlerFunc {
@@ -65,8 +65,8 @@ func _updateadorderstatusMw() []app.HandlerFunc {
 }
 
 func _userMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _getuseradorderlistMw() []app.HandlerFunc {
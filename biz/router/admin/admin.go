@@ -25,8 +25,14 @@ func Register(r *server.Hertz) {
 				_admin := _v1.Group("/admin", _adminMw()...)
 				_admin.POST("/kols", append(_getallkolsMw(), admin.GetAllKols)...)
 				_admin.POST("/orders", append(_getallordersMw(), admin.GetAllOrders)...)
+				_admin.POST("/roles", append(_listrolesMw(), admin.ListRoles)...)
 				_admin.POST("/teams", append(_getallteamsMw(), admin.GetAllTeams)...)
 				_admin.POST("/users", append(_getallusersMw(), admin.GetAllUsers)...)
+				{
+					_audit := _admin.Group("/audit", _auditMw()...)
+					_audit.POST("/export", append(_exportauditlogsMw(), admin.ExportAuditLogs)...)
+					_audit.POST("/logs", append(_getauditlogsMw(), admin.GetAuditLogs)...)
+				}
 				{
 					_campaign := _admin.Group("/campaign", _campaignMw()...)
 					_campaign.POST("/consume", append(_addcampaignconsumeMw(), admin.AddCampaignConsume)...)
@@ -44,12 +50,23 @@ func Register(r *server.Hertz) {
 				}
 				{
 					_user := _admin.Group("/user", _userMw()...)
+					_user.POST("/merge", append(_mergeusersMw(), admin.MergeUsers)...)
 					_user.POST("/status", append(_setuserstatusMw(), admin.SetUserStatus)...)
+					{
+						_role := _user.Group("/role", _roleMw()...)
+						_role.POST("/assign", append(_assignuserroleMw(), admin.AssignUserRole)...)
+						_role.POST("/revoke", append(_revokeuserroleMw(), admin.RevokeUserRole)...)
+					}
 					{
 						_user_id := _user.Group("/:user_id", _user_idMw()...)
+						_user_id.POST("/roles", append(_getuserrolesMw(), admin.GetUserRoles)...)
 						_user_id.POST("/wallet", append(_getuserwalletMw(), admin.GetUserWallet)...)
 					}
 				}
+				{
+					_wallet := _admin.Group("/wallet", _walletMw()...)
+					_wallet.POST("/reconcile", append(_reconcilewalletsMw(), admin.ReconcileWallets)...)
+				}
 			}
 		}
 	}
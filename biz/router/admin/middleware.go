@@ -3,6 +3,9 @@
 package admin
 
 import (
+	"orbia_api/biz/consts"
+	"orbia_api/biz/mw"
+
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -21,29 +24,29 @@ func _v1Mw() []app.HandlerFunc {
 	return nil
 }
 
+// 后台接口 - 仅管理员，具体权限由各接口的 RequirePermission 校验
 func _adminMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
 }
 
+// KOL列表 - 需要 kol:read 权限
 func _getallkolsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermKolRead)}
 }
 
+// 订单列表 - 需要 order:read 权限
 func _getallordersMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermOrderRead)}
 }
 
+// 团队列表 - 需要 user:read 权限
 func _getallteamsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermUserRead)}
 }
 
+// 用户列表 - 需要 user:read 权限
 func _getallusersMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermUserRead)}
 }
 
 func _kolMw() []app.HandlerFunc {
@@ -51,9 +54,9 @@ func _kolMw() []app.HandlerFunc {
 	return nil
 }
 
+// 审核KOL - 需要 kol:review 权限
 func _adminreviewkolMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermKolReview)}
 }
 
 func _teamMw() []app.HandlerFunc {
@@ -66,9 +69,9 @@ func _team_idMw() []app.HandlerFunc {
 	return nil
 }
 
+// 团队成员 - 需要 user:read 权限
 func _getteammembersMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermUserRead)}
 }
 
 func _userMw() []app.HandlerFunc {
@@ -76,9 +79,9 @@ func _userMw() []app.HandlerFunc {
 	return nil
 }
 
+// 修改用户状态 - 需要 user:manage 权限
 func _setuserstatusMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermUserManage)}
 }
 
 func _user_idMw() []app.HandlerFunc {
@@ -86,9 +89,9 @@ func _user_idMw() []app.HandlerFunc {
 	return nil
 }
 
+// 用户钱包 - 需要 finance:read 权限
 func _getuserwalletMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceRead)}
 }
 
 func _campaignMw() []app.HandlerFunc {
@@ -96,7 +99,62 @@ func _campaignMw() []app.HandlerFunc {
 	return nil
 }
 
+// Campaign消费会扣减用户钱包 - 需要 finance:write 权限，按 Idempotency-Key 去重，防止重复扣款
 func _addcampaignconsumeMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite), mw.IdempotencyMiddleware()}
+}
+
+func _walletMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// 钱包对账 - 需要 finance:read 权限
+func _reconcilewalletsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceRead)}
+}
+
+// 合并用户账号会转移钱包余额和订单 - 需要 user:manage 权限
+func _mergeusersMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermUserManage)}
+}
+
+// 后台角色列表 - 需要 role:manage 权限
+func _listrolesMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermRoleManage)}
+}
+
+func _roleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// 分配后台角色 - 需要 role:manage 权限
+func _assignuserroleMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermRoleManage)}
+}
+
+// 撤销后台角色 - 需要 role:manage 权限
+func _revokeuserroleMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermRoleManage)}
+}
+
+// 用户后台角色 - 需要 role:manage 权限
+func _getuserrolesMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermRoleManage)}
+}
+
+// 审计日志 - 需要 audit:read 权限
+func _auditMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermAuditRead)}
+}
+
+func _exportauditlogsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getauditlogsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
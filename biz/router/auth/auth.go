@@ -24,8 +24,24 @@ func Register(r *server.Hertz) {
 			{
 				_auth := _v1.Group("/auth", _authMw()...)
 				_auth.POST("/email-login", append(_emailloginMw(), auth.EmailLogin)...)
+				_auth.POST("/link-email", append(_linkemailMw(), auth.LinkEmail)...)
+				_auth.POST("/link-wallet", append(_linkwalletMw(), auth.LinkWallet)...)
+				_auth.POST("/logout", append(_logoutMw(), auth.Logout)...)
+				_auth.POST("/logout-all", append(_logoutallMw(), auth.LogoutAll)...)
+				_auth.POST("/refresh", append(_refreshtokenMw(), auth.RefreshToken)...)
 				_auth.POST("/send-verification-code", append(_sendverificationcodeMw(), auth.SendVerificationCode)...)
+				_auth.POST("/unlink", append(_unlinkloginmethodMw(), auth.UnlinkLoginMethod)...)
+				_auth.POST("/wallet-challenge", append(_walletchallengeMw(), auth.WalletChallenge)...)
 				_auth.POST("/wallet-login", append(_walletloginMw(), auth.WalletLogin)...)
+				{
+					__fa := _auth.Group("/2fa", __faMw()...)
+					__fa.POST("/disable", append(_disabletwofactorMw(), auth.DisableTwoFactor)...)
+					__fa.POST("/enable", append(_enabletwofactorMw(), auth.EnableTwoFactor)...)
+					__fa.POST("/recovery-codes", append(_regeneraterecoverycodesMw(), auth.RegenerateRecoveryCodes)...)
+					__fa.POST("/setup", append(_setuptwofactorMw(), auth.SetupTwoFactor)...)
+					__fa.POST("/status", append(_gettwofactorstatusMw(), auth.GetTwoFactorStatus)...)
+					__fa.POST("/verify", append(_verifytwofactorloginMw(), auth.VerifyTwoFactorLogin)...)
+				}
 			}
 		}
 	}
@@ -3,6 +3,9 @@
 package auth
 
 import (
+	"orbia_api/biz/consts"
+	"orbia_api/biz/mw"
+
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -26,9 +29,9 @@ func _authMw() []app.HandlerFunc {
 	return nil
 }
 
+// 邮箱登录 - 按 IP 和邮箱限流
 func _emailloginMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RateLimitMiddleware("email_login")}
 }
 
 func _sendcodeMw() []app.HandlerFunc {
@@ -36,12 +39,82 @@ func _sendcodeMw() []app.HandlerFunc {
 	return nil
 }
 
+// 钱包登录 - 按 IP 和钱包地址限流
 func _walletloginMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RateLimitMiddleware("wallet_login")}
 }
 
+// 发送验证码 - 按 IP 和邮箱限流
 func _sendverificationcodeMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RateLimitMiddleware("send_verification_code")}
+}
+
+// 钱包登录挑战 - 按 IP 限流
+func _walletchallengeMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RateLimitMiddleware("wallet_challenge")}
+}
+
+// 退出登录 - 需要登录
+func _logoutMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+// 退出所有会话 - 需要登录
+func _logoutallMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+// 刷新令牌 - 按 IP 限流
+func _refreshtokenMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RateLimitMiddleware("refresh_token")}
+}
+
+// 绑定邮箱 - 需要登录，按 IP 和邮箱限流
+func _linkemailMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin), mw.RateLimitMiddleware("link_email")}
+}
+
+// 绑定钱包 - 需要登录，按 IP 和钱包地址限流
+func _linkwalletMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin), mw.RateLimitMiddleware("wallet_login")}
+}
+
+// 解绑登录方式 - 需要登录
+func _unlinkloginmethodMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+func __faMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+// 关闭两步验证 - 需要登录，按 IP 限流
+func _disabletwofactorMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin), mw.RateLimitMiddleware("two_factor_manage")}
+}
+
+// 启用两步验证 - 需要登录，按 IP 限流
+func _enabletwofactorMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin), mw.RateLimitMiddleware("two_factor_manage")}
+}
+
+// 重新生成恢复码 - 需要登录，按 IP 限流
+func _regeneraterecoverycodesMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin), mw.RateLimitMiddleware("two_factor_manage")}
+}
+
+// 开始启用两步验证 - 需要登录
+func _setuptwofactorMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+// 获取两步验证状态 - 需要登录
+func _gettwofactorstatusMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+// 两步验证登录 - 按 IP 限流
+func _verifytwofactorloginMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RateLimitMiddleware("two_factor_verify")}
+}
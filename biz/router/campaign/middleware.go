@@ -19,14 +19,14 @@ func _campaignMw() []app.HandlerFunc {
 	return nil
 }
 
+// admin查询所有Campaign - 需要Campaign查看权限
 func _adminlistcampaignsMw() []app.HandlerFunc {
-	// 需要JWT认证，且仅管理员可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin), mw.RequirePermission(consts.PermCampaignRead)}
 }
 
+// admin修改Campaign状态 - 需要Campaign管理权限
 func _adminupdatecampaignstatusMw() []app.HandlerFunc {
-	// 需要JWT认证，且仅管理员可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin), mw.RequirePermission(consts.PermCampaignManage)}
 }
 
 func _campaign0Mw() []app.HandlerFunc {
@@ -34,28 +34,28 @@ func _campaign0Mw() []app.HandlerFunc {
 }
 
 func _createcampaignMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户可访问，允许拥有 campaign:write 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeCampaignWrite), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _getcampaignMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户可访问，允许拥有 campaign:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeCampaignRead), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _listcampaignsMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户可访问，允许拥有 campaign:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeCampaignRead), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _updatecampaignstatusMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户可访问，允许拥有 campaign:write 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeCampaignWrite), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _updatecampaignMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户可访问，允许拥有 campaign:write 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeCampaignWrite), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _apiMw() []app.HandlerFunc {
@@ -31,9 +31,9 @@ func _adminMw() []app.HandlerFunc {
 	}
 }
 
+// Dashboard内容管理 - 需要内容管理权限
 func _dashboardMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermContentManage)}
 }
 
 func _excellentcaseMw() []app.HandlerFunc {
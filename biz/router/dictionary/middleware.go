@@ -28,8 +28,9 @@ func _adminMw() []app.HandlerFunc {
 	}
 }
 
+// 字典管理 - 需要内容管理权限
 func _dictionaryMw() []app.HandlerFunc {
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermContentManage)}
 }
 
 func _getdictionarybycodeMw() []app.HandlerFunc {
@@ -37,6 +37,7 @@ func Register(r *server.Hertz) {
 				}
 				{
 					_stats := _kol.Group("/stats", _statsMw()...)
+					_stats.POST("/history", append(_getkolstatshistoryMw(), kol.GetKolStatsHistory)...)
 					_stats.POST("/update", append(_updatekolstatsMw(), kol.UpdateKolStats)...)
 				}
 				{
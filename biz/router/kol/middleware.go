@@ -35,8 +35,8 @@ func _applykolMw() []app.HandlerFunc {
 }
 
 func _getkolinfoMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户和管理员都可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户和管理员都可访问，允许拥有 kol:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeKolRead), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _getkollistMw() []app.HandlerFunc {
@@ -45,13 +45,13 @@ func _getkollistMw() []app.HandlerFunc {
 }
 
 func _getkolplansMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户和管理员都可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户和管理员都可访问，允许拥有 kol:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeKolRead), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
+// 审核KOL申请 - 需要KOL审核权限
 func _reviewkolMw() []app.HandlerFunc {
-	// 需要JWT认证，且仅管理员可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin), mw.RequirePermission(consts.PermKolReview)}
 }
 
 func _updatekolinfoMw() []app.HandlerFunc {
@@ -60,8 +60,8 @@ func _updatekolinfoMw() []app.HandlerFunc {
 }
 
 func _getkolvideosMw() []app.HandlerFunc {
-	// 需要JWT认证，普通用户和管理员都可访问
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+	// 需要JWT认证，普通用户和管理员都可访问，允许拥有 kol:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeKolRead), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
 
 func _planMw() []app.HandlerFunc {
@@ -108,3 +108,8 @@ func _updatekolvideoMw() []app.HandlerFunc {
 	// 需要JWT认证，普通用户和管理员都可访问
 	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
+
+func _getkolstatshistoryMw() []app.HandlerFunc {
+	// 需要JWT认证，普通用户和管理员都可访问，允许拥有 kol:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeKolRead), mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
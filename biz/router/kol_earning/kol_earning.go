@@ -0,0 +1,52 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package kol_earning
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	kol_earning "orbia_api/biz/handler/kol_earning"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	{
+		_api := root.Group("/api", _apiMw()...)
+		{
+			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_admin := _v1.Group("/admin", _adminMw()...)
+				{
+					_withdrawal := _admin.Group("/withdrawal", _withdrawalMw()...)
+					_withdrawal.POST("/confirm", append(_confirmwithdrawalorderMw(), kol_earning.ConfirmWithdrawalOrder)...)
+					_withdrawal.POST("/list", append(_getallwithdrawalordersMw(), kol_earning.GetAllWithdrawalOrders)...)
+					_withdrawal.POST("/reject", append(_rejectwithdrawalorderMw(), kol_earning.RejectWithdrawalOrder)...)
+				}
+			}
+			{
+				_kol_earning := _v1.Group("/kol-earning", _kol_earningMw()...)
+				_kol_earning.POST("/info", append(_getmykolearningMw(), kol_earning.GetMyKolEarning)...)
+				_kol_earning.POST("/records", append(_getmykolearningrecordsMw(), kol_earning.GetMyKolEarningRecords)...)
+				{
+					_withdrawal0 := _kol_earning.Group("/withdrawal", _withdrawal0Mw()...)
+					_withdrawal0.POST("/create", append(_createwithdrawalorderMw(), kol_earning.CreateWithdrawalOrder)...)
+					{
+						_detail := _withdrawal0.Group("/detail", _detailMw()...)
+						_detail.POST("/:order_id", append(_getwithdrawalorderdetailMw(), kol_earning.GetWithdrawalOrderDetail)...)
+					}
+					{
+						_my := _withdrawal0.Group("/my", _myMw()...)
+						_my.POST("/list", append(_getmywithdrawalordersMw(), kol_earning.GetMyWithdrawalOrders)...)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by hertz generator.
+
+package kol_earning
+
+import (
+	"orbia_api/biz/consts"
+	"orbia_api/biz/mw"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// admin路由需要管理员权限
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+}
+
+func _withdrawalMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// admin确认提现打款 - 需要资金操作权限，按 Idempotency-Key 去重，防止重复扣减冻结收益
+func _confirmwithdrawalorderMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite), mw.IdempotencyMiddleware()}
+}
+
+// admin查询所有提现订单 - 需要资金查看权限
+func _getallwithdrawalordersMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceRead)}
+}
+
+// admin拒绝提现会解冻收益余额 - 需要资金操作权限，按 Idempotency-Key 去重
+func _rejectwithdrawalorderMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite), mw.IdempotencyMiddleware()}
+}
+
+func _kol_earningMw() []app.HandlerFunc {
+	// KOL 收益接口需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+func _getmykolearningMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmykolearningrecordsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _withdrawal0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// 创建提现订单会冻结收益余额，按 Idempotency-Key 去重，防止超时重试重复冻结
+func _createwithdrawalorderMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.IdempotencyMiddleware()}
+}
+
+func _detailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getwithdrawalorderdetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _myMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmywithdrawalordersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -22,24 +22,49 @@ func Register(r *server.Hertz) {
 		{
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			{
-				_kol_order := _v1.Group("/kol-order", _kol_orderMw()...)
-				_kol_order.POST("/cancel", append(_cancelkolorderMw(), kol_order.CancelKolOrder)...)
-				_kol_order.POST("/create", append(_createkolorderMw(), kol_order.CreateKolOrder)...)
-				_kol_order.POST("/detail", append(_getkolorderMw(), kol_order.GetKolOrder)...)
+				_admin := _v1.Group("/admin", _adminMw()...)
 				{
-					_kol := _kol_order.Group("/kol", _kolMw()...)
+					_kol_order := _admin.Group("/kol-order", _kol_orderMw()...)
+					_kol_order.POST("/refund", append(_adminrefundkolorderMw(), kol_order.AdminRefundKolOrder)...)
+					{
+						_review := _kol_order.Group("/review", _reviewMw()...)
+						_review.POST("/list", append(_admingetkolreviewlistMw(), kol_order.AdminGetKolReviewList)...)
+						_review.POST("/moderate", append(_adminmoderatekolreviewMw(), kol_order.AdminModerateKolReview)...)
+					}
+				}
+			}
+			{
+				_kol_order0 := _v1.Group("/kol-order", _kol_order0Mw()...)
+				_kol_order0.POST("/cancel", append(_cancelkolorderMw(), kol_order.CancelKolOrder)...)
+				_kol_order0.POST("/create", append(_createkolorderMw(), kol_order.CreateKolOrder)...)
+				_kol_order0.POST("/detail", append(_getkolorderMw(), kol_order.GetKolOrder)...)
+				{
+					_deliverable := _kol_order0.Group("/deliverable", _deliverableMw()...)
+					_deliverable.POST("/accept", append(_acceptkolorderdeliverableMw(), kol_order.AcceptKolOrderDeliverable)...)
+					_deliverable.POST("/list", append(_getkolorderdeliverablesMw(), kol_order.GetKolOrderDeliverables)...)
+					_deliverable.POST("/revision", append(_requestkolorderrevisionMw(), kol_order.RequestKolOrderRevision)...)
+					_deliverable.POST("/submit", append(_submitkolorderdeliverableMw(), kol_order.SubmitKolOrderDeliverable)...)
+				}
+				{
+					_kol := _kol_order0.Group("/kol", _kolMw()...)
 					_kol.POST("/list", append(_getkolreceivedorderlistMw(), kol_order.GetKolReceivedOrderList)...)
 				}
 				{
-					_payment := _kol_order.Group("/payment", _paymentMw()...)
+					_payment := _kol_order0.Group("/payment", _paymentMw()...)
 					_payment.POST("/confirm", append(_confirmkolorderpaymentMw(), kol_order.ConfirmKolOrderPayment)...)
 				}
 				{
-					_status := _kol_order.Group("/status", _statusMw()...)
+					_review0 := _kol_order0.Group("/review", _review0Mw()...)
+					_review0.POST("/create", append(_createkolorderreviewMw(), kol_order.CreateKolOrderReview)...)
+					_review0.POST("/list", append(_getkolreviewlistMw(), kol_order.GetKolReviewList)...)
+					_review0.POST("/reply", append(_replykolreviewMw(), kol_order.ReplyKolReview)...)
+				}
+				{
+					_status := _kol_order0.Group("/status", _statusMw()...)
 					_status.POST("/update", append(_updatekolorderstatusMw(), kol_order.UpdateKolOrderStatus)...)
 				}
 				{
-					_user := _kol_order.Group("/user", _userMw()...)
+					_user := _kol_order0.Group("/user", _userMw()...)
 					_user.POST("/list", append(_getuserkolorderlistMw(), kol_order.GetUserKolOrderList)...)
 				}
 			}
@@ -3,6 +3,7 @@
 package kol_order
 
 import (
+	"orbia_api/biz/consts"
 	"orbia_api/biz/mw"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -24,28 +25,28 @@ func _v1Mw() []app.HandlerFunc {
 }
 
 func _kol_orderMw() []app.HandlerFunc {
-	// KOL 订单需要用户认证
-	return []app.HandlerFunc{mw.AuthMiddleware()}
+	// your code...
+	return nil
 }
 
+// 取消已支付的订单会退回托管资金，按 Idempotency-Key 去重，防止超时重试重复退款
 func _cancelkolorderMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.AuthMiddleware(), mw.IdempotencyMiddleware()}
 }
 
 func _createkolorderMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证，允许拥有 orders:write 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeOrdersWrite), mw.AuthMiddleware()}
 }
 
 func _getkolorderMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _kolMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _getkolreceivedorderlistMw() []app.HandlerFunc {
@@ -54,8 +55,8 @@ func _getkolreceivedorderlistMw() []app.HandlerFunc {
 }
 
 func _statusMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _updatekolorderstatusMw() []app.HandlerFunc {
@@ -64,8 +65,8 @@ func _updatekolorderstatusMw() []app.HandlerFunc {
 }
 
 func _userMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
 }
 
 func _getuserkolorderlistMw() []app.HandlerFunc {
@@ -74,11 +75,86 @@ func _getuserkolorderlistMw() []app.HandlerFunc {
 }
 
 func _paymentMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+// 确认支付会冻结钱包余额，按 Idempotency-Key 去重，防止超时重试重复扣款
+func _confirmkolorderpaymentMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.IdempotencyMiddleware()}
+}
+
+func _adminMw() []app.HandlerFunc {
+	// 管理员接口需要管理员权限
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+}
+
+// admin退款KOL订单会退回钱包余额 - 需要资金操作权限，按 Idempotency-Key 去重，防止重复退款
+func _adminrefundkolorderMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite), mw.IdempotencyMiddleware()}
+}
+
+func _kol_order0Mw() []app.HandlerFunc {
+	// KOL 订单需要用户认证，在各路由上声明，以便部分路由允许团队API Key访问
+	return nil
+}
+
+func _reviewMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
-func _confirmkolorderpaymentMw() []app.HandlerFunc {
+// admin查看评价列表 - 需要KOL查看权限
+func _admingetkolreviewlistMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermKolRead)}
+}
+
+// admin隐藏/恢复评价 - 需要KOL审核权限
+func _adminmoderatekolreviewMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermKolReview)}
+}
+
+func _review0Mw() []app.HandlerFunc {
+	// 需要用户认证，在各路由上声明，以便评价列表允许团队API Key访问
+	return nil
+}
+
+func _createkolorderreviewMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+func _getkolreviewlistMw() []app.HandlerFunc {
+	// 需要用户认证，允许拥有 kol:read 的团队API Key访问
+	return []app.HandlerFunc{mw.AllowAPIKey(consts.APIKeyScopeKolRead), mw.AuthMiddleware()}
+}
+
+func _replykolreviewMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+func _deliverableMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _acceptkolorderdeliverableMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+func _getkolorderdeliverablesMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+func _requestkolorderrevisionMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
+
+func _submitkolorderdeliverableMw() []app.HandlerFunc {
+	// 需要用户认证
+	return []app.HandlerFunc{mw.AuthMiddleware()}
+}
@@ -28,16 +28,19 @@ func _adminMw() []app.HandlerFunc {
 	}
 }
 
+// 收款设置管理 - 需要收款设置权限
 func _payment_settingsMw() []app.HandlerFunc {
-	return nil
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermPaymentManage)}
 }
 
+// 创建收款钱包 - 需要两步验证码
 func _createpaymentsettingMw() []app.HandlerFunc {
-	return nil
+	return []app.HandlerFunc{mw.TwoFactorStepUp()}
 }
 
+// 删除收款钱包 - 需要两步验证码
 func _deletepaymentsettingMw() []app.HandlerFunc {
-	return nil
+	return []app.HandlerFunc{mw.TwoFactorStepUp()}
 }
 
 func _getpaymentsettingdetailMw() []app.HandlerFunc {
@@ -48,8 +51,9 @@ func _getpaymentsettinglistMw() []app.HandlerFunc {
 	return nil
 }
 
+// 更新收款钱包 - 修改地址、网络或状态时需要两步验证码
 func _updatepaymentsettingMw() []app.HandlerFunc {
-	return nil
+	return []app.HandlerFunc{mw.TwoFactorStepUp()}
 }
 
 func _payment_settings0Mw() []app.HandlerFunc {
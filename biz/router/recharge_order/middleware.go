@@ -34,19 +34,19 @@ func _rechargeMw() []app.HandlerFunc {
 	return nil
 }
 
-// admin确认充值订单 - 仅管理员
+// admin确认充值订单 - 需要资金操作权限，大额订单需要两步验证码，按 Idempotency-Key 去重，防止重复入账
 func _confirmrechargeorderMw() []app.HandlerFunc {
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite), mw.TwoFactorStepUp(), mw.IdempotencyMiddleware()}
 }
 
-// admin查询所有充值订单 - 仅管理员
+// admin查询所有充值订单 - 需要资金查看权限
 func _getallrechargeordersMw() []app.HandlerFunc {
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceRead)}
 }
 
-// admin拒绝充值订单 - 仅管理员
+// admin拒绝充值订单 - 需要资金操作权限
 func _rejectrechargeorderMw() []app.HandlerFunc {
-	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleAdmin)}
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite)}
 }
 
 func _recharge0Mw() []app.HandlerFunc {
@@ -88,3 +88,33 @@ func _myMw() []app.HandlerFunc {
 func _getmyrechargeordersMw() []app.HandlerFunc {
 	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 }
+
+// admin触发链上校验 - 需要资金操作权限
+func _verifyrechargeorderMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite)}
+}
+
+func _depositMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// admin查询链上入账记录 - 需要资金查看权限
+func _getchaindepositsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceRead)}
+}
+
+// admin处理未匹配入账记录 - 需要资金操作权限，匹配时会入账，按 Idempotency-Key 去重
+func _resolvechaindepositMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequirePermission(consts.PermFinanceWrite), mw.IdempotencyMiddleware()}
+}
+
+func _webhookMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// 在线支付平台 webhook - 无需登录，由 handler 按平台规则校验签名
+func _paymentwebhookMw() []app.HandlerFunc {
+	return nil
+}
@@ -28,6 +28,12 @@ func Register(r *server.Hertz) {
 					_recharge.POST("/confirm", append(_confirmrechargeorderMw(), recharge_order.ConfirmRechargeOrder)...)
 					_recharge.POST("/list", append(_getallrechargeordersMw(), recharge_order.GetAllRechargeOrders)...)
 					_recharge.POST("/reject", append(_rejectrechargeorderMw(), recharge_order.RejectRechargeOrder)...)
+					_recharge.POST("/verify", append(_verifyrechargeorderMw(), recharge_order.VerifyRechargeOrder)...)
+					{
+						_deposit := _recharge.Group("/deposit", _depositMw()...)
+						_deposit.POST("/list", append(_getchaindepositsMw(), recharge_order.GetChainDeposits)...)
+						_deposit.POST("/resolve", append(_resolvechaindepositMw(), recharge_order.ResolveChainDeposit)...)
+					}
 				}
 			}
 			{
@@ -45,6 +51,10 @@ func Register(r *server.Hertz) {
 					_my := _recharge0.Group("/my", _myMw()...)
 					_my.POST("/list", append(_getmyrechargeordersMw(), recharge_order.GetMyRechargeOrders)...)
 				}
+				{
+					_webhook := _recharge0.Group("/webhook", _webhookMw()...)
+					_webhook.POST("/:platform", append(_paymentwebhookMw(), recharge_order.PaymentWebhook)...)
+				}
 			}
 		}
 	}
@@ -13,6 +13,7 @@ import (
 	dashboard "orbia_api/biz/router/dashboard"
 	dictionary "orbia_api/biz/router/dictionary"
 	kol "orbia_api/biz/router/kol"
+	kol_earning "orbia_api/biz/router/kol_earning"
 	kol_order "orbia_api/biz/router/kol_order"
 	payment_setting "orbia_api/biz/router/payment_setting"
 	recharge_order "orbia_api/biz/router/recharge_order"
@@ -25,6 +26,8 @@ import (
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	kol_earning.Register(r)
+
 	dashboard.Register(r)
 
 	campaign.Register(r)
@@ -123,3 +123,23 @@ func _invitationMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _api_keyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createteamapikeyMw() []app.HandlerFunc {
+	// 需要JWT认证，普通用户和管理员都可访问
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+func _listteamapikeysMw() []app.HandlerFunc {
+	// 需要JWT认证，普通用户和管理员都可访问
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+func _revoketeamapikeyMw() []app.HandlerFunc {
+	// 需要JWT认证，普通用户和管理员都可访问
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
@@ -31,6 +31,12 @@ func Register(r *server.Hertz) {
 				_team.POST("/remove-member", append(_removeteammemberMw(), team.RemoveTeamMember)...)
 				_team.POST("/update", append(_updateteamMw(), team.UpdateTeam)...)
 				_team.POST("/user-teams", append(_getuserteamsMw(), team.GetUserTeams)...)
+				{
+					_api_key := _team.Group("/api-key", _api_keyMw()...)
+					_api_key.POST("/create", append(_createteamapikeyMw(), team.CreateTeamAPIKey)...)
+					_api_key.POST("/list", append(_listteamapikeysMw(), team.ListTeamAPIKeys)...)
+					_api_key.POST("/revoke", append(_revoketeamapikeyMw(), team.RevokeTeamAPIKey)...)
+				}
 				{
 					_invitation := _team.Group("/invitation", _invitationMw()...)
 					_invitation.POST("/accept", append(_acceptinvitationMw(), team.AcceptInvitation)...)
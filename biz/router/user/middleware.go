@@ -49,3 +49,18 @@ func _switchcurrentteamMw() []app.HandlerFunc {
 	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
 
 }
+
+func _sessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listsessionsMw() []app.HandlerFunc {
+	// 需要JWT认证，普通用户和管理员都可访问
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
+
+func _revokesessionMw() []app.HandlerFunc {
+	// 需要JWT认证，普通用户和管理员都可访问
+	return []app.HandlerFunc{mw.AuthMiddleware(consts.RoleNormal, consts.RoleAdmin)}
+}
@@ -24,6 +24,9 @@ func Register(r *server.Hertz) {
 			{
 				_user := _v1.Group("/user", _userMw()...)
 				_user.POST("/profile", append(_getprofileMw(), user.GetProfile)...)
+				_user.POST("/sessions", append(_listsessionsMw(), user.ListSessions)...)
+				_sessions := _user.Group("/sessions", _sessionsMw()...)
+				_sessions.POST("/revoke", append(_revokesessionMw(), user.RevokeSession)...)
 				_user.POST("/switch-team", append(_switchcurrentteamMw(), user.SwitchCurrentTeam)...)
 				_user.POST("/update-profile", append(_updateprofileMw(), user.UpdateProfile)...)
 				_user.POST("/:user_id", append(_getuserbyidMw(), user.GetUserById)...)
@@ -10,6 +10,7 @@ import (
 	"orbia_api/biz/dal/mysql"
 	adOrderModel "orbia_api/biz/model/ad_order"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 )
 
 var (
@@ -30,17 +31,23 @@ func CreateAdOrder(userID int64, req *adOrderModel.CreateAdOrderReq) (*adOrderMo
 		// TODO: 验证用户是否属于该团队（需要团队仓储支持）
 	}
 
-	// 2. 生成订单ID (ADORD_ 前缀表示 Ad Order)
+	// 2. 解析预算金额
+	budget, err := money.Parse(req.Budget)
+	if err != nil {
+		return nil, fmt.Errorf("预算金额格式错误: %w", err)
+	}
+
+	// 3. 生成订单ID (ADORD_ 前缀表示 Ad Order)
 	orderID := utils.GenerateAdOrderID()
 
-	// 3. 创建订单
+	// 4. 创建订单
 	order := &mysql.AdOrder{
 		OrderID:        orderID,
 		UserID:         userID,
 		TeamID:         req.TeamID,
 		Title:          req.Title,
 		Description:    req.Description,
-		Budget:         req.Budget,
+		Budget:         budget,
 		AdType:         req.AdType,
 		TargetAudience: req.TargetAudience,
 		StartDate:      req.StartDate,
@@ -209,7 +216,7 @@ func convertToAdOrderInfo(order *mysql.AdOrderWithUserInfo) *adOrderModel.AdOrde
 		UserID:         order.UserID,
 		Title:          order.Title,
 		Description:    order.Description,
-		Budget:         order.Budget,
+		Budget:         order.Budget.String(),
 		AdType:         order.AdType,
 		TargetAudience: order.TargetAudience,
 		StartDate:      order.StartDate,
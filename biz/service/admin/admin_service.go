@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"orbia_api/biz/consts"
@@ -11,7 +12,10 @@ import (
 	"orbia_api/biz/dal/mysql"
 	adminmodel "orbia_api/biz/model/admin"
 	"orbia_api/biz/model/common"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/ledger"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"gorm.io/gorm"
@@ -26,6 +30,11 @@ type AdminService struct {
 	walletRepo   mysql.WalletRepository
 	campaignRepo mysql.CampaignRepository
 	txRepo       mysql.TransactionRepository
+	ledgerSvc    ledger.LedgerService
+	sessionRepo  mysql.AuthSessionRepository
+	mergeRepo    mysql.AccountMergeRepository
+	userRoleRepo mysql.UserRoleRepository
+	auditSvc     audit.AuditService
 	db           *gorm.DB
 }
 
@@ -38,6 +47,11 @@ func NewAdminService(
 	walletRepo mysql.WalletRepository,
 	campaignRepo mysql.CampaignRepository,
 	txRepo mysql.TransactionRepository,
+	ledgerSvc ledger.LedgerService,
+	sessionRepo mysql.AuthSessionRepository,
+	mergeRepo mysql.AccountMergeRepository,
+	userRoleRepo mysql.UserRoleRepository,
+	auditSvc audit.AuditService,
 	db *gorm.DB,
 ) *AdminService {
 	return &AdminService{
@@ -48,6 +62,11 @@ func NewAdminService(
 		walletRepo:   walletRepo,
 		campaignRepo: campaignRepo,
 		txRepo:       txRepo,
+		ledgerSvc:    ledgerSvc,
+		sessionRepo:  sessionRepo,
+		mergeRepo:    mergeRepo,
+		userRoleRepo: userRoleRepo,
+		auditSvc:     auditSvc,
 		db:           db,
 	}
 }
@@ -83,30 +102,7 @@ func (s *AdminService) GetAllUsers(ctx context.Context, req *adminmodel.GetAllUs
 	// 构建响应
 	userList := make([]*adminmodel.UserListItem, 0, len(users))
 	for _, user := range users {
-		item := &adminmodel.UserListItem{
-			ID:        user.ID,
-			Role:      user.Role,
-			Status:    user.Status,
-			CreatedAt: user.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt: user.UpdatedAt.Format("2006-01-02 15:04:05"),
-		}
-
-		if user.WalletAddress != nil {
-			item.WalletAddress = user.WalletAddress
-		}
-		if user.Email != nil {
-			item.Email = user.Email
-		}
-		if user.Nickname != nil {
-			item.Nickname = user.Nickname
-		}
-		if user.AvatarURL != nil {
-			item.AvatarURL = user.AvatarURL
-		}
-		if user.KolID != nil {
-			item.KolID = user.KolID
-		}
-
+		item := buildUserListItem(user)
 		userList = append(userList, item)
 	}
 
@@ -122,6 +118,35 @@ func (s *AdminService) GetAllUsers(ctx context.Context, req *adminmodel.GetAllUs
 	}, nil
 }
 
+// buildUserListItem 构建用户列表项
+func buildUserListItem(user *mysql.User) *adminmodel.UserListItem {
+	item := &adminmodel.UserListItem{
+		ID:        user.ID,
+		Role:      user.Role,
+		Status:    user.Status,
+		CreatedAt: user.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: user.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if user.WalletAddress != nil {
+		item.WalletAddress = user.WalletAddress
+	}
+	if user.Email != nil {
+		item.Email = user.Email
+	}
+	if user.Nickname != nil {
+		item.Nickname = user.Nickname
+	}
+	if user.AvatarURL != nil {
+		item.AvatarURL = user.AvatarURL
+	}
+	if user.KolID != nil {
+		item.KolID = user.KolID
+	}
+
+	return item
+}
+
 // SetUserStatus 设置用户状态
 func (s *AdminService) SetUserStatus(ctx context.Context, req *adminmodel.SetUserStatusReq) (*adminmodel.SetUserStatusResp, error) {
 	// 验证状态值
@@ -129,21 +154,34 @@ func (s *AdminService) SetUserStatus(ctx context.Context, req *adminmodel.SetUse
 		return nil, errors.New("invalid status value")
 	}
 
-	// 获取用户信息
-	user, err := s.userRepo.GetUserByID(req.UserID)
-	if err != nil {
-		hlog.Errorf("Failed to get user: %v", err)
-		return nil, errors.New("user not found")
-	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 获取用户信息
+		user, err := s.userRepo.GetUserByIDForUpdate(tx, req.UserID)
+		if err != nil {
+			hlog.Errorf("Failed to get user: %v", err)
+			return errors.New("user not found")
+		}
 
-	// 检查是否是管理员
-	if user.Role == string(consts.RoleAdmin) {
-		return nil, errors.New("cannot modify admin user status")
-	}
+		// 检查是否是管理员
+		if user.Role == string(consts.RoleAdmin) {
+			return errors.New("cannot modify admin user status")
+		}
 
-	// 更新用户状态
-	if err := s.userRepo.UpdateUserStatus(req.UserID, req.Status); err != nil {
-		hlog.Errorf("Failed to update user status: %v", err)
+		// 更新用户状态
+		if err := s.userRepo.UpdateUserStatusWithTx(tx, req.UserID, req.Status); err != nil {
+			return fmt.Errorf("failed to update user status: %v", err)
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionUserSetStatus,
+			TargetType: audit.TargetUser,
+			TargetID:   strconv.FormatInt(req.UserID, 10),
+			Before:     map[string]interface{}{"status": user.Status},
+			After:      map[string]interface{}{"status": req.Status},
+		})
+	})
+	if err != nil {
+		hlog.Errorf("Failed to set user %d status: %v", req.UserID, err)
 		return nil, err
 	}
 
@@ -232,25 +270,40 @@ func (s *AdminService) AdminReviewKol(ctx context.Context, req *adminmodel.Admin
 		return nil, errors.New("invalid status value")
 	}
 
-	// 获取KOL信息
-	kol, err := s.kolRepo.GetKolByID(req.KolID)
-	if err != nil {
-		hlog.Errorf("Failed to get kol: %v", err)
-		return nil, errors.New("kol not found")
-	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 获取KOL信息
+		kol, err := s.kolRepo.GetKolByIDForUpdate(tx, req.KolID)
+		if err != nil {
+			hlog.Errorf("Failed to get kol: %v", err)
+			return errors.New("kol not found")
+		}
 
-	// 更新KOL状态
-	kol.Status = req.Status
-	if req.Status == "approved" {
-		now := time.Now()
-		kol.ApprovedAt = &now
-		kol.RejectReason = nil
-	} else if req.Status == "rejected" && req.RejectReason != nil {
-		kol.RejectReason = req.RejectReason
-	}
+		before := kolReviewSnapshot(kol.Status, kol.RejectReason)
+
+		// 更新KOL状态
+		kol.Status = req.Status
+		if req.Status == "approved" {
+			now := time.Now()
+			kol.ApprovedAt = &now
+			kol.RejectReason = nil
+		} else if req.Status == "rejected" && req.RejectReason != nil {
+			kol.RejectReason = req.RejectReason
+		}
+
+		if err := s.kolRepo.UpdateKolWithTx(tx, kol); err != nil {
+			return fmt.Errorf("failed to update kol: %v", err)
+		}
 
-	if err := s.kolRepo.UpdateKol(kol); err != nil {
-		hlog.Errorf("Failed to update kol: %v", err)
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionKolReview,
+			TargetType: audit.TargetKol,
+			TargetID:   strconv.FormatInt(kol.ID, 10),
+			Before:     before,
+			After:      kolReviewSnapshot(kol.Status, kol.RejectReason),
+		})
+	})
+	if err != nil {
+		hlog.Errorf("Failed to review kol %d: %v", req.KolID, err)
 		return nil, err
 	}
 
@@ -414,7 +467,7 @@ func (s *AdminService) GetAllOrders(ctx context.Context, req *adminmodel.GetAllO
 			UserID:    order.UserID,
 			KolID:     order.KolID,
 			PlanTitle: order.PlanTitle,
-			PlanPrice: order.PlanPrice,
+			PlanPrice: order.PlanPrice.String(),
 			Status:    order.Status,
 			CreatedAt: order.CreatedAt.Format("2006-01-02 15:04:05"),
 		}
@@ -470,10 +523,10 @@ func (s *AdminService) GetUserWallet(ctx context.Context, req *adminmodel.GetUse
 
 	walletInfo := &adminmodel.UserWalletInfo{
 		UserID:        user.ID,
-		Balance:       wallet.Balance,
-		FrozenBalance: wallet.FrozenBalance,
-		TotalRecharge: wallet.TotalRecharge,
-		TotalConsume:  wallet.TotalConsume,
+		Balance:       wallet.Balance.String(),
+		FrozenBalance: wallet.FrozenBalance.String(),
+		TotalRecharge: wallet.TotalRecharge.String(),
+		TotalConsume:  wallet.TotalConsume.String(),
 		CreatedAt:     wallet.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:     wallet.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
@@ -494,6 +547,42 @@ func (s *AdminService) GetUserWallet(ctx context.Context, req *adminmodel.GetUse
 	}, nil
 }
 
+// ReconcileWallets 按账本重新计算钱包余额，并报告与 orbia_wallet 的差异
+func (s *AdminService) ReconcileWallets(ctx context.Context, req *adminmodel.ReconcileWalletsReq) (*adminmodel.ReconcileWalletsResp, error) {
+	report, err := s.ledgerSvc.ReconcileWallets(req.UserID)
+	if err != nil {
+		hlog.Errorf("Failed to reconcile wallets: %v", err)
+		return nil, fmt.Errorf("failed to reconcile wallets: %v", err)
+	}
+
+	drifts := make([]*adminmodel.WalletDriftItem, 0, len(report.Drifts))
+	for _, drift := range report.Drifts {
+		drifts = append(drifts, &adminmodel.WalletDriftItem{
+			UserID:              drift.UserID,
+			WalletBalance:       drift.WalletBalance.String(),
+			LedgerBalance:       drift.LedgerBalance.String(),
+			BalanceDrift:        drift.BalanceDrift.String(),
+			WalletFrozenBalance: drift.WalletFrozenBalance.String(),
+			LedgerFrozenBalance: drift.LedgerFrozenBalance.String(),
+			FrozenDrift:         drift.FrozenDrift.String(),
+		})
+	}
+
+	return &adminmodel.ReconcileWalletsResp{
+		BaseResp: &common.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		WalletCount: int32(report.WalletCount),
+		DriftCount:  int32(len(drifts)),
+		Drifts:      drifts,
+		TotalDebit:  report.TotalDebit.String(),
+		TotalCredit: report.TotalCredit.String(),
+		Balanced:    report.Balanced,
+		CheckedAt:   report.CheckedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
 // getStringValue 辅助函数：获取字符串指针的值
 func getStringValue(s *string) string {
 	if s == nil {
@@ -505,7 +594,11 @@ func getStringValue(s *string) string {
 // AddCampaignConsume 管理员给Campaign添加消费账单
 func (s *AdminService) AddCampaignConsume(ctx context.Context, req *adminmodel.AddCampaignConsumeReq) (*adminmodel.AddCampaignConsumeResp, error) {
 	// 1. 验证金额
-	if req.Amount <= 0 {
+	amount, err := money.Parse(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if !amount.IsPositive() {
 		return nil, errors.New("amount must be greater than 0")
 	}
 
@@ -519,48 +612,47 @@ func (s *AdminService) AddCampaignConsume(ctx context.Context, req *adminmodel.A
 		return nil, fmt.Errorf("failed to get campaign: %v", err)
 	}
 
-	// 3. 获取 Campaign 创建用户的钱包
-	wallet, err := s.walletRepo.GetWalletByUserID(campaign.UserID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user wallet not found")
+	// 3. 开始事务
+	var transactionID string
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 3.1 获取 Campaign 创建用户的钱包并加行锁，防止并发扣款同时通过余额检查
+		wallet, err := s.walletRepo.GetWalletByUserIDForUpdate(tx, campaign.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("user wallet not found")
+			}
+			hlog.Errorf("Failed to get wallet: %v", err)
+			return fmt.Errorf("failed to get wallet: %v", err)
 		}
-		hlog.Errorf("Failed to get wallet: %v", err)
-		return nil, fmt.Errorf("failed to get wallet: %v", err)
-	}
 
-	// 4. 检查余额是否足够
-	if wallet.Balance < req.Amount {
-		return nil, fmt.Errorf("insufficient balance: current balance %.2f, required %.2f", wallet.Balance, req.Amount)
-	}
+		// 3.2 检查余额是否足够
+		if wallet.Balance < amount {
+			return fmt.Errorf("insufficient balance: current balance %s, required %s", wallet.Balance, amount)
+		}
 
-	// 5. 开始事务
-	var transactionID string
-	err = s.db.Transaction(func(tx *gorm.DB) error {
-		// 5.1 扣除用户钱包余额
-		balanceDelta := -req.Amount // 负数表示扣款
-		if err := s.walletRepo.UpdateBalance(tx, campaign.UserID, balanceDelta, 0); err != nil {
+		// 3.3 扣除用户钱包余额（记账：用户钱包 -> 平台收入）
+		_, err = s.ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryCampaignConsume,
+			ReferenceType: "campaign",
+			ReferenceID:   req.CampaignID,
+			Remark:        getStringValue(req.Remark),
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.UserWallet(campaign.UserID), amount),
+				ledger.Credit(ledger.PlatformRevenue, amount),
+			},
+		})
+		if err != nil {
 			hlog.Errorf("Failed to update wallet balance: %v", err)
 			return fmt.Errorf("failed to update wallet balance: %v", err)
 		}
 
-		// 5.2 更新累计消费金额
-		err := tx.Model(&model.OrbiaWallet{}).
-			Where("user_id = ?", campaign.UserID).
-			Update("total_consume", gorm.Expr("total_consume + ?", req.Amount)).Error
-		if err != nil {
+		// 3.4 更新累计消费金额
+		if err := s.walletRepo.UpdateWalletTotals(tx, campaign.UserID, 0, amount); err != nil {
 			hlog.Errorf("Failed to update total_consume: %v", err)
 			return fmt.Errorf("failed to update total_consume: %v", err)
 		}
 
-		// 5.3 获取更新后的钱包信息
-		updatedWallet, err := s.walletRepo.GetWalletByUserID(campaign.UserID)
-		if err != nil {
-			hlog.Errorf("Failed to get updated wallet: %v", err)
-			return fmt.Errorf("failed to get updated wallet: %v", err)
-		}
-
-		// 5.4 创建交易记录
+		// 3.5 创建交易记录
 		transactionID = utils.GenerateTransactionID()
 		now := time.Now()
 
@@ -573,9 +665,9 @@ func (s *AdminService) AddCampaignConsume(ctx context.Context, req *adminmodel.A
 			TransactionID:    transactionID,
 			UserID:           campaign.UserID,
 			Type:             "consume",
-			Amount:           req.Amount,
+			Amount:           amount,
 			BalanceBefore:    wallet.Balance,
-			BalanceAfter:     updatedWallet.Balance,
+			BalanceAfter:     wallet.Balance - amount,
 			Status:           "completed",
 			RelatedOrderType: stringPtr("campaign"),
 			RelatedOrderID:   &req.CampaignID,
@@ -588,7 +680,20 @@ func (s *AdminService) AddCampaignConsume(ctx context.Context, req *adminmodel.A
 			return fmt.Errorf("failed to create transaction: %v", err)
 		}
 
-		return nil
+		// 3.6 记录审计日志
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionCampaignConsume,
+			TargetType: audit.TargetCampaign,
+			TargetID:   req.CampaignID,
+			Before:     map[string]interface{}{"user_id": campaign.UserID, "balance": wallet.Balance},
+			After: map[string]interface{}{
+				"user_id":        campaign.UserID,
+				"balance":        wallet.Balance - amount,
+				"amount":         amount,
+				"transaction_id": transactionID,
+				"remark":         remark,
+			},
+		})
 	})
 
 	if err != nil {
@@ -605,6 +710,14 @@ func (s *AdminService) AddCampaignConsume(ctx context.Context, req *adminmodel.A
 	}, nil
 }
 
+// kolReviewSnapshot 构建KOL审核的审计快照
+func kolReviewSnapshot(status string, rejectReason *string) map[string]interface{} {
+	return map[string]interface{}{
+		"status":        status,
+		"reject_reason": getStringValue(rejectReason),
+	}
+}
+
 // stringPtr 辅助函数：将字符串转换为指针
 func stringPtr(s string) *string {
 	if s == "" {
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"orbia_api/biz/dal/mysql"
+	adminmodel "orbia_api/biz/model/admin"
+	"orbia_api/biz/model/common"
+	"orbia_api/biz/utils"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// GetAuditLogs 查询审计日志
+func (s *AdminService) GetAuditLogs(ctx context.Context, req *adminmodel.GetAuditLogsReq) (*adminmodel.GetAuditLogsResp, error) {
+	filter, err := buildAuditLogFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := utils.NormalizePaginationValue(req.Page, req.PageSize)
+	logs, total, err := s.auditSvc.GetAuditLogs(filter, int(params.Page), int(params.PageSize))
+	if err != nil {
+		hlog.Errorf("Failed to get audit logs: %v", err)
+		return nil, err
+	}
+
+	items := make([]*adminmodel.AuditLogItem, 0, len(logs))
+	for _, log := range logs {
+		item := &adminmodel.AuditLogItem{
+			ID:         log.ID,
+			ActorID:    log.ActorID,
+			Action:     log.Action,
+			TargetType: log.TargetType,
+			TargetID:   log.TargetID,
+			BeforeData: log.BeforeData,
+			AfterData:  log.AfterData,
+			IP:         log.IP,
+			UserAgent:  log.UserAgent,
+			RequestID:  log.RequestID,
+		}
+		if log.CreatedAt != nil {
+			item.CreatedAt = log.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		items = append(items, item)
+	}
+
+	return &adminmodel.GetAuditLogsResp{
+		BaseResp: &common.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Logs:     items,
+		PageInfo: utils.BuildPageResp(params, total),
+	}, nil
+}
+
+// ExportAuditLogs 按查询条件导出审计日志为 CSV
+func (s *AdminService) ExportAuditLogs(ctx context.Context, req *adminmodel.GetAuditLogsReq) ([]byte, error) {
+	filter, err := buildAuditLogFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.auditSvc.ExportAuditLogsCSV(filter)
+	if err != nil {
+		hlog.Errorf("Failed to export audit logs: %v", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+// buildAuditLogFilter 将请求参数转换为审计日志查询条件
+func buildAuditLogFilter(req *adminmodel.GetAuditLogsReq) (*mysql.AuditLogFilter, error) {
+	filter := &mysql.AuditLogFilter{
+		Action:     getStringValue(req.Action),
+		TargetType: getStringValue(req.TargetType),
+		TargetID:   getStringValue(req.TargetID),
+		RequestID:  getStringValue(req.RequestID),
+	}
+	if req.ActorID != nil {
+		filter.ActorID = *req.ActorID
+	}
+
+	if req.StartTime != nil && *req.StartTime != "" {
+		startTime, err := time.Parse(time.RFC3339, *req.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_time format: %v", err)
+		}
+		filter.StartTime = &startTime
+	}
+	if req.EndTime != nil && *req.EndTime != "" {
+		endTime, err := time.Parse(time.RFC3339, *req.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_time format: %v", err)
+		}
+		filter.EndTime = &endTime
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,201 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/mysql"
+	adminmodel "orbia_api/biz/model/admin"
+	"orbia_api/biz/model/common"
+	"orbia_api/biz/service/audit"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// ListRoles 获取所有后台角色及其权限
+func (s *AdminService) ListRoles(ctx context.Context, req *adminmodel.ListRolesReq) (*adminmodel.ListRolesResp, error) {
+	roles := make([]*adminmodel.RoleInfo, 0, len(consts.AllStaffRoles()))
+	for _, role := range consts.AllStaffRoles() {
+		roles = append(roles, &adminmodel.RoleInfo{
+			Role:        role.String(),
+			Permissions: permissionStrings(role.Permissions()),
+		})
+	}
+
+	return &adminmodel.ListRolesResp{
+		BaseResp: &common.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Roles: roles,
+	}, nil
+}
+
+// GetUserRoles 获取用户的后台角色和权限
+func (s *AdminService) GetUserRoles(ctx context.Context, req *adminmodel.GetUserRolesReq) (*adminmodel.UserRolesResp, error) {
+	if _, err := s.userRepo.GetUserByID(req.UserID); err != nil {
+		hlog.Errorf("Failed to get user: %v", err)
+		return nil, errors.New("user not found")
+	}
+	return s.buildUserRolesResp(req.UserID)
+}
+
+// AssignUserRole 为用户分配后台角色，拥有后台角色的用户同时成为管理员
+func (s *AdminService) AssignUserRole(ctx context.Context, operatorID int64, req *adminmodel.UpdateUserRoleReq) (*adminmodel.UserRolesResp, error) {
+	role := consts.StaffRole(req.Role)
+	if !role.IsValid() {
+		return nil, errors.New("invalid role")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		user, err := s.userRepo.GetUserByIDForUpdate(tx, req.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("user not found")
+			}
+			return fmt.Errorf("failed to get user: %v", err)
+		}
+		if user.Status != "normal" {
+			return errors.New("user is not in normal status")
+		}
+
+		if err := s.userRoleRepo.AssignRole(tx, &mysql.UserRole{
+			UserID:    user.ID,
+			Role:      role.String(),
+			GrantedBy: &operatorID,
+		}); err != nil {
+			return fmt.Errorf("failed to assign role: %v", err)
+		}
+
+		if user.Role != string(consts.RoleAdmin) {
+			user.Role = string(consts.RoleAdmin)
+			if err := s.userRepo.UpdateUserWithTx(tx, user); err != nil {
+				return fmt.Errorf("failed to update user role: %v", err)
+			}
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionUserRoleAssign,
+			TargetType: audit.TargetUser,
+			TargetID:   strconv.FormatInt(user.ID, 10),
+			After:      map[string]interface{}{"role": role.String()},
+		})
+	})
+	if err != nil {
+		hlog.Errorf("Failed to assign role %s to user %d: %v", req.Role, req.UserID, err)
+		return nil, err
+	}
+
+	hlog.Infof("User %d assigned role %s to user %d", operatorID, req.Role, req.UserID)
+	return s.buildUserRolesResp(req.UserID)
+}
+
+// RevokeUserRole 撤销用户的后台角色，最后一个超级管理员不能被撤销；撤销全部角色后用户降为普通用户
+func (s *AdminService) RevokeUserRole(ctx context.Context, operatorID int64, req *adminmodel.UpdateUserRoleReq) (*adminmodel.UserRolesResp, error) {
+	role := consts.StaffRole(req.Role)
+	if !role.IsValid() {
+		return nil, errors.New("invalid role")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		user, err := s.userRepo.GetUserByIDForUpdate(tx, req.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("user not found")
+			}
+			return fmt.Errorf("failed to get user: %v", err)
+		}
+
+		// 锁定所有超级管理员的角色记录后再检查数量，防止并发撤销不同的超级管理员后一个都不剩
+		if role == consts.StaffRoleSuperAdmin {
+			count, err := s.userRoleRepo.CountUsersWithRoleForUpdate(tx, role.String())
+			if err != nil {
+				return fmt.Errorf("failed to count super admins: %v", err)
+			}
+			if count <= 1 {
+				return errors.New("cannot revoke the last super admin")
+			}
+		}
+
+		revoked, err := s.userRoleRepo.RevokeRole(tx, user.ID, role.String())
+		if err != nil {
+			return fmt.Errorf("failed to revoke role: %v", err)
+		}
+		if !revoked {
+			return errors.New("user does not have this role")
+		}
+
+		remaining, err := s.userRoleRepo.CountRoles(tx, user.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count user roles: %v", err)
+		}
+		if remaining == 0 && user.Role == string(consts.RoleAdmin) {
+			user.Role = string(consts.RoleNormal)
+			if err := s.userRepo.UpdateUserWithTx(tx, user); err != nil {
+				return fmt.Errorf("failed to update user role: %v", err)
+			}
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionUserRoleRevoke,
+			TargetType: audit.TargetUser,
+			TargetID:   strconv.FormatInt(user.ID, 10),
+			Before:     map[string]interface{}{"role": role.String()},
+		})
+	})
+	if err != nil {
+		hlog.Errorf("Failed to revoke role %s from user %d: %v", req.Role, req.UserID, err)
+		return nil, err
+	}
+
+	hlog.Infof("User %d revoked role %s from user %d", operatorID, req.Role, req.UserID)
+	return s.buildUserRolesResp(req.UserID)
+}
+
+// buildUserRolesResp 构建用户后台角色响应
+func (s *AdminService) buildUserRolesResp(userID int64) (*adminmodel.UserRolesResp, error) {
+	userRoles, err := s.userRoleRepo.GetRolesByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %v", err)
+	}
+
+	roles := make([]string, 0, len(userRoles))
+	granted := make(map[consts.Permission]bool)
+	for _, userRole := range userRoles {
+		roles = append(roles, userRole.Role)
+		for _, permission := range consts.StaffRole(userRole.Role).Permissions() {
+			granted[permission] = true
+		}
+	}
+
+	// 按 AllPermissions 的顺序输出，保证结果稳定
+	permissions := make([]consts.Permission, 0, len(granted))
+	for _, permission := range consts.AllPermissions() {
+		if granted[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	return &adminmodel.UserRolesResp{
+		BaseResp: &common.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		UserID:      userID,
+		Roles:       roles,
+		Permissions: permissionStrings(permissions),
+	}, nil
+}
+
+// permissionStrings 将权限列表转换为字符串列表
+func permissionStrings(permissions []consts.Permission) []string {
+	result := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		result = append(result, permission.String())
+	}
+	return result
+}
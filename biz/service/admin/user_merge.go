@@ -0,0 +1,228 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+	adminmodel "orbia_api/biz/model/admin"
+	"orbia_api/biz/model/common"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/ledger"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// revokeReasonAccountMerged 源账号被合并后吊销其登录会话
+const revokeReasonAccountMerged = "account_merged"
+
+// MergeUsers 合并两个用户账号
+// 源账号的邮箱/钱包地址、钱包余额（通过账本转账）、团队、订单、Campaign、交易记录、会话消息和KOL资料
+// 全部转移到目标账号，源账号的登录会话被吊销并删除；两个账号都有邮箱、钱包地址或KOL资料时无法合并
+func (s *AdminService) MergeUsers(ctx context.Context, req *adminmodel.MergeUsersReq) (*adminmodel.MergeUsersResp, error) {
+	if req.SourceUserID <= 0 || req.TargetUserID <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+	if req.SourceUserID == req.TargetUserID {
+		return nil, errors.New("cannot merge a user into itself")
+	}
+
+	var target *mysql.User
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 1. 按用户ID顺序加锁，避免并发合并时死锁
+		source, t, err := s.lockMergeUsers(tx, req.SourceUserID, req.TargetUserID)
+		if err != nil {
+			return err
+		}
+		target = t
+
+		if err := checkMergeUsers(source, target); err != nil {
+			return err
+		}
+		before := map[string]interface{}{
+			"source": buildUserListItem(source),
+			"target": buildUserListItem(target),
+		}
+
+		// 2. 转移钱包余额
+		if err := s.mergeWallets(tx, source.ID, target.ID, getStringValue(req.Remark)); err != nil {
+			return err
+		}
+
+		// 3. 转移订单、Campaign、交易记录、团队和会话
+		if err := s.mergeRepo.ReassignOwnedRecords(tx, source.ID, target.ID); err != nil {
+			return err
+		}
+		if err := s.mergeRepo.MergeTeamMemberships(tx, source.ID, target.ID); err != nil {
+			return fmt.Errorf("failed to merge team memberships: %v", err)
+		}
+		if err := s.mergeRepo.MergeConversationMemberships(tx, source.ID, target.ID); err != nil {
+			return fmt.Errorf("failed to merge conversation memberships: %v", err)
+		}
+		if source.KolID != nil {
+			if err := s.mergeRepo.ReassignKol(tx, *source.KolID, target.ID); err != nil {
+				return fmt.Errorf("failed to reassign KOL profile: %v", err)
+			}
+		}
+
+		// 4. 吊销源账号的登录会话
+		if err := s.sessionRepo.RevokeUserSessions(tx, source.ID, revokeReasonAccountMerged); err != nil {
+			return fmt.Errorf("failed to revoke source user sessions: %v", err)
+		}
+
+		// 5. 登录方式和KOL资料转移到目标账号；先清空源账号，避免唯一索引冲突
+		email, walletAddress, kolID := source.Email, source.WalletAddress, source.KolID
+		source.Email = nil
+		source.WalletAddress = nil
+		source.KolID = nil
+		source.CurrentTeamID = nil
+		source.Status = "deleted"
+		source.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		if err := s.userRepo.UpdateUserWithTx(tx, source); err != nil {
+			return fmt.Errorf("failed to delete source user: %v", err)
+		}
+
+		if target.Email == nil {
+			target.Email = email
+		}
+		if target.WalletAddress == nil {
+			target.WalletAddress = walletAddress
+		}
+		if target.KolID == nil {
+			target.KolID = kolID
+		}
+		if err := s.userRepo.UpdateUserWithTx(tx, target); err != nil {
+			return fmt.Errorf("failed to update target user: %v", err)
+		}
+
+		// 6. 记录审计日志
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionUserMerge,
+			TargetType: audit.TargetUser,
+			TargetID:   strconv.FormatInt(target.ID, 10),
+			Before:     before,
+			After: map[string]interface{}{
+				"source_user_id": source.ID,
+				"target":         buildUserListItem(target),
+				"remark":         getStringValue(req.Remark),
+			},
+		})
+	})
+	if err != nil {
+		hlog.Errorf("Failed to merge user %d into %d: %v", req.SourceUserID, req.TargetUserID, err)
+		return nil, err
+	}
+
+	hlog.Infof("Merged user %d into %d", req.SourceUserID, req.TargetUserID)
+
+	return &adminmodel.MergeUsersResp{
+		BaseResp: &common.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		User: buildUserListItem(target),
+	}, nil
+}
+
+// lockMergeUsers 按用户ID顺序锁定源账号和目标账号
+func (s *AdminService) lockMergeUsers(tx *gorm.DB, sourceUserID, targetUserID int64) (*mysql.User, *mysql.User, error) {
+	users := make(map[int64]*mysql.User, 2)
+	ids := []int64{sourceUserID, targetUserID}
+	if targetUserID < sourceUserID {
+		ids = []int64{targetUserID, sourceUserID}
+	}
+
+	for _, id := range ids {
+		user, err := s.userRepo.GetUserByIDForUpdate(tx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, fmt.Errorf("user %d not found", id)
+			}
+			return nil, nil, fmt.Errorf("failed to get user: %v", err)
+		}
+		users[id] = user
+	}
+
+	return users[sourceUserID], users[targetUserID], nil
+}
+
+// checkMergeUsers 检查两个账号是否可以合并
+func checkMergeUsers(source, target *mysql.User) error {
+	if source.Role == string(consts.RoleAdmin) {
+		return errors.New("cannot merge an admin user")
+	}
+	if target.Status != "normal" {
+		return errors.New("target user is not in normal status")
+	}
+	if source.Email != nil && target.Email != nil {
+		return errors.New("both users have an email, one of them must be unlinked before merging")
+	}
+	if source.WalletAddress != nil && target.WalletAddress != nil {
+		return errors.New("both users have a wallet address, one of them must be unlinked before merging")
+	}
+	if source.KolID != nil && target.KolID != nil {
+		return errors.New("both users have a KOL profile")
+	}
+	return nil
+}
+
+// mergeWallets 将源账号的可用余额和冻结余额转入目标账号（记账：源钱包 -> 目标钱包），并合并累计统计
+func (s *AdminService) mergeWallets(tx *gorm.DB, sourceUserID, targetUserID int64, remark string) error {
+	lockOrder := []int64{sourceUserID, targetUserID}
+	if targetUserID < sourceUserID {
+		lockOrder = []int64{targetUserID, sourceUserID}
+	}
+
+	wallets := make(map[int64]*model.OrbiaWallet, 2)
+	for _, userID := range lockOrder {
+		wallet, err := s.walletRepo.GetWalletByUserIDForUpdate(tx, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to get wallet: %v", err)
+		}
+		wallets[userID] = wallet
+	}
+
+	sourceWallet := wallets[sourceUserID]
+	if sourceWallet == nil {
+		return nil
+	}
+	if wallets[targetUserID] == nil {
+		return errors.New("target user wallet not found")
+	}
+
+	if !sourceWallet.Balance.IsZero() || !sourceWallet.FrozenBalance.IsZero() {
+		_, err := s.ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryAccountMerge,
+			ReferenceType: "user",
+			ReferenceID:   strconv.FormatInt(sourceUserID, 10),
+			Remark:        remark,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.UserWallet(sourceUserID), sourceWallet.Balance),
+				ledger.Credit(ledger.UserWallet(targetUserID), sourceWallet.Balance),
+				ledger.Debit(ledger.UserWalletFrozen(sourceUserID), sourceWallet.FrozenBalance),
+				ledger.Credit(ledger.UserWalletFrozen(targetUserID), sourceWallet.FrozenBalance),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to transfer wallet balance: %v", err)
+		}
+	}
+
+	if err := s.walletRepo.UpdateWalletTotals(tx, targetUserID, sourceWallet.TotalRecharge, sourceWallet.TotalConsume); err != nil {
+		return fmt.Errorf("failed to update wallet totals: %v", err)
+	}
+	if err := s.walletRepo.UpdateWalletTotals(tx, sourceUserID, -sourceWallet.TotalRecharge, -sourceWallet.TotalConsume); err != nil {
+		return fmt.Errorf("failed to update wallet totals: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+
+	"gorm.io/gorm"
+)
+
+// 操作类型
+const (
+	ActionUserSetStatus        = "user.set_status"
+	ActionUserMerge            = "user.merge"
+	ActionUserRoleAssign       = "user_role.assign"
+	ActionUserRoleRevoke       = "user_role.revoke"
+	ActionKolReview            = "kol.review"
+	ActionRechargeConfirm      = "recharge_order.confirm"
+	ActionRechargeReject       = "recharge_order.reject"
+	ActionChainDepositResolve  = "chain_deposit.resolve"
+	ActionWithdrawalConfirm    = "withdrawal_order.confirm"
+	ActionWithdrawalReject     = "withdrawal_order.reject"
+	ActionKolOrderRefund       = "kol_order.refund"
+	ActionKolReviewModerate    = "kol_review.moderate"
+	ActionCampaignConsume      = "campaign.consume"
+	ActionCampaignUpdateStatus = "campaign.update_status"
+	ActionPaymentSettingCreate = "payment_setting.create"
+	ActionPaymentSettingUpdate = "payment_setting.update"
+	ActionPaymentSettingDelete = "payment_setting.delete"
+	ActionDictionaryCreate     = "dictionary.create"
+	ActionDictionaryUpdate     = "dictionary.update"
+	ActionDictionaryDelete     = "dictionary.delete"
+	ActionDictionaryItemCreate = "dictionary_item.create"
+	ActionDictionaryItemUpdate = "dictionary_item.update"
+	ActionDictionaryItemDelete = "dictionary_item.delete"
+	ActionExcellentCaseCreate  = "excellent_case.create"
+	ActionExcellentCaseUpdate  = "excellent_case.update"
+	ActionExcellentCaseDelete  = "excellent_case.delete"
+	ActionContentTrendCreate   = "content_trend.create"
+	ActionContentTrendUpdate   = "content_trend.update"
+	ActionContentTrendDelete   = "content_trend.delete"
+	ActionPlatformStatsUpdate  = "platform_stats.update"
+)
+
+// 目标实体类型
+const (
+	TargetUser           = "user"
+	TargetKol            = "kol"
+	TargetRechargeOrder  = "recharge_order"
+	TargetChainDeposit   = "chain_deposit"
+	TargetWithdrawal     = "withdrawal_order"
+	TargetKolOrder       = "kol_order"
+	TargetKolReview      = "kol_review"
+	TargetCampaign       = "campaign"
+	TargetPaymentSetting = "payment_setting"
+	TargetDictionary     = "dictionary"
+	TargetDictionaryItem = "dictionary_item"
+	TargetExcellentCase  = "excellent_case"
+	TargetContentTrend   = "content_trend"
+	TargetPlatformStats  = "platform_stats"
+)
+
+// MaxExportRows 单次导出的最大行数
+const MaxExportRows = 10000
+
+// Actor 操作人信息，由 RequirePermission 中间件写入请求 ctx
+type Actor struct {
+	UserID    int64
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+type actorContextKey struct{}
+
+// WithActor 将操作人信息写入 ctx
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext 从 ctx 中获取操作人信息
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	if ctx == nil {
+		return Actor{}, false
+	}
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
+
+// Entry 审计日志条目
+// Before/After 为操作前后的快照，会序列化为 JSON；新建时 Before 为 nil，删除时 After 为 nil
+type Entry struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     interface{}
+	After      interface{}
+}
+
+// AuditService 审计服务接口
+// 审计日志只追加不修改，后台特权操作都必须通过 Record 留痕
+type AuditService interface {
+	// Record 写入审计日志，操作人取自 ctx；传入事务时与业务修改一起提交
+	Record(ctx context.Context, tx *gorm.DB, entry *Entry) error
+	// GetAuditLogs 分页查询审计日志
+	GetAuditLogs(filter *mysql.AuditLogFilter, page, pageSize int) ([]*model.OrbiaAdminAuditLog, int64, error)
+	// ExportAuditLogsCSV 按条件导出审计日志为 CSV，最多 MaxExportRows 行
+	ExportAuditLogsCSV(filter *mysql.AuditLogFilter) ([]byte, error)
+}
+
+// auditService 审计服务实现
+type auditService struct {
+	auditLogRepo mysql.AuditLogRepository
+}
+
+// NewAuditService 创建审计服务实例
+func NewAuditService(auditLogRepo mysql.AuditLogRepository) AuditService {
+	return &auditService{auditLogRepo: auditLogRepo}
+}
+
+// Record 写入审计日志
+func (s *auditService) Record(ctx context.Context, tx *gorm.DB, entry *Entry) error {
+	if entry.Action == "" || entry.TargetType == "" || entry.TargetID == "" {
+		return errors.New("audit action and target are required")
+	}
+
+	before, err := snapshot(entry.Before)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit snapshot: %v", err)
+	}
+	after, err := snapshot(entry.After)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit snapshot: %v", err)
+	}
+
+	// 没有操作人信息时（如系统任务）记录为 0
+	actor, _ := ActorFromContext(ctx)
+	log := &model.OrbiaAdminAuditLog{
+		ActorID:    actor.UserID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		BeforeData: before,
+		AfterData:  after,
+		IP:         truncate(actor.IP, 64),
+		UserAgent:  truncate(actor.UserAgent, 255),
+		RequestID:  truncate(actor.RequestID, 64),
+	}
+	if err := s.auditLogRepo.CreateAuditLog(tx, log); err != nil {
+		return fmt.Errorf("failed to create audit log: %v", err)
+	}
+	return nil
+}
+
+// GetAuditLogs 分页查询审计日志
+func (s *auditService) GetAuditLogs(filter *mysql.AuditLogFilter, page, pageSize int) ([]*model.OrbiaAdminAuditLog, int64, error) {
+	return s.auditLogRepo.GetAuditLogs(filter, page, pageSize)
+}
+
+// ExportAuditLogsCSV 导出审计日志为 CSV
+func (s *auditService) ExportAuditLogsCSV(filter *mysql.AuditLogFilter) ([]byte, error) {
+	logs, err := s.auditLogRepo.GetAuditLogsForExport(filter, MaxExportRows)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := []string{"id", "created_at", "actor_id", "action", "target_type", "target_id", "before", "after", "ip", "user_agent", "request_id"}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, log := range logs {
+		createdAt := ""
+		if log.CreatedAt != nil {
+			createdAt = log.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		record := []string{
+			strconv.FormatInt(log.ID, 10),
+			createdAt,
+			strconv.FormatInt(log.ActorID, 10),
+			log.Action,
+			log.TargetType,
+			log.TargetID,
+			stringValue(log.BeforeData),
+			stringValue(log.AfterData),
+			log.IP,
+			log.UserAgent,
+			log.RequestID,
+		}
+		for i := range record {
+			record[i] = escapeCSVCell(record[i])
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// snapshot 将快照序列化为 JSON，nil 返回 nil
+func snapshot(v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	s := string(data)
+	return &s, nil
+}
+
+// escapeCSVCell 防止 CSV 注入：以公式字符开头的单元格前加单引号，避免在表格软件中被当作公式执行
+func escapeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// truncate 截断超出列长度的字符串
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
+
+// stringValue 获取字符串指针的值
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils"
+
+	"gorm.io/gorm"
+)
+
+// 登录方式
+const (
+	LoginMethodEmail  = "email"
+	LoginMethodWallet = "wallet"
+)
+
+// LinkEmail 为当前账号绑定邮箱
+// 邮箱通过 code_type=link 的验证码证明所有权，已被其他账号使用的邮箱不能绑定
+func (s *authService) LinkEmail(userID int64, email, code string) (*mysql.User, error) {
+	if !utils.ValidateEmail(email) {
+		return nil, errors.New("invalid email format")
+	}
+	if code == "" {
+		return nil, errors.New("verification code is required")
+	}
+
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+	if user.Email != nil {
+		return nil, errors.New("account already has an email, please unlink it first")
+	}
+	if err := s.checkLoginMethodAvailable(LoginMethodEmail, email); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyEmailCode(email, code, "link"); err != nil {
+		return nil, err
+	}
+
+	linked, err := s.userRepo.LinkEmail(userID, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link email: %v", err)
+	}
+	if !linked {
+		return nil, errors.New("account already has an email, please unlink it first")
+	}
+
+	return s.userRepo.GetUserByID(userID)
+}
+
+// LinkWallet 为当前账号绑定钱包
+// 与钱包登录相同：message 必须是 CreateWalletChallenge 下发的消息，验签通过后消耗随机数
+func (s *authService) LinkWallet(userID int64, walletAddress, signature, message string) (*mysql.User, error) {
+	if !utils.ValidateWalletAddress(walletAddress) {
+		return nil, errors.New("invalid wallet address format")
+	}
+
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+	if user.WalletAddress != nil {
+		return nil, errors.New("account already has a wallet, please unlink it first")
+	}
+	if err := s.checkLoginMethodAvailable(LoginMethodWallet, walletAddress); err != nil {
+		return nil, err
+	}
+
+	nonce, err := s.checkWalletChallenge(walletAddress, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.VerifySignature(walletAddress, message, signature); err != nil {
+		return nil, fmt.Errorf("signature verification failed: %v", err)
+	}
+	consumed, err := s.nonceRepo.ConsumeNonce(nonce.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume nonce: %v", err)
+	}
+	if !consumed {
+		return nil, errors.New("nonce has already been used or expired")
+	}
+
+	linked, err := s.userRepo.LinkWalletAddress(userID, walletAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link wallet: %v", err)
+	}
+	if !linked {
+		return nil, errors.New("account already has a wallet, please unlink it first")
+	}
+
+	return s.userRepo.GetUserByID(userID)
+}
+
+// UnlinkLoginMethod 解绑登录方式（email 或 wallet），账号至少保留一种登录方式
+func (s *authService) UnlinkLoginMethod(userID int64, method string) (*mysql.User, error) {
+	var unlinked bool
+	var err error
+	switch method {
+	case LoginMethodEmail:
+		unlinked, err = s.userRepo.UnlinkEmail(userID)
+	case LoginMethodWallet:
+		unlinked, err = s.userRepo.UnlinkWalletAddress(userID)
+	default:
+		return nil, errors.New("invalid login method, must be email or wallet")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unlink %s: %v", method, err)
+	}
+	if !unlinked {
+		return nil, fmt.Errorf("cannot unlink %s: it is not linked or it is the only login method", method)
+	}
+
+	return s.userRepo.GetUserByID(userID)
+}
+
+// checkLoginMethodAvailable 检查邮箱或钱包是否已被其他账号使用，已被使用时需要联系管理员合并账号
+func (s *authService) checkLoginMethodAvailable(method, value string) error {
+	var err error
+	if method == LoginMethodEmail {
+		_, err = s.userRepo.GetUserByEmail(value)
+	} else {
+		_, err = s.userRepo.GetUserByWalletAddress(value)
+	}
+
+	if err == nil {
+		return fmt.Errorf("%s is already linked to another account, please contact support to merge the accounts", method)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to query user: %v", err)
+	}
+	return nil
+}
@@ -3,57 +3,92 @@ package auth
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"orbia_api/biz/consts"
 	"orbia_api/biz/dal/mysql"
 	"orbia_api/biz/infra/config"
+	"orbia_api/biz/service/twofactor"
 	walletService "orbia_api/biz/service/wallet"
 	"orbia_api/biz/utils"
 
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"gorm.io/gorm"
 )
 
+// defaultMaxVerificationAttempts 未配置时验证码允许的最大错误次数
+const defaultMaxVerificationAttempts = 5
+
 // AuthService 认证服务接口
 type AuthService interface {
-	WalletLogin(walletAddress, signature, message string) (string, int64, error)
+	CreateWalletChallenge(walletAddress string, chainID int64) (*WalletChallenge, error)
+	WalletLogin(walletAddress, signature, message string, client *ClientInfo) (*LoginResult, error)
 	SendVerificationCode(email, codeType string) error
-	EmailLogin(email, code string) (string, int64, error)
+	EmailLogin(email, code string, client *ClientInfo) (*LoginResult, error)
+	VerifyTwoFactorLogin(challengeToken, code string, client *ClientInfo) (*TokenPair, error)
+	RefreshToken(refreshToken string) (*TokenPair, error)
+	Logout(sessionID string) error
+	LogoutAll(userID int64) error
+	LinkEmail(userID int64, email, code string) (*mysql.User, error)
+	LinkWallet(userID int64, walletAddress, signature, message string) (*mysql.User, error)
+	UnlinkLoginMethod(userID int64, method string) (*mysql.User, error)
+	CleanExpiredWalletNonces() error
 }
 
 // authService 认证服务实现
 type authService struct {
+	db               *gorm.DB
 	userRepo         mysql.UserRepository
 	teamRepo         mysql.TeamRepository
 	walletSvc        walletService.WalletService
 	verificationRepo mysql.VerificationCodeRepository
+	nonceRepo        mysql.WalletNonceRepository
+	sessionRepo      mysql.AuthSessionRepository
+	twoFactorSvc     twofactor.TwoFactorService
 }
 
 // NewAuthService 创建认证服务实例
-func NewAuthService(userRepo mysql.UserRepository, teamRepo mysql.TeamRepository, walletSvc walletService.WalletService, verificationRepo mysql.VerificationCodeRepository) AuthService {
+func NewAuthService(db *gorm.DB, userRepo mysql.UserRepository, teamRepo mysql.TeamRepository, walletSvc walletService.WalletService, verificationRepo mysql.VerificationCodeRepository, nonceRepo mysql.WalletNonceRepository, sessionRepo mysql.AuthSessionRepository, twoFactorSvc twofactor.TwoFactorService) AuthService {
 	return &authService{
+		db:               db,
 		userRepo:         userRepo,
 		teamRepo:         teamRepo,
 		walletSvc:        walletSvc,
 		verificationRepo: verificationRepo,
+		nonceRepo:        nonceRepo,
+		sessionRepo:      sessionRepo,
+		twoFactorSvc:     twoFactorSvc,
 	}
 }
 
 // WalletLogin 钱包登录
-func (s *authService) WalletLogin(walletAddress, signature, message string) (string, int64, error) {
+// message 必须是 CreateWalletChallenge 下发的 EIP-4361 消息，校验随机数、域名和有效期后再验签，
+// 验签通过后消耗随机数，同一签名不能重复登录
+func (s *authService) WalletLogin(walletAddress, signature, message string, client *ClientInfo) (*LoginResult, error) {
 	// 验证钱包地址格式
 	if !utils.ValidateWalletAddress(walletAddress) {
-		return "", 0, errors.New("invalid wallet address format")
+		return nil, errors.New("invalid wallet address format")
 	}
 
-	// 如果没有提供消息，生成默认消息
-	if message == "" {
-		message = utils.GenerateSignMessage(walletAddress, time.Now().Unix())
+	// 校验登录消息和随机数
+	nonce, err := s.checkWalletChallenge(walletAddress, message)
+	if err != nil {
+		return nil, err
 	}
 
 	// 验证签名
 	if err := utils.VerifySignature(walletAddress, message, signature); err != nil {
-		return "", 0, fmt.Errorf("signature verification failed: %v", err)
+		return nil, fmt.Errorf("signature verification failed: %v", err)
+	}
+
+	// 消耗随机数
+	consumed, err := s.nonceRepo.ConsumeNonce(nonce.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume nonce: %v", err)
+	}
+	if !consumed {
+		return nil, errors.New("nonce has already been used or expired")
 	}
 
 	// 查找用户
@@ -70,20 +105,20 @@ func (s *authService) WalletLogin(walletAddress, signature, message string) (str
 			}
 
 			if err := s.userRepo.CreateUser(user); err != nil {
-				return "", 0, fmt.Errorf("failed to create user: %v", err)
+				return nil, fmt.Errorf("failed to create user: %v", err)
 			}
 
 			// 为新用户初始化账户
 			if err := s.initializeNewUser(user.ID); err != nil {
-				return "", 0, err
+				return nil, err
 			}
 		} else {
-			return "", 0, fmt.Errorf("failed to query user: %v", err)
+			return nil, fmt.Errorf("failed to query user: %v", err)
 		}
 	}
 
-	// 生成JWT token并返回
-	return s.generateTokenForUser(user.ID)
+	// 生成访问令牌和刷新令牌并返回（已启用两步验证时返回两步验证挑战）
+	return s.completeLogin(user.ID, client)
 }
 
 // initializeNewUser 为新用户初始化账户（创建默认团队和钱包）
@@ -142,13 +177,59 @@ func (s *authService) createDefaultTeam(userID int64) error {
 	return nil
 }
 
-// generateTokenForUser 为用户生成JWT token
-func (s *authService) generateTokenForUser(userID int64) (string, int64, error) {
-	token, expiresIn, err := utils.GenerateToken(userID)
+// maxVerificationAttempts 验证码允许的最大错误次数
+func maxVerificationAttempts() int {
+	if n := config.GlobalConfig.VerificationCode.MaxAttempts; n > 0 {
+		return n
+	}
+	return defaultMaxVerificationAttempts
+}
+
+// isTestModeLogin 是否为测试模式登录
+// 仅当显式设置 ORBIA_ENV=dev、配置开启测试模式且邮箱在白名单中时，固定测试验证码才有效，每次使用都记录日志
+// ORBIA_ENV 未设置时即使加载了 dev 配置也不生效
+func isTestModeLogin(email, code string) bool {
+	cfg := config.GlobalConfig
+	if cfg == nil || !config.IsExplicitDevEnv() {
+		return false
+	}
+	testMode := cfg.VerificationCode.TestMode
+	if cfg.Env != config.EnvDev || !testMode.Enabled || testMode.Code == "" || code != testMode.Code {
+		return false
+	}
+
+	for _, allowed := range testMode.Emails {
+		if strings.EqualFold(strings.TrimSpace(allowed), email) {
+			hlog.Warnf("Test mode verification code used for %s", email)
+			return true
+		}
+	}
+	return false
+}
+
+// verifyEmailCode 校验邮箱验证码并标记为已使用
+func (s *authService) verifyEmailCode(email, code, codeType string) error {
+	verificationCode, err := s.verificationRepo.GetValidVerificationCode(email, code, codeType)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 记录错误次数，错误过多时验证码失效，防止暴力猜测
+			if err := s.verificationRepo.RecordFailedAttempt(email, codeType, maxVerificationAttempts()); err != nil {
+				hlog.Errorf("Failed to record verification attempt for %s: %v", email, err)
+			}
+			return errors.New("invalid or expired verification code")
+		}
+		return fmt.Errorf("failed to verify code: %v", err)
+	}
+
+	// 标记验证码为已使用，并发请求使用同一验证码时只有一个能成功
+	consumed, err := s.verificationRepo.ConsumeCode(verificationCode.ID)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed to generate token: %v", err)
+		return fmt.Errorf("failed to mark code as used: %v", err)
 	}
-	return token, expiresIn, nil
+	if !consumed {
+		return errors.New("invalid or expired verification code")
+	}
+	return nil
 }
 
 // SendVerificationCode 发送验证码
@@ -164,7 +245,7 @@ func (s *authService) SendVerificationCode(email, codeType string) error {
 	}
 
 	// 验证codeType合法性
-	if codeType != "login" && codeType != "register" && codeType != "reset_password" {
+	if codeType != "login" && codeType != "register" && codeType != "reset_password" && codeType != "link" {
 		return errors.New("invalid code type")
 	}
 
@@ -175,6 +256,11 @@ func (s *authService) SendVerificationCode(email, codeType string) error {
 	// 计算过期时间
 	expiresAt := time.Now().Add(time.Duration(cfg.ExpireMinutes) * time.Minute)
 
+	// 之前发送的验证码失效，同一时间只有最新的验证码有效
+	if err := s.verificationRepo.ExpireUnusedCodes(email, codeType); err != nil {
+		return fmt.Errorf("failed to expire previous verification codes: %v", err)
+	}
+
 	// 保存验证码到数据库
 	verificationCode := &mysql.VerificationCode{
 		Email:     email,
@@ -197,31 +283,21 @@ func (s *authService) SendVerificationCode(email, codeType string) error {
 }
 
 // EmailLogin 邮箱验证码登录
-func (s *authService) EmailLogin(email, code string) (string, int64, error) {
+func (s *authService) EmailLogin(email, code string, client *ClientInfo) (*LoginResult, error) {
 	// 验证邮箱格式
 	if !utils.ValidateEmail(email) {
-		return "", 0, errors.New("invalid email format")
+		return nil, errors.New("invalid email format")
 	}
 
 	// 验证码为空检查
 	if code == "" {
-		return "", 0, errors.New("verification code is required")
+		return nil, errors.New("verification code is required")
 	}
 
-	// Debug模式：如果验证码是888888，直接通过验证
-	if code != "888888" {
-		// 验证验证码
-		verificationCode, err := s.verificationRepo.GetValidVerificationCode(email, code, "login")
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return "", 0, errors.New("invalid or expired verification code")
-			}
-			return "", 0, fmt.Errorf("failed to verify code: %v", err)
-		}
-
-		// 标记验证码为已使用
-		if err := s.verificationRepo.MarkAsUsed(verificationCode.ID); err != nil {
-			return "", 0, fmt.Errorf("failed to mark code as used: %v", err)
+	// 测试模式：仅开发环境下白名单邮箱可使用固定验证码
+	if !isTestModeLogin(email, code) {
+		if err := s.verifyEmailCode(email, code, "login"); err != nil {
+			return nil, err
 		}
 	}
 
@@ -239,18 +315,18 @@ func (s *authService) EmailLogin(email, code string) (string, int64, error) {
 			}
 
 			if err := s.userRepo.CreateUser(user); err != nil {
-				return "", 0, fmt.Errorf("failed to create user: %v", err)
+				return nil, fmt.Errorf("failed to create user: %v", err)
 			}
 
 			// 为新用户初始化账户
 			if err := s.initializeNewUser(user.ID); err != nil {
-				return "", 0, err
+				return nil, err
 			}
 		} else {
-			return "", 0, fmt.Errorf("failed to query user: %v", err)
+			return nil, fmt.Errorf("failed to query user: %v", err)
 		}
 	}
 
-	// 生成JWT token并返回
-	return s.generateTokenForUser(user.ID)
+	// 生成访问令牌和刷新令牌并返回（已启用两步验证时返回两步验证挑战）
+	return s.completeLogin(user.ID, client)
 }
@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+)
+
+const (
+	testModeEmail = "test@orbia.dev"
+	testModeCode  = "888888"
+)
+
+// loadConfig 从仓库根目录加载 ORBIA_ENV 对应的配置文件，env 为空表示未设置 ORBIA_ENV
+func loadConfig(t *testing.T, env string) *config.Config {
+	t.Helper()
+	t.Chdir("../../..")
+	if env == "" {
+		t.Setenv("ORBIA_ENV", "")
+		os.Unsetenv("ORBIA_ENV")
+	} else {
+		t.Setenv("ORBIA_ENV", env)
+	}
+
+	if env == config.EnvProd {
+		t.Setenv("TOTP_ENCRYPTION_KEY", "test_totp_encryption_key")
+	}
+
+	previous := config.GlobalConfig
+	t.Cleanup(func() { config.GlobalConfig = previous })
+
+	if err := config.LoadConfig(); err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	return config.GlobalConfig
+}
+
+// enableTestMode 强制开启测试模式，模拟配置文件被误改的情况
+func enableTestMode(cfg *config.Config) {
+	cfg.VerificationCode.TestMode = config.TestModeConfig{
+		Enabled: true,
+		Code:    testModeCode,
+		Emails:  []string{testModeEmail},
+	}
+}
+
+func TestIsTestModeLoginDev(t *testing.T) {
+	cfg := loadConfig(t, config.EnvDev)
+	enableTestMode(cfg)
+
+	if !isTestModeLogin(testModeEmail, testModeCode) {
+		t.Fatal("test mode login should be allowed with ORBIA_ENV=dev")
+	}
+	if isTestModeLogin("other@orbia.dev", testModeCode) {
+		t.Fatal("test mode login should be rejected for emails outside the allowlist")
+	}
+	if isTestModeLogin(testModeEmail, "123456") {
+		t.Fatal("test mode login should be rejected for a wrong code")
+	}
+}
+
+func TestIsTestModeLoginEnvUnset(t *testing.T) {
+	// 未设置 ORBIA_ENV 时默认加载 dev 配置（其中开启了测试模式），但测试模式不能生效
+	cfg := loadConfig(t, "")
+	if cfg.Env != config.EnvDev || !cfg.VerificationCode.TestMode.Enabled {
+		t.Fatalf("expected the default dev config with test mode enabled, got env %q", cfg.Env)
+	}
+
+	if isTestModeLogin(testModeEmail, cfg.VerificationCode.TestMode.Code) {
+		t.Fatal("test mode login must not be allowed when ORBIA_ENV is unset")
+	}
+}
+
+func TestIsTestModeLoginProd(t *testing.T) {
+	cfg := loadConfig(t, config.EnvProd)
+	if isTestModeLogin(testModeEmail, testModeCode) {
+		t.Fatal("test mode login must not be allowed with the prod config")
+	}
+
+	// 即使生产配置误开启了测试模式也不生效
+	enableTestMode(cfg)
+	if isTestModeLogin(testModeEmail, testModeCode) {
+		t.Fatal("test mode login must not be allowed under ORBIA_ENV=prod even if enabled in config")
+	}
+}
+
+func TestIsTestModeLoginOtherEnv(t *testing.T) {
+	// 配置内容来自 dev，但 ORBIA_ENV 不是 dev（如 staging 复用 dev 配置）
+	cfg := loadConfig(t, config.EnvDev)
+	enableTestMode(cfg)
+
+	for _, env := range []string{"", "prod", "DEV", " dev", "staging"} {
+		t.Setenv("ORBIA_ENV", env)
+		if isTestModeLogin(testModeEmail, testModeCode) {
+			t.Fatalf("test mode login must not be allowed with ORBIA_ENV=%q", env)
+		}
+	}
+}
+
+func TestIsTestModeLoginNoConfig(t *testing.T) {
+	previous := config.GlobalConfig
+	t.Cleanup(func() { config.GlobalConfig = previous })
+	t.Setenv("ORBIA_ENV", config.EnvDev)
+
+	config.GlobalConfig = nil
+	if isTestModeLogin(testModeEmail, testModeCode) {
+		t.Fatal("test mode login must not be allowed without a loaded config")
+	}
+}
+
+// racingVerificationRepo 模拟两个请求同时查到同一条有效验证码，只有第一次使用能成功
+type racingVerificationRepo struct {
+	mysql.VerificationCodeRepository
+
+	mu   sync.Mutex
+	used map[int64]bool
+}
+
+func (r *racingVerificationRepo) GetValidVerificationCode(email, code, codeType string) (*mysql.VerificationCode, error) {
+	return &mysql.VerificationCode{ID: 1, Email: email, Code: code, CodeType: codeType, Status: "unused"}, nil
+}
+
+func (r *racingVerificationRepo) ConsumeCode(id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.used[id] {
+		return false, nil
+	}
+	r.used[id] = true
+	return true, nil
+}
+
+func TestVerifyEmailCodeConsumedOnce(t *testing.T) {
+	svc := &authService{verificationRepo: &racingVerificationRepo{used: make(map[int64]bool)}}
+
+	if err := svc.verifyEmailCode(testModeEmail, "123456", "login"); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if err := svc.verifyEmailCode(testModeEmail, "123456", "login"); err == nil {
+		t.Fatal("a verification code must not be accepted twice")
+	}
+}
@@ -24,13 +24,13 @@ func NewAuthRPC(userRepo mysql.UserRepository) *AuthRPC {
 // ValidateUserToken 验证用户token并返回用户信息
 func (r *AuthRPC) ValidateUserToken(token string) (*mysql.User, error) {
 	// 验证token
-	userID, err := utils.ValidateToken(token)
+	claims, err := utils.ValidateToken(token)
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %v", err)
 	}
 
 	// 获取用户信息
-	user, err := r.userRepo.GetUserByID(userID)
+	user, err := r.userRepo.GetUserByID(claims.UserID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("user not found")
@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/utils"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultNonceExpireMinutes 未配置时登录挑战的有效期（分钟）
+	defaultNonceExpireMinutes = 10
+	// siweClockSkew 允许的客户端与服务端时钟偏差
+	siweClockSkew = time.Minute
+)
+
+// WalletChallenge 钱包登录挑战
+type WalletChallenge struct {
+	Message   string    // 待签名的 EIP-4361 消息
+	Nonce     string    // 一次性随机数
+	ExpiresAt time.Time // 过期时间
+}
+
+// CreateWalletChallenge 创建钱包登录挑战
+// 下发的随机数保存在服务端，只能在有效期内使用一次；chainID 为 0 时使用默认链
+func (s *authService) CreateWalletChallenge(walletAddress string, chainID int64) (*WalletChallenge, error) {
+	// 验证钱包地址格式
+	if !utils.ValidateWalletAddress(walletAddress) {
+		return nil, errors.New("invalid wallet address format")
+	}
+
+	cfg := config.GlobalConfig.SIWE
+	if cfg.Domain == "" || cfg.URI == "" {
+		return nil, errors.New("wallet login is not configured")
+	}
+
+	if chainID == 0 && len(cfg.ChainIDs) > 0 {
+		chainID = cfg.ChainIDs[0]
+	}
+	if !isAllowedChainID(chainID) {
+		return nil, fmt.Errorf("unsupported chain ID: %d", chainID)
+	}
+
+	nonce, err := utils.GenerateSIWENonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %v", err)
+	}
+
+	expireMinutes := cfg.NonceExpireMinutes
+	if expireMinutes <= 0 {
+		expireMinutes = defaultNonceExpireMinutes
+	}
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	expiresAt := issuedAt.Add(time.Duration(expireMinutes) * time.Minute)
+
+	msg := &utils.SIWEMessage{
+		Domain:         cfg.Domain,
+		Address:        common.HexToAddress(walletAddress).Hex(),
+		Statement:      cfg.Statement,
+		URI:            cfg.URI,
+		Version:        "1",
+		ChainID:        chainID,
+		Nonce:          nonce,
+		IssuedAt:       issuedAt,
+		ExpirationTime: &expiresAt,
+	}
+	message := msg.String()
+
+	// 保存随机数，登录时校验
+	walletNonce := &mysql.WalletNonce{
+		Nonce:         nonce,
+		WalletAddress: msg.Address,
+		ChainID:       chainID,
+		Message:       message,
+		Status:        "unused",
+		ExpiresAt:     expiresAt,
+	}
+	if err := s.nonceRepo.CreateNonce(walletNonce); err != nil {
+		return nil, fmt.Errorf("failed to save nonce: %v", err)
+	}
+
+	return &WalletChallenge{
+		Message:   message,
+		Nonce:     nonce,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// checkWalletChallenge 校验登录消息：格式、钱包地址、域名、链ID、有效期以及随机数
+// 随机数必须由服务端下发给该钱包、未使用且未过期，且消息与下发时完全一致
+func (s *authService) checkWalletChallenge(walletAddress, message string) (*mysql.WalletNonce, error) {
+	if message == "" {
+		return nil, errors.New("sign-in message is required, please request a wallet challenge first")
+	}
+
+	msg, err := utils.ParseSIWEMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sign-in message: %v", err)
+	}
+
+	cfg := config.GlobalConfig.SIWE
+	if !common.IsHexAddress(walletAddress) || msg.Address != common.HexToAddress(walletAddress).Hex() {
+		return nil, errors.New("sign-in message address does not match wallet address")
+	}
+	if msg.Domain != cfg.Domain {
+		return nil, fmt.Errorf("sign-in message domain mismatch: %s", msg.Domain)
+	}
+	if msg.URI != cfg.URI {
+		return nil, fmt.Errorf("sign-in message URI mismatch: %s", msg.URI)
+	}
+	if !isAllowedChainID(msg.ChainID) {
+		return nil, fmt.Errorf("unsupported chain ID: %d", msg.ChainID)
+	}
+
+	now := time.Now()
+	if msg.ExpirationTime == nil || !now.Before(*msg.ExpirationTime) {
+		return nil, errors.New("sign-in message has expired")
+	}
+	if msg.IssuedAt.After(now.Add(siweClockSkew)) {
+		return nil, errors.New("sign-in message issued in the future")
+	}
+
+	nonce, err := s.nonceRepo.GetNonce(msg.Nonce)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("invalid nonce")
+		}
+		return nil, fmt.Errorf("failed to get nonce: %v", err)
+	}
+	if nonce.Status != "unused" {
+		return nil, errors.New("nonce has already been used or expired")
+	}
+	if !now.Before(nonce.ExpiresAt) {
+		return nil, errors.New("nonce has expired")
+	}
+	if nonce.WalletAddress != msg.Address || nonce.Message != message {
+		return nil, errors.New("sign-in message does not match the issued challenge")
+	}
+
+	return nonce, nil
+}
+
+// isAllowedChainID 链ID是否在配置的允许列表中
+func isAllowedChainID(chainID int64) bool {
+	for _, id := range config.GlobalConfig.SIWE.ChainIDs {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// CleanExpiredWalletNonces 将过期未使用的登录挑战标记为已过期
+func (s *authService) CleanExpiredWalletNonces() error {
+	return s.nonceRepo.CleanExpiredNonces()
+}
+
+// StartNonceCleanupJob 启动定时清理过期登录挑战任务，interval <= 0 时不启动
+func StartNonceCleanupJob(svc AuthService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := svc.CleanExpiredWalletNonces(); err != nil {
+				hlog.Errorf("Wallet nonce cleanup failed: %v", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// 会话吊销原因
+const (
+	RevokeReasonLogout     = "logout"
+	RevokeReasonLogoutAll  = "logout_all"
+	RevokeReasonTokenReuse = "token_reuse"
+)
+
+// maxUserAgentLength 会话保存的 User-Agent 最大长度
+const maxUserAgentLength = 500
+
+// ClientInfo 登录客户端信息，用于记录会话设备和识别新设备登录
+type ClientInfo struct {
+	DeviceID  string // 客户端提供的设备标识（X-Device-ID），可为空
+	UserAgent string
+	IP        string
+}
+
+// errRefreshTokenReused 已轮换的刷新令牌被再次使用
+var errRefreshTokenReused = errors.New("refresh token has already been used, all tokens of this session have been revoked")
+
+// TokenPair 登录/刷新后签发的令牌
+type TokenPair struct {
+	AccessToken      string // 访问令牌（短期 JWT）
+	ExpiresIn        int64  // 访问令牌有效期（秒）
+	RefreshToken     string // 刷新令牌
+	RefreshExpiresIn int64  // 刷新令牌有效期（秒）
+	SessionID        string // 登录会话ID
+}
+
+// LoginResult 登录结果
+// 未启用两步验证时直接签发令牌；已启用时 Tokens 为空，需凭 MFAToken 和验证码调用 VerifyTwoFactorLogin 换取令牌
+type LoginResult struct {
+	Tokens       *TokenPair
+	MFARequired  bool   // 是否需要两步验证
+	MFAToken     string // 两步验证挑战令牌
+	MFAExpiresIn int64  // 两步验证挑战有效期（秒）
+}
+
+// completeLogin 登录第一步（邮箱验证码或钱包签名）通过后，签发令牌或下发两步验证挑战
+func (s *authService) completeLogin(userID int64, client *ClientInfo) (*LoginResult, error) {
+	enabled, err := s.twoFactorSvc.IsEnabled(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		challenge, err := s.twoFactorSvc.CreateLoginChallenge(userID)
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{
+			MFARequired:  true,
+			MFAToken:     challenge.Token,
+			MFAExpiresIn: challenge.ExpiresIn,
+		}, nil
+	}
+
+	pair, err := s.generateTokenForUser(userID, false, client)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{Tokens: pair}, nil
+}
+
+// VerifyTwoFactorLogin 登录第二步：校验两步验证挑战和验证码（TOTP 或恢复码）后签发令牌
+func (s *authService) VerifyTwoFactorLogin(challengeToken, code string, client *ClientInfo) (*TokenPair, error) {
+	userID, err := s.twoFactorSvc.VerifyLoginChallenge(challengeToken, code)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+	if user.Status != "normal" {
+		return nil, errors.New("user account is not in normal status")
+	}
+
+	return s.generateTokenForUser(userID, true, client)
+}
+
+// generateTokenForUser 为用户创建登录会话并签发访问令牌和刷新令牌
+// mfaVerified 表示本次登录已通过两步验证，管理员接口只接受通过两步验证的会话
+// 会话记录登录设备、User-Agent 和 IP；用户首次在某设备上登录时发送新设备登录提醒邮件
+func (s *authService) generateTokenForUser(userID int64, mfaVerified bool, client *ClientInfo) (*TokenPair, error) {
+	if client == nil {
+		client = &ClientInfo{}
+	}
+
+	sessionID, err := utils.GenerateSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session ID: %v", err)
+	}
+
+	now := time.Now()
+	deviceHash := utils.HashDeviceID(client.DeviceID, client.UserAgent)
+	deviceName := utils.ParseDeviceName(client.UserAgent)
+	userAgent := client.UserAgent
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	newDevice := s.isNewDevice(userID, deviceHash)
+
+	var pair *TokenPair
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		session := &mysql.AuthSession{
+			SessionID:  sessionID,
+			UserID:     userID,
+			Status:     "active",
+			DeviceHash: &deviceHash,
+			DeviceName: &deviceName,
+			UserAgent:  &userAgent,
+			IP:         &client.IP,
+			LastSeenAt: &now,
+			LastSeenIP: &client.IP,
+		}
+		if mfaVerified {
+			session.MFAVerifiedAt = &now
+		}
+		if err := s.sessionRepo.CreateSession(tx, session); err != nil {
+			return fmt.Errorf("failed to create session: %v", err)
+		}
+
+		var err error
+		pair, err = s.issueTokens(tx, userID, sessionID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if newDevice {
+		go s.notifyNewDeviceLogin(userID, &utils.NewDeviceLogin{
+			DeviceName: deviceName,
+			IP:         client.IP,
+			Time:       now,
+		})
+	}
+
+	return pair, nil
+}
+
+// isNewDevice 用户是否首次在该设备上登录；用户第一次登录（注册）不算新设备
+// 查询失败时不提醒，不影响登录
+func (s *authService) isNewDevice(userID int64, deviceHash string) bool {
+	hasSessions, err := s.sessionRepo.HasUserSessions(userID)
+	if err != nil {
+		hlog.Errorf("Failed to check sessions of user %d: %v", userID, err)
+		return false
+	}
+	if !hasSessions {
+		return false
+	}
+
+	known, err := s.sessionRepo.HasUserDeviceSession(userID, deviceHash)
+	if err != nil {
+		hlog.Errorf("Failed to check device sessions of user %d: %v", userID, err)
+		return false
+	}
+	return !known
+}
+
+// notifyNewDeviceLogin 向用户绑定的邮箱发送新设备登录提醒，未绑定邮箱时跳过
+func (s *authService) notifyNewDeviceLogin(userID int64, login *utils.NewDeviceLogin) {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		hlog.Errorf("Failed to get user %d for new device notification: %v", userID, err)
+		return
+	}
+	if user.Email == nil || *user.Email == "" {
+		return
+	}
+
+	if err := utils.SendNewDeviceLoginEmail(*user.Email, login); err != nil {
+		hlog.Errorf("Failed to send new device login email to user %d: %v", userID, err)
+	}
+}
+
+// RefreshToken 使用刷新令牌换取新的访问令牌和刷新令牌
+// 刷新令牌每次使用后轮换；已轮换的令牌再次出现说明令牌可能泄露，吊销整个会话
+func (s *authService) RefreshToken(refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh token is required")
+	}
+
+	var pair *TokenPair
+	var reusedSessionID string
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		token, err := s.sessionRepo.GetRefreshTokenByHashForUpdate(tx, utils.HashRefreshToken(refreshToken))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("invalid refresh token")
+			}
+			return fmt.Errorf("failed to get refresh token: %v", err)
+		}
+
+		switch token.Status {
+		case "active":
+		case "rotated":
+			reusedSessionID = token.SessionID
+			return errRefreshTokenReused
+		default:
+			return errors.New("refresh token has been revoked")
+		}
+		if !time.Now().Before(token.ExpiresAt) {
+			return errors.New("refresh token has expired")
+		}
+
+		session, err := s.sessionRepo.GetSessionBySessionID(token.SessionID)
+		if err != nil {
+			return fmt.Errorf("failed to get session: %v", err)
+		}
+		if session.Status != "active" {
+			return errors.New("session has been revoked")
+		}
+
+		// 账号被禁用后不再续期
+		user, err := s.userRepo.GetUserByID(token.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %v", err)
+		}
+		if user.Status != "normal" {
+			return errors.New("user account is not in normal status")
+		}
+
+		if err := s.sessionRepo.MarkRefreshTokenRotated(tx, token.ID); err != nil {
+			return fmt.Errorf("failed to rotate refresh token: %v", err)
+		}
+		if err := s.sessionRepo.TouchSession(tx, token.SessionID); err != nil {
+			return fmt.Errorf("failed to update session: %v", err)
+		}
+
+		pair, err = s.issueTokens(tx, token.UserID, token.SessionID)
+		return err
+	})
+
+	// 令牌重用：在事务外吊销整个令牌家族，避免随事务一起回滚
+	if errors.Is(err, errRefreshTokenReused) {
+		hlog.Warnf("Refresh token reuse detected for session %s, revoking session", reusedSessionID)
+		if revokeErr := s.sessionRepo.RevokeSession(nil, reusedSessionID, RevokeReasonTokenReuse); revokeErr != nil {
+			hlog.Errorf("Failed to revoke session %s after refresh token reuse: %v", reusedSessionID, revokeErr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+// Logout 退出当前会话，会话的访问令牌和刷新令牌全部失效
+func (s *authService) Logout(sessionID string) error {
+	if err := s.sessionRepo.RevokeSession(nil, sessionID, RevokeReasonLogout); err != nil {
+		return fmt.Errorf("failed to revoke session: %v", err)
+	}
+	return nil
+}
+
+// LogoutAll 退出用户的所有会话
+func (s *authService) LogoutAll(userID int64) error {
+	if err := s.sessionRepo.RevokeUserSessions(nil, userID, RevokeReasonLogoutAll); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %v", err)
+	}
+	return nil
+}
+
+// issueTokens 为会话签发访问令牌和新的刷新令牌（在事务中执行）
+func (s *authService) issueTokens(tx *gorm.DB, userID int64, sessionID string) (*TokenPair, error) {
+	refreshToken, refreshHash, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %v", err)
+	}
+
+	refreshTTL := utils.RefreshTokenTTL()
+	if err := s.sessionRepo.CreateRefreshToken(tx, &mysql.RefreshToken{
+		TokenHash: refreshHash,
+		SessionID: sessionID,
+		UserID:    userID,
+		Status:    "active",
+		ExpiresAt: time.Now().Add(refreshTTL),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to save refresh token: %v", err)
+	}
+
+	accessToken, expiresIn, err := utils.GenerateToken(userID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %v", err)
+	}
+
+	return &TokenPair{
+		AccessToken:      accessToken,
+		ExpiresIn:        expiresIn,
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: int64(refreshTTL / time.Second),
+		SessionID:        sessionID,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package campaign
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,7 +10,9 @@ import (
 	"time"
 
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/service/audit"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 
 	"gorm.io/gorm"
 )
@@ -25,7 +28,7 @@ type CampaignService interface {
 
 	// 管理员接口
 	AdminListCampaigns(keyword string, status string, promotionObjective string, userID *int64, teamID *int64, page int, pageSize int) ([]*mysql.Campaign, int64, error)
-	AdminUpdateCampaignStatus(campaignID string, status string) error
+	AdminUpdateCampaignStatus(ctx context.Context, campaignID string, status string) error
 }
 
 // CreateCampaignRequest 创建Campaign请求
@@ -54,7 +57,7 @@ type CreateCampaignRequest struct {
 	FrequencyCapTimes  *int32
 	FrequencyCapDays   *int32
 	BudgetType         int8
-	BudgetAmount       float64
+	BudgetAmount       money.Amount
 	Website            *string
 	IOSDownloadURL     *string
 	AndroidDownloadURL *string
@@ -87,7 +90,7 @@ type UpdateCampaignRequest struct {
 	FrequencyCapTimes  *int32
 	FrequencyCapDays   *int32
 	BudgetType         *int8
-	BudgetAmount       *float64
+	BudgetAmount       *money.Amount
 	Website            *string
 	IOSDownloadURL     *string
 	AndroidDownloadURL *string
@@ -96,17 +99,21 @@ type UpdateCampaignRequest struct {
 
 // campaignService Campaign服务实现
 type campaignService struct {
+	db           *gorm.DB
 	campaignRepo mysql.CampaignRepository
 	userRepo     mysql.UserRepository
 	teamRepo     mysql.TeamRepository
+	auditSvc     audit.AuditService
 }
 
 // NewCampaignService 创建Campaign服务实例
-func NewCampaignService(campaignRepo mysql.CampaignRepository, userRepo mysql.UserRepository, teamRepo mysql.TeamRepository) CampaignService {
+func NewCampaignService(db *gorm.DB, campaignRepo mysql.CampaignRepository, userRepo mysql.UserRepository, teamRepo mysql.TeamRepository, auditSvc audit.AuditService) CampaignService {
 	return &campaignService{
+		db:           db,
 		campaignRepo: campaignRepo,
 		userRepo:     userRepo,
 		teamRepo:     teamRepo,
+		auditSvc:     auditSvc,
 	}
 }
 
@@ -508,28 +515,37 @@ func (s *campaignService) AdminListCampaigns(keyword string, status string, prom
 }
 
 // AdminUpdateCampaignStatus 管理员更新Campaign状态
-func (s *campaignService) AdminUpdateCampaignStatus(campaignID string, status string) error {
-	// 获取Campaign
-	campaign, err := s.campaignRepo.GetCampaignByCampaignID(campaignID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("campaign not found")
+func (s *campaignService) AdminUpdateCampaignStatus(ctx context.Context, campaignID string, status string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// 获取Campaign
+		campaign, err := s.campaignRepo.GetCampaignByCampaignIDForUpdate(tx, campaignID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("campaign not found")
+			}
+			return fmt.Errorf("failed to get campaign: %v", err)
 		}
-		return fmt.Errorf("failed to get campaign: %v", err)
-	}
 
-	// 验证状态转换
-	if err := validateStatusTransition(campaign.Status, status, true); err != nil {
-		return err
-	}
+		// 验证状态转换
+		if err := validateStatusTransition(campaign.Status, status, true); err != nil {
+			return err
+		}
 
-	campaign.Status = status
+		oldStatus := campaign.Status
+		campaign.Status = status
 
-	if err := s.campaignRepo.UpdateCampaign(campaign); err != nil {
-		return fmt.Errorf("failed to update campaign status: %v", err)
-	}
+		if err := s.campaignRepo.UpdateCampaignWithTx(tx, campaign); err != nil {
+			return fmt.Errorf("failed to update campaign status: %v", err)
+		}
 
-	return nil
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionCampaignUpdateStatus,
+			TargetType: audit.TargetCampaign,
+			TargetID:   campaignID,
+			Before:     map[string]interface{}{"status": oldStatus},
+			After:      map[string]interface{}{"status": status},
+		})
+	})
 }
 
 // Helper functions
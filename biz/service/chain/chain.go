@@ -0,0 +1,348 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/utils/money"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var (
+	// ErrTxNotFound 交易不存在（尚未上链或哈希错误）
+	ErrTxNotFound = errors.New("transaction not found")
+	// ErrScanNotSupported 该链的实现不支持按地址扫描转账
+	ErrScanNotSupported = errors.New("transfer scanning is not supported for this network")
+)
+
+// Transfer 交易中的一笔代币转账（ERC-20 / TRC-20 Transfer 事件）
+type Transfer struct {
+	Token string   // 代币合约地址（已规范化）
+	From  string   // 转出地址（已规范化）
+	To    string   // 接收地址（已规范化）
+	Value *big.Int // 转账数量（代币最小单位）
+
+	// 以下字段仅在扫描转账时填充
+	TxHash      string // 交易哈希（已规范化）
+	LogIndex    uint   // 转账在交易中的序号
+	BlockNumber uint64 // 所在区块高度（TRON 扫描结果为 0）
+}
+
+// Transaction 链上交易信息
+type Transaction struct {
+	TxHash        string // 交易哈希（已规范化）
+	Success       bool   // 交易是否执行成功
+	BlockNumber   uint64 // 所在区块高度
+	Confirmations uint64 // 当前确认数
+	Transfers     []Transfer
+}
+
+// Verifier 区块链交易查询接口，每种链类型一个实现
+type Verifier interface {
+	// GetTransaction 查询交易及其中的代币转账，交易不存在时返回 ErrTxNotFound
+	GetTransaction(ctx context.Context, txHash string) (*Transaction, error)
+	// NormalizeAddress 校验并规范化地址，用于比较
+	NormalizeAddress(address string) (string, error)
+	// NormalizeTxHash 校验并规范化交易哈希
+	NormalizeTxHash(txHash string) (string, error)
+}
+
+// Scanner 按游标扫描转入指定地址的代币转账，可选实现
+// 游标的含义由实现决定（EVM 为下一个待扫描的区块高度，TRON 为区块时间戳毫秒），
+// cursor 为 0 表示首次扫描，从链上当前位置开始，不回溯历史转账
+type Scanner interface {
+	// ScanTransfers 扫描游标之后已达到确认数要求的转账，返回转账列表和新的游标
+	ScanTransfers(ctx context.Context, tokens []string, recipients []string, cursor uint64, minConfirmations uint64) ([]Transfer, uint64, error)
+}
+
+// Token 接受的稳定币
+type Token struct {
+	Symbol   string
+	Contract string // 已规范化的合约地址
+	Decimals int
+}
+
+// Network 区块链网络：节点、确认数要求和接受的代币
+type Network struct {
+	Name             string
+	MinConfirmations uint64
+	Tokens           map[string]Token // key 为规范化后的合约地址
+	Verifier         Verifier
+}
+
+// VerdictStatus 校验结论
+type VerdictStatus string
+
+const (
+	VerdictConfirmed VerdictStatus = "confirmed" // 校验通过，可自动确认
+	VerdictPending   VerdictStatus = "pending"   // 交易未上链或确认数不足，稍后重试
+	VerdictFlagged   VerdictStatus = "flagged"   // 校验不通过，需要管理员人工处理
+)
+
+// Expectation 充值订单期望的转账
+type Expectation struct {
+	TxHash    string
+	Sender    string       // 用户填写的转出地址（订单的 user_crypto_address）
+	Recipient string       // 收款地址（订单快照）
+	Amount    money.Amount // 订单金额（美元，稳定币按 1:1 计）
+}
+
+// Verdict 校验结果
+type Verdict struct {
+	Status   VerdictStatus
+	Reason   string
+	TxHash   string       // 规范化后的交易哈希
+	Token    *Token       // 收到的代币（校验通过时）
+	Received money.Amount // 收款地址收到的稳定币金额
+}
+
+// Verify 校验交易是否为从用户转出地址向收款地址支付订单金额的稳定币转账
+// 只统计转出地址为订单 user_crypto_address 的转账，防止用户提交他人的交易哈希冒领入账
+// 节点请求失败时返回 error，由调用方稍后重试
+func (n *Network) Verify(ctx context.Context, exp Expectation) (*Verdict, error) {
+	txHash, err := n.Verifier.NormalizeTxHash(exp.TxHash)
+	if err != nil {
+		return &Verdict{Status: VerdictFlagged, Reason: fmt.Sprintf("invalid transaction hash: %v", err)}, nil
+	}
+
+	recipient, err := n.Verifier.NormalizeAddress(exp.Recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment address %s: %v", exp.Recipient, err)
+	}
+
+	sender, err := n.Verifier.NormalizeAddress(exp.Sender)
+	if err != nil {
+		return &Verdict{Status: VerdictFlagged, Reason: fmt.Sprintf("invalid sender address %s: %v", exp.Sender, err), TxHash: txHash}, nil
+	}
+
+	tx, err := n.Verifier.GetTransaction(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, ErrTxNotFound) {
+			return &Verdict{Status: VerdictPending, Reason: "transaction not found on chain yet", TxHash: txHash}, nil
+		}
+		return nil, err
+	}
+
+	verdict := &Verdict{TxHash: tx.TxHash}
+	if !tx.Success {
+		verdict.Status = VerdictFlagged
+		verdict.Reason = "transaction failed on chain"
+		return verdict, nil
+	}
+
+	// 汇总同一笔交易中从用户转出地址转入收款地址的受支持代币
+	var token *Token
+	otherSender := ""
+	received := new(big.Rat)
+	for _, transfer := range tx.Transfers {
+		if transfer.To != recipient {
+			continue
+		}
+		t, ok := n.Tokens[transfer.Token]
+		if !ok {
+			continue
+		}
+		if transfer.From != sender {
+			otherSender = transfer.From
+			continue
+		}
+		if token != nil && token.Contract != t.Contract {
+			verdict.Status = VerdictFlagged
+			verdict.Reason = "transaction pays the recipient with more than one token"
+			return verdict, nil
+		}
+		token = &t
+
+		units := new(big.Rat).SetFrac(transfer.Value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(t.Decimals)), nil))
+		received.Add(received, units)
+	}
+
+	if token == nil {
+		verdict.Status = VerdictFlagged
+		if otherSender != "" {
+			verdict.Reason = fmt.Sprintf("sender mismatch: expected %s, transfer to %s was sent from %s", sender, recipient, otherSender)
+		} else {
+			verdict.Reason = fmt.Sprintf("no supported token transfer to %s found in transaction", recipient)
+		}
+		return verdict, nil
+	}
+
+	verdict.Token = token
+	amount, err := currencyFor(token.Symbol).Round(received)
+	if err != nil {
+		verdict.Status = VerdictFlagged
+		verdict.Reason = fmt.Sprintf("received %s %s is out of the supported amount range", received.FloatString(token.Decimals), token.Symbol)
+		return verdict, nil
+	}
+	verdict.Received = amount
+	if verdict.Received != exp.Amount {
+		verdict.Status = VerdictFlagged
+		verdict.Reason = fmt.Sprintf("amount mismatch: expected %s, received %s %s", exp.Amount, verdict.Received, token.Symbol)
+		return verdict, nil
+	}
+
+	if tx.Confirmations < n.MinConfirmations {
+		verdict.Status = VerdictPending
+		verdict.Reason = fmt.Sprintf("waiting for confirmations: %d/%d", tx.Confirmations, n.MinConfirmations)
+		return verdict, nil
+	}
+
+	verdict.Status = VerdictConfirmed
+	verdict.Reason = fmt.Sprintf("received %s %s with %d confirmations", verdict.Received, token.Symbol, tx.Confirmations)
+	return verdict, nil
+}
+
+// Deposit 扫描到的转入收款地址的稳定币转账
+type Deposit struct {
+	TxHash      string
+	LogIndex    uint
+	BlockNumber uint64
+	Token       Token
+	From        string
+	To          string
+	Amount      money.Amount // 按代币舍入规则换算的美元金额
+}
+
+// ScanDeposits 扫描转入收款地址的受支持代币转账，返回转账列表和新的游标
+func (n *Network) ScanDeposits(ctx context.Context, recipients []string, cursor uint64) ([]Deposit, uint64, error) {
+	scanner, ok := n.Verifier.(Scanner)
+	if !ok {
+		return nil, cursor, ErrScanNotSupported
+	}
+
+	normalized := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		address, err := n.Verifier.NormalizeAddress(recipient)
+		if err != nil {
+			return nil, cursor, fmt.Errorf("invalid payment address %s: %v", recipient, err)
+		}
+		normalized = append(normalized, address)
+	}
+
+	tokens := make([]string, 0, len(n.Tokens))
+	for contract := range n.Tokens {
+		tokens = append(tokens, contract)
+	}
+
+	transfers, next, err := scanner.ScanTransfers(ctx, tokens, normalized, cursor, n.MinConfirmations)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	deposits := make([]Deposit, 0, len(transfers))
+	for _, transfer := range transfers {
+		token, ok := n.Tokens[transfer.Token]
+		if !ok {
+			continue
+		}
+		units := new(big.Rat).SetFrac(transfer.Value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(token.Decimals)), nil))
+		amount, err := currencyFor(token.Symbol).Round(units)
+		if err != nil {
+			// 超出金额范围的转账无法入账，跳过后由管理员在链上核对
+			hlog.Warnf("Skip %s transfer %s#%d: %v", token.Symbol, transfer.TxHash, transfer.LogIndex, err)
+			continue
+		}
+		deposits = append(deposits, Deposit{
+			TxHash:      transfer.TxHash,
+			LogIndex:    transfer.LogIndex,
+			BlockNumber: transfer.BlockNumber,
+			Token:       token,
+			From:        transfer.From,
+			To:          transfer.To,
+			Amount:      amount,
+		})
+	}
+	return deposits, next, nil
+}
+
+// Registry 按收款钱包设置的网络名称查找区块链网络
+type Registry struct {
+	networks []*Network
+}
+
+// NewRegistry 根据配置创建区块链网络注册表
+func NewRegistry(cfg config.ChainConfig) (*Registry, error) {
+	registry := &Registry{}
+	for _, networkCfg := range cfg.Networks {
+		var verifier Verifier
+		switch strings.ToLower(networkCfg.Type) {
+		case "evm":
+			v, err := DialEVMVerifier(networkCfg.RPCURL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create verifier for %s: %v", networkCfg.Name, err)
+			}
+			verifier = v
+		case "tron":
+			verifier = NewTronVerifier(networkCfg.RPCURL, networkCfg.APIKey, nil)
+		default:
+			return nil, fmt.Errorf("unsupported chain type %q for network %s", networkCfg.Type, networkCfg.Name)
+		}
+
+		network, err := NewNetwork(networkCfg.Name, networkCfg.MinConfirmations, networkCfg.Tokens, verifier)
+		if err != nil {
+			return nil, err
+		}
+		registry.Register(network)
+	}
+	return registry, nil
+}
+
+// NewNetwork 创建区块链网络，代币合约地址按链规则规范化
+func NewNetwork(name string, minConfirmations uint64, tokens []config.ChainTokenConfig, verifier Verifier) (*Network, error) {
+	network := &Network{
+		Name:             name,
+		MinConfirmations: minConfirmations,
+		Tokens:           make(map[string]Token, len(tokens)),
+		Verifier:         verifier,
+	}
+	for _, tokenCfg := range tokens {
+		contract, err := verifier.NormalizeAddress(tokenCfg.Contract)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s contract for network %s: %v", tokenCfg.Symbol, name, err)
+		}
+		network.Tokens[contract] = Token{Symbol: tokenCfg.Symbol, Contract: contract, Decimals: tokenCfg.Decimals}
+	}
+	return network, nil
+}
+
+// Register 注册区块链网络
+func (r *Registry) Register(network *Network) {
+	r.networks = append(r.networks, network)
+}
+
+// Lookup 根据收款钱包设置的网络名称（如 "TRC-20 - TRON Network (TRC-20)"）查找区块链网络
+func (r *Registry) Lookup(paymentNetwork string) (*Network, bool) {
+	if r == nil {
+		return nil, false
+	}
+	name := strings.ToUpper(strings.TrimSpace(paymentNetwork))
+	for _, network := range r.networks {
+		if strings.HasPrefix(name, strings.ToUpper(network.Name)) {
+			return network, true
+		}
+	}
+	return nil, false
+}
+
+// Networks 返回已注册的区块链网络
+func (r *Registry) Networks() []*Network {
+	if r == nil {
+		return nil
+	}
+	return r.networks
+}
+
+// currencyFor 根据代币符号获取入账舍入规则
+func currencyFor(symbol string) money.Currency {
+	switch strings.ToUpper(symbol) {
+	case money.USDC.Code:
+		return money.USDC
+	default:
+		return money.USDT
+	}
+}
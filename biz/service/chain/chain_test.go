@@ -0,0 +1,312 @@
+package chain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/utils/money"
+)
+
+// transferTokenCode 测试代币合约的部署代码
+// 运行时代码把 calldata 解析为 (to, value)，以调用者为转出地址发出 ERC-20 Transfer 事件：
+//
+//	PUSH1 0x20 CALLDATALOAD PUSH1 0x00 MSTORE          ; mem[0:32] = value
+//	PUSH1 0x00 CALLDATALOAD CALLER PUSH32 <topic>      ; topics: Transfer, caller, to
+//	PUSH1 0x20 PUSH1 0x00 LOG3 STOP
+func transferTokenCode() []byte {
+	runtime := []byte{0x60, 0x20, 0x35, 0x60, 0x00, 0x52, 0x60, 0x00, 0x35, 0x33, 0x7f}
+	runtime = append(runtime, erc20TransferTopic.Bytes()...)
+	runtime = append(runtime, 0x60, 0x20, 0x60, 0x00, 0xa3, 0x00)
+
+	// 部署代码：CODECOPY 运行时代码到内存并返回
+	initCode := []byte{0x60, byte(len(runtime)), 0x80, 0x60, 0x0b, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3}
+	return append(initCode, runtime...)
+}
+
+// simChain 基于 go-ethereum simulated 后端的测试链
+type simChain struct {
+	t       *testing.T
+	backend *simulated.Backend
+	client  simulated.Client
+	chainID *big.Int
+}
+
+// account 测试账户
+type account struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func newAccount(t *testing.T) account {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return account{key: key, addr: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func newSimChain(t *testing.T, funded ...account) *simChain {
+	t.Helper()
+	alloc := types.GenesisAlloc{}
+	for _, acc := range funded {
+		alloc[acc.addr] = types.Account{Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))}
+	}
+	backend := simulated.NewBackend(alloc)
+	t.Cleanup(func() { backend.Close() })
+
+	client := backend.Client()
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		t.Fatalf("chain id: %v", err)
+	}
+	return &simChain{t: t, backend: backend, client: client, chainID: chainID}
+}
+
+// send 发送交易并出块，返回交易哈希
+func (c *simChain) send(from account, to *common.Address, data []byte) common.Hash {
+	c.t.Helper()
+	ctx := context.Background()
+	nonce, err := c.client.PendingNonceAt(ctx, from.addr)
+	if err != nil {
+		c.t.Fatalf("nonce: %v", err)
+	}
+
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   c.chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(100 * params.GWei),
+		Gas:       200000,
+		To:        to,
+		Data:      data,
+	}), types.LatestSignerForChainID(c.chainID), from.key)
+	if err != nil {
+		c.t.Fatalf("sign tx: %v", err)
+	}
+	if err := c.client.SendTransaction(ctx, tx); err != nil {
+		c.t.Fatalf("send tx: %v", err)
+	}
+	c.backend.Commit()
+	return tx.Hash()
+}
+
+// deployToken 部署测试代币合约
+func (c *simChain) deployToken(deployer account) common.Address {
+	c.t.Helper()
+	nonce, err := c.client.PendingNonceAt(context.Background(), deployer.addr)
+	if err != nil {
+		c.t.Fatalf("nonce: %v", err)
+	}
+	c.send(deployer, nil, transferTokenCode())
+	return crypto.CreateAddress(deployer.addr, nonce)
+}
+
+// transfer 从 from 调用代币合约向 to 转账 value（代币最小单位）
+func (c *simChain) transfer(from account, token, to common.Address, value int64) common.Hash {
+	c.t.Helper()
+	data := append(common.LeftPadBytes(to.Bytes(), 32), common.LeftPadBytes(big.NewInt(value).Bytes(), 32)...)
+	return c.send(from, &token, data)
+}
+
+func mustAmount(t *testing.T, s string) money.Amount {
+	t.Helper()
+	amount, err := money.Parse(s)
+	if err != nil {
+		t.Fatalf("parse amount %s: %v", s, err)
+	}
+	return amount
+}
+
+func TestNetworkVerifyEVM(t *testing.T) {
+	payer := newAccount(t)
+	stranger := newAccount(t)
+	recipient := newAccount(t).addr
+	other := newAccount(t).addr
+
+	sim := newSimChain(t, payer, stranger)
+	usdt := sim.deployToken(payer)
+	unsupported := sim.deployToken(payer)
+
+	network, err := NewNetwork("ERC-20", 1, []config.ChainTokenConfig{
+		{Symbol: "USDT", Contract: usdt.Hex(), Decimals: 6},
+	}, NewEVMVerifier(sim.client))
+	if err != nil {
+		t.Fatalf("new network: %v", err)
+	}
+
+	paid := sim.transfer(payer, usdt, recipient, 10_000_000)
+	toOther := sim.transfer(payer, usdt, other, 10_000_000)
+	wrongToken := sim.transfer(payer, unsupported, recipient, 10_000_000)
+	short := sim.transfer(payer, usdt, recipient, 9_990_000)
+	fromStranger := sim.transfer(stranger, usdt, recipient, 10_000_000)
+
+	tests := []struct {
+		name       string
+		txHash     string
+		sender     string
+		amount     string
+		status     VerdictStatus
+		reasonPart string
+	}{
+		{name: "confirmed", txHash: paid.Hex(), sender: payer.addr.Hex(), amount: "10.00", status: VerdictConfirmed},
+		{name: "wrong recipient", txHash: toOther.Hex(), sender: payer.addr.Hex(), amount: "10.00", status: VerdictFlagged, reasonPart: "no supported token transfer"},
+		{name: "wrong token", txHash: wrongToken.Hex(), sender: payer.addr.Hex(), amount: "10.00", status: VerdictFlagged, reasonPart: "no supported token transfer"},
+		{name: "short amount", txHash: short.Hex(), sender: payer.addr.Hex(), amount: "10.00", status: VerdictFlagged, reasonPart: "amount mismatch"},
+		{name: "wrong sender", txHash: fromStranger.Hex(), sender: payer.addr.Hex(), amount: "10.00", status: VerdictFlagged, reasonPart: "sender mismatch"},
+		// 他人提交同一笔交易哈希冒领：订单的转出地址与交易不符
+		{name: "reused hash by another user", txHash: paid.Hex(), sender: stranger.addr.Hex(), amount: "10.00", status: VerdictFlagged, reasonPart: "sender mismatch"},
+		{name: "invalid sender", txHash: paid.Hex(), sender: "not-an-address", amount: "10.00", status: VerdictFlagged, reasonPart: "invalid sender address"},
+		{name: "not found", txHash: common.HexToHash("0x1234").Hex(), sender: payer.addr.Hex(), amount: "10.00", status: VerdictPending},
+		{name: "invalid hash", txHash: "0x1234", sender: payer.addr.Hex(), amount: "10.00", status: VerdictFlagged, reasonPart: "invalid transaction hash"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := network.Verify(context.Background(), Expectation{
+				TxHash:    tt.txHash,
+				Sender:    tt.sender,
+				Recipient: recipient.Hex(),
+				Amount:    mustAmount(t, tt.amount),
+			})
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if verdict.Status != tt.status {
+				t.Fatalf("status = %s (%s), want %s", verdict.Status, verdict.Reason, tt.status)
+			}
+			if tt.reasonPart != "" && !strings.Contains(verdict.Reason, tt.reasonPart) {
+				t.Fatalf("reason = %q, want it to contain %q", verdict.Reason, tt.reasonPart)
+			}
+		})
+	}
+}
+
+func TestNetworkVerifyEVMConfirmations(t *testing.T) {
+	payer := newAccount(t)
+	recipient := newAccount(t).addr
+
+	sim := newSimChain(t, payer)
+	usdt := sim.deployToken(payer)
+
+	network, err := NewNetwork("ERC-20", 3, []config.ChainTokenConfig{
+		{Symbol: "USDT", Contract: usdt.Hex(), Decimals: 6},
+	}, NewEVMVerifier(sim.client))
+	if err != nil {
+		t.Fatalf("new network: %v", err)
+	}
+
+	hash := sim.transfer(payer, usdt, recipient, 25_500_000)
+	exp := Expectation{TxHash: hash.Hex(), Sender: payer.addr.Hex(), Recipient: recipient.Hex(), Amount: mustAmount(t, "25.50")}
+
+	verdict, err := network.Verify(context.Background(), exp)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verdict.Status != VerdictPending || !strings.Contains(verdict.Reason, "1/3") {
+		t.Fatalf("verdict = %s (%s), want pending with 1/3 confirmations", verdict.Status, verdict.Reason)
+	}
+
+	sim.backend.Commit()
+	sim.backend.Commit()
+
+	verdict, err = network.Verify(context.Background(), exp)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verdict.Status != VerdictConfirmed {
+		t.Fatalf("verdict = %s (%s), want confirmed", verdict.Status, verdict.Reason)
+	}
+}
+
+// 同一笔交易的哈希无论大小写和前缀如何书写，都规范化为同一个值，
+// 入账时按规范化后的哈希登记（orbia_chain_tx_claim），重复提交无法再次入账
+func TestNetworkVerifyEVMReusedHashNormalized(t *testing.T) {
+	payer := newAccount(t)
+	recipient := newAccount(t).addr
+
+	sim := newSimChain(t, payer)
+	usdt := sim.deployToken(payer)
+
+	network, err := NewNetwork("ERC-20", 1, []config.ChainTokenConfig{
+		{Symbol: "USDT", Contract: usdt.Hex(), Decimals: 6},
+	}, NewEVMVerifier(sim.client))
+	if err != nil {
+		t.Fatalf("new network: %v", err)
+	}
+
+	hash := sim.transfer(payer, usdt, recipient, 10_000_000)
+	spellings := []string{
+		hash.Hex(),
+		strings.ToUpper(hash.Hex()[2:]),
+		"0X" + strings.ToUpper(hash.Hex()[2:]),
+		"  " + hash.Hex() + " ",
+	}
+
+	for _, spelling := range spellings {
+		verdict, err := network.Verify(context.Background(), Expectation{
+			TxHash:    spelling,
+			Sender:    strings.ToLower(payer.addr.Hex()),
+			Recipient: strings.ToLower(recipient.Hex()),
+			Amount:    mustAmount(t, "10.00"),
+		})
+		if err != nil {
+			t.Fatalf("verify %q: %v", spelling, err)
+		}
+		if verdict.Status != VerdictConfirmed {
+			t.Fatalf("verify %q: status = %s (%s), want confirmed", spelling, verdict.Status, verdict.Reason)
+		}
+		if verdict.TxHash != hash.Hex() {
+			t.Fatalf("verify %q: tx hash = %s, want %s", spelling, verdict.TxHash, hash.Hex())
+		}
+	}
+}
+
+func TestNetworkScanDepositsEVM(t *testing.T) {
+	payer := newAccount(t)
+	recipient := newAccount(t).addr
+
+	sim := newSimChain(t, payer)
+	usdt := sim.deployToken(payer)
+
+	network, err := NewNetwork("ERC-20", 1, []config.ChainTokenConfig{
+		{Symbol: "USDT", Contract: usdt.Hex(), Decimals: 6},
+	}, NewEVMVerifier(sim.client))
+	if err != nil {
+		t.Fatalf("new network: %v", err)
+	}
+
+	// 首次扫描不回溯历史转账，只返回起始游标
+	deposits, cursor, err := network.ScanDeposits(context.Background(), []string{recipient.Hex()}, 0)
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	if len(deposits) != 0 || cursor == 0 {
+		t.Fatalf("first scan = %d deposits, cursor %d; want none and a start cursor", len(deposits), cursor)
+	}
+
+	hash := sim.transfer(payer, usdt, recipient, 12_340_000)
+	sim.transfer(payer, usdt, newAccount(t).addr, 1_000_000)
+
+	deposits, _, err = network.ScanDeposits(context.Background(), []string{recipient.Hex()}, cursor)
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	if len(deposits) != 1 {
+		t.Fatalf("got %d deposits, want 1", len(deposits))
+	}
+	d := deposits[0]
+	if d.TxHash != hash.Hex() || d.From != payer.addr.Hex() || d.To != recipient.Hex() || d.Amount != mustAmount(t, "12.34") {
+		t.Fatalf("unexpected deposit: %+v", d)
+	}
+}
@@ -0,0 +1,183 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// erc20TransferTopic ERC-20 Transfer(address,address,uint256) 事件签名
+var erc20TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// evmMaxScanBlocks 单次扫描的最大区块数，避免超出节点 eth_getLogs 的范围限制
+const evmMaxScanBlocks = 2000
+
+// EVMBackend EVM 节点接口，ethclient.Client 和 simulated 后端均实现该接口
+type EVMBackend interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// evmVerifier EVM 链（Ethereum、BSC 等）ERC-20 转账查询
+type evmVerifier struct {
+	backend EVMBackend
+}
+
+// NewEVMVerifier 创建 EVM 链交易查询实例
+func NewEVMVerifier(backend EVMBackend) Verifier {
+	return &evmVerifier{backend: backend}
+}
+
+// DialEVMVerifier 连接 JSON-RPC 节点并创建 EVM 链交易查询实例
+func DialEVMVerifier(rpcURL string) (Verifier, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	return NewEVMVerifier(client), nil
+}
+
+// GetTransaction 查询交易回执并解析 ERC-20 Transfer 事件
+func (v *evmVerifier) GetTransaction(ctx context.Context, txHash string) (*Transaction, error) {
+	hash := common.HexToHash(txHash)
+	receipt, err := v.backend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, ErrTxNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction receipt: %v", err)
+	}
+
+	latest, err := v.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block number: %v", err)
+	}
+
+	tx := &Transaction{
+		TxHash:  hash.Hex(),
+		Success: receipt.Status == types.ReceiptStatusSuccessful,
+	}
+	if receipt.BlockNumber != nil {
+		tx.BlockNumber = receipt.BlockNumber.Uint64()
+		if latest >= tx.BlockNumber {
+			tx.Confirmations = latest - tx.BlockNumber + 1
+		}
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Removed || len(log.Topics) != 3 || log.Topics[0] != erc20TransferTopic {
+			continue
+		}
+		tx.Transfers = append(tx.Transfers, Transfer{
+			Token: log.Address.Hex(),
+			From:  common.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+			To:    common.BytesToAddress(log.Topics[2].Bytes()).Hex(),
+			Value: new(big.Int).SetBytes(log.Data),
+		})
+	}
+	return tx, nil
+}
+
+// ScanTransfers 按区块范围扫描转入收款地址的 ERC-20 Transfer 事件
+// 游标为下一个待扫描的区块高度，只扫描确认数已满足要求的区块
+func (v *evmVerifier) ScanTransfers(ctx context.Context, tokens []string, recipients []string, cursor uint64, minConfirmations uint64) ([]Transfer, uint64, error) {
+	latest, err := v.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, cursor, fmt.Errorf("failed to get latest block number: %v", err)
+	}
+
+	// 确认数 = latest - block + 1，满足要求的最高区块为 latest + 1 - minConfirmations
+	if minConfirmations > latest+1 {
+		return nil, cursor, nil
+	}
+	safe := latest
+	if minConfirmations > 0 {
+		safe = latest + 1 - minConfirmations
+	}
+
+	if cursor == 0 {
+		return nil, safe + 1, nil
+	}
+	if cursor > safe || len(tokens) == 0 || len(recipients) == 0 {
+		return nil, cursor, nil
+	}
+
+	to := safe
+	if to-cursor+1 > evmMaxScanBlocks {
+		to = cursor + evmMaxScanBlocks - 1
+	}
+
+	contracts := make([]common.Address, 0, len(tokens))
+	for _, token := range tokens {
+		contracts = append(contracts, common.HexToAddress(token))
+	}
+	recipientTopics := make([]common.Hash, 0, len(recipients))
+	for _, recipient := range recipients {
+		recipientTopics = append(recipientTopics, common.BytesToHash(common.HexToAddress(recipient).Bytes()))
+	}
+
+	logs, err := v.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(cursor),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: contracts,
+		Topics:    [][]common.Hash{{erc20TransferTopic}, nil, recipientTopics},
+	})
+	if err != nil {
+		return nil, cursor, fmt.Errorf("failed to filter transfer logs: %v", err)
+	}
+
+	transfers := make([]Transfer, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed || len(log.Topics) != 3 {
+			continue
+		}
+		transfers = append(transfers, Transfer{
+			Token:       log.Address.Hex(),
+			From:        common.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+			To:          common.BytesToAddress(log.Topics[2].Bytes()).Hex(),
+			Value:       new(big.Int).SetBytes(log.Data),
+			TxHash:      log.TxHash.Hex(),
+			LogIndex:    log.Index,
+			BlockNumber: log.BlockNumber,
+		})
+	}
+	return transfers, to + 1, nil
+}
+
+// NormalizeAddress 校验十六进制地址并转换为 EIP-55 校验和格式
+func (v *evmVerifier) NormalizeAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if !common.IsHexAddress(address) {
+		return "", errors.New("not a valid EVM address")
+	}
+	return common.HexToAddress(address).Hex(), nil
+}
+
+// NormalizeTxHash 校验交易哈希（32 字节十六进制）并统一为 0x 开头的小写格式
+func (v *evmVerifier) NormalizeTxHash(txHash string) (string, error) {
+	txHash = strings.TrimSpace(txHash)
+	raw := strings.TrimPrefix(strings.TrimPrefix(txHash, "0x"), "0X")
+	if len(raw) != 2*common.HashLength || !isHex(raw) {
+		return "", errors.New("not a valid EVM transaction hash")
+	}
+	return common.HexToHash(raw).Hex(), nil
+}
+
+// isHex 是否为十六进制字符串
+func isHex(s string) bool {
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
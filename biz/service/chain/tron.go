@@ -0,0 +1,386 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// tronAddressPrefix TRON 主网地址前缀字节
+	tronAddressPrefix = 0x41
+	// trc20TransferTopic TRC-20 Transfer(address,address,uint256) 事件签名（与 ERC-20 相同，不带 0x）
+	trc20TransferTopic = "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+	// tronScanPageSize TronGrid 单页最大返回条数
+	tronScanPageSize = 200
+	// tronConfirmationLag 交易从上链到固化（only_confirmed 可查到）的最长时间，游标至少保留该回看窗口
+	tronConfirmationLag = 10 * time.Minute
+)
+
+// tronVerifier TRON 链 TRC-20 转账查询（基于 TronGrid / java-tron HTTP API）
+type tronVerifier struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewTronVerifier 创建 TRON 链交易查询实例，httpClient 为空时使用默认客户端
+func NewTronVerifier(baseURL, apiKey string, httpClient *http.Client) Verifier {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &tronVerifier{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+// tronTransactionInfo /wallet/gettransactioninfobyid 响应
+type tronTransactionInfo struct {
+	ID          string `json:"id"`
+	BlockNumber uint64 `json:"blockNumber"`
+	Result      string `json:"result"` // 执行失败时为 FAILED
+	Receipt     struct {
+		Result string `json:"result"` // 合约执行结果，成功为 SUCCESS
+	} `json:"receipt"`
+	Log []struct {
+		Address string   `json:"address"` // 合约地址（十六进制，不带 41 前缀）
+		Topics  []string `json:"topics"`
+		Data    string   `json:"data"`
+	} `json:"log"`
+}
+
+// tronBlock /wallet/getnowblock 响应
+type tronBlock struct {
+	BlockHeader struct {
+		RawData struct {
+			Number uint64 `json:"number"`
+		} `json:"raw_data"`
+	} `json:"block_header"`
+}
+
+// tronTRC20Transfers /v1/accounts/{address}/transactions/trc20 响应
+type tronTRC20Transfers struct {
+	Data []struct {
+		TransactionID string `json:"transaction_id"`
+		TokenInfo     struct {
+			Address string `json:"address"`
+		} `json:"token_info"`
+		BlockTimestamp uint64 `json:"block_timestamp"`
+		From           string `json:"from"`
+		To             string `json:"to"`
+		Type           string `json:"type"`
+		Value          string `json:"value"`
+	} `json:"data"`
+	Success bool `json:"success"`
+}
+
+// ScanTransfers 按区块时间扫描转入收款地址的已固化 TRC-20 转账
+// 游标为区块时间戳（毫秒）；查询包含游标时刻本身，重复的转账由调用方按 (tx_hash, log_index) 去重。
+// 某个地址的结果达到单页上限时，游标只推进到该页最后一条转账的时间，剩余转账在下次扫描中获取
+func (v *tronVerifier) ScanTransfers(ctx context.Context, tokens []string, recipients []string, cursor uint64, minConfirmations uint64) ([]Transfer, uint64, error) {
+	lookback := uint64(time.Now().Add(-tronConfirmationLag).UnixMilli())
+	if cursor == 0 {
+		return nil, lookback, nil
+	}
+
+	var transfers []Transfer
+	logIndexes := make(map[string]uint)
+	next := cursor
+	if lookback > next {
+		next = lookback
+	}
+	truncated := false
+	var truncatedAt uint64
+
+	for _, recipient := range recipients {
+		for _, token := range tokens {
+			query := url.Values{}
+			query.Set("only_confirmed", "true")
+			query.Set("only_to", "true")
+			query.Set("contract_address", token)
+			query.Set("min_timestamp", strconv.FormatUint(cursor, 10))
+			query.Set("order_by", "block_timestamp,asc")
+			query.Set("limit", strconv.Itoa(tronScanPageSize))
+
+			var page tronTRC20Transfers
+			if err := v.get(ctx, "/v1/accounts/"+recipient+"/transactions/trc20?"+query.Encode(), &page); err != nil {
+				return nil, cursor, fmt.Errorf("failed to list TRC-20 transfers of %s: %v", recipient, err)
+			}
+
+			var last uint64
+			for _, item := range page.Data {
+				last = item.BlockTimestamp
+				if item.Type != "" && item.Type != "Transfer" {
+					continue
+				}
+
+				to, err := v.NormalizeAddress(item.To)
+				if err != nil || to != recipient {
+					continue
+				}
+				from, err := v.NormalizeAddress(item.From)
+				if err != nil {
+					continue
+				}
+				contract, err := v.NormalizeAddress(item.TokenInfo.Address)
+				if err != nil {
+					continue
+				}
+				value, ok := new(big.Int).SetString(item.Value, 10)
+				if !ok {
+					continue
+				}
+
+				txHash := strings.ToLower(item.TransactionID)
+				transfers = append(transfers, Transfer{
+					Token:    contract,
+					From:     from,
+					To:       to,
+					Value:    value,
+					TxHash:   txHash,
+					LogIndex: logIndexes[txHash],
+				})
+				logIndexes[txHash]++
+
+				if item.BlockTimestamp > next {
+					next = item.BlockTimestamp
+				}
+			}
+
+			if len(page.Data) >= tronScanPageSize && (!truncated || last < truncatedAt) {
+				truncated = true
+				truncatedAt = last
+			}
+		}
+	}
+
+	if truncated {
+		next = truncatedAt
+	}
+	return transfers, next, nil
+}
+
+// GetTransaction 查询交易执行结果并解析 TRC-20 Transfer 事件
+func (v *tronVerifier) GetTransaction(ctx context.Context, txHash string) (*Transaction, error) {
+	var info tronTransactionInfo
+	if err := v.post(ctx, "/wallet/gettransactioninfobyid", map[string]string{"value": txHash}, &info); err != nil {
+		return nil, fmt.Errorf("failed to get transaction info: %v", err)
+	}
+	// 交易不存在时接口返回空对象
+	if info.ID == "" {
+		return nil, ErrTxNotFound
+	}
+
+	var block tronBlock
+	if err := v.post(ctx, "/wallet/getnowblock", map[string]string{}, &block); err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %v", err)
+	}
+
+	tx := &Transaction{
+		TxHash:      strings.ToLower(info.ID),
+		Success:     info.Result != "FAILED" && info.Receipt.Result == "SUCCESS",
+		BlockNumber: info.BlockNumber,
+	}
+	if latest := block.BlockHeader.RawData.Number; latest >= info.BlockNumber {
+		tx.Confirmations = latest - info.BlockNumber + 1
+	}
+
+	for _, log := range info.Log {
+		if len(log.Topics) != 3 || strings.ToLower(log.Topics[0]) != trc20TransferTopic {
+			continue
+		}
+
+		token, err := tronAddressFromHex(log.Address)
+		if err != nil {
+			continue
+		}
+		from, err := tronAddressFromTopic(log.Topics[1])
+		if err != nil {
+			continue
+		}
+		to, err := tronAddressFromTopic(log.Topics[2])
+		if err != nil {
+			continue
+		}
+		value, ok := new(big.Int).SetString(log.Data, 16)
+		if !ok {
+			continue
+		}
+
+		tx.Transfers = append(tx.Transfers, Transfer{Token: token, From: from, To: to, Value: value})
+	}
+	return tx, nil
+}
+
+// NormalizeAddress 校验 TRON 地址并统一为 Base58Check 格式（T 开头），同时接受 41 开头的十六进制地址
+func (v *tronVerifier) NormalizeAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if strings.HasPrefix(address, "T") {
+		payload, err := base58CheckDecode(address)
+		if err != nil {
+			return "", err
+		}
+		if len(payload) != 21 || payload[0] != tronAddressPrefix {
+			return "", errors.New("not a valid TRON address")
+		}
+		return address, nil
+	}
+	return tronAddressFromHex(address)
+}
+
+// NormalizeTxHash 校验交易ID（32 字节十六进制）并统一为不带 0x 的小写格式
+func (v *tronVerifier) NormalizeTxHash(txHash string) (string, error) {
+	raw := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(txHash), "0x"))
+	if len(raw) != 64 || !isHex(raw) {
+		return "", errors.New("not a valid TRON transaction id")
+	}
+	return raw, nil
+}
+
+// post 调用 TRON HTTP API（POST 接口）
+func (v *tronVerifier) post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return v.do(req, out)
+}
+
+// get 调用 TRON HTTP API（GET 接口）
+func (v *tronVerifier) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	return v.do(req, out)
+}
+
+// do 发送请求并解析 JSON 响应
+func (v *tronVerifier) do(req *http.Request, out interface{}) error {
+	if v.apiKey != "" {
+		req.Header.Set("TRON-PRO-API-KEY", v.apiKey)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(data))
+	}
+	return json.Unmarshal(data, out)
+}
+
+// tronAddressFromTopic 从事件 topic（32 字节，地址在低 20 字节）解析 TRON 地址
+func tronAddressFromTopic(topic string) (string, error) {
+	topic = strings.TrimPrefix(topic, "0x")
+	if len(topic) != 64 {
+		return "", errors.New("invalid address topic")
+	}
+	return tronAddressFromHex(topic[24:])
+}
+
+// tronAddressFromHex 将十六进制地址（20 字节，或带 41 前缀的 21 字节）转换为 Base58Check 格式
+func tronAddressFromHex(address string) (string, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid hex address: %v", err)
+	}
+
+	switch {
+	case len(raw) == 20:
+		raw = append([]byte{tronAddressPrefix}, raw...)
+	case len(raw) == 21 && raw[0] == tronAddressPrefix:
+	default:
+		return "", errors.New("not a valid TRON address")
+	}
+	return base58CheckEncode(raw), nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58CheckEncode Base58Check 编码（载荷 + 双 SHA-256 前 4 字节校验和）
+func base58CheckEncode(payload []byte) string {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	data := append(append([]byte{}, payload...), second[:4]...)
+
+	num := new(big.Int).SetBytes(data)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+	var encoded []byte
+	for num.Sign() > 0 {
+		num.DivMod(num, base, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+// base58CheckDecode Base58Check 解码并校验校验和，返回载荷
+func base58CheckDecode(s string) ([]byte, error) {
+	num := new(big.Int)
+	base := big.NewInt(58)
+	for _, c := range s {
+		idx := strings.IndexRune(base58Alphabet, c)
+		if idx < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		num.Mul(num, base)
+		num.Add(num, big.NewInt(int64(idx)))
+	}
+
+	data := num.Bytes()
+	for _, c := range s {
+		if c != rune(base58Alphabet[0]) {
+			break
+		}
+		data = append([]byte{0}, data...)
+	}
+	if len(data) < 5 {
+		return nil, errors.New("base58 data too short")
+	}
+
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		return nil, errors.New("invalid base58 checksum")
+	}
+	return payload, nil
+}
@@ -3,12 +3,14 @@ package dashboard
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
 	common "orbia_api/biz/model/common"
 	dashboardModel "orbia_api/biz/model/dashboard"
+	"orbia_api/biz/service/audit"
 	"orbia_api/biz/utils"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -17,21 +19,27 @@ import (
 
 // DashboardService Dashboard服务
 type DashboardService struct {
+	db                *gorm.DB
 	excellentCaseRepo mysql.ExcellentCaseRepository
 	contentTrendRepo  mysql.ContentTrendRepository
 	platformStatsRepo mysql.PlatformStatsRepository
+	auditSvc          audit.AuditService
 }
 
 // NewDashboardService 创建Dashboard服务实例
 func NewDashboardService(
+	db *gorm.DB,
 	excellentCaseRepo mysql.ExcellentCaseRepository,
 	contentTrendRepo mysql.ContentTrendRepository,
 	platformStatsRepo mysql.PlatformStatsRepository,
+	auditSvc audit.AuditService,
 ) *DashboardService {
 	return &DashboardService{
+		db:                db,
 		excellentCaseRepo: excellentCaseRepo,
 		contentTrendRepo:  contentTrendRepo,
 		platformStatsRepo: platformStatsRepo,
+		auditSvc:          auditSvc,
 	}
 }
 
@@ -72,7 +80,18 @@ func (s *DashboardService) CreateExcellentCase(ctx context.Context, req *dashboa
 	}
 
 	// 创建优秀案例
-	if err := s.excellentCaseRepo.CreateExcellentCase(excellentCase); err != nil {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.excellentCaseRepo.CreateExcellentCaseWithTx(tx, excellentCase); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionExcellentCaseCreate,
+			TargetType: audit.TargetExcellentCase,
+			TargetID:   strconv.FormatInt(excellentCase.ID, 10),
+			After:      excellentCase,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to create excellent case: %v", err)
 		return &dashboardModel.CreateExcellentCaseResp{
 			BaseResp: utils.BuildBaseResp(500, "创建优秀案例失败"),
@@ -101,6 +120,8 @@ func (s *DashboardService) UpdateExcellentCase(ctx context.Context, req *dashboa
 		}, nil
 	}
 
+	before := *existingCase
+
 	// 更新字段
 	if req.VideoURL != nil {
 		existingCase.VideoURL = *req.VideoURL
@@ -122,7 +143,19 @@ func (s *DashboardService) UpdateExcellentCase(ctx context.Context, req *dashboa
 	}
 
 	// 保存更新
-	if err := s.excellentCaseRepo.UpdateExcellentCase(existingCase); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.excellentCaseRepo.UpdateExcellentCaseWithTx(tx, existingCase); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionExcellentCaseUpdate,
+			TargetType: audit.TargetExcellentCase,
+			TargetID:   strconv.FormatInt(existingCase.ID, 10),
+			Before:     before,
+			After:      existingCase,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to update excellent case: %v", err)
 		return &dashboardModel.UpdateExcellentCaseResp{
 			BaseResp: utils.BuildBaseResp(500, "更新优秀案例失败"),
@@ -137,7 +170,7 @@ func (s *DashboardService) UpdateExcellentCase(ctx context.Context, req *dashboa
 // DeleteExcellentCase 删除优秀案例
 func (s *DashboardService) DeleteExcellentCase(ctx context.Context, req *dashboardModel.DeleteExcellentCaseReq) (*dashboardModel.DeleteExcellentCaseResp, error) {
 	// 检查案例是否存在
-	_, err := s.excellentCaseRepo.GetExcellentCaseByID(req.ID)
+	existingCase, err := s.excellentCaseRepo.GetExcellentCaseByID(req.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &dashboardModel.DeleteExcellentCaseResp{
@@ -151,7 +184,18 @@ func (s *DashboardService) DeleteExcellentCase(ctx context.Context, req *dashboa
 	}
 
 	// 删除案例
-	if err := s.excellentCaseRepo.DeleteExcellentCase(req.ID); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.excellentCaseRepo.DeleteExcellentCaseWithTx(tx, req.ID); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionExcellentCaseDelete,
+			TargetType: audit.TargetExcellentCase,
+			TargetID:   strconv.FormatInt(existingCase.ID, 10),
+			Before:     existingCase,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to delete excellent case: %v", err)
 		return &dashboardModel.DeleteExcellentCaseResp{
 			BaseResp: utils.BuildBaseResp(500, "删除优秀案例失败"),
@@ -266,7 +310,18 @@ func (s *DashboardService) CreateContentTrend(ctx context.Context, req *dashboar
 	}
 
 	// 创建内容趋势
-	if err := s.contentTrendRepo.CreateContentTrend(trend); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.contentTrendRepo.CreateContentTrendWithTx(tx, trend); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionContentTrendCreate,
+			TargetType: audit.TargetContentTrend,
+			TargetID:   strconv.FormatInt(trend.ID, 10),
+			After:      trend,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to create content trend: %v", err)
 		return &dashboardModel.CreateContentTrendResp{
 			BaseResp: utils.BuildBaseResp(500, "创建内容趋势失败"),
@@ -295,6 +350,8 @@ func (s *DashboardService) UpdateContentTrend(ctx context.Context, req *dashboar
 		}, nil
 	}
 
+	before := *existingTrend
+
 	// 如果要更新排名，检查新排名是否已存在
 	if req.Ranking != nil && *req.Ranking != existingTrend.Ranking {
 		exists, err := s.contentTrendRepo.CheckRankingExists(*req.Ranking, req.ID)
@@ -335,7 +392,19 @@ func (s *DashboardService) UpdateContentTrend(ctx context.Context, req *dashboar
 	}
 
 	// 保存更新
-	if err := s.contentTrendRepo.UpdateContentTrend(existingTrend); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.contentTrendRepo.UpdateContentTrendWithTx(tx, existingTrend); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionContentTrendUpdate,
+			TargetType: audit.TargetContentTrend,
+			TargetID:   strconv.FormatInt(existingTrend.ID, 10),
+			Before:     before,
+			After:      existingTrend,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to update content trend: %v", err)
 		return &dashboardModel.UpdateContentTrendResp{
 			BaseResp: utils.BuildBaseResp(500, "更新内容趋势失败"),
@@ -350,7 +419,7 @@ func (s *DashboardService) UpdateContentTrend(ctx context.Context, req *dashboar
 // DeleteContentTrend 删除内容趋势
 func (s *DashboardService) DeleteContentTrend(ctx context.Context, req *dashboardModel.DeleteContentTrendReq) (*dashboardModel.DeleteContentTrendResp, error) {
 	// 检查趋势是否存在
-	_, err := s.contentTrendRepo.GetContentTrendByID(req.ID)
+	existingTrend, err := s.contentTrendRepo.GetContentTrendByID(req.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &dashboardModel.DeleteContentTrendResp{
@@ -364,7 +433,18 @@ func (s *DashboardService) DeleteContentTrend(ctx context.Context, req *dashboar
 	}
 
 	// 删除趋势
-	if err := s.contentTrendRepo.DeleteContentTrend(req.ID); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.contentTrendRepo.DeleteContentTrendWithTx(tx, req.ID); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionContentTrendDelete,
+			TargetType: audit.TargetContentTrend,
+			TargetID:   strconv.FormatInt(existingTrend.ID, 10),
+			Before:     existingTrend,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to delete content trend: %v", err)
 		return &dashboardModel.DeleteContentTrendResp{
 			BaseResp: utils.BuildBaseResp(500, "删除内容趋势失败"),
@@ -451,6 +531,8 @@ func (s *DashboardService) UpdatePlatformStats(ctx context.Context, req *dashboa
 		}, nil
 	}
 
+	before := *stats
+
 	// 更新字段
 	if req.ActiveKols != nil {
 		stats.ActiveKols = *req.ActiveKols
@@ -475,7 +557,19 @@ func (s *DashboardService) UpdatePlatformStats(ctx context.Context, req *dashboa
 	}
 
 	// 保存更新
-	if err := s.platformStatsRepo.UpdatePlatformStats(stats); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.platformStatsRepo.UpdatePlatformStatsWithTx(tx, stats); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionPlatformStatsUpdate,
+			TargetType: audit.TargetPlatformStats,
+			TargetID:   strconv.FormatInt(stats.ID, 10),
+			Before:     before,
+			After:      stats,
+		})
+	})
+	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to update platform stats: %v", err)
 		return &dashboardModel.UpdatePlatformStatsResp{
 			BaseResp: utils.BuildBaseResp(500, "更新平台数据失败"),
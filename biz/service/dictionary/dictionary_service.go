@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
 	dictModel "orbia_api/biz/model/dictionary"
+	"orbia_api/biz/service/audit"
 	"orbia_api/biz/utils"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -16,18 +18,24 @@ import (
 
 // DictionaryService 字典服务
 type DictionaryService struct {
+	db           *gorm.DB
 	dictRepo     mysql.DictionaryRepository
 	dictItemRepo mysql.DictionaryItemRepository
+	auditSvc     audit.AuditService
 }
 
 // NewDictionaryService 创建字典服务实例
 func NewDictionaryService(
+	db *gorm.DB,
 	dictRepo mysql.DictionaryRepository,
 	dictItemRepo mysql.DictionaryItemRepository,
+	auditSvc audit.AuditService,
 ) *DictionaryService {
 	return &DictionaryService{
+		db:           db,
 		dictRepo:     dictRepo,
 		dictItemRepo: dictItemRepo,
+		auditSvc:     auditSvc,
 	}
 }
 
@@ -65,7 +73,18 @@ func (s *DictionaryService) CreateDictionary(ctx context.Context, req *dictModel
 		Status:      1, // 默认启用
 	}
 
-	if err := s.dictRepo.CreateDictionary(dictionary); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.dictRepo.CreateDictionaryWithTx(tx, dictionary); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionDictionaryCreate,
+			TargetType: audit.TargetDictionary,
+			TargetID:   strconv.FormatInt(dictionary.ID, 10),
+			After:      dictionary,
+		})
+	})
+	if err != nil {
 		hlog.Errorf("Failed to create dictionary: %v", err)
 		return &dictModel.CreateDictionaryResp{
 			BaseResp: utils.BuildBaseResp(500, "创建字典失败"),
@@ -96,6 +115,8 @@ func (s *DictionaryService) UpdateDictionary(ctx context.Context, req *dictModel
 		}, nil
 	}
 
+	before := *dictionary
+
 	// 更新字段
 	dictionary.Name = req.Name
 	if req.Description != nil {
@@ -106,7 +127,19 @@ func (s *DictionaryService) UpdateDictionary(ctx context.Context, req *dictModel
 	}
 
 	// 更新字典
-	if err := s.dictRepo.UpdateDictionary(dictionary); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.dictRepo.UpdateDictionaryWithTx(tx, dictionary); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionDictionaryUpdate,
+			TargetType: audit.TargetDictionary,
+			TargetID:   strconv.FormatInt(dictionary.ID, 10),
+			Before:     before,
+			After:      dictionary,
+		})
+	})
+	if err != nil {
 		hlog.Errorf("Failed to update dictionary: %v", err)
 		return &dictModel.UpdateDictionaryResp{
 			BaseResp: utils.BuildBaseResp(500, "更新字典失败"),
@@ -124,7 +157,7 @@ func (s *DictionaryService) UpdateDictionary(ctx context.Context, req *dictModel
 // DeleteDictionary 删除字典（软删除）
 func (s *DictionaryService) DeleteDictionary(ctx context.Context, req *dictModel.DeleteDictionaryReq) (*dictModel.DeleteDictionaryResp, error) {
 	// 检查字典是否存在
-	_, err := s.dictRepo.GetDictionaryByID(req.ID)
+	dictionary, err := s.dictRepo.GetDictionaryByID(req.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &dictModel.DeleteDictionaryResp{
@@ -137,20 +170,32 @@ func (s *DictionaryService) DeleteDictionary(ctx context.Context, req *dictModel
 		}, nil
 	}
 
-	// 删除字典（软删除）
-	if err := s.dictRepo.DeleteDictionary(req.ID); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 删除字典（软删除）
+		if err := s.dictRepo.DeleteDictionaryWithTx(tx, req.ID); err != nil {
+			return err
+		}
+
+		// 同时删除该字典下的所有字典项
+		if err := s.dictItemRepo.DeleteDictionaryItemsByDictionaryIDWithTx(tx, req.ID); err != nil {
+			hlog.Errorf("Failed to delete dictionary items: %v", err)
+			// 不影响主流程，只记录日志
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionDictionaryDelete,
+			TargetType: audit.TargetDictionary,
+			TargetID:   strconv.FormatInt(dictionary.ID, 10),
+			Before:     dictionary,
+		})
+	})
+	if err != nil {
 		hlog.Errorf("Failed to delete dictionary: %v", err)
 		return &dictModel.DeleteDictionaryResp{
 			BaseResp: utils.BuildBaseResp(500, "删除字典失败"),
 		}, nil
 	}
 
-	// 同时删除该字典下的所有字典项
-	if err := s.dictItemRepo.DeleteDictionaryItemsByDictionaryID(req.ID); err != nil {
-		hlog.Errorf("Failed to delete dictionary items: %v", err)
-		// 不影响主流程，只记录日志
-	}
-
 	return &dictModel.DeleteDictionaryResp{
 		BaseResp: utils.BuildSuccessResp(),
 	}, nil
@@ -317,24 +362,36 @@ func (s *DictionaryService) CreateDictionaryItem(ctx context.Context, req *dictM
 		Status:       1,    // 默认启用
 	}
 
-	if err := s.dictItemRepo.CreateDictionaryItem(item); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.dictItemRepo.CreateDictionaryItemWithTx(tx, item); err != nil {
+			return err
+		}
+
+		// 更新path为完整路径
+		if path != "" {
+			item.Path = fmt.Sprintf("%s/%d", path, item.ID)
+		} else {
+			item.Path = fmt.Sprintf("%d", item.ID)
+		}
+		if err := s.dictItemRepo.UpdateDictionaryItemWithTx(tx, item); err != nil {
+			hlog.Errorf("Failed to update dictionary item path: %v", err)
+			// 不影响主流程
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionDictionaryItemCreate,
+			TargetType: audit.TargetDictionaryItem,
+			TargetID:   strconv.FormatInt(item.ID, 10),
+			After:      item,
+		})
+	})
+	if err != nil {
 		hlog.Errorf("Failed to create dictionary item: %v", err)
 		return &dictModel.CreateDictionaryItemResp{
 			BaseResp: utils.BuildBaseResp(500, "创建字典项失败"),
 		}, nil
 	}
 
-	// 更新path为完整路径
-	if path != "" {
-		item.Path = fmt.Sprintf("%s/%d", path, item.ID)
-	} else {
-		item.Path = fmt.Sprintf("%d", item.ID)
-	}
-	if err := s.dictItemRepo.UpdateDictionaryItem(item); err != nil {
-		hlog.Errorf("Failed to update dictionary item path: %v", err)
-		// 不影响主流程
-	}
-
 	// 构建响应
 	itemInfo := s.buildDictionaryItemInfo(item)
 	return &dictModel.CreateDictionaryItemResp{
@@ -359,6 +416,8 @@ func (s *DictionaryService) UpdateDictionaryItem(ctx context.Context, req *dictM
 		}, nil
 	}
 
+	before := *item
+
 	// 更新字段
 	if req.Name != nil {
 		item.Name = *req.Name
@@ -377,7 +436,19 @@ func (s *DictionaryService) UpdateDictionaryItem(ctx context.Context, req *dictM
 	}
 
 	// 更新字典项
-	if err := s.dictItemRepo.UpdateDictionaryItem(item); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.dictItemRepo.UpdateDictionaryItemWithTx(tx, item); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionDictionaryItemUpdate,
+			TargetType: audit.TargetDictionaryItem,
+			TargetID:   strconv.FormatInt(item.ID, 10),
+			Before:     before,
+			After:      item,
+		})
+	})
+	if err != nil {
 		hlog.Errorf("Failed to update dictionary item: %v", err)
 		return &dictModel.UpdateDictionaryItemResp{
 			BaseResp: utils.BuildBaseResp(500, "更新字典项失败"),
@@ -408,8 +479,19 @@ func (s *DictionaryService) DeleteDictionaryItem(ctx context.Context, req *dictM
 		}, nil
 	}
 
-	// 递归删除该节点及其所有子节点
-	if err := s.deleteDictionaryItemRecursive(item.ID); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 递归删除该节点及其所有子节点
+		if err := s.deleteDictionaryItemRecursive(tx, item.ID); err != nil {
+			return err
+		}
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionDictionaryItemDelete,
+			TargetType: audit.TargetDictionaryItem,
+			TargetID:   strconv.FormatInt(item.ID, 10),
+			Before:     item,
+		})
+	})
+	if err != nil {
 		hlog.Errorf("Failed to delete dictionary item: %v", err)
 		return &dictModel.DeleteDictionaryItemResp{
 			BaseResp: utils.BuildBaseResp(500, "删除字典项失败"),
@@ -608,8 +690,8 @@ func (s *DictionaryService) buildTree(items []*model.OrbiaDictionaryItem, parent
 	return nodes
 }
 
-// deleteDictionaryItemRecursive 递归删除字典项及其所有子节点
-func (s *DictionaryService) deleteDictionaryItemRecursive(itemID int64) error {
+// deleteDictionaryItemRecursive 递归删除字典项及其所有子节点（在事务中执行）
+func (s *DictionaryService) deleteDictionaryItemRecursive(tx *gorm.DB, itemID int64) error {
 	// 获取所有子节点
 	children, err := s.dictItemRepo.GetChildrenByParentID(itemID)
 	if err != nil {
@@ -618,11 +700,11 @@ func (s *DictionaryService) deleteDictionaryItemRecursive(itemID int64) error {
 
 	// 递归删除所有子节点
 	for _, child := range children {
-		if err := s.deleteDictionaryItemRecursive(child.ID); err != nil {
+		if err := s.deleteDictionaryItemRecursive(tx, child.ID); err != nil {
 			return err
 		}
 	}
 
 	// 删除当前节点
-	return s.dictItemRepo.DeleteDictionaryItem(itemID)
+	return s.dictItemRepo.DeleteDictionaryItemWithTx(tx, itemID)
 }
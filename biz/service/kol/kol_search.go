@@ -0,0 +1,160 @@
+package kol
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils/money"
+)
+
+const (
+	// kolSearchMaxPageSize KOL搜索每页最大数量
+	kolSearchMaxPageSize = 100
+	// kolSearchMaxKeywordLength 搜索关键词最大长度
+	kolSearchMaxKeywordLength = 100
+	// kolSearchMaxFilterValues 多选条件最多的取值数量
+	kolSearchMaxFilterValues = 20
+)
+
+// KolSearchResult KOL搜索结果，附带列表展示所需的统计、标签、语言、最低报价和评分
+type KolSearchResult struct {
+	Kols      []*mysql.Kol
+	Total     int64
+	Stats     map[int64]*mysql.KolStats
+	Tags      map[int64][]*mysql.KolTag
+	Languages map[int64][]*mysql.KolLanguage
+	MinPrices map[int64]money.Amount
+	Ratings   map[int64]*mysql.KolRating // 没有公开评价的KOL不在结果中
+	Facets    *mysql.KolSearchFacets     // 未请求分面时为 nil
+}
+
+// SearchKols 搜索KOL列表
+func (s *kolService) SearchKols(filter *mysql.KolSearchFilter, page, pageSize int, withFacets bool) (*KolSearchResult, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > kolSearchMaxPageSize {
+		pageSize = kolSearchMaxPageSize
+	}
+
+	if err := normalizeKolSearchFilter(filter); err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * pageSize
+	kols, total, err := s.kolRepo.SearchKols(filter, offset, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search KOLs: %v", err)
+	}
+
+	kolIDs := make([]int64, 0, len(kols))
+	for _, k := range kols {
+		kolIDs = append(kolIDs, k.ID)
+	}
+
+	result := &KolSearchResult{Kols: kols, Total: total}
+	if result.Stats, err = s.kolRepo.GetKolStatsByKolIDs(kolIDs); err != nil {
+		return nil, fmt.Errorf("failed to get KOL stats: %v", err)
+	}
+	if result.Tags, err = s.kolRepo.GetKolTagsByKolIDs(kolIDs); err != nil {
+		return nil, fmt.Errorf("failed to get KOL tags: %v", err)
+	}
+	if result.Languages, err = s.kolRepo.GetKolLanguagesByKolIDs(kolIDs); err != nil {
+		return nil, fmt.Errorf("failed to get KOL languages: %v", err)
+	}
+	if result.MinPrices, err = s.kolRepo.GetKolMinPlanPrices(kolIDs); err != nil {
+		return nil, fmt.Errorf("failed to get KOL prices: %v", err)
+	}
+	if result.Ratings, err = s.kolRepo.GetKolRatingsByKolIDs(kolIDs); err != nil {
+		return nil, fmt.Errorf("failed to get KOL ratings: %v", err)
+	}
+
+	if withFacets {
+		if result.Facets, err = s.kolRepo.GetKolSearchFacets(filter); err != nil {
+			return nil, fmt.Errorf("failed to get KOL search facets: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetKolRating 获取KOL评分汇总，没有公开评价时平均分和评价数为0
+func (s *kolService) GetKolRating(kolID int64) (*mysql.KolRating, error) {
+	ratings, err := s.kolRepo.GetKolRatingsByKolIDs([]int64{kolID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KOL rating: %v", err)
+	}
+	if rating, ok := ratings[kolID]; ok {
+		return rating, nil
+	}
+	return &mysql.KolRating{KolID: kolID}, nil
+}
+
+// normalizeKolSearchFilter 校验搜索条件，去掉空值和重复值
+func normalizeKolSearchFilter(filter *mysql.KolSearchFilter) error {
+	filter.Keyword = strings.TrimSpace(filter.Keyword)
+	if len([]rune(filter.Keyword)) > kolSearchMaxKeywordLength {
+		return fmt.Errorf("keyword cannot exceed %d characters", kolSearchMaxKeywordLength)
+	}
+
+	var err error
+	if filter.Countries, err = normalizeKolFilterValues("countries", filter.Countries); err != nil {
+		return err
+	}
+	if filter.Tags, err = normalizeKolFilterValues("tags", filter.Tags); err != nil {
+		return err
+	}
+	if filter.Languages, err = normalizeKolFilterValues("languages", filter.Languages); err != nil {
+		return err
+	}
+
+	if (filter.MinFollowers != nil && *filter.MinFollowers < 0) || (filter.MaxFollowers != nil && *filter.MaxFollowers < 0) {
+		return errors.New("follower range cannot be negative")
+	}
+	if filter.MinFollowers != nil && filter.MaxFollowers != nil && *filter.MinFollowers > *filter.MaxFollowers {
+		return errors.New("min_followers cannot be greater than max_followers")
+	}
+	if (filter.MinEngagement != nil && *filter.MinEngagement < 0) || (filter.MaxEngagement != nil && *filter.MaxEngagement < 0) {
+		return errors.New("engagement rate range cannot be negative")
+	}
+	if filter.MinEngagement != nil && filter.MaxEngagement != nil && *filter.MinEngagement > *filter.MaxEngagement {
+		return errors.New("min_engagement_rate cannot be greater than max_engagement_rate")
+	}
+	if (filter.MinPrice != nil && *filter.MinPrice < 0) || (filter.MaxPrice != nil && *filter.MaxPrice < 0) {
+		return errors.New("price range cannot be negative")
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return errors.New("min_price cannot be greater than max_price")
+	}
+
+	switch filter.SortBy {
+	case "", mysql.KolSortRelevance, mysql.KolSortFollowers, mysql.KolSortEngagement, mysql.KolSortPrice, mysql.KolSortRating, mysql.KolSortRecency:
+	default:
+		return fmt.Errorf("invalid sort_by: %s", filter.SortBy)
+	}
+
+	return nil
+}
+
+// normalizeKolFilterValues 去掉多选条件中的空值和重复值
+func normalizeKolFilterValues(name string, values []string) ([]string, error) {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	if len(result) > kolSearchMaxFilterValues {
+		return nil, fmt.Errorf("%s cannot have more than %d values", name, kolSearchMaxFilterValues)
+	}
+	return result, nil
+}
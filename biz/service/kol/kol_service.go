@@ -1,29 +1,46 @@
 package kol
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/social"
+	"orbia_api/biz/utils/money"
 
 	"gorm.io/gorm"
 )
 
+const (
+	// defaultPlanRevisions 创建Plan时未指定的默认修改次数
+	defaultPlanRevisions = 2
+	// maxPlanRevisions Plan允许设置的最大修改次数
+	maxPlanRevisions = 10
+)
+
 // KolService KOL服务接口
 type KolService interface {
 	// KOL申请和基本信息管理
 	ApplyKol(userID int64, displayName, description, country string, avatarURL, tiktokURL, youtubeURL, xURL, discordURL *string, languageCodes, languageNames, tags []string) (int64, error)
 	GetKolInfo(kolID *int64, userID *int64) (*mysql.Kol, []*mysql.KolLanguage, []*mysql.KolTag, *mysql.KolStats, error)
 	UpdateKolInfo(userID int64, displayName, description, country, avatarURL, tiktokURL, youtubeURL, xURL, discordURL *string, languageCodes, languageNames, tags *[]string) error
-	ReviewKol(kolID int64, status, rejectReason string) error
-	GetKolList(status, country, tag *string, page, pageSize int) ([]*mysql.Kol, int64, error)
+	ReviewKol(ctx context.Context, kolID int64, status, rejectReason string) error
+	SearchKols(filter *mysql.KolSearchFilter, page, pageSize int, withFacets bool) (*KolSearchResult, error)
+	GetKolRating(kolID int64) (*mysql.KolRating, error)
 
 	// KOL统计数据管理
 	UpdateKolStats(userID int64, totalFollowers, tiktokFollowers, youtubeSubscribers, xFollowers, discordMembers, tiktokAvgViews *int64, engagementRate *float64) error
+	RefreshAllKolStats(ctx context.Context) error
+	SnapshotKolStats() error
+	GetKolStatsHistory(kolID *int64, userID *int64, days int) (*KolStatsHistoryResult, error)
 
 	// KOL报价Plans管理
-	SaveKolPlan(userID int64, planID *int64, title, description string, price float64, planType string) (int64, error)
+	SaveKolPlan(userID int64, planID *int64, title, description string, price money.Amount, planType string, maxRevisions *int32) (int64, error)
 	DeleteKolPlan(userID, planID int64) error
 	GetKolPlans(kolID *int64, userID *int64) ([]*mysql.KolPlan, error)
 
@@ -36,15 +53,21 @@ type KolService interface {
 
 // kolService KOL服务实现
 type kolService struct {
-	kolRepo  mysql.KolRepository
-	userRepo mysql.UserRepository
+	db            *gorm.DB
+	kolRepo       mysql.KolRepository
+	userRepo      mysql.UserRepository
+	auditSvc      audit.AuditService
+	statsRegistry *social.Registry
 }
 
-// NewKolService 创建KOL服务实例
-func NewKolService(kolRepo mysql.KolRepository, userRepo mysql.UserRepository) KolService {
+// NewKolService 创建KOL服务实例，statsRegistry 为空时不通过平台接口同步粉丝数据
+func NewKolService(db *gorm.DB, kolRepo mysql.KolRepository, userRepo mysql.UserRepository, auditSvc audit.AuditService, statsRegistry *social.Registry) KolService {
 	return &kolService{
-		kolRepo:  kolRepo,
-		userRepo: userRepo,
+		db:            db,
+		kolRepo:       kolRepo,
+		userRepo:      userRepo,
+		auditSvc:      auditSvc,
+		statsRegistry: statsRegistry,
 	}
 }
 
@@ -200,16 +223,30 @@ func (s *kolService) UpdateKolInfo(userID int64, displayName, description, count
 	if avatarURL != nil {
 		kol.AvatarURL = avatarURL
 	}
+	// 主页地址变更的平台需要重新核验粉丝数据
+	var changedPlatforms []social.Platform
 	if tiktokURL != nil {
+		if !sameURL(kol.TiktokURL, tiktokURL) {
+			changedPlatforms = append(changedPlatforms, social.PlatformTikTok)
+		}
 		kol.TiktokURL = tiktokURL
 	}
 	if youtubeURL != nil {
+		if !sameURL(kol.YoutubeURL, youtubeURL) {
+			changedPlatforms = append(changedPlatforms, social.PlatformYouTube)
+		}
 		kol.YoutubeURL = youtubeURL
 	}
 	if xURL != nil {
+		if !sameURL(kol.XURL, xURL) {
+			changedPlatforms = append(changedPlatforms, social.PlatformX)
+		}
 		kol.XURL = xURL
 	}
 	if discordURL != nil {
+		if !sameURL(kol.DiscordURL, discordURL) {
+			changedPlatforms = append(changedPlatforms, social.PlatformDiscord)
+		}
 		kol.DiscordURL = discordURL
 	}
 
@@ -217,6 +254,12 @@ func (s *kolService) UpdateKolInfo(userID int64, displayName, description, count
 		return fmt.Errorf("failed to update KOL: %v", err)
 	}
 
+	if len(changedPlatforms) > 0 {
+		if err := s.resetStatsVerification(kol.ID, changedPlatforms); err != nil {
+			return err
+		}
+	}
+
 	// 更新语言（如果提供）
 	if languageCodes != nil && languageNames != nil {
 		if len(*languageCodes) != len(*languageNames) {
@@ -264,54 +307,46 @@ func (s *kolService) UpdateKolInfo(userID int64, displayName, description, count
 }
 
 // ReviewKol 审核KOL（管理员使用）
-func (s *kolService) ReviewKol(kolID int64, status, rejectReason string) error {
+func (s *kolService) ReviewKol(ctx context.Context, kolID int64, status, rejectReason string) error {
 	// 验证状态
 	if status != "approved" && status != "rejected" {
 		return errors.New("invalid status, must be approved or rejected")
 	}
 
-	// 获取KOL信息
-	kol, err := s.kolRepo.GetKolByID(kolID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("KOL not found")
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// 获取KOL信息
+		kol, err := s.kolRepo.GetKolByIDForUpdate(tx, kolID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("KOL not found")
+			}
+			return fmt.Errorf("failed to get KOL: %v", err)
 		}
-		return fmt.Errorf("failed to get KOL: %v", err)
-	}
 
-	// 更新状态
-	kol.Status = status
-	if status == "rejected" && rejectReason != "" {
-		kol.RejectReason = &rejectReason
-	}
-	if status == "approved" {
-		now := time.Now()
-		kol.ApprovedAt = &now
-	}
-
-	if err := s.kolRepo.UpdateKol(kol); err != nil {
-		return fmt.Errorf("failed to update KOL status: %v", err)
-	}
-
-	return nil
-}
+		before := map[string]interface{}{"status": kol.Status, "reject_reason": kol.RejectReason}
 
-// GetKolList 获取KOL列表
-func (s *kolService) GetKolList(status, country, tag *string, page, pageSize int) ([]*mysql.Kol, int64, error) {
-	if page <= 0 {
-		page = 1
-	}
-	if pageSize <= 0 {
-		pageSize = 10
-	}
+		// 更新状态
+		kol.Status = status
+		if status == "rejected" && rejectReason != "" {
+			kol.RejectReason = &rejectReason
+		}
+		if status == "approved" {
+			now := time.Now()
+			kol.ApprovedAt = &now
+		}
 
-	offset := (page - 1) * pageSize
-	kols, total, err := s.kolRepo.GetKolList(status, country, tag, offset, pageSize)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get KOL list: %v", err)
-	}
+		if err := s.kolRepo.UpdateKolWithTx(tx, kol); err != nil {
+			return fmt.Errorf("failed to update KOL status: %v", err)
+		}
 
-	return kols, total, nil
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionKolReview,
+			TargetType: audit.TargetKol,
+			TargetID:   strconv.FormatInt(kol.ID, 10),
+			Before:     before,
+			After:      map[string]interface{}{"status": kol.Status, "reject_reason": kol.RejectReason},
+		})
+	})
 }
 
 // UpdateKolStats 更新KOL统计数据
@@ -338,51 +373,66 @@ func (s *kolService) UpdateKolStats(userID int64, totalFollowers, tiktokFollower
 		}
 	}
 
-	// 更新统计数据
+	// 更新手动填写的统计数据，已核验的平台仍以平台接口数据为准
 	if totalFollowers != nil {
-		stats.TotalFollowers = *totalFollowers
+		stats.ManualTotalFollowers = *totalFollowers
 	}
 	if tiktokFollowers != nil {
-		stats.TiktokFollowers = *tiktokFollowers
+		stats.ManualTiktokFollowers = *tiktokFollowers
 	}
 	if youtubeSubscribers != nil {
-		stats.YoutubeSubscribers = *youtubeSubscribers
+		stats.ManualYoutubeSubscribers = *youtubeSubscribers
 	}
 	if xFollowers != nil {
-		stats.XFollowers = *xFollowers
+		stats.ManualXFollowers = *xFollowers
 	}
 	if discordMembers != nil {
-		stats.DiscordMembers = *discordMembers
+		stats.ManualDiscordMembers = *discordMembers
 	}
 	if tiktokAvgViews != nil {
-		stats.TiktokAvgViews = *tiktokAvgViews
+		stats.ManualTiktokAvgViews = *tiktokAvgViews
 	}
 	if engagementRate != nil {
-		stats.EngagementRate = *engagementRate
+		stats.ManualEngagementRate = *engagementRate
 	}
+	applyManualFallback(stats)
 
-	if stats.ID == 0 {
-		// 创建
-		if err := s.kolRepo.CreateKolStats(stats); err != nil {
-			return fmt.Errorf("failed to create KOL stats: %v", err)
-		}
-	} else {
-		// 更新
-		if err := s.kolRepo.UpdateKolStats(stats); err != nil {
-			return fmt.Errorf("failed to update KOL stats: %v", err)
-		}
+	if err := s.saveKolStats(stats); err != nil {
+		return err
+	}
+
+	if err := s.kolRepo.CreateKolStatsHistory(statsHistory(stats, time.Now())); err != nil {
+		return fmt.Errorf("failed to record KOL stats history: %v", err)
 	}
 
 	return nil
 }
 
+// sameURL 比较主页地址是否相同（忽略首尾空白）
+func sameURL(current, updated *string) bool {
+	currentURL, updatedURL := "", ""
+	if current != nil {
+		currentURL = strings.TrimSpace(*current)
+	}
+	if updated != nil {
+		updatedURL = strings.TrimSpace(*updated)
+	}
+	return currentURL == updatedURL
+}
+
 // SaveKolPlan 创建或更新KOL报价Plan
-func (s *kolService) SaveKolPlan(userID int64, planID *int64, title, description string, price float64, planType string) (int64, error) {
+// maxRevisions 为空时，创建使用默认修改次数，更新保持原值不变
+func (s *kolService) SaveKolPlan(userID int64, planID *int64, title, description string, price money.Amount, planType string, maxRevisions *int32) (int64, error) {
 	// 验证planType
 	if planType != "basic" && planType != "standard" && planType != "premium" {
 		return 0, errors.New("invalid plan type, must be basic, standard, or premium")
 	}
 
+	// 验证修改次数
+	if maxRevisions != nil && (*maxRevisions < 0 || *maxRevisions > maxPlanRevisions) {
+		return 0, fmt.Errorf("max revisions must be between 0 and %d", maxPlanRevisions)
+	}
+
 	// 获取KOL信息
 	kol, err := s.kolRepo.GetKolByUserID(userID)
 	if err != nil {
@@ -412,6 +462,9 @@ func (s *kolService) SaveKolPlan(userID int64, planID *int64, title, description
 		plan.Description = &description
 		plan.Price = price
 		plan.PlanType = planType
+		if maxRevisions != nil {
+			plan.MaxRevisions = *maxRevisions
+		}
 
 		if err := s.kolRepo.UpdateKolPlan(plan); err != nil {
 			return 0, fmt.Errorf("failed to update plan: %v", err)
@@ -421,11 +474,15 @@ func (s *kolService) SaveKolPlan(userID int64, planID *int64, title, description
 	} else {
 		// 创建新Plan
 		plan := &mysql.KolPlan{
-			KolID:       kol.ID,
-			Title:       title,
-			Description: &description,
-			Price:       price,
-			PlanType:    planType,
+			KolID:        kol.ID,
+			Title:        title,
+			Description:  &description,
+			Price:        price,
+			PlanType:     planType,
+			MaxRevisions: defaultPlanRevisions,
+		}
+		if maxRevisions != nil {
+			plan.MaxRevisions = *maxRevisions
 		}
 
 		if err := s.kolRepo.CreateKolPlan(plan); err != nil {
@@ -0,0 +1,172 @@
+package kol
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"orbia_api/biz/dal/mysql"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	// statsHistoryDefaultDays 统计趋势默认返回的天数
+	statsHistoryDefaultDays = 90
+	// statsHistoryMaxDays 统计趋势最多返回的天数
+	statsHistoryMaxDays = 365
+)
+
+// statsGrowthPeriods 增长统计周期（天）
+var statsGrowthPeriods = []int{7, 30, 90}
+
+// KolStatsGrowth 指标在一个周期内的增长
+type KolStatsGrowth struct {
+	PeriodDays int
+	Metric     string
+	StartValue *float64 // 周期起点的值，快照不足一个周期时为 nil
+	EndValue   float64  // 最新快照的值
+	Change     *float64 // 增长量
+	ChangeRate *float64 // 增长率（百分比），起点为 0 时为 nil
+}
+
+// KolStatsHistoryResult KOL统计趋势
+type KolStatsHistoryResult struct {
+	Kol       *mysql.Kol
+	Snapshots []*mysql.KolStatsDaily // 按日期升序
+	Growth    []*KolStatsGrowth
+}
+
+// GetKolStatsHistory 获取KOL最近 days 天的每日统计快照，以及7、30、90天的增长
+func (s *kolService) GetKolStatsHistory(kolID *int64, userID *int64, days int) (*KolStatsHistoryResult, error) {
+	if days <= 0 {
+		days = statsHistoryDefaultDays
+	}
+	if days > statsHistoryMaxDays {
+		days = statsHistoryMaxDays
+	}
+
+	var kol *mysql.Kol
+	var err error
+	if kolID != nil {
+		kol, err = s.kolRepo.GetKolByID(*kolID)
+	} else if userID != nil {
+		kol, err = s.kolRepo.GetKolByUserID(*userID)
+	} else {
+		return nil, errors.New("either kol_id or user_id must be provided")
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("KOL not found")
+		}
+		return nil, fmt.Errorf("failed to get KOL: %v", err)
+	}
+
+	// 增长统计需要最长周期之前的快照，查询范围取两者中较大的
+	lookback := days
+	if maxPeriod := statsGrowthPeriods[len(statsGrowthPeriods)-1]; maxPeriod > lookback {
+		lookback = maxPeriod
+	}
+	today := snapshotDate(time.Now())
+	snapshots, err := s.kolRepo.GetKolStatsDaily(kol.ID, today.AddDate(0, 0, -lookback))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KOL stats history: %v", err)
+	}
+
+	result := &KolStatsHistoryResult{
+		Kol:       kol,
+		Snapshots: make([]*mysql.KolStatsDaily, 0, len(snapshots)),
+		Growth:    statsGrowth(snapshots),
+	}
+	from := today.AddDate(0, 0, -(days - 1))
+	for _, snapshot := range snapshots {
+		if !snapshot.SnapshotDate.Before(from) {
+			result.Snapshots = append(result.Snapshots, snapshot)
+		}
+	}
+	return result, nil
+}
+
+// SnapshotKolStats 将所有KOL的当前统计数据保存为当天快照
+func (s *kolService) SnapshotKolStats() error {
+	rows, err := s.kolRepo.SnapshotAllKolStats(snapshotDate(time.Now()))
+	if err != nil {
+		return fmt.Errorf("failed to snapshot KOL stats: %v", err)
+	}
+	hlog.Infof("KOL stats snapshot saved, %d rows affected", rows)
+	return nil
+}
+
+// statsGrowth 以最新快照为终点，计算各指标在每个周期内的增长
+// 起点为终点日期往前 N 天当天或之前最近的一条快照
+func statsGrowth(snapshots []*mysql.KolStatsDaily) []*KolStatsGrowth {
+	if len(snapshots) == 0 {
+		return []*KolStatsGrowth{}
+	}
+
+	latest := snapshots[len(snapshots)-1]
+	growth := make([]*KolStatsGrowth, 0, len(statsGrowthPeriods)*len(mysql.AllKolMetrics()))
+	for _, period := range statsGrowthPeriods {
+		startDate := latest.SnapshotDate.AddDate(0, 0, -period)
+		var start *mysql.KolStatsDaily
+		for _, snapshot := range snapshots {
+			if snapshot.SnapshotDate.After(startDate) {
+				break
+			}
+			start = snapshot
+		}
+
+		for _, metric := range mysql.AllKolMetrics() {
+			g := &KolStatsGrowth{
+				PeriodDays: period,
+				Metric:     metric,
+				EndValue:   latest.MetricValue(metric),
+			}
+			if start != nil {
+				startValue := start.MetricValue(metric)
+				change := g.EndValue - startValue
+				g.StartValue = &startValue
+				g.Change = &change
+				if startValue != 0 {
+					changeRate := change / startValue * 100
+					g.ChangeRate = &changeRate
+				}
+			}
+			growth = append(growth, g)
+		}
+	}
+	return growth
+}
+
+// recordDailySnapshot 统计数据变更后更新当天快照，失败只记录日志
+func (s *kolService) recordDailySnapshot(stats *mysql.KolStats) {
+	if err := s.kolRepo.UpsertKolStatsDaily(stats, snapshotDate(time.Now())); err != nil {
+		hlog.Errorf("Failed to save daily stats snapshot of KOL %d: %v", stats.KolID, err)
+	}
+}
+
+// snapshotDate 返回快照日期（当天零点）
+func snapshotDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// StartStatsSnapshotJob 启动定时保存KOL统计快照任务，每次运行覆盖当天快照
+// interval 小于等于 0 时不启动
+func StartStatsSnapshotJob(svc KolService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := svc.SnapshotKolStats(); err != nil {
+				hlog.Errorf("KOL stats snapshot failed: %v", err)
+			}
+		}
+	}()
+}
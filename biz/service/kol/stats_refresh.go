@@ -0,0 +1,273 @@
+package kol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/service/social"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	// statsRefreshBatchSize 定时同步每批处理的KOL数量
+	statsRefreshBatchSize = 100
+	// statsRefreshTimeout 单个KOL同步所有平台数据的超时时间
+	statsRefreshTimeout = 30 * time.Second
+	// statsRefreshErrorMaxLength 同步失败原因的最大长度（与 refresh_error 字段一致）
+	statsRefreshErrorMaxLength = 1000
+)
+
+// statsPlatform 社交平台与KOL主页地址、统计字段的对应关系
+type statsPlatform struct {
+	platform   social.Platform
+	metric     string
+	profileURL func(kol *mysql.Kol) *string
+	manual     func(stats *mysql.KolStats) int64
+	get        func(stats *mysql.KolStats) int64
+	set        func(stats *mysql.KolStats, value int64)
+}
+
+var statsPlatforms = []statsPlatform{
+	{
+		platform:   social.PlatformTikTok,
+		metric:     mysql.KolMetricTiktokFollowers,
+		profileURL: func(kol *mysql.Kol) *string { return kol.TiktokURL },
+		manual:     func(stats *mysql.KolStats) int64 { return stats.ManualTiktokFollowers },
+		get:        func(stats *mysql.KolStats) int64 { return stats.TiktokFollowers },
+		set:        func(stats *mysql.KolStats, value int64) { stats.TiktokFollowers = value },
+	},
+	{
+		platform:   social.PlatformYouTube,
+		metric:     mysql.KolMetricYoutubeSubscribers,
+		profileURL: func(kol *mysql.Kol) *string { return kol.YoutubeURL },
+		manual:     func(stats *mysql.KolStats) int64 { return stats.ManualYoutubeSubscribers },
+		get:        func(stats *mysql.KolStats) int64 { return stats.YoutubeSubscribers },
+		set:        func(stats *mysql.KolStats, value int64) { stats.YoutubeSubscribers = value },
+	},
+	{
+		platform:   social.PlatformX,
+		metric:     mysql.KolMetricXFollowers,
+		profileURL: func(kol *mysql.Kol) *string { return kol.XURL },
+		manual:     func(stats *mysql.KolStats) int64 { return stats.ManualXFollowers },
+		get:        func(stats *mysql.KolStats) int64 { return stats.XFollowers },
+		set:        func(stats *mysql.KolStats, value int64) { stats.XFollowers = value },
+	},
+	{
+		platform:   social.PlatformDiscord,
+		metric:     mysql.KolMetricDiscordMembers,
+		profileURL: func(kol *mysql.Kol) *string { return kol.DiscordURL },
+		manual:     func(stats *mysql.KolStats) int64 { return stats.ManualDiscordMembers },
+		get:        func(stats *mysql.KolStats) int64 { return stats.DiscordMembers },
+		set:        func(stats *mysql.KolStats, value int64) { stats.DiscordMembers = value },
+	},
+}
+
+// RefreshAllKolStats 通过平台接口同步所有已审核通过KOL的粉丝数据
+// 单个KOL同步失败只记录日志，不影响其他KOL
+func (s *kolService) RefreshAllKolStats(ctx context.Context) error {
+	if s.statsRegistry.Len() == 0 {
+		return nil
+	}
+
+	var afterID int64
+	for {
+		kols, err := s.kolRepo.ListKolsForStatsRefresh(afterID, statsRefreshBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list KOLs: %v", err)
+		}
+
+		for _, kol := range kols {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			kolCtx, cancel := context.WithTimeout(ctx, statsRefreshTimeout)
+			if _, err := s.refreshKolStats(kolCtx, kol); err != nil {
+				hlog.Errorf("Failed to refresh stats of KOL %d: %v", kol.ID, err)
+			}
+			cancel()
+		}
+
+		if len(kols) < statsRefreshBatchSize {
+			return nil
+		}
+		afterID = kols[len(kols)-1].ID
+	}
+}
+
+// refreshKolStats 同步单个KOL的粉丝数据并记录历史
+// 平台接口成功时使用接口数据并标记该平台已核验；接口失败、未配置或未填写主页时使用KOL手动填写的数据
+func (s *kolService) refreshKolStats(ctx context.Context, kol *mysql.Kol) (*mysql.KolStats, error) {
+	stats, err := s.kolRepo.GetKolStats(kol.ID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get KOL stats: %v", err)
+		}
+		stats = &mysql.KolStats{KolID: kol.ID}
+	}
+
+	linked := 0
+	verified := make([]string, 0, len(statsPlatforms))
+	var failures []string
+	for _, p := range statsPlatforms {
+		profileURL := p.profileURL(kol)
+		if profileURL == nil || strings.TrimSpace(*profileURL) == "" {
+			continue
+		}
+		linked++
+
+		provider, ok := s.statsRegistry.Lookup(p.platform)
+		if !ok {
+			continue
+		}
+		fetched, err := provider.FetchStats(ctx, *profileURL)
+		if err != nil {
+			hlog.Warnf("Failed to fetch %s stats of KOL %d, using manual value: %v", p.platform, kol.ID, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", p.platform, err))
+			continue
+		}
+		p.set(stats, fetched.Followers)
+		verified = append(verified, string(p.platform))
+	}
+
+	now := time.Now()
+	stats.VerifiedPlatforms = strings.Join(verified, ",")
+	stats.Verified = linked > 0 && len(verified) == linked
+	if len(verified) > 0 {
+		stats.VerifiedAt = &now
+	}
+	stats.LastRefreshedAt = &now
+	stats.RefreshError = nil
+	if len(failures) > 0 {
+		refreshError := strings.Join(failures, "; ")
+		if len(refreshError) > statsRefreshErrorMaxLength {
+			refreshError = refreshError[:statsRefreshErrorMaxLength]
+		}
+		stats.RefreshError = &refreshError
+	}
+	applyManualFallback(stats)
+
+	if err := s.saveKolStats(stats); err != nil {
+		return nil, err
+	}
+	if err := s.kolRepo.CreateKolStatsHistory(statsHistory(stats, now)); err != nil {
+		return nil, fmt.Errorf("failed to record KOL stats history: %v", err)
+	}
+	return stats, nil
+}
+
+// saveKolStats 创建或更新KOL统计数据，并更新当天快照
+func (s *kolService) saveKolStats(stats *mysql.KolStats) error {
+	if stats.ID == 0 {
+		if err := s.kolRepo.CreateKolStats(stats); err != nil {
+			return fmt.Errorf("failed to create KOL stats: %v", err)
+		}
+	} else if err := s.kolRepo.UpdateKolStats(stats); err != nil {
+		return fmt.Errorf("failed to update KOL stats: %v", err)
+	}
+
+	s.recordDailySnapshot(stats)
+	return nil
+}
+
+// applyManualFallback 未核验的平台使用手动填写的数据，并重新计算粉丝总数
+// 没有任何平台核验时粉丝总数使用手动填写的值；平均观看数和互动率目前没有平台接口，始终使用手动填写的值
+func applyManualFallback(stats *mysql.KolStats) {
+	var total int64
+	for _, p := range statsPlatforms {
+		if !stats.IsPlatformVerified(string(p.platform)) {
+			p.set(stats, p.manual(stats))
+		}
+		total += p.get(stats)
+	}
+
+	if stats.VerifiedPlatforms == "" {
+		stats.TotalFollowers = stats.ManualTotalFollowers
+	} else {
+		stats.TotalFollowers = total
+	}
+	stats.TiktokAvgViews = stats.ManualTiktokAvgViews
+	stats.EngagementRate = stats.ManualEngagementRate
+}
+
+// statsHistory 生成当前各项指标的历史记录
+func statsHistory(stats *mysql.KolStats, recordedAt time.Time) []*mysql.KolStatsHistory {
+	record := func(metric string, value float64, fromProvider bool) *mysql.KolStatsHistory {
+		source := mysql.KolStatsSourceManual
+		if fromProvider {
+			source = mysql.KolStatsSourceProvider
+		}
+		return &mysql.KolStatsHistory{
+			KolID:      stats.KolID,
+			Metric:     metric,
+			Value:      value,
+			Source:     source,
+			RecordedAt: recordedAt,
+		}
+	}
+
+	records := make([]*mysql.KolStatsHistory, 0, len(statsPlatforms)+3)
+	records = append(records, record(mysql.KolMetricTotalFollowers, float64(stats.TotalFollowers), stats.Verified))
+	for _, p := range statsPlatforms {
+		records = append(records, record(p.metric, float64(p.get(stats)), stats.IsPlatformVerified(string(p.platform))))
+	}
+	records = append(records,
+		record(mysql.KolMetricTiktokAvgViews, float64(stats.TiktokAvgViews), false),
+		record(mysql.KolMetricEngagementRate, stats.EngagementRate, false),
+	)
+	return records
+}
+
+// resetStatsVerification KOL修改主页地址后，对应平台的核验结果失效，恢复为手动填写的数据，等待下次同步
+func (s *kolService) resetStatsVerification(kolID int64, platforms []social.Platform) error {
+	stats, err := s.kolRepo.GetKolStats(kolID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get KOL stats: %v", err)
+	}
+
+	reset := make(map[string]bool, len(platforms))
+	for _, p := range platforms {
+		reset[string(p)] = true
+	}
+	remaining := make([]string, 0, len(platforms))
+	for _, p := range stats.VerifiedPlatformList() {
+		if !reset[p] {
+			remaining = append(remaining, p)
+		}
+	}
+	if len(remaining) == len(stats.VerifiedPlatformList()) {
+		return nil
+	}
+
+	stats.VerifiedPlatforms = strings.Join(remaining, ",")
+	stats.Verified = false
+	applyManualFallback(stats)
+	return s.saveKolStats(stats)
+}
+
+// StartStatsRefreshJob 启动定时同步KOL社交平台数据任务
+// interval 小于等于 0 时不启动
+func StartStatsRefreshJob(svc KolService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := svc.RefreshAllKolStats(context.Background()); err != nil {
+				hlog.Errorf("KOL stats refresh failed: %v", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,490 @@
+package kol_earning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/chain"
+	"orbia_api/biz/service/ledger"
+	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
+
+	"gorm.io/gorm"
+)
+
+// KolEarningService KOL收益服务接口
+type KolEarningService interface {
+	// GetMyKolEarning 获取KOL自己的收益账户
+	GetMyKolEarning(userID int64) (*model.OrbiaKolEarning, error)
+	// GetMyKolEarningRecords 获取KOL自己的收益流水
+	GetMyKolEarningRecords(userID int64, recordType *string, page, pageSize int) ([]*model.OrbiaKolEarningRecord, int64, error)
+	// CreateWithdrawalOrder 创建提现订单
+	CreateWithdrawalOrder(userID int64, amount money.Amount, network, address string, remark *string) (*model.OrbiaWithdrawalOrder, error)
+	// GetMyWithdrawalOrders 获取KOL自己的提现订单列表
+	GetMyWithdrawalOrders(userID int64, status *string, page, pageSize int) ([]*model.OrbiaWithdrawalOrder, int64, error)
+	// GetWithdrawalOrderDetail 获取提现订单详情
+	GetWithdrawalOrderDetail(userID int64, orderID string, isAdmin bool) (*model.OrbiaWithdrawalOrder, error)
+	// GetAllWithdrawalOrders 获取所有提现订单列表（管理员）
+	GetAllWithdrawalOrders(kolID, userID *int64, status, network *string, page, pageSize int) ([]*model.OrbiaWithdrawalOrder, int64, error)
+	// ConfirmWithdrawalOrder 确认提现订单已打款（管理员）
+	ConfirmWithdrawalOrder(ctx context.Context, adminUserID int64, orderID string, cryptoTxHash string, remark *string) (*model.OrbiaWithdrawalOrder, error)
+	// RejectWithdrawalOrder 拒绝提现订单（管理员）
+	RejectWithdrawalOrder(ctx context.Context, adminUserID int64, orderID string, rejectedReason string) (*model.OrbiaWithdrawalOrder, error)
+}
+
+// kolEarningService KOL收益服务实现
+type kolEarningService struct {
+	db                  *gorm.DB
+	kolRepo             mysql.KolRepository
+	kolEarningRepo      mysql.KolEarningRepository
+	withdrawalOrderRepo mysql.WithdrawalOrderRepository
+	ledgerSvc           ledger.LedgerService
+	auditSvc            audit.AuditService
+	chainRegistry       *chain.Registry
+}
+
+// NewKolEarningService 创建KOL收益服务实例
+func NewKolEarningService(
+	db *gorm.DB,
+	kolRepo mysql.KolRepository,
+	kolEarningRepo mysql.KolEarningRepository,
+	withdrawalOrderRepo mysql.WithdrawalOrderRepository,
+	ledgerSvc ledger.LedgerService,
+	auditSvc audit.AuditService,
+	chainRegistry *chain.Registry,
+) KolEarningService {
+	return &kolEarningService{
+		db:                  db,
+		kolRepo:             kolRepo,
+		kolEarningRepo:      kolEarningRepo,
+		withdrawalOrderRepo: withdrawalOrderRepo,
+		ledgerSvc:           ledgerSvc,
+		auditSvc:            auditSvc,
+		chainRegistry:       chainRegistry,
+	}
+}
+
+// GetMyKolEarning 获取KOL自己的收益账户
+// 尚未产生收益的KOL返回零值账户
+func (s *kolEarningService) GetMyKolEarning(userID int64) (*model.OrbiaKolEarning, error) {
+	kol, err := s.getKolByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	earning, err := s.kolEarningRepo.GetEarningByKolID(kol.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &model.OrbiaKolEarning{KolID: kol.ID, UserID: kol.UserID}, nil
+		}
+		return nil, fmt.Errorf("failed to get kol earning: %v", err)
+	}
+
+	return earning, nil
+}
+
+// GetMyKolEarningRecords 获取KOL自己的收益流水
+func (s *kolEarningService) GetMyKolEarningRecords(
+	userID int64,
+	recordType *string,
+	page, pageSize int,
+) ([]*model.OrbiaKolEarningRecord, int64, error) {
+	kol, err := s.getKolByUserID(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, pageSize = normalizePage(page, pageSize)
+	return s.kolEarningRepo.GetEarningRecordsByKolID(kol.ID, recordType, page, pageSize)
+}
+
+// CreateWithdrawalOrder 创建提现订单
+// 提现金额从可提现余额转入冻结金额，待管理员打款确认或拒绝
+func (s *kolEarningService) CreateWithdrawalOrder(
+	userID int64,
+	amount money.Amount,
+	network, address string,
+	remark *string,
+) (*model.OrbiaWithdrawalOrder, error) {
+	if !amount.IsPositive() {
+		return nil, errors.New("invalid amount")
+	}
+
+	minAmount, err := money.FromFloat(config.GlobalConfig.KolEarning.MinWithdrawalAmount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid min withdrawal amount config: %v", err)
+	}
+	if amount < minAmount {
+		return nil, fmt.Errorf("withdrawal amount must be at least %s", minAmount)
+	}
+
+	network = strings.TrimSpace(network)
+	address = strings.TrimSpace(address)
+	if network == "" {
+		return nil, errors.New("network is required")
+	}
+	if address == "" {
+		return nil, errors.New("address is required")
+	}
+
+	// 提现由管理员按订单手动打款，创建时就校验网络和地址，避免冻结收益后打款到无效地址
+	chainNetwork, ok := s.chainRegistry.Lookup(network)
+	if !ok {
+		return nil, fmt.Errorf("unsupported network: %s", network)
+	}
+	address, err = chainNetwork.Verifier.NormalizeAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s address: %v", chainNetwork.Name, err)
+	}
+
+	kol, err := s.getKolByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成订单ID
+	timestamp := time.Now().Unix()
+	id, err := utils.GetDefaultGenerator().NextID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate order ID: %v", err)
+	}
+
+	order := &model.OrbiaWithdrawalOrder{
+		OrderID: fmt.Sprintf("WDORD_%d_%d", timestamp, id),
+		KolID:   kol.ID,
+		UserID:  kol.UserID,
+		Amount:  amount,
+		Network: network,
+		Address: address,
+		Status:  "pending",
+		Remark:  remark,
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		earning, err := s.kolEarningRepo.GetOrCreateEarningWithTx(tx, kol.ID, kol.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get kol earning: %v", err)
+		}
+
+		// 冻结提现金额
+		if _, err := s.ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryWithdrawalRequest,
+			ReferenceType: "withdrawal_order",
+			ReferenceID:   order.OrderID,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.KolEarning(kol.ID), amount),
+				ledger.Credit(ledger.KolEarningFrozen(kol.ID), amount),
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to freeze withdrawal amount: %v", err)
+		}
+
+		if err := s.withdrawalOrderRepo.CreateWithdrawalOrder(tx, order); err != nil {
+			return fmt.Errorf("failed to create withdrawal order: %v", err)
+		}
+
+		return s.createWithdrawalRecord(tx, earning, "freeze", order, earning.Balance-amount, "申请提现，冻结提现金额")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// GetMyWithdrawalOrders 获取KOL自己的提现订单列表
+func (s *kolEarningService) GetMyWithdrawalOrders(
+	userID int64,
+	status *string,
+	page, pageSize int,
+) ([]*model.OrbiaWithdrawalOrder, int64, error) {
+	kol, err := s.getKolByUserID(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, pageSize = normalizePage(page, pageSize)
+	return s.withdrawalOrderRepo.GetWithdrawalOrdersByKolID(kol.ID, status, page, pageSize)
+}
+
+// GetWithdrawalOrderDetail 获取提现订单详情
+func (s *kolEarningService) GetWithdrawalOrderDetail(
+	userID int64,
+	orderID string,
+	isAdmin bool,
+) (*model.OrbiaWithdrawalOrder, error) {
+	order, err := s.getWithdrawalOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 如果不是管理员，检查是否是本人的订单
+	if !isAdmin && order.UserID != userID {
+		return nil, errors.New("permission denied")
+	}
+
+	return order, nil
+}
+
+// GetAllWithdrawalOrders 获取所有提现订单列表（管理员）
+func (s *kolEarningService) GetAllWithdrawalOrders(
+	kolID, userID *int64,
+	status, network *string,
+	page, pageSize int,
+) ([]*model.OrbiaWithdrawalOrder, int64, error) {
+	page, pageSize = normalizePage(page, pageSize)
+	return s.withdrawalOrderRepo.GetAllWithdrawalOrders(kolID, userID, status, network, page, pageSize)
+}
+
+// ConfirmWithdrawalOrder 确认提现订单已打款（管理员）
+// 冻结金额出账并计入累计提现
+func (s *kolEarningService) ConfirmWithdrawalOrder(
+	ctx context.Context,
+	adminUserID int64,
+	orderID string,
+	cryptoTxHash string,
+	remark *string,
+) (*model.OrbiaWithdrawalOrder, error) {
+	cryptoTxHash = strings.TrimSpace(cryptoTxHash)
+	if cryptoTxHash == "" {
+		return nil, errors.New("crypto tx hash is required")
+	}
+
+	order, err := s.getPendingWithdrawalOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := withdrawalOrderSnapshot(order)
+	now := time.Now()
+	order.Status = "confirmed"
+	order.CryptoTxHash = &cryptoTxHash
+	order.ConfirmedBy = &adminUserID
+	order.ConfirmedAt = &now
+
+	if remark != nil && *remark != "" {
+		if order.Remark != nil && *order.Remark != "" {
+			combinedRemark := *order.Remark + "\n[Admin]: " + *remark
+			order.Remark = &combinedRemark
+		} else {
+			adminRemark := "[Admin]: " + *remark
+			order.Remark = &adminRemark
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.withdrawalOrderRepo.UpdatePendingWithdrawalOrder(tx, order); err != nil {
+			return fmt.Errorf("failed to update withdrawal order: %v", err)
+		}
+
+		earning, err := s.kolEarningRepo.GetOrCreateEarningWithTx(tx, order.KolID, order.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get kol earning: %v", err)
+		}
+
+		// 冻结金额出账
+		if _, err := s.ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryWithdrawalPayout,
+			ReferenceType: "withdrawal_order",
+			ReferenceID:   order.OrderID,
+			Remark:        cryptoTxHash,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.KolEarningFrozen(order.KolID), order.Amount),
+				ledger.Credit(ledger.PlatformCash, order.Amount),
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to deduct frozen amount: %v", err)
+		}
+		if err := s.kolEarningRepo.UpdateEarningTotals(tx, order.KolID, 0, 0, order.Amount); err != nil {
+			return fmt.Errorf("failed to update total withdrawn: %v", err)
+		}
+
+		if err := s.createWithdrawalRecord(tx, earning, "withdraw", order, earning.Balance, "提现已打款："+cryptoTxHash); err != nil {
+			return err
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionWithdrawalConfirm,
+			TargetType: audit.TargetWithdrawal,
+			TargetID:   order.OrderID,
+			Before:     before,
+			After:      withdrawalOrderSnapshot(order),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// RejectWithdrawalOrder 拒绝提现订单（管理员）
+// 冻结金额退回可提现余额
+func (s *kolEarningService) RejectWithdrawalOrder(
+	ctx context.Context,
+	adminUserID int64,
+	orderID string,
+	rejectedReason string,
+) (*model.OrbiaWithdrawalOrder, error) {
+	if rejectedReason == "" {
+		return nil, errors.New("rejected reason is required")
+	}
+
+	order, err := s.getPendingWithdrawalOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := withdrawalOrderSnapshot(order)
+	now := time.Now()
+	order.Status = "rejected"
+	order.RejectedReason = &rejectedReason
+	order.ConfirmedBy = &adminUserID
+	order.ConfirmedAt = &now
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.withdrawalOrderRepo.UpdatePendingWithdrawalOrder(tx, order); err != nil {
+			return fmt.Errorf("failed to update withdrawal order: %v", err)
+		}
+
+		earning, err := s.kolEarningRepo.GetOrCreateEarningWithTx(tx, order.KolID, order.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get kol earning: %v", err)
+		}
+
+		// 冻结金额退回可提现余额
+		if _, err := s.ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryWithdrawalReject,
+			ReferenceType: "withdrawal_order",
+			ReferenceID:   order.OrderID,
+			Remark:        rejectedReason,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.KolEarningFrozen(order.KolID), order.Amount),
+				ledger.Credit(ledger.KolEarning(order.KolID), order.Amount),
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to unfreeze withdrawal amount: %v", err)
+		}
+
+		if err := s.createWithdrawalRecord(tx, earning, "unfreeze", order, earning.Balance+order.Amount, "提现被拒绝："+rejectedReason); err != nil {
+			return err
+		}
+
+		return s.auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionWithdrawalReject,
+			TargetType: audit.TargetWithdrawal,
+			TargetID:   order.OrderID,
+			Before:     before,
+			After:      withdrawalOrderSnapshot(order),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// withdrawalOrderSnapshot 构建提现订单的审计快照
+func withdrawalOrderSnapshot(order *model.OrbiaWithdrawalOrder) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"kol_id":       order.KolID,
+		"amount":       order.Amount,
+		"status":       order.Status,
+		"confirmed_by": order.ConfirmedBy,
+	}
+	if order.CryptoTxHash != nil {
+		snapshot["crypto_tx_hash"] = *order.CryptoTxHash
+	}
+	if order.RejectedReason != nil {
+		snapshot["rejected_reason"] = *order.RejectedReason
+	}
+	return snapshot
+}
+
+// getKolByUserID 根据用户ID获取KOL信息
+func (s *kolEarningService) getKolByUserID(userID int64) (*mysql.Kol, error) {
+	kol, err := s.kolRepo.GetKolByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("kol profile not found")
+		}
+		return nil, fmt.Errorf("failed to get kol: %v", err)
+	}
+	return kol, nil
+}
+
+// getWithdrawalOrder 根据订单ID获取提现订单
+func (s *kolEarningService) getWithdrawalOrder(orderID string) (*model.OrbiaWithdrawalOrder, error) {
+	order, err := s.withdrawalOrderRepo.GetWithdrawalOrderByOrderID(orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("withdrawal order not found")
+		}
+		return nil, fmt.Errorf("failed to get withdrawal order: %v", err)
+	}
+	return order, nil
+}
+
+// getPendingWithdrawalOrder 获取待处理的提现订单
+func (s *kolEarningService) getPendingWithdrawalOrder(orderID string) (*model.OrbiaWithdrawalOrder, error) {
+	order, err := s.getWithdrawalOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != "pending" {
+		return nil, fmt.Errorf("withdrawal order is not in pending status, current status: %s", order.Status)
+	}
+	return order, nil
+}
+
+// createWithdrawalRecord 创建提现相关的收益流水（在事务中执行）
+func (s *kolEarningService) createWithdrawalRecord(
+	tx *gorm.DB,
+	earning *model.OrbiaKolEarning,
+	recordType string,
+	order *model.OrbiaWithdrawalOrder,
+	balanceAfter money.Amount,
+	remark string,
+) error {
+	relatedOrderType := "withdrawal_order"
+	record := &model.OrbiaKolEarningRecord{
+		RecordID:         utils.GenerateTransactionID(),
+		KolID:            order.KolID,
+		UserID:           order.UserID,
+		Type:             recordType,
+		Amount:           order.Amount,
+		BalanceBefore:    earning.Balance,
+		BalanceAfter:     balanceAfter,
+		RelatedOrderType: &relatedOrderType,
+		RelatedOrderID:   &order.OrderID,
+		Remark:           &remark,
+	}
+
+	if err := s.kolEarningRepo.CreateEarningRecord(tx, record); err != nil {
+		return fmt.Errorf("failed to create earning record: %v", err)
+	}
+	return nil
+}
+
+// normalizePage 规范化分页参数
+func normalizePage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize
+}
@@ -0,0 +1,75 @@
+package kol_earning
+
+import (
+	"strings"
+	"testing"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/service/chain"
+	"orbia_api/biz/utils/money"
+
+	"gorm.io/gorm"
+)
+
+// missingKolRepository 查不到任何KOL的仓储，用于确认请求通过了参数校验
+type missingKolRepository struct {
+	mysql.KolRepository
+}
+
+func (r *missingKolRepository) GetKolByUserID(userID int64) (*mysql.Kol, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func newWithdrawalTestService(t *testing.T) KolEarningService {
+	t.Helper()
+	previous := config.GlobalConfig
+	t.Cleanup(func() { config.GlobalConfig = previous })
+	config.GlobalConfig = &config.Config{KolEarning: config.KolEarningConfig{MinWithdrawalAmount: 10}}
+
+	registry := &chain.Registry{}
+	bep20, err := chain.NewNetwork("BEP-20", 1, nil, chain.NewEVMVerifier(nil))
+	if err != nil {
+		t.Fatalf("new network: %v", err)
+	}
+	registry.Register(bep20)
+
+	return NewKolEarningService(nil, &missingKolRepository{}, nil, nil, nil, nil, registry)
+}
+
+func TestCreateWithdrawalOrderValidatesPayoutAddress(t *testing.T) {
+	svc := newWithdrawalTestService(t)
+	amount := money.FromCents(2000)
+
+	tests := []struct {
+		name    string
+		network string
+		address string
+		errPart string
+	}{
+		{name: "unsupported network", network: "SOL - Solana", address: "0x52908400098527886E0F7030069857D2E4169EE7", errPart: "unsupported network"},
+		{name: "malformed address", network: "BEP-20 - BNB Smart Chain", address: "0x1234", errPart: "invalid BEP-20 address"},
+		{name: "address from another chain", network: "BEP-20", address: "TJRyWwFs9wTFGZg3JbrVriFbNfCug5tDeC", errPart: "invalid BEP-20 address"},
+		// 校验通过后才会查询KOL
+		{name: "valid address", network: "BEP-20 - BNB Smart Chain", address: " 0x52908400098527886e0f7030069857d2e4169ee7 ", errPart: "kol profile not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateWithdrawalOrder(1, amount, tt.network, tt.address, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.errPart) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.errPart)
+			}
+		})
+	}
+}
+
+func TestCreateWithdrawalOrderWithoutChainConfig(t *testing.T) {
+	newWithdrawalTestService(t)
+	svc := NewKolEarningService(nil, &missingKolRepository{}, nil, nil, nil, nil, nil)
+
+	_, err := svc.CreateWithdrawalOrder(1, money.FromCents(2000), "BEP-20", "0x52908400098527886E0F7030069857D2E4169EE7", nil)
+	if err == nil || !strings.Contains(err.Error(), "unsupported network") {
+		t.Fatalf("err = %v, want unsupported network", err)
+	}
+}
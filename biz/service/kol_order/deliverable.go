@@ -0,0 +1,382 @@
+package kol_order
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	kolOrderModel "orbia_api/biz/model/kol_order"
+	"orbia_api/biz/utils"
+)
+
+const (
+	// deliverableMaxURLs 每次交付的视频链接和文件各自的最大数量
+	deliverableMaxURLs = 10
+	// deliverableURLMaxLength 视频链接和文件URL的最大长度（与 url 字段一致）
+	deliverableURLMaxLength = 1000
+	// autoAcceptBatchSize 自动验收任务每批处理的订单数
+	autoAcceptBatchSize = 100
+)
+
+// SubmitKolOrderDeliverable 提交交付内容（KOL使用），订单从进行中变为已交付，等待买家验收
+func SubmitKolOrderDeliverable(userID int64, req *kolOrderModel.SubmitKolOrderDeliverableReq) (*kolOrderModel.SubmitKolOrderDeliverableResp, error) {
+	resp := &kolOrderModel.SubmitKolOrderDeliverableResp{}
+
+	// 1. 校验交付内容
+	videoURLs, fileURLs, err := normalizeDeliverableURLs(req.VideoUrls, req.FileUrls)
+	if err != nil {
+		return nil, err
+	}
+	var note *string
+	if req.Note != nil {
+		trimmed := strings.TrimSpace(*req.Note)
+		if len([]rune(trimmed)) > reviewContentMaxLength {
+			return nil, fmt.Errorf("交付说明不能超过%d字", reviewContentMaxLength)
+		}
+		if trimmed != "" {
+			note = &trimmed
+		}
+	}
+
+	// 2. 验证用户是否是 KOL
+	kol, err := kolRepo.GetKolByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("您还不是 KOL，无法提交交付内容")
+		}
+		return nil, fmt.Errorf("获取 KOL 信息失败: %w", err)
+	}
+
+	// 3. 获取订单并验证是否属于该 KOL
+	order, err := orderRepo.GetOrderByID(req.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("订单不存在")
+		}
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+	if order.KolID != kol.ID {
+		return nil, fmt.Errorf("无权操作该订单")
+	}
+	if order.Status != "in_progress" {
+		return nil, fmt.Errorf("只能为进行中的订单提交交付内容")
+	}
+
+	// 4. 确定提交轮次（买家申请修改后重新提交时轮次加一）
+	round := int32(1)
+	latest, err := deliverableRepo.GetLatestDeliverable(order.OrderID)
+	if err == nil {
+		round = latest.Round + 1
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("获取交付内容失败: %w", err)
+	}
+
+	deliverable := &mysql.KolOrderDeliverable{
+		OrderID: order.OrderID,
+		KolID:   kol.ID,
+		Round:   round,
+		Note:    note,
+		Status:  mysql.DeliverableStatusSubmitted,
+	}
+	for _, videoURL := range videoURLs {
+		deliverable.Items = append(deliverable.Items, &mysql.KolOrderDeliverableItem{ItemType: mysql.DeliverableItemVideo, URL: videoURL})
+	}
+	for _, fileURL := range fileURLs {
+		deliverable.Items = append(deliverable.Items, &mysql.KolOrderDeliverableItem{ItemType: mysql.DeliverableItemFile, URL: fileURL})
+	}
+
+	// 5. 在事务中保存交付内容并更新订单为已交付；仅当订单仍为进行中时生效，防止并发重复提交
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := orderRepo.UpdateOrderStatusFromWithTx(tx, order.OrderID, "in_progress", "delivered", nil); err != nil {
+			return fmt.Errorf("更新订单状态失败: %w", err)
+		}
+		if err := deliverableRepo.CreateDeliverableWithTx(tx, deliverable); err != nil {
+			return fmt.Errorf("保存交付内容失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.DeliverableID = &deliverable.ID
+	return resp, nil
+}
+
+// GetKolOrderDeliverables 获取订单的交付内容（下单用户或KOL使用）
+func GetKolOrderDeliverables(userID int64, req *kolOrderModel.GetKolOrderDeliverablesReq) (*kolOrderModel.GetKolOrderDeliverablesResp, error) {
+	resp := &kolOrderModel.GetKolOrderDeliverablesResp{}
+
+	order, err := orderRepo.GetOrderByID(req.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("订单不存在")
+		}
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+
+	// 只有订单创建者和订单相关的KOL可以查看
+	userKol, _ := kolRepo.GetKolByUserID(userID)
+	isKolOwner := userKol != nil && userKol.ID == order.KolID
+	if order.UserID != userID && !isKolOwner {
+		return nil, fmt.Errorf("无权查看该订单")
+	}
+
+	deliverables, err := deliverableRepo.GetDeliverablesByOrderID(order.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("获取交付内容失败: %w", err)
+	}
+
+	resp.Deliverables = make([]*kolOrderModel.KolOrderDeliverableInfo, 0, len(deliverables))
+	for _, deliverable := range deliverables {
+		resp.Deliverables = append(resp.Deliverables, convertToDeliverableInfo(deliverable))
+	}
+
+	return resp, nil
+}
+
+// AcceptKolOrderDeliverable 验收交付内容（下单用户使用），订单完成并将托管资金结算给 KOL
+func AcceptKolOrderDeliverable(userID int64, req *kolOrderModel.AcceptKolOrderDeliverableReq) (*kolOrderModel.AcceptKolOrderDeliverableResp, error) {
+	resp := &kolOrderModel.AcceptKolOrderDeliverableResp{}
+
+	order, err := orderRepo.GetOrderByID(req.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("订单不存在")
+		}
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+	if order.UserID != userID {
+		return nil, fmt.Errorf("无权操作该订单")
+	}
+	if order.Status != "delivered" {
+		return nil, fmt.Errorf("订单尚未交付，无法验收")
+	}
+
+	if err := acceptDelivery(order, false); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RequestKolOrderRevision 申请修改交付内容（下单用户使用），订单回到进行中，等待 KOL 重新提交
+func RequestKolOrderRevision(userID int64, req *kolOrderModel.RequestKolOrderRevisionReq) (*kolOrderModel.RequestKolOrderRevisionResp, error) {
+	resp := &kolOrderModel.RequestKolOrderRevisionResp{}
+
+	feedback := strings.TrimSpace(req.Feedback)
+	if feedback == "" {
+		return nil, fmt.Errorf("修改意见不能为空")
+	}
+	if len([]rune(feedback)) > reviewContentMaxLength {
+		return nil, fmt.Errorf("修改意见不能超过%d字", reviewContentMaxLength)
+	}
+
+	order, err := orderRepo.GetOrderByID(req.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("订单不存在")
+		}
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+	if order.UserID != userID {
+		return nil, fmt.Errorf("无权操作该订单")
+	}
+	if order.Status != "delivered" {
+		return nil, fmt.Errorf("订单尚未交付，无法申请修改")
+	}
+	if order.RevisionCount >= order.PlanMaxRevisions {
+		return nil, fmt.Errorf("已达到该报价计划的修改次数上限（%d次），请验收订单", order.PlanMaxRevisions)
+	}
+
+	deliverable, err := deliverableRepo.GetLatestDeliverable(order.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("获取交付内容失败: %w", err)
+	}
+
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := orderRepo.RequestOrderRevisionWithTx(tx, order.OrderID); err != nil {
+			return fmt.Errorf("更新订单状态失败: %w", err)
+		}
+		if err := deliverableRepo.ReviewDeliverableWithTx(tx, deliverable.ID, mysql.DeliverableStatusRevisionRequested, &feedback, false); err != nil {
+			return fmt.Errorf("更新交付内容状态失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AutoAcceptDeliveredOrders 自动验收超时未处理的已交付订单，返回验收的订单数
+// 单个订单验收失败只记录日志，不影响其他订单
+func AutoAcceptDeliveredOrders() (int, error) {
+	days := config.GlobalConfig.KolOrder.AutoAcceptDays
+	if days <= 0 {
+		return 0, nil
+	}
+	deliveredBefore := time.Now().AddDate(0, 0, -days)
+
+	accepted := 0
+	for {
+		orders, err := orderRepo.GetDeliveredOrdersBefore(deliveredBefore, autoAcceptBatchSize)
+		if err != nil {
+			return accepted, fmt.Errorf("获取待自动验收订单失败: %w", err)
+		}
+
+		failed := 0
+		for _, order := range orders {
+			if err := acceptDelivery(order, true); err != nil {
+				hlog.Errorf("Failed to auto-accept kol order %s: %v", order.OrderID, err)
+				failed++
+				continue
+			}
+			accepted++
+		}
+
+		// 已处理完，或本批全部验收失败时结束，避免反复处理同一批失败订单
+		if len(orders) < autoAcceptBatchSize || failed == len(orders) {
+			break
+		}
+	}
+
+	return accepted, nil
+}
+
+// StartAutoAcceptJob 启动已交付订单的定时自动验收任务，interval <= 0 时不启动
+func StartAutoAcceptJob(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			accepted, err := AutoAcceptDeliveredOrders()
+			if err != nil {
+				hlog.Errorf("KOL order auto-accept failed: %v", err)
+			}
+			if accepted > 0 {
+				hlog.Infof("Auto-accepted %d delivered KOL orders", accepted)
+			}
+		}
+	}()
+}
+
+// acceptDelivery 验收订单最近一次交付内容：订单完成并结算托管资金给 KOL（在事务中执行）
+func acceptDelivery(order *mysql.KolOrder, autoAccepted bool) error {
+	kol, err := kolRepo.GetKolByID(order.KolID)
+	if err != nil {
+		return fmt.Errorf("获取 KOL 信息失败: %w", err)
+	}
+
+	deliverable, err := deliverableRepo.GetLatestDeliverable(order.OrderID)
+	if err != nil {
+		return fmt.Errorf("获取交付内容失败: %w", err)
+	}
+
+	return mysql.DB.Transaction(func(tx *gorm.DB) error {
+		// 仅当订单仍为已交付时生效，防止买家验收与自动验收并发重复结算
+		if err := orderRepo.UpdateOrderStatusFromWithTx(tx, order.OrderID, "delivered", "completed", nil); err != nil {
+			return fmt.Errorf("更新订单状态失败: %w", err)
+		}
+		if err := deliverableRepo.ReviewDeliverableWithTx(tx, deliverable.ID, mysql.DeliverableStatusAccepted, nil, autoAccepted); err != nil {
+			return fmt.Errorf("更新交付内容状态失败: %w", err)
+		}
+		return settleOrderPayment(tx, order, kol.UserID, order.PlanPrice)
+	})
+}
+
+// orderAutoAcceptAt 计算已交付订单的自动验收时间，未开启自动验收或订单不是已交付状态时返回 nil
+func orderAutoAcceptAt(order *mysql.KolOrder) *time.Time {
+	days := config.GlobalConfig.KolOrder.AutoAcceptDays
+	if order.Status != "delivered" || order.DeliveredAt == nil || days <= 0 {
+		return nil
+	}
+	autoAcceptAt := order.DeliveredAt.AddDate(0, 0, days)
+	return &autoAcceptAt
+}
+
+// normalizeDeliverableURLs 校验并去除空白的视频链接和文件URL
+// 视频链接必须是 TikTok、YouTube 等允许的外部平台地址；文件必须是平台上传的文件
+func normalizeDeliverableURLs(videoURLs, fileURLs []string) ([]string, []string, error) {
+	videos := make([]string, 0, len(videoURLs))
+	for _, raw := range videoURLs {
+		videoURL := strings.TrimSpace(raw)
+		if videoURL == "" {
+			continue
+		}
+		if len(videoURL) > deliverableURLMaxLength {
+			return nil, nil, fmt.Errorf("视频链接长度不能超过%d个字符", deliverableURLMaxLength)
+		}
+		if isValid, errorMessage := utils.ValidatePlatformVideoURL(videoURL); !isValid {
+			return nil, nil, fmt.Errorf("视频链接无效: %s", errorMessage)
+		}
+		videos = append(videos, videoURL)
+	}
+
+	files := make([]string, 0, len(fileURLs))
+	for _, raw := range fileURLs {
+		fileURL := strings.TrimSpace(raw)
+		if fileURL == "" {
+			continue
+		}
+		if len(fileURL) > deliverableURLMaxLength {
+			return nil, nil, fmt.Errorf("文件URL长度不能超过%d个字符", deliverableURLMaxLength)
+		}
+		if isValid, errorMessage := utils.ValidateFileURL(fileURL); !isValid {
+			return nil, nil, fmt.Errorf("文件URL无效: %s", errorMessage)
+		}
+		files = append(files, fileURL)
+	}
+
+	if len(videos) == 0 && len(files) == 0 {
+		return nil, nil, fmt.Errorf("请至少提供一个视频链接或文件")
+	}
+	if len(videos) > deliverableMaxURLs || len(files) > deliverableMaxURLs {
+		return nil, nil, fmt.Errorf("视频链接和文件各最多%d个", deliverableMaxURLs)
+	}
+
+	return videos, files, nil
+}
+
+// convertToDeliverableInfo 转换为交付内容信息模型
+func convertToDeliverableInfo(deliverable *mysql.KolOrderDeliverable) *kolOrderModel.KolOrderDeliverableInfo {
+	info := &kolOrderModel.KolOrderDeliverableInfo{
+		ID:           deliverable.ID,
+		OrderID:      deliverable.OrderID,
+		Round:        deliverable.Round,
+		VideoUrls:    make([]string, 0),
+		FileUrls:     make([]string, 0),
+		Note:         strPtrToOptional(deliverable.Note),
+		Status:       deliverable.Status,
+		Feedback:     strPtrToOptional(deliverable.Feedback),
+		AutoAccepted: deliverable.AutoAccepted,
+		CreatedAt:    deliverable.CreatedAt.Format(time.RFC3339),
+	}
+
+	for _, item := range deliverable.Items {
+		if item.ItemType == mysql.DeliverableItemVideo {
+			info.VideoUrls = append(info.VideoUrls, item.URL)
+		} else {
+			info.FileUrls = append(info.FileUrls, item.URL)
+		}
+	}
+
+	if deliverable.ReviewedAt != nil {
+		reviewedAt := deliverable.ReviewedAt.Format(time.RFC3339)
+		info.ReviewedAt = &reviewedAt
+	}
+
+	return info
+}
@@ -1,6 +1,7 @@
 package kol_order
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -9,17 +10,26 @@ import (
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
 	kolOrderModel "orbia_api/biz/model/kol_order"
+	"orbia_api/biz/service/audit"
 	conversationService "orbia_api/biz/service/conversation"
+	"orbia_api/biz/service/ledger"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 )
 
 var (
-	orderRepo  mysql.OrderRepository
-	kolRepo    mysql.KolRepository
-	convSvc    conversationService.ConversationService
-	walletRepo mysql.WalletRepository
-	txRepo     mysql.TransactionRepository
+	orderRepo       mysql.OrderRepository
+	kolRepo         mysql.KolRepository
+	convSvc         conversationService.ConversationService
+	walletRepo      mysql.WalletRepository
+	txRepo          mysql.TransactionRepository
+	kolEarningRepo  mysql.KolEarningRepository
+	ledgerSvc       ledger.LedgerService
+	auditSvc        audit.AuditService
+	reviewRepo      mysql.KolReviewRepository
+	deliverableRepo mysql.KolOrderDeliverableRepository
 )
 
 // InitKolOrderService 初始化KOL订单服务
@@ -30,7 +40,12 @@ func InitKolOrderService() {
 	userRepo := mysql.NewUserRepository(mysql.DB)
 	walletRepo = mysql.NewWalletRepository(mysql.DB)
 	txRepo = mysql.NewTransactionRepository(mysql.DB)
+	kolEarningRepo = mysql.NewKolEarningRepository(mysql.DB)
+	ledgerSvc = ledger.NewLedgerService(mysql.DB, mysql.NewLedgerRepository(mysql.DB))
 	convSvc = conversationService.NewConversationService(convRepo, userRepo)
+	auditSvc = audit.NewAuditService(mysql.NewAuditLogRepository(mysql.DB))
+	reviewRepo = mysql.NewKolReviewRepository(mysql.DB)
+	deliverableRepo = mysql.NewKolOrderDeliverableRepository(mysql.DB)
 }
 
 // CreateKolOrder 创建KOL订单
@@ -99,6 +114,7 @@ func CreateKolOrder(userID int64, req *kolOrderModel.CreateKolOrderReq) (*kolOrd
 		PlanDescription:        plan.Description,
 		PlanPrice:              plan.Price,
 		PlanType:               plan.PlanType,
+		PlanMaxRevisions:       plan.MaxRevisions,
 		Title:                  req.Title,
 		RequirementDescription: req.RequirementDescription,
 		VideoType:              req.VideoType,
@@ -143,6 +159,9 @@ func GetKolOrder(userID int64, req *kolOrderModel.GetKolOrderReq) (*kolOrderMode
 
 	// 3. 转换为响应模型
 	resp.Order = convertToKolOrderInfo(orderWithKol)
+	if err := attachOrderReviews([]*kolOrderModel.KolOrderInfo{resp.Order}); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
@@ -165,6 +184,9 @@ func GetUserKolOrderList(userID int64, req *kolOrderModel.GetUserKolOrderListReq
 	for _, order := range orders {
 		resp.Orders = append(resp.Orders, convertToKolOrderInfo(order))
 	}
+	if err := attachOrderReviews(resp.Orders); err != nil {
+		return nil, err
+	}
 	resp.Total = total
 
 	return resp, nil
@@ -198,6 +220,9 @@ func GetKolReceivedOrderList(userID int64, req *kolOrderModel.GetKolReceivedOrde
 	for _, order := range orders {
 		resp.Orders = append(resp.Orders, convertToKolOrderInfo(order))
 	}
+	if err := attachOrderReviews(resp.Orders); err != nil {
+		return nil, err
+	}
 	resp.Total = total
 
 	return resp, nil
@@ -230,11 +255,11 @@ func UpdateKolOrderStatus(userID int64, req *kolOrderModel.UpdateKolOrderStatusR
 		return nil, fmt.Errorf("无权操作该订单")
 	}
 
-	// 4. 验证状态转换是否合法
+	// 4. 验证状态转换是否合法（完成订单需提交交付内容并由买家验收）
 	validTransitions := map[string][]string{
 		"pending":     {"confirmed", "cancelled"},   // 待确认 -> 已确认/已取消
 		"confirmed":   {"in_progress", "cancelled"}, // 已确认 -> 进行中/已取消
-		"in_progress": {"completed", "cancelled"},   // 进行中 -> 已完成/已取消
+		"in_progress": {"cancelled"},                // 进行中 -> 已取消
 	}
 
 	allowedStatuses, exists := validTransitions[order.Status]
@@ -253,9 +278,25 @@ func UpdateKolOrderStatus(userID int64, req *kolOrderModel.UpdateKolOrderStatusR
 		return nil, fmt.Errorf("不允许从 %s 状态转换到 %s 状态", order.Status, req.Status)
 	}
 
-	// 5. 更新订单状态
-	if err := orderRepo.UpdateOrderStatus(req.OrderID, req.Status, req.RejectReason); err != nil {
-		return nil, fmt.Errorf("更新订单状态失败: %w", err)
+	// 5. 更新订单状态，取消时同时退还托管资金
+	switch req.Status {
+	case "cancelled":
+		// KOL 拒绝/取消订单：托管资金全额退款给买家，订单变为已退款
+		reason := "KOL取消订单"
+		if req.RejectReason != nil && *req.RejectReason != "" {
+			reason = *req.RejectReason
+		}
+		err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+			return refundOrderPayment(tx, order, kol.UserID, order.PlanPrice, reason)
+		})
+	default:
+		// 仅当订单仍为读取时的状态才更新，防止覆盖并发的买家取消或管理员退款
+		if err = orderRepo.UpdateOrderStatusFromWithTx(nil, req.OrderID, order.Status, req.Status, req.RejectReason); err != nil {
+			err = fmt.Errorf("更新订单状态失败: %w", err)
+		}
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -284,67 +325,15 @@ func ConfirmKolOrderPayment(userID int64, req *kolOrderModel.ConfirmKolOrderPaym
 		return nil, fmt.Errorf("订单状态不是待支付，无法确认支付")
 	}
 
-	// 4. 获取用户钱包信息
-	wallet, err := walletRepo.GetWalletByUserID(userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("钱包不存在，请先创建钱包")
-		}
-		return nil, fmt.Errorf("获取钱包信息失败: %w", err)
-	}
-
-	// 5. 检查钱包余额是否充足
-	if wallet.Balance < order.PlanPrice {
-		return nil, fmt.Errorf("钱包余额不足，当前余额: %.2f USD，订单金额: %.2f USD", wallet.Balance, order.PlanPrice)
-	}
-
-	// 6. 在事务中执行扣款、创建交易记录和更新订单状态
+	// 4. 在事务中更新订单状态并冻结订单金额（托管，待订单完成后结算给KOL）
 	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
-		// 6.1 扣除钱包余额（负值表示减少）
-		if err := walletRepo.UpdateBalance(tx, userID, -order.PlanPrice, 0); err != nil {
-			return fmt.Errorf("扣除钱包余额失败: %w", err)
-		}
-
-		// 6.2 更新钱包的累计消费金额
-		if err := tx.Model(&model.OrbiaWallet{}).
-			Where("user_id = ?", userID).
-			Update("total_consume", gorm.Expr("total_consume + ?", order.PlanPrice)).Error; err != nil {
-			return fmt.Errorf("更新累计消费金额失败: %w", err)
-		}
-
-		// 6.3 生成交易ID
-		transactionID := fmt.Sprintf("TXN%d", time.Now().UnixNano())
-
-		// 6.4 创建交易记录
-		now := time.Now()
-		relatedOrderType := "kol_order"
-		remark := fmt.Sprintf("支付KOL订单：%s", order.Title)
-		transaction := &model.OrbiaTransaction{
-			TransactionID:    transactionID,
-			UserID:           userID,
-			Type:             "consume",
-			Amount:           order.PlanPrice,
-			BalanceBefore:    wallet.Balance,
-			BalanceAfter:     wallet.Balance - order.PlanPrice,
-			Status:           "completed",
-			RelatedOrderType: &relatedOrderType,
-			RelatedOrderID:   &order.OrderID,
-			Remark:           &remark,
-			CompletedAt:      &now,
-		}
-
-		if err := txRepo.CreateTransaction(tx, transaction); err != nil {
-			return fmt.Errorf("创建交易记录失败: %w", err)
-		}
-
-		// 6.5 更新订单状态为待确认（等待KOL确认）
-		if err := tx.Model(&mysql.KolOrder{}).
-			Where("order_id = ?", req.OrderID).
-			Update("status", "pending").Error; err != nil {
+		// 4.1 更新订单状态为待确认（等待KOL确认）；仅当订单仍为待支付时生效，防止并发重复支付
+		if err := orderRepo.UpdateOrderStatusFromWithTx(tx, req.OrderID, "pending_payment", "pending", nil); err != nil {
 			return fmt.Errorf("更新订单状态失败: %w", err)
 		}
 
-		return nil
+		// 4.2 将订单金额从可用余额转入冻结余额
+		return freezeOrderPayment(tx, order)
 	})
 
 	if err != nil {
@@ -377,15 +366,343 @@ func CancelKolOrder(userID int64, req *kolOrderModel.CancelKolOrderReq) (*kolOrd
 	if order.Status == "completed" || order.Status == "cancelled" || order.Status == "refunded" {
 		return nil, fmt.Errorf("该订单无法取消")
 	}
+	// KOL 已交付的订单需验收或申请修改，有争议时由管理员退款处理
+	if order.Status == "delivered" {
+		return nil, fmt.Errorf("KOL 已交付，请验收或申请修改")
+	}
+
+	// 4. 未支付的订单直接取消；仅当订单仍为读取时的状态才更新，防止覆盖并发的支付
+	if !isEscrowStatus(order.Status) {
+		if err := orderRepo.UpdateOrderStatusFromWithTx(nil, req.OrderID, order.Status, "cancelled", &req.Reason); err != nil {
+			return nil, fmt.Errorf("取消订单失败: %w", err)
+		}
+		return resp, nil
+	}
+
+	// 5. 已支付的订单在事务中全额退款，订单变为已退款
+	kol, err := kolRepo.GetKolByID(order.KolID)
+	if err != nil {
+		return nil, fmt.Errorf("获取 KOL 信息失败: %w", err)
+	}
+
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		return refundOrderPayment(tx, order, kol.UserID, order.PlanPrice, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AdminRefundKolOrder 管理员退款（纠纷处理，支持全额和部分退款）
+func AdminRefundKolOrder(ctx context.Context, adminUserID int64, req *kolOrderModel.AdminRefundKolOrderReq) (*kolOrderModel.AdminRefundKolOrderResp, error) {
+	resp := &kolOrderModel.AdminRefundKolOrderResp{}
+
+	if req.Reason == "" {
+		return nil, fmt.Errorf("退款原因不能为空")
+	}
+
+	// 1. 获取订单
+	order, err := orderRepo.GetOrderByID(req.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("订单不存在")
+		}
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+
+	// 2. 只有已支付（资金托管中）或已完成的订单可以退款
+	if !isEscrowStatus(order.Status) && order.Status != "completed" {
+		return nil, fmt.Errorf("当前订单状态无法退款")
+	}
+
+	// 3. 确定退款金额，未指定时全额退款
+	amount := order.PlanPrice
+	if req.Amount != nil {
+		amount, err = money.Parse(*req.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("退款金额格式错误: %w", err)
+		}
+	}
+	if amount <= 0 || amount > order.PlanPrice {
+		return nil, fmt.Errorf("退款金额必须大于0且不超过订单金额 %s USD", order.PlanPrice)
+	}
 
-	// 4. 取消订单
-	if err := orderRepo.UpdateOrderStatus(req.OrderID, "cancelled", &req.Reason); err != nil {
-		return nil, fmt.Errorf("取消订单失败: %w", err)
+	// 4. 获取 KOL 信息（部分退款时剩余金额需结算给 KOL，已完成订单需从 KOL 收益扣回）
+	kol, err := kolRepo.GetKolByID(order.KolID)
+	if err != nil {
+		return nil, fmt.Errorf("获取 KOL 信息失败: %w", err)
 	}
 
+	reason := fmt.Sprintf("[Admin %d]: %s", adminUserID, req.Reason)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := refundOrderPayment(tx, order, kol.UserID, amount, reason); err != nil {
+			return err
+		}
+
+		return auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionKolOrderRefund,
+			TargetType: audit.TargetKolOrder,
+			TargetID:   order.OrderID,
+			Before:     map[string]interface{}{"status": order.Status, "plan_price": order.PlanPrice},
+			After:      map[string]interface{}{"status": "refunded", "refund_amount": amount, "reason": req.Reason},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refundAmount := amount.String()
+	resp.RefundAmount = &refundAmount
 	return resp, nil
 }
 
+// isEscrowStatus 判断订单资金是否处于托管（冻结）状态
+// 支付后至完成/取消前，订单金额都冻结在买家钱包中
+func isEscrowStatus(status string) bool {
+	switch status {
+	case "pending", "confirmed", "in_progress", "delivered":
+		return true
+	default:
+		return false
+	}
+}
+
+// freezeOrderPayment 冻结订单金额（可用余额 -> 冻结余额，在事务中执行）
+// 钱包加行锁后再检查余额，避免并发支付同时通过余额检查
+func freezeOrderPayment(tx *gorm.DB, order *mysql.KolOrder) error {
+	wallet, err := walletRepo.GetWalletByUserIDForUpdate(tx, order.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("钱包不存在，请先创建钱包")
+		}
+		return fmt.Errorf("获取钱包信息失败: %w", err)
+	}
+
+	if wallet.Balance < order.PlanPrice {
+		return fmt.Errorf("钱包余额不足，当前余额: %s USD，订单金额: %s USD", wallet.Balance, order.PlanPrice)
+	}
+
+	remark := fmt.Sprintf("支付KOL订单（资金托管）：%s", order.Title)
+	if _, err := ledgerSvc.Post(tx, &ledger.Entry{
+		EntryType:     ledger.EntryKolOrderPayment,
+		ReferenceType: "kol_order",
+		ReferenceID:   order.OrderID,
+		Remark:        remark,
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.UserWallet(order.UserID), order.PlanPrice),
+			ledger.Credit(ledger.UserWalletFrozen(order.UserID), order.PlanPrice),
+		},
+	}); err != nil {
+		return fmt.Errorf("冻结订单金额失败: %w", err)
+	}
+
+	return createOrderTransaction(tx, order.UserID, "freeze", order, order.PlanPrice, wallet.Balance, wallet.Balance-order.PlanPrice, remark)
+}
+
+// settleOrderPayment 结算托管资金（在事务中执行）
+// 从买家冻结余额中扣除 amount 计入累计消费，扣除平台佣金后计入 KOL 收益账户
+func settleOrderPayment(tx *gorm.DB, order *mysql.KolOrder, kolUserID int64, amount money.Amount) error {
+	buyerWallet, err := walletRepo.GetWalletByUserIDForUpdate(tx, order.UserID)
+	if err != nil {
+		return fmt.Errorf("获取买家钱包失败: %w", err)
+	}
+
+	earning, err := kolEarningRepo.GetOrCreateEarningWithTx(tx, order.KolID, kolUserID)
+	if err != nil {
+		return fmt.Errorf("获取KOL收益账户失败: %w", err)
+	}
+
+	commission, err := amount.MulRate(config.GlobalConfig.KolEarning.CommissionRate)
+	if err != nil {
+		return fmt.Errorf("计算平台佣金失败: %w", err)
+	}
+	income := amount - commission
+
+	// 1. 记账：买家冻结余额 -> KOL 收益 + 平台佣金
+	if _, err := ledgerSvc.Post(tx, &ledger.Entry{
+		EntryType:     ledger.EntryKolOrderSettle,
+		ReferenceType: "kol_order",
+		ReferenceID:   order.OrderID,
+		Remark:        fmt.Sprintf("KOL订单结算：%s", order.Title),
+		Lines: []ledger.Line{
+			ledger.Debit(ledger.UserWalletFrozen(order.UserID), amount),
+			ledger.Credit(ledger.KolEarning(order.KolID), income),
+			ledger.Credit(ledger.PlatformCommission, commission),
+		},
+	}); err != nil {
+		return fmt.Errorf("结算托管资金失败: %w", err)
+	}
+
+	// 2. 更新买家累计消费金额和 KOL 累计收益
+	if err := walletRepo.UpdateWalletTotals(tx, order.UserID, 0, amount); err != nil {
+		return fmt.Errorf("更新累计消费金额失败: %w", err)
+	}
+	if err := kolEarningRepo.UpdateEarningTotals(tx, order.KolID, income, commission, 0); err != nil {
+		return fmt.Errorf("更新KOL累计收益失败: %w", err)
+	}
+
+	// 3. 买家消费记录（从冻结余额扣除，可用余额不变）
+	remark := fmt.Sprintf("KOL订单结算（托管资金）：%s", order.Title)
+	if err := createOrderTransaction(tx, order.UserID, "consume", order, amount, buyerWallet.Balance, buyerWallet.Balance, remark); err != nil {
+		return err
+	}
+
+	// 4. KOL 收入流水
+	remark = fmt.Sprintf("KOL订单收入：%s", order.Title)
+	return createOrderEarningRecord(tx, earning, "income", order, income, commission, earning.Balance+income, remark)
+}
+
+// refundOrderPayment 订单退款（在事务中执行）
+// 资金托管中的订单：退款金额从冻结余额退回买家可用余额，部分退款时剩余金额结算给 KOL
+// 已完成的订单：退款金额按比例从 KOL 收益和平台佣金中扣回，并退回买家可用余额
+// 退款完成后订单状态变为 refunded
+func refundOrderPayment(tx *gorm.DB, order *mysql.KolOrder, kolUserID int64, amount money.Amount, reason string) error {
+	buyerWallet, err := walletRepo.GetWalletByUserIDForUpdate(tx, order.UserID)
+	if err != nil {
+		return fmt.Errorf("获取买家钱包失败: %w", err)
+	}
+
+	remark := fmt.Sprintf("KOL订单退款：%s（%s）", order.Title, reason)
+
+	if order.Status == "completed" {
+		// 1. 按结算时的佣金比例拆分退款：KOL 收益扣回 + 平台佣金退还 -> 买家可用余额
+		kolShare, commission, err := splitSettledRefund(tx, order, amount)
+		if err != nil {
+			return err
+		}
+
+		earning, err := kolEarningRepo.GetOrCreateEarningWithTx(tx, order.KolID, kolUserID)
+		if err != nil {
+			return fmt.Errorf("获取KOL收益账户失败: %w", err)
+		}
+
+		if _, err := ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryKolOrderRefund,
+			ReferenceType: "kol_order",
+			ReferenceID:   order.OrderID,
+			Remark:        remark,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.KolEarning(order.KolID), kolShare),
+				ledger.Debit(ledger.PlatformCommission, commission),
+				ledger.Credit(ledger.UserWallet(order.UserID), amount),
+			},
+		}); err != nil {
+			return fmt.Errorf("KOL收益余额不足，无法扣回退款: %w", err)
+		}
+
+		// 2. 冲减 KOL 累计收益和买家累计消费
+		if err := kolEarningRepo.UpdateEarningTotals(tx, order.KolID, -kolShare, -commission, 0); err != nil {
+			return fmt.Errorf("更新KOL累计收益失败: %w", err)
+		}
+		if err := walletRepo.UpdateWalletTotals(tx, order.UserID, 0, -amount); err != nil {
+			return fmt.Errorf("更新累计消费金额失败: %w", err)
+		}
+		if err := createOrderEarningRecord(tx, earning, "refund", order, kolShare, commission, earning.Balance-kolShare, remark); err != nil {
+			return err
+		}
+	} else {
+		// 1. 退款金额从冻结余额退回买家可用余额
+		if _, err := ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryKolOrderRefund,
+			ReferenceType: "kol_order",
+			ReferenceID:   order.OrderID,
+			Remark:        remark,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.UserWalletFrozen(order.UserID), amount),
+				ledger.Credit(ledger.UserWallet(order.UserID), amount),
+			},
+		}); err != nil {
+			return fmt.Errorf("退款入账失败: %w", err)
+		}
+	}
+
+	// 3. 买家退款记录
+	if err := createOrderTransaction(tx, order.UserID, "refund", order, amount, buyerWallet.Balance, buyerWallet.Balance+amount, remark); err != nil {
+		return err
+	}
+
+	// 4. 部分退款：托管中剩余的金额结算给 KOL
+	if order.Status != "completed" && amount < order.PlanPrice {
+		if err := settleOrderPayment(tx, order, kolUserID, order.PlanPrice-amount); err != nil {
+			return err
+		}
+	}
+
+	// 5. 更新订单状态为已退款
+	if err := orderRepo.MarkOrderRefundedWithTx(tx, order.OrderID, order.Status, amount, &reason); err != nil {
+		return fmt.Errorf("更新订单退款状态失败: %w", err)
+	}
+
+	return nil
+}
+
+// splitSettledRefund 拆分已完成订单的退款金额（在事务中执行）
+// 按该订单结算时 KOL 所得与平台佣金的比例拆分，返回 KOL 扣回金额和佣金退还金额
+func splitSettledRefund(tx *gorm.DB, order *mysql.KolOrder, amount money.Amount) (money.Amount, money.Amount, error) {
+	incomeRecord, err := kolEarningRepo.GetIncomeRecordByOrderIDWithTx(tx, order.KolID, order.OrderID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("获取订单收入流水失败: %w", err)
+	}
+
+	kolShare := amount
+	if settled := incomeRecord.Amount + incomeRecord.CommissionAmount; settled > 0 {
+		kolShare, err = amount.MulRatio(incomeRecord.Amount, settled)
+		if err != nil {
+			return 0, 0, fmt.Errorf("拆分退款金额失败: %w", err)
+		}
+	}
+	return kolShare, amount - kolShare, nil
+}
+
+// createOrderEarningRecord 创建KOL订单相关的收益流水（在事务中执行）
+func createOrderEarningRecord(tx *gorm.DB, earning *model.OrbiaKolEarning, recordType string, order *mysql.KolOrder, amount, commission, balanceAfter money.Amount, remark string) error {
+	relatedOrderType := "kol_order"
+	record := &model.OrbiaKolEarningRecord{
+		RecordID:         utils.GenerateTransactionID(),
+		KolID:            earning.KolID,
+		UserID:           earning.UserID,
+		Type:             recordType,
+		Amount:           amount,
+		CommissionAmount: commission,
+		BalanceBefore:    earning.Balance,
+		BalanceAfter:     balanceAfter,
+		RelatedOrderType: &relatedOrderType,
+		RelatedOrderID:   &order.OrderID,
+		Remark:           &remark,
+	}
+
+	if err := kolEarningRepo.CreateEarningRecord(tx, record); err != nil {
+		return fmt.Errorf("创建收益流水失败: %w", err)
+	}
+	return nil
+}
+
+// createOrderTransaction 创建KOL订单相关的交易记录（在事务中执行）
+func createOrderTransaction(tx *gorm.DB, userID int64, txType string, order *mysql.KolOrder, amount, balanceBefore, balanceAfter money.Amount, remark string) error {
+	now := time.Now()
+	relatedOrderType := "kol_order"
+	transaction := &model.OrbiaTransaction{
+		TransactionID:    utils.GenerateTransactionID(),
+		UserID:           userID,
+		Type:             txType,
+		Amount:           amount,
+		BalanceBefore:    balanceBefore,
+		BalanceAfter:     balanceAfter,
+		Status:           "completed",
+		RelatedOrderType: &relatedOrderType,
+		RelatedOrderID:   &order.OrderID,
+		Remark:           &remark,
+		CompletedAt:      &now,
+	}
+
+	if err := txRepo.CreateTransaction(tx, transaction); err != nil {
+		return fmt.Errorf("创建交易记录失败: %w", err)
+	}
+	return nil
+}
+
 // convertToKolOrderInfo 转换为 KOL 订单信息模型
 func convertToKolOrderInfo(order *mysql.OrderWithKolInfo) *kolOrderModel.KolOrderInfo {
 	planDesc := ""
@@ -400,7 +717,7 @@ func convertToKolOrderInfo(order *mysql.OrderWithKolInfo) *kolOrderModel.KolOrde
 		PlanID:                 order.PlanID,
 		PlanTitle:              order.PlanTitle,
 		PlanDescription:        planDesc,
-		PlanPrice:              order.PlanPrice,
+		PlanPrice:              order.PlanPrice.String(),
 		PlanType:               order.PlanType,
 		Title:                  order.Title,
 		RequirementDescription: order.RequirementDescription,
@@ -411,6 +728,8 @@ func convertToKolOrderInfo(order *mysql.OrderWithKolInfo) *kolOrderModel.KolOrde
 		AdditionalRequirements: strPtrToOptional(order.AdditionalRequirements),
 		Status:                 order.Status,
 		RejectReason:           strPtrToOptional(order.RejectReason),
+		MaxRevisions:           order.PlanMaxRevisions,
+		RevisionCount:          order.RevisionCount,
 		CreatedAt:              order.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:              order.UpdatedAt.Format(time.RFC3339),
 	}
@@ -440,6 +759,16 @@ func convertToKolOrderInfo(order *mysql.OrderWithKolInfo) *kolOrderModel.KolOrde
 		info.ConfirmedAt = &confirmedAt
 	}
 
+	if order.DeliveredAt != nil {
+		deliveredAt := order.DeliveredAt.Format(time.RFC3339)
+		info.DeliveredAt = &deliveredAt
+
+		if autoAcceptAt := orderAutoAcceptAt(&order.KolOrder); autoAcceptAt != nil {
+			formatted := autoAcceptAt.Format(time.RFC3339)
+			info.AutoAcceptAt = &formatted
+		}
+	}
+
 	if order.CompletedAt != nil {
 		completedAt := order.CompletedAt.Format(time.RFC3339)
 		info.CompletedAt = &completedAt
@@ -454,6 +783,16 @@ func convertToKolOrderInfo(order *mysql.OrderWithKolInfo) *kolOrderModel.KolOrde
 		info.ConversationID = order.ConversationID
 	}
 
+	if order.RefundAmount != nil {
+		refundAmount := order.RefundAmount.String()
+		info.RefundAmount = &refundAmount
+	}
+
+	if order.RefundedAt != nil {
+		refundedAt := order.RefundedAt.Format(time.RFC3339)
+		info.RefundedAt = &refundedAt
+	}
+
 	return info
 }
 
@@ -0,0 +1,288 @@
+package kol_order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"orbia_api/biz/dal/mysql"
+	kolOrderModel "orbia_api/biz/model/kol_order"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/utils"
+)
+
+const (
+	// reviewContentMaxLength 评价内容和KOL回复的最大长度（字符数）
+	reviewContentMaxLength = 2000
+	// reviewHiddenReasonMaxLength 隐藏原因的最大长度（与 hidden_reason 字段一致）
+	reviewHiddenReasonMaxLength = 500
+)
+
+// CreateKolOrderReview 创建订单评价（下单用户使用）
+func CreateKolOrderReview(userID int64, req *kolOrderModel.CreateKolOrderReviewReq) (*kolOrderModel.CreateKolOrderReviewResp, error) {
+	resp := &kolOrderModel.CreateKolOrderReviewResp{}
+
+	// 1. 校验评分和评价内容
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, fmt.Errorf("评分必须为1-5星")
+	}
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return nil, fmt.Errorf("评价内容不能为空")
+	}
+	if len([]rune(content)) > reviewContentMaxLength {
+		return nil, fmt.Errorf("评价内容不能超过%d字", reviewContentMaxLength)
+	}
+
+	// 2. 获取订单
+	order, err := orderRepo.GetOrderByID(req.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("订单不存在")
+		}
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+
+	// 3. 只有下单用户可以评价已完成的订单
+	if order.UserID != userID {
+		return nil, fmt.Errorf("无权评价该订单")
+	}
+	if order.Status != "completed" {
+		return nil, fmt.Errorf("只能评价已完成的订单")
+	}
+
+	// 4. 每个订单只能评价一次（order_id 唯一索引兜底并发重复提交）
+	if _, err := reviewRepo.GetReviewByOrderID(order.OrderID); err == nil {
+		return nil, fmt.Errorf("该订单已评价")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("获取订单评价失败: %w", err)
+	}
+
+	review := &mysql.KolReview{
+		OrderID: order.OrderID,
+		KolID:   order.KolID,
+		UserID:  userID,
+		Rating:  req.Rating,
+		Content: content,
+	}
+	if err := reviewRepo.CreateReview(review); err != nil {
+		return nil, fmt.Errorf("创建评价失败: %w", err)
+	}
+
+	resp.ReviewID = &review.ID
+	return resp, nil
+}
+
+// ReplyKolReview KOL公开回复评价（重复回复会覆盖之前的回复）
+func ReplyKolReview(userID int64, req *kolOrderModel.ReplyKolReviewReq) (*kolOrderModel.ReplyKolReviewResp, error) {
+	resp := &kolOrderModel.ReplyKolReviewResp{}
+
+	reply := strings.TrimSpace(req.Reply)
+	if reply == "" {
+		return nil, fmt.Errorf("回复内容不能为空")
+	}
+	if len([]rune(reply)) > reviewContentMaxLength {
+		return nil, fmt.Errorf("回复内容不能超过%d字", reviewContentMaxLength)
+	}
+
+	// 1. 验证用户是否是 KOL
+	kol, err := kolRepo.GetKolByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("您还不是 KOL，无法回复评价")
+		}
+		return nil, fmt.Errorf("获取 KOL 信息失败: %w", err)
+	}
+
+	// 2. 获取评价并验证是否属于该 KOL
+	review, err := reviewRepo.GetReviewByID(req.ReviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("评价不存在")
+		}
+		return nil, fmt.Errorf("获取评价失败: %w", err)
+	}
+	if review.KolID != kol.ID {
+		return nil, fmt.Errorf("无权回复该评价")
+	}
+
+	if err := reviewRepo.UpdateReviewReply(review.ID, reply); err != nil {
+		return nil, fmt.Errorf("回复评价失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetKolReviewList 获取KOL公开的评价列表和评分汇总
+func GetKolReviewList(req *kolOrderModel.GetKolReviewListReq) (*kolOrderModel.GetKolReviewListResp, error) {
+	resp := &kolOrderModel.GetKolReviewListResp{}
+
+	if _, err := kolRepo.GetKolByID(req.KolID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("KOL 不存在")
+		}
+		return nil, fmt.Errorf("获取 KOL 信息失败: %w", err)
+	}
+
+	page, pageSize := utils.GetPageParams(req.Page, req.PageSize)
+	offset := (page - 1) * pageSize
+
+	reviews, total, err := reviewRepo.GetKolVisibleReviews(req.KolID, offset, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取评价列表失败: %w", err)
+	}
+
+	ratings, err := kolRepo.GetKolRatingsByKolIDs([]int64{req.KolID})
+	if err != nil {
+		return nil, fmt.Errorf("获取评分失败: %w", err)
+	}
+	if rating, ok := ratings[req.KolID]; ok {
+		resp.AverageRating = rating.AverageRating
+		resp.ReviewCount = rating.ReviewCount
+	}
+
+	// 公开列表不展示隐藏原因等审核信息
+	resp.Reviews = make([]*kolOrderModel.KolReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		resp.Reviews = append(resp.Reviews, convertToKolReviewInfo(review, false))
+	}
+	resp.Total = total
+
+	return resp, nil
+}
+
+// AdminGetKolReviewList 管理员获取评价列表（包含已隐藏的评价）
+func AdminGetKolReviewList(req *kolOrderModel.AdminGetKolReviewListReq) (*kolOrderModel.AdminGetKolReviewListResp, error) {
+	resp := &kolOrderModel.AdminGetKolReviewListResp{}
+
+	page, pageSize := utils.GetPageParams(req.Page, req.PageSize)
+	offset := (page - 1) * pageSize
+
+	filter := &mysql.KolReviewFilter{
+		KolID:  req.KolID,
+		Rating: req.Rating,
+		Hidden: req.Hidden,
+	}
+	reviews, total, err := reviewRepo.GetAllReviews(filter, offset, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取评价列表失败: %w", err)
+	}
+
+	resp.Reviews = make([]*kolOrderModel.KolReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		resp.Reviews = append(resp.Reviews, convertToKolReviewInfo(review, true))
+	}
+	resp.Total = total
+
+	return resp, nil
+}
+
+// AdminModerateKolReview 管理员隐藏/恢复评价，隐藏的评价不公开展示、不计入KOL评分
+func AdminModerateKolReview(ctx context.Context, adminUserID int64, req *kolOrderModel.AdminModerateKolReviewReq) (*kolOrderModel.AdminModerateKolReviewResp, error) {
+	resp := &kolOrderModel.AdminModerateKolReviewResp{}
+
+	var reason *string
+	if req.Hidden {
+		if req.Reason == nil || strings.TrimSpace(*req.Reason) == "" {
+			return nil, fmt.Errorf("隐藏原因不能为空")
+		}
+		trimmed := strings.TrimSpace(*req.Reason)
+		if len([]rune(trimmed)) > reviewHiddenReasonMaxLength {
+			return nil, fmt.Errorf("隐藏原因不能超过%d字", reviewHiddenReasonMaxLength)
+		}
+		reason = &trimmed
+	}
+
+	review, err := reviewRepo.GetReviewByID(req.ReviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("评价不存在")
+		}
+		return nil, fmt.Errorf("获取评价失败: %w", err)
+	}
+
+	after := map[string]interface{}{"hidden": req.Hidden}
+	if reason != nil {
+		after["reason"] = *reason
+	}
+
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := reviewRepo.SetReviewHiddenWithTx(tx, review.ID, req.Hidden, reason, adminUserID); err != nil {
+			return fmt.Errorf("更新评价状态失败: %w", err)
+		}
+		return auditSvc.Record(ctx, tx, &audit.Entry{
+			Action:     audit.ActionKolReviewModerate,
+			TargetType: audit.TargetKolReview,
+			TargetID:   fmt.Sprintf("%d", review.ID),
+			Before:     map[string]interface{}{"hidden": review.Hidden, "order_id": review.OrderID, "kol_id": review.KolID},
+			After:      after,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// attachOrderReviews 为订单附加评价（下单用户和KOL查看自己的订单时使用，包含已隐藏的评价）
+func attachOrderReviews(orders []*kolOrderModel.KolOrderInfo) error {
+	orderIDs := make([]string, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.OrderID)
+	}
+
+	reviews, err := reviewRepo.GetReviewsByOrderIDs(orderIDs)
+	if err != nil {
+		return fmt.Errorf("获取订单评价失败: %w", err)
+	}
+	for _, order := range orders {
+		if review, ok := reviews[order.OrderID]; ok {
+			order.Review = convertToKolReviewInfo(&mysql.KolReviewWithUser{KolReview: *review}, true)
+		}
+	}
+	return nil
+}
+
+// convertToKolReviewInfo 转换为评价信息模型，withModeration 为 false 时不返回隐藏原因
+func convertToKolReviewInfo(review *mysql.KolReviewWithUser, withModeration bool) *kolOrderModel.KolReviewInfo {
+	info := &kolOrderModel.KolReviewInfo{
+		ReviewID:  review.ID,
+		OrderID:   review.OrderID,
+		KolID:     review.KolID,
+		UserID:    review.UserID,
+		Rating:    review.Rating,
+		Content:   review.Content,
+		Reply:     strPtrToOptional(review.Reply),
+		Hidden:    review.Hidden,
+		CreatedAt: review.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: review.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if review.KolDisplayName != nil {
+		info.KolDisplayName = *review.KolDisplayName
+	}
+
+	if review.UserNickname != nil {
+		info.UserNickname = *review.UserNickname
+	}
+
+	if review.UserAvatarURL != nil {
+		info.UserAvatarURL = *review.UserAvatarURL
+	}
+
+	if review.RepliedAt != nil {
+		repliedAt := review.RepliedAt.Format(time.RFC3339)
+		info.RepliedAt = &repliedAt
+	}
+
+	if withModeration {
+		info.HiddenReason = strPtrToOptional(review.HiddenReason)
+	}
+
+	return info
+}
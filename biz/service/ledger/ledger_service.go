@@ -0,0 +1,379 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// 账户类型
+// 用户钱包和KOL收益为负债类账户（贷方增加），平台资金为资产类账户（借方增加），平台收入和佣金为收入类账户（贷方增加），期初权益为权益类账户（贷方增加）
+const (
+	AccountUserWallet         = "user_wallet"         // 用户可用余额
+	AccountUserWalletFrozen   = "user_wallet_frozen"  // 用户冻结余额
+	AccountKolEarning         = "kol_earning"         // KOL可提现收益
+	AccountKolEarningFrozen   = "kol_earning_frozen"  // KOL提现中金额
+	AccountPlatformCash       = "platform_cash"       // 平台资金（充值流入、提现流出）
+	AccountPlatformRevenue    = "platform_revenue"    // 平台收入（Campaign消费）
+	AccountPlatformCommission = "platform_commission" // 平台佣金（KOL订单抽成）
+	AccountOpeningEquity      = "opening_equity"      // 期初权益（启用账本前已有余额的对方科目）
+)
+
+// 借贷方向
+const (
+	DirectionDebit  = "debit"
+	DirectionCredit = "credit"
+)
+
+// 分录类型
+const (
+	EntryRecharge          = "recharge"
+	EntryKolOrderPayment   = "kol_order_payment"
+	EntryKolOrderSettle    = "kol_order_settle"
+	EntryKolOrderRefund    = "kol_order_refund"
+	EntryCampaignConsume   = "campaign_consume"
+	EntryWithdrawalRequest = "withdrawal_request"
+	EntryWithdrawalPayout  = "withdrawal_payout"
+	EntryWithdrawalReject  = "withdrawal_reject"
+	EntryAccountMerge      = "account_merge"
+	EntryOpeningBalance    = "opening_balance" // 期初余额，仅由 sql/init.sql 在启用账本时写入
+)
+
+// Account 账本账户
+type Account struct {
+	Type    string
+	OwnerID int64
+}
+
+// UserWallet 用户可用余额账户
+func UserWallet(userID int64) Account {
+	return Account{Type: AccountUserWallet, OwnerID: userID}
+}
+
+// UserWalletFrozen 用户冻结余额账户
+func UserWalletFrozen(userID int64) Account {
+	return Account{Type: AccountUserWalletFrozen, OwnerID: userID}
+}
+
+// KolEarning KOL可提现收益账户
+func KolEarning(kolID int64) Account {
+	return Account{Type: AccountKolEarning, OwnerID: kolID}
+}
+
+// KolEarningFrozen KOL提现中金额账户
+func KolEarningFrozen(kolID int64) Account {
+	return Account{Type: AccountKolEarningFrozen, OwnerID: kolID}
+}
+
+// 平台账户
+var (
+	PlatformCash       = Account{Type: AccountPlatformCash}
+	PlatformRevenue    = Account{Type: AccountPlatformRevenue}
+	PlatformCommission = Account{Type: AccountPlatformCommission}
+)
+
+// Line 分录明细
+type Line struct {
+	Account   Account
+	Direction string
+	Amount    money.Amount
+}
+
+// Debit 借记
+func Debit(account Account, amount money.Amount) Line {
+	return Line{Account: account, Direction: DirectionDebit, Amount: amount}
+}
+
+// Credit 贷记
+func Credit(account Account, amount money.Amount) Line {
+	return Line{Account: account, Direction: DirectionCredit, Amount: amount}
+}
+
+// Entry 账本分录
+type Entry struct {
+	EntryType     string
+	ReferenceType string
+	ReferenceID   string
+	Remark        string
+	Lines         []Line
+}
+
+// WalletDrift 钱包对账差异
+type WalletDrift struct {
+	UserID              int64
+	WalletBalance       money.Amount
+	LedgerBalance       money.Amount
+	BalanceDrift        money.Amount
+	WalletFrozenBalance money.Amount
+	LedgerFrozenBalance money.Amount
+	FrozenDrift         money.Amount
+}
+
+// ReconcileReport 钱包对账报告
+type ReconcileReport struct {
+	WalletCount int
+	Drifts      []*WalletDrift
+	TotalDebit  money.Amount
+	TotalCredit money.Amount
+	Balanced    bool // 账本借贷是否平衡
+	CheckedAt   time.Time
+}
+
+// LedgerService 账本服务接口
+// 钱包和KOL收益账户的所有余额变动都必须通过 Post 记账
+type LedgerService interface {
+	// Post 记账：写入借贷平衡的分录，并同步更新钱包/KOL收益账户余额（在事务中执行）
+	Post(tx *gorm.DB, entry *Entry) (string, error)
+	// ReconcileWallets 按账本重新计算钱包余额，并报告与 orbia_wallet 的差异
+	ReconcileWallets(userID *int64) (*ReconcileReport, error)
+}
+
+// ledgerService 账本服务实现
+type ledgerService struct {
+	db         *gorm.DB
+	ledgerRepo mysql.LedgerRepository
+}
+
+// NewLedgerService 创建账本服务实例
+func NewLedgerService(db *gorm.DB, ledgerRepo mysql.LedgerRepository) LedgerService {
+	return &ledgerService{
+		db:         db,
+		ledgerRepo: ledgerRepo,
+	}
+}
+
+// balanceDelta 单个所有者的余额变动（可用/冻结）
+type balanceDelta struct {
+	balance money.Amount
+	frozen  money.Amount
+}
+
+// isZero 判断余额变动是否为 0（同一所有者的借贷相互抵消时无需更新）
+func (d *balanceDelta) isZero() bool {
+	return d.balance.IsZero() && d.frozen.IsZero()
+}
+
+// Post 记账
+func (s *ledgerService) Post(tx *gorm.DB, entry *Entry) (string, error) {
+	if tx == nil {
+		tx = s.db
+	}
+
+	if entry.EntryType == "" {
+		return "", errors.New("ledger entry type is required")
+	}
+
+	// 1. 校验借贷平衡；金额为 0 的明细直接忽略
+	var debitTotal, creditTotal money.Amount
+	lines := make([]Line, 0, len(entry.Lines))
+	for _, line := range entry.Lines {
+		if line.Amount < 0 {
+			return "", fmt.Errorf("ledger line amount must not be negative: %s %s", line.Account.Type, line.Amount)
+		}
+		if line.Amount.IsZero() {
+			continue
+		}
+
+		switch line.Direction {
+		case DirectionDebit:
+			debitTotal += line.Amount
+		case DirectionCredit:
+			creditTotal += line.Amount
+		default:
+			return "", fmt.Errorf("invalid ledger line direction: %s", line.Direction)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) < 2 {
+		return "", errors.New("ledger entry must have at least two lines")
+	}
+	if debitTotal != creditTotal {
+		return "", fmt.Errorf("ledger entry is not balanced: debit %s, credit %s", debitTotal, creditTotal)
+	}
+
+	// 2. 写入分录和明细
+	entryID := utils.GenerateLedgerEntryID()
+	entryModel := &model.OrbiaLedgerEntry{
+		EntryID:   entryID,
+		EntryType: entry.EntryType,
+	}
+	if entry.ReferenceType != "" {
+		entryModel.ReferenceType = &entry.ReferenceType
+	}
+	if entry.ReferenceID != "" {
+		entryModel.ReferenceID = &entry.ReferenceID
+	}
+	if entry.Remark != "" {
+		entryModel.Remark = &entry.Remark
+	}
+
+	lineModels := make([]*model.OrbiaLedgerLine, 0, len(lines))
+	for _, line := range lines {
+		lineModels = append(lineModels, &model.OrbiaLedgerLine{
+			EntryID:     entryID,
+			AccountType: line.Account.Type,
+			OwnerID:     line.Account.OwnerID,
+			Direction:   line.Direction,
+			Amount:      line.Amount,
+		})
+	}
+
+	if err := s.ledgerRepo.CreateEntry(tx, entryModel, lineModels); err != nil {
+		return "", fmt.Errorf("failed to create ledger entry: %v", err)
+	}
+
+	// 3. 同步钱包和KOL收益账户余额
+	if err := s.applyBalances(tx, lines); err != nil {
+		return "", err
+	}
+
+	return entryID, nil
+}
+
+// applyBalances 将分录明细汇总后更新到钱包和KOL收益账户
+// 负债类账户余额变动 = 贷方 - 借方；按所有者ID排序更新，保证加锁顺序一致
+func (s *ledgerService) applyBalances(tx *gorm.DB, lines []Line) error {
+	walletDeltas := make(map[int64]*balanceDelta)
+	earningDeltas := make(map[int64]*balanceDelta)
+
+	for _, line := range lines {
+		amount := line.Amount
+		if line.Direction == DirectionDebit {
+			amount = -amount
+		}
+
+		switch line.Account.Type {
+		case AccountUserWallet:
+			deltaFor(walletDeltas, line.Account.OwnerID).balance += amount
+		case AccountUserWalletFrozen:
+			deltaFor(walletDeltas, line.Account.OwnerID).frozen += amount
+		case AccountKolEarning:
+			deltaFor(earningDeltas, line.Account.OwnerID).balance += amount
+		case AccountKolEarningFrozen:
+			deltaFor(earningDeltas, line.Account.OwnerID).frozen += amount
+		}
+	}
+
+	for _, userID := range sortedOwners(walletDeltas) {
+		delta := walletDeltas[userID]
+		if delta.isZero() {
+			continue
+		}
+		if err := s.ledgerRepo.ApplyWalletDelta(tx, userID, delta.balance, delta.frozen); err != nil {
+			return fmt.Errorf("failed to update wallet balance: %v", err)
+		}
+	}
+
+	for _, kolID := range sortedOwners(earningDeltas) {
+		delta := earningDeltas[kolID]
+		if delta.isZero() {
+			continue
+		}
+		if err := s.ledgerRepo.ApplyKolEarningDelta(tx, kolID, delta.balance, delta.frozen); err != nil {
+			return fmt.Errorf("failed to update kol earning balance: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileWallets 钱包对账
+func (s *ledgerService) ReconcileWallets(userID *int64) (*ReconcileReport, error) {
+	rows, err := s.ledgerRepo.GetWalletReconciliation(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recompute wallet balances: %v", err)
+	}
+
+	totalDebit, totalCredit, err := s.ledgerRepo.GetTrialBalance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger trial balance: %v", err)
+	}
+
+	report := &ReconcileReport{
+		WalletCount: len(rows),
+		Drifts:      make([]*WalletDrift, 0),
+		TotalDebit:  totalDebit,
+		TotalCredit: totalCredit,
+		Balanced:    totalDebit == totalCredit,
+		CheckedAt:   time.Now(),
+	}
+
+	for _, row := range rows {
+		balanceDrift := row.WalletBalance - row.LedgerBalance
+		frozenDrift := row.WalletFrozenBalance - row.LedgerFrozenBalance
+		if balanceDrift.IsZero() && frozenDrift.IsZero() {
+			continue
+		}
+
+		report.Drifts = append(report.Drifts, &WalletDrift{
+			UserID:              row.UserID,
+			WalletBalance:       row.WalletBalance,
+			LedgerBalance:       row.LedgerBalance,
+			BalanceDrift:        balanceDrift,
+			WalletFrozenBalance: row.WalletFrozenBalance,
+			LedgerFrozenBalance: row.LedgerFrozenBalance,
+			FrozenDrift:         frozenDrift,
+		})
+	}
+
+	return report, nil
+}
+
+// StartReconcileJob 启动定时对账任务，发现差异时输出告警日志
+// interval 小于等于 0 时不启动
+func StartReconcileJob(svc LedgerService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			report, err := svc.ReconcileWallets(nil)
+			if err != nil {
+				hlog.Errorf("Ledger reconciliation failed: %v", err)
+				continue
+			}
+
+			if !report.Balanced {
+				hlog.Warnf("Ledger is not balanced: total debit %s, total credit %s", report.TotalDebit, report.TotalCredit)
+			}
+			for _, drift := range report.Drifts {
+				hlog.Warnf("Wallet drift detected: user_id=%d, balance drift %s, frozen drift %s",
+					drift.UserID, drift.BalanceDrift, drift.FrozenDrift)
+			}
+		}
+	}()
+}
+
+// deltaFor 获取（或初始化）所有者的余额变动
+func deltaFor(deltas map[int64]*balanceDelta, ownerID int64) *balanceDelta {
+	delta, ok := deltas[ownerID]
+	if !ok {
+		delta = &balanceDelta{}
+		deltas[ownerID] = delta
+	}
+	return delta
+}
+
+// sortedOwners 返回排序后的所有者ID
+func sortedOwners(deltas map[int64]*balanceDelta) []int64 {
+	owners := make([]int64, 0, len(deltas))
+	for ownerID := range deltas {
+		owners = append(owners, ownerID)
+	}
+	sort.Slice(owners, func(i, j int) bool { return owners[i] < owners[j] })
+	return owners
+}
@@ -0,0 +1,275 @@
+package ledger
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils/money"
+
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	concurrentWorkers = 16
+	opsPerWorker      = 50
+)
+
+// memLedgerRepository 内存账本仓库
+// ApplyWalletDelta 与 MySQL 实现一致：余额检查和更新是原子的，结果为负时拒绝更新；
+// 分录明细按事务（tx 指针）暂存，提交后才计入账本
+type memLedgerRepository struct {
+	mu         sync.Mutex
+	balances   map[int64]money.Amount
+	frozen     map[int64]money.Amount
+	pending    map[*gorm.DB][]*model.OrbiaLedgerLine
+	lines      []*model.OrbiaLedgerLine
+	minBalance money.Amount
+}
+
+func newMemLedgerRepository() *memLedgerRepository {
+	return &memLedgerRepository{
+		balances: make(map[int64]money.Amount),
+		frozen:   make(map[int64]money.Amount),
+		pending:  make(map[*gorm.DB][]*model.OrbiaLedgerLine),
+	}
+}
+
+func (r *memLedgerRepository) CreateEntry(tx *gorm.DB, _ *model.OrbiaLedgerEntry, lines []*model.OrbiaLedgerLine) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending[tx] = append(r.pending[tx], lines...)
+	return nil
+}
+
+// finish 结束事务：成功时提交暂存的明细，失败时丢弃（余额更新失败时不会生效，无需撤销）
+func (r *memLedgerRepository) finish(tx *gorm.DB, commit bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if commit {
+		r.lines = append(r.lines, r.pending[tx]...)
+	}
+	delete(r.pending, tx)
+}
+
+func (r *memLedgerRepository) ApplyWalletDelta(_ *gorm.DB, userID int64, balanceDelta money.Amount, frozenDelta money.Amount) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	balance, frozen := r.balances[userID]+balanceDelta, r.frozen[userID]+frozenDelta
+	if balance < 0 || frozen < 0 {
+		return errors.New("insufficient balance or wallet not found")
+	}
+	r.balances[userID], r.frozen[userID] = balance, frozen
+	if balance < r.minBalance {
+		r.minBalance = balance
+	}
+	return nil
+}
+
+func (r *memLedgerRepository) ApplyKolEarningDelta(*gorm.DB, int64, money.Amount, money.Amount) error {
+	return nil
+}
+
+func (r *memLedgerRepository) GetWalletReconciliation(*int64) ([]*mysql.WalletReconciliationRow, error) {
+	return nil, nil
+}
+
+func (r *memLedgerRepository) GetTrialBalance() (money.Amount, money.Amount, error) {
+	return 0, 0, nil
+}
+
+// walletSum 按账本明细计算钱包余额（贷方 - 借方）
+func walletSum(lines []*model.OrbiaLedgerLine, userID int64) money.Amount {
+	var sum money.Amount
+	for _, line := range lines {
+		if line.AccountType != AccountUserWallet || line.OwnerID != userID {
+			continue
+		}
+		if line.Direction == DirectionCredit {
+			sum += line.Amount
+		} else {
+			sum -= line.Amount
+		}
+	}
+	return sum
+}
+
+// hammerWallet 并发对同一钱包扣款和入账，返回成功的扣款/入账次数
+// 每个 worker 交替执行扣款和入账，扣款金额大于入账金额，余额最终会被耗尽
+func hammerWallet(t *testing.T, post func(debit bool, amount money.Amount) error) (debits, credits int64) {
+	t.Helper()
+	debitAmount, creditAmount := money.FromCents(700), money.FromCents(300)
+
+	var wg sync.WaitGroup
+	var okDebits, okCredits atomic.Int64
+	for w := 0; w < concurrentWorkers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < opsPerWorker; i++ {
+				debit := (w+i)%2 == 0
+				amount := creditAmount
+				if debit {
+					amount = debitAmount
+				}
+				err := post(debit, amount)
+				switch {
+				case err == nil && debit:
+					okDebits.Add(1)
+				case err == nil:
+					okCredits.Add(1)
+				case !debit:
+					t.Errorf("credit failed: %v", err)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	return okDebits.Load(), okCredits.Load()
+}
+
+func TestPostConcurrentWalletDebitsAndCredits(t *testing.T) {
+	const userID = 1001
+	repo := newMemLedgerRepository()
+	svc := NewLedgerService(nil, repo)
+
+	// post 在独立的“事务”中记账
+	post := func(entry *Entry) error {
+		tx := &gorm.DB{}
+		_, err := svc.Post(tx, entry)
+		repo.finish(tx, err == nil)
+		return err
+	}
+
+	opening := money.FromCents(50_000)
+	if err := post(&Entry{
+		EntryType: EntryRecharge,
+		Lines:     []Line{Debit(PlatformCash, opening), Credit(UserWallet(userID), opening)},
+	}); err != nil {
+		t.Fatalf("opening recharge: %v", err)
+	}
+
+	debits, credits := hammerWallet(t, func(debit bool, amount money.Amount) error {
+		entry := &Entry{EntryType: EntryRecharge, Lines: []Line{Debit(PlatformCash, amount), Credit(UserWallet(userID), amount)}}
+		if debit {
+			entry = &Entry{EntryType: EntryCampaignConsume, Lines: []Line{Debit(UserWallet(userID), amount), Credit(PlatformRevenue, amount)}}
+		}
+		return post(entry)
+	})
+
+	if debits == 0 || credits == 0 {
+		t.Fatalf("expected both debits and credits to succeed, got %d debits and %d credits", debits, credits)
+	}
+	if repo.minBalance < 0 {
+		t.Fatalf("wallet balance went negative: %s", repo.minBalance)
+	}
+
+	balance := repo.balances[userID]
+	want := opening + money.FromCents(300)*money.Amount(credits) - money.FromCents(700)*money.Amount(debits)
+	if balance != want {
+		t.Fatalf("balance = %s, want %s (%d debits, %d credits)", balance, want, debits, credits)
+	}
+	if sum := walletSum(repo.lines, userID); sum != balance {
+		t.Fatalf("ledger sum = %s, wallet balance = %s", sum, balance)
+	}
+}
+
+// TestWalletConcurrencyMySQL 在真实 MySQL 上并发扣款和入账
+// 扣款按业务代码的方式执行：事务中 SELECT ... FOR UPDATE 锁定钱包、检查余额后记账
+// 需要设置 ORBIA_TEST_MYSQL_DSN 指向一个空的测试库，未设置时跳过
+func TestWalletConcurrencyMySQL(t *testing.T) {
+	dsn := os.Getenv("ORBIA_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("ORBIA_TEST_MYSQL_DSN is not set")
+	}
+
+	db, err := gorm.Open(gormmysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("connect mysql: %v", err)
+	}
+	if err := db.AutoMigrate(&model.OrbiaWallet{}, &model.OrbiaLedgerEntry{}, &model.OrbiaLedgerLine{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(concurrentWorkers)
+
+	userID := int64(900_000_000 + os.Getpid())
+	t.Cleanup(func() {
+		db.Where("user_id = ?", userID).Delete(&model.OrbiaWallet{})
+		db.Where("entry_id IN (?)", db.Model(&model.OrbiaLedgerLine{}).Select("entry_id").
+			Where("account_type = ? AND owner_id = ?", AccountUserWallet, userID)).
+			Delete(&model.OrbiaLedgerEntry{})
+		db.Where("account_type = ? AND owner_id = ?", AccountUserWallet, userID).Delete(&model.OrbiaLedgerLine{})
+	})
+
+	walletRepo := mysql.NewWalletRepository(db)
+	svc := NewLedgerService(db, mysql.NewLedgerRepository(db))
+
+	if err := db.Create(&model.OrbiaWallet{UserID: userID}).Error; err != nil {
+		t.Fatalf("create wallet: %v", err)
+	}
+	opening := money.FromCents(50_000)
+	if _, err := svc.Post(nil, &Entry{
+		EntryType: EntryRecharge,
+		Lines:     []Line{Debit(PlatformCash, opening), Credit(UserWallet(userID), opening)},
+	}); err != nil {
+		t.Fatalf("opening recharge: %v", err)
+	}
+
+	errInsufficient := errors.New("insufficient balance")
+	debits, credits := hammerWallet(t, func(debit bool, amount money.Amount) error {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if !debit {
+				_, err := svc.Post(tx, &Entry{EntryType: EntryRecharge, Lines: []Line{Debit(PlatformCash, amount), Credit(UserWallet(userID), amount)}})
+				return err
+			}
+
+			wallet, err := walletRepo.GetWalletByUserIDForUpdate(tx, userID)
+			if err != nil {
+				return err
+			}
+			if wallet.Balance < 0 {
+				t.Errorf("locked wallet balance is negative: %s", wallet.Balance)
+			}
+			if wallet.Balance < amount {
+				return errInsufficient
+			}
+			_, err = svc.Post(tx, &Entry{EntryType: EntryCampaignConsume, Lines: []Line{Debit(UserWallet(userID), amount), Credit(PlatformRevenue, amount)}})
+			return err
+		})
+		if debit && err != nil && !errors.Is(err, errInsufficient) {
+			t.Errorf("debit failed: %v", err)
+		}
+		return err
+	})
+
+	wallet, err := walletRepo.GetWalletByUserID(userID)
+	if err != nil {
+		t.Fatalf("get wallet: %v", err)
+	}
+	if wallet.Balance < 0 {
+		t.Fatalf("wallet balance went negative: %s", wallet.Balance)
+	}
+	want := opening + money.FromCents(300)*money.Amount(credits) - money.FromCents(700)*money.Amount(debits)
+	if wallet.Balance != want {
+		t.Fatalf("balance = %s, want %s (%d debits, %d credits)", wallet.Balance, want, debits, credits)
+	}
+
+	report, err := svc.ReconcileWallets(&userID)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if report.WalletCount != 1 || len(report.Drifts) != 0 {
+		t.Fatalf("wallet drifts from ledger: %+v", report.Drifts)
+	}
+}
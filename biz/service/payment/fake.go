@@ -0,0 +1,112 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"orbia_api/biz/utils/money"
+)
+
+// FakeSignatureHeader 模拟支付 webhook 的签名头
+const FakeSignatureHeader = "X-Fake-Signature"
+
+// fakeProvider 本地联调用的模拟支付平台，不发起任何外部请求
+//
+// 支付页面地址为 checkout_url?order_id=...&amount=...，模拟支付结果时向
+// /api/v1/recharge/webhook/fake 发送如下请求体，并在 X-Fake-Signature 头中携带
+// hex(HMAC-SHA256(webhook_secret, 请求体))：
+//
+//	{"id":"evt_1","type":"payment.succeeded","order_id":"RCHORD_...","amount":"100.00","currency":"USD"}
+//
+// type 为 payment.failed 时可携带 reason 说明失败原因
+type fakeProvider struct {
+	checkoutURL   string
+	webhookSecret string
+}
+
+// NewFakeProvider 创建模拟支付实例
+func NewFakeProvider(checkoutURL, webhookSecret string) Provider {
+	return &fakeProvider{checkoutURL: checkoutURL, webhookSecret: webhookSecret}
+}
+
+// Name 平台标识
+func (p *fakeProvider) Name() string {
+	return "fake"
+}
+
+// CreateCheckout 返回本地模拟支付页面地址
+func (p *fakeProvider) CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error) {
+	query := url.Values{}
+	query.Set("order_id", req.OrderID)
+	query.Set("amount", req.Amount.String())
+	query.Set("currency", req.Currency)
+
+	return &Checkout{
+		ProviderOrderID: "fake_" + req.OrderID,
+		PaymentURL:      withQuery(p.checkoutURL, query),
+	}, nil
+}
+
+// HandleWebhook 校验 X-Fake-Signature 并解析模拟支付事件
+func (p *fakeProvider) HandleWebhook(ctx context.Context, header http.Header, body []byte) (*Event, error) {
+	signature, err := hex.DecodeString(header.Get(FakeSignatureHeader))
+	if p.webhookSecret == "" || err != nil || !hmac.Equal(signature, signFake(p.webhookSecret, body)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var payload struct {
+		ID       string `json:"id"`
+		Type     string `json:"type"`
+		OrderID  string `json:"order_id"`
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+		Reason   string `json:"reason"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid fake payment event: %v", err)
+	}
+
+	event := &Event{
+		ID:              payload.ID,
+		Type:            EventIgnored,
+		OrderID:         payload.OrderID,
+		ProviderOrderID: "fake_" + payload.OrderID,
+		Currency:        strings.ToUpper(payload.Currency),
+		Reason:          payload.Reason,
+	}
+
+	switch payload.Type {
+	case "payment.succeeded":
+		amount, err := money.Parse(payload.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fake payment amount %q: %v", payload.Amount, err)
+		}
+		event.Type = EventPaymentSucceeded
+		event.Amount = amount
+	case "payment.failed":
+		event.Type = EventPaymentFailed
+		if event.Reason == "" {
+			event.Reason = "fake payment failed"
+		}
+	}
+	return event, nil
+}
+
+// SignFakeWebhook 计算模拟支付 webhook 签名，供本地脚本调用
+func SignFakeWebhook(webhookSecret string, body []byte) string {
+	return hex.EncodeToString(signFake(webhookSecret, body))
+}
+
+// signFake HMAC-SHA256(webhook_secret, 请求体)
+func signFake(webhookSecret string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,125 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/utils/money"
+)
+
+var (
+	// ErrInvalidSignature webhook 签名校验失败
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrUnsupportedPlatform 支付平台不存在或未配置
+	ErrUnsupportedPlatform = errors.New("unsupported payment platform")
+)
+
+// CheckoutRequest 创建支付会话请求
+type CheckoutRequest struct {
+	OrderID     string       // 充值订单号，回传到 webhook 事件中用于关联订单
+	Amount      money.Amount // 支付金额
+	Currency    string       // ISO 4217 货币代码，如 USD
+	Description string       // 支付页面展示的商品描述
+	SuccessURL  string       // 支付成功后的跳转地址
+	CancelURL   string       // 取消支付后的跳转地址
+}
+
+// Checkout 支付平台创建的支付会话
+type Checkout struct {
+	ProviderOrderID string // 支付平台的会话/订单 ID
+	PaymentURL      string // 用户支付页面地址
+}
+
+// EventType webhook 事件类型
+type EventType string
+
+const (
+	EventPaymentSucceeded EventType = "payment_succeeded" // 支付成功，可确认充值订单
+	EventPaymentFailed    EventType = "payment_failed"    // 支付失败或会话过期，充值订单置为失败
+	EventIgnored          EventType = "ignored"           // 与充值无关的事件，直接应答
+)
+
+// Event 已验签并解析的 webhook 事件
+type Event struct {
+	ID              string       // 支付平台事件 ID
+	Type            EventType    // 事件类型
+	OrderID         string       // 充值订单号
+	ProviderOrderID string       // 支付平台的会话/订单 ID
+	Amount          money.Amount // 实付金额（支付成功时）
+	Currency        string       // 实付货币代码（大写）
+	Reason          string       // 失败原因
+}
+
+// Provider 在线支付平台接口，每个平台一个实现
+type Provider interface {
+	// Name 平台标识，与充值订单的 online_payment_platform 一致
+	Name() string
+	// CreateCheckout 创建支付会话，返回支付页面地址
+	CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error)
+	// HandleWebhook 校验 webhook 签名并解析事件，签名不正确时返回 ErrInvalidSignature
+	HandleWebhook(ctx context.Context, header http.Header, body []byte) (*Event, error)
+}
+
+// Registry 已配置的在线支付平台
+type Registry struct {
+	providers  map[string]Provider
+	successURL string
+	cancelURL  string
+}
+
+// NewRegistry 根据配置创建支付平台注册表，未配置密钥的平台不注册
+func NewRegistry(cfg config.PaymentConfig) *Registry {
+	registry := &Registry{
+		providers:  make(map[string]Provider),
+		successURL: cfg.SuccessURL,
+		cancelURL:  cfg.CancelURL,
+	}
+
+	if cfg.Stripe.SecretKey != "" {
+		registry.Register(NewStripeProvider(cfg.Stripe.APIBase, cfg.Stripe.SecretKey, cfg.Stripe.WebhookSecret, nil))
+	}
+	if cfg.PayPal.ClientID != "" {
+		registry.Register(NewPayPalProvider(cfg.PayPal.APIBase, cfg.PayPal.ClientID, cfg.PayPal.ClientSecret, cfg.PayPal.WebhookID, nil))
+	}
+	if cfg.Fake.Enabled {
+		registry.Register(NewFakeProvider(cfg.Fake.CheckoutURL, cfg.Fake.WebhookSecret))
+	}
+	return registry
+}
+
+// Register 注册支付平台
+func (r *Registry) Register(provider Provider) {
+	r.providers[strings.ToLower(provider.Name())] = provider
+}
+
+// Lookup 根据平台标识查找支付平台（不区分大小写）
+func (r *Registry) Lookup(platform string) (Provider, bool) {
+	if r == nil {
+		return nil, false
+	}
+	provider, ok := r.providers[strings.ToLower(strings.TrimSpace(platform))]
+	return provider, ok
+}
+
+// ReturnURLs 获取支付完成/取消后的跳转地址，追加 order_id 参数
+func (r *Registry) ReturnURLs(orderID string) (successURL, cancelURL string) {
+	query := url.Values{}
+	query.Set("order_id", orderID)
+	return withQuery(r.successURL, query), withQuery(r.cancelURL, query)
+}
+
+// withQuery 在地址后追加查询参数，地址为空时返回空
+func withQuery(rawURL string, query url.Values) string {
+	if rawURL == "" {
+		return ""
+	}
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+	return rawURL + separator + query.Encode()
+}
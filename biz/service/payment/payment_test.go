@@ -0,0 +1,270 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"orbia_api/biz/utils/money"
+)
+
+const (
+	testWebhookSecret = "whsec_test"
+	testPayPalSig     = "paypal_test_signature"
+)
+
+// stripeTestNow 固定的当前时间，签名时间戳相对它计算
+var stripeTestNow = time.Unix(1_700_000_000, 0)
+
+const stripeTestBody = `{"id":"evt_1","type":"checkout.session.completed","data":{"object":{` +
+	`"id":"cs_1","client_reference_id":"RCHORD_1","amount_total":10050,"currency":"usd","payment_status":"paid"}}}`
+
+// newTestStripeProvider 创建当前时间固定为 stripeTestNow 的 Stripe 实例
+func newTestStripeProvider() *stripeProvider {
+	p := NewStripeProvider("", "sk_test", testWebhookSecret, nil).(*stripeProvider)
+	p.now = func() time.Time { return stripeTestNow }
+	return p
+}
+
+// signStripe 按 Stripe 的方式计算 v1 签名
+func signStripe(secret string, timestamp int64, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "." + body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestStripeVerifySignature(t *testing.T) {
+	ts := stripeTestNow.Unix()
+	valid := signStripe(testWebhookSecret, ts, stripeTestBody)
+	stamp := "t=" + strconv.FormatInt(ts, 10)
+
+	tests := []struct {
+		name   string
+		header string
+		body   string
+		valid  bool
+	}{
+		{name: "valid signature", header: stamp + ",v1=" + valid, body: stripeTestBody, valid: true},
+		{name: "tampered body", header: stamp + ",v1=" + valid, body: `{"id":"evt_1","type":"checkout.session.completed","amount_total":1}`},
+		{name: "wrong secret", header: stamp + ",v1=" + signStripe("whsec_other", ts, stripeTestBody), body: stripeTestBody},
+		{
+			name:   "stale timestamp",
+			header: "t=" + strconv.FormatInt(ts-301, 10) + ",v1=" + signStripe(testWebhookSecret, ts-301, stripeTestBody),
+			body:   stripeTestBody,
+		},
+		{
+			name:   "timestamp too far in the future",
+			header: "t=" + strconv.FormatInt(ts+301, 10) + ",v1=" + signStripe(testWebhookSecret, ts+301, stripeTestBody),
+			body:   stripeTestBody,
+		},
+		{
+			name:   "timestamp within tolerance",
+			header: "t=" + strconv.FormatInt(ts-299, 10) + ",v1=" + signStripe(testWebhookSecret, ts-299, stripeTestBody),
+			body:   stripeTestBody,
+			valid:  true,
+		},
+		{
+			// 轮换密钥期间 Stripe 会同时发送新旧密钥的签名
+			name:   "one of multiple v1 values matches",
+			header: stamp + ",v1=" + signStripe("whsec_old", ts, stripeTestBody) + ",v1=" + valid + ",v0=deadbeef",
+			body:   stripeTestBody,
+			valid:  true,
+		},
+		{
+			name:   "none of multiple v1 values matches",
+			header: stamp + ",v1=" + signStripe("whsec_old", ts, stripeTestBody) + ",v1=not-hex",
+			body:   stripeTestBody,
+		},
+		{name: "only v0 signature", header: stamp + ",v0=" + valid, body: stripeTestBody},
+		{name: "missing timestamp", header: "v1=" + valid, body: stripeTestBody},
+		{name: "empty header", body: stripeTestBody},
+	}
+
+	p := newTestStripeProvider()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.verifySignature(tt.header, []byte(tt.body))
+			if tt.valid && err != nil {
+				t.Fatalf("verify signature: %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidSignature) {
+				t.Fatalf("err = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+}
+
+func TestStripeVerifySignatureWithoutSecret(t *testing.T) {
+	p := newTestStripeProvider()
+	p.webhookSecret = ""
+
+	// 未配置密钥时即使签名按空密钥计算也拒绝
+	ts := stripeTestNow.Unix()
+	header := "t=" + strconv.FormatInt(ts, 10) + ",v1=" + signStripe("", ts, stripeTestBody)
+	if err := p.verifySignature(header, []byte(stripeTestBody)); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("err = %v, want ErrInvalidSignature", err)
+	}
+}
+
+func TestStripeHandleWebhook(t *testing.T) {
+	p := newTestStripeProvider()
+	ts := stripeTestNow.Unix()
+
+	header := http.Header{}
+	header.Set("Stripe-Signature", "t="+strconv.FormatInt(ts, 10)+",v1="+signStripe(testWebhookSecret, ts, stripeTestBody))
+	event, err := p.HandleWebhook(context.Background(), header, []byte(stripeTestBody))
+	if err != nil {
+		t.Fatalf("handle webhook: %v", err)
+	}
+	want := Event{ID: "evt_1", Type: EventPaymentSucceeded, OrderID: "RCHORD_1", ProviderOrderID: "cs_1", Amount: 10050, Currency: "USD"}
+	if *event != want {
+		t.Fatalf("event = %+v, want %+v", *event, want)
+	}
+
+	// 签名不正确时不解析事件
+	header.Set("Stripe-Signature", "t="+strconv.FormatInt(ts, 10)+",v1="+signStripe("whsec_other", ts, stripeTestBody))
+	if event, err := p.HandleWebhook(context.Background(), header, []byte(stripeTestBody)); !errors.Is(err, ErrInvalidSignature) || event != nil {
+		t.Fatalf("event = %+v, err = %v; want ErrInvalidSignature", event, err)
+	}
+}
+
+func TestFakeHandleWebhook(t *testing.T) {
+	p := NewFakeProvider("", testWebhookSecret)
+	body := []byte(`{"id":"evt_1","type":"payment.succeeded","order_id":"RCHORD_1","amount":"100.50","currency":"usd"}`)
+
+	tests := []struct {
+		name      string
+		signature string
+		body      []byte
+		valid     bool
+	}{
+		{name: "valid signature", signature: SignFakeWebhook(testWebhookSecret, body), body: body, valid: true},
+		{name: "tampered body", signature: SignFakeWebhook(testWebhookSecret, body), body: []byte(`{"id":"evt_1","type":"payment.succeeded","order_id":"RCHORD_1","amount":"9999.00","currency":"usd"}`)},
+		{name: "wrong secret", signature: SignFakeWebhook("whsec_other", body), body: body},
+		{name: "not hex", signature: "zz", body: body},
+		{name: "missing signature", body: body},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(FakeSignatureHeader, tt.signature)
+			event, err := p.HandleWebhook(context.Background(), header, tt.body)
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalidSignature) {
+					t.Fatalf("err = %v, want ErrInvalidSignature", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("handle webhook: %v", err)
+			}
+			if event.Type != EventPaymentSucceeded || event.OrderID != "RCHORD_1" || event.Amount != 10050 || event.Currency != "USD" {
+				t.Fatalf("unexpected event %+v", event)
+			}
+		})
+	}
+
+	// 未配置密钥时拒绝所有请求
+	unsigned := NewFakeProvider("", "")
+	header := http.Header{}
+	header.Set(FakeSignatureHeader, SignFakeWebhook("", body))
+	if _, err := unsigned.HandleWebhook(context.Background(), header, body); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("err = %v, want ErrInvalidSignature without a webhook secret", err)
+	}
+}
+
+// newPayPalTestServer 模拟 PayPal 的 OAuth 和验签接口
+// 传输签名为 testPayPalSig 且 webhook_event 与原始请求体一致时返回 SUCCESS
+func newPayPalTestServer(t *testing.T, verifications *atomic.Int32) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+	})
+	mux.HandleFunc("/v1/notifications/verify-webhook-signature", func(w http.ResponseWriter, r *http.Request) {
+		verifications.Add(1)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			TransmissionSig string          `json:"transmission_sig"`
+			WebhookID       string          `json:"webhook_id"`
+			WebhookEvent    json.RawMessage `json:"webhook_event"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		status := "FAILURE"
+		if req.TransmissionSig == testPayPalSig && req.WebhookID == "WH-1" && string(req.WebhookEvent) == paypalTestBody {
+			status = "SUCCESS"
+		}
+		json.NewEncoder(w).Encode(map[string]string{"verification_status": status})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+const paypalTestBody = `{"id":"WH-EVT-1","event_type":"PAYMENT.CAPTURE.COMPLETED","resource":{"id":"CAP-1","status":"COMPLETED",` +
+	`"custom_id":"RCHORD_1","amount":{"currency_code":"usd","value":"100.50"},"supplementary_data":{"related_ids":{"order_id":"PP-ORDER-1"}}}}`
+
+func TestPayPalHandleWebhook(t *testing.T) {
+	var verifications atomic.Int32
+	server := newPayPalTestServer(t, &verifications)
+	p := NewPayPalProvider(server.URL, "client", "secret", "WH-1", server.Client())
+
+	header := http.Header{}
+	header.Set("Paypal-Transmission-Sig", testPayPalSig)
+	event, err := p.HandleWebhook(context.Background(), header, []byte(paypalTestBody))
+	if err != nil {
+		t.Fatalf("handle webhook: %v", err)
+	}
+	want := Event{ID: "WH-EVT-1", Type: EventPaymentSucceeded, OrderID: "RCHORD_1", ProviderOrderID: "PP-ORDER-1", Amount: money.FromCents(10050), Currency: "USD"}
+	if *event != want {
+		t.Fatalf("event = %+v, want %+v", *event, want)
+	}
+
+	tests := []struct {
+		name      string
+		signature string
+		body      string
+	}{
+		{name: "forged signature", signature: "forged", body: paypalTestBody},
+		{name: "tampered body", signature: testPayPalSig, body: `{"id":"WH-EVT-1","event_type":"PAYMENT.CAPTURE.COMPLETED","resource":{"status":"COMPLETED"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("Paypal-Transmission-Sig", tt.signature)
+			if _, err := p.HandleWebhook(context.Background(), header, []byte(tt.body)); !errors.Is(err, ErrInvalidSignature) {
+				t.Fatalf("err = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+
+	// 缺少签名头或未配置 webhook ID 时不调用验签接口
+	before := verifications.Load()
+	if _, err := p.HandleWebhook(context.Background(), http.Header{}, []byte(paypalTestBody)); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("missing signature err = %v, want ErrInvalidSignature", err)
+	}
+	noWebhookID := NewPayPalProvider(server.URL, "client", "secret", "", server.Client())
+	if _, err := noWebhookID.HandleWebhook(context.Background(), header, []byte(paypalTestBody)); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("missing webhook id err = %v, want ErrInvalidSignature", err)
+	}
+	if verifications.Load() != before {
+		t.Fatal("verify-webhook-signature should not be called without a signature or webhook id")
+	}
+}
@@ -0,0 +1,278 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"orbia_api/biz/utils/money"
+)
+
+const paypalDefaultAPIBase = "https://api-m.paypal.com"
+
+// paypalProvider PayPal Orders v2 支付
+type paypalProvider struct {
+	apiBase      string
+	clientID     string
+	clientSecret string
+	webhookID    string
+	httpClient   *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// NewPayPalProvider 创建 PayPal 支付实例，apiBase 为空时使用正式环境地址
+func NewPayPalProvider(apiBase, clientID, clientSecret, webhookID string, httpClient *http.Client) Provider {
+	if apiBase == "" {
+		apiBase = paypalDefaultAPIBase
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &paypalProvider{
+		apiBase:      strings.TrimRight(apiBase, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		webhookID:    webhookID,
+		httpClient:   httpClient,
+	}
+}
+
+// Name 平台标识
+func (p *paypalProvider) Name() string {
+	return "paypal"
+}
+
+// paypalAmount 金额对象
+type paypalAmount struct {
+	CurrencyCode string `json:"currency_code"`
+	Value        string `json:"value"`
+}
+
+// paypalCapture 支付捕获对象（仅包含用到的字段）
+type paypalCapture struct {
+	ID                string       `json:"id"`
+	Status            string       `json:"status"`
+	CustomID          string       `json:"custom_id"`
+	Amount            paypalAmount `json:"amount"`
+	SupplementaryData struct {
+		RelatedIDs struct {
+			OrderID string `json:"order_id"`
+		} `json:"related_ids"`
+	} `json:"supplementary_data"`
+}
+
+// paypalOrder 订单对象（仅包含用到的字段）
+type paypalOrder struct {
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	PurchaseUnits []struct {
+		CustomID string `json:"custom_id"`
+		Payments struct {
+			Captures []paypalCapture `json:"captures"`
+		} `json:"payments"`
+	} `json:"purchase_units"`
+	Links []struct {
+		Href string `json:"href"`
+		Rel  string `json:"rel"`
+	} `json:"links"`
+}
+
+// CreateCheckout 创建 PayPal 订单，充值订单号写入 custom_id
+func (p *paypalProvider) CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error) {
+	body := map[string]interface{}{
+		"intent": "CAPTURE",
+		"purchase_units": []map[string]interface{}{{
+			"reference_id": req.OrderID,
+			"custom_id":    req.OrderID,
+			"description":  req.Description,
+			"amount": paypalAmount{
+				CurrencyCode: strings.ToUpper(req.Currency),
+				Value:        req.Amount.String(),
+			},
+		}},
+		"payment_source": map[string]interface{}{
+			"paypal": map[string]interface{}{
+				"experience_context": map[string]interface{}{
+					"return_url":  req.SuccessURL,
+					"cancel_url":  req.CancelURL,
+					"user_action": "PAY_NOW",
+				},
+			},
+		},
+	}
+
+	var order paypalOrder
+	if err := p.call(ctx, http.MethodPost, "/v2/checkout/orders", "checkout-"+req.OrderID, body, &order); err != nil {
+		return nil, fmt.Errorf("failed to create paypal order: %v", err)
+	}
+
+	for _, link := range order.Links {
+		if link.Rel == "payer-action" || link.Rel == "approve" {
+			return &Checkout{ProviderOrderID: order.ID, PaymentURL: link.Href}, nil
+		}
+	}
+	return nil, errors.New("paypal order has no approval link")
+}
+
+// HandleWebhook 通过 PayPal 验签接口校验 webhook，并解析订单/捕获事件
+// 买家批准订单（CHECKOUT.ORDER.APPROVED）后在此完成捕获，捕获完成才视为支付成功
+func (p *paypalProvider) HandleWebhook(ctx context.Context, header http.Header, body []byte) (*Event, error) {
+	if err := p.verifySignature(ctx, header, body); err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		ID        string          `json:"id"`
+		EventType string          `json:"event_type"`
+		Resource  json.RawMessage `json:"resource"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid paypal event: %v", err)
+	}
+
+	event := &Event{ID: payload.ID, Type: EventIgnored}
+	switch payload.EventType {
+	case "CHECKOUT.ORDER.APPROVED":
+		var order paypalOrder
+		if err := json.Unmarshal(payload.Resource, &order); err != nil {
+			return nil, fmt.Errorf("invalid paypal order: %v", err)
+		}
+
+		var captured paypalOrder
+		path := "/v2/checkout/orders/" + url.PathEscape(order.ID) + "/capture"
+		if err := p.call(ctx, http.MethodPost, path, "capture-"+order.ID, struct{}{}, &captured); err != nil {
+			return nil, fmt.Errorf("failed to capture paypal order %s: %v", order.ID, err)
+		}
+		if len(captured.PurchaseUnits) == 0 || len(captured.PurchaseUnits[0].Payments.Captures) == 0 {
+			return event, nil
+		}
+
+		capture := captured.PurchaseUnits[0].Payments.Captures[0]
+		if capture.CustomID == "" {
+			capture.CustomID = captured.PurchaseUnits[0].CustomID
+		}
+		capture.SupplementaryData.RelatedIDs.OrderID = captured.ID
+		return p.captureEvent(event, &capture)
+
+	case "PAYMENT.CAPTURE.COMPLETED", "PAYMENT.CAPTURE.DENIED", "PAYMENT.CAPTURE.DECLINED":
+		var capture paypalCapture
+		if err := json.Unmarshal(payload.Resource, &capture); err != nil {
+			return nil, fmt.Errorf("invalid paypal capture: %v", err)
+		}
+		return p.captureEvent(event, &capture)
+	}
+	return event, nil
+}
+
+// captureEvent 根据捕获状态生成事件，PENDING 等中间状态忽略，等待后续事件
+func (p *paypalProvider) captureEvent(event *Event, capture *paypalCapture) (*Event, error) {
+	event.OrderID = capture.CustomID
+	event.ProviderOrderID = capture.SupplementaryData.RelatedIDs.OrderID
+
+	switch capture.Status {
+	case "COMPLETED":
+		amount, err := money.Parse(capture.Amount.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid paypal capture amount %q: %v", capture.Amount.Value, err)
+		}
+		event.Type = EventPaymentSucceeded
+		event.Amount = amount
+		event.Currency = strings.ToUpper(capture.Amount.CurrencyCode)
+	case "DECLINED", "DENIED", "FAILED":
+		event.Type = EventPaymentFailed
+		event.Reason = "paypal capture " + strings.ToLower(capture.Status)
+	}
+	return event, nil
+}
+
+// verifySignature 调用 PayPal 验签接口校验 webhook 的传输签名
+func (p *paypalProvider) verifySignature(ctx context.Context, header http.Header, body []byte) error {
+	if p.webhookID == "" || header.Get("Paypal-Transmission-Sig") == "" {
+		return ErrInvalidSignature
+	}
+
+	request := map[string]interface{}{
+		"auth_algo":         header.Get("Paypal-Auth-Algo"),
+		"cert_url":          header.Get("Paypal-Cert-Url"),
+		"transmission_id":   header.Get("Paypal-Transmission-Id"),
+		"transmission_sig":  header.Get("Paypal-Transmission-Sig"),
+		"transmission_time": header.Get("Paypal-Transmission-Time"),
+		"webhook_id":        p.webhookID,
+		"webhook_event":     json.RawMessage(body),
+	}
+
+	var result struct {
+		VerificationStatus string `json:"verification_status"`
+	}
+	if err := p.call(ctx, http.MethodPost, "/v1/notifications/verify-webhook-signature", "", request, &result); err != nil {
+		return fmt.Errorf("failed to verify paypal webhook signature: %v", err)
+	}
+	if result.VerificationStatus != "SUCCESS" {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// call 使用 OAuth 访问令牌调用 PayPal REST API，requestID 非空时作为 PayPal-Request-Id 保证幂等
+func (p *paypalProvider) call(ctx context.Context, method, path, requestID string, body interface{}, out interface{}) error {
+	token, err := p.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.apiBase+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	if requestID != "" {
+		req.Header.Set("PayPal-Request-Id", requestID)
+	}
+	return doJSON(p.httpClient, req, out)
+}
+
+// getAccessToken 获取 OAuth 访问令牌，过期前一分钟刷新
+func (p *paypalProvider) getAccessToken(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.accessToken != "" && time.Now().Before(p.tokenExpiry) {
+		return p.accessToken, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBase+"/v1/oauth2/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(p.clientID, p.clientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := doJSON(p.httpClient, req, &result); err != nil {
+		return "", fmt.Errorf("failed to get paypal access token: %v", err)
+	}
+
+	p.accessToken = result.AccessToken
+	p.tokenExpiry = time.Now().Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+	return p.accessToken, nil
+}
@@ -0,0 +1,216 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"orbia_api/biz/utils/money"
+)
+
+const (
+	stripeDefaultAPIBase = "https://api.stripe.com"
+	// stripeSignatureTolerance webhook 签名时间戳允许的最大偏差，防止重放
+	stripeSignatureTolerance = 5 * time.Minute
+)
+
+// stripeProvider Stripe Checkout 支付
+type stripeProvider struct {
+	apiBase       string
+	secretKey     string
+	webhookSecret string
+	httpClient    *http.Client
+	now           func() time.Time
+}
+
+// NewStripeProvider 创建 Stripe 支付实例，apiBase 为空时使用官方地址
+func NewStripeProvider(apiBase, secretKey, webhookSecret string, httpClient *http.Client) Provider {
+	if apiBase == "" {
+		apiBase = stripeDefaultAPIBase
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &stripeProvider{
+		apiBase:       strings.TrimRight(apiBase, "/"),
+		secretKey:     secretKey,
+		webhookSecret: webhookSecret,
+		httpClient:    httpClient,
+		now:           time.Now,
+	}
+}
+
+// Name 平台标识
+func (p *stripeProvider) Name() string {
+	return "stripe"
+}
+
+// stripeCheckoutSession Checkout Session 对象（仅包含用到的字段）
+type stripeCheckoutSession struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	ClientReferenceID string            `json:"client_reference_id"`
+	AmountTotal       int64             `json:"amount_total"`
+	Currency          string            `json:"currency"`
+	PaymentStatus     string            `json:"payment_status"`
+	Metadata          map[string]string `json:"metadata"`
+}
+
+// CreateCheckout 创建 Checkout Session，充值订单号写入 client_reference_id 和 metadata
+func (p *stripeProvider) CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error) {
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("client_reference_id", req.OrderID)
+	form.Set("metadata[order_id]", req.OrderID)
+	form.Set("payment_intent_data[metadata][order_id]", req.OrderID)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", strings.ToLower(req.Currency))
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(req.Amount.Cents(), 10))
+	form.Set("line_items[0][price_data][product_data][name]", req.Description)
+	form.Set("success_url", req.SuccessURL)
+	form.Set("cancel_url", req.CancelURL)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBase+"/v1/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(p.secretKey, "")
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// 同一充值订单重复请求时返回同一个会话
+	httpReq.Header.Set("Idempotency-Key", "checkout-"+req.OrderID)
+
+	var session stripeCheckoutSession
+	if err := doJSON(p.httpClient, httpReq, &session); err != nil {
+		return nil, fmt.Errorf("failed to create stripe checkout session: %v", err)
+	}
+	if session.ID == "" || session.URL == "" {
+		return nil, errors.New("stripe checkout session has no id or url")
+	}
+
+	return &Checkout{ProviderOrderID: session.ID, PaymentURL: session.URL}, nil
+}
+
+// HandleWebhook 校验 Stripe-Signature 并解析 Checkout Session 事件
+func (p *stripeProvider) HandleWebhook(ctx context.Context, header http.Header, body []byte) (*Event, error) {
+	if err := p.verifySignature(header.Get("Stripe-Signature"), body); err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Data struct {
+			Object json.RawMessage `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid stripe event: %v", err)
+	}
+
+	event := &Event{ID: payload.ID, Type: EventIgnored}
+	switch payload.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded",
+		"checkout.session.async_payment_failed", "checkout.session.expired":
+	default:
+		return event, nil
+	}
+
+	var session stripeCheckoutSession
+	if err := json.Unmarshal(payload.Data.Object, &session); err != nil {
+		return nil, fmt.Errorf("invalid stripe checkout session: %v", err)
+	}
+	event.OrderID = session.ClientReferenceID
+	if event.OrderID == "" {
+		event.OrderID = session.Metadata["order_id"]
+	}
+	event.ProviderOrderID = session.ID
+	event.Amount = money.FromCents(session.AmountTotal)
+	event.Currency = strings.ToUpper(session.Currency)
+
+	switch payload.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+		// 异步支付方式在 completed 时尚未到账，需等待 async_payment_succeeded
+		if session.PaymentStatus == "paid" || session.PaymentStatus == "no_payment_required" {
+			event.Type = EventPaymentSucceeded
+		}
+	case "checkout.session.async_payment_failed":
+		event.Type = EventPaymentFailed
+		event.Reason = "stripe payment failed"
+	case "checkout.session.expired":
+		event.Type = EventPaymentFailed
+		event.Reason = "stripe checkout session expired"
+	}
+	return event, nil
+}
+
+// verifySignature 校验 Stripe-Signature 头：t=时间戳,v1=HMAC-SHA256(时间戳.请求体)
+func (p *stripeProvider) verifySignature(signatureHeader string, body []byte) error {
+	if p.webhookSecret == "" || signatureHeader == "" {
+		return ErrInvalidSignature
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(signatureHeader, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+	if age := p.now().Sub(time.Unix(ts, 0)); age > stripeSignatureTolerance || age < -stripeSignatureTolerance {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.webhookSecret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	for _, signature := range signatures {
+		actual, err := hex.DecodeString(signature)
+		if err == nil && hmac.Equal(actual, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// doJSON 发送请求并解析 JSON 响应，非 2xx 状态码返回错误
+func doJSON(httpClient *http.Client, req *http.Request, out interface{}) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(data))
+	}
+	return json.Unmarshal(data, out)
+}
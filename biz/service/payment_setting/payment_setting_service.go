@@ -3,10 +3,13 @@ package payment_setting
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
 	psModel "orbia_api/biz/model/payment_setting"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/twofactor"
 	"orbia_api/biz/utils"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -15,13 +18,17 @@ import (
 
 // PaymentSettingService 收款钱包设置服务
 type PaymentSettingService struct {
-	repo mysql.PaymentSettingRepository
+	db       *gorm.DB
+	repo     mysql.PaymentSettingRepository
+	auditSvc audit.AuditService
 }
 
 // NewPaymentSettingService 创建收款钱包设置服务实例
-func NewPaymentSettingService(repo mysql.PaymentSettingRepository) *PaymentSettingService {
+func NewPaymentSettingService(db *gorm.DB, repo mysql.PaymentSettingRepository, auditSvc audit.AuditService) *PaymentSettingService {
 	return &PaymentSettingService{
-		repo: repo,
+		db:       db,
+		repo:     repo,
+		auditSvc: auditSvc,
 	}
 }
 
@@ -112,6 +119,13 @@ func (s *PaymentSettingService) CreatePaymentSetting(ctx context.Context, req *p
 		}, nil
 	}
 
+	// 新增收款地址会改变用户充值的去向，需要两步验证码
+	if !twofactor.StepUpVerified(ctx) {
+		return &psModel.CreatePaymentSettingResp{
+			BaseResp: utils.BuildBaseResp(403, twofactor.ErrStepUpRequired.Error()),
+		}, nil
+	}
+
 	// 设置状态默认值
 	status := int32(1)
 	if req.Status != nil {
@@ -126,7 +140,13 @@ func (s *PaymentSettingService) CreatePaymentSetting(ctx context.Context, req *p
 		Status:  status,
 	}
 
-	if err := s.repo.CreatePaymentSetting(setting); err != nil {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreatePaymentSettingWithTx(tx, setting); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, audit.ActionPaymentSettingCreate, setting.ID, nil, setting)
+	})
+	if err != nil {
 		hlog.Errorf("Failed to create payment setting: %v", err)
 		return &psModel.CreatePaymentSettingResp{
 			BaseResp: utils.BuildBaseResp(500, "创建收款钱包设置失败"),
@@ -155,6 +175,15 @@ func (s *PaymentSettingService) UpdatePaymentSetting(ctx context.Context, req *p
 		}, nil
 	}
 
+	// 修改收款地址、网络或启用状态都会改变用户充值的去向，需要两步验证码
+	if changesPaymentDestination(setting, req) && !twofactor.StepUpVerified(ctx) {
+		return &psModel.UpdatePaymentSettingResp{
+			BaseResp: utils.BuildBaseResp(403, twofactor.ErrStepUpRequired.Error()),
+		}, nil
+	}
+
+	before := *setting
+
 	// 更新字段
 	if req.Network != nil {
 		setting.Network = *req.Network
@@ -170,7 +199,13 @@ func (s *PaymentSettingService) UpdatePaymentSetting(ctx context.Context, req *p
 	}
 
 	// 更新设置
-	if err := s.repo.UpdatePaymentSetting(setting); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.UpdatePaymentSettingWithTx(tx, setting); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, audit.ActionPaymentSettingUpdate, setting.ID, &before, setting)
+	})
+	if err != nil {
 		hlog.Errorf("Failed to update payment setting: %v", err)
 		return &psModel.UpdatePaymentSettingResp{
 			BaseResp: utils.BuildBaseResp(500, "更新收款钱包设置失败"),
@@ -186,7 +221,7 @@ func (s *PaymentSettingService) UpdatePaymentSetting(ctx context.Context, req *p
 // DeletePaymentSetting 删除收款钱包设置
 func (s *PaymentSettingService) DeletePaymentSetting(ctx context.Context, req *psModel.DeletePaymentSettingReq) (*psModel.DeletePaymentSettingResp, error) {
 	// 检查设置是否存在
-	_, err := s.repo.GetPaymentSettingByID(req.ID)
+	setting, err := s.repo.GetPaymentSettingByID(req.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &psModel.DeletePaymentSettingResp{
@@ -199,8 +234,21 @@ func (s *PaymentSettingService) DeletePaymentSetting(ctx context.Context, req *p
 		}, nil
 	}
 
+	// 删除收款地址会改变用户充值的去向，需要两步验证码
+	if !twofactor.StepUpVerified(ctx) {
+		return &psModel.DeletePaymentSettingResp{
+			BaseResp: utils.BuildBaseResp(403, twofactor.ErrStepUpRequired.Error()),
+		}, nil
+	}
+
 	// 删除设置（软删除）
-	if err := s.repo.DeletePaymentSetting(req.ID); err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.DeletePaymentSettingWithTx(tx, req.ID); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, audit.ActionPaymentSettingDelete, setting.ID, setting, nil)
+	})
+	if err != nil {
 		hlog.Errorf("Failed to delete payment setting: %v", err)
 		return &psModel.DeletePaymentSettingResp{
 			BaseResp: utils.BuildBaseResp(500, "删除收款钱包设置失败"),
@@ -212,6 +260,30 @@ func (s *PaymentSettingService) DeletePaymentSetting(ctx context.Context, req *p
 	}, nil
 }
 
+// changesPaymentDestination 更新请求是否修改了收款地址、网络或启用状态
+func changesPaymentDestination(setting *model.OrbiaPaymentSetting, req *psModel.UpdatePaymentSettingReq) bool {
+	return (req.Address != nil && *req.Address != setting.Address) ||
+		(req.Network != nil && *req.Network != setting.Network) ||
+		(req.Status != nil && *req.Status != setting.Status)
+}
+
+// recordAudit 在事务中记录收款钱包设置的审计日志，写入失败时整个操作回滚
+func (s *PaymentSettingService) recordAudit(ctx context.Context, tx *gorm.DB, action string, settingID int64, before, after *model.OrbiaPaymentSetting) error {
+	entry := &audit.Entry{
+		Action:     action,
+		TargetType: audit.TargetPaymentSetting,
+		TargetID:   strconv.FormatInt(settingID, 10),
+	}
+	// 避免 nil 指针被序列化为 "null"
+	if before != nil {
+		entry.Before = before
+	}
+	if after != nil {
+		entry.After = after
+	}
+	return s.auditSvc.Record(ctx, tx, entry)
+}
+
 // ==================== 用户接口 ====================
 
 // GetActivePaymentSettings 获取启用的收款钱包设置列表
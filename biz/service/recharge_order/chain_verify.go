@@ -0,0 +1,226 @@
+package recharge_order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/service/chain"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	// verifyBatchSize 定时任务每次校验的订单数量
+	verifyBatchSize = 100
+	// txNotFoundTimeout 交易哈希在该时间内仍未上链时标记为待人工处理
+	txNotFoundTimeout = 24 * time.Hour
+	// verifyMessageMaxLength verify_message 字段长度
+	verifyMessageMaxLength = 500
+)
+
+// errTxHashAlreadyUsed 交易哈希已被其他已确认订单使用
+var errTxHashAlreadyUsed = errors.New("transaction hash has already been used by another confirmed recharge order")
+
+// VerifyCryptoRechargeOrder 链上校验加密货币充值订单
+// 校验转出地址、收款地址、代币合约、金额和确认数：通过时自动确认入账；交易未上链或确认数不足时保持待确认，
+// 等待下次校验；其他情况标记为 flagged，由管理员人工确认或拒绝
+func (s *rechargeOrderService) VerifyCryptoRechargeOrder(ctx context.Context, orderID string) (*model.OrbiaRechargeOrder, error) {
+	if s.chainRegistry == nil {
+		return nil, errors.New("on-chain verification is not configured")
+	}
+
+	order, err := s.rechargeOrderRepo.GetRechargeOrderByOrderID(orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("recharge order not found")
+		}
+		return nil, fmt.Errorf("failed to get recharge order: %v", err)
+	}
+
+	if order.PaymentType != "crypto" {
+		return nil, errors.New("only crypto recharge orders can be verified on chain")
+	}
+	if order.Status != "pending" {
+		return nil, fmt.Errorf("recharge order is not in pending status, current status: %s", order.Status)
+	}
+	if order.CryptoTxHash == nil || *order.CryptoTxHash == "" {
+		return nil, errors.New("recharge order has no transaction hash")
+	}
+	if order.PaymentNetwork == nil || order.PaymentAddress == nil {
+		return nil, errors.New("recharge order has no payment address snapshot")
+	}
+	if order.UserCryptoAddress == nil || *order.UserCryptoAddress == "" {
+		return nil, errors.New("recharge order has no sender address")
+	}
+
+	network, ok := s.chainRegistry.Lookup(*order.PaymentNetwork)
+	if !ok {
+		return nil, fmt.Errorf("on-chain verification is not supported for network %s", *order.PaymentNetwork)
+	}
+
+	verdict, err := network.Verify(ctx, chain.Expectation{
+		TxHash:    *order.CryptoTxHash,
+		Sender:    *order.UserCryptoAddress,
+		Recipient: *order.PaymentAddress,
+		Amount:    order.Amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify transaction: %v", err)
+	}
+
+	// 交易长时间未上链，交由管理员处理
+	if verdict.Status == chain.VerdictPending && verdict.Token == nil &&
+		order.CreatedAt != nil && time.Since(*order.CreatedAt) > txNotFoundTimeout {
+		verdict.Status = chain.VerdictFlagged
+		verdict.Reason = fmt.Sprintf("transaction not found on chain within %s", txNotFoundTimeout)
+	}
+
+	return s.applyVerdict(ctx, order.OrderID, *order.CryptoTxHash, verdict)
+}
+
+// VerifyPendingCryptoOrders 批量校验已填写交易哈希且尚未被标记的待确认订单
+func (s *rechargeOrderService) VerifyPendingCryptoOrders(ctx context.Context) error {
+	if s.chainRegistry == nil {
+		return nil
+	}
+
+	orders, err := s.rechargeOrderRepo.GetCryptoOrdersToVerify(verifyBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get recharge orders to verify: %v", err)
+	}
+
+	for _, order := range orders {
+		if order.PaymentNetwork == nil {
+			continue
+		}
+		if _, ok := s.chainRegistry.Lookup(*order.PaymentNetwork); !ok {
+			continue
+		}
+
+		verified, err := s.VerifyCryptoRechargeOrder(ctx, order.OrderID)
+		if err != nil {
+			hlog.Warnf("Failed to verify recharge order %s on chain: %v", order.OrderID, err)
+			continue
+		}
+		if verified.Status == "confirmed" {
+			hlog.Infof("Recharge order %s auto-confirmed after on-chain verification", order.OrderID)
+		} else if verified.VerifyStatus == "flagged" {
+			hlog.Warnf("Recharge order %s flagged by on-chain verification: %s", order.OrderID, stringValue(verified.VerifyMessage))
+		}
+	}
+	return nil
+}
+
+// applyVerdict 根据链上校验结果更新订单
+// txHash 为校验时订单上的交易哈希，加锁后若已被管理员修改则放弃本次结果
+func (s *rechargeOrderService) applyVerdict(ctx context.Context, orderID, txHash string, verdict *chain.Verdict) (*model.OrbiaRechargeOrder, error) {
+	message := truncateMessage(verdict.Reason)
+
+	if verdict.Status == chain.VerdictConfirmed {
+		order, err := s.confirmPendingOrder(ctx, orderID, nil, func(tx *gorm.DB, order *model.OrbiaRechargeOrder) error {
+			if err := checkTxHashUnchanged(order, txHash); err != nil {
+				return err
+			}
+
+			// 同一笔链上转账只能为一个订单入账
+			used, err := s.rechargeOrderRepo.IsTxHashConfirmed(tx, verdict.TxHash, order.OrderID)
+			if err != nil {
+				return fmt.Errorf("failed to check transaction hash: %v", err)
+			}
+			if used {
+				return errTxHashAlreadyUsed
+			}
+
+			now := time.Now()
+			order.CryptoTxHash = &verdict.TxHash
+			order.VerifyStatus = "verified"
+			order.VerifyMessage = &message
+			order.VerifiedAt = &now
+			appendRemark(order, "[Auto]: "+verdict.Reason)
+			return nil
+		})
+		if !errors.Is(err, errTxHashAlreadyUsed) {
+			return order, err
+		}
+
+		verdict.Status = chain.VerdictFlagged
+		message = errTxHashAlreadyUsed.Error()
+	}
+
+	var order *model.OrbiaRechargeOrder
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		order, err = s.getPendingRechargeOrderForUpdate(tx, orderID)
+		if err != nil {
+			return err
+		}
+		if err := checkTxHashUnchanged(order, txHash); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if verdict.Status == chain.VerdictFlagged {
+			order.VerifyStatus = "flagged"
+		}
+		order.VerifyMessage = &message
+		order.VerifiedAt = &now
+
+		if err := s.rechargeOrderRepo.UpdateRechargeOrderWithTx(tx, order); err != nil {
+			return fmt.Errorf("failed to update recharge order: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// StartChainVerifyJob 启动定时链上校验任务
+// interval 小于等于 0 时不启动
+func StartChainVerifyJob(svc RechargeOrderService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := svc.VerifyPendingCryptoOrders(context.Background()); err != nil {
+				hlog.Errorf("On-chain recharge verification failed: %v", err)
+			}
+		}
+	}()
+}
+
+// checkTxHashUnchanged 校验期间订单的交易哈希未被修改
+func checkTxHashUnchanged(order *model.OrbiaRechargeOrder, txHash string) error {
+	if order.CryptoTxHash == nil || *order.CryptoTxHash != txHash {
+		return errors.New("transaction hash changed during verification, please verify again")
+	}
+	return nil
+}
+
+// truncateMessage 截断校验说明，避免超出字段长度
+func truncateMessage(message string) string {
+	runes := []rune(message)
+	if len(runes) > verifyMessageMaxLength {
+		return string(runes[:verifyMessageMaxLength])
+	}
+	return message
+}
+
+// stringValue 获取字符串指针的值
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
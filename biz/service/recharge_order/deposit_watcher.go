@@ -0,0 +1,335 @@
+package recharge_order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/chain"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// ScanChainDeposits 扫描所有启用的收款地址的入账转账，并匹配待确认的充值订单
+// 每个网络独立保存扫描游标；入账记录按 (network, tx_hash, log_index) 去重，重复扫描不会重复入账
+func (s *rechargeOrderService) ScanChainDeposits(ctx context.Context) error {
+	if s.chainRegistry == nil {
+		return nil
+	}
+
+	settings, err := s.paymentSettingRepo.GetActivePaymentSettings("")
+	if err != nil {
+		return fmt.Errorf("failed to get payment settings: %v", err)
+	}
+
+	// 按网络汇总收款地址
+	addresses := make(map[*chain.Network][]string)
+	for _, setting := range settings {
+		network, ok := s.chainRegistry.Lookup(setting.Network)
+		if !ok {
+			continue
+		}
+		addresses[network] = append(addresses[network], setting.Address)
+	}
+
+	for _, network := range s.chainRegistry.Networks() {
+		recipients, ok := addresses[network]
+		if !ok {
+			continue
+		}
+		if err := s.scanNetworkDeposits(ctx, network, recipients); err != nil {
+			hlog.Errorf("Failed to scan deposits on %s: %v", network.Name, err)
+		}
+	}
+	return nil
+}
+
+// scanNetworkDeposits 扫描单个网络的入账转账
+func (s *rechargeOrderService) scanNetworkDeposits(ctx context.Context, network *chain.Network, recipients []string) error {
+	cursor, err := s.chainDepositRepo.GetCursor(network.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get cursor: %v", err)
+	}
+
+	deposits, next, err := network.ScanDeposits(ctx, recipients, cursor)
+	if err != nil {
+		if errors.Is(err, chain.ErrScanNotSupported) {
+			return nil
+		}
+		return err
+	}
+
+	for _, d := range deposits {
+		deposit := &model.OrbiaChainDeposit{
+			Network:       network.Name,
+			TxHash:        d.TxHash,
+			LogIndex:      uint32(d.LogIndex),
+			BlockNumber:   d.BlockNumber,
+			TokenSymbol:   d.Token.Symbol,
+			TokenContract: d.Token.Contract,
+			FromAddress:   d.From,
+			ToAddress:     d.To,
+			Amount:        d.Amount,
+			Status:        "unmatched",
+		}
+
+		created, err := s.chainDepositRepo.CreateDepositIfAbsent(deposit)
+		if err != nil {
+			// 保存失败时不推进游标，下次重新扫描
+			return fmt.Errorf("failed to save deposit %s: %v", d.TxHash, err)
+		}
+		if !created {
+			continue
+		}
+
+		if err := s.matchDeposit(ctx, network, deposit); err != nil {
+			hlog.Warnf("Deposit %d (%s) left for manual review: %v", deposit.ID, deposit.TxHash, err)
+		}
+	}
+
+	if next != cursor {
+		if err := s.chainDepositRepo.SaveCursor(network.Name, next); err != nil {
+			return fmt.Errorf("failed to save cursor: %v", err)
+		}
+	}
+	return nil
+}
+
+// matchDeposit 为新的入账记录匹配待确认的充值订单并确认入账
+// 匹配条件：同一网络、收款地址一致、转出地址为订单的 user_crypto_address、金额一致；多笔订单符合时取最早的一笔
+func (s *rechargeOrderService) matchDeposit(ctx context.Context, network *chain.Network, deposit *model.OrbiaChainDeposit) error {
+	// 该笔转账已通过交易哈希校验（或人工确认）入账
+	if order, err := s.rechargeOrderRepo.GetConfirmedOrderByTxHash(deposit.TxHash); err == nil {
+		return s.markDepositMatched(deposit.ID, order.OrderID, "transaction already confirmed by recharge order "+order.OrderID)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to check transaction hash: %v", err)
+	}
+
+	orders, err := s.rechargeOrderRepo.GetPendingCryptoOrdersByAmount(deposit.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to get pending recharge orders: %v", err)
+	}
+
+	for _, order := range orders {
+		if order.PaymentNetwork == nil || order.PaymentAddress == nil || order.UserCryptoAddress == nil {
+			continue
+		}
+		if orderNetwork, ok := s.chainRegistry.Lookup(*order.PaymentNetwork); !ok || orderNetwork != network {
+			continue
+		}
+		if to, err := network.Verifier.NormalizeAddress(*order.PaymentAddress); err != nil || to != deposit.ToAddress {
+			continue
+		}
+		if from, err := network.Verifier.NormalizeAddress(*order.UserCryptoAddress); err != nil || from != deposit.FromAddress {
+			continue
+		}
+
+		remark := fmt.Sprintf("[Auto]: matched on-chain deposit %s (%s %s)", deposit.TxHash, deposit.Amount, deposit.TokenSymbol)
+		if _, err := s.confirmDeposit(ctx, deposit.ID, order.OrderID, nil, remark); err != nil {
+			hlog.Warnf("Failed to confirm recharge order %s with deposit %s: %v", order.OrderID, deposit.TxHash, err)
+			continue
+		}
+		hlog.Infof("Recharge order %s auto-confirmed by deposit %s", order.OrderID, deposit.TxHash)
+		return nil
+	}
+
+	return errors.New("no matching pending recharge order")
+}
+
+// confirmDeposit 使用入账记录确认充值订单，与 ConfirmRechargeOrder 走同一入账流程
+// confirmedBy 为空表示自动匹配
+func (s *rechargeOrderService) confirmDeposit(ctx context.Context, depositID int64, orderID string, confirmedBy *int64, remark string) (*model.OrbiaChainDeposit, error) {
+	var deposit *model.OrbiaChainDeposit
+	_, err := s.confirmPendingOrder(ctx, orderID, confirmedBy, func(tx *gorm.DB, order *model.OrbiaRechargeOrder) error {
+		var err error
+		deposit, err = s.getUnmatchedDepositForUpdate(tx, depositID)
+		if err != nil {
+			return err
+		}
+
+		if order.PaymentType != "crypto" {
+			return errors.New("only crypto recharge orders can be matched with deposits")
+		}
+		if order.Amount != deposit.Amount {
+			return fmt.Errorf("amount mismatch: order %s, deposit %s", order.Amount, deposit.Amount)
+		}
+
+		// 同一笔链上转账只能为一个订单入账
+		used, err := s.rechargeOrderRepo.IsTxHashConfirmed(tx, deposit.TxHash, order.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to check transaction hash: %v", err)
+		}
+		if used {
+			return errTxHashAlreadyUsed
+		}
+
+		now := time.Now()
+		message := truncateMessage(fmt.Sprintf("matched on-chain deposit %s/%d", deposit.TxHash, deposit.LogIndex))
+		order.CryptoTxHash = &deposit.TxHash
+		order.VerifyStatus = "verified"
+		order.VerifyMessage = &message
+		order.VerifiedAt = &now
+		appendRemark(order, remark)
+
+		before := chainDepositSnapshot(deposit)
+		deposit.Status = "matched"
+		deposit.RechargeOrderID = &order.OrderID
+		deposit.ResolvedBy = confirmedBy
+		deposit.ResolvedAt = &now
+		if err := s.chainDepositRepo.UpdateDepositWithTx(tx, deposit); err != nil {
+			return fmt.Errorf("failed to update deposit: %v", err)
+		}
+
+		return s.recordAdminAudit(ctx, tx, &audit.Entry{
+			Action:     audit.ActionChainDepositResolve,
+			TargetType: audit.TargetChainDeposit,
+			TargetID:   strconv.FormatInt(deposit.ID, 10),
+			Before:     before,
+			After:      chainDepositSnapshot(deposit),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deposit, nil
+}
+
+// markDepositMatched 将入账记录关联到已确认的充值订单（不再入账）
+func (s *rechargeOrderService) markDepositMatched(depositID int64, orderID, remark string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		deposit, err := s.getUnmatchedDepositForUpdate(tx, depositID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		deposit.Status = "matched"
+		deposit.RechargeOrderID = &orderID
+		deposit.ResolvedAt = &now
+		deposit.Remark = &remark
+		if err := s.chainDepositRepo.UpdateDepositWithTx(tx, deposit); err != nil {
+			return fmt.Errorf("failed to update deposit: %v", err)
+		}
+		return nil
+	})
+}
+
+// GetChainDeposits 获取链上入账记录列表（管理员审核队列）
+func (s *rechargeOrderService) GetChainDeposits(status, network *string, page, pageSize int) ([]*model.OrbiaChainDeposit, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	return s.chainDepositRepo.GetDeposits(status, network, page, pageSize)
+}
+
+// ResolveChainDeposit 处理未匹配的入账记录（管理员）
+// action 为 match 时将入账记录匹配到指定的待确认充值订单并确认入账，为 ignore 时忽略该入账记录
+func (s *rechargeOrderService) ResolveChainDeposit(ctx context.Context, adminUserID, depositID int64, action string, orderID, remark *string) (*model.OrbiaChainDeposit, error) {
+	switch action {
+	case "match":
+		if orderID == nil || *orderID == "" {
+			return nil, errors.New("order_id is required to match a deposit")
+		}
+
+		line := "[Admin]: matched on-chain deposit"
+		if remark != nil && *remark != "" {
+			line += ": " + *remark
+		}
+		return s.confirmDeposit(ctx, depositID, *orderID, &adminUserID, line)
+
+	case "ignore":
+		var deposit *model.OrbiaChainDeposit
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			deposit, err = s.getUnmatchedDepositForUpdate(tx, depositID)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			before := chainDepositSnapshot(deposit)
+			deposit.Status = "ignored"
+			deposit.ResolvedBy = &adminUserID
+			deposit.ResolvedAt = &now
+			deposit.Remark = remark
+			if err := s.chainDepositRepo.UpdateDepositWithTx(tx, deposit); err != nil {
+				return fmt.Errorf("failed to update deposit: %v", err)
+			}
+
+			return s.auditSvc.Record(ctx, tx, &audit.Entry{
+				Action:     audit.ActionChainDepositResolve,
+				TargetType: audit.TargetChainDeposit,
+				TargetID:   strconv.FormatInt(deposit.ID, 10),
+				Before:     before,
+				After:      chainDepositSnapshot(deposit),
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return deposit, nil
+
+	default:
+		return nil, fmt.Errorf("invalid action: %s", action)
+	}
+}
+
+// chainDepositSnapshot 构建链上入账记录的审计快照
+func chainDepositSnapshot(deposit *model.OrbiaChainDeposit) map[string]interface{} {
+	return map[string]interface{}{
+		"tx_hash":           deposit.TxHash,
+		"amount":            deposit.Amount,
+		"status":            deposit.Status,
+		"recharge_order_id": stringValue(deposit.RechargeOrderID),
+		"resolved_by":       deposit.ResolvedBy,
+		"remark":            stringValue(deposit.Remark),
+	}
+}
+
+// getUnmatchedDepositForUpdate 获取未匹配的入账记录并加行锁（在事务中执行）
+func (s *rechargeOrderService) getUnmatchedDepositForUpdate(tx *gorm.DB, depositID int64) (*model.OrbiaChainDeposit, error) {
+	deposit, err := s.chainDepositRepo.GetDepositByIDForUpdate(tx, depositID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("deposit not found")
+		}
+		return nil, fmt.Errorf("failed to get deposit: %v", err)
+	}
+
+	if deposit.Status != "unmatched" {
+		return nil, fmt.Errorf("deposit is not in unmatched status, current status: %s", deposit.Status)
+	}
+	return deposit, nil
+}
+
+// StartDepositWatcher 启动链上入账扫描任务
+// interval 小于等于 0 时不启动
+func StartDepositWatcher(svc RechargeOrderService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := svc.ScanChainDeposits(context.Background()); err != nil {
+				hlog.Errorf("Chain deposit scan failed: %v", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,95 @@
+package recharge_order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/service/payment"
+	"orbia_api/biz/utils/money"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// HandlePaymentWebhook 处理在线支付平台的 webhook 通知
+// 签名校验不通过时返回 payment.ErrInvalidSignature；支付平台会重复推送同一事件，
+// 订单已处理过时直接返回订单，不会重复入账
+func (s *rechargeOrderService) HandlePaymentWebhook(
+	ctx context.Context,
+	platform string,
+	header http.Header,
+	body []byte,
+) (*model.OrbiaRechargeOrder, error) {
+	provider, ok := s.paymentRegistry.Lookup(platform)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", payment.ErrUnsupportedPlatform, platform)
+	}
+
+	event, err := provider.HandleWebhook(ctx, header, body)
+	if err != nil {
+		return nil, err
+	}
+	if event.Type == payment.EventIgnored || event.OrderID == "" {
+		return nil, nil
+	}
+
+	order, err := s.rechargeOrderRepo.GetRechargeOrderByOrderID(event.OrderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("recharge order not found")
+		}
+		return nil, fmt.Errorf("failed to get recharge order: %v", err)
+	}
+	if err := checkPaymentEvent(order, provider.Name(), event); err != nil {
+		return nil, err
+	}
+
+	if order.Status != "pending" {
+		if event.Type == payment.EventPaymentSucceeded && order.Status != "confirmed" {
+			// 订单已失败或取消后才收到支付成功通知，需要人工退款或补单
+			hlog.Errorf("Recharge order %s is %s but %s reports a successful payment (event %s), manual review required",
+				order.OrderID, order.Status, provider.Name(), event.ID)
+		}
+		return order, nil
+	}
+
+	switch event.Type {
+	case payment.EventPaymentSucceeded:
+		return s.confirmPendingOrder(ctx, order.OrderID, nil, func(tx *gorm.DB, order *model.OrbiaRechargeOrder) error {
+			if err := checkPaymentEvent(order, provider.Name(), event); err != nil {
+				return err
+			}
+			if event.Currency != money.USD.Code || event.Amount != order.Amount {
+				return fmt.Errorf("amount mismatch: order %s USD, paid %s %s", order.Amount, event.Amount, event.Currency)
+			}
+
+			if order.OnlinePaymentOrderID == nil && event.ProviderOrderID != "" {
+				order.OnlinePaymentOrderID = &event.ProviderOrderID
+			}
+			appendRemark(order, fmt.Sprintf("[Webhook]: %s payment succeeded (event %s)", provider.Name(), event.ID))
+			return nil
+		})
+
+	case payment.EventPaymentFailed:
+		return s.failPendingOrder(ctx, order.OrderID, event.Reason, func(order *model.OrbiaRechargeOrder) error {
+			return checkPaymentEvent(order, provider.Name(), event)
+		})
+	}
+	return order, nil
+}
+
+// checkPaymentEvent 校验 webhook 事件与充值订单的支付平台、支付平台订单号一致
+func checkPaymentEvent(order *model.OrbiaRechargeOrder, platform string, event *payment.Event) error {
+	if order.PaymentType != "online" || order.OnlinePaymentPlatform == nil ||
+		!strings.EqualFold(*order.OnlinePaymentPlatform, platform) {
+		return fmt.Errorf("recharge order %s is not paid via %s", order.OrderID, platform)
+	}
+	if order.OnlinePaymentOrderID != nil && event.ProviderOrderID != "" && *order.OnlinePaymentOrderID != event.ProviderOrderID {
+		return fmt.Errorf("payment order mismatch: order %s, event %s", *order.OnlinePaymentOrderID, event.ProviderOrderID)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package recharge_order
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"orbia_api/biz/dal/model"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/service/ledger"
+	"orbia_api/biz/service/payment"
+	"orbia_api/biz/utils/money"
+
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testWebhookSecret = "whsec_test"
+
+// txOnlyDriver 只支持开启/提交/回滚事务的 database/sql 驱动，
+// 让 db.Transaction 可以在没有 MySQL 的情况下执行，读写由内存仓库完成
+type txOnlyDriver struct{}
+
+func (txOnlyDriver) Open(string) (driver.Conn, error) { return txOnlyConn{}, nil }
+
+type txOnlyConn struct{}
+
+func (txOnlyConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("txOnlyDriver does not execute statements: " + query)
+}
+func (txOnlyConn) Close() error              { return nil }
+func (txOnlyConn) Begin() (driver.Tx, error) { return txOnlyTx{}, nil }
+
+type txOnlyTx struct{}
+
+func (txOnlyTx) Commit() error   { return nil }
+func (txOnlyTx) Rollback() error { return nil }
+
+func init() {
+	sql.Register("orbia_tx_only", txOnlyDriver{})
+}
+
+func newTxOnlyDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	sqlDB, err := sql.Open("orbia_tx_only", "")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	return db
+}
+
+// memRechargeOrderRepository 内存充值订单仓库，记录订单更新次数
+type memRechargeOrderRepository struct {
+	mysql.RechargeOrderRepository
+	order   model.OrbiaRechargeOrder
+	updates int
+}
+
+func (r *memRechargeOrderRepository) GetRechargeOrderByOrderID(orderID string) (*model.OrbiaRechargeOrder, error) {
+	if orderID != r.order.OrderID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	order := r.order
+	return &order, nil
+}
+
+func (r *memRechargeOrderRepository) GetRechargeOrderByOrderIDForUpdate(_ *gorm.DB, orderID string) (*model.OrbiaRechargeOrder, error) {
+	return r.GetRechargeOrderByOrderID(orderID)
+}
+
+func (r *memRechargeOrderRepository) UpdateRechargeOrderWithTx(_ *gorm.DB, order *model.OrbiaRechargeOrder) error {
+	r.updates++
+	r.order = *order
+	return nil
+}
+
+// memWalletRepository 内存钱包仓库，余额变动由 memLedgerService 完成
+type memWalletRepository struct {
+	mysql.WalletRepository
+	balance money.Amount
+}
+
+func (r *memWalletRepository) GetWalletByUserIDForUpdate(_ *gorm.DB, userID int64) (*model.OrbiaWallet, error) {
+	return &model.OrbiaWallet{UserID: userID, Balance: r.balance}, nil
+}
+
+func (r *memWalletRepository) UpdateWalletTotals(*gorm.DB, int64, money.Amount, money.Amount) error {
+	return nil
+}
+
+type memTransactionRepository struct {
+	mysql.TransactionRepository
+}
+
+func (memTransactionRepository) CreateTransaction(*gorm.DB, *model.OrbiaTransaction) error {
+	return nil
+}
+
+// memLedgerService 记录入账金额
+type memLedgerService struct {
+	ledger.LedgerService
+	wallet *memWalletRepository
+}
+
+func (s *memLedgerService) Post(_ *gorm.DB, entry *ledger.Entry) (string, error) {
+	for _, line := range entry.Lines {
+		if line.Account.Type == ledger.AccountUserWallet && line.Direction == ledger.DirectionCredit {
+			s.wallet.balance += line.Amount
+		}
+	}
+	return "LEDGER_1", nil
+}
+
+// newWebhookTestService 创建只配置了模拟支付的充值订单服务，订单为 100.00 USD 的待支付订单
+func newWebhookTestService(t *testing.T) (*rechargeOrderService, *memRechargeOrderRepository, *memWalletRepository) {
+	t.Helper()
+	platform := "fake"
+	orders := &memRechargeOrderRepository{order: model.OrbiaRechargeOrder{
+		OrderID:               "RCHORD_1",
+		UserID:                1,
+		Amount:                money.FromCents(10000),
+		PaymentType:           "online",
+		OnlinePaymentPlatform: &platform,
+		Status:                "pending",
+	}}
+	wallet := &memWalletRepository{}
+
+	registry := payment.NewRegistry(config.PaymentConfig{})
+	registry.Register(payment.NewFakeProvider("", testWebhookSecret))
+
+	svc := &rechargeOrderService{
+		db:                newTxOnlyDB(t),
+		rechargeOrderRepo: orders,
+		walletRepo:        wallet,
+		txRepo:            memTransactionRepository{},
+		ledgerSvc:         &memLedgerService{wallet: wallet},
+		paymentRegistry:   registry,
+	}
+	return svc, orders, wallet
+}
+
+// fakeWebhook 构造签名正确的模拟支付成功通知
+func fakeWebhook(amount, currency string) (http.Header, []byte) {
+	body := []byte(`{"id":"evt_1","type":"payment.succeeded","order_id":"RCHORD_1","amount":"` + amount + `","currency":"` + currency + `"}`)
+	header := http.Header{}
+	header.Set(payment.FakeSignatureHeader, payment.SignFakeWebhook(testWebhookSecret, body))
+	return header, body
+}
+
+func TestHandlePaymentWebhookAmountMismatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+	}{
+		{name: "underpaid", amount: "99.99", currency: "USD"},
+		{name: "overpaid", amount: "100.01", currency: "USD"},
+		{name: "currency mismatch", amount: "100.00", currency: "EUR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, orders, wallet := newWebhookTestService(t)
+			header, body := fakeWebhook(tt.amount, tt.currency)
+
+			order, err := svc.HandlePaymentWebhook(context.Background(), "fake", header, body)
+			if err == nil || !strings.Contains(err.Error(), "amount mismatch") {
+				t.Fatalf("order = %+v, err = %v; want an amount mismatch error", order, err)
+			}
+			if orders.updates != 0 || orders.order.Status != "pending" {
+				t.Fatalf("order must stay pending, got status %s after %d updates", orders.order.Status, orders.updates)
+			}
+			if wallet.balance != 0 {
+				t.Fatalf("wallet credited %s on a mismatched payment", wallet.balance)
+			}
+		})
+	}
+}
+
+func TestHandlePaymentWebhookConfirmsMatchingPayment(t *testing.T) {
+	svc, orders, wallet := newWebhookTestService(t)
+
+	// 货币代码不区分大小写
+	header, body := fakeWebhook("100.00", "usd")
+	order, err := svc.HandlePaymentWebhook(context.Background(), "fake", header, body)
+	if err != nil {
+		t.Fatalf("handle webhook: %v", err)
+	}
+	if order.Status != "confirmed" || orders.order.Status != "confirmed" {
+		t.Fatalf("order status = %s, stored %s; want confirmed", order.Status, orders.order.Status)
+	}
+	if wallet.balance != money.FromCents(10000) {
+		t.Fatalf("wallet balance = %s, want 100.00", wallet.balance)
+	}
+
+	// 重复推送同一事件不会重复入账
+	if _, err := svc.HandlePaymentWebhook(context.Background(), "fake", header, body); err != nil {
+		t.Fatalf("replayed webhook: %v", err)
+	}
+	if orders.updates != 1 || wallet.balance != money.FromCents(10000) {
+		t.Fatalf("replayed webhook updated the order %d times, balance %s", orders.updates, wallet.balance)
+	}
+}
+
+func TestHandlePaymentWebhookInvalidSignature(t *testing.T) {
+	svc, orders, _ := newWebhookTestService(t)
+	header, _ := fakeWebhook("100.00", "USD")
+	_, body := fakeWebhook("1.00", "USD")
+
+	if _, err := svc.HandlePaymentWebhook(context.Background(), "fake", header, body); !errors.Is(err, payment.ErrInvalidSignature) {
+		t.Fatalf("err = %v, want ErrInvalidSignature", err)
+	}
+	if orders.updates != 0 {
+		t.Fatal("order must not be updated for an invalid signature")
+	}
+}
@@ -1,33 +1,56 @@
 package recharge_order
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/service/audit"
+	"orbia_api/biz/service/chain"
+	"orbia_api/biz/service/ledger"
+	"orbia_api/biz/service/payment"
+	"orbia_api/biz/service/twofactor"
 	"orbia_api/biz/utils"
+	"orbia_api/biz/utils/money"
 
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"gorm.io/gorm"
 )
 
 // RechargeOrderService 充值订单服务接口
 type RechargeOrderService interface {
 	// CreateCryptoRechargeOrder 创建加密货币充值订单
-	CreateCryptoRechargeOrder(userID int64, amount float64, paymentSettingID int64, userCryptoAddress string, cryptoTxHash, remark *string) (*model.OrbiaRechargeOrder, error)
+	CreateCryptoRechargeOrder(userID int64, amount money.Amount, paymentSettingID int64, userCryptoAddress string, cryptoTxHash, remark *string) (*model.OrbiaRechargeOrder, error)
 	// CreateOnlineRechargeOrder 创建在线支付充值订单
-	CreateOnlineRechargeOrder(userID int64, amount float64, platform string) (*model.OrbiaRechargeOrder, string, error)
+	CreateOnlineRechargeOrder(ctx context.Context, userID int64, amount money.Amount, platform string) (*model.OrbiaRechargeOrder, string, error)
+	// HandlePaymentWebhook 处理在线支付平台的 webhook 通知，验签后确认或置失败充值订单
+	HandlePaymentWebhook(ctx context.Context, platform string, header http.Header, body []byte) (*model.OrbiaRechargeOrder, error)
 	// GetMyRechargeOrders 获取用户自己的充值订单列表
 	GetMyRechargeOrders(userID int64, status *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error)
 	// GetRechargeOrderDetail 获取充值订单详情
 	GetRechargeOrderDetail(userID int64, orderID string, isAdmin bool) (*model.OrbiaRechargeOrder, error)
 	// GetAllRechargeOrders 获取所有充值订单列表（管理员）
-	GetAllRechargeOrders(userID *int64, status, paymentType *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error)
+	GetAllRechargeOrders(userID *int64, status, paymentType, verifyStatus *string, page, pageSize int) ([]*model.OrbiaRechargeOrder, int64, error)
 	// ConfirmRechargeOrder 确认充值订单（管理员）
-	ConfirmRechargeOrder(adminUserID int64, orderID string, cryptoTxHash, remark *string) (*model.OrbiaRechargeOrder, error)
+	ConfirmRechargeOrder(ctx context.Context, adminUserID int64, orderID string, cryptoTxHash, remark *string) (*model.OrbiaRechargeOrder, error)
 	// RejectRechargeOrder 拒绝充值订单（管理员）
-	RejectRechargeOrder(adminUserID int64, orderID string, failedReason string) (*model.OrbiaRechargeOrder, error)
+	RejectRechargeOrder(ctx context.Context, adminUserID int64, orderID string, failedReason string) (*model.OrbiaRechargeOrder, error)
+	// VerifyCryptoRechargeOrder 链上校验加密货币充值订单，通过时自动确认，不通过时标记待人工处理
+	VerifyCryptoRechargeOrder(ctx context.Context, orderID string) (*model.OrbiaRechargeOrder, error)
+	// VerifyPendingCryptoOrders 批量校验已填写交易哈希的待确认订单（定时任务）
+	VerifyPendingCryptoOrders(ctx context.Context) error
+	// ScanChainDeposits 扫描收款地址的链上入账并匹配待确认订单（定时任务）
+	ScanChainDeposits(ctx context.Context) error
+	// GetChainDeposits 获取链上入账记录列表（管理员）
+	GetChainDeposits(status, network *string, page, pageSize int) ([]*model.OrbiaChainDeposit, int64, error)
+	// ResolveChainDeposit 处理未匹配的链上入账记录（管理员）
+	ResolveChainDeposit(ctx context.Context, adminUserID, depositID int64, action string, orderID, remark *string) (*model.OrbiaChainDeposit, error)
 }
 
 // rechargeOrderService 充值订单服务实现
@@ -36,6 +59,12 @@ type rechargeOrderService struct {
 	rechargeOrderRepo  mysql.RechargeOrderRepository
 	paymentSettingRepo mysql.PaymentSettingRepository
 	walletRepo         mysql.WalletRepository
+	txRepo             mysql.TransactionRepository
+	ledgerSvc          ledger.LedgerService
+	chainRegistry      *chain.Registry
+	chainDepositRepo   mysql.ChainDepositRepository
+	paymentRegistry    *payment.Registry
+	auditSvc           audit.AuditService
 }
 
 // NewRechargeOrderService 创建充值订单服务实例
@@ -44,19 +73,31 @@ func NewRechargeOrderService(
 	rechargeOrderRepo mysql.RechargeOrderRepository,
 	paymentSettingRepo mysql.PaymentSettingRepository,
 	walletRepo mysql.WalletRepository,
+	txRepo mysql.TransactionRepository,
+	ledgerSvc ledger.LedgerService,
+	chainRegistry *chain.Registry,
+	chainDepositRepo mysql.ChainDepositRepository,
+	paymentRegistry *payment.Registry,
+	auditSvc audit.AuditService,
 ) RechargeOrderService {
 	return &rechargeOrderService{
 		db:                 db,
 		rechargeOrderRepo:  rechargeOrderRepo,
 		paymentSettingRepo: paymentSettingRepo,
 		walletRepo:         walletRepo,
+		txRepo:             txRepo,
+		ledgerSvc:          ledgerSvc,
+		chainRegistry:      chainRegistry,
+		chainDepositRepo:   chainDepositRepo,
+		paymentRegistry:    paymentRegistry,
+		auditSvc:           auditSvc,
 	}
 }
 
 // CreateCryptoRechargeOrder 创建加密货币充值订单
 func (s *rechargeOrderService) CreateCryptoRechargeOrder(
 	userID int64,
-	amount float64,
+	amount money.Amount,
 	paymentSettingID int64,
 	userCryptoAddress string,
 	cryptoTxHash, remark *string,
@@ -117,15 +158,23 @@ func (s *rechargeOrderService) CreateCryptoRechargeOrder(
 }
 
 // CreateOnlineRechargeOrder 创建在线支付充值订单
+// 先保存待支付订单，再调用支付平台创建支付会话；会话创建失败时订单置为失败
 func (s *rechargeOrderService) CreateOnlineRechargeOrder(
+	ctx context.Context,
 	userID int64,
-	amount float64,
+	amount money.Amount,
 	platform string,
 ) (*model.OrbiaRechargeOrder, string, error) {
 	if amount <= 0 {
 		return nil, "", errors.New("invalid amount")
 	}
 
+	provider, ok := s.paymentRegistry.Lookup(platform)
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s", payment.ErrUnsupportedPlatform, platform)
+	}
+	platformName := provider.Name()
+
 	// 生成订单ID
 	timestamp := time.Now().Unix()
 	id, err := utils.GetDefaultGenerator().NextID()
@@ -141,24 +190,39 @@ func (s *rechargeOrderService) CreateOnlineRechargeOrder(
 		UserID:                userID,
 		Amount:                amount,
 		PaymentType:           paymentType,
-		OnlinePaymentPlatform: &platform,
+		OnlinePaymentPlatform: &platformName,
 		Status:                status,
 	}
 
-	// TODO: 这里应该调用第三方支付平台API创建支付订单
-	// 目前只是预留字段，返回空URL
-	paymentURL := ""
-	// 示例：如果是Stripe，这里会返回Stripe的支付URL
-	// if platform == "stripe" {
-	//     paymentURL = createStripePaymentSession(order.OrderID, amount)
-	// }
-
 	// 保存充值订单
 	if err := s.rechargeOrderRepo.CreateRechargeOrder(order); err != nil {
 		return nil, "", fmt.Errorf("failed to create recharge order: %v", err)
 	}
 
-	return order, paymentURL, nil
+	// 调用支付平台创建支付会话
+	successURL, cancelURL := s.paymentRegistry.ReturnURLs(order.OrderID)
+	checkout, err := provider.CreateCheckout(ctx, &payment.CheckoutRequest{
+		OrderID:     order.OrderID,
+		Amount:      amount,
+		Currency:    money.USD.Code,
+		Description: "Orbia wallet recharge " + order.OrderID,
+		SuccessURL:  successURL,
+		CancelURL:   cancelURL,
+	})
+	if err != nil {
+		if _, failErr := s.failPendingOrder(ctx, order.OrderID, "failed to create payment checkout", nil); failErr != nil {
+			hlog.Errorf("Failed to mark recharge order %s as failed: %v", order.OrderID, failErr)
+		}
+		return nil, "", fmt.Errorf("failed to create payment checkout: %v", err)
+	}
+
+	order.OnlinePaymentOrderID = &checkout.ProviderOrderID
+	order.OnlinePaymentURL = &checkout.PaymentURL
+	if err := s.rechargeOrderRepo.UpdateRechargeOrder(order); err != nil {
+		return nil, "", fmt.Errorf("failed to update recharge order: %v", err)
+	}
+
+	return order, checkout.PaymentURL, nil
 }
 
 // GetMyRechargeOrders 获取用户自己的充值订单列表
@@ -205,7 +269,7 @@ func (s *rechargeOrderService) GetRechargeOrderDetail(
 // GetAllRechargeOrders 获取所有充值订单列表（管理员）
 func (s *rechargeOrderService) GetAllRechargeOrders(
 	userID *int64,
-	status, paymentType *string,
+	status, paymentType, verifyStatus *string,
 	page, pageSize int,
 ) ([]*model.OrbiaRechargeOrder, int64, error) {
 	if page < 1 {
@@ -218,85 +282,156 @@ func (s *rechargeOrderService) GetAllRechargeOrders(
 		pageSize = 100
 	}
 
-	return s.rechargeOrderRepo.GetAllRechargeOrders(userID, status, paymentType, page, pageSize)
+	return s.rechargeOrderRepo.GetAllRechargeOrders(userID, status, paymentType, verifyStatus, page, pageSize)
 }
 
 // ConfirmRechargeOrder 确认充值订单（管理员）
 func (s *rechargeOrderService) ConfirmRechargeOrder(
+	ctx context.Context,
 	adminUserID int64,
 	orderID string,
 	cryptoTxHash, remark *string,
 ) (*model.OrbiaRechargeOrder, error) {
-	// 开始事务
-	tx := s.db.Begin()
-	if tx.Error != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %v", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+	return s.confirmPendingOrder(ctx, orderID, &adminUserID, func(tx *gorm.DB, order *model.OrbiaRechargeOrder) error {
+		// 大额订单需要两步验证码
+		if requiresStepUp(order.Amount) && !twofactor.StepUpVerified(ctx) {
+			return twofactor.ErrStepUpRequired
 		}
-	}()
 
-	// 获取充值订单
-	order, err := s.rechargeOrderRepo.GetRechargeOrderByOrderID(orderID)
-	if err != nil {
-		tx.Rollback()
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("recharge order not found")
+		if cryptoTxHash != nil && *cryptoTxHash != "" {
+			order.CryptoTxHash = cryptoTxHash
 		}
-		return nil, fmt.Errorf("failed to get recharge order: %v", err)
-	}
-
-	// 检查订单状态
-	if order.Status != "pending" {
-		tx.Rollback()
-		return nil, fmt.Errorf("recharge order is not in pending status, current status: %s", order.Status)
-	}
 
-	// 更新订单状态
-	now := time.Now()
-	order.Status = "confirmed"
-	order.ConfirmedBy = &adminUserID
-	order.ConfirmedAt = &now
+		if remark != nil && *remark != "" {
+			appendRemark(order, "[Admin]: "+*remark)
+		}
+		return nil
+	})
+}
 
-	if cryptoTxHash != nil && *cryptoTxHash != "" {
-		order.CryptoTxHash = cryptoTxHash
+// claimTxHash 登记加密货币充值订单入账使用的交易哈希（在事务中执行），已被其他订单登记时返回 errTxHashAlreadyUsed
+// 已配置链上校验的网络使用配置的网络标识和规范化后的交易哈希，与入账扫描记录的格式一致
+func (s *rechargeOrderService) claimTxHash(tx *gorm.DB, order *model.OrbiaRechargeOrder) error {
+	if order.PaymentType != "crypto" || order.CryptoTxHash == nil || strings.TrimSpace(*order.CryptoTxHash) == "" {
+		return nil
 	}
 
-	if remark != nil && *remark != "" {
-		if order.Remark != nil && *order.Remark != "" {
-			combinedRemark := *order.Remark + "\n[Admin]: " + *remark
-			order.Remark = &combinedRemark
-		} else {
-			adminRemark := "[Admin]: " + *remark
-			order.Remark = &adminRemark
+	networkName := stringValue(order.PaymentNetwork)
+	txHash := strings.TrimSpace(*order.CryptoTxHash)
+	if network, ok := s.chainRegistry.Lookup(networkName); ok {
+		networkName = network.Name
+		if normalized, err := network.Verifier.NormalizeTxHash(txHash); err == nil {
+			txHash = normalized
 		}
 	}
 
-	if err := s.rechargeOrderRepo.UpdateRechargeOrder(order); err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("failed to update recharge order: %v", err)
-	}
-
-	// 更新用户钱包余额
-	wallet, err := s.walletRepo.GetWalletByUserID(order.UserID)
+	claimed, err := s.chainDepositRepo.ClaimTxHashWithTx(tx, &model.OrbiaChainTxClaim{
+		Network:         networkName,
+		TxHash:          txHash,
+		RechargeOrderID: order.OrderID,
+	})
 	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("failed to get user wallet: %v", err)
+		return fmt.Errorf("failed to claim transaction hash: %v", err)
+	}
+	if !claimed {
+		return errTxHashAlreadyUsed
 	}
+	return nil
+}
+
+// confirmPendingOrder 确认待处理的充值订单并入账
+// 订单和钱包均在事务中加行锁，防止同一订单被重复确认入账；
+// prepare 在订单加锁后调用，用于补充确认信息或做最终校验，返回错误时整个事务回滚
+func (s *rechargeOrderService) confirmPendingOrder(
+	ctx context.Context,
+	orderID string,
+	confirmedBy *int64,
+	prepare func(tx *gorm.DB, order *model.OrbiaRechargeOrder) error,
+) (*model.OrbiaRechargeOrder, error) {
+	var order *model.OrbiaRechargeOrder
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 获取充值订单并加锁
+		var err error
+		order, err = s.getPendingRechargeOrderForUpdate(tx, orderID)
+		if err != nil {
+			return err
+		}
 
-	wallet.Balance += order.Amount
-	wallet.TotalRecharge += order.Amount
+		before := rechargeOrderSnapshot(order)
+		if prepare != nil {
+			if err := prepare(tx, order); err != nil {
+				return err
+			}
+		}
 
-	if err := s.walletRepo.UpdateWallet(wallet); err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("failed to update wallet balance: %v", err)
-	}
+		// 同一笔链上交易只能为一个订单入账：在入账事务中登记交易哈希，
+		// 唯一索引保证并发确认（人工确认、链上校验、入账扫描）时只有一个事务能登记成功
+		if err := s.claimTxHash(tx, order); err != nil {
+			return err
+		}
+
+		// 更新订单状态
+		now := time.Now()
+		order.Status = "confirmed"
+		order.ConfirmedBy = confirmedBy
+		order.ConfirmedAt = &now
+
+		if err := s.rechargeOrderRepo.UpdateRechargeOrderWithTx(tx, order); err != nil {
+			return fmt.Errorf("failed to update recharge order: %v", err)
+		}
+
+		// 更新用户钱包余额
+		wallet, err := s.walletRepo.GetWalletByUserIDForUpdate(tx, order.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get user wallet: %v", err)
+		}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+		if _, err := s.ledgerSvc.Post(tx, &ledger.Entry{
+			EntryType:     ledger.EntryRecharge,
+			ReferenceType: "recharge_order",
+			ReferenceID:   order.OrderID,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.PlatformCash, order.Amount),
+				ledger.Credit(ledger.UserWallet(order.UserID), order.Amount),
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to update wallet balance: %v", err)
+		}
+
+		if err := s.walletRepo.UpdateWalletTotals(tx, order.UserID, order.Amount, 0); err != nil {
+			return fmt.Errorf("failed to update total recharge: %v", err)
+		}
+
+		// 创建充值交易记录
+		relatedOrderType := "recharge_order"
+		txRemark := "充值到账"
+		transaction := &model.OrbiaTransaction{
+			TransactionID:    utils.GenerateTransactionID(),
+			UserID:           order.UserID,
+			Type:             "recharge",
+			Amount:           order.Amount,
+			BalanceBefore:    wallet.Balance,
+			BalanceAfter:     wallet.Balance + order.Amount,
+			Status:           "completed",
+			RelatedOrderType: &relatedOrderType,
+			RelatedOrderID:   &order.OrderID,
+			Remark:           &txRemark,
+			CompletedAt:      &now,
+		}
+		if err := s.txRepo.CreateTransaction(tx, transaction); err != nil {
+			return fmt.Errorf("failed to create transaction: %v", err)
+		}
+
+		return s.recordAdminAudit(ctx, tx, &audit.Entry{
+			Action:     audit.ActionRechargeConfirm,
+			TargetType: audit.TargetRechargeOrder,
+			TargetID:   order.OrderID,
+			Before:     before,
+			After:      rechargeOrderSnapshot(order),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return order, nil
@@ -304,6 +439,7 @@ func (s *rechargeOrderService) ConfirmRechargeOrder(
 
 // RejectRechargeOrder 拒绝充值订单（管理员）
 func (s *rechargeOrderService) RejectRechargeOrder(
+	ctx context.Context,
 	adminUserID int64,
 	orderID string,
 	failedReason string,
@@ -312,8 +448,59 @@ func (s *rechargeOrderService) RejectRechargeOrder(
 		return nil, errors.New("failed reason is required")
 	}
 
-	// 获取充值订单
-	order, err := s.rechargeOrderRepo.GetRechargeOrderByOrderID(orderID)
+	return s.failPendingOrder(ctx, orderID, failedReason, nil)
+}
+
+// failPendingOrder 将待处理的充值订单置为失败
+// check 在订单加锁后调用，返回错误时不修改订单
+func (s *rechargeOrderService) failPendingOrder(
+	ctx context.Context,
+	orderID string,
+	failedReason string,
+	check func(order *model.OrbiaRechargeOrder) error,
+) (*model.OrbiaRechargeOrder, error) {
+	var order *model.OrbiaRechargeOrder
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 获取充值订单并加锁，避免与确认操作并发执行
+		var err error
+		order, err = s.getPendingRechargeOrderForUpdate(tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if check != nil {
+			if err := check(order); err != nil {
+				return err
+			}
+		}
+
+		// 更新订单状态
+		before := rechargeOrderSnapshot(order)
+		order.Status = "failed"
+		order.FailedReason = &failedReason
+
+		if err := s.rechargeOrderRepo.UpdateRechargeOrderWithTx(tx, order); err != nil {
+			return fmt.Errorf("failed to update recharge order: %v", err)
+		}
+
+		return s.recordAdminAudit(ctx, tx, &audit.Entry{
+			Action:     audit.ActionRechargeReject,
+			TargetType: audit.TargetRechargeOrder,
+			TargetID:   order.OrderID,
+			Before:     before,
+			After:      rechargeOrderSnapshot(order),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// getPendingRechargeOrderForUpdate 获取待处理的充值订单并加行锁（在事务中执行）
+func (s *rechargeOrderService) getPendingRechargeOrderForUpdate(tx *gorm.DB, orderID string) (*model.OrbiaRechargeOrder, error) {
+	order, err := s.rechargeOrderRepo.GetRechargeOrderByOrderIDForUpdate(tx, orderID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("recharge order not found")
@@ -326,13 +513,46 @@ func (s *rechargeOrderService) RejectRechargeOrder(
 		return nil, fmt.Errorf("recharge order is not in pending status, current status: %s", order.Status)
 	}
 
-	// 更新订单状态
-	order.Status = "failed"
-	order.FailedReason = &failedReason
+	return order, nil
+}
 
-	if err := s.rechargeOrderRepo.UpdateRechargeOrder(order); err != nil {
-		return nil, fmt.Errorf("failed to update recharge order: %v", err)
+// recordAdminAudit 后台操作时记录审计日志；定时任务、支付回调等系统操作的 ctx 中没有操作人，不记录
+func (s *rechargeOrderService) recordAdminAudit(ctx context.Context, tx *gorm.DB, entry *audit.Entry) error {
+	if _, ok := audit.ActorFromContext(ctx); !ok {
+		return nil
 	}
+	return s.auditSvc.Record(ctx, tx, entry)
+}
 
-	return order, nil
+// rechargeOrderSnapshot 构建充值订单的审计快照
+func rechargeOrderSnapshot(order *model.OrbiaRechargeOrder) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":        order.UserID,
+		"amount":         order.Amount,
+		"status":         order.Status,
+		"verify_status":  order.VerifyStatus,
+		"crypto_tx_hash": stringValue(order.CryptoTxHash),
+		"confirmed_by":   order.ConfirmedBy,
+		"failed_reason":  stringValue(order.FailedReason),
+		"remark":         stringValue(order.Remark),
+	}
+}
+
+// requiresStepUp 确认充值订单是否需要两步验证码：金额达到配置阈值时需要，阈值为 0 或配置无效时所有订单都需要
+func requiresStepUp(amount money.Amount) bool {
+	threshold, err := money.FromFloat(config.GlobalConfig.TwoFactor.StepUpRechargeAmount)
+	if err != nil {
+		return true
+	}
+	return amount.Cents() >= threshold.Cents()
+}
+
+// appendRemark 在订单备注后追加一行
+func appendRemark(order *model.OrbiaRechargeOrder, line string) {
+	if order.Remark != nil && *order.Remark != "" {
+		combined := *order.Remark + "\n" + line
+		order.Remark = &combined
+		return
+	}
+	order.Remark = &line
 }
@@ -0,0 +1,69 @@
+package social
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const discordDefaultAPIBase = "https://discord.com/api/v10"
+
+// discordProvider Discord 邀请链接数据（公开接口，无需密钥）
+type discordProvider struct {
+	apiBase    string
+	httpClient *http.Client
+}
+
+// NewDiscordProvider 创建 Discord 数据接口实例，apiBase 为空时使用官方地址
+func NewDiscordProvider(apiBase string, httpClient *http.Client) Provider {
+	if apiBase == "" {
+		apiBase = discordDefaultAPIBase
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &discordProvider{
+		apiBase:    strings.TrimRight(apiBase, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// Platform 平台标识
+func (p *discordProvider) Platform() Platform {
+	return PlatformDiscord
+}
+
+// FetchStats 根据服务器邀请链接查询成员数
+// 支持 discord.gg/{code} 和 discord.com/invite/{code} 两种地址
+func (p *discordProvider) FetchStats(ctx context.Context, profileURL string) (*ProfileStats, error) {
+	segments, err := parseProfileURL(profileURL, "discord.gg", "discord.com", "discordapp.com")
+	if err != nil {
+		return nil, err
+	}
+
+	code := segments[0]
+	if segments[0] == "invite" {
+		if len(segments) < 2 {
+			return nil, ErrInvalidProfileURL
+		}
+		code = segments[1]
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		p.apiBase+"/invites/"+url.PathEscape(code)+"?with_counts=true", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Code                   string `json:"code"`
+		ApproximateMemberCount int64  `json:"approximate_member_count"`
+	}
+	if err := doJSON(p.httpClient, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &ProfileStats{Followers: resp.ApproximateMemberCount}, nil
+}
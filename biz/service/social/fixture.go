@@ -0,0 +1,119 @@
+package social
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// fixtureIgnoredParams 匹配录制响应时忽略的查询参数（密钥等与账号无关的参数）
+var fixtureIgnoredParams = []string{"key"}
+
+// Recording 录制的一次平台接口调用
+type Recording struct {
+	Method   string          `json:"method"`
+	URL      string          `json:"url"`            // 请求地址，不含 key 等密钥参数
+	Body     json.RawMessage `json:"body,omitempty"` // JSON 请求体，为空时不比较请求体
+	Status   int             `json:"status"`
+	Response json.RawMessage `json:"response"`
+}
+
+// FixtureTransport 回放录制的平台接口响应，不访问外部平台
+// 各平台实现使用该 Transport 时，请求构造和响应解析与正式环境完全一致，用于本地联调和测试；
+// 没有匹配的录制时返回错误，可用于验证接口失败时回退到手动填写的数据
+type FixtureTransport struct {
+	recordings []Recording
+}
+
+// NewFixtureTransport 根据录制的接口调用创建回放 Transport
+func NewFixtureTransport(recordings []Recording) *FixtureTransport {
+	return &FixtureTransport{recordings: recordings}
+}
+
+// LoadFixtureTransport 从 JSON 文件加载录制的接口调用（Recording 数组）
+func LoadFixtureTransport(path string) (*FixtureTransport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordings []Recording
+	if err := json.Unmarshal(data, &recordings); err != nil {
+		return nil, fmt.Errorf("invalid fixture file %s: %v", path, err)
+	}
+	return NewFixtureTransport(recordings), nil
+}
+
+// RoundTrip 按请求方法、地址和请求体查找录制的响应
+func (t *FixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	requestURL := canonicalFixtureURL(req.URL)
+	for _, rec := range t.recordings {
+		if !strings.EqualFold(rec.Method, req.Method) {
+			continue
+		}
+		recURL, err := url.Parse(rec.URL)
+		if err != nil || canonicalFixtureURL(recURL) != requestURL {
+			continue
+		}
+		if len(rec.Body) > 0 && !jsonEqual(rec.Body, body) {
+			continue
+		}
+
+		status := rec.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			StatusCode: status,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(rec.Response)),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded response for %s %s", req.Method, requestURL)
+}
+
+// canonicalFixtureURL 去掉忽略的查询参数并按参数名排序，用于比较地址
+func canonicalFixtureURL(u *url.URL) string {
+	query := u.Query()
+	for _, param := range fixtureIgnoredParams {
+		query.Del(param)
+	}
+
+	canonical := u.Scheme + "://" + u.Host + u.EscapedPath()
+	if encoded := query.Encode(); encoded != "" {
+		canonical += "?" + encoded
+	}
+	return canonical
+}
+
+// jsonEqual 比较两个 JSON 文本是否等价（忽略空白和字段顺序）
+func jsonEqual(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return bytes.Equal(ca, cb)
+}
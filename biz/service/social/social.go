@@ -0,0 +1,190 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"orbia_api/biz/infra/config"
+)
+
+var (
+	// ErrInvalidProfileURL 主页地址无法识别为该平台的账号
+	ErrInvalidProfileURL = errors.New("invalid profile url")
+	// ErrProfileNotFound 平台上不存在该账号（或邀请链接已失效）
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+// Platform 社交平台
+type Platform string
+
+const (
+	PlatformTikTok  Platform = "tiktok"
+	PlatformYouTube Platform = "youtube"
+	PlatformX       Platform = "x"
+	PlatformDiscord Platform = "discord"
+)
+
+// ProfileStats 平台接口返回的账号数据
+type ProfileStats struct {
+	Followers int64 // 粉丝数（YouTube 为订阅数，Discord 为服务器成员数）
+}
+
+// Provider 社交平台数据接口，每个平台一个实现
+type Provider interface {
+	// Platform 平台标识
+	Platform() Platform
+	// FetchStats 根据KOL填写的主页地址获取账号数据
+	// 地址无法识别时返回 ErrInvalidProfileURL，账号不存在时返回 ErrProfileNotFound
+	FetchStats(ctx context.Context, profileURL string) (*ProfileStats, error)
+}
+
+// Registry 已配置的社交平台数据接口
+type Registry struct {
+	providers map[Platform]Provider
+}
+
+// NewRegistry 根据配置创建社交平台注册表，未配置密钥的平台不注册
+// 配置了 fixture_file 时所有平台都回放录制的接口响应，不访问外部平台
+func NewRegistry(cfg config.SocialStatsConfig) (*Registry, error) {
+	registry := &Registry{providers: make(map[Platform]Provider)}
+
+	if cfg.FixtureFile != "" {
+		transport, err := LoadFixtureTransport(cfg.FixtureFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load social stats fixtures: %v", err)
+		}
+		httpClient := &http.Client{Transport: transport}
+		registry.Register(NewTikTokProvider(cfg.TikTok.APIBase, "fixture", "fixture", httpClient))
+		registry.Register(NewYouTubeProvider(cfg.YouTube.APIBase, "fixture", httpClient))
+		registry.Register(NewXProvider(cfg.X.APIBase, "fixture", httpClient))
+		registry.Register(NewDiscordProvider(cfg.Discord.APIBase, httpClient))
+		return registry, nil
+	}
+
+	if cfg.TikTok.ClientKey != "" {
+		registry.Register(NewTikTokProvider(cfg.TikTok.APIBase, cfg.TikTok.ClientKey, cfg.TikTok.ClientSecret, nil))
+	}
+	if cfg.YouTube.APIKey != "" {
+		registry.Register(NewYouTubeProvider(cfg.YouTube.APIBase, cfg.YouTube.APIKey, nil))
+	}
+	if cfg.X.BearerToken != "" {
+		registry.Register(NewXProvider(cfg.X.APIBase, cfg.X.BearerToken, nil))
+	}
+	if cfg.Discord.Enabled {
+		registry.Register(NewDiscordProvider(cfg.Discord.APIBase, nil))
+	}
+	return registry, nil
+}
+
+// Register 注册社交平台数据接口
+func (r *Registry) Register(provider Provider) {
+	r.providers[provider.Platform()] = provider
+}
+
+// Lookup 查找社交平台数据接口
+func (r *Registry) Lookup(platform Platform) (Provider, bool) {
+	if r == nil {
+		return nil, false
+	}
+	provider, ok := r.providers[platform]
+	return provider, ok
+}
+
+// Len 返回已注册的平台数量
+func (r *Registry) Len() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.providers)
+}
+
+// parseProfileURL 解析主页地址并校验域名，返回去掉首尾斜杠后的路径片段
+// 地址可以省略协议（如 tiktok.com/@name）
+func parseProfileURL(profileURL string, hosts ...string) ([]string, error) {
+	raw := strings.TrimSpace(profileURL)
+	if raw == "" {
+		return nil, ErrInvalidProfileURL
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, ErrInvalidProfileURL
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	matched := false
+	for _, h := range hosts {
+		if host == h {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return nil, ErrInvalidProfileURL
+	}
+
+	path := strings.Trim(u.Path, "/")
+	if path == "" {
+		return nil, ErrInvalidProfileURL
+	}
+	return strings.Split(path, "/"), nil
+}
+
+// StatusError 平台接口返回非 2xx 状态码
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, truncate(string(e.Body), 200))
+}
+
+// doJSON 发送请求并解析 JSON 响应，404 返回 ErrProfileNotFound，其他非 2xx 状态码返回 *StatusError
+func doJSON(httpClient *http.Client, req *http.Request, out interface{}) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// 请求地址中可能包含 API Key，错误信息中去掉地址
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrProfileNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &StatusError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("invalid response: %v", err)
+	}
+	return nil
+}
+
+// truncate 截断过长的错误信息
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,185 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"orbia_api/biz/infra/config"
+)
+
+const recordingsFile = "testdata/recordings.json"
+
+// recordingKey 录制调用的唯一标识（请求方法、规范化地址和请求体）
+func recordingKey(method string, u *url.URL, body []byte) string {
+	key := strings.ToUpper(method) + " " + canonicalFixtureURL(u)
+	if len(body) > 0 {
+		var v interface{}
+		if json.Unmarshal(body, &v) == nil {
+			canonical, _ := json.Marshal(v)
+			key += " " + string(canonical)
+		}
+	}
+	return key
+}
+
+// coverageTransport 记录经过的请求，用于确认每条录制都被测试用例覆盖
+type coverageTransport struct {
+	next http.RoundTripper
+
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+func (t *coverageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(strings.NewReader(string(body)))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.mu.Lock()
+		t.seen[recordingKey(req.Method, req.URL, body)] = true
+		t.mu.Unlock()
+	}
+	return resp, err
+}
+
+// loadRecordings 读取录制文件
+func loadRecordings(t *testing.T) []Recording {
+	t.Helper()
+	data, err := os.ReadFile(recordingsFile)
+	if err != nil {
+		t.Fatalf("read recordings: %v", err)
+	}
+	var recordings []Recording
+	if err := json.Unmarshal(data, &recordings); err != nil {
+		t.Fatalf("parse recordings: %v", err)
+	}
+	return recordings
+}
+
+func TestProvidersAgainstRecordings(t *testing.T) {
+	recordings := loadRecordings(t)
+	transport := &coverageTransport{next: NewFixtureTransport(recordings), seen: make(map[string]bool)}
+	httpClient := &http.Client{Transport: transport}
+
+	providers := map[Platform]Provider{
+		PlatformTikTok:  NewTikTokProvider("", "fixture", "fixture", httpClient),
+		PlatformYouTube: NewYouTubeProvider("", "fixture", httpClient),
+		PlatformX:       NewXProvider("", "fixture", httpClient),
+		PlatformDiscord: NewDiscordProvider("", httpClient),
+	}
+
+	tests := []struct {
+		name       string
+		platform   Platform
+		profileURL string
+		followers  int64
+		wantErr    error  // 期望的哨兵错误
+		wantStatus int    // 期望的 StatusError 状态码
+		errPart    string // 其他错误期望包含的内容
+	}{
+		{name: "tiktok profile", platform: PlatformTikTok, profileURL: "https://www.tiktok.com/@orbia_demo", followers: 125400},
+		{name: "tiktok deleted user", platform: PlatformTikTok, profileURL: "tiktok.com/@deleted_creator", wantErr: ErrProfileNotFound},
+		{name: "tiktok not recorded", platform: PlatformTikTok, profileURL: "tiktok.com/@unknown_creator", errPart: "no recorded response"},
+		{name: "tiktok invalid url", platform: PlatformTikTok, profileURL: "https://www.tiktok.com/orbia_demo", wantErr: ErrInvalidProfileURL},
+
+		{name: "youtube handle", platform: PlatformYouTube, profileURL: "https://www.youtube.com/@orbiademo", followers: 48200},
+		{name: "youtube hidden subscribers", platform: PlatformYouTube, profileURL: "youtube.com/channel/UCfixtureHiddenCount0001", errPart: "hides its subscriber count"},
+		{name: "youtube invalid url", platform: PlatformYouTube, profileURL: "https://vimeo.com/@orbiademo", wantErr: ErrInvalidProfileURL},
+
+		{name: "x profile", platform: PlatformX, profileURL: "https://x.com/orbia_demo", followers: 30150},
+		{name: "x not found", platform: PlatformX, profileURL: "twitter.com/no_such_creator", wantErr: ErrProfileNotFound},
+		{name: "x rate limited", platform: PlatformX, profileURL: "https://x.com/busy_creator", wantStatus: http.StatusTooManyRequests},
+		{name: "x invalid username", platform: PlatformX, profileURL: "https://x.com/not-a-valid-name", wantErr: ErrInvalidProfileURL},
+
+		{name: "discord invite", platform: PlatformDiscord, profileURL: "https://discord.gg/orbia", followers: 5420},
+		{name: "discord expired invite", platform: PlatformDiscord, profileURL: "discord.com/invite/expired123", wantErr: ErrProfileNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := providers[tt.platform].FetchStats(context.Background(), tt.profileURL)
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantStatus != 0:
+				var statusErr *StatusError
+				if !errors.As(err, &statusErr) || statusErr.StatusCode != tt.wantStatus {
+					t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+				}
+			case tt.errPart != "":
+				if err == nil || !strings.Contains(err.Error(), tt.errPart) {
+					t.Fatalf("err = %v, want it to contain %q", err, tt.errPart)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("fetch stats: %v", err)
+				}
+				if stats.Followers != tt.followers {
+					t.Fatalf("followers = %d, want %d", stats.Followers, tt.followers)
+				}
+			}
+		})
+	}
+
+	// 每条录制都必须被上面的用例使用，新增录制时需同步补充用例
+	for _, rec := range recordings {
+		u, err := url.Parse(rec.URL)
+		if err != nil {
+			t.Fatalf("invalid recording url %s: %v", rec.URL, err)
+		}
+		if key := recordingKey(rec.Method, u, rec.Body); !transport.seen[key] {
+			t.Errorf("recording not covered by any test case: %s", key)
+		}
+	}
+}
+
+func TestNewRegistryWithFixtureFile(t *testing.T) {
+	registry, err := NewRegistry(configWithFixture(recordingsFile))
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+	if registry.Len() != 4 {
+		t.Fatalf("registered %d providers, want 4", registry.Len())
+	}
+
+	provider, ok := registry.Lookup(PlatformDiscord)
+	if !ok {
+		t.Fatal("discord provider is not registered")
+	}
+	stats, err := provider.FetchStats(context.Background(), "discord.gg/orbia")
+	if err != nil {
+		t.Fatalf("fetch stats: %v", err)
+	}
+	if stats.Followers != 5420 {
+		t.Fatalf("followers = %d, want 5420", stats.Followers)
+	}
+
+	if _, err := NewRegistry(configWithFixture("testdata/missing.json")); err == nil {
+		t.Fatal("expected an error for a missing fixture file")
+	}
+}
+
+// configWithFixture 使用录制文件的社交平台配置
+func configWithFixture(path string) config.SocialStatsConfig {
+	return config.SocialStatsConfig{FixtureFile: path}
+}
@@ -0,0 +1,144 @@
+package social
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const tiktokDefaultAPIBase = "https://open.tiktokapis.com"
+
+// tiktokProvider TikTok Research API 账号数据（client credentials 授权）
+type tiktokProvider struct {
+	apiBase      string
+	clientKey    string
+	clientSecret string
+	httpClient   *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// NewTikTokProvider 创建 TikTok 数据接口实例，apiBase 为空时使用官方地址
+func NewTikTokProvider(apiBase, clientKey, clientSecret string, httpClient *http.Client) Provider {
+	if apiBase == "" {
+		apiBase = tiktokDefaultAPIBase
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &tiktokProvider{
+		apiBase:      strings.TrimRight(apiBase, "/"),
+		clientKey:    clientKey,
+		clientSecret: clientSecret,
+		httpClient:   httpClient,
+	}
+}
+
+// Platform 平台标识
+func (p *tiktokProvider) Platform() Platform {
+	return PlatformTikTok
+}
+
+// tiktokError TikTok 接口错误信息，成功时 code 为 ok
+type tiktokError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// FetchStats 查询 TikTok 账号粉丝数，主页地址格式为 tiktok.com/@username
+func (p *tiktokProvider) FetchStats(ctx context.Context, profileURL string) (*ProfileStats, error) {
+	segments, err := parseProfileURL(profileURL, "tiktok.com")
+	if err != nil {
+		return nil, err
+	}
+	username := strings.TrimPrefix(segments[0], "@")
+	if !strings.HasPrefix(segments[0], "@") || username == "" {
+		return nil, ErrInvalidProfileURL
+	}
+
+	token, err := p.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _ := json.Marshal(map[string]string{"username": username})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		p.apiBase+"/v2/research/user/info/?fields=display_name,follower_count", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp struct {
+		Data struct {
+			FollowerCount *int64 `json:"follower_count"`
+		} `json:"data"`
+		Error tiktokError `json:"error"`
+	}
+	// 参数错误（如用户不存在）时接口返回 4xx，错误信息在响应体的 error 字段中
+	if err := doJSON(p.httpClient, req, &resp); err != nil {
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || json.Unmarshal(statusErr.Body, &resp) != nil || resp.Error.Code == "" {
+			return nil, err
+		}
+	}
+	if resp.Error.Code != "ok" {
+		if resp.Error.Code == "invalid_params" {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("tiktok error %s: %s", resp.Error.Code, resp.Error.Message)
+	}
+	if resp.Data.FollowerCount == nil {
+		return nil, errors.New("tiktok response does not contain follower_count")
+	}
+
+	return &ProfileStats{Followers: *resp.Data.FollowerCount}, nil
+}
+
+// getAccessToken 获取 client access token，过期前一分钟刷新
+func (p *tiktokProvider) getAccessToken(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.accessToken != "" && time.Now().Before(p.tokenExpiry) {
+		return p.accessToken, nil
+	}
+
+	form := url.Values{}
+	form.Set("client_key", p.clientKey)
+	form.Set("client_secret", p.clientSecret)
+	form.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBase+"/v2/oauth/token/", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := doJSON(p.httpClient, req, &resp); err != nil {
+		return "", fmt.Errorf("failed to get tiktok access token: %v", err)
+	}
+	if resp.AccessToken == "" {
+		return "", fmt.Errorf("failed to get tiktok access token: %s %s", resp.Error, resp.ErrorDescription)
+	}
+
+	p.accessToken = resp.AccessToken
+	p.tokenExpiry = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - time.Minute)
+	return p.accessToken, nil
+}
@@ -0,0 +1,78 @@
+package social
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const xDefaultAPIBase = "https://api.x.com"
+
+// xUsernamePattern X 用户名规则
+var xUsernamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{1,15}$`)
+
+// xProvider X API v2 用户数据（App-only Bearer Token）
+type xProvider struct {
+	apiBase     string
+	bearerToken string
+	httpClient  *http.Client
+}
+
+// NewXProvider 创建 X 数据接口实例，apiBase 为空时使用官方地址
+func NewXProvider(apiBase, bearerToken string, httpClient *http.Client) Provider {
+	if apiBase == "" {
+		apiBase = xDefaultAPIBase
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &xProvider{
+		apiBase:     strings.TrimRight(apiBase, "/"),
+		bearerToken: bearerToken,
+		httpClient:  httpClient,
+	}
+}
+
+// Platform 平台标识
+func (p *xProvider) Platform() Platform {
+	return PlatformX
+}
+
+// FetchStats 查询 X 账号粉丝数，主页地址格式为 x.com/username 或 twitter.com/username
+func (p *xProvider) FetchStats(ctx context.Context, profileURL string) (*ProfileStats, error) {
+	segments, err := parseProfileURL(profileURL, "x.com", "twitter.com")
+	if err != nil {
+		return nil, err
+	}
+	username := strings.TrimPrefix(segments[0], "@")
+	if !xUsernamePattern.MatchString(username) {
+		return nil, ErrInvalidProfileURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		p.apiBase+"/2/users/by/username/"+url.PathEscape(username)+"?user.fields=public_metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.bearerToken)
+
+	// 用户不存在时接口返回 200 和 errors 字段，data 为空
+	var resp struct {
+		Data *struct {
+			PublicMetrics struct {
+				FollowersCount int64 `json:"followers_count"`
+			} `json:"public_metrics"`
+		} `json:"data"`
+	}
+	if err := doJSON(p.httpClient, req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, ErrProfileNotFound
+	}
+
+	return &ProfileStats{Followers: resp.Data.PublicMetrics.FollowersCount}, nil
+}
@@ -0,0 +1,96 @@
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const youtubeDefaultAPIBase = "https://www.googleapis.com/youtube/v3"
+
+// youtubeProvider YouTube Data API v3 频道数据
+type youtubeProvider struct {
+	apiBase    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewYouTubeProvider 创建 YouTube 数据接口实例，apiBase 为空时使用官方地址
+func NewYouTubeProvider(apiBase, apiKey string, httpClient *http.Client) Provider {
+	if apiBase == "" {
+		apiBase = youtubeDefaultAPIBase
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &youtubeProvider{
+		apiBase:    strings.TrimRight(apiBase, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+// Platform 平台标识
+func (p *youtubeProvider) Platform() Platform {
+	return PlatformYouTube
+}
+
+// FetchStats 查询 YouTube 频道订阅数
+// 支持 youtube.com/@handle、youtube.com/channel/{id} 和 youtube.com/user/{name} 三种主页地址
+func (p *youtubeProvider) FetchStats(ctx context.Context, profileURL string) (*ProfileStats, error) {
+	segments, err := parseProfileURL(profileURL, "youtube.com")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("part", "statistics")
+	switch {
+	case strings.HasPrefix(segments[0], "@") && len(segments[0]) > 1:
+		query.Set("forHandle", segments[0])
+	case segments[0] == "channel" && len(segments) > 1:
+		query.Set("id", segments[1])
+	case segments[0] == "user" && len(segments) > 1:
+		query.Set("forUsername", segments[1])
+	default:
+		return nil, ErrInvalidProfileURL
+	}
+	query.Set("key", p.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiBase+"/channels?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Items []struct {
+			ID         string `json:"id"`
+			Statistics struct {
+				SubscriberCount       string `json:"subscriberCount"`
+				HiddenSubscriberCount bool   `json:"hiddenSubscriberCount"`
+			} `json:"statistics"`
+		} `json:"items"`
+	}
+	if err := doJSON(p.httpClient, req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Items) == 0 {
+		return nil, ErrProfileNotFound
+	}
+
+	statistics := resp.Items[0].Statistics
+	if statistics.HiddenSubscriberCount {
+		return nil, errors.New("youtube channel hides its subscriber count")
+	}
+	subscribers, err := strconv.ParseInt(statistics.SubscriberCount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid youtube subscriber count %q", statistics.SubscriberCount)
+	}
+
+	return &ProfileStats{Followers: subscribers}, nil
+}
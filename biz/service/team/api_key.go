@@ -0,0 +1,216 @@
+package team
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/model/common"
+	"orbia_api/biz/model/team"
+	"orbia_api/biz/utils"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// apiKeyDefaultExpireDays API Key默认有效期（天）
+	apiKeyDefaultExpireDays = 90
+	// apiKeyMaxExpireDays API Key最长有效期（天）
+	apiKeyMaxExpireDays = 365
+	// apiKeyMaxActivePerTeam 每个团队最多可用的API Key数量
+	apiKeyMaxActivePerTeam = 20
+	// apiKeyMaxNameLength API Key名称最大长度
+	apiKeyMaxNameLength = 50
+)
+
+// CreateAPIKey 创建团队API Key（只有 creator 和 owner 可以创建）
+// 明文密钥只在响应中返回一次，数据库只保存哈希
+func (s *teamService) CreateAPIKey(userID int64, req *team.CreateTeamAPIKeyReq) (*team.CreateTeamAPIKeyResp, error) {
+	teamID, err := strconv.ParseInt(req.TeamID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team_id: %v", err)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("api key name is required")
+	}
+	if len([]rune(name)) > apiKeyMaxNameLength {
+		return nil, fmt.Errorf("api key name cannot exceed %d characters", apiKeyMaxNameLength)
+	}
+
+	scopes, err := normalizeAPIKeyScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	expireDays := apiKeyDefaultExpireDays
+	if req.ExpiresInDays != nil {
+		expireDays = int(*req.ExpiresInDays)
+		if expireDays < 1 || expireDays > apiKeyMaxExpireDays {
+			return nil, fmt.Errorf("expires_in_days must be between 1 and %d", apiKeyMaxExpireDays)
+		}
+	}
+
+	hasPermission, err := s.checkTeamEditPermission(teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, errors.New("access denied: insufficient permissions")
+	}
+
+	count, err := s.apiKeyRepo.CountActiveAPIKeys(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count api keys: %v", err)
+	}
+	if count >= apiKeyMaxActivePerTeam {
+		return nil, fmt.Errorf("a team can have at most %d active api keys", apiKeyMaxActivePerTeam)
+	}
+
+	key, prefix, err := utils.GenerateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate api key: %v", err)
+	}
+
+	apiKey := &mysql.TeamAPIKey{
+		TeamID:    teamID,
+		CreatorID: userID,
+		Name:      name,
+		KeyPrefix: prefix,
+		KeyHash:   utils.HashAPIKey(key),
+		Scopes:    strings.Join(scopes, ","),
+		Status:    "active",
+		ExpiresAt: time.Now().AddDate(0, 0, expireDays),
+	}
+	if err := s.apiKeyRepo.CreateAPIKey(apiKey); err != nil {
+		return nil, fmt.Errorf("failed to create api key: %v", err)
+	}
+
+	return &team.CreateTeamAPIKeyResp{
+		BaseResp: &common.BaseResp{Code: 0, Message: "success"},
+		APIKey:   s.convertAPIKeyToModel(apiKey),
+		Key:      key,
+	}, nil
+}
+
+// ListAPIKeys 获取团队API Key列表（只有 creator 和 owner 可以查看）
+func (s *teamService) ListAPIKeys(userID int64, req *team.ListTeamAPIKeysReq) (*team.ListTeamAPIKeysResp, error) {
+	teamID, err := strconv.ParseInt(req.TeamID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team_id: %v", err)
+	}
+
+	hasPermission, err := s.checkTeamEditPermission(teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, errors.New("access denied: insufficient permissions")
+	}
+
+	keys, err := s.apiKeyRepo.ListTeamAPIKeys(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %v", err)
+	}
+
+	apiKeys := make([]*team.TeamAPIKey, 0, len(keys))
+	for _, k := range keys {
+		apiKeys = append(apiKeys, s.convertAPIKeyToModel(k))
+	}
+
+	return &team.ListTeamAPIKeysResp{
+		BaseResp: &common.BaseResp{Code: 0, Message: "success"},
+		APIKeys:  apiKeys,
+	}, nil
+}
+
+// RevokeAPIKey 吊销团队API Key（只有 creator 和 owner 可以吊销）
+func (s *teamService) RevokeAPIKey(userID int64, req *team.RevokeTeamAPIKeyReq) (*team.RevokeTeamAPIKeyResp, error) {
+	teamID, err := strconv.ParseInt(req.TeamID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team_id: %v", err)
+	}
+
+	hasPermission, err := s.checkTeamEditPermission(teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, errors.New("access denied: insufficient permissions")
+	}
+
+	apiKey, err := s.apiKeyRepo.GetAPIKeyByID(req.KeyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("api key not found")
+		}
+		return nil, fmt.Errorf("failed to get api key: %v", err)
+	}
+	if apiKey.TeamID != teamID {
+		return nil, errors.New("api key not found")
+	}
+
+	revoked, err := s.apiKeyRepo.RevokeAPIKey(apiKey.ID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %v", err)
+	}
+	if !revoked {
+		return nil, errors.New("api key has already been revoked")
+	}
+
+	return &team.RevokeTeamAPIKeyResp{
+		BaseResp: &common.BaseResp{Code: 0, Message: "success"},
+	}, nil
+}
+
+// normalizeAPIKeyScopes 校验并去重授权范围
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !consts.APIKeyScope(scope).IsValid() {
+			return nil, fmt.Errorf("invalid scope: %s", scope)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		result = append(result, scope)
+	}
+	return result, nil
+}
+
+// convertAPIKeyToModel 将数据库API Key模型转换为API模型（不包含密钥）
+func (s *teamService) convertAPIKeyToModel(k *mysql.TeamAPIKey) *team.TeamAPIKey {
+	info := &team.TeamAPIKey{
+		ID:         k.ID,
+		TeamID:     k.TeamID,
+		CreatorID:  k.CreatorID,
+		Name:       k.Name,
+		KeyPrefix:  k.KeyPrefix,
+		Scopes:     k.ScopeList(),
+		Status:     k.Status,
+		ExpiresAt:  k.ExpiresAt.Format(time.RFC3339),
+		LastUsedIP: k.LastUsedIP,
+		CreatedAt:  k.CreatedAt.Format(time.RFC3339),
+	}
+	if k.LastUsedAt != nil {
+		lastUsedAt := k.LastUsedAt.Format(time.RFC3339)
+		info.LastUsedAt = &lastUsedAt
+	}
+	if k.RevokedAt != nil {
+		revokedAt := k.RevokedAt.Format(time.RFC3339)
+		info.RevokedAt = &revokedAt
+	}
+	return info
+}
@@ -27,19 +27,26 @@ type TeamService interface {
 	AcceptInvitation(userID int64, req *team.AcceptInvitationReq) (*team.AcceptInvitationResp, error)
 	RejectInvitation(userID int64, req *team.RejectInvitationReq) (*team.RejectInvitationResp, error)
 	GetTeamMembers(userID int64, req *team.GetTeamMembersReq) (*team.GetTeamMembersResp, error)
+
+	// API Key管理
+	CreateAPIKey(userID int64, req *team.CreateTeamAPIKeyReq) (*team.CreateTeamAPIKeyResp, error)
+	ListAPIKeys(userID int64, req *team.ListTeamAPIKeysReq) (*team.ListTeamAPIKeysResp, error)
+	RevokeAPIKey(userID int64, req *team.RevokeTeamAPIKeyReq) (*team.RevokeTeamAPIKeyResp, error)
 }
 
 // teamService 团队服务实现
 type teamService struct {
-	teamRepo mysql.TeamRepository
-	userRepo mysql.UserRepository
+	teamRepo   mysql.TeamRepository
+	userRepo   mysql.UserRepository
+	apiKeyRepo mysql.TeamAPIKeyRepository
 }
 
 // NewTeamService 创建团队服务实例
-func NewTeamService(teamRepo mysql.TeamRepository, userRepo mysql.UserRepository) TeamService {
+func NewTeamService(teamRepo mysql.TeamRepository, userRepo mysql.UserRepository, apiKeyRepo mysql.TeamAPIKeyRepository) TeamService {
 	return &teamService{
-		teamRepo: teamRepo,
-		userRepo: userRepo,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		apiKeyRepo: apiKeyRepo,
 	}
 }
 
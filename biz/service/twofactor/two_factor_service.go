@@ -0,0 +1,490 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"orbia_api/biz/consts"
+	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/infra/config"
+	"orbia_api/biz/utils"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	// recoveryCodeCount 每次生成的恢复码数量
+	recoveryCodeCount = 10
+	// defaultIssuer 未配置时验证器 App 中显示的发行方
+	defaultIssuer = "Orbia"
+	// defaultChallengeExpireMinutes 未配置时登录挑战的有效期（分钟）
+	defaultChallengeExpireMinutes = 5
+	// defaultMaxAttempts 未配置时登录挑战允许的最大错误次数
+	defaultMaxAttempts = 5
+)
+
+var (
+	// ErrStepUpRequired 高风险操作需要重新输入两步验证码
+	ErrStepUpRequired = errors.New("two-factor verification code is required for this operation")
+	// errInvalidCode 验证码错误
+	errInvalidCode = errors.New("invalid two-factor verification code")
+	// errNotEnabled 未启用两步验证
+	errNotEnabled = errors.New("two-factor authentication is not enabled")
+)
+
+// Status 两步验证状态
+type Status struct {
+	Enabled                bool  // 是否已启用
+	Required               bool  // 是否必须启用（管理员）
+	RecoveryCodesRemaining int64 // 剩余可用的恢复码数量
+}
+
+// SetupInfo 开始启用两步验证时下发的密钥
+type SetupInfo struct {
+	Secret     string // base32 密钥，供无法扫码时手动输入
+	OTPAuthURL string // otpauth:// 地址，前端生成二维码供验证器 App 扫描
+}
+
+// LoginChallenge 登录时下发的两步验证挑战
+type LoginChallenge struct {
+	Token     string // 挑战令牌，和验证码一起提交换取登录令牌
+	ExpiresIn int64  // 有效期（秒）
+}
+
+// TwoFactorService 两步验证服务接口
+type TwoFactorService interface {
+	// GetStatus 获取用户的两步验证状态
+	GetStatus(userID int64) (*Status, error)
+	// Setup 生成新的 TOTP 密钥，验证通过 Enable 后才生效
+	Setup(userID int64) (*SetupInfo, error)
+	// Enable 校验验证码后启用两步验证，返回恢复码（只展示一次）；当前会话同时标记为已通过两步验证
+	Enable(userID int64, sessionID, code string) ([]string, error)
+	// Disable 校验验证码后关闭两步验证，管理员不能关闭
+	Disable(userID int64, code string) error
+	// RegenerateRecoveryCodes 校验验证码后重新生成恢复码，原有恢复码全部失效
+	RegenerateRecoveryCodes(userID int64, code string) ([]string, error)
+	// IsEnabled 用户是否已启用两步验证
+	IsEnabled(userID int64) (bool, error)
+	// VerifyCode 校验 TOTP 验证码（不接受恢复码），用于高风险操作的二次确认
+	VerifyCode(userID int64, code string) error
+	// CreateLoginChallenge 登录第一步通过后创建两步验证挑战
+	CreateLoginChallenge(userID int64) (*LoginChallenge, error)
+	// VerifyLoginChallenge 校验挑战令牌和验证码（TOTP 或恢复码），返回用户ID
+	VerifyLoginChallenge(token, code string) (int64, error)
+}
+
+// twoFactorService 两步验证服务实现
+type twoFactorService struct {
+	db            *gorm.DB
+	twoFactorRepo mysql.TwoFactorRepository
+	userRepo      mysql.UserRepository
+	sessionRepo   mysql.AuthSessionRepository
+}
+
+// NewTwoFactorService 创建两步验证服务实例
+func NewTwoFactorService(db *gorm.DB, twoFactorRepo mysql.TwoFactorRepository, userRepo mysql.UserRepository, sessionRepo mysql.AuthSessionRepository) TwoFactorService {
+	return &twoFactorService{
+		db:            db,
+		twoFactorRepo: twoFactorRepo,
+		userRepo:      userRepo,
+		sessionRepo:   sessionRepo,
+	}
+}
+
+type stepUpContextKey struct{}
+
+// WithStepUp 标记本次请求已通过高风险操作的两步验证
+func WithStepUp(ctx context.Context) context.Context {
+	return context.WithValue(ctx, stepUpContextKey{}, true)
+}
+
+// StepUpVerified 本次请求是否已通过高风险操作的两步验证
+func StepUpVerified(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	verified, _ := ctx.Value(stepUpContextKey{}).(bool)
+	return verified
+}
+
+// GetStatus 获取用户的两步验证状态
+func (s *twoFactorService) GetStatus(userID int64) (*Status, error) {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+
+	status := &Status{Required: user.Role == string(consts.RoleAdmin)}
+	enabled, err := s.IsEnabled(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return status, nil
+	}
+
+	remaining, err := s.twoFactorRepo.CountUnusedRecoveryCodes(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count recovery codes: %v", err)
+	}
+	status.Enabled = true
+	status.RecoveryCodesRemaining = remaining
+	return status, nil
+}
+
+// Setup 生成新的 TOTP 密钥
+// 已启用时不能重新生成，需先关闭；未确认的密钥会被覆盖
+func (s *twoFactorService) Setup(userID int64) (*SetupInfo, error) {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+
+	totp, err := s.twoFactorRepo.GetTOTPByUserID(userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get two-factor settings: %v", err)
+	}
+	if totp != nil && totp.Status == "enabled" {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %v", err)
+	}
+	encrypted, err := utils.EncryptTOTPSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt secret: %v", err)
+	}
+
+	if totp == nil {
+		totp = &mysql.UserTOTP{UserID: userID}
+	}
+	totp.Secret = encrypted
+	totp.Status = "pending"
+	totp.LastUsedStep = 0
+	if err := s.twoFactorRepo.SaveTOTP(nil, totp); err != nil {
+		return nil, fmt.Errorf("failed to save two-factor settings: %v", err)
+	}
+
+	return &SetupInfo{
+		Secret:     secret,
+		OTPAuthURL: utils.BuildTOTPURL(issuer(), accountName(user), secret),
+	}, nil
+}
+
+// Enable 校验验证码后启用两步验证
+func (s *twoFactorService) Enable(userID int64, sessionID, code string) ([]string, error) {
+	totp, err := s.twoFactorRepo.GetTOTPByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("two-factor setup has not been started")
+		}
+		return nil, fmt.Errorf("failed to get two-factor settings: %v", err)
+	}
+	if totp.Status == "enabled" {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.useTOTPCode(tx, totp, code); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		totp.Status = "enabled"
+		totp.EnabledAt = &now
+		if err := s.twoFactorRepo.SaveTOTP(tx, totp); err != nil {
+			return fmt.Errorf("failed to enable two-factor authentication: %v", err)
+		}
+		if err := s.twoFactorRepo.ReplaceRecoveryCodes(tx, userID, hashes); err != nil {
+			return fmt.Errorf("failed to save recovery codes: %v", err)
+		}
+
+		// 刚完成验证的当前会话视为已通过两步验证，管理员无需重新登录
+		if sessionID != "" {
+			if err := s.sessionRepo.MarkSessionMFAVerified(tx, sessionID); err != nil {
+				return fmt.Errorf("failed to update session: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.Infof("User %d enabled two-factor authentication", userID)
+	return codes, nil
+}
+
+// Disable 校验验证码后关闭两步验证
+func (s *twoFactorService) Disable(userID int64, code string) error {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+	if user.Role == string(consts.RoleAdmin) {
+		return errors.New("two-factor authentication is mandatory for admin accounts")
+	}
+
+	totp, err := s.getEnabledTOTP(userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.useTOTPCode(tx, totp, code); err != nil {
+			return err
+		}
+		if err := s.twoFactorRepo.DeleteTOTP(tx, userID); err != nil {
+			return fmt.Errorf("failed to disable two-factor authentication: %v", err)
+		}
+		if err := s.twoFactorRepo.DeleteRecoveryCodes(tx, userID); err != nil {
+			return fmt.Errorf("failed to delete recovery codes: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	hlog.Infof("User %d disabled two-factor authentication", userID)
+	return nil
+}
+
+// RegenerateRecoveryCodes 校验验证码后重新生成恢复码
+func (s *twoFactorService) RegenerateRecoveryCodes(userID int64, code string) ([]string, error) {
+	totp, err := s.getEnabledTOTP(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.useTOTPCode(tx, totp, code); err != nil {
+			return err
+		}
+		if err := s.twoFactorRepo.ReplaceRecoveryCodes(tx, userID, hashes); err != nil {
+			return fmt.Errorf("failed to save recovery codes: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// IsEnabled 用户是否已启用两步验证
+func (s *twoFactorService) IsEnabled(userID int64) (bool, error) {
+	totp, err := s.twoFactorRepo.GetTOTPByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get two-factor settings: %v", err)
+	}
+	return totp.Status == "enabled", nil
+}
+
+// VerifyCode 校验 TOTP 验证码
+func (s *twoFactorService) VerifyCode(userID int64, code string) error {
+	totp, err := s.getEnabledTOTP(userID)
+	if err != nil {
+		return err
+	}
+	return s.useTOTPCode(nil, totp, code)
+}
+
+// CreateLoginChallenge 创建登录挑战
+func (s *twoFactorService) CreateLoginChallenge(userID int64) (*LoginChallenge, error) {
+	token, tokenHash, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate challenge token: %v", err)
+	}
+
+	ttl := challengeTTL()
+	if err := s.twoFactorRepo.CreateChallenge(&mysql.MFAChallenge{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		Status:    "pending",
+		ExpiresAt: time.Now().Add(ttl),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create two-factor challenge: %v", err)
+	}
+
+	return &LoginChallenge{
+		Token:     token,
+		ExpiresIn: int64(ttl / time.Second),
+	}, nil
+}
+
+// VerifyLoginChallenge 校验挑战令牌和验证码
+// 验证码可以是 TOTP 或未使用的恢复码；错误次数达到上限后挑战失效，需要重新登录
+func (s *twoFactorService) VerifyLoginChallenge(token, code string) (int64, error) {
+	if token == "" || code == "" {
+		return 0, errors.New("challenge token and code are required")
+	}
+
+	var userID int64
+	var verifyErr error
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		challenge, err := s.twoFactorRepo.GetChallengeByHashForUpdate(tx, utils.HashRefreshToken(token))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("invalid two-factor challenge")
+			}
+			return fmt.Errorf("failed to get two-factor challenge: %v", err)
+		}
+		if challenge.Status != "pending" || !time.Now().Before(challenge.ExpiresAt) {
+			return errors.New("two-factor challenge has expired, please log in again")
+		}
+
+		totp, err := s.getEnabledTOTP(challenge.UserID)
+		if err != nil {
+			return err
+		}
+
+		verifyErr = s.useTOTPCode(tx, totp, code)
+		if errors.Is(verifyErr, errInvalidCode) {
+			verifyErr = s.useRecoveryCode(tx, challenge.UserID, code)
+		}
+
+		if verifyErr != nil {
+			// 记录错误次数，提交事务后再返回验证失败
+			challenge.Attempts++
+			if challenge.Attempts >= maxAttempts() {
+				challenge.Status = "expired"
+			}
+		} else {
+			challenge.Status = "completed"
+			userID = challenge.UserID
+		}
+		if err := s.twoFactorRepo.UpdateChallenge(tx, challenge); err != nil {
+			return fmt.Errorf("failed to update two-factor challenge: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if verifyErr != nil {
+		return 0, verifyErr
+	}
+	return userID, nil
+}
+
+// getEnabledTOTP 获取已启用的两步验证设置
+func (s *twoFactorService) getEnabledTOTP(userID int64) (*mysql.UserTOTP, error) {
+	totp, err := s.twoFactorRepo.GetTOTPByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errNotEnabled
+		}
+		return nil, fmt.Errorf("failed to get two-factor settings: %v", err)
+	}
+	if totp.Status != "enabled" {
+		return nil, errNotEnabled
+	}
+	return totp, nil
+}
+
+// useTOTPCode 校验 TOTP 验证码并记录时间步，同一验证码不能重复使用
+func (s *twoFactorService) useTOTPCode(tx *gorm.DB, totp *mysql.UserTOTP, code string) error {
+	secret, err := utils.DecryptTOTPSecret(totp.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt two-factor secret: %v", err)
+	}
+
+	step, ok := utils.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return errInvalidCode
+	}
+
+	used, err := s.twoFactorRepo.UseTOTPStep(tx, totp.ID, step)
+	if err != nil {
+		return fmt.Errorf("failed to record two-factor code: %v", err)
+	}
+	if !used {
+		return errInvalidCode
+	}
+	totp.LastUsedStep = step
+	return nil
+}
+
+// useRecoveryCode 使用恢复码
+func (s *twoFactorService) useRecoveryCode(tx *gorm.DB, userID int64, code string) error {
+	used, err := s.twoFactorRepo.UseRecoveryCode(tx, userID, utils.HashRecoveryCode(code))
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %v", err)
+	}
+	if !used {
+		return errInvalidCode
+	}
+	hlog.Warnf("User %d logged in with a recovery code", userID)
+	return nil
+}
+
+// generateRecoveryCodes 生成恢复码，返回明文和哈希
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := utils.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %v", err)
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, utils.HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// accountName 验证器 App 中显示的账号名，优先使用邮箱，其次钱包地址
+func accountName(user *mysql.User) string {
+	if user.Email != nil && *user.Email != "" {
+		return *user.Email
+	}
+	if user.WalletAddress != nil && *user.WalletAddress != "" {
+		return *user.WalletAddress
+	}
+	return strconv.FormatInt(user.ID, 10)
+}
+
+// issuer 验证器 App 中显示的发行方
+func issuer() string {
+	if name := config.GlobalConfig.TwoFactor.Issuer; name != "" {
+		return name
+	}
+	return defaultIssuer
+}
+
+// challengeTTL 登录挑战有效期
+func challengeTTL() time.Duration {
+	minutes := config.GlobalConfig.TwoFactor.ChallengeExpireMinutes
+	if minutes <= 0 {
+		minutes = defaultChallengeExpireMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// maxAttempts 登录挑战允许的最大错误次数
+func maxAttempts() int {
+	if n := config.GlobalConfig.TwoFactor.MaxAttempts; n > 0 {
+		return n
+	}
+	return defaultMaxAttempts
+}
@@ -24,21 +24,28 @@ type UserService interface {
 	UpdateProfile(userID int64, nickname, avatarURL *string) error
 	GetUserByID(userID int64) (*mysql.User, error)
 	SwitchCurrentTeam(userID int64, teamID int64) (*mysql.Team, error)
+	ListSessions(userID int64) ([]*mysql.AuthSession, error)
+	RevokeSession(userID int64, sessionID string) error
 }
 
+// RevokeReasonUser 用户在会话列表中主动吊销会话
+const RevokeReasonUser = "user_revoked"
+
 // userService 用户服务实现
 type userService struct {
-	userRepo mysql.UserRepository
-	teamRepo mysql.TeamRepository
-	kolRepo  mysql.KolRepository
+	userRepo    mysql.UserRepository
+	teamRepo    mysql.TeamRepository
+	kolRepo     mysql.KolRepository
+	sessionRepo mysql.AuthSessionRepository
 }
 
 // NewUserService 创建用户服务实例
-func NewUserService(userRepo mysql.UserRepository, teamRepo mysql.TeamRepository, kolRepo mysql.KolRepository) UserService {
+func NewUserService(userRepo mysql.UserRepository, teamRepo mysql.TeamRepository, kolRepo mysql.KolRepository, sessionRepo mysql.AuthSessionRepository) UserService {
 	return &userService{
-		userRepo: userRepo,
-		teamRepo: teamRepo,
-		kolRepo:  kolRepo,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		kolRepo:     kolRepo,
+		sessionRepo: sessionRepo,
 	}
 }
 
@@ -186,3 +193,35 @@ func (s *userService) SwitchCurrentTeam(userID int64, teamID int64) (*mysql.Team
 
 	return team, nil
 }
+
+// ListSessions 获取用户所有有效的登录会话
+func (s *userService) ListSessions(userID int64) ([]*mysql.AuthSession, error) {
+	sessions, err := s.sessionRepo.ListActiveUserSessions(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %v", err)
+	}
+	return sessions, nil
+}
+
+// RevokeSession 吊销用户的指定会话，会话的访问令牌和刷新令牌全部失效
+// 只能吊销自己的会话；吊销当前会话等同于退出登录
+func (s *userService) RevokeSession(userID int64, sessionID string) error {
+	session, err := s.sessionRepo.GetSessionBySessionID(sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("session not found")
+		}
+		return fmt.Errorf("failed to get session: %v", err)
+	}
+	if session.UserID != userID {
+		return errors.New("session not found")
+	}
+	if session.Status != "active" {
+		return errors.New("session has already been revoked")
+	}
+
+	if err := s.sessionRepo.RevokeSession(nil, sessionID, RevokeReasonUser); err != nil {
+		return fmt.Errorf("failed to revoke session: %v", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"orbia_api/biz/dal/model"
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/utils/money"
 
 	"gorm.io/gorm"
 )
@@ -17,9 +18,9 @@ type WalletService interface {
 	// GetWalletInfo 获取钱包信息
 	GetWalletInfo(userID int64) (*model.OrbiaWallet, error)
 	// CryptoRecharge 加密货币充值
-	CryptoRecharge(userID int64, amount float64, cryptoCurrency, cryptoChain, cryptoAddress string) (*model.OrbiaTransaction, error)
+	CryptoRecharge(userID int64, amount money.Amount, cryptoCurrency, cryptoChain, cryptoAddress string) (*model.OrbiaTransaction, error)
 	// OnlineRecharge 在线支付充值
-	OnlineRecharge(userID int64, amount float64, platform string) (*model.OrbiaTransaction, string, error)
+	OnlineRecharge(userID int64, amount money.Amount, platform string) (*model.OrbiaTransaction, string, error)
 	// ConfirmCryptoRecharge 确认加密货币充值
 	ConfirmCryptoRecharge(userID int64, transactionID, cryptoTxHash string) (*model.OrbiaTransaction, error)
 	// GetTransactionList 获取交易记录列表
@@ -86,12 +87,12 @@ func (s *walletService) GetWalletInfo(userID int64) (*model.OrbiaWallet, error)
 }
 
 // CryptoRecharge 加密货币充值（已废弃，请使用充值订单接口）
-func (s *walletService) CryptoRecharge(userID int64, amount float64, cryptoCurrency, cryptoChain, cryptoAddress string) (*model.OrbiaTransaction, error) {
+func (s *walletService) CryptoRecharge(userID int64, amount money.Amount, cryptoCurrency, cryptoChain, cryptoAddress string) (*model.OrbiaTransaction, error) {
 	return nil, errors.New("this API is deprecated, please use /api/v1/recharge/create/crypto instead")
 }
 
 // OnlineRecharge 在线支付充值（已废弃，请使用充值订单接口）
-func (s *walletService) OnlineRecharge(userID int64, amount float64, platform string) (*model.OrbiaTransaction, string, error) {
+func (s *walletService) OnlineRecharge(userID int64, amount money.Amount, platform string) (*model.OrbiaTransaction, string, error) {
 	return nil, "", errors.New("this API is deprecated, please use /api/v1/recharge/create/online instead")
 }
 
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// APIKeyPrefix 团队API Key前缀，认证中间件据此区分API Key和JWT
+	APIKeyPrefix = "orbia_sk_"
+	// apiKeyDisplayPrefixLen 列表中展示的密钥前缀长度
+	apiKeyDisplayPrefixLen = len(APIKeyPrefix) + 6
+)
+
+// GenerateAPIKey 生成团队API Key，返回明文密钥和用于展示的前缀
+// 明文密钥只在创建时返回一次，数据库只保存哈希
+func GenerateAPIKey() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	key := APIKeyPrefix + hex.EncodeToString(buf)
+	return key, key[:apiKeyDisplayPrefixLen], nil
+}
+
+// HashAPIKey 计算API Key的SHA-256
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey 判断凭证是否为团队API Key
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, APIKeyPrefix)
+}
@@ -66,11 +66,6 @@ func VerifySignature(walletAddress, message, signature string) error {
 	return nil
 }
 
-// GenerateSignMessage 生成用于签名的消息
-func GenerateSignMessage(walletAddress string, timestamp int64) string {
-	return fmt.Sprintf("Welcome to Orbia!\n\nWallet: %s\nTimestamp: %d\n\nThis request will not trigger a blockchain transaction or cost any gas fees.", walletAddress, timestamp)
-}
-
 // HashPassword 对密码进行哈希
 func HashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
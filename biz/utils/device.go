@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// userAgentBrowsers 浏览器识别规则，按顺序匹配（Edge、Opera 的 UA 中也包含 Chrome，需要先匹配）
+var userAgentBrowsers = []struct {
+	token string
+	name  string
+}{
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"Firefox/", "Firefox"},
+	{"Chrome/", "Chrome"},
+	{"CriOS/", "Chrome"},
+	{"Safari/", "Safari"},
+	{"okhttp", "Android App"},
+	{"CFNetwork", "iOS App"},
+}
+
+// userAgentSystems 操作系统识别规则，按顺序匹配（iPhone、Android 的 UA 中也包含 Mac OS X、Linux，需要先匹配）
+var userAgentSystems = []struct {
+	token string
+	name  string
+}{
+	{"iPhone", "iOS"},
+	{"iPad", "iPadOS"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"Mac OS X", "macOS"},
+	{"CrOS", "ChromeOS"},
+	{"Linux", "Linux"},
+}
+
+// ParseDeviceName 根据 User-Agent 生成可读的设备名称，如 "Chrome on macOS"
+func ParseDeviceName(userAgent string) string {
+	if userAgent == "" {
+		return "Unknown device"
+	}
+
+	browser := ""
+	for _, b := range userAgentBrowsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	system := ""
+	for _, s := range userAgentSystems {
+		if strings.Contains(userAgent, s.token) {
+			system = s.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	default:
+		return "Unknown device"
+	}
+}
+
+// HashDeviceID 计算设备标识哈希，用于识别新设备登录
+// 客户端提供 X-Device-ID 时以其为准，否则退化为 User-Agent
+func HashDeviceID(deviceID, userAgent string) string {
+	source := "id:" + deviceID
+	if deviceID == "" {
+		source = "ua:" + userAgent
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"net/smtp"
 	"strings"
+	"time"
 
 	"orbia_api/biz/infra/config"
 )
@@ -129,6 +130,110 @@ Content-Type: text/html; charset="UTF-8"
     </div>
 </body>
 </html>
+`
+
+	// EmailNewDeviceLoginTemplate 新设备登录提醒邮件模板（包含邮件头部）
+	EmailNewDeviceLoginTemplate = `Subject: Orbia App - New Sign-in to Your Account
+From: {{.From}}
+To: {{.To}}
+MIME-version: 1.0
+Content-Type: text/html; charset="UTF-8"
+
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>New Sign-in</title>
+    <style>
+        body {
+            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif;
+            line-height: 1.6;
+            color: #333;
+            max-width: 600px;
+            margin: 0 auto;
+            padding: 20px;
+        }
+        .container {
+            background: linear-gradient(135deg, #667eea 0%, #764ba2 100%);
+            border-radius: 10px;
+            padding: 40px;
+            text-align: center;
+        }
+        .content {
+            background: white;
+            border-radius: 8px;
+            padding: 40px;
+            margin-top: 20px;
+        }
+        .logo {
+            font-size: 32px;
+            font-weight: bold;
+            color: white;
+            margin-bottom: 10px;
+        }
+        h1 {
+            color: #333;
+            font-size: 24px;
+            margin-bottom: 20px;
+        }
+        .details {
+            background: #f7fafc;
+            border-radius: 8px;
+            padding: 20px;
+            margin: 30px 0;
+            text-align: left;
+            font-size: 14px;
+        }
+        .info {
+            color: #718096;
+            font-size: 14px;
+            margin: 20px 0;
+        }
+        .warning {
+            background: #fff3cd;
+            border-left: 4px solid #ffc107;
+            padding: 12px;
+            margin-top: 20px;
+            text-align: left;
+            font-size: 14px;
+            color: #856404;
+        }
+        .footer {
+            margin-top: 30px;
+            padding-top: 20px;
+            border-top: 1px solid #e2e8f0;
+            color: #a0aec0;
+            font-size: 12px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="logo">🚀 Orbia App</div>
+
+        <div class="content">
+            <h1>New Sign-in Detected</h1>
+            <p class="info">Your Orbia account was just signed in to from a device we haven't seen before.</p>
+
+            <div class="details">
+                <p><strong>Device:</strong> {{.DeviceName}}</p>
+                <p><strong>IP address:</strong> {{.IP}}</p>
+                <p><strong>Time:</strong> {{.Time}}</p>
+            </div>
+
+            <div class="warning">
+                <strong>⚠️ Security Notice:</strong><br>
+                If this was you, no action is needed. If you don't recognize this sign-in, revoke the session from your account's session list and secure your login methods immediately.
+            </div>
+
+            <div class="footer">
+                <p>This is an automated message from Orbia App.</p>
+                <p>© 2025 Orbia. All rights reserved.</p>
+            </div>
+        </div>
+    </div>
+</body>
+</html>
 `
 )
 
@@ -216,6 +321,51 @@ func SendVerificationEmail(to, code string, expireMinutes int) error {
 	return nil
 }
 
+// NewDeviceLogin 新设备登录提醒邮件内容
+type NewDeviceLogin struct {
+	DeviceName string
+	IP         string
+	Time       time.Time
+}
+
+// SendNewDeviceLoginEmail 发送新设备登录提醒邮件
+func SendNewDeviceLoginEmail(to string, login *NewDeviceLogin) error {
+	cfg := config.GlobalConfig.SMTP
+	if cfg.Server == "" || cfg.Port == "" {
+		return fmt.Errorf("SMTP configuration is not set")
+	}
+
+	data := struct {
+		From       string
+		To         string
+		DeviceName string
+		IP         string
+		Time       string
+	}{
+		From:       cfg.Email,
+		To:         to,
+		DeviceName: login.DeviceName,
+		IP:         login.IP,
+		Time:       login.Time.UTC().Format("2006-01-02 15:04:05 UTC"),
+	}
+
+	tmpl, err := template.New("new_device_login").Parse(EmailNewDeviceLoginTemplate)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+	var emailBody bytes.Buffer
+	if err := tmpl.Execute(&emailBody, data); err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	auth := smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Server)
+	addr := fmt.Sprintf("%s:%s", cfg.Server, cfg.Port)
+	if err := smtp.SendMail(addr, auth, cfg.Email, []string{to}, emailBody.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+	return nil
+}
+
 // min helper function
 func min(a, b int) int {
 	if a < b {
@@ -159,7 +159,19 @@ func GenerateTransactionID() string {
 	return fmt.Sprintf("TXN%d", id)
 }
 
+// GenerateLedgerEntryID 生成账本分录ID（格式：JE{snowflake_id}）
+func GenerateLedgerEntryID() string {
+	id, _ := GetDefaultGenerator().NextID()
+	return fmt.Sprintf("JE%d", id)
+}
+
 // GenerateID 生成通用ID字符串
 func GenerateID() (string, error) {
 	return GetDefaultGenerator().NextIDString()
 }
+
+// GenerateRequestID 生成请求ID（格式：REQ{snowflake_id}）
+func GenerateRequestID() string {
+	id, _ := GetDefaultGenerator().NextID()
+	return fmt.Sprintf("REQ%d", id)
+}
@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -8,23 +12,39 @@ import (
 	"orbia_api/biz/infra/config"
 )
 
+const (
+	// defaultAccessExpireMinutes 未配置时访问令牌的有效期（分钟）
+	defaultAccessExpireMinutes = 15
+	// defaultRefreshExpireDays 未配置时刷新令牌的有效期（天）
+	defaultRefreshExpireDays = 30
+)
+
 // Claims JWT 声明结构
 type Claims struct {
-	UserID int64 `json:"user_id"`
+	UserID    int64  `json:"user_id"`
+	SessionID string `json:"sid"` // 登录会话ID，会话吊销后该会话签发的访问令牌全部失效
 	jwt.RegisteredClaims
 }
 
-// GenerateToken 生成 JWT token
-func GenerateToken(userID int64) (string, int64, error) {
+// GenerateToken 生成访问令牌（短期 JWT），返回令牌和有效期秒数
+func GenerateToken(userID int64, sessionID string) (string, int64, error) {
 	cfg := config.GlobalConfig.JWT
-	expirationTime := time.Now().Add(time.Duration(cfg.ExpireHours) * time.Hour)
-	
+	expireIn := AccessTokenTTL()
+	now := time.Now()
+
+	jti, err := randomToken(16)
+	if err != nil {
+		return "", 0, err
+	}
+
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(now.Add(expireIn)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "orbia_api",
 		},
 	}
@@ -35,14 +55,17 @@ func GenerateToken(userID int64) (string, int64, error) {
 		return "", 0, err
 	}
 
-	return tokenString, int64(cfg.ExpireHours * 3600), nil // 返回秒数
+	return tokenString, int64(expireIn / time.Second), nil // 返回秒数
 }
 
 // ParseToken 解析 JWT token
 func ParseToken(tokenString string) (*Claims, error) {
 	cfg := config.GlobalConfig.JWT
-	
+
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
 		return []byte(cfg.Secret), nil
 	})
 
@@ -57,17 +80,74 @@ func ParseToken(tokenString string) (*Claims, error) {
 	return nil, errors.New("invalid token")
 }
 
-// ValidateToken 验证 token 是否有效
-func ValidateToken(tokenString string) (int64, error) {
+// ValidateToken 验证访问令牌是否有效，返回令牌声明
+// 只校验签名和有效期，会话是否已吊销由调用方检查
+func ValidateToken(tokenString string) (*Claims, error) {
 	claims, err := ParseToken(tokenString)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// 检查是否过期
-	if claims.ExpiresAt.Before(time.Now()) {
-		return 0, errors.New("token expired")
+	if claims.ExpiresAt == nil || claims.ExpiresAt.Before(time.Now()) {
+		return nil, errors.New("token expired")
+	}
+
+	// 不含会话ID的旧令牌无法吊销，不再接受
+	if claims.SessionID == "" {
+		return nil, errors.New("token has no session")
+	}
+
+	return claims, nil
+}
+
+// GenerateRefreshToken 生成刷新令牌（随机串），返回令牌和用于存储的哈希
+func GenerateRefreshToken() (string, string, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken 计算刷新令牌的 SHA-256，数据库只保存哈希
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateSessionID 生成登录会话ID
+func GenerateSessionID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
 	}
+	return hex.EncodeToString(buf), nil
+}
 
-	return claims.UserID, nil
-}
\ No newline at end of file
+// AccessTokenTTL 访问令牌有效期
+func AccessTokenTTL() time.Duration {
+	minutes := config.GlobalConfig.JWT.AccessExpireMinutes
+	if minutes <= 0 {
+		minutes = defaultAccessExpireMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// RefreshTokenTTL 刷新令牌有效期，每次刷新后重新计算
+func RefreshTokenTTL() time.Duration {
+	days := config.GlobalConfig.JWT.RefreshExpireDays
+	if days <= 0 {
+		days = defaultRefreshExpireDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// randomToken 生成 URL 安全的随机串
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,276 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// Scale 金额精度（小数位数），与数据库 DECIMAL(x,2) 字段一致
+const Scale = 2
+
+// Amount 金额（定点数，以“分”为单位存储）
+// 数据库中读写为 DECIMAL，JSON 中序列化为十进制字符串（如 "12.34"）
+type Amount int64
+
+// Zero 零金额
+const Zero Amount = 0
+
+// MaxAmount 金额绝对值的上限，与数据库 DECIMAL(12,2) 字段的取值范围一致
+const MaxAmount Amount = 999999999999
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// ErrInvalidAmount 金额格式错误
+var ErrInvalidAmount = errors.New("invalid amount format")
+
+// RoundingMode 舍入方式
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // 四舍五入（远离零）
+	RoundHalfEven                     // 银行家舍入
+	RoundDown                         // 向零截断
+	RoundUp                           // 远离零进位
+)
+
+// Currency 币种及其舍入规则
+type Currency struct {
+	Code     string
+	Digits   int          // 最小货币单位的小数位数，不超过 Scale
+	Rounding RoundingMode // 计算结果（佣金、按比例拆分等）的舍入方式
+}
+
+var (
+	// USD 美元：精确到分，四舍五入
+	USD = Currency{Code: "USD", Digits: 2, Rounding: RoundHalfUp}
+	// USDT 链上精度高于系统精度，入账时向下截断，避免多记
+	USDT = Currency{Code: "USDT", Digits: 2, Rounding: RoundDown}
+	// USDC 同 USDT
+	USDC = Currency{Code: "USDC", Digits: 2, Rounding: RoundDown}
+
+	// Default 系统记账币种
+	Default = USD
+)
+
+// FromCents 根据“分”创建金额
+func FromCents(cents int64) Amount {
+	return Amount(cents)
+}
+
+// FromFloat 将浮点数转换为金额（按默认币种舍入，仅用于配置等非账务数据）
+func FromFloat(f float64) (Amount, error) {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	if !ok {
+		return Zero, ErrInvalidAmount
+	}
+	return Default.Round(r)
+}
+
+// Parse 按默认币种解析十进制金额字符串
+func Parse(s string) (Amount, error) {
+	return Default.Parse(s)
+}
+
+// Parse 解析十进制金额字符串，小数位数超过币种精度时返回错误
+func (c Currency) Parse(s string) (Amount, error) {
+	r, err := parseRat(s)
+	if err != nil {
+		return Zero, err
+	}
+
+	a, err := c.Round(r)
+	if err != nil {
+		return Zero, err
+	}
+	if a.Rat().Cmp(r) != 0 {
+		return Zero, fmt.Errorf("%w: at most %d decimal places allowed for %s", ErrInvalidAmount, c.Digits, c.Code)
+	}
+	return a, nil
+}
+
+// ParseRounded 解析十进制金额字符串，并按币种规则舍入多余的小数位
+func (c Currency) ParseRounded(s string) (Amount, error) {
+	r, err := parseRat(s)
+	if err != nil {
+		return Zero, err
+	}
+	return c.Round(r)
+}
+
+// Round 按币种精度和舍入方式将有理数舍入为金额，超出 MaxAmount 范围时返回 ErrInvalidAmount
+func (c Currency) Round(r *big.Rat) (Amount, error) {
+	digits := c.Digits
+	if digits > Scale {
+		digits = Scale
+	}
+
+	unit := pow10(digits)
+	units := roundRat(new(big.Rat).Mul(r, new(big.Rat).SetInt(unit)), c.Rounding)
+	units.Mul(units, pow10(Scale-digits))
+	if !units.IsInt64() || Amount(units.Int64()).Abs() > MaxAmount {
+		return Zero, fmt.Errorf("%w: %s is out of range", ErrInvalidAmount, r.FloatString(Scale))
+	}
+	return Amount(units.Int64()), nil
+}
+
+// Cents 返回以“分”为单位的整数
+func (a Amount) Cents() int64 {
+	return int64(a)
+}
+
+// Rat 返回金额的精确有理数表示（以元为单位）
+func (a Amount) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(int64(a)), pow10(Scale))
+}
+
+// IsZero 是否为零
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// IsPositive 是否大于零
+func (a Amount) IsPositive() bool {
+	return a > 0
+}
+
+// Abs 绝对值
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// MulRate 乘以比例（如佣金比例），结果按默认币种规则舍入
+func (a Amount) MulRate(rate float64) (Amount, error) {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(rate, 'f', -1, 64))
+	if !ok {
+		return Zero, ErrInvalidAmount
+	}
+	return Default.Round(r.Mul(r, a.Rat()))
+}
+
+// MulRatio 按 num/den 比例拆分金额，结果按默认币种规则舍入；den 为零时返回原金额
+func (a Amount) MulRatio(num, den Amount) (Amount, error) {
+	if den == 0 {
+		return a, nil
+	}
+	r := new(big.Rat).SetFrac(big.NewInt(int64(num)), big.NewInt(int64(den)))
+	return Default.Round(r.Mul(r, a.Rat()))
+}
+
+// String 格式化为十进制字符串，固定两位小数（如 "-12.30"）
+func (a Amount) String() string {
+	sign := ""
+	cents := int64(a)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON 序列化为十进制字符串
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(a.String())), nil
+}
+
+// UnmarshalJSON 支持十进制字符串和数字两种格式
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Value 实现 driver.Valuer，以十进制字符串写入数据库，避免浮点误差
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan 实现 sql.Scanner，读取 DECIMAL 字段
+func (a *Amount) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*a = Zero
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		*a = Amount(v * 100)
+		return nil
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("unsupported amount type %T", value)
+	}
+
+	parsed, err := Default.ParseRounded(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// parseRat 解析十进制字符串（不接受科学计数法和分数形式）
+func parseRat(s string) (*big.Rat, error) {
+	if !decimalPattern.MatchString(s) {
+		return nil, ErrInvalidAmount
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+	return r, nil
+}
+
+// roundRat 将有理数按舍入方式取整
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// 余数与除数一半的比较结果：-1 小于一半，0 恰好一半，1 大于一半
+	half := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(r.Denom())
+
+	awayFromZero := false
+	switch mode {
+	case RoundHalfUp:
+		awayFromZero = half >= 0
+	case RoundHalfEven:
+		awayFromZero = half > 0 || (half == 0 && quo.Bit(0) == 1)
+	case RoundUp:
+		awayFromZero = true
+	case RoundDown:
+		awayFromZero = false
+	}
+
+	if awayFromZero {
+		quo.Add(quo, big.NewInt(int64(r.Sign())))
+	}
+	return quo
+}
+
+// pow10 返回 10 的 n 次方
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,236 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{in: "12.34", want: 1234},
+		{in: "0.1", want: 10},
+		{in: "-5", want: -500},
+		{in: "+3.", want: 300},
+		{in: ".5", want: 50},
+		{in: "9999999999.99", want: MaxAmount},
+		{in: "-9999999999.99", want: -MaxAmount},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "1/2", wantErr: true},
+		{in: "1.234", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidAmount) {
+				t.Errorf("Parse(%q) err = %v, want ErrInvalidAmount", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseOverflow(t *testing.T) {
+	for _, in := range []string{"10000000000.00", "-10000000000", "92233720368547758.07", "1" + strings.Repeat("0", 40)} {
+		_, err := Parse(in)
+		if !errors.Is(err, ErrInvalidAmount) {
+			t.Fatalf("Parse(%q) err = %v, want ErrInvalidAmount", in, err)
+		}
+		if !strings.Contains(err.Error(), "out of range") {
+			t.Fatalf("Parse(%q) err = %v, want an out of range error", in, err)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode RoundingMode
+		want Amount
+	}{
+		{in: "1.005", mode: RoundHalfUp, want: 101},
+		{in: "-1.005", mode: RoundHalfUp, want: -101},
+		{in: "1.004", mode: RoundHalfUp, want: 100},
+		{in: "1.005", mode: RoundHalfEven, want: 100},
+		{in: "1.015", mode: RoundHalfEven, want: 102},
+		{in: "1.0051", mode: RoundHalfEven, want: 101},
+		{in: "1.009", mode: RoundDown, want: 100},
+		{in: "-1.009", mode: RoundDown, want: -100},
+		{in: "1.001", mode: RoundUp, want: 101},
+		{in: "-1.001", mode: RoundUp, want: -101},
+		{in: "1.00", mode: RoundUp, want: 100},
+	}
+
+	for _, tt := range tests {
+		r, _ := new(big.Rat).SetString(tt.in)
+		got, err := Currency{Code: "TEST", Digits: 2, Rounding: tt.mode}.Round(r)
+		if err != nil || got != tt.want {
+			t.Errorf("Round(%s, mode %d) = %d, %v; want %d", tt.in, tt.mode, got, err, tt.want)
+		}
+	}
+
+	// 币种精度低于系统精度时按币种精度舍入
+	whole := Currency{Code: "WHOLE", Digits: 0, Rounding: RoundHalfUp}
+	if got, err := whole.Round(big.NewRat(3, 2)); err != nil || got != 200 {
+		t.Errorf("Round(1.5) with 0 digits = %d, %v; want 200", got, err)
+	}
+}
+
+func TestRoundOverflow(t *testing.T) {
+	// 18 位小数的 BEP-20 链上金额
+	wei := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	tests := []*big.Rat{
+		new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(1), 200), wei),
+		new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(1), 127), wei),
+		new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(10_000_000_000), wei), wei),
+		big.NewRat(-10_000_000_000, 1),
+	}
+	for _, r := range tests {
+		if got, err := USDT.Round(r); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Round(%s) = %d, %v; want ErrInvalidAmount", r.FloatString(2), got, err)
+		}
+	}
+
+	maxUnits := new(big.Int).Mul(big.NewInt(999_999_999_999), new(big.Int).Exp(big.NewInt(10), big.NewInt(16), nil))
+	if got, err := USDT.Round(new(big.Rat).SetFrac(maxUnits, wei)); err != nil || got != MaxAmount {
+		t.Errorf("Round(max) = %d, %v; want %d", got, err, MaxAmount)
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	if got, err := FromFloat(0.1); err != nil || got != 10 {
+		t.Errorf("FromFloat(0.1) = %d, %v; want 10", got, err)
+	}
+	for _, f := range []float64{math.NaN(), math.Inf(1), 1e20} {
+		if _, err := FromFloat(f); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("FromFloat(%v) err = %v, want ErrInvalidAmount", f, err)
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	if got, err := FromCents(1001).MulRate(0.1); err != nil || got != 100 {
+		t.Errorf("MulRate = %d, %v; want 100", got, err)
+	}
+	if _, err := MaxAmount.MulRate(2); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("MulRate overflow err = %v, want ErrInvalidAmount", err)
+	}
+	if got, err := FromCents(1000).MulRatio(1, 3); err != nil || got != 333 {
+		t.Errorf("MulRatio = %d, %v; want 333", got, err)
+	}
+	if got, err := FromCents(1000).MulRatio(1, 0); err != nil || got != 1000 {
+		t.Errorf("MulRatio with zero den = %d, %v; want 1000", got, err)
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := map[Amount]string{
+		0:          "0.00",
+		5:          "0.05",
+		1230:       "12.30",
+		-1230:      "-12.30",
+		-5:         "-0.05",
+		MaxAmount:  "9999999999.99",
+		-MaxAmount: "-9999999999.99",
+	}
+	for a, want := range tests {
+		if got := a.String(); got != want {
+			t.Errorf("Amount(%d).String() = %q, want %q", int64(a), got, want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Amount Amount  `json:"amount"`
+		Refund *Amount `json:"refund"`
+	}
+
+	refund := FromCents(-50)
+	data, err := json.Marshal(payload{Amount: 1234, Refund: &refund})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(data) != `{"amount":"12.34","refund":"-0.50"}` {
+		t.Fatalf("marshal = %s", data)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if decoded.Amount != 1234 || decoded.Refund == nil || *decoded.Refund != refund {
+		t.Fatalf("round trip = %+v", decoded)
+	}
+
+	// 数字格式和 null
+	decoded = payload{Amount: 1}
+	if err := json.Unmarshal([]byte(`{"amount":12.5,"refund":null}`), &decoded); err != nil {
+		t.Fatalf("unmarshal number: %v", err)
+	}
+	if decoded.Amount != 1250 || decoded.Refund != nil {
+		t.Fatalf("unmarshal number = %+v", decoded)
+	}
+
+	for _, in := range []string{`{"amount":"1.234"}`, `{"amount":"abc"}`, `{"amount":"10000000000"}`, `{"amount":1e3}`} {
+		if err := json.Unmarshal([]byte(in), &decoded); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("unmarshal %s err = %v, want ErrInvalidAmount", in, err)
+		}
+	}
+}
+
+func TestSQL(t *testing.T) {
+	value, err := FromCents(-1230).Value()
+	if err != nil || value != "-12.30" {
+		t.Fatalf("Value() = %v, %v; want -12.30", value, err)
+	}
+
+	tests := []struct {
+		in   interface{}
+		want Amount
+	}{
+		{in: []byte("12.34"), want: 1234},
+		{in: "-12.30", want: -1230},
+		{in: int64(7), want: 700},
+		{in: 0.1, want: 10},
+		{in: nil, want: Zero},
+		// 数据库返回的小数位数多于系统精度时按默认币种舍入
+		{in: "1.005", want: 101},
+	}
+	for _, tt := range tests {
+		a := Amount(99)
+		if err := a.Scan(tt.in); err != nil || a != tt.want {
+			t.Errorf("Scan(%v) = %d, %v; want %d", tt.in, a, err, tt.want)
+		}
+	}
+
+	// Value 写入的值可以原样读回
+	for _, original := range []Amount{0, 1, -1, 1234, MaxAmount, -MaxAmount} {
+		v, _ := original.Value()
+		var scanned Amount
+		if err := scanned.Scan(v); err != nil || scanned != original {
+			t.Errorf("round trip %d = %d, %v", int64(original), scanned, err)
+		}
+	}
+
+	var a Amount
+	if err := a.Scan(true); err == nil {
+		t.Error("Scan(bool) should fail")
+	}
+	if err := a.Scan("99999999999.99"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Scan overflow err = %v, want ErrInvalidAmount", err)
+	}
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+
+// siweNoncePattern EIP-4361 要求 nonce 为至少 8 位的字母数字
+var siweNoncePattern = regexp.MustCompile(`^[a-zA-Z0-9]{8,}$`)
+
+// SIWEMessage EIP-4361（Sign-In with Ethereum）登录消息
+type SIWEMessage struct {
+	Domain         string     // 请求签名的域名（可带端口）
+	Address        string     // EIP-55 校验和格式的钱包地址
+	Statement      string     // 展示给用户的说明，可为空
+	URI            string     // 登录的资源地址
+	Version        string     // 固定为 1
+	ChainID        int64      // EIP-155 链 ID
+	Nonce          string     // 服务端下发的一次性随机数
+	IssuedAt       time.Time  // 签发时间
+	ExpirationTime *time.Time // 过期时间
+}
+
+// String 按 EIP-4361 格式生成待签名的消息
+func (m *SIWEMessage) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + siweHeaderSuffix + "\n")
+	b.WriteString(m.Address + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("URI: " + m.URI + "\n")
+	b.WriteString("Version: " + m.Version + "\n")
+	b.WriteString("Chain ID: " + strconv.FormatInt(m.ChainID, 10) + "\n")
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + m.IssuedAt.UTC().Format(time.RFC3339))
+	if m.ExpirationTime != nil {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	return b.String()
+}
+
+// ParseSIWEMessage 解析 EIP-4361 登录消息
+// 只支持本服务签发的字段（不含 Not Before、Request ID 和 Resources）
+func ParseSIWEMessage(message string) (*SIWEMessage, error) {
+	lines := strings.Split(message, "\n")
+	if len(lines) < 3 || !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, errors.New("not a sign-in with ethereum message")
+	}
+
+	m := &SIWEMessage{Domain: strings.TrimSuffix(lines[0], siweHeaderSuffix)}
+	if m.Domain == "" {
+		return nil, errors.New("missing domain")
+	}
+	if !common.IsHexAddress(lines[1]) || common.HexToAddress(lines[1]).Hex() != lines[1] {
+		return nil, errors.New("address must be an EIP-55 checksummed address")
+	}
+	m.Address = lines[1]
+	if lines[2] != "" {
+		return nil, errors.New("malformed message: expected empty line after address")
+	}
+
+	// 说明行（可选）后跟一个空行
+	i := 3
+	if i < len(lines) && lines[i] != "" && !strings.HasPrefix(lines[i], "URI: ") {
+		m.Statement = lines[i]
+		i++
+	}
+	if i >= len(lines) || lines[i] != "" {
+		return nil, errors.New("malformed message: expected empty line before fields")
+	}
+	i++
+
+	fields := []string{"URI", "Version", "Chain ID", "Nonce", "Issued At", "Expiration Time"}
+	values := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if i >= len(lines) {
+			break
+		}
+		value, ok := strings.CutPrefix(lines[i], field+": ")
+		if !ok {
+			if field == "Expiration Time" {
+				break
+			}
+			return nil, fmt.Errorf("malformed message: missing %s", field)
+		}
+		values[field] = value
+		i++
+	}
+	if i != len(lines) {
+		return nil, errors.New("malformed message: unexpected trailing content")
+	}
+	for _, field := range fields[:5] {
+		if _, ok := values[field]; !ok {
+			return nil, fmt.Errorf("malformed message: missing %s", field)
+		}
+	}
+
+	m.URI = values["URI"]
+	m.Version = values["Version"]
+	if m.Version != "1" {
+		return nil, fmt.Errorf("unsupported version: %s", m.Version)
+	}
+
+	chainID, err := strconv.ParseInt(values["Chain ID"], 10, 64)
+	if err != nil || chainID <= 0 {
+		return nil, errors.New("invalid chain ID")
+	}
+	m.ChainID = chainID
+
+	m.Nonce = values["Nonce"]
+	if !siweNoncePattern.MatchString(m.Nonce) {
+		return nil, errors.New("invalid nonce")
+	}
+
+	m.IssuedAt, err = time.Parse(time.RFC3339, values["Issued At"])
+	if err != nil {
+		return nil, errors.New("invalid issued at time")
+	}
+	if value, ok := values["Expiration Time"]; ok {
+		expirationTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.New("invalid expiration time")
+		}
+		m.ExpirationTime = &expirationTime
+	}
+
+	return m, nil
+}
+
+// GenerateSIWENonce 生成 EIP-4361 登录随机数（32 位十六进制）
+func GenerateSIWENonce() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"net/url"
 	"strings"
 
 	"orbia_api/biz/infra/config"
@@ -83,3 +84,37 @@ func IsWhitelistedURL(url string) bool {
 	}
 	return false
 }
+
+// PlatformVideoHosts 允许作为交付视频链接的外部平台域名，子域名（如 www.、m.、vm.）同样允许
+var PlatformVideoHosts = []string{
+	"tiktok.com",
+	"youtube.com",
+	"youtu.be",
+	"x.com",
+	"twitter.com",
+	"discord.com",
+}
+
+// ValidatePlatformVideoURL 校验外部平台视频链接：必须是 http/https 链接，
+// 域名在 PlatformVideoHosts 中且不带端口和用户信息
+func ValidatePlatformVideoURL(videoURL string) (bool, string) {
+	if videoURL == "" {
+		return false, "video URL cannot be empty"
+	}
+
+	parsed, err := url.Parse(videoURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return false, "invalid video URL format"
+	}
+	if parsed.User != nil || parsed.Port() != "" {
+		return false, "invalid video URL format"
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range PlatformVideoHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true, ""
+		}
+	}
+	return false, "video platform not allowed"
+}
@@ -0,0 +1,35 @@
+package utils
+
+import "testing"
+
+func TestValidatePlatformVideoURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://www.tiktok.com/@orbia/video/7300000000000000000", valid: true},
+		{url: "https://vm.tiktok.com/ZMabcdef/", valid: true},
+		{url: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", valid: true},
+		{url: "https://m.youtube.com/shorts/dQw4w9WgXcQ", valid: true},
+		{url: "https://youtu.be/dQw4w9WgXcQ", valid: true},
+		{url: "http://x.com/orbia/status/1", valid: true},
+		{url: "https://TWITTER.com/orbia/status/1", valid: true},
+		{url: "https://discord.com/channels/1/2/3", valid: true},
+		{url: ""},
+		{url: "tiktok.com/@orbia/video/1"},
+		{url: "ftp://youtube.com/video"},
+		{url: "javascript:alert(1)"},
+		{url: "https://evil.com/youtube.com"},
+		{url: "https://youtube.com.evil.com/watch?v=1"},
+		{url: "https://notyoutube.com/watch?v=1"},
+		{url: "https://youtube.com@evil.com/watch?v=1"},
+		{url: "https://user@youtube.com/watch?v=1"},
+		{url: "https://youtube.com:8443/watch?v=1"},
+	}
+
+	for _, tt := range tests {
+		if valid, msg := ValidatePlatformVideoURL(tt.url); valid != tt.valid {
+			t.Errorf("ValidatePlatformVideoURL(%q) = %v (%s), want %v", tt.url, valid, msg, tt.valid)
+		}
+	}
+}
@@ -11,7 +11,7 @@ struct AdOrderInfo {
     5: optional string team_name  // 团队名称（关联查询）
     6: string title  // 广告订单标题
     7: string description  // 广告订单描述
-    8: double budget  // 广告预算（美元）
+    8: string budget  // 广告预算（美元）
     9: string ad_type  // 广告类型：banner, video, social_media, influencer
     10: string target_audience  // 目标受众
     11: string start_date  // 开始日期（YYYY-MM-DD）
@@ -29,7 +29,7 @@ struct AdOrderInfo {
 struct CreateAdOrderReq {
     1: string title (api.body="title")  // 广告订单标题
     2: string description (api.body="description")  // 广告订单描述
-    3: double budget (api.body="budget")  // 广告预算（美元）
+    3: string budget (api.body="budget")  // 广告预算（美元）
     4: string ad_type (api.body="ad_type")  // 广告类型
     5: string target_audience (api.body="target_audience")  // 目标受众
     6: string start_date (api.body="start_date")  // 开始日期（YYYY-MM-DD）
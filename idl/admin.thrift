@@ -48,6 +48,20 @@ struct SetUserStatusResp {
     1: common.BaseResp base_resp
 }
 
+// 合并用户账号请求
+// 源账号的登录方式、钱包余额、团队、订单和KOL资料转移到目标账号，源账号随后被删除
+struct MergeUsersReq {
+    1: required i64 source_user_id (api.body="source_user_id") // 被合并的账号（合并后删除）
+    2: required i64 target_user_id (api.body="target_user_id") // 保留的账号
+    3: optional string remark (api.body="remark") // 备注
+}
+
+// 合并用户账号响应
+struct MergeUsersResp {
+    1: common.BaseResp base_resp
+    2: UserListItem user // 合并后的目标账号
+}
+
 // ==================== KOL管理 ====================
 
 // 管理员获取所有KOL列表请求
@@ -162,7 +176,7 @@ struct OrderListItem {
     5: i64 kol_id
     6: optional string kol_name
     7: string plan_title
-    8: double plan_price
+    8: string plan_price
     9: string status
     10: string created_at
     11: optional string completed_at
@@ -187,10 +201,10 @@ struct UserWalletInfo {
     1: i64 user_id
     2: optional string user_name
     3: optional string user_email
-    4: double balance
-    5: double frozen_balance
-    6: double total_recharge
-    7: double total_consume
+    4: string balance
+    5: string frozen_balance
+    6: string total_recharge
+    7: string total_consume
     8: string created_at
     9: string updated_at
 }
@@ -201,12 +215,40 @@ struct GetUserWalletResp {
     2: optional UserWalletInfo wallet
 }
 
+// 管理员钱包对账请求
+struct ReconcileWalletsReq {
+    1: optional i64 user_id (api.body="user_id") // 仅核对指定用户，不传则核对全部钱包
+}
+
+// 钱包对账差异项
+struct WalletDriftItem {
+    1: i64 user_id
+    2: string wallet_balance // 钱包可用余额
+    3: string ledger_balance // 账本计算的可用余额
+    4: string balance_drift // 可用余额差异（钱包 - 账本）
+    5: string wallet_frozen_balance // 钱包冻结余额
+    6: string ledger_frozen_balance // 账本计算的冻结余额
+    7: string frozen_drift // 冻结余额差异（钱包 - 账本）
+}
+
+// 管理员钱包对账响应
+struct ReconcileWalletsResp {
+    1: common.BaseResp base_resp
+    2: i32 wallet_count // 核对的钱包数量
+    3: i32 drift_count // 存在差异的钱包数量
+    4: list<WalletDriftItem> drifts // 差异明细
+    5: string total_debit // 账本借方合计
+    6: string total_credit // 账本贷方合计
+    7: bool balanced // 账本借贷是否平衡
+    8: string checked_at // 对账时间
+}
+
 // ==================== Campaign消费管理 ====================
 
 // 管理员给Campaign添加消费账单请求
 struct AddCampaignConsumeReq {
     1: string campaign_id (api.body="campaign_id")
-    2: double amount (api.body="amount") // 消费金额（美元）
+    2: string amount (api.body="amount") // 消费金额（美元）
     3: optional string remark (api.body="remark") // 备注说明
 }
 
@@ -216,11 +258,91 @@ struct AddCampaignConsumeResp {
     2: optional string transaction_id // 交易ID
 }
 
+// ==================== 后台角色管理 ====================
+
+// 后台角色
+struct RoleInfo {
+    1: string role // super_admin, finance, moderator, content_editor, support
+    2: list<string> permissions // 角色拥有的权限
+}
+
+// 获取后台角色列表请求
+struct ListRolesReq {
+}
+
+// 获取后台角色列表响应
+struct ListRolesResp {
+    1: common.BaseResp base_resp
+    2: list<RoleInfo> roles
+}
+
+// 获取用户后台角色请求
+struct GetUserRolesReq {
+    1: i64 user_id (api.path="user_id")
+}
+
+// 用户后台角色响应（分配/撤销后也返回最新角色）
+struct UserRolesResp {
+    1: common.BaseResp base_resp
+    2: i64 user_id
+    3: list<string> roles // 已分配的后台角色
+    4: list<string> permissions // 角色权限的并集
+}
+
+// 分配/撤销后台角色请求
+struct UpdateUserRoleReq {
+    1: required i64 user_id (api.body="user_id")
+    2: required string role (api.body="role")
+}
+
+// ==================== 审计日志 ====================
+
+// 审计日志查询条件
+struct GetAuditLogsReq {
+    1: optional i64 actor_id (api.query="actor_id") // 操作人用户ID
+    2: optional string action (api.query="action") // 操作类型，如 user.set_status
+    3: optional string target_type (api.query="target_type") // 目标实体类型，如 user, recharge_order
+    4: optional string target_id (api.query="target_id") // 目标实体ID
+    5: optional string request_id (api.query="request_id") // 请求ID
+    6: optional string start_time (api.query="start_time") // 开始时间（RFC3339）
+    7: optional string end_time (api.query="end_time") // 结束时间（RFC3339）
+    8: optional i32 page = 1 (api.query="page")
+    9: optional i32 page_size = 10 (api.query="page_size")
+}
+
+// 审计日志项
+struct AuditLogItem {
+    1: i64 id
+    2: i64 actor_id // 操作人用户ID（系统任务为0）
+    3: string action
+    4: string target_type
+    5: string target_id
+    6: optional string before_data // 操作前快照（JSON）
+    7: optional string after_data // 操作后快照（JSON）
+    8: string ip
+    9: string user_agent
+    10: string request_id
+    11: string created_at
+}
+
+// 审计日志列表响应
+struct GetAuditLogsResp {
+    1: common.BaseResp base_resp
+    2: list<AuditLogItem> logs
+    3: common.PageResp page_info
+}
+
+// 导出审计日志响应（成功时直接返回 CSV 文件，失败时返回该结构）
+struct ExportAuditLogsResp {
+    1: common.BaseResp base_resp
+}
+
 // 管理员服务
 service AdminService {
     // 用户管理
     GetAllUsersResp GetAllUsers(1: GetAllUsersReq req) (api.post="/api/v1/admin/users")
     SetUserStatusResp SetUserStatus(1: SetUserStatusReq req) (api.post="/api/v1/admin/user/status")
+    MergeUsersResp MergeUsers(1: MergeUsersReq req) (api.post="/api/v1/admin/user/merge")
     
     // KOL管理
     GetAllKolsResp GetAllKols(1: GetAllKolsReq req) (api.post="/api/v1/admin/kols")
@@ -235,8 +357,19 @@ service AdminService {
     
     // 钱包管理
     GetUserWalletResp GetUserWallet(1: GetUserWalletReq req) (api.post="/api/v1/admin/user/:user_id/wallet")
+    ReconcileWalletsResp ReconcileWallets(1: ReconcileWalletsReq req) (api.post="/api/v1/admin/wallet/reconcile")
     
     // Campaign消费管理
     AddCampaignConsumeResp AddCampaignConsume(1: AddCampaignConsumeReq req) (api.post="/api/v1/admin/campaign/consume")
+
+    // 后台角色管理
+    ListRolesResp ListRoles(1: ListRolesReq req) (api.post="/api/v1/admin/roles")
+    UserRolesResp GetUserRoles(1: GetUserRolesReq req) (api.post="/api/v1/admin/user/:user_id/roles")
+    UserRolesResp AssignUserRole(1: UpdateUserRoleReq req) (api.post="/api/v1/admin/user/role/assign")
+    UserRolesResp RevokeUserRole(1: UpdateUserRoleReq req) (api.post="/api/v1/admin/user/role/revoke")
+
+    // 审计日志
+    GetAuditLogsResp GetAuditLogs(1: GetAuditLogsReq req) (api.post="/api/v1/admin/audit/logs")
+    ExportAuditLogsResp ExportAuditLogs(1: GetAuditLogsReq req) (api.post="/api/v1/admin/audit/export")
 }
 
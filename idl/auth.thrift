@@ -2,24 +2,43 @@ namespace go auth
 
 include "common.thrift"
 
+// 钱包登录挑战请求
+struct WalletChallengeReq {
+    1: required string wallet_address (api.body="wallet_address")
+    2: optional i64 chain_id (api.body="chain_id")  // 链ID，不传时使用默认链
+}
+
+// 钱包登录挑战响应
+struct WalletChallengeResp {
+    1: string message     // 待签名的 EIP-4361 消息，原样交给钱包签名
+    2: string nonce       // 一次性随机数
+    3: string expires_at  // 过期时间
+    4: common.BaseResp base_resp
+}
+
 // 钱包登录请求
 struct WalletLoginReq {
     1: required string wallet_address (api.body="wallet_address")
     2: required string signature (api.body="signature")
-    3: optional string message (api.body="message")
+    3: required string message (api.body="message")  // 登录挑战下发的 EIP-4361 消息
 }
 
 // 钱包登录响应
 struct WalletLoginResp {
-    1: string token
-    2: i64 expires_in
+    1: string token               // 访问令牌
+    2: i64 expires_in             // 访问令牌有效期（秒）
     3: common.BaseResp base_resp
+    4: string refresh_token       // 刷新令牌，访问令牌过期后调用 /auth/refresh 换取新令牌
+    5: i64 refresh_expires_in     // 刷新令牌有效期（秒）
+    6: bool mfa_required          // 是否需要两步验证，为 true 时不返回令牌，需调用 /auth/2fa/verify
+    7: string mfa_token           // 两步验证挑战令牌
+    8: i64 mfa_expires_in         // 两步验证挑战有效期（秒）
 }
 
 // 发送验证码请求
 struct SendVerificationCodeReq {
     1: required string email (api.body="email")
-    2: optional string code_type (api.body="code_type")  // 验证码类型：login, register, reset_password，默认为login
+    2: optional string code_type (api.body="code_type")  // 验证码类型：login, register, reset_password, link，默认为login
 }
 
 // 发送验证码响应
@@ -35,14 +54,139 @@ struct EmailLoginReq {
 
 // 邮箱验证码登录响应
 struct EmailLoginResp {
+    1: string token               // 访问令牌
+    2: i64 expires_in             // 访问令牌有效期（秒）
+    3: common.BaseResp base_resp
+    4: string refresh_token       // 刷新令牌，访问令牌过期后调用 /auth/refresh 换取新令牌
+    5: i64 refresh_expires_in     // 刷新令牌有效期（秒）
+    6: bool mfa_required          // 是否需要两步验证，为 true 时不返回令牌，需调用 /auth/2fa/verify
+    7: string mfa_token           // 两步验证挑战令牌
+    8: i64 mfa_expires_in         // 两步验证挑战有效期（秒）
+}
+
+// 刷新令牌请求
+struct RefreshTokenReq {
+    1: required string refresh_token (api.body="refresh_token")
+}
+
+// 刷新令牌响应（刷新令牌每次使用后轮换，旧令牌作废）
+struct RefreshTokenResp {
     1: string token
     2: i64 expires_in
+    3: string refresh_token
+    4: i64 refresh_expires_in
+    5: common.BaseResp base_resp
+}
+
+// 退出登录请求
+struct LogoutReq {
+    // JWT中间件会自动解析用户ID和会话ID，无需传参
+}
+
+// 退出所有会话请求
+struct LogoutAllReq {
+    // JWT中间件会自动解析用户ID，无需传参
+}
+
+// 退出登录响应
+struct LogoutResp {
+    1: common.BaseResp base_resp
+}
+
+// 绑定邮箱请求（验证码通过 send-verification-code 以 code_type=link 获取）
+struct LinkEmailReq {
+    1: required string email (api.body="email")
+    2: required string code (api.body="code")
+}
+
+// 绑定钱包请求（签名消息通过 wallet-challenge 获取）
+struct LinkWalletReq {
+    1: required string wallet_address (api.body="wallet_address")
+    2: required string signature (api.body="signature")
+    3: required string message (api.body="message")
+}
+
+// 解绑登录方式请求
+struct UnlinkLoginMethodReq {
+    1: required string method (api.body="method")  // 登录方式：email, wallet，至少保留一种
+}
+
+// 绑定/解绑登录方式响应
+struct LinkAccountResp {
+    1: optional string email           // 当前绑定的邮箱
+    2: optional string wallet_address  // 当前绑定的钱包地址
     3: common.BaseResp base_resp
 }
 
+// 两步验证登录请求（登录第二步）
+struct TwoFactorLoginReq {
+    1: required string mfa_token (api.body="mfa_token")  // 登录接口返回的两步验证挑战令牌
+    2: required string code (api.body="code")            // 验证器 App 中的 6 位验证码或恢复码
+}
+
+// 两步验证登录响应
+struct TwoFactorLoginResp {
+    1: string token
+    2: i64 expires_in
+    3: string refresh_token
+    4: i64 refresh_expires_in
+    5: common.BaseResp base_resp
+}
+
+// 获取两步验证状态请求
+struct GetTwoFactorStatusReq {
+    // JWT中间件会自动解析用户ID，无需传参
+}
+
+// 两步验证状态响应
+struct TwoFactorStatusResp {
+    1: bool enabled                    // 是否已启用
+    2: bool required                   // 是否必须启用（管理员必须启用）
+    3: i64 recovery_codes_remaining    // 剩余可用的恢复码数量
+    4: common.BaseResp base_resp
+}
+
+// 开始启用两步验证请求
+struct SetupTwoFactorReq {
+    // JWT中间件会自动解析用户ID，无需传参
+}
+
+// 开始启用两步验证响应
+struct SetupTwoFactorResp {
+    1: string secret        // base32 密钥，无法扫码时手动输入
+    2: string otpauth_url   // otpauth:// 地址，前端生成二维码供验证器 App 扫描
+    3: common.BaseResp base_resp
+}
+
+// 两步验证码请求（启用、关闭、重新生成恢复码时需要输入当前验证码）
+struct TwoFactorCodeReq {
+    1: required string code (api.body="code")  // 验证器 App 中的 6 位验证码
+}
+
+// 恢复码响应（恢复码只展示一次，请提示用户妥善保存）
+struct TwoFactorRecoveryCodesResp {
+    1: list<string> recovery_codes
+    2: common.BaseResp base_resp
+}
+
 // 认证服务
 service AuthService {
+    WalletChallengeResp WalletChallenge(1: WalletChallengeReq req) (api.post="/api/v1/auth/wallet-challenge")
     WalletLoginResp WalletLogin(1: WalletLoginReq req) (api.post="/api/v1/auth/wallet-login")
     SendVerificationCodeResp SendVerificationCode(1: SendVerificationCodeReq req) (api.post="/api/v1/auth/send-verification-code")
     EmailLoginResp EmailLogin(1: EmailLoginReq req) (api.post="/api/v1/auth/email-login")
+    RefreshTokenResp RefreshToken(1: RefreshTokenReq req) (api.post="/api/v1/auth/refresh")
+    LogoutResp Logout(1: LogoutReq req) (api.post="/api/v1/auth/logout")
+    LogoutResp LogoutAll(1: LogoutAllReq req) (api.post="/api/v1/auth/logout-all")
+    LinkAccountResp LinkEmail(1: LinkEmailReq req) (api.post="/api/v1/auth/link-email")
+    LinkAccountResp LinkWallet(1: LinkWalletReq req) (api.post="/api/v1/auth/link-wallet")
+    LinkAccountResp UnlinkLoginMethod(1: UnlinkLoginMethodReq req) (api.post="/api/v1/auth/unlink")
+
+    // 两步验证
+    TwoFactorLoginResp VerifyTwoFactorLogin(1: TwoFactorLoginReq req) (api.post="/api/v1/auth/2fa/verify")
+    TwoFactorStatusResp GetTwoFactorStatus(1: GetTwoFactorStatusReq req) (api.post="/api/v1/auth/2fa/status")
+    SetupTwoFactorResp SetupTwoFactor(1: SetupTwoFactorReq req) (api.post="/api/v1/auth/2fa/setup")
+    TwoFactorRecoveryCodesResp EnableTwoFactor(1: TwoFactorCodeReq req) (api.post="/api/v1/auth/2fa/enable")
+    TwoFactorStatusResp DisableTwoFactor(1: TwoFactorCodeReq req) (api.post="/api/v1/auth/2fa/disable")
+    TwoFactorRecoveryCodesResp RegenerateRecoveryCodes(1: TwoFactorCodeReq req) (api.post="/api/v1/auth/2fa/recovery-codes")
 }
\ No newline at end of file
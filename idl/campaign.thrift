@@ -42,7 +42,7 @@ struct CampaignInfo {
     26: optional i32 frequency_cap_times
     27: optional i32 frequency_cap_days
     28: i32 budget_type  // 0-每日预算, 1-总预算
-    29: double budget_amount
+    29: string budget_amount
     30: optional string website
     31: optional string ios_download_url
     32: optional string android_download_url
@@ -78,7 +78,7 @@ struct CreateCampaignReq {
     22: optional i32 frequency_cap_times (api.body="frequency_cap_times")
     23: optional i32 frequency_cap_days (api.body="frequency_cap_days")
     24: i32 budget_type (api.body="budget_type")
-    25: double budget_amount (api.body="budget_amount")
+    25: string budget_amount (api.body="budget_amount")
     26: optional string website (api.body="website")
     27: optional string ios_download_url (api.body="ios_download_url")
     28: optional string android_download_url (api.body="android_download_url")
@@ -118,7 +118,7 @@ struct UpdateCampaignReq {
     23: optional i32 frequency_cap_times (api.body="frequency_cap_times")
     24: optional i32 frequency_cap_days (api.body="frequency_cap_days")
     25: optional i32 budget_type (api.body="budget_type")
-    26: optional double budget_amount (api.body="budget_amount")
+    26: optional string budget_amount (api.body="budget_amount")
     27: optional string website (api.body="website")
     28: optional string ios_download_url (api.body="ios_download_url")
     29: optional string android_download_url (api.body="android_download_url")
@@ -22,6 +22,10 @@ struct KolStats {
     5: i64 discord_members
     6: i64 tiktok_avg_views
     7: double engagement_rate
+    8: bool verified  // 所有已填写主页的平台粉丝数均已由平台接口核验
+    9: list<string> verified_platforms  // 粉丝数已核验的平台：tiktok, youtube, x, discord；其余平台为KOL手动填写
+    10: optional string verified_at  // 最近一次核验成功时间
+    11: optional string last_refreshed_at  // 最近一次同步时间
 }
 
 // KOL报价Plan
@@ -29,10 +33,11 @@ struct KolPlan {
     1: i64 id
     2: string title
     3: string description
-    4: double price
+    4: string price
     5: string plan_type  // basic, standard, premium
     6: string created_at
     7: string updated_at
+    8: i32 max_revisions  // 买家验收时最多可申请修改的次数
 }
 
 // KOL视频
@@ -44,6 +49,12 @@ struct KolVideo {
     5: string updated_at
 }
 
+// KOL评分汇总（只统计未被隐藏的评价）
+struct KolRating {
+    1: double average_rating  // 平均分（1-5），没有评价时为0
+    2: i64 review_count  // 评价数量
+}
+
 // KOL详细信息
 struct KolInfo {
     1: i64 id
@@ -64,6 +75,8 @@ struct KolInfo {
     16: KolStats stats
     17: string created_at
     18: string updated_at
+    19: optional string min_price  // 最低报价（列表接口返回），没有报价Plan时为空
+    20: optional KolRating rating  // 评分汇总（详情和列表接口返回）
 }
 
 // 申请成为KOL请求
@@ -146,13 +159,51 @@ struct UpdateKolStatsResp {
     1: common.BaseResp base_resp
 }
 
+// KOL每日统计快照
+struct KolStatsSnapshot {
+    1: string date  // 快照日期，格式 2006-01-02
+    2: i64 total_followers
+    3: i64 tiktok_followers
+    4: i64 youtube_subscribers
+    5: i64 x_followers
+    6: i64 discord_members
+    7: i64 tiktok_avg_views
+    8: double engagement_rate
+    9: bool verified
+}
+
+// KOL指标周期增长
+struct KolStatsGrowth {
+    1: i32 period_days  // 7, 30, 90
+    2: string metric  // total_followers, tiktok_followers, youtube_subscribers, x_followers, discord_members, tiktok_avg_views, engagement_rate
+    3: optional double start_value  // 周期起点的值，快照不足一个周期时不返回
+    4: double end_value  // 最新快照的值
+    5: optional double change  // 增长量
+    6: optional double change_rate  // 增长率（百分比），起点为0时不返回
+}
+
+// 获取KOL统计趋势请求
+struct GetKolStatsHistoryReq {
+    1: optional i64 kol_id (api.query="kol_id")  // 不传则获取当前登录用户的KOL统计趋势
+    2: optional i32 days (api.query="days")  // 返回最近多少天的快照，默认90，最大365
+}
+
+// 获取KOL统计趋势响应
+struct GetKolStatsHistoryResp {
+    1: common.BaseResp base_resp
+    2: i64 kol_id
+    3: list<KolStatsSnapshot> snapshots  // 按日期升序
+    4: list<KolStatsGrowth> growth  // 7、30、90天的各指标增长
+}
+
 // 创建/更新KOL报价Plan请求
 struct SaveKolPlanReq {
     1: optional i64 id (api.body="id")  // 不传则创建，传了则更新
     2: string title (api.body="title")
     3: string description (api.body="description")
-    4: double price (api.body="price")
+    4: string price (api.body="price")
     5: string plan_type (api.body="plan_type")  // basic, standard, premium
+    6: optional i32 max_revisions (api.body="max_revisions")  // 可修改次数（0-10），创建时默认2，更新时不传则保持不变
 }
 
 // 创建/更新KOL报价Plan响应
@@ -233,10 +284,40 @@ struct GetKolVideosResp {
 // KOL列表请求
 struct GetKolListReq {
     1: optional string status (api.query="status")  // pending, approved, rejected，不传则查所有approved
-    2: optional string country (api.query="country")
-    3: optional string tag (api.query="tag")
+    2: optional string country (api.query="country")  // 兼容旧参数，与 countries 合并
+    3: optional string tag (api.query="tag")  // 兼容旧参数，与 tags 合并
     4: optional i32 page (api.query="page")  // 默认1
-    5: optional i32 page_size (api.query="page_size")  // 默认10
+    5: optional i32 page_size (api.query="page_size")  // 默认10，最大100
+    6: optional string keyword (api.query="keyword")  // 匹配显示名称和描述
+    7: optional list<string> countries (api.query="countries")  // 任一匹配
+    8: optional list<string> tags (api.query="tags")  // 任一匹配
+    9: optional list<string> languages (api.query="languages")  // 语言代码，任一匹配
+    10: optional i64 min_followers (api.query="min_followers")
+    11: optional i64 max_followers (api.query="max_followers")
+    12: optional double min_engagement_rate (api.query="min_engagement_rate")
+    13: optional double max_engagement_rate (api.query="max_engagement_rate")
+    14: optional string min_price (api.query="min_price")  // 有任一报价Plan在区间内
+    15: optional string max_price (api.query="max_price")
+    16: optional string sort_by (api.query="sort_by")  // relevance, followers, engagement, price, rating, recency；有关键词时默认relevance，否则默认recency
+    17: optional string sort_order (api.query="sort_order")  // asc, desc，默认desc
+    18: optional bool with_facets (api.query="with_facets")  // 是否返回分面统计
+}
+
+// 分面取值及命中数量
+struct KolFacetBucket {
+    1: string value  // 取值；区间分面为区间标识，如 10000-100000、1000000+
+    2: string label
+    3: i64 count
+}
+
+// KOL搜索分面统计（每个分面统计时忽略自身条件）
+struct KolSearchFacets {
+    1: list<KolFacetBucket> tags
+    2: list<KolFacetBucket> languages
+    3: list<KolFacetBucket> countries
+    4: list<KolFacetBucket> follower_ranges  // 区间为 [下限, 上限)
+    5: list<KolFacetBucket> engagement_ranges
+    6: list<KolFacetBucket> price_ranges  // 有任一报价Plan在区间内即计入
 }
 
 // KOL列表响应
@@ -244,6 +325,7 @@ struct GetKolListResp {
     1: common.BaseResp base_resp
     2: list<KolInfo> kol_list
     3: i64 total
+    4: optional KolSearchFacets facets  // with_facets 为 true 时返回
 }
 
 // KOL服务
@@ -257,6 +339,7 @@ service KolService {
     
     // KOL统计数据管理
     UpdateKolStatsResp UpdateKolStats(1: UpdateKolStatsReq req) (api.post="/api/v1/kol/stats/update")
+    GetKolStatsHistoryResp GetKolStatsHistory(1: GetKolStatsHistoryReq req) (api.post="/api/v1/kol/stats/history")
     
     // KOL报价Plans管理
     SaveKolPlanResp SaveKolPlan(1: SaveKolPlanReq req) (api.post="/api/v1/kol/plan/save")
@@ -0,0 +1,181 @@
+namespace go kol_earning
+
+include "common.thrift"
+
+// 提现订单状态枚举
+enum WithdrawalOrderStatus {
+    PENDING = 1 // 待处理
+    CONFIRMED = 2 // 已打款
+    REJECTED = 3 // 已拒绝
+}
+
+// KOL收益账户信息
+struct KolEarningInfo {
+    1: i64 kol_id
+    2: i64 user_id
+    3: string balance // 可提现余额（美元）
+    4: string frozen_balance // 提现中冻结金额（美元）
+    5: string total_earned // 累计收益（美元，已扣除平台佣金）
+    6: string total_commission // 累计平台佣金（美元）
+    7: string total_withdrawn // 累计已提现金额（美元）
+    8: string commission_rate // 当前平台佣金比例
+    9: string min_withdrawal_amount // 单笔最低提现金额（美元）
+}
+
+// KOL收益流水
+struct KolEarningRecord {
+    1: i64 id
+    2: string record_id
+    3: i64 kol_id
+    4: string type // income, refund, freeze, unfreeze, withdraw
+    5: string amount // 金额（美元，KOL实际所得部分）
+    6: string commission_amount // 平台佣金（美元）
+    7: string balance_before // 变动前可提现余额
+    8: string balance_after // 变动后可提现余额
+    9: optional string related_order_type // kol_order, withdrawal_order
+    10: optional string related_order_id
+    11: optional string remark
+    12: string created_at
+}
+
+// 提现订单信息
+struct WithdrawalOrder {
+    1: i64 id
+    2: string order_id
+    3: i64 kol_id
+    4: i64 user_id
+    5: string amount // 提现金额（美元）
+    6: string network // 收款区块链网络
+    7: string address // 收款钱包地址
+    8: optional string crypto_tx_hash // 打款交易哈希
+    9: string status // WithdrawalOrderStatus转字符串：pending, confirmed, rejected
+    10: optional i64 confirmed_by // 处理人ID（管理员）
+    11: optional string confirmed_at // 处理时间
+    12: optional string rejected_reason // 拒绝原因
+    13: optional string remark // 备注
+    14: string created_at
+    15: string updated_at
+}
+
+// 获取我的收益账户请求
+struct GetMyKolEarningReq {
+}
+
+// 获取我的收益账户响应
+struct GetMyKolEarningResp {
+    1: optional KolEarningInfo earning
+    2: common.BaseResp base_resp
+}
+
+// 获取我的收益流水请求
+struct GetMyKolEarningRecordsReq {
+    1: optional string type (api.body="type") // 类型筛选：income, refund, freeze, unfreeze, withdraw
+    2: optional i32 page (api.body="page")
+    3: optional i32 page_size (api.body="page_size")
+}
+
+// 获取我的收益流水响应
+struct GetMyKolEarningRecordsResp {
+    1: list<KolEarningRecord> records
+    2: i64 total
+    3: i32 page
+    4: i32 page_size
+    5: common.BaseResp base_resp
+}
+
+// 创建提现订单请求
+struct CreateWithdrawalOrderReq {
+    1: required string amount (api.body="amount") // 提现金额（美元）
+    2: required string network (api.body="network") // 收款区块链网络，如 TRC-20
+    3: required string address (api.body="address") // 收款钱包地址
+    4: optional string remark (api.body="remark") // 备注
+}
+
+// 创建提现订单响应
+struct CreateWithdrawalOrderResp {
+    1: optional WithdrawalOrder order
+    2: common.BaseResp base_resp
+}
+
+// 查询我的提现订单列表请求
+struct GetMyWithdrawalOrdersReq {
+    1: optional string status (api.body="status") // 状态筛选：pending, confirmed, rejected
+    2: optional i32 page (api.body="page")
+    3: optional i32 page_size (api.body="page_size")
+}
+
+// 查询提现订单列表响应
+struct GetWithdrawalOrdersResp {
+    1: list<WithdrawalOrder> orders
+    2: i64 total
+    3: i32 page
+    4: i32 page_size
+    5: common.BaseResp base_resp
+}
+
+// 查询提现订单详情请求
+struct GetWithdrawalOrderDetailReq {
+    1: required string order_id (api.path="order_id")
+}
+
+// 查询提现订单详情响应
+struct GetWithdrawalOrderDetailResp {
+    1: optional WithdrawalOrder order
+    2: common.BaseResp base_resp
+}
+
+// 查询所有提现订单列表请求（admin用户）
+struct GetAllWithdrawalOrdersReq {
+    1: optional string status (api.body="status") // 状态筛选
+    2: optional string network (api.body="network") // 网络筛选
+    3: optional i64 kol_id (api.body="kol_id") // KOL ID筛选
+    4: optional i64 user_id (api.body="user_id") // 用户ID筛选
+    5: optional i32 page (api.body="page")
+    6: optional i32 page_size (api.body="page_size")
+}
+
+// admin确认提现订单请求（已完成打款）
+struct ConfirmWithdrawalOrderReq {
+    1: required string order_id (api.body="order_id")
+    2: required string crypto_tx_hash (api.body="crypto_tx_hash") // 打款交易哈希
+    3: optional string remark (api.body="remark") // 备注
+}
+
+// admin确认提现订单响应
+struct ConfirmWithdrawalOrderResp {
+    1: optional WithdrawalOrder order
+    2: common.BaseResp base_resp
+}
+
+// admin拒绝提现订单请求
+struct RejectWithdrawalOrderReq {
+    1: required string order_id (api.body="order_id")
+    2: required string rejected_reason (api.body="rejected_reason") // 拒绝原因
+}
+
+// admin拒绝提现订单响应
+struct RejectWithdrawalOrderResp {
+    1: optional WithdrawalOrder order
+    2: common.BaseResp base_resp
+}
+
+// KOL收益服务
+service KolEarningService {
+    // KOL查询自己的收益账户和流水
+    GetMyKolEarningResp GetMyKolEarning(1: GetMyKolEarningReq req) (api.post="/api/v1/kol-earning/info")
+    GetMyKolEarningRecordsResp GetMyKolEarningRecords(1: GetMyKolEarningRecordsReq req) (api.post="/api/v1/kol-earning/records")
+
+    // KOL申请提现
+    CreateWithdrawalOrderResp CreateWithdrawalOrder(1: CreateWithdrawalOrderReq req) (api.post="/api/v1/kol-earning/withdrawal/create")
+
+    // KOL查询自己的提现订单
+    GetWithdrawalOrdersResp GetMyWithdrawalOrders(1: GetMyWithdrawalOrdersReq req) (api.post="/api/v1/kol-earning/withdrawal/my/list")
+    GetWithdrawalOrderDetailResp GetWithdrawalOrderDetail(1: GetWithdrawalOrderDetailReq req) (api.post="/api/v1/kol-earning/withdrawal/detail/:order_id")
+
+    // admin查询所有提现订单
+    GetWithdrawalOrdersResp GetAllWithdrawalOrders(1: GetAllWithdrawalOrdersReq req) (api.post="/api/v1/admin/withdrawal/list")
+
+    // admin确认打款/拒绝提现订单
+    ConfirmWithdrawalOrderResp ConfirmWithdrawalOrder(1: ConfirmWithdrawalOrderReq req) (api.post="/api/v1/admin/withdrawal/confirm")
+    RejectWithdrawalOrderResp RejectWithdrawalOrder(1: RejectWithdrawalOrderReq req) (api.post="/api/v1/admin/withdrawal/reject")
+}
@@ -15,7 +15,7 @@ struct KolOrderInfo {
     9: i64 plan_id
     10: string plan_title  // Plan标题（快照）
     11: string plan_description  // Plan描述（快照）
-    12: double plan_price  // Plan价格（快照，美元）
+    12: string plan_price  // Plan价格（快照，美元）
     13: string plan_type  // Plan类型（快照）：basic, standard, premium
     14: string title  // 订单标题
     15: string requirement_description  // 合作需求描述
@@ -24,7 +24,7 @@ struct KolOrderInfo {
     18: string target_audience  // 目标受众
     19: string expected_delivery_date  // 期望交付日期（YYYY-MM-DD）
     20: optional string additional_requirements  // 额外要求
-    21: string status  // pending_payment-待支付, pending-待确认, confirmed-已确认, in_progress-进行中, completed-已完成, cancelled-已取消, refunded-已退款
+    21: string status  // pending_payment-待支付, pending-待确认, confirmed-已确认, in_progress-进行中, delivered-已交付（待买家验收）, completed-已完成, cancelled-已取消, refunded-已退款
     22: optional string reject_reason  // 拒绝/取消原因
     23: optional string confirmed_at
     24: optional string completed_at
@@ -32,6 +32,47 @@ struct KolOrderInfo {
     26: string created_at
     27: string updated_at
     28: optional string conversation_id  // 会话ID（用于聊天）
+    29: optional string refund_amount  // 退款金额（美元，部分退款时小于订单金额）
+    30: optional string refunded_at
+    31: optional KolReviewInfo review  // 订单评价（已评价时返回）
+    32: optional string delivered_at  // 最近一次交付时间
+    33: i32 max_revisions  // 买家最多可申请修改的次数（Plan快照）
+    34: i32 revision_count  // 买家已申请修改的次数
+    35: optional string auto_accept_at  // 已交付订单的自动验收时间（未开启自动验收时不返回）
+}
+
+// KOL订单交付内容（KOL每次提交一轮）
+struct KolOrderDeliverableInfo {
+    1: i64 id
+    2: string order_id
+    3: i32 round  // 提交轮次，从1开始
+    4: list<string> video_urls  // 视频链接
+    5: list<string> file_urls  // 上传的文件
+    6: optional string note  // KOL交付说明
+    7: string status  // submitted-待验收, accepted-已验收, revision_requested-已申请修改
+    8: optional string feedback  // 买家修改意见
+    9: bool auto_accepted  // 是否超时自动验收
+    10: optional string reviewed_at  // 验收/申请修改时间
+    11: string created_at
+}
+
+// KOL订单评价信息
+struct KolReviewInfo {
+    1: i64 review_id
+    2: string order_id
+    3: i64 kol_id
+    4: string kol_display_name  // KOL显示名称（关联查询）
+    5: i64 user_id
+    6: string user_nickname  // 评价用户昵称（关联查询）
+    7: string user_avatar_url  // 评价用户头像（关联查询）
+    8: i32 rating  // 评分：1-5星
+    9: string content  // 评价内容
+    10: optional string reply  // KOL公开回复
+    11: optional string replied_at
+    12: bool hidden  // 是否被管理员隐藏，隐藏的评价不公开展示、不计入评分
+    13: optional string hidden_reason  // 隐藏原因
+    14: string created_at
+    15: string updated_at
 }
 
 // 创建KOL订单请求
@@ -100,7 +141,7 @@ struct GetKolReceivedOrderListResp {
 // 更新KOL订单状态请求（KOL使用）
 struct UpdateKolOrderStatusReq {
     1: string order_id (api.body="order_id")
-    2: string status (api.body="status")  // pending, confirmed, in_progress, completed, cancelled, refunded
+    2: string status (api.body="status")  // confirmed, in_progress, cancelled（完成订单需提交交付内容并由买家验收）
     3: optional string reject_reason (api.body="reject_reason")  // 取消时需要提供原因
 }
 
@@ -130,6 +171,133 @@ struct ConfirmKolOrderPaymentResp {
     1: common.BaseResp base_resp
 }
 
+// 管理员退款请求（纠纷处理）
+struct AdminRefundKolOrderReq {
+    1: string order_id (api.body="order_id")
+    2: optional string amount (api.body="amount")  // 退款金额（美元），为空时全额退款
+    3: string reason (api.body="reason")  // 退款原因
+}
+
+// 管理员退款响应
+struct AdminRefundKolOrderResp {
+    1: common.BaseResp base_resp
+    2: optional string refund_amount  // 实际退款金额
+}
+
+// 创建订单评价请求（下单用户对已完成的订单评价，每个订单只能评价一次）
+struct CreateKolOrderReviewReq {
+    1: string order_id (api.body="order_id")
+    2: i32 rating (api.body="rating")  // 评分：1-5星
+    3: string content (api.body="content")  // 评价内容，最多2000字
+}
+
+// 创建订单评价响应
+struct CreateKolOrderReviewResp {
+    1: common.BaseResp base_resp
+    2: optional i64 review_id
+}
+
+// KOL回复评价请求（重复回复会覆盖之前的回复）
+struct ReplyKolReviewReq {
+    1: i64 review_id (api.body="review_id")
+    2: string reply (api.body="reply")  // 回复内容，最多2000字
+}
+
+// KOL回复评价响应
+struct ReplyKolReviewResp {
+    1: common.BaseResp base_resp
+}
+
+// 获取KOL公开评价列表请求
+struct GetKolReviewListReq {
+    1: i64 kol_id (api.body="kol_id")
+    2: optional i32 page (api.body="page")  // 默认1
+    3: optional i32 page_size (api.body="page_size")  // 默认10
+}
+
+// 获取KOL公开评价列表响应
+struct GetKolReviewListResp {
+    1: common.BaseResp base_resp
+    2: list<KolReviewInfo> reviews
+    3: i64 total
+    4: double average_rating  // 平均分（1-5），没有评价时为0
+    5: i64 review_count
+}
+
+// 管理员获取评价列表请求
+struct AdminGetKolReviewListReq {
+    1: optional i64 kol_id (api.body="kol_id")
+    2: optional i32 rating (api.body="rating")  // 评分筛选
+    3: optional bool hidden (api.body="hidden")  // 是否隐藏筛选，不传则查询全部
+    4: optional i32 page (api.body="page")  // 默认1
+    5: optional i32 page_size (api.body="page_size")  // 默认10
+}
+
+// 管理员获取评价列表响应
+struct AdminGetKolReviewListResp {
+    1: common.BaseResp base_resp
+    2: list<KolReviewInfo> reviews
+    3: i64 total
+}
+
+// 管理员隐藏/恢复评价请求
+struct AdminModerateKolReviewReq {
+    1: i64 review_id (api.body="review_id")
+    2: bool hidden (api.body="hidden")  // true-隐藏，false-恢复展示
+    3: optional string reason (api.body="reason")  // 隐藏原因，隐藏时必填
+}
+
+// 管理员隐藏/恢复评价响应
+struct AdminModerateKolReviewResp {
+    1: common.BaseResp base_resp
+}
+
+// 提交交付内容请求（KOL使用，订单需处于进行中）
+struct SubmitKolOrderDeliverableReq {
+    1: string order_id (api.body="order_id")
+    2: list<string> video_urls (api.body="video_urls")  // 视频链接（TikTok、YouTube、X、Discord 等平台地址），最多10个
+    3: list<string> file_urls (api.body="file_urls")  // 已上传文件的URL，最多10个；视频链接和文件至少提供一项
+    4: optional string note (api.body="note")  // 交付说明，最多2000字
+}
+
+// 提交交付内容响应
+struct SubmitKolOrderDeliverableResp {
+    1: common.BaseResp base_resp
+    2: optional i64 deliverable_id
+}
+
+// 获取订单交付内容请求（下单用户或KOL使用）
+struct GetKolOrderDeliverablesReq {
+    1: string order_id (api.body="order_id")
+}
+
+// 获取订单交付内容响应
+struct GetKolOrderDeliverablesResp {
+    1: common.BaseResp base_resp
+    2: list<KolOrderDeliverableInfo> deliverables  // 按提交轮次升序
+}
+
+// 验收交付内容请求（下单用户使用，验收后订单完成并结算给KOL）
+struct AcceptKolOrderDeliverableReq {
+    1: string order_id (api.body="order_id")
+}
+
+// 验收交付内容响应
+struct AcceptKolOrderDeliverableResp {
+    1: common.BaseResp base_resp
+}
+
+// 申请修改请求（下单用户使用，次数受Plan限制，订单回到进行中）
+struct RequestKolOrderRevisionReq {
+    1: string order_id (api.body="order_id")
+    2: string feedback (api.body="feedback")  // 修改意见，最多2000字
+}
+
+// 申请修改响应
+struct RequestKolOrderRevisionResp {
+    1: common.BaseResp base_resp
+}
+
 // KOL订单服务
 service KolOrderService {
     // 用户订单管理
@@ -142,5 +310,21 @@ service KolOrderService {
     // KOL订单管理
     GetKolReceivedOrderListResp GetKolReceivedOrderList(1: GetKolReceivedOrderListReq req) (api.post="/api/v1/kol-order/kol/list")
     UpdateKolOrderStatusResp UpdateKolOrderStatus(1: UpdateKolOrderStatusReq req) (api.post="/api/v1/kol-order/status/update")
+
+    // 订单交付验收
+    SubmitKolOrderDeliverableResp SubmitKolOrderDeliverable(1: SubmitKolOrderDeliverableReq req) (api.post="/api/v1/kol-order/deliverable/submit")
+    GetKolOrderDeliverablesResp GetKolOrderDeliverables(1: GetKolOrderDeliverablesReq req) (api.post="/api/v1/kol-order/deliverable/list")
+    AcceptKolOrderDeliverableResp AcceptKolOrderDeliverable(1: AcceptKolOrderDeliverableReq req) (api.post="/api/v1/kol-order/deliverable/accept")
+    RequestKolOrderRevisionResp RequestKolOrderRevision(1: RequestKolOrderRevisionReq req) (api.post="/api/v1/kol-order/deliverable/revision")
+
+    // 订单评价
+    CreateKolOrderReviewResp CreateKolOrderReview(1: CreateKolOrderReviewReq req) (api.post="/api/v1/kol-order/review/create")
+    ReplyKolReviewResp ReplyKolReview(1: ReplyKolReviewReq req) (api.post="/api/v1/kol-order/review/reply")
+    GetKolReviewListResp GetKolReviewList(1: GetKolReviewListReq req) (api.post="/api/v1/kol-order/review/list")
+
+    // 管理员订单管理
+    AdminRefundKolOrderResp AdminRefundKolOrder(1: AdminRefundKolOrderReq req) (api.post="/api/v1/admin/kol-order/refund")
+    AdminGetKolReviewListResp AdminGetKolReviewList(1: AdminGetKolReviewListReq req) (api.post="/api/v1/admin/kol-order/review/list")
+    AdminModerateKolReviewResp AdminModerateKolReview(1: AdminModerateKolReviewReq req) (api.post="/api/v1/admin/kol-order/review/moderate")
 }
 
@@ -39,6 +39,9 @@ struct RechargeOrder {
     19: optional string remark // 备注
     20: string created_at
     21: string updated_at
+    22: string verify_status // 链上校验状态：unverified, verified, flagged
+    23: optional string verify_message // 链上校验结果说明
+    24: optional string verified_at // 最近一次链上校验时间
 }
 
 // 创建充值订单请求（加密货币）
@@ -59,7 +62,7 @@ struct CreateRechargeOrderResp {
 // 创建充值订单请求（在线支付）
 struct CreateOnlineRechargeOrderReq {
     1: required string amount (api.body="amount") // 充值金额（美元）
-    2: required string platform (api.body="platform") // 支付平台：stripe, paypal
+    2: required string platform (api.body="platform") // 支付平台：stripe, paypal, fake（仅本地联调）
 }
 
 // 查询充值订单列表请求（normal用户）
@@ -85,6 +88,7 @@ struct GetAllRechargeOrdersReq {
     3: optional i64 user_id (api.body="user_id") // 用户ID筛选
     4: optional i32 page (api.body="page")
     5: optional i32 page_size (api.body="page_size")
+    6: optional string verify_status (api.body="verify_status") // 链上校验状态筛选：unverified, verified, flagged
 }
 
 // 查询充值订单详情请求
@@ -123,11 +127,86 @@ struct RejectRechargeOrderResp {
     2: common.BaseResp base_resp
 }
 
+// admin触发链上校验请求
+struct VerifyRechargeOrderReq {
+    1: required string order_id (api.body="order_id")
+}
+
+// admin触发链上校验响应
+struct VerifyRechargeOrderResp {
+    1: optional RechargeOrder order
+    2: common.BaseResp base_resp
+}
+
+// 链上入账记录
+struct ChainDeposit {
+    1: i64 id
+    2: string network // 区块链网络标识
+    3: string tx_hash
+    4: i64 log_index // 转账在交易中的序号
+    5: i64 block_number
+    6: string token_symbol // USDT, USDC
+    7: string token_contract
+    8: string from_address
+    9: string to_address
+    10: string amount // 入账金额（美元）
+    11: string status // unmatched, matched, ignored
+    12: optional string recharge_order_id // 匹配的充值订单ID
+    13: optional i64 resolved_by // 处理人ID（管理员）
+    14: optional string resolved_at
+    15: optional string remark
+    16: string created_at
+}
+
+// admin查询链上入账记录请求
+struct GetChainDepositsReq {
+    1: optional string status (api.body="status") // 状态筛选：unmatched（待审核）, matched, ignored
+    2: optional string network (api.body="network") // 网络筛选
+    3: optional i32 page (api.body="page")
+    4: optional i32 page_size (api.body="page_size")
+}
+
+// admin查询链上入账记录响应
+struct GetChainDepositsResp {
+    1: list<ChainDeposit> deposits
+    2: i64 total
+    3: i32 page
+    4: i32 page_size
+    5: common.BaseResp base_resp
+}
+
+// admin处理未匹配入账记录请求
+struct ResolveChainDepositReq {
+    1: required i64 deposit_id (api.body="deposit_id")
+    2: required string action (api.body="action") // match-匹配到充值订单并入账，ignore-忽略
+    3: optional string order_id (api.body="order_id") // action 为 match 时必填，待确认的充值订单ID
+    4: optional string remark (api.body="remark") // 备注
+}
+
+// admin处理未匹配入账记录响应
+struct ResolveChainDepositResp {
+    1: optional ChainDeposit deposit
+    2: common.BaseResp base_resp
+}
+
 // 充值订单服务
+// 在线支付平台 webhook 通知（请求体为支付平台原始事件，按平台规则验签）
+struct PaymentWebhookReq {
+    1: required string platform (api.path="platform") // 支付平台：stripe, paypal, fake
+}
+
+// 在线支付平台 webhook 响应
+struct PaymentWebhookResp {
+    1: common.BaseResp base_resp
+}
+
 service RechargeOrderService {
     // normal用户创建充值订单
     CreateRechargeOrderResp CreateCryptoRechargeOrder(1: CreateCryptoRechargeOrderReq req) (api.post="/api/v1/recharge/create/crypto")
     CreateRechargeOrderResp CreateOnlineRechargeOrder(1: CreateOnlineRechargeOrderReq req) (api.post="/api/v1/recharge/create/online")
+
+    // 在线支付平台 webhook（无需登录，按平台签名校验）
+    PaymentWebhookResp PaymentWebhook(1: PaymentWebhookReq req) (api.post="/api/v1/recharge/webhook/:platform")
     
     // normal用户查询自己的充值订单
     GetRechargeOrdersResp GetMyRechargeOrders(1: GetMyRechargeOrdersReq req) (api.post="/api/v1/recharge/my/list")
@@ -139,5 +218,12 @@ service RechargeOrderService {
     // admin确认/拒绝充值订单
     ConfirmRechargeOrderResp ConfirmRechargeOrder(1: ConfirmRechargeOrderReq req) (api.post="/api/v1/admin/recharge/confirm")
     RejectRechargeOrderResp RejectRechargeOrder(1: RejectRechargeOrderReq req) (api.post="/api/v1/admin/recharge/reject")
+
+    // admin触发链上校验（校验通过自动确认，不通过标记待人工处理）
+    VerifyRechargeOrderResp VerifyRechargeOrder(1: VerifyRechargeOrderReq req) (api.post="/api/v1/admin/recharge/verify")
+
+    // admin链上入账审核队列（未匹配到充值订单的入账）
+    GetChainDepositsResp GetChainDeposits(1: GetChainDepositsReq req) (api.post="/api/v1/admin/recharge/deposit/list")
+    ResolveChainDepositResp ResolveChainDeposit(1: ResolveChainDepositReq req) (api.post="/api/v1/admin/recharge/deposit/resolve")
 }
 
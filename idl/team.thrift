@@ -175,6 +175,59 @@ struct GetUserInvitationsResp {
     3: common.BaseResp base_resp
 }
 
+// 团队API Key信息（不包含明文密钥）
+struct TeamAPIKey {
+    1: i64 id
+    2: i64 team_id
+    3: i64 creator_id
+    4: string name
+    5: string key_prefix
+    6: list<string> scopes
+    7: string status
+    8: string expires_at
+    9: optional string last_used_at
+    10: optional string last_used_ip
+    11: optional string revoked_at
+    12: string created_at
+}
+
+// 创建团队API Key请求
+struct CreateTeamAPIKeyReq {
+    1: string team_id (api.body="team_id")
+    2: string name (api.body="name")
+    3: list<string> scopes (api.body="scopes")
+    4: optional i32 expires_in_days (api.body="expires_in_days")
+}
+
+// 创建团队API Key响应（明文密钥只返回这一次）
+struct CreateTeamAPIKeyResp {
+    1: TeamAPIKey api_key
+    2: string key
+    3: common.BaseResp base_resp
+}
+
+// 获取团队API Key列表请求
+struct ListTeamAPIKeysReq {
+    1: string team_id (api.body="team_id")
+}
+
+// 获取团队API Key列表响应
+struct ListTeamAPIKeysResp {
+    1: list<TeamAPIKey> api_keys
+    2: common.BaseResp base_resp
+}
+
+// 吊销团队API Key请求
+struct RevokeTeamAPIKeyReq {
+    1: string team_id (api.body="team_id")
+    2: i64 key_id (api.body="key_id")
+}
+
+// 吊销团队API Key响应
+struct RevokeTeamAPIKeyResp {
+    1: common.BaseResp base_resp
+}
+
 // 团队服务接口
 service TeamService {
     // 创建团队
@@ -206,4 +259,13 @@ service TeamService {
     
     // 获取用户邀请列表
     GetUserInvitationsResp GetUserInvitations(1: GetUserInvitationsReq req) (api.post="/api/v1/team/invitations")
+
+    // 创建团队API Key（仅 creator 和 owner）
+    CreateTeamAPIKeyResp CreateTeamAPIKey(1: CreateTeamAPIKeyReq req) (api.post="/api/v1/team/api-key/create")
+
+    // 获取团队API Key列表（仅 creator 和 owner）
+    ListTeamAPIKeysResp ListTeamAPIKeys(1: ListTeamAPIKeysReq req) (api.post="/api/v1/team/api-key/list")
+
+    // 吊销团队API Key（仅 creator 和 owner）
+    RevokeTeamAPIKeyResp RevokeTeamAPIKey(1: RevokeTeamAPIKeyReq req) (api.post="/api/v1/team/api-key/revoke")
 }
\ No newline at end of file
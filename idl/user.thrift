@@ -63,10 +63,46 @@ struct GetUserByIdResp {
     2: common.BaseResp base_resp
 }
 
+// 登录会话信息
+struct UserSession {
+    1: string session_id
+    2: optional string device_name
+    3: optional string user_agent
+    4: optional string ip // 登录IP
+    5: string created_at // 登录时间
+    6: optional string last_seen_at
+    7: optional string last_seen_ip
+    8: bool is_current // 是否为当前请求所用的会话
+    9: bool mfa_verified // 是否已通过两步验证
+}
+
+// 获取登录会话列表请求
+struct ListSessionsReq {
+    // JWT中间件会自动解析用户ID，无需传参
+}
+
+// 获取登录会话列表响应
+struct ListSessionsResp {
+    1: list<UserSession> sessions
+    2: common.BaseResp base_resp
+}
+
+// 吊销登录会话请求
+struct RevokeSessionReq {
+    1: required string session_id (api.body="session_id")
+}
+
+// 吊销登录会话响应
+struct RevokeSessionResp {
+    1: common.BaseResp base_resp
+}
+
 // 用户服务
 service UserService {
     GetProfileResp GetProfile(1: GetProfileReq req) (api.post="/api/v1/user/profile")
     UpdateProfileResp UpdateProfile(1: UpdateProfileReq req) (api.post="/api/v1/user/update-profile")
     SwitchCurrentTeamResp SwitchCurrentTeam(1: SwitchCurrentTeamReq req) (api.post="/api/v1/user/switch-team")
     GetUserByIdResp GetUserById(1: GetUserByIdReq req) (api.post="/api/v1/user/:user_id")
+    ListSessionsResp ListSessions(1: ListSessionsReq req) (api.post="/api/v1/user/sessions")
+    RevokeSessionResp RevokeSession(1: RevokeSessionReq req) (api.post="/api/v1/user/sessions/revoke")
 }
\ No newline at end of file
@@ -4,11 +4,17 @@ package main
 
 import (
 	"log"
+	"time"
 
 	"orbia_api/biz/dal/mysql"
+	"orbia_api/biz/dal/redis"
 	"orbia_api/biz/handler"
 	"orbia_api/biz/infra/config"
+	"orbia_api/biz/infra/ratelimit"
 	"orbia_api/biz/mw"
+	kolOrderService "orbia_api/biz/service/kol_order"
+	"orbia_api/biz/service/ledger"
+	"orbia_api/biz/service/twofactor"
 
 	"orbia_api/biz/router"
 
@@ -31,16 +37,63 @@ func main() {
 
 	// 初始化认证中间件
 	userRepo := mysql.NewUserRepository(mysql.DB)
-	mw.InitAuthMiddleware(userRepo)
+	mw.InitAuthMiddleware(userRepo, mysql.NewAuthSessionRepository(mysql.DB))
 	log.Println("✅ Auth middleware initialized successfully")
 
+	// 初始化团队API Key认证
+	mw.InitAPIKeyMiddleware(mysql.NewTeamAPIKeyRepository(mysql.DB), mysql.NewTeamRepository(mysql.DB))
+	log.Println("✅ API key middleware initialized successfully")
+
+	// 初始化权限中间件
+	mw.InitPermissionMiddleware(mysql.NewUserRoleRepository(mysql.DB))
+	log.Println("✅ Permission middleware initialized successfully")
+
+	// 初始化两步验证中间件（高风险操作二次确认）
+	mw.InitTwoFactorMiddleware(twofactor.NewTwoFactorService(mysql.DB, mysql.NewTwoFactorRepository(mysql.DB), userRepo, mysql.NewAuthSessionRepository(mysql.DB)))
+	log.Println("✅ Two-factor middleware initialized successfully")
+
+	// 初始化幂等中间件
+	mw.InitIdempotencyMiddleware(mysql.NewIdempotencyRepository(mysql.DB))
+	log.Println("✅ Idempotency middleware initialized successfully")
+
+	// 初始化限流中间件（配置 redis 且连接成功时多实例共享计数，否则使用进程内计数）
+	rateLimitStore := ratelimit.NewMemoryStore()
+	if config.GlobalConfig.RateLimit.Store == "redis" {
+		if err := redis.Init(); err != nil {
+			log.Printf("⚠️ Failed to initialize redis, falling back to in-memory rate limiting: %v", err)
+		} else {
+			defer redis.Close()
+			rateLimitStore = ratelimit.NewRedisStore(redis.Client)
+		}
+	}
+	mw.InitRateLimitMiddleware(rateLimitStore)
+	log.Println("✅ Rate limit middleware initialized successfully")
+
 	// 初始化所有 handler 服务
 	handler.InitAllServices()
+
+	// 启动定时钱包对账任务
+	ledgerSvc := ledger.NewLedgerService(mysql.DB, mysql.NewLedgerRepository(mysql.DB))
+	ledger.StartReconcileJob(ledgerSvc, time.Duration(config.GlobalConfig.Ledger.ReconcileIntervalMinutes)*time.Minute)
+
+	// 启动已交付KOL订单的定时自动验收任务
+	kolOrderService.StartAutoAcceptJob(time.Duration(config.GlobalConfig.KolOrder.AutoAcceptIntervalMinutes) * time.Minute)
+
 	h := server.Default()
 
+	// 只信任可信代理传递的客户端 IP，防止伪造 X-Forwarded-For 绕过按 IP 限流
+	clientIP, err := mw.NewClientIPFunc(config.GlobalConfig.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("❌ Failed to configure trusted proxies: %v", err)
+	}
+	h.SetClientIPFunc(clientIP)
+
 	// 注册全局 CORS 中间件
 	h.Use(mw.CORS())
 
+	// 注册全局请求ID中间件（审计日志按请求ID关联）
+	h.Use(mw.RequestID())
+
 	router.GeneratedRegister(h)
 	h.Spin()
 }
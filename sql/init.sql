@@ -86,6 +86,30 @@ CREATE TABLE IF NOT EXISTS orbia_team_invitation (
     FOREIGN KEY (inviter_id) REFERENCES orbia_user(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='团队邀请表';
 
+-- 团队API Key表（只保存密钥哈希）
+CREATE TABLE IF NOT EXISTS orbia_team_api_key (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT 'API Key ID',
+    team_id BIGINT NOT NULL COMMENT '团队ID',
+    creator_id BIGINT NOT NULL COMMENT '创建者ID，API Key以该用户身份在团队内操作',
+    name VARCHAR(50) NOT NULL COMMENT 'API Key名称',
+    key_prefix VARCHAR(20) NOT NULL COMMENT '密钥前缀，用于识别',
+    key_hash VARCHAR(64) NOT NULL COMMENT '密钥SHA-256哈希',
+    scopes VARCHAR(255) NOT NULL COMMENT '授权范围，逗号分隔',
+    status ENUM('active', 'revoked') NOT NULL DEFAULT 'active' COMMENT '状态',
+    expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
+    last_used_at TIMESTAMP NULL COMMENT '最后使用时间',
+    last_used_ip VARCHAR(45) COMMENT '最后使用IP',
+    revoked_at TIMESTAMP NULL COMMENT '吊销时间',
+    revoked_by BIGINT COMMENT '吊销人ID',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_key_hash (key_hash),
+    INDEX idx_team_id (team_id),
+    INDEX idx_creator_id (creator_id),
+    FOREIGN KEY (team_id) REFERENCES orbia_team(id) ON DELETE CASCADE,
+    FOREIGN KEY (creator_id) REFERENCES orbia_user(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='团队API Key表';
+
 -- KOL信息表
 CREATE TABLE IF NOT EXISTS orbia_kol (
     id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT 'KOL ID',
@@ -107,6 +131,8 @@ CREATE TABLE IF NOT EXISTS orbia_kol (
     INDEX idx_user_id (user_id),
     INDEX idx_status (status),
     INDEX idx_deleted_at (deleted_at),
+    INDEX idx_country (country),
+    FULLTEXT INDEX ft_kol_search (display_name, description) WITH PARSER ngram COMMENT 'KOL关键词搜索（ngram分词，支持中文）',
     FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL信息表';
 
@@ -145,14 +171,58 @@ CREATE TABLE IF NOT EXISTS orbia_kol_stats (
     discord_members BIGINT COMMENT 'Discord成员数',
     tiktok_avg_views BIGINT COMMENT 'TikTok视频平均观看数',
     engagement_rate DECIMAL(10, 2) DEFAULT 0.00 COMMENT '订阅指数（Engagement Rate）',
+    manual_total_followers BIGINT DEFAULT 0 COMMENT '手动填写的粉丝总数',
+    manual_tiktok_followers BIGINT DEFAULT 0 COMMENT '手动填写的TikTok粉丝数',
+    manual_youtube_subscribers BIGINT DEFAULT 0 COMMENT '手动填写的Youtube订阅数',
+    manual_x_followers BIGINT DEFAULT 0 COMMENT '手动填写的X粉丝数',
+    manual_discord_members BIGINT DEFAULT 0 COMMENT '手动填写的Discord成员数',
+    manual_tiktok_avg_views BIGINT DEFAULT 0 COMMENT '手动填写的TikTok视频平均观看数',
+    manual_engagement_rate DECIMAL(10, 2) DEFAULT 0.00 COMMENT '手动填写的订阅指数',
+    verified TINYINT(1) NOT NULL DEFAULT 0 COMMENT '所有已填写主页的平台粉丝数均已由平台接口核验',
+    verified_platforms VARCHAR(100) COMMENT '最近一次同步核验成功的平台，逗号分隔：tiktok,youtube,x,discord',
+    verified_at TIMESTAMP NULL COMMENT '最近一次核验成功时间',
+    last_refreshed_at TIMESTAMP NULL COMMENT '最近一次同步时间',
+    refresh_error VARCHAR(1000) COMMENT '最近一次同步失败的平台及原因',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     INDEX idx_kol_id (kol_id),
     INDEX idx_total_followers (total_followers),
     INDEX idx_engagement_rate (engagement_rate),
+    INDEX idx_verified (verified),
     FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL数据统计表';
 
+-- KOL统计数据历史表（每次同步或手动更新记录一次各项指标）
+CREATE TABLE IF NOT EXISTS orbia_kol_stats_history (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT 'ID',
+    kol_id BIGINT NOT NULL COMMENT 'KOL ID',
+    metric VARCHAR(50) NOT NULL COMMENT '指标：total_followers, tiktok_followers, youtube_subscribers, x_followers, discord_members, tiktok_avg_views, engagement_rate',
+    value DECIMAL(20, 2) NOT NULL COMMENT '指标值',
+    source ENUM('provider', 'manual') NOT NULL COMMENT '数据来源：provider-平台接口核验，manual-KOL手动填写',
+    recorded_at TIMESTAMP NOT NULL COMMENT '记录时间',
+    INDEX idx_kol_metric_time (kol_id, metric, recorded_at),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL统计数据历史表';
+
+-- KOL统计数据每日快照表（每个KOL每天一条，保存当天最后一次的数据，用于增长趋势）
+CREATE TABLE IF NOT EXISTS orbia_kol_stats_daily (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT 'ID',
+    kol_id BIGINT NOT NULL COMMENT 'KOL ID',
+    snapshot_date DATE NOT NULL COMMENT '快照日期',
+    total_followers BIGINT DEFAULT 0 COMMENT '全社交网站粉丝总数',
+    tiktok_followers BIGINT DEFAULT 0 COMMENT 'TikTok粉丝数',
+    youtube_subscribers BIGINT DEFAULT 0 COMMENT 'Youtube订阅数',
+    x_followers BIGINT DEFAULT 0 COMMENT 'X粉丝数',
+    discord_members BIGINT DEFAULT 0 COMMENT 'Discord成员数',
+    tiktok_avg_views BIGINT DEFAULT 0 COMMENT 'TikTok视频平均观看数',
+    engagement_rate DECIMAL(10, 2) DEFAULT 0.00 COMMENT '订阅指数（Engagement Rate）',
+    verified TINYINT(1) NOT NULL DEFAULT 0 COMMENT '当天数据是否已由平台接口核验',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_kol_date (kol_id, snapshot_date),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL统计数据每日快照表';
+
 -- KOL报价Plans表
 CREATE TABLE IF NOT EXISTS orbia_kol_plan (
     id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT 'Plan ID',
@@ -161,6 +231,7 @@ CREATE TABLE IF NOT EXISTS orbia_kol_plan (
     description TEXT COMMENT '报价描述',
     price DECIMAL(10, 2) NOT NULL COMMENT '价格（美元）',
     plan_type ENUM('basic', 'standard', 'premium') NOT NULL COMMENT 'Plan类型：basic-基础，standard-标准，premium-高级',
+    max_revisions INT NOT NULL DEFAULT 2 COMMENT '买家验收时最多可申请修改的次数',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '软删除时间',
@@ -206,11 +277,16 @@ CREATE TABLE orbia_kol_order (
     expected_delivery_date DATE NOT NULL COMMENT '期望交付日期',
     additional_requirements TEXT COMMENT '额外要求',
     conversation_id VARCHAR(64) COMMENT '关联的会话ID（引用orbia_conversation.conversation_id）',
-    status ENUM('pending_payment', 'pending', 'confirmed', 'in_progress', 'completed', 'cancelled', 'refunded') NOT NULL DEFAULT 'pending_payment' COMMENT '订单状态：pending_payment-待支付，pending-待确认，confirmed-已确认，in_progress-进行中，completed-已完成，cancelled-已取消，refunded-已退款',
+    plan_max_revisions INT NOT NULL DEFAULT 0 COMMENT 'Plan可修改次数（快照）',
+    revision_count INT NOT NULL DEFAULT 0 COMMENT '买家已申请修改的次数',
+    status ENUM('pending_payment', 'pending', 'confirmed', 'in_progress', 'delivered', 'completed', 'cancelled', 'refunded') NOT NULL DEFAULT 'pending_payment' COMMENT '订单状态：pending_payment-待支付，pending-待确认，confirmed-已确认，in_progress-进行中，delivered-已交付（待买家验收），completed-已完成，cancelled-已取消，refunded-已退款',
     reject_reason TEXT COMMENT '拒绝/取消原因',
     confirmed_at TIMESTAMP NULL COMMENT '确认时间',
+    delivered_at TIMESTAMP NULL COMMENT '最近一次交付时间（超时未验收时自动验收）',
     completed_at TIMESTAMP NULL COMMENT '完成时间',
     cancelled_at TIMESTAMP NULL COMMENT '取消时间',
+    refund_amount DECIMAL(10, 2) COMMENT '退款金额（美元，部分退款时小于plan_price）',
+    refunded_at TIMESTAMP NULL COMMENT '退款时间',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '软删除时间',
@@ -225,12 +301,67 @@ CREATE TABLE orbia_kol_order (
     INDEX idx_deleted_at (deleted_at),
     INDEX idx_title (title),
     INDEX idx_expected_delivery_date (expected_delivery_date),
+    INDEX idx_status_delivered_at (status, delivered_at),
     FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE,
     FOREIGN KEY (team_id) REFERENCES orbia_team(id) ON DELETE SET NULL,
     FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE,
     FOREIGN KEY (plan_id) REFERENCES orbia_kol_plan(id) ON DELETE RESTRICT
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL订单表';
 
+-- KOL订单交付内容表（KOL每次提交一条，买家验收或申请修改）
+DROP TABLE IF EXISTS orbia_kol_order_deliverable;
+CREATE TABLE orbia_kol_order_deliverable (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '交付内容ID',
+    order_id VARCHAR(64) NOT NULL COMMENT '订单ID',
+    kol_id BIGINT NOT NULL COMMENT 'KOL ID',
+    round INT NOT NULL COMMENT '提交轮次（从1开始）',
+    note TEXT COMMENT 'KOL交付说明',
+    status ENUM('submitted', 'accepted', 'revision_requested') NOT NULL DEFAULT 'submitted' COMMENT '状态：submitted-待验收，accepted-已验收，revision_requested-已申请修改',
+    feedback TEXT COMMENT '买家修改意见',
+    auto_accepted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否超时自动验收',
+    reviewed_at TIMESTAMP NULL COMMENT '验收/申请修改时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_order_round (order_id, round),
+    INDEX idx_kol_id (kol_id),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL订单交付内容表';
+
+-- KOL订单交付内容明细表（视频链接和文件）
+DROP TABLE IF EXISTS orbia_kol_order_deliverable_item;
+CREATE TABLE orbia_kol_order_deliverable_item (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT 'ID',
+    deliverable_id BIGINT NOT NULL COMMENT '交付内容ID',
+    item_type ENUM('video', 'file') NOT NULL COMMENT '类型：video-视频链接，file-上传的文件',
+    url VARCHAR(1000) NOT NULL COMMENT '视频链接或文件URL',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    INDEX idx_deliverable_id (deliverable_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL订单交付内容明细表';
+
+-- KOL订单评价表（买家对已完成订单的评价，每个订单一条）
+DROP TABLE IF EXISTS orbia_kol_review;
+CREATE TABLE orbia_kol_review (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '评价ID',
+    order_id VARCHAR(64) NOT NULL UNIQUE COMMENT '订单ID',
+    kol_id BIGINT NOT NULL COMMENT 'KOL ID',
+    user_id BIGINT NOT NULL COMMENT '评价用户ID（下单用户）',
+    rating TINYINT NOT NULL COMMENT '评分：1-5星',
+    content TEXT NOT NULL COMMENT '评价内容',
+    reply TEXT COMMENT 'KOL公开回复',
+    replied_at TIMESTAMP NULL COMMENT 'KOL回复时间',
+    hidden TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否被管理员隐藏，隐藏的评价不公开展示、不计入评分',
+    hidden_reason VARCHAR(500) COMMENT '隐藏原因',
+    hidden_by BIGINT COMMENT '隐藏评价的管理员ID',
+    hidden_at TIMESTAMP NULL COMMENT '隐藏时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    INDEX idx_kol_hidden (kol_id, hidden),
+    INDEX idx_user_id (user_id),
+    INDEX idx_created_at (created_at),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL订单评价表';
+
 -- 广告订单表
 DROP TABLE IF EXISTS orbia_ad_order;
 CREATE TABLE orbia_ad_order (
@@ -282,19 +413,19 @@ CREATE TABLE IF NOT EXISTS orbia_wallet (
     FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户钱包表';
 
--- 交易记录表（仅用于记录支出账单）
+-- 交易记录表（用户账单：充值、消费、退款、冻结/解冻）
 DROP TABLE IF EXISTS orbia_transaction;
 CREATE TABLE orbia_transaction (
     id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID（内部使用）',
     transaction_id VARCHAR(64) NOT NULL UNIQUE COMMENT '交易ID（业务唯一ID，格式：TXN{snowflake_id}）',
     user_id BIGINT NOT NULL COMMENT '用户ID',
-    type ENUM('consume', 'refund', 'freeze', 'unfreeze') NOT NULL COMMENT '交易类型：consume-消费，refund-退款，freeze-冻结，unfreeze-解冻',
+    type ENUM('recharge', 'consume', 'refund', 'freeze', 'unfreeze') NOT NULL COMMENT '交易类型：recharge-充值，consume-消费，refund-退款，freeze-冻结，unfreeze-解冻',
     amount DECIMAL(12, 2) NOT NULL COMMENT '交易金额（美元）',
     balance_before DECIMAL(12, 2) NOT NULL COMMENT '交易前余额（美元）',
     balance_after DECIMAL(12, 2) NOT NULL COMMENT '交易后余额（美元）',
     status ENUM('pending', 'processing', 'completed', 'failed', 'cancelled') NOT NULL DEFAULT 'pending' COMMENT '交易状态：pending-待处理，processing-处理中，completed-已完成，failed-失败，cancelled-已取消',
-    related_order_type VARCHAR(50) COMMENT '关联订单类型：kol_order-KOL订单，ad_order-广告订单',
-    related_order_id VARCHAR(64) COMMENT '关联订单ID（如果是消费/退款类型）',
+    related_order_type VARCHAR(50) COMMENT '关联订单类型：kol_order-KOL订单，ad_order-广告订单，recharge_order-充值订单',
+    related_order_id VARCHAR(64) COMMENT '关联订单ID',
     remark TEXT COMMENT '备注说明',
     completed_at TIMESTAMP NULL COMMENT '完成时间',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
@@ -307,7 +438,59 @@ CREATE TABLE orbia_transaction (
     INDEX idx_related_order_id (related_order_id),
     INDEX idx_created_at (created_at),
     FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='交易记录表（仅记录支出账单）';
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='交易记录表（用户账单）';
+
+-- 账本分录表（复式记账，所有余额变动都必须通过账本记账）
+DROP TABLE IF EXISTS orbia_ledger_line;
+DROP TABLE IF EXISTS orbia_ledger_entry;
+CREATE TABLE orbia_ledger_entry (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID（内部使用）',
+    entry_id VARCHAR(64) NOT NULL UNIQUE COMMENT '分录ID（业务唯一ID，格式：JE{snowflake_id}）',
+    entry_type VARCHAR(50) NOT NULL COMMENT '分录类型：recharge-充值，kol_order_payment-KOL订单支付，kol_order_settle-KOL订单结算，kol_order_refund-KOL订单退款，campaign_consume-Campaign消费，withdrawal_request-提现申请，withdrawal_payout-提现打款，withdrawal_reject-提现拒绝，account_merge-账号合并，opening_balance-期初余额',
+    reference_type VARCHAR(50) COMMENT '关联业务类型：recharge_order, kol_order, campaign, withdrawal_order, user',
+    reference_id VARCHAR(64) COMMENT '关联业务ID',
+    remark TEXT COMMENT '备注说明',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    INDEX idx_entry_id (entry_id),
+    INDEX idx_entry_type (entry_type),
+    INDEX idx_reference (reference_type, reference_id),
+    INDEX idx_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='账本分录表';
+
+-- 账本分录明细表（每条分录的借方合计必须等于贷方合计）
+CREATE TABLE orbia_ledger_line (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID（内部使用）',
+    entry_id VARCHAR(64) NOT NULL COMMENT '分录ID',
+    account_type VARCHAR(50) NOT NULL COMMENT '账户类型：user_wallet-用户可用余额，user_wallet_frozen-用户冻结余额，kol_earning-KOL可提现收益，kol_earning_frozen-KOL提现中金额，platform_cash-平台资金，platform_revenue-平台收入，platform_commission-平台佣金，opening_equity-期初权益',
+    owner_id BIGINT NOT NULL DEFAULT 0 COMMENT '账户所属ID（用户账户为user_id，KOL账户为kol_id，平台账户为0）',
+    direction ENUM('debit', 'credit') NOT NULL COMMENT '借贷方向：debit-借，credit-贷',
+    amount DECIMAL(12, 2) NOT NULL COMMENT '金额（美元）',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    INDEX idx_entry_id (entry_id),
+    INDEX idx_account (account_type, owner_id),
+    FOREIGN KEY (entry_id) REFERENCES orbia_ledger_entry(entry_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='账本分录明细表';
+
+-- 期初余额：为启用账本前已有余额的钱包补记一笔期初分录（借：期初权益，贷：用户可用/冻结余额），
+-- 否则对账时每个钱包的差异都等于其全部余额。分录ID固定为 JEOPEN{user_id}，重复执行不会重复记账
+INSERT INTO orbia_ledger_entry (entry_id, entry_type, reference_type, reference_id, remark)
+SELECT CONCAT('JEOPEN', w.user_id), 'opening_balance', 'user', CAST(w.user_id AS CHAR), '期初余额（启用账本前的钱包余额）'
+FROM orbia_wallet w
+WHERE (w.balance <> 0 OR w.frozen_balance <> 0)
+  AND NOT EXISTS (SELECT 1 FROM orbia_ledger_entry e WHERE e.entry_id = CONCAT('JEOPEN', w.user_id));
+
+INSERT INTO orbia_ledger_line (entry_id, account_type, owner_id, direction, amount)
+SELECT entry_id, account_type, owner_id, direction, amount FROM (
+    SELECT CONCAT('JEOPEN', w.user_id) AS entry_id, 'opening_equity' AS account_type, 0 AS owner_id, 'debit' AS direction, w.balance + w.frozen_balance AS amount
+    FROM orbia_wallet w WHERE w.balance + w.frozen_balance > 0
+    UNION ALL
+    SELECT CONCAT('JEOPEN', w.user_id), 'user_wallet', w.user_id, 'credit', w.balance
+    FROM orbia_wallet w WHERE w.balance > 0
+    UNION ALL
+    SELECT CONCAT('JEOPEN', w.user_id), 'user_wallet_frozen', w.user_id, 'credit', w.frozen_balance
+    FROM orbia_wallet w WHERE w.frozen_balance > 0
+) opening
+WHERE NOT EXISTS (SELECT 1 FROM orbia_ledger_line l WHERE l.entry_id = opening.entry_id);
 
 
 -- 创建数据字典表
@@ -386,7 +569,7 @@ CREATE TABLE orbia_recharge_order (
     payment_label VARCHAR(200) COMMENT '快照-钱包标签',
     user_crypto_address VARCHAR(500) COMMENT '用户的转账钱包地址（仅加密货币支付）',
     crypto_tx_hash VARCHAR(500) COMMENT '加密货币交易哈希（用户或管理员填写）',
-    online_payment_platform VARCHAR(50) COMMENT '在线支付平台：stripe, paypal',
+    online_payment_platform VARCHAR(50) COMMENT '在线支付平台：stripe, paypal, fake',
     online_payment_order_id VARCHAR(200) COMMENT '在线支付平台订单ID',
     online_payment_url TEXT COMMENT '在线支付URL',
     status ENUM('pending', 'confirmed', 'failed', 'cancelled') NOT NULL DEFAULT 'pending' COMMENT '订单状态：pending-待确认，confirmed-已确认，failed-失败，cancelled-已取消',
@@ -394,6 +577,9 @@ CREATE TABLE orbia_recharge_order (
     confirmed_at TIMESTAMP NULL COMMENT '确认时间',
     failed_reason TEXT COMMENT '失败原因',
     remark TEXT COMMENT '备注',
+    verify_status ENUM('unverified', 'verified', 'flagged') NOT NULL DEFAULT 'unverified' COMMENT '链上校验状态：unverified-未校验，verified-校验通过，flagged-校验不通过（待人工处理）',
+    verify_message VARCHAR(500) COMMENT '链上校验结果说明',
+    verified_at TIMESTAMP NULL COMMENT '最近一次链上校验时间',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '软删除时间',
@@ -406,19 +592,144 @@ CREATE TABLE orbia_recharge_order (
     INDEX idx_confirmed_at (confirmed_at),
     INDEX idx_deleted_at (deleted_at),
     INDEX idx_crypto_tx_hash (crypto_tx_hash),
+    INDEX idx_verify_status (verify_status),
     FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE,
     FOREIGN KEY (payment_setting_id) REFERENCES orbia_payment_setting(id) ON DELETE SET NULL,
     FOREIGN KEY (confirmed_by) REFERENCES orbia_user(id) ON DELETE SET NULL
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='充值订单表';
 
+-- 链上扫描游标表（每个区块链网络一条）
+DROP TABLE IF EXISTS orbia_chain_cursor;
+CREATE TABLE orbia_chain_cursor (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    network VARCHAR(100) NOT NULL UNIQUE COMMENT '区块链网络标识（与配置 chain.networks[].name 一致）',
+    position BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '扫描游标（EVM 为下一个待扫描的区块高度，TRON 为区块时间戳毫秒）',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='链上扫描游标表';
+
+-- 链上入账记录表（扫描到的转入收款地址的稳定币转账，未匹配到充值订单的进入管理员审核队列）
+DROP TABLE IF EXISTS orbia_chain_deposit;
+CREATE TABLE orbia_chain_deposit (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    network VARCHAR(100) NOT NULL COMMENT '区块链网络标识',
+    tx_hash VARCHAR(128) NOT NULL COMMENT '交易哈希',
+    log_index INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '转账在交易中的序号',
+    block_number BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '区块高度（TRON 为 0）',
+    token_symbol VARCHAR(20) NOT NULL COMMENT '代币符号：USDT, USDC',
+    token_contract VARCHAR(128) NOT NULL COMMENT '代币合约地址',
+    from_address VARCHAR(128) NOT NULL COMMENT '转出地址',
+    to_address VARCHAR(128) NOT NULL COMMENT '收款地址',
+    amount DECIMAL(12, 2) NOT NULL COMMENT '入账金额（美元）',
+    status ENUM('unmatched', 'matched', 'ignored') NOT NULL DEFAULT 'unmatched' COMMENT '状态：unmatched-未匹配（待审核），matched-已匹配充值订单，ignored-已忽略',
+    recharge_order_id VARCHAR(64) COMMENT '匹配的充值订单ID',
+    resolved_by BIGINT COMMENT '处理人ID（管理员，自动匹配为空）',
+    resolved_at TIMESTAMP NULL COMMENT '处理时间',
+    remark TEXT COMMENT '备注',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_network_tx_log (network, tx_hash, log_index),
+    INDEX idx_status (status),
+    INDEX idx_recharge_order_id (recharge_order_id),
+    INDEX idx_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='链上入账记录表';
+
+-- 链上交易入账登记表（同一笔链上交易只能为一个充值订单入账，在入账事务中登记，唯一索引防止并发确认重复入账）
+DROP TABLE IF EXISTS orbia_chain_tx_claim;
+CREATE TABLE orbia_chain_tx_claim (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    network VARCHAR(100) NOT NULL COMMENT '区块链网络标识（已配置链上校验的网络与 chain.networks[].name 一致）',
+    tx_hash VARCHAR(500) NOT NULL COMMENT '交易哈希（已规范化）',
+    recharge_order_id VARCHAR(64) NOT NULL COMMENT '使用该交易入账的充值订单ID',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    UNIQUE KEY uk_network_tx_hash (network, tx_hash),
+    INDEX idx_recharge_order_id (recharge_order_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='链上交易入账登记表';
+
+-- KOL收益账户表
+DROP TABLE IF EXISTS orbia_kol_earning;
+CREATE TABLE orbia_kol_earning (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '收益账户ID',
+    kol_id BIGINT NOT NULL UNIQUE COMMENT 'KOL ID',
+    user_id BIGINT NOT NULL COMMENT 'KOL对应的用户ID',
+    balance DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '可提现余额（美元）',
+    frozen_balance DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '提现中冻结金额（美元）',
+    total_earned DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '累计收益（美元，已扣除平台佣金）',
+    total_commission DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '累计平台佣金（美元）',
+    total_withdrawn DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '累计已提现金额（美元）',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    INDEX idx_kol_id (kol_id),
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL收益账户表';
+
+-- KOL收益流水表
+DROP TABLE IF EXISTS orbia_kol_earning_record;
+CREATE TABLE orbia_kol_earning_record (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID（内部使用）',
+    record_id VARCHAR(64) NOT NULL UNIQUE COMMENT '流水ID（业务唯一ID，格式：TXN{snowflake_id}）',
+    kol_id BIGINT NOT NULL COMMENT 'KOL ID',
+    user_id BIGINT NOT NULL COMMENT 'KOL对应的用户ID',
+    type ENUM('income', 'refund', 'freeze', 'unfreeze', 'withdraw') NOT NULL COMMENT '流水类型：income-订单收入，refund-订单退款扣回，freeze-提现冻结，unfreeze-提现解冻，withdraw-提现出账',
+    amount DECIMAL(12, 2) NOT NULL COMMENT '金额（美元，KOL实际所得部分）',
+    commission_amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '平台佣金（美元，仅订单收入/退款）',
+    balance_before DECIMAL(12, 2) NOT NULL COMMENT '变动前可提现余额（美元）',
+    balance_after DECIMAL(12, 2) NOT NULL COMMENT '变动后可提现余额（美元）',
+    related_order_type VARCHAR(50) COMMENT '关联订单类型：kol_order-KOL订单，withdrawal_order-提现订单',
+    related_order_id VARCHAR(64) COMMENT '关联订单ID',
+    remark TEXT COMMENT '备注说明',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    INDEX idx_record_id (record_id),
+    INDEX idx_kol_id (kol_id),
+    INDEX idx_type (type),
+    INDEX idx_related_order_id (related_order_id),
+    INDEX idx_created_at (created_at),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL收益流水表';
+
+-- KOL提现订单表
+DROP TABLE IF EXISTS orbia_withdrawal_order;
+CREATE TABLE orbia_withdrawal_order (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID（内部使用）',
+    order_id VARCHAR(64) NOT NULL UNIQUE COMMENT '订单ID（业务唯一ID，格式：WDORD_{timestamp}_{random}）',
+    kol_id BIGINT NOT NULL COMMENT 'KOL ID',
+    user_id BIGINT NOT NULL COMMENT 'KOL对应的用户ID',
+    amount DECIMAL(12, 2) NOT NULL COMMENT '提现金额（美元）',
+    network VARCHAR(100) NOT NULL COMMENT '收款区块链网络（如：TRC-20）',
+    address VARCHAR(500) NOT NULL COMMENT 'KOL的收款钱包地址',
+    crypto_tx_hash VARCHAR(500) COMMENT '打款交易哈希（管理员确认时填写）',
+    status ENUM('pending', 'confirmed', 'rejected') NOT NULL DEFAULT 'pending' COMMENT '订单状态：pending-待处理，confirmed-已打款，rejected-已拒绝',
+    confirmed_by BIGINT COMMENT '处理人ID（管理员）',
+    confirmed_at TIMESTAMP NULL COMMENT '处理时间',
+    rejected_reason TEXT COMMENT '拒绝原因',
+    remark TEXT COMMENT '备注',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    deleted_at TIMESTAMP NULL COMMENT '软删除时间',
+    INDEX idx_order_id (order_id),
+    INDEX idx_kol_id (kol_id),
+    INDEX idx_user_id (user_id),
+    INDEX idx_network (network),
+    INDEX idx_status (status),
+    INDEX idx_created_at (created_at),
+    INDEX idx_deleted_at (deleted_at),
+    INDEX idx_crypto_tx_hash (crypto_tx_hash),
+    FOREIGN KEY (kol_id) REFERENCES orbia_kol(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE,
+    FOREIGN KEY (confirmed_by) REFERENCES orbia_user(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='KOL提现订单表';
+
 -- 验证码表
 DROP TABLE IF EXISTS orbia_verification_code;
 CREATE TABLE orbia_verification_code (
     id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
     email VARCHAR(255) NOT NULL COMMENT '邮箱地址',
     code VARCHAR(10) NOT NULL COMMENT '验证码',
-    code_type ENUM('login', 'register', 'reset_password') NOT NULL DEFAULT 'login' COMMENT '验证码类型：login-登录，register-注册，reset_password-重置密码',
+    code_type ENUM('login', 'register', 'reset_password', 'link') NOT NULL DEFAULT 'login' COMMENT '验证码类型：login-登录，register-注册，reset_password-重置密码，link-绑定邮箱',
     status ENUM('unused', 'used', 'expired') NOT NULL DEFAULT 'unused' COMMENT '状态：unused-未使用，used-已使用，expired-已过期',
+    failed_attempts INT NOT NULL DEFAULT 0 COMMENT '错误尝试次数，达到上限后验证码失效',
     used_at TIMESTAMP NULL COMMENT '使用时间',
     expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
@@ -431,6 +742,170 @@ CREATE TABLE orbia_verification_code (
     INDEX idx_created_at (created_at)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='验证码表';
 
+-- 钱包登录随机数表（EIP-4361 登录挑战，一次性使用）
+DROP TABLE IF EXISTS orbia_wallet_nonce;
+CREATE TABLE orbia_wallet_nonce (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    nonce VARCHAR(64) NOT NULL COMMENT '随机数',
+    wallet_address VARCHAR(42) NOT NULL COMMENT '请求登录的钱包地址（EIP-55 格式）',
+    chain_id BIGINT NOT NULL COMMENT '链ID',
+    message TEXT NOT NULL COMMENT '下发的 EIP-4361 待签名消息',
+    status ENUM('unused', 'used', 'expired') NOT NULL DEFAULT 'unused' COMMENT '状态：unused-未使用，used-已使用，expired-已过期',
+    used_at TIMESTAMP NULL COMMENT '使用时间',
+    expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_nonce (nonce),
+    INDEX idx_wallet_address (wallet_address),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='钱包登录随机数表';
+
+-- 登录会话表（一次登录为一个会话，即一个刷新令牌家族）
+DROP TABLE IF EXISTS orbia_auth_session;
+CREATE TABLE orbia_auth_session (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    session_id VARCHAR(64) NOT NULL COMMENT '会话ID（访问令牌中的 sid）',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    status ENUM('active', 'revoked') NOT NULL DEFAULT 'active' COMMENT '状态：active-有效，revoked-已吊销',
+    revoke_reason VARCHAR(50) NULL COMMENT '吊销原因：logout, logout_all, token_reuse, user_revoked',
+    revoked_at TIMESTAMP NULL COMMENT '吊销时间',
+    last_refreshed_at TIMESTAMP NULL COMMENT '最后刷新时间',
+    mfa_verified_at TIMESTAMP NULL COMMENT '两步验证通过时间，为空表示该会话未通过两步验证',
+    device_hash CHAR(64) NULL COMMENT '设备标识哈希（X-Device-ID，未提供时为User-Agent）',
+    device_name VARCHAR(100) NULL COMMENT '设备名称，如 Chrome on macOS',
+    user_agent VARCHAR(500) NULL COMMENT '登录时的User-Agent',
+    ip VARCHAR(45) NULL COMMENT '登录IP',
+    last_seen_at TIMESTAMP NULL COMMENT '最后活跃时间',
+    last_seen_ip VARCHAR(45) NULL COMMENT '最后活跃IP',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_session_id (session_id),
+    INDEX idx_user_status (user_id, status),
+    INDEX idx_user_device (user_id, device_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='登录会话表';
+
+-- 刷新令牌表（只保存令牌哈希，每次刷新轮换，旧令牌再次使用时吊销整个会话）
+DROP TABLE IF EXISTS orbia_refresh_token;
+CREATE TABLE orbia_refresh_token (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    token_hash CHAR(64) NOT NULL COMMENT '刷新令牌的SHA-256',
+    session_id VARCHAR(64) NOT NULL COMMENT '所属会话ID（令牌家族）',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    status ENUM('active', 'rotated', 'revoked') NOT NULL DEFAULT 'active' COMMENT '状态：active-有效，rotated-已轮换，revoked-已吊销',
+    expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
+    used_at TIMESTAMP NULL COMMENT '轮换时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_token_hash (token_hash),
+    INDEX idx_session_id (session_id),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='刷新令牌表';
+
+-- 两步验证（TOTP）表，密钥加密存储；管理员必须启用
+DROP TABLE IF EXISTS orbia_user_totp;
+CREATE TABLE orbia_user_totp (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    secret VARCHAR(255) NOT NULL COMMENT 'TOTP 密钥（AES-GCM 加密后 base64）',
+    status ENUM('pending', 'enabled') NOT NULL DEFAULT 'pending' COMMENT '状态：pending-待确认，enabled-已启用',
+    last_used_step BIGINT NOT NULL DEFAULT 0 COMMENT '最后一次验证通过的时间步，同一验证码不能重复使用',
+    enabled_at TIMESTAMP NULL COMMENT '启用时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='两步验证表';
+
+-- 两步验证恢复码表（只保存哈希，每个恢复码只能使用一次）
+DROP TABLE IF EXISTS orbia_user_recovery_code;
+CREATE TABLE orbia_user_recovery_code (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    code_hash CHAR(64) NOT NULL COMMENT '恢复码的SHA-256',
+    used_at TIMESTAMP NULL COMMENT '使用时间，为空表示未使用',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    UNIQUE KEY uk_user_code (user_id, code_hash),
+    FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='两步验证恢复码表';
+
+-- 两步验证登录挑战表（登录第一步通过后下发，凭挑战令牌和验证码换取登录令牌）
+DROP TABLE IF EXISTS orbia_mfa_challenge;
+CREATE TABLE orbia_mfa_challenge (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    token_hash CHAR(64) NOT NULL COMMENT '挑战令牌的SHA-256',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '验证码错误次数',
+    status ENUM('pending', 'completed', 'expired') NOT NULL DEFAULT 'pending' COMMENT '状态：pending-待验证，completed-已完成，expired-已失效',
+    expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_token_hash (token_hash),
+    INDEX idx_user_id (user_id),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='两步验证登录挑战表';
+
+-- 后台角色分配表（角色和权限的对应关系在代码 consts.StaffRole 中定义，拥有任一后台角色的用户 role 为 admin）
+CREATE TABLE IF NOT EXISTS orbia_user_role (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    role VARCHAR(50) NOT NULL COMMENT '后台角色：super_admin-超级管理员，finance-财务，moderator-审核，content_editor-内容编辑，support-客服',
+    granted_by BIGINT NULL COMMENT '授权人ID（管理员）',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '授权时间',
+    UNIQUE KEY uk_user_role (user_id, role),
+    INDEX idx_role (role),
+    FOREIGN KEY (user_id) REFERENCES orbia_user(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='后台角色分配表';
+
+-- 已有管理员默认授予超级管理员角色，保持原有权限
+INSERT IGNORE INTO orbia_user_role (user_id, role)
+SELECT id, 'super_admin' FROM orbia_user WHERE role = 'admin' AND deleted_at IS NULL;
+
+-- 后台审计日志表（只允许追加，触发器禁止修改和删除）
+DROP TABLE IF EXISTS orbia_admin_audit_log;
+CREATE TABLE orbia_admin_audit_log (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    actor_id BIGINT NOT NULL DEFAULT 0 COMMENT '操作人用户ID（系统任务为0）',
+    action VARCHAR(64) NOT NULL COMMENT '操作类型，如 user.set_status、recharge_order.confirm',
+    target_type VARCHAR(50) NOT NULL COMMENT '目标实体类型：user, kol, recharge_order, campaign, payment_setting, dictionary 等',
+    target_id VARCHAR(64) NOT NULL COMMENT '目标实体ID',
+    before_data JSON NULL COMMENT '操作前快照',
+    after_data JSON NULL COMMENT '操作后快照',
+    ip VARCHAR(64) NOT NULL DEFAULT '' COMMENT '操作人IP',
+    user_agent VARCHAR(255) NOT NULL DEFAULT '' COMMENT '操作人User-Agent',
+    request_id VARCHAR(64) NOT NULL DEFAULT '' COMMENT '请求ID（X-Request-ID）',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    INDEX idx_actor_id (actor_id),
+    INDEX idx_action (action),
+    INDEX idx_target (target_type, target_id),
+    INDEX idx_request_id (request_id),
+    INDEX idx_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='后台审计日志表';
+
+CREATE TRIGGER trg_admin_audit_log_no_update BEFORE UPDATE ON orbia_admin_audit_log
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'orbia_admin_audit_log is append-only';
+
+CREATE TRIGGER trg_admin_audit_log_no_delete BEFORE DELETE ON orbia_admin_audit_log
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'orbia_admin_audit_log is append-only';
+
+-- 幂等键表（资金类接口按 Idempotency-Key 请求头去重，重复请求直接返回首次响应）
+DROP TABLE IF EXISTS orbia_idempotency_key;
+CREATE TABLE orbia_idempotency_key (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY COMMENT '自增ID',
+    idem_key VARCHAR(128) NOT NULL COMMENT '客户端传入的幂等键（Idempotency-Key 请求头）',
+    user_id BIGINT NOT NULL DEFAULT 0 COMMENT '请求用户ID（未登录为0）',
+    method VARCHAR(10) NOT NULL COMMENT '请求方法',
+    path VARCHAR(255) NOT NULL COMMENT '请求路径',
+    request_hash CHAR(64) NOT NULL COMMENT '请求指纹（方法、路径和请求体的SHA-256）',
+    status ENUM('processing', 'completed') NOT NULL DEFAULT 'processing' COMMENT '状态：processing-处理中，completed-已完成',
+    response_status INT NULL COMMENT '首次响应的HTTP状态码',
+    response_body MEDIUMTEXT NULL COMMENT '首次响应的响应体',
+    expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE KEY uk_user_path_key (user_id, path, idem_key),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='幂等键表';
+
 -- 会话表
 DROP TABLE IF EXISTS orbia_message;
 DROP TABLE IF EXISTS orbia_conversation_member;